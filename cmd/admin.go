@@ -0,0 +1,846 @@
+package cmd
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"github.com/spf13/cobra"
+	"github.com/theopenlane/entx"
+	"github.com/theopenlane/iam/fgax"
+	"github.com/theopenlane/riverboat/pkg/riverqueue"
+	"github.com/theopenlane/utils/cache"
+
+	"github.com/theopenlane/core/config"
+	"github.com/theopenlane/core/internal/ent/fulltext"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/entdb"
+	"github.com/theopenlane/core/internal/httpserve/serveropts"
+	"github.com/theopenlane/core/internal/objects/validators"
+	"github.com/theopenlane/core/pkg/gala"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+var (
+	// ErrMetadataFragmentRequired is returned when a job purge is not given a json object to match
+	ErrMetadataFragmentRequired = errors.New("a json object metadata fragment is required")
+	// ErrUnhealthy is returned when one of the dependencies of the server failed its health check
+	ErrUnhealthy = errors.New("one or more dependencies are unhealthy")
+)
+
+const (
+	// jobsPageSize is the number of jobs listed per page when retrying jobs
+	jobsPageSize = 100
+	// deadLettersListLimit is the default number of dead letters listed
+	deadLettersListLimit = 100
+)
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "run maintenance tasks against the dependencies of the server",
+}
+
+var adminMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "run or verify the database migrations",
+}
+
+var adminMigrateRunCmd = &cobra.Command{
+	Use:     "run",
+	Short:   "apply the pending database migrations with the configured migration provider",
+	Example: `  openlane admin migrate run`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := runMigrations(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminMigrateVerifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "list the pending database migrations and fail when there are any",
+	Example: `  openlane admin migrate verify
+  openlane admin migrate verify --json`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := verifyMigrations(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminJobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "manage River and gala jobs",
+}
+
+var adminJobsRetryCmd = &cobra.Command{
+	Use:   "retry",
+	Short: "re-enqueue discarded jobs, optionally limited to kinds, a queue or a gala topic",
+	Example: `  openlane admin jobs retry --kind send_email
+  openlane admin jobs retry --kind gala_dispatch_v1 --topic integration.sync --dry-run=false
+  openlane admin jobs retry --queue events --include-cancelled --limit 50 --dry-run=false`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := retryJobs(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminJobsPurgeCmd = &cobra.Command{
+	Use:   "purge",
+	Short: "delete the live gala jobs whose metadata contains a json fragment",
+	Example: `  openlane admin jobs purge --metadata '{"integration_id":"01HXYZ"}'
+  openlane admin jobs purge --metadata '{"integration_id":"01HXYZ"}' --dry-run=false`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := purgeJobs(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminDeadLettersCmd = &cobra.Command{
+	Use:   "deadletters",
+	Short: "inspect, replay and discard the gala envelopes that exhausted their retries",
+}
+
+var adminDeadLettersListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list the dead letters, optionally limited to a topic or listener",
+	Example: `  openlane admin deadletters list
+  openlane admin deadletters list --topic integration.sync --listener integration.sync.run --json`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := listDeadLetters(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminDeadLettersReplayCmd = &cobra.Command{
+	Use:   "replay [id]...",
+	Short: "dispatch dead letters again, optionally to a single listener, and remove them",
+	Example: `  openlane admin deadletters replay 01HXYZ
+  openlane admin deadletters replay 01HXYZ 01HXZA --listener integration.sync.run`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		err := replayDeadLetters(cmd, args)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminDeadLettersDiscardCmd = &cobra.Command{
+	Use:   "discard",
+	Short: "remove dead letters without dispatching them",
+	Example: `  openlane admin deadletters discard --topic integration.sync
+  openlane admin deadletters discard --id 01HXYZ --id 01HXZA --dry-run=false
+  openlane admin deadletters discard --all --dry-run=false`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := discardDeadLetters(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminKeysCmd = &cobra.Command{
+	Use:   "keys",
+	Short: "manage the token signing keys in the key directory",
+}
+
+var adminKeysListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "list the signing keys and the one new tokens are signed with",
+	Example: `  openlane admin keys list --dir /keys`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := listKeys(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminKeysRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "write a new signing key that the servers switch to once it has propagated",
+	Example: `  openlane admin keys rotate --dir /keys
+  openlane admin keys rotate --dir /keys --lifetime 4320h --prune`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := rotateKeys(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminSearchCmd = &cobra.Command{
+	Use:   "search",
+	Short: "manage the full-text search indexes",
+}
+
+var adminSearchReindexCmd = &cobra.Command{
+	Use:   "reindex",
+	Short: "rebuild the full-text search indexes",
+	Example: `  openlane admin search reindex
+  openlane admin search reindex --type Control --type Risk --regenerate`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := reindexSearch(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var adminHealthCmd = &cobra.Command{
+	Use:   "health",
+	Short: "check the connection to postgres, the job queue, redis, fga and the storage providers",
+	Example: `  openlane admin health
+  openlane admin health --json`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := checkHealth(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+// init registers the admin command and its subcommands on the root command.
+func init() {
+	rootCmd.AddCommand(adminCmd)
+	adminCmd.AddCommand(adminMigrateCmd, adminJobsCmd, adminDeadLettersCmd, adminKeysCmd, adminSearchCmd, adminHealthCmd)
+	adminMigrateCmd.AddCommand(adminMigrateRunCmd, adminMigrateVerifyCmd)
+	adminJobsCmd.AddCommand(adminJobsRetryCmd, adminJobsPurgeCmd)
+	adminDeadLettersCmd.AddCommand(adminDeadLettersListCmd, adminDeadLettersReplayCmd, adminDeadLettersDiscardCmd)
+	adminKeysCmd.AddCommand(adminKeysListCmd, adminKeysRotateCmd)
+	adminSearchCmd.AddCommand(adminSearchReindexCmd)
+
+	adminCmd.PersistentFlags().String("config", "./config/.config.yaml", "config file location")
+
+	adminMigrateVerifyCmd.Flags().Bool("json", false, "print the pending migrations as json")
+
+	adminJobsRetryCmd.Flags().StringSlice("kind", nil, "limit the retry to jobs of the kind; can be repeated")
+	adminJobsRetryCmd.Flags().String("queue", "", "limit the retry to jobs of the queue")
+	adminJobsRetryCmd.Flags().String("topic", "", "limit the retry to gala jobs of the topic")
+	adminJobsRetryCmd.Flags().Bool("include-cancelled", false, "retry cancelled jobs as well as discarded ones")
+	adminJobsRetryCmd.Flags().Int("limit", 0, "maximum number of jobs to retry, 0 retries every matching job")
+	adminJobsRetryCmd.Flags().Bool("dry-run", true, "only list the jobs that would be retried")
+
+	adminJobsPurgeCmd.Flags().String("metadata", "", "json object the metadata of the jobs must contain")
+	adminJobsPurgeCmd.Flags().Bool("dry-run", true, "only count the jobs that would be purged")
+
+	adminDeadLettersListCmd.Flags().String("topic", "", "limit the list to dead letters of the topic")
+	adminDeadLettersListCmd.Flags().String("listener", "", "limit the list to dead letters of the listener")
+	adminDeadLettersListCmd.Flags().Int("limit", deadLettersListLimit, "maximum number of dead letters to list, 0 lists every one")
+	adminDeadLettersListCmd.Flags().Bool("json", false, "print the dead letters as json, including the envelopes")
+
+	adminDeadLettersReplayCmd.Flags().String("listener", "", "only deliver to the listener, defaults to every listener on the topic")
+
+	adminDeadLettersDiscardCmd.Flags().String("topic", "", "discard the dead letters of the topic")
+	adminDeadLettersDiscardCmd.Flags().String("listener", "", "discard the dead letters of the listener")
+	adminDeadLettersDiscardCmd.Flags().StringSlice("id", nil, "discard the dead letter; can be repeated")
+	adminDeadLettersDiscardCmd.Flags().Bool("all", false, "discard every dead letter")
+	adminDeadLettersDiscardCmd.Flags().Bool("dry-run", true, "only count the dead letters that would be discarded")
+
+	adminKeysCmd.PersistentFlags().String("dir", "", "key directory, defaults to the keywatcher key directory of the config")
+	adminKeysListCmd.Flags().Bool("json", false, "print the keys as json")
+	adminKeysRotateCmd.Flags().Duration("lifetime", serveropts.DefaultKeyLifetime, "validity of the new key's certificate")
+	adminKeysRotateCmd.Flags().Bool("prune", false, "remove the keys whose certificate has expired")
+
+	adminSearchReindexCmd.Flags().StringSlice("type", nil, "limit the reindex to the type, e.g. Control; can be repeated")
+	adminSearchReindexCmd.Flags().Bool("regenerate", false, "regenerate the search vectors as well as the indexes, locking each table while it runs")
+
+	adminHealthCmd.Flags().Bool("json", false, "print the results as json")
+}
+
+// loadAdminConfig loads the server config from the config flag
+func loadAdminConfig() (*config.Config, error) {
+	cfgFile := k.String("config")
+
+	return config.Load(&cfgFile)
+}
+
+// runMigrations applies the migrations of the configured provider to the primary database
+func runMigrations(cmd *cobra.Command) error {
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := entdb.Migrate(cmd.Context(), cfg.DB); err != nil {
+		return err
+	}
+
+	fmt.Println("Migrations applied")
+
+	return nil
+}
+
+// verifyMigrations prints the pending migrations and returns ErrPendingMigrations when there are any
+func verifyMigrations(cmd *cobra.Command) error {
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	pending, err := entdb.PendingMigrations(cmd.Context(), cfg.DB)
+	if err != nil {
+		return err
+	}
+
+	if k.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(pending); err != nil {
+			return err
+		}
+	} else {
+		for _, m := range pending {
+			fmt.Printf("  %s\n", m)
+		}
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: %d pending with the %s provider", entdb.ErrPendingMigrations, len(pending), cmp.Or(cfg.DB.MigrationProvider, "atlas"))
+	}
+
+	if !k.Bool("json") {
+		fmt.Println("Database schema is up to date")
+	}
+
+	return nil
+}
+
+// jobController lists and retries River jobs
+type jobController interface {
+	JobList(context.Context, *river.JobListParams) (*river.JobListResult, error)
+	JobRetry(context.Context, int64) (*rivertype.JobRow, error)
+}
+
+// retryJobs re-enqueues the discarded jobs matching the flags and prints them
+func retryJobs(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	jobClient, err := riverqueue.New(ctx, riverqueue.WithConnectionURI(cfg.JobQueue.ConnectionURI))
+	if err != nil {
+		return err
+	}
+
+	defer jobClient.Close()
+
+	states := []rivertype.JobState{rivertype.JobStateDiscarded}
+	if k.Bool("include-cancelled") {
+		states = append(states, rivertype.JobStateCancelled)
+	}
+
+	params := river.NewJobListParams().States(states...).First(jobsPageSize)
+
+	if kinds := k.Strings("kind"); len(kinds) > 0 {
+		params = params.Kinds(kinds...)
+	}
+
+	if queue := k.String("queue"); queue != "" {
+		params = params.Queues(queue)
+	}
+
+	if topic := k.String("topic"); topic != "" {
+		fragment, err := json.Marshal(map[string]string{"topic": topic})
+		if err != nil {
+			return err
+		}
+
+		params = params.Metadata(string(fragment))
+	}
+
+	dryRun := k.Bool("dry-run")
+
+	jobs, err := retryMatchingJobs(ctx, jobClient.GetRiverClient(), params, k.Int("limit"), dryRun)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintln(w, "ID\tKIND\tQUEUE\tSTATE\tATTEMPTS\tLAST ERROR")
+
+	for _, job := range jobs {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\n", job.ID, job.Kind, job.Queue, job.State, job.Attempt, lastJobError(job))
+	}
+
+	if flushErr := w.Flush(); flushErr != nil {
+		return flushErr
+	}
+
+	if dryRun {
+		fmt.Printf("\n%d jobs would be retried, rerun with --dry-run=false to retry them\n", len(jobs))
+	} else {
+		fmt.Printf("\n%d jobs retried\n", len(jobs))
+	}
+
+	return err
+}
+
+// retryMatchingJobs retries the jobs matching the params up to the limit and returns them; on a dry run the
+// jobs are only listed
+func retryMatchingJobs(ctx context.Context, jc jobController, params *river.JobListParams, limit int, dryRun bool) ([]*rivertype.JobRow, error) {
+	var jobs []*rivertype.JobRow
+
+	for {
+		result, err := jc.JobList(ctx, params)
+		if err != nil {
+			return jobs, err
+		}
+
+		for _, job := range result.Jobs {
+			if limit > 0 && len(jobs) >= limit {
+				return jobs, nil
+			}
+
+			if !dryRun {
+				if _, err := jc.JobRetry(ctx, job.ID); err != nil {
+					return jobs, fmt.Errorf("retrying job %d: %w", job.ID, err)
+				}
+			}
+
+			jobs = append(jobs, job)
+		}
+
+		if len(result.Jobs) < jobsPageSize || result.LastCursor == nil {
+			return jobs, nil
+		}
+
+		params = params.After(result.LastCursor)
+	}
+}
+
+// lastJobError returns the first line of the error of the last attempt of the job
+func lastJobError(job *rivertype.JobRow) string {
+	if len(job.Errors) == 0 {
+		return ""
+	}
+
+	msg, _, _ := strings.Cut(job.Errors[len(job.Errors)-1].Error, "\n")
+
+	return msg
+}
+
+// purgeJobs deletes the live gala jobs whose metadata contains the fragment, or counts them on a dry run
+func purgeJobs(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	fragment := k.String("metadata")
+
+	var obj map[string]any
+	if err := json.Unmarshal([]byte(fragment), &obj); err != nil || len(obj) == 0 {
+		return ErrMetadataFragmentRequired
+	}
+
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	galaApp, err := gala.NewGala(ctx, gala.Config{
+		ConnectionURI: cfg.JobQueue.ConnectionURI,
+		QueueName:     cfg.Workflows.Gala.QueueName,
+	})
+	if err != nil {
+		return err
+	}
+
+	defer galaApp.Close()
+
+	if k.Bool("dry-run") {
+		count, err := galaApp.CountActiveJobsWithMetadata(ctx, fragment)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%d jobs would be purged, rerun with --dry-run=false to purge them\n", count)
+
+		return nil
+	}
+
+	count, err := galaApp.PurgeActiveJobsWithMetadata(ctx, fragment)
+
+	fmt.Printf("%d jobs purged\n", count)
+
+	return err
+}
+
+// newDeadLetterGala creates a gala runtime on the job queue with the dead letter store; the runtime registers no
+// listeners and starts no workers, replayed envelopes are processed by the servers
+func newDeadLetterGala(ctx context.Context) (*gala.Gala, error) {
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := gala.NewPostgresDeadLetterStore(ctx, cfg.DB.PrimaryDBSource)
+	if err != nil {
+		return nil, err
+	}
+
+	galaApp, err := gala.NewGala(ctx, gala.Config{
+		ConnectionURI:   cfg.JobQueue.ConnectionURI,
+		QueueName:       cfg.Workflows.Gala.QueueName,
+		DeadLetterStore: store,
+	})
+	if err != nil {
+		_ = store.Close()
+
+		return nil, err
+	}
+
+	return galaApp, nil
+}
+
+// listDeadLetters prints the dead letters matching the flags
+func listDeadLetters(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	galaApp, err := newDeadLetterGala(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer galaApp.Close()
+
+	letters, err := galaApp.ListDeadLetters(ctx, gala.DeadLetterFilter{
+		Topic:    gala.TopicName(k.String("topic")),
+		Listener: k.String("listener"),
+		Limit:    k.Int("limit"),
+	})
+	if err != nil {
+		return err
+	}
+
+	if k.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(letters)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintln(w, "ID\tTOPIC\tLISTENER\tEVENT ID\tATTEMPTS\tFAILED AT\tLAST ERROR")
+
+	for _, letter := range letters {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", letter.ID, letter.Topic, cmp.Or(letter.Listener, "-"), letter.EventID,
+			letter.Attempts, letter.FailedAt.UTC().Format(time.RFC3339), lastDeadLetterError(letter))
+	}
+
+	return w.Flush()
+}
+
+// lastDeadLetterError returns the first line of the error of the final attempt of the dead letter
+func lastDeadLetterError(letter gala.DeadLetter) string {
+	if len(letter.Errors) == 0 {
+		return ""
+	}
+
+	msg, _, _ := strings.Cut(letter.Errors[len(letter.Errors)-1].Error, "\n")
+
+	return msg
+}
+
+// replayDeadLetters dispatches the dead letters again and removes them, stopping at the first failure
+func replayDeadLetters(cmd *cobra.Command, ids []string) error {
+	ctx := cmd.Context()
+
+	galaApp, err := newDeadLetterGala(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer galaApp.Close()
+
+	listener := k.String("listener")
+
+	for _, id := range ids {
+		letter, err := galaApp.ReplayDeadLetter(ctx, id, listener)
+		if err != nil {
+			return fmt.Errorf("replaying dead letter %s: %w", id, err)
+		}
+
+		fmt.Printf("Replayed dead letter %s of event %s on %s to %s\n", letter.ID, letter.EventID, letter.Topic, cmp.Or(listener, "every listener"))
+	}
+
+	return nil
+}
+
+// discardDeadLetters removes the dead letters matching the flags, or counts them on a dry run
+func discardDeadLetters(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	filter := gala.DeadLetterFilter{
+		Topic:    gala.TopicName(k.String("topic")),
+		Listener: k.String("listener"),
+		IDs:      k.Strings("id"),
+		All:      k.Bool("all"),
+	}
+
+	// checked up front so a dry run without a selection does not report every dead letter
+	if filter.Topic == "" && filter.Listener == "" && len(filter.IDs) == 0 && !filter.All {
+		return gala.ErrDeadLetterFilterRequired
+	}
+
+	galaApp, err := newDeadLetterGala(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer galaApp.Close()
+
+	if k.Bool("dry-run") {
+		letters, err := galaApp.ListDeadLetters(ctx, filter)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("%d dead letters would be discarded, rerun with --dry-run=false to discard them\n", len(letters))
+
+		return nil
+	}
+
+	count, err := galaApp.DiscardDeadLetters(ctx, filter)
+
+	fmt.Printf("%d dead letters discarded\n", count)
+
+	return err
+}
+
+// keyDir returns the key directory from the dir flag or the keywatcher config
+func keyDir(cfg *config.Config) string {
+	return cmp.Or(k.String("dir"), cfg.Keywatcher.KeyDir)
+}
+
+// listKeys prints the signing keys in the key directory
+func listKeys(_ *cobra.Command) error {
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	keys, err := serveropts.ListKeyDir(keyDir(cfg), cfg.Auth.Token)
+	if err != nil {
+		return err
+	}
+
+	if k.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(keys)
+	}
+
+	return printKeys(keys)
+}
+
+// rotateKeys writes a new signing key to the key directory, prunes the expired keys when asked and prints the
+// resulting keys
+func rotateKeys(_ *cobra.Command) error {
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	dir := keyDir(cfg)
+
+	key, err := serveropts.RotateKeyDir(dir, cfg.Auth.Token, k.Duration("lifetime"))
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Wrote signing key %s to %s\n", key.KID, key.Path)
+
+	if k.Bool("prune") {
+		removed, err := serveropts.PruneKeyDir(dir, time.Now())
+		if err != nil {
+			return err
+		}
+
+		for _, kid := range removed {
+			fmt.Printf("Removed expired signing key %s\n", kid)
+		}
+	}
+
+	keys, err := serveropts.ListKeyDir(dir, cfg.Auth.Token)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	return printKeys(keys)
+}
+
+// printKeys prints the keys as a table
+func printKeys(keys []serveropts.KeyDirKey) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintln(w, "KID\tNOT BEFORE\tNOT AFTER\tSIGNING")
+
+	for _, key := range keys {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%t\n", key.KID, formatKeyTime(key.NotBefore), formatKeyTime(key.NotAfter), key.Signing)
+	}
+
+	return w.Flush()
+}
+
+// formatKeyTime formats the certificate time of a key, keys without a certificate have none
+func formatKeyTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+// reindexSearch rebuilds the full-text search indexes of the primary database
+func reindexSearch(cmd *cobra.Command) error {
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	entConfig, err := entx.NewDBConfig(cfg.DB)
+	if err != nil {
+		return err
+	}
+
+	drv := entConfig.GetPrimaryDB()
+
+	defer drv.Close()
+
+	tables, err := fulltext.Reindex(cmd.Context(), drv.DB(), k.Strings("type"), k.Bool("regenerate"))
+
+	for _, table := range tables {
+		fmt.Printf("Reindexed %s\n", table)
+	}
+
+	return err
+}
+
+// healthResult is the outcome of the health check of one dependency
+type healthResult struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
+}
+
+// checkHealth checks the connection to every configured dependency of the server and prints the results
+func checkHealth(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+
+	cfg, err := loadAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	var results []healthResult
+
+	record := func(name string, err error) {
+		res := healthResult{Name: name, Healthy: err == nil}
+		if err != nil {
+			res.Error = err.Error()
+		}
+
+		results = append(results, res)
+	}
+
+	var entOpts []generated.Option
+
+	if cfg.Authz.Enabled {
+		fgaClient, err := fgax.CreateFGAClientWithStore(ctx, cfg.Authz)
+		if err == nil {
+			err = fgax.Healthcheck(*fgaClient)(ctx)
+			entOpts = append(entOpts, generated.Authz(*fgaClient))
+		}
+
+		record("fga", err)
+	}
+
+	// a health check never changes the schema
+	dbConfig := cfg.DB
+	dbConfig.RunMigrations = false
+
+	dbClient, err := entdb.New(ctx, dbConfig, []riverqueue.Option{riverqueue.WithConnectionURI(cfg.JobQueue.ConnectionURI)}, []entdb.Option{}, entOpts...)
+	if err != nil {
+		record("db_primary", err)
+	} else {
+		defer dbClient.Close()
+
+		record("db_primary", entx.Healthcheck(dbClient.Config.GetPrimaryDB())(ctx))
+
+		if cfg.DB.MultiWrite {
+			record("db_secondary", entx.Healthcheck(dbClient.Config.GetSecondaryDB())(ctx))
+		}
+
+		if jc, ok := dbClient.Job.(*riverqueue.Client); ok {
+			record("job_queue", riverqueue.Healthcheck(jc)(ctx))
+		}
+
+		// the database storage provider is validated through the ent client of the context
+		ctx = generated.NewContext(ctx, dbClient)
+	}
+
+	if cfg.Redis.Enabled {
+		r := cache.New(cfg.Redis)
+
+		defer r.Close()
+
+		record("redis", cache.Healthcheck(r)(ctx))
+	}
+
+	if cfg.ObjectStorage.Enabled {
+		record("object_storage", errors.Join(validators.ValidateAvailabilityByProvider(ctx, ensureAvailable(cfg.ObjectStorage), false)...))
+	}
+
+	unhealthy := 0
+
+	for _, res := range results {
+		if !res.Healthy {
+			unhealthy++
+		}
+	}
+
+	if k.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if err := enc.Encode(results); err != nil {
+			return err
+		}
+	} else {
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+		fmt.Fprintln(w, "DEPENDENCY\tSTATUS\tERROR")
+
+		for _, res := range results {
+			status := "ok"
+			if !res.Healthy {
+				status = "failed"
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s\n", res.Name, status, res.Error)
+		}
+
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	if unhealthy > 0 {
+		return fmt.Errorf("%w: %d of %d checks failed", ErrUnhealthy, unhealthy, len(results))
+	}
+
+	return nil
+}
+
+// ensureAvailable returns the storage config with every enabled provider validated, not only those the server
+// requires to be available on startup
+func ensureAvailable(cfg storage.ProviderConfig) storage.ProviderConfig {
+	for _, p := range []*storage.ProviderConfigs{
+		&cfg.Providers.S3, &cfg.Providers.R2, &cfg.Providers.Disk,
+		&cfg.Providers.Database, &cfg.Providers.AzureBlob, &cfg.Providers.GCS,
+	} {
+		p.EnsureAvailable = p.Enabled
+	}
+
+	return cfg
+}
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/theopenlane/iam/fgax"
+	"github.com/theopenlane/riverboat/pkg/riverqueue"
+
+	"github.com/theopenlane/core/config"
+	"github.com/theopenlane/core/internal/authzexplain"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+	"github.com/theopenlane/core/internal/entdb"
+)
+
+var authzCmd = &cobra.Command{
+	Use:   "authz",
+	Short: "inspect the authorization tuples of the server",
+}
+
+var authzExplainCmd = &cobra.Command{
+	Use:   "explain",
+	Short: "explain why a subject can or cannot access an object",
+	Example: `  openlane authz explain --subject user:01HXYZ --object program:01HABC --relation can_edit
+  openlane authz explain --subject service:01HXYZ --object control:01HABC --relation can_view --json`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := explainAccess(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var authzTuplesCmd = &cobra.Command{
+	Use:   "tuples",
+	Short: "check the tuples of an organization against its records and optionally repair them",
+	Example: `  openlane authz tuples --org 01HXYZ
+  openlane authz tuples --org 01HXYZ --schema control --schema subcontrol --json
+  openlane authz tuples --org 01HXYZ --repair --dry-run=false --batch-size 50`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := checkTuples(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+// init registers the authz command and its subcommands on the root command.
+func init() {
+	rootCmd.AddCommand(authzCmd)
+	authzCmd.AddCommand(authzExplainCmd)
+	authzCmd.AddCommand(authzTuplesCmd)
+
+	authzCmd.PersistentFlags().String("config", "./config/.config.yaml", "config file location")
+	authzExplainCmd.Flags().String("subject", "", "subject in type:id format, e.g. user:01HXYZ")
+	authzExplainCmd.Flags().String("object", "", "object in type:id format, e.g. program:01HABC")
+	authzExplainCmd.Flags().String("relation", "", "relation to explain, e.g. can_edit")
+	authzExplainCmd.Flags().Bool("json", false, "print the explanation as json")
+
+	authzTuplesCmd.Flags().String("org", "", "id of the organization to check")
+	authzTuplesCmd.Flags().StringSlice("schema", nil, "limit the check to the schema, e.g. control; can be repeated")
+	authzTuplesCmd.Flags().Bool("repair", false, "write missing tuples and delete orphaned tuples")
+	authzTuplesCmd.Flags().Bool("dry-run", true, "only report what a repair would change")
+	authzTuplesCmd.Flags().Int("batch-size", tuplecheck.DefaultBatchSize, "tuples written or deleted per repair request")
+	authzTuplesCmd.Flags().Bool("json", false, "print the report as json")
+}
+
+// explainAccess connects to the configured fga store and prints the explanation of the access
+func explainAccess(cmd *cobra.Command) error {
+	subjectType, subjectID, _ := strings.Cut(k.String("subject"), ":")
+	objectType, objectID, _ := strings.Cut(k.String("object"), ":")
+
+	req := authzexplain.Request{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		ObjectType:  objectType,
+		ObjectID:    objectID,
+		Relation:    k.String("relation"),
+	}
+
+	cfgFile := k.String("config")
+
+	cfg, err := config.Load(&cfgFile)
+	if err != nil {
+		return err
+	}
+
+	fgaClient, err := fgax.CreateFGAClientWithStore(cmd.Context(), cfg.Authz)
+	if err != nil {
+		return err
+	}
+
+	explainer, err := authzexplain.New(authzexplain.NewFGAClient(fgaClient))
+	if err != nil {
+		return err
+	}
+
+	exp, err := explainer.Explain(cmd.Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if k.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(exp)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintf(w, "Subject:\t%s\n", exp.Subject)
+	fmt.Fprintf(w, "Object:\t%s\n", exp.Object)
+	fmt.Fprintf(w, "Relation:\t%s\n", exp.Relation)
+	fmt.Fprintf(w, "Allowed:\t%t\n", exp.Allowed)
+	fmt.Fprintf(w, "Granted:\t%s\n", strings.Join(exp.GrantedRelations, ", "))
+
+	if exp.Truncated {
+		fmt.Fprintf(w, "Truncated:\t%t\n", exp.Truncated)
+	}
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if exp.Allowed {
+		fmt.Println("\nPath:")
+
+		for i, step := range exp.Path {
+			fmt.Printf("  %d. [%s] %s\n", i+1, step.Source, step.Description)
+		}
+
+		return nil
+	}
+
+	if exp.MissingLink != nil {
+		fmt.Printf("\nMissing link:\n  [%s] %s\n", exp.MissingLink.Source, exp.MissingLink.Description)
+	}
+
+	return nil
+}
+
+// checkTuples connects to the configured database and fga store, reports the tuple drift of the organization
+// and repairs it when requested
+func checkTuples(cmd *cobra.Command) error {
+	ctx := cmd.Context()
+	cfgFile := k.String("config")
+
+	cfg, err := config.Load(&cfgFile)
+	if err != nil {
+		return err
+	}
+
+	fgaClient, err := fgax.CreateFGAClientWithStore(ctx, cfg.Authz)
+	if err != nil {
+		return err
+	}
+
+	jobOpts := []riverqueue.Option{
+		riverqueue.WithConnectionURI(cfg.JobQueue.ConnectionURI),
+	}
+
+	dbClient, err := entdb.New(ctx, cfg.DB, jobOpts, []entdb.Option{}, generated.Authz(*fgaClient))
+	if err != nil {
+		return err
+	}
+
+	defer dbClient.Close()
+
+	checker, err := tuplecheck.New(tuplecheck.NewSQLSource(dbClient.Driver()), tuplecheck.NewFGAStore(fgaClient),
+		tuplecheck.WithSchemas(k.Strings("schema")...))
+	if err != nil {
+		return err
+	}
+
+	report, err := checker.Check(ctx, k.String("org"))
+	if err != nil {
+		return err
+	}
+
+	var repair *tuplecheck.RepairResult
+
+	if k.Bool("repair") && !report.Consistent() {
+		repair, err = checker.Repair(ctx, report, k.Int("batch-size"), k.Bool("dry-run"))
+		if err != nil {
+			return err
+		}
+	}
+
+	if k.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(struct {
+			*tuplecheck.Report
+			Repair *tuplecheck.RepairResult `json:"repair,omitempty"`
+		}{Report: report, Repair: repair})
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintf(w, "Organization:\t%s\n", report.OrganizationID)
+	fmt.Fprintf(w, "Schemas:\t%d\n", report.Schemas)
+	fmt.Fprintf(w, "Objects:\t%d\n", report.Objects)
+	fmt.Fprintf(w, "Missing:\t%d\n", len(report.Missing))
+	fmt.Fprintf(w, "Orphaned:\t%d\n", len(report.Orphaned))
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, t := range report.Missing {
+		fmt.Printf("  + %s\n", t)
+	}
+
+	for _, t := range report.Orphaned {
+		fmt.Printf("  - %s\n", t)
+	}
+
+	if repair != nil {
+		action := "Repaired"
+		if repair.DryRun {
+			action = "Dry run, would repair"
+		}
+
+		fmt.Printf("\n%s: %d written, %d deleted in %d batches\n", action, repair.Written, repair.Deleted, repair.Batches)
+	}
+
+	return nil
+}
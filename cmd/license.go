@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/theopenlane/core/config"
+	"github.com/theopenlane/core/pkg/entitlements"
+)
+
+var licenseCmd = &cobra.Command{
+	Use:   "license",
+	Short: "manage the offline license of a self-hosted deployment",
+}
+
+var licenseInspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "verify the configured license file and print its contents and status",
+	Run: func(_ *cobra.Command, _ []string) {
+		err := inspectLicense()
+		cobra.CheckErr(err)
+	},
+}
+
+// init registers the license command and its subcommands on the root command.
+func init() {
+	rootCmd.AddCommand(licenseCmd)
+	licenseCmd.AddCommand(licenseInspectCmd)
+
+	licenseCmd.PersistentFlags().String("config", "./config/.config.yaml", "config file location")
+	licenseInspectCmd.Flags().String("file", "", "license file to inspect, defaults to the configured license file")
+}
+
+// inspectLicense verifies the license file against the configured public key and prints it
+func inspectLicense() error {
+	cfgFile := k.String("config")
+
+	cfg, err := config.Load(&cfgFile)
+	if err != nil {
+		return err
+	}
+
+	licenseCfg := cfg.Entitlements.License
+	if file := k.String("file"); file != "" {
+		licenseCfg.File = file
+	}
+
+	if licenseCfg.File == "" || licenseCfg.PublicKey == "" {
+		return entitlements.ErrLicenseNotConfigured
+	}
+
+	key, err := entitlements.ParseLicensePublicKey(licenseCfg.PublicKey)
+	if err != nil {
+		return err
+	}
+
+	l, err := entitlements.LoadLicenseFile(licenseCfg.File, key)
+	if err != nil {
+		return err
+	}
+
+	grace := licenseCfg.GracePeriod
+	if grace <= 0 {
+		grace = entitlements.DefaultLicenseGracePeriod
+	}
+
+	seats := "unlimited"
+	if l.Seats > 0 {
+		seats = fmt.Sprintf("%d", l.Seats)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintf(w, "ID:\t%s\n", l.ID)
+	fmt.Fprintf(w, "Licensee:\t%s\n", l.Licensee)
+	fmt.Fprintf(w, "Organization:\t%s\n", l.OrganizationID)
+	fmt.Fprintf(w, "Modules:\t%s\n", strings.Join(l.Modules, ", "))
+	fmt.Fprintf(w, "Seats:\t%s\n", seats)
+	fmt.Fprintf(w, "Issued:\t%s\n", l.IssuedAt.Format(time.RFC3339))
+	fmt.Fprintf(w, "Expires:\t%s\n", l.ExpiresAt.Format(time.RFC3339))
+	fmt.Fprintf(w, "Status:\t%s\n", l.Status(time.Now(), grace))
+
+	return w.Flush()
+}
@@ -161,7 +161,15 @@ func serve(ctx context.Context) error {
 		historygenerated.EntConfig(&so.Config.Settings.EntConfig),
 	}
 
-	historyClient, err := entdb.NewHistory(so.Config.Settings.DB, histOpts...)
+	// read replicas are shared by the primary and history clients; callers who recently wrote are pinned to the
+	// primary through redis so the pin holds across instances
+	replicas, err := entdb.NewReplicaSet(ctx, so.Config.Settings.DBReplicas, so.Config.Settings.DB.DriverName,
+		so.Config.Settings.DB.MaxConnections, so.Config.Settings.DB.MaxIdleConnections, entdb.NewRedisSessionStore(redisClient))
+	if err != nil {
+		return err
+	}
+
+	historyClient, err := entdb.NewHistoryWithReplicas(so.Config.Settings.DB, replicas, histOpts...)
 	if err != nil {
 		return err
 	}
@@ -198,10 +206,22 @@ func serve(ctx context.Context) error {
 	clientOpts := []entdb.Option{
 		entdb.WithWorkflows(&so.Config.Settings.Workflows, galaApp),
 		entdb.WithModules(),
+		entdb.WithQuotas(),
 		entdb.WithMetricsHook(),
 	}
 
-	dbClient, err := entdb.New(ctx, so.Config.Settings.DB, jobOpts, clientOpts, entOpts...)
+	// self-hosted deployments grant modules from an offline license instead of stripe
+	license, err := serveropts.NewLicenseProvider(ctx, so.Config.Settings.Entitlements.License, redisClient, fgaClient)
+	if err != nil {
+		return err
+	}
+
+	if license != nil {
+		clientOpts = append(clientOpts, entdb.WithLicense(license))
+		entOpts = append(entOpts, ent.LicenseManager(license))
+	}
+
+	dbClient, err := entdb.NewWithReplicas(ctx, so.Config.Settings.DB, replicas, jobOpts, clientOpts, entOpts...)
 	if err != nil {
 		return err
 	}
@@ -229,6 +249,10 @@ func serve(ctx context.Context) error {
 			if err := entdb.GracefulClose(context.Background(), dbClient, time.Second); err != nil {
 				log.Error().Err(err).Msg("error closing database")
 			}
+
+			if err := replicas.Close(); err != nil {
+				log.Error().Err(err).Msg("error closing read replicas")
+			}
 		})
 	}
 
@@ -283,6 +307,8 @@ func serve(ctx context.Context) error {
 	// add default trust center domain
 	so.AddServerOptions(
 		serveropts.WithDefaultTrustCenterDomain(),
+		serveropts.WithTrustCenterExportSigner(),
+		serveropts.WithResumableUploads(),
 	)
 
 	// add ready checks
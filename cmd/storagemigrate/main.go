@@ -0,0 +1,203 @@
+//go:build clistoragemigrate
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+	"github.com/theopenlane/iam/fgax"
+	"github.com/theopenlane/riverboat/pkg/riverqueue"
+	"github.com/urfave/cli/v3"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/config"
+	"github.com/theopenlane/core/internal/ent/generated"
+	_ "github.com/theopenlane/core/internal/ent/generated/runtime"
+	"github.com/theopenlane/core/internal/entdb"
+	"github.com/theopenlane/core/internal/objects/migrate"
+	"github.com/theopenlane/core/internal/objects/resolver"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+func main() {
+	if err := app().Run(context.Background(), os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func app() *cli.Command {
+	return &cli.Command{
+		Name:  "storagemigrate",
+		Usage: "Move an organization's files from one object storage provider to another",
+		Description: `
+
+Examples:
+  # Dry-run by default (preview the files that would be migrated)
+  storagemigrate --org 01HXYZ --from database --to s3
+
+  # Copy the files and switch their records to the new provider
+  storagemigrate --org 01HXYZ --from database --to s3 --dry-run=false
+
+  # Remove the source objects once every file has been migrated
+  storagemigrate --org 01HXYZ --from database --to s3 --dry-run=false cleanup`,
+		Flags: []cli.Flag{
+			&cli.StringFlag{
+				Name:    "config",
+				Value:   "./config/.config.yaml",
+				Usage:   "config file path",
+				Sources: cli.EnvVars("CORE_CONFIG"),
+			},
+			&cli.StringFlag{
+				Name:     "org",
+				Usage:    "organization ID whose files are migrated",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "from",
+				Usage:    "provider the files are currently stored in (s3, r2, gcs, azureblob, disk, database)",
+				Required: true,
+			},
+			&cli.StringFlag{
+				Name:     "to",
+				Usage:    "provider the files are copied to (s3, r2, gcs, azureblob, disk, database)",
+				Required: true,
+			},
+			&cli.IntFlag{
+				Name:  "batch-size",
+				Usage: "number of files loaded per query",
+				Value: migrate.DefaultBatchSize,
+			},
+			&cli.BoolFlag{
+				Name:  "dry-run",
+				Usage: "preview changes without making them",
+				Value: true,
+			},
+			&cli.BoolFlag{
+				Name:  "debug",
+				Usage: "enable debug logging",
+			},
+		},
+		Action: runMigration,
+		Commands: []*cli.Command{
+			{
+				Name:   "cleanup",
+				Usage:  "remove source objects and fallback locations for files that finished migrating",
+				Action: runCleanup,
+			},
+		},
+	}
+}
+
+func runMigration(ctx context.Context, c *cli.Command) error {
+	migrator, opts, err := setup(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	progress, err := migrator.Run(ctx, opts, func(p migrate.Progress) {
+		log.Info().
+			Int("migrated", p.Migrated).
+			Int("failed", p.Failed).
+			Int("remaining", p.Remaining()).
+			Int("total", p.Total).
+			Msg("migration progress")
+	})
+	if err != nil {
+		return err
+	}
+
+	if progress.Failed > 0 {
+		log.Warn().Int("failed", progress.Failed).Msg("some files were not migrated, run the migration again to retry them")
+	}
+
+	return nil
+}
+
+func runCleanup(ctx context.Context, c *cli.Command) error {
+	migrator, opts, err := setup(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	progress, err := migrator.Cleanup(ctx, opts, func(p migrate.Progress) {
+		log.Debug().Int("cleaned", p.Cleaned).Int("kept", p.Failed).Msg("cleanup progress")
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info().Int("cleaned", progress.Cleaned).Int("kept", progress.Failed).Msg("storage migration cleanup complete")
+
+	return nil
+}
+
+func setup(ctx context.Context, c *cli.Command) (*migrate.Migrator, migrate.Options, error) {
+	setupLogging(c.Root().Bool("debug"))
+
+	cfgLoc := c.Root().String("config")
+
+	cfg, err := config.Load(&cfgLoc)
+	if err != nil {
+		return nil, migrate.Options{}, fmt.Errorf("load config: %w", err) //nolint:err113
+	}
+
+	db, err := createDB(ctx, cfg)
+	if err != nil {
+		return nil, migrate.Options{}, err
+	}
+
+	opts := migrate.Options{
+		OrganizationID: c.Root().String("org"),
+		From:           storagetypes.ProviderType(c.Root().String("from")),
+		To:             storagetypes.ProviderType(c.Root().String("to")),
+		BatchSize:      c.Root().Int("batch-size"),
+		DryRun:         c.Root().Bool("dry-run"),
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, migrate.Options{}, err
+	}
+
+	return migrate.New(db, resolver.NewServiceFromConfig(cfg.ObjectStorage)), opts, nil
+}
+
+func createDB(ctx context.Context, cfg *config.Config) (*generated.Client, error) {
+	if cfg.JobQueue.ConnectionURI == "" {
+		return nil, fmt.Errorf("missing required job queue connection URI in config") //nolint:err113
+	}
+
+	jobOpts := []riverqueue.Option{
+		riverqueue.WithConnectionURI(cfg.JobQueue.ConnectionURI),
+	}
+
+	fgaClient, err := fgax.CreateFGAClientWithStore(ctx, cfg.Authz)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create FGA client: %w", err) //nolint:err113
+	}
+
+	dbClient, err := entdb.New(ctx, cfg.DB, jobOpts, []entdb.Option{}, generated.Authz(*fgaClient))
+	if err != nil {
+		return nil, fmt.Errorf("database client: %w", err) //nolint:err113
+	}
+
+	return dbClient, nil
+}
+
+func setupLogging(debug bool) {
+	level := zerolog.InfoLevel
+	if debug {
+		level = zerolog.DebugLevel
+	}
+
+	logx.Configure(logx.LoggerConfig{
+		Level:     level,
+		Pretty:    true,
+		Writer:    os.Stderr,
+		SetGlobal: true,
+	})
+}
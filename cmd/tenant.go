@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/theopenlane/iam/fgax"
+	"github.com/theopenlane/riverboat/pkg/riverqueue"
+
+	"github.com/theopenlane/core/config"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+	"github.com/theopenlane/core/internal/entdb"
+	"github.com/theopenlane/core/internal/objects/resolver"
+	"github.com/theopenlane/core/internal/tenantexport"
+)
+
+var tenantCmd = &cobra.Command{
+	Use:   "tenant",
+	Short: "back up, restore and move organizations between environments",
+}
+
+var tenantExportCmd = &cobra.Command{
+	Use:     "export",
+	Short:   "export an organization with its history, tuples and files to an archive",
+	Example: `  openlane tenant export --org 01HXYZ --out acme.zip`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := exportTenant(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+var tenantImportCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import an organization from an archive with new ids",
+	Example: `  openlane tenant import --file acme.zip
+  openlane tenant import --file acme.zip --name "Acme (restored)" --dry-run=false
+  openlane tenant import --file acme.zip --dry-run=false --skip-invalid --json`,
+	Run: func(cmd *cobra.Command, _ []string) {
+		err := importTenant(cmd)
+		cobra.CheckErr(err)
+	},
+}
+
+// init registers the tenant command and its subcommands on the root command.
+func init() {
+	rootCmd.AddCommand(tenantCmd)
+	tenantCmd.AddCommand(tenantExportCmd)
+	tenantCmd.AddCommand(tenantImportCmd)
+
+	tenantCmd.PersistentFlags().String("config", "./config/.config.yaml", "config file location")
+	tenantExportCmd.Flags().String("org", "", "id of the organization to export")
+	tenantExportCmd.Flags().String("out", "", "path of the archive to write, defaults to <org>.zip")
+
+	tenantImportCmd.Flags().String("file", "", "path of the archive to import")
+	tenantImportCmd.Flags().String("name", "", "name of the restored organization, required when the name is taken")
+	tenantImportCmd.Flags().Bool("dry-run", true, "validate the archive and roll back instead of restoring it")
+	tenantImportCmd.Flags().Bool("skip-invalid", false, "restore without the rows referencing missing rows instead of aborting")
+	tenantImportCmd.Flags().Bool("json", false, "print the result as json")
+}
+
+// newTenantService connects to the configured database, fga store and object storage
+func newTenantService(ctx context.Context) (*tenantexport.Service, func(), error) {
+	cfgFile := k.String("config")
+
+	cfg, err := config.Load(&cfgFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fgaClient, err := fgax.CreateFGAClientWithStore(ctx, cfg.Authz)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	jobOpts := []riverqueue.Option{
+		riverqueue.WithConnectionURI(cfg.JobQueue.ConnectionURI),
+	}
+
+	dbClient, err := entdb.New(ctx, cfg.DB, jobOpts, []entdb.Option{}, generated.Authz(*fgaClient))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	svc, err := tenantexport.New(dbClient.Driver(), tuplecheck.NewFGAStore(fgaClient),
+		tenantexport.NewObjects(dbClient, resolver.NewServiceFromConfig(cfg.ObjectStorage)))
+	if err != nil {
+		dbClient.Close()
+
+		return nil, nil, err
+	}
+
+	return svc, func() { dbClient.Close() }, nil
+}
+
+// exportTenant writes the archive of the organization and prints its manifest summary
+func exportTenant(cmd *cobra.Command) error {
+	orgID := k.String("org")
+	if orgID == "" {
+		return tenantexport.ErrOrganizationRequired
+	}
+
+	out := k.String("out")
+	if out == "" {
+		out = orgID + ".zip"
+	}
+
+	svc, closeFn, err := newTenantService(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	defer closeFn()
+
+	f, err := os.Create(out)
+	if err != nil {
+		return err
+	}
+
+	m, err := svc.Export(cmd.Context(), orgID, f)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(out)
+
+		return err
+	}
+
+	rows := 0
+	for _, t := range m.Tables {
+		rows += t.Rows
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintf(w, "Archive:\t%s\n", out)
+	fmt.Fprintf(w, "Organization:\t%s (%s)\n", m.OrganizationName, m.OrganizationID)
+	fmt.Fprintf(w, "Tables:\t%d\n", len(m.Tables))
+	fmt.Fprintf(w, "Rows:\t%d\n", rows)
+	fmt.Fprintf(w, "Users:\t%d\n", m.Users)
+	fmt.Fprintf(w, "Tuples:\t%d\n", m.Tuples)
+	fmt.Fprintf(w, "Files:\t%d\n", len(m.Files))
+
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	for _, id := range m.MissingFiles {
+		fmt.Printf("  contents of file %s could not be read\n", id)
+	}
+
+	return nil
+}
+
+// importTenant restores the organization of the archive and prints the result
+func importTenant(cmd *cobra.Command) error {
+	f, err := os.Open(k.String("file"))
+	if err != nil {
+		return err
+	}
+
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	archive, err := tenantexport.OpenArchive(f, info.Size())
+	if err != nil {
+		return err
+	}
+
+	svc, closeFn, err := newTenantService(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	defer closeFn()
+
+	res, err := svc.Import(cmd.Context(), archive, tenantexport.Options{
+		Name:        k.String("name"),
+		DryRun:      k.Bool("dry-run"),
+		SkipInvalid: k.Bool("skip-invalid"),
+	})
+	if res == nil {
+		return err
+	}
+
+	if k.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		if encErr := enc.Encode(res); encErr != nil {
+			return encErr
+		}
+
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0) //nolint:mnd
+
+	fmt.Fprintf(w, "Source organization:\t%s\n", res.SourceOrganizationID)
+	fmt.Fprintf(w, "Organization:\t%s (%s)\n", res.OrganizationName, res.OrganizationID)
+	fmt.Fprintf(w, "Dry run:\t%t\n", res.DryRun)
+	fmt.Fprintf(w, "Rows:\t%d\n", res.Rows)
+	fmt.Fprintf(w, "History rows:\t%d\n", res.HistoryRows)
+	fmt.Fprintf(w, "Users mapped:\t%d\n", res.Users)
+	fmt.Fprintf(w, "Tuples:\t%d\n", res.Tuples)
+	fmt.Fprintf(w, "Files:\t%d\n", res.Files)
+
+	if flushErr := w.Flush(); flushErr != nil {
+		return flushErr
+	}
+
+	for _, email := range res.UnmappedUsers {
+		fmt.Printf("  user %s does not exist in the target\n", email)
+	}
+
+	for _, v := range res.Violations {
+		fmt.Printf("  %s\n", v)
+	}
+
+	for _, e := range res.TupleErrors {
+		fmt.Printf("  tuple write failed: %s\n", e)
+	}
+
+	for _, e := range res.FileErrors {
+		fmt.Printf("  file upload failed: %s\n", e)
+	}
+
+	if errors.Is(err, tenantexport.ErrIntegrityViolations) {
+		fmt.Println("\nNothing was restored, rerun with --skip-invalid to restore without the rows above")
+	}
+
+	if len(res.TupleErrors) > 0 {
+		fmt.Printf("\nSome tuples were not written, run openlane authz tuples --org %s --repair --dry-run=false to restore them\n", res.OrganizationID)
+	}
+
+	return err
+}
@@ -18,6 +18,8 @@ var (
 	CampaignTypeCustom CampaignType = "CUSTOM"
 	// CampaignTypeTrustCenterUpdate indicates a campaign sending trust center updates to subscribers
 	CampaignTypeTrustCenterUpdate CampaignType = "TRUST_CENTER_UPDATE"
+	// CampaignTypeAccessReview indicates a review of the memberships and tokens granting access to the organization
+	CampaignTypeAccessReview CampaignType = "ACCESS_REVIEW"
 	// CampaignTypeInvalid is used when an unknown or unsupported value is provided
 	CampaignTypeInvalid CampaignType = "INVALID"
 )
@@ -29,10 +31,11 @@ var campaignTypeValues = []CampaignType{
 	CampaignTypeVendorAssessment,
 	CampaignTypeCustom,
 	CampaignTypeTrustCenterUpdate,
+	CampaignTypeAccessReview,
 }
 
 // Values returns a slice of strings that represents all the possible values of the CampaignType enum
-// Possible default values are "QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", and "TRUST_CENTER_UPDATE"
+// Possible default values are "QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE", and "ACCESS_REVIEW"
 func (CampaignType) Values() []string { return stringValues(campaignTypeValues) }
 
 // String returns the CampaignType as a string
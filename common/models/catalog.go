@@ -71,4 +71,13 @@ type Usage struct {
 	EvidenceStorageGB int64 `json:"evidence_storage_gb,omitempty" yaml:"evidence_storage_gb,omitempty" jsonschema:"description=Storage limit in GB for evidence,example=10"`
 	// RecordCount is the maximum number of records allowed for the feature
 	RecordCount int64 `json:"record_count,omitempty" yaml:"record_count,omitempty" jsonschema:"description=Maximum number of records allowed,example=1000"`
+	// Seats is the maximum number of organization members allowed for the feature
+	Seats int64 `json:"seats,omitempty" yaml:"seats,omitempty" jsonschema:"description=Maximum number of organization members,example=25"`
+	// IntegrationRunsPerMonth is the maximum number of integration runs allowed per calendar month
+	IntegrationRunsPerMonth int64 `json:"integration_runs_per_month,omitempty" yaml:"integration_runs_per_month,omitempty" jsonschema:"description=Maximum number of integration runs per calendar month,example=5000"`
+	// ActivePrograms is the maximum number of programs that are not completed or archived
+	ActivePrograms int64 `json:"active_programs,omitempty" yaml:"active_programs,omitempty" jsonschema:"description=Maximum number of active programs,example=5"`
+	// OverageAllowancePercent is how far past the limits usage may grow before requests are rejected; the limits
+	// themselves are soft and only warn
+	OverageAllowancePercent int64 `json:"overage_allowance_percent,omitempty" yaml:"overage_allowance_percent,omitempty" jsonschema:"description=Percentage over the limits allowed before requests are rejected,example=10"`
 }
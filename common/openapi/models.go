@@ -704,6 +704,127 @@ func (r *FileDownloadResponse) ExampleResponse() any {
 	}
 }
 
+// =========
+// FILE LEGAL HOLD
+// =========
+
+// FileLegalHoldRequest is the request to place or release a legal hold on a file
+type FileLegalHoldRequest struct {
+	// ID is the id value
+	ID string `param:"id" description:"the file ID" example:"01J4HMNDSZCCQBTY93BF9CBF5D"`
+	// Reason is the reason value
+	Reason string `json:"reason,omitempty" description:"The reason the legal hold is placed, required when placing a hold" example:"Pending litigation, matter 2026-014"`
+}
+
+// Validate ensures the required fields are set on the FileLegalHoldRequest request
+func (r *FileLegalHoldRequest) Validate() error {
+	if r.ID == "" {
+		return rout.NewMissingRequiredFieldError("id")
+	}
+
+	return nil
+}
+
+// ExampleFileLegalHoldRequest is an example of a legal hold request for OpenAPI documentation
+var ExampleFileLegalHoldRequest = FileLegalHoldRequest{
+	ID:     "01J4HMNDSZCCQBTY93BF9CBF5D",
+	Reason: "Pending litigation, matter 2026-014",
+}
+
+// FileLegalHoldResponse holds the fields that are sent on a response to the `/files/:id/legal-hold` endpoint
+type FileLegalHoldResponse struct {
+	// Reply is the reply value
+	rout.Reply
+	// FileID is the file_id value
+	FileID string `json:"file_id" description:"The ID of the file"`
+	// LegalHold is the legal_hold value
+	LegalHold bool `json:"legal_hold" description:"Whether the file is under legal hold"`
+	// Reason is the reason value
+	Reason string `json:"reason,omitempty" description:"The reason the legal hold was placed"`
+	// RetainUntil is the retain_until value
+	RetainUntil *time.Time `json:"retain_until,omitempty" description:"The time the retention period of the file ends, if any"`
+}
+
+// ExampleResponse returns an example FileLegalHoldResponse for OpenAPI documentation
+func (r *FileLegalHoldResponse) ExampleResponse() any {
+	return FileLegalHoldResponse{
+		Reply:     rout.Reply{Success: true},
+		FileID:    "01J4HMNDSZCCQBTY93BF9CBF5D",
+		LegalHold: true,
+		Reason:    "Pending litigation, matter 2026-014",
+	}
+}
+
+// =========
+// SEARCH
+// =========
+
+// minSearchQueryLength is the shortest query accepted by the search endpoint
+const minSearchQueryLength = 3
+
+// SearchRequest holds the query parameters of a ranked search request to the `/search` endpoint
+type SearchRequest struct {
+	// Query is the q value
+	Query string `query:"q" description:"The search query; quoted text is matched as a phrase and other words as prefixes" example:"\"incident response\" plan"`
+	// Types is the types value
+	Types []string `query:"types" description:"Limits the search to the listed object types, all searchable types are included when empty" example:"Control"`
+	// Limit is the limit value
+	Limit int `query:"limit" description:"The maximum number of results returned, up to 100" example:"25"`
+}
+
+// Validate ensures the required fields are set on the SearchRequest request
+func (r *SearchRequest) Validate() error {
+	r.Query = strings.TrimSpace(r.Query)
+
+	switch {
+	case r.Query == "":
+		return rout.NewMissingRequiredFieldError("q")
+	case len(r.Query) < minSearchQueryLength:
+		return rout.InvalidField("q")
+	case r.Limit < 0:
+		return rout.InvalidField("limit")
+	}
+
+	return nil
+}
+
+// ExampleSearchRequest is an example of a search request for OpenAPI documentation
+var ExampleSearchRequest = SearchRequest{
+	Query: `"incident response" plan`,
+	Limit: 25, //nolint:mnd
+}
+
+// SearchResult is a single match of a ranked search
+type SearchResult struct {
+	// Type is the type value
+	Type string `json:"type" description:"The object type of the match" example:"InternalPolicy"`
+	// ID is the id value
+	ID string `json:"id" description:"The ID of the matched object" example:"01J4HMNDSZCCQBTY93BF9CBF5D"`
+	// Rank is the rank value
+	Rank float64 `json:"rank" description:"The relevance of the match, results are ordered by rank across all types" example:"0.42"`
+	// FileID is the file id value
+	FileID string `json:"file_id,omitempty" description:"The ID of the attached file whose contents matched, set when the match came from an uploaded document" example:"01J4HMNDSZCCQBTY93BF9CBF5F"`
+}
+
+// SearchResponse holds the fields that are sent on a response to the `/search` endpoint
+type SearchResponse struct {
+	// Reply is the reply value
+	rout.Reply
+	// Results is the results value
+	Results []SearchResult `json:"results" description:"The matches across all searched types, most relevant first"`
+}
+
+// ExampleResponse returns an example SearchResponse for OpenAPI documentation
+func (r *SearchResponse) ExampleResponse() any {
+	return SearchResponse{
+		Reply: rout.Reply{Success: true},
+		Results: []SearchResult{
+			{Type: "InternalPolicy", ID: "01J4HMNDSZCCQBTY93BF9CBF5D", Rank: 0.42},                                  //nolint:mnd
+			{Type: "Procedure", ID: "01J4HMNDSZCCQBTY93BF9CBF5E", Rank: 0.17, FileID: "01J4HMNDSZCCQBTY93BF9CBF5F"}, //nolint:mnd
+		},
+	}
+}
+
 // =========
 // RESEND EMAIL
 // =========
@@ -2853,7 +2974,8 @@ var ExampleRolesResponse = RolesResponse{
 // OrganizationRolesRequest contains functional roles that can be assigned to users or groups in addition to their base level org role such as member, admin, etc.
 type OrganizationRolesRequest struct {
 	OrganizationID string   `json:"organization_id,omitempty" description:"The ID of the organization to assign roles in. Defaults to the authenticated organization." example:"01J4HMNDSZCCQBTY93BF9CBF5D"`
-	Role           string   `json:"role" description:"The organization responsibility role to assign" example:"policy_manager"`
+	Role           string   `json:"role,omitempty" description:"The organization responsibility role to assign" example:"policy_manager"`
+	CustomRoleID   string   `json:"custom_role_id,omitempty" description:"The ID of a custom role of the organization to assign instead of a responsibility role" example:"01J4HMNDSZCCQBTY93BF9CBF5E"`
 	UserIDs        []string `json:"user_ids,omitempty" description:"User IDs to assign the role to"`
 	GroupIDs       []string `json:"group_ids,omitempty" description:"Group IDs to assign the role to"`
 }
@@ -2862,7 +2984,8 @@ type OrganizationRolesRequest struct {
 type OrganizationRolesResponse struct {
 	rout.Reply
 	OrganizationID string `json:"organization_id" description:"The ID of the organization the role was applied to" example:"01J4HMNDSZCCQBTY93BF9CBF5D"`
-	Role           string `json:"role" description:"The organization responsibility role" example:"policy_manager"`
+	Role           string `json:"role,omitempty" description:"The organization responsibility role" example:"policy_manager"`
+	CustomRoleID   string `json:"custom_role_id,omitempty" description:"The ID of the custom role" example:"01J4HMNDSZCCQBTY93BF9CBF5E"`
 }
 
 // AccountRolesMeRequest contains no input; it uses the authenticated caller.
@@ -2876,17 +2999,20 @@ type AccountRolesMeResponse struct {
 }
 
 func (r *OrganizationRolesRequest) Validate() error {
-	if r.Role == "" {
-		return rout.NewMissingRequiredFieldError("role")
-	}
-
-	ok, err := fgamodel.IsOrganizationRole(r.Role)
-	if err != nil {
-		return err
-	}
+	switch {
+	case r.Role == "" && r.CustomRoleID == "":
+		return rout.NewMissingRequiredFieldError("role or custom_role_id")
+	case r.Role != "" && r.CustomRoleID != "":
+		return rout.InvalidField("custom_role_id")
+	case r.Role != "":
+		ok, err := fgamodel.IsOrganizationRole(r.Role)
+		if err != nil {
+			return err
+		}
 
-	if !ok {
-		return rout.InvalidField("role")
+		if !ok {
+			return rout.InvalidField("role")
+		}
 	}
 
 	if len(r.UserIDs) == 0 && len(r.GroupIDs) == 0 {
@@ -24,12 +24,24 @@ type Provider interface {
 	// GetScheme returns the URL scheme for this provider
 	GetScheme() *string
 	// ListBuckets is used to list the buckets in the storage backend
-	ListBuckets() ([]string, error)
+	ListBuckets(ctx context.Context) ([]string, error)
 	// ProviderType returns the type of the storage provider (e.g., S3, R2)
 	ProviderType() ProviderType
 	io.Closer
 }
 
+// LegalHoldProvider is implemented by providers that can place object lock legal holds on stored objects
+type LegalHoldProvider interface {
+	// SetLegalHold places or releases a legal hold on the stored object
+	SetLegalHold(ctx context.Context, file *File, enabled bool) error
+}
+
+// StreamingProvider is implemented by providers that can read stored objects without buffering them in memory
+type StreamingProvider interface {
+	// Open returns a reader over the stored object; the caller must close it
+	Open(ctx context.Context, file *File) (io.ReadCloser, error)
+}
+
 // ProviderType represents the type of storage provider
 type ProviderType string
 
@@ -42,6 +54,10 @@ const (
 	DiskProvider ProviderType = "disk"
 	// DatabaseProvider is the type for database storage
 	DatabaseProvider ProviderType = "database"
+	// AzureBlobProvider is the type for Azure Blob Storage
+	AzureBlobProvider ProviderType = "azblob"
+	// GCSProvider is the type for Google Cloud Storage
+	GCSProvider ProviderType = "gcs"
 )
 
 // PresignMode determines how presigned URLs are generated for a provider
@@ -91,6 +107,8 @@ type File struct {
 	Parent ParentObject `json:"parent"`
 	// Metadata contains additional file metadata
 	Metadata map[string]any `json:"metadata,omitempty"`
+	// ScanStatus is the content scan status of the file; files that have not passed scanning cannot be downloaded
+	ScanStatus string `json:"scan_status,omitempty"`
 	// CorrelatedObjectID is the ID of the object this file belongs to
 	CorrelatedObjectID string
 	// CorrelatedObjectType is the type of object this file belongs to
@@ -119,10 +137,22 @@ type UploadFileOptions struct {
 	Bucket string `json:"bucket,omitempty"`
 	// FolderDestination is the folder/path within the bucket
 	FolderDestination string `json:"folder_destination,omitempty"`
+	// ObjectLock requests write-once storage for the object on providers that support it
+	ObjectLock *ObjectLockOptions `json:"object_lock,omitempty"`
 	// FileMetadata contains common metadata about the file
 	FileMetadata
 }
 
+// ObjectLockOptions describes the write-once retention applied to an uploaded object
+type ObjectLockOptions struct {
+	// Mode is the object lock mode, either GOVERNANCE or COMPLIANCE
+	Mode string `json:"mode"`
+	// RetainUntil is the time until which the object cannot be overwritten or deleted
+	RetainUntil time.Time `json:"retain_until"`
+	// LegalHold places a legal hold on the object in addition to the retention period
+	LegalHold bool `json:"legal_hold,omitempty"`
+}
+
 // UploadedFileMetadata contains metadata about an uploaded file
 type UploadedFileMetadata struct {
 	// TimeUploaded is the time the file was uploaded
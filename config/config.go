@@ -26,6 +26,7 @@ import (
 	"github.com/theopenlane/utils/cache"
 
 	"github.com/theopenlane/core/internal/ent/entconfig"
+	"github.com/theopenlane/core/internal/entdb"
 	"github.com/theopenlane/core/internal/httpserve/handlers"
 	"github.com/theopenlane/core/internal/integrations/definitions/catalog"
 	"github.com/theopenlane/core/internal/workflows"
@@ -61,6 +62,8 @@ type Config struct {
 	Authz fgax.Config `json:"authz" koanf:"authz"`
 	// DB contains the database configuration for the ent client
 	DB entx.Config `json:"db" koanf:"db"`
+	// DBReplicas contains the read replicas serving read-only graph queries, history reads and exports
+	DBReplicas entdb.ReplicaConfig `json:"dbreplicas" koanf:"dbreplicas"`
 	// JobQueue contains the configuration for the job queue (river) client
 	JobQueue riverqueue.Config `json:"jobqueue" koanf:"jobqueue"`
 	// Redis contains the redis configuration for the key-value store
@@ -149,6 +152,9 @@ type Server struct {
 	// TrustCenterCnameTarget is the cname target for the trust center
 	// Used for mapping the vanity domains to the trust centers
 	TrustCenterCnameTarget string `json:"trustcentercnametarget" koanf:"trustcentercnametarget" default:""`
+	// TrustCenterExportKeyFile is the path to a PEM encoded Ed25519 private key used to sign trust center site exports
+	// When unset, trust center site exports are disabled
+	TrustCenterExportKeyFile string `json:"trustcenterexportkeyfile" koanf:"trustcenterexportkeyfile" default:"" sensitive:"true"`
 	// TrustCenterPreviewZoneID is the cloudflare zone id for the trust center preview domain
 	TrustCenterPreviewZoneID string `json:"trustcenterpreviewzoneid" koanf:"trustcenterpreviewzoneid" default:""`
 	// NotificationLookbackDays is the number of days of read notifications to pull when starting a notification subscription
@@ -18,6 +18,7 @@ import (
 
 	atlas "ariga.io/atlas/sql/migrate"
 	"ariga.io/atlas/sql/sqltool"
+	"github.com/theopenlane/core/internal/ent/fulltext"
 	"github.com/theopenlane/core/internal/ent/generated/migrate"
 	historymigrate "github.com/theopenlane/core/internal/ent/historygenerated/migrate"
 	"github.com/theopenlane/core/internal/entdb"
@@ -43,6 +44,8 @@ func main() {
 		schema.WithMigrationMode(schema.ModeReplay), // provide migration mode
 		schema.WithDropColumn(true),
 		schema.WithDropIndex(true),
+		// the search vector columns are created by a versioned migration and are not part of the ent schema
+		schema.WithDiffHook(fulltext.PreserveSearchVectors),
 	}
 
 	postgresOpts := append(baseOpts, schema.WithDialect(dialect.Postgres))
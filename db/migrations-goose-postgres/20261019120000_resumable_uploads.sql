@@ -0,0 +1,11 @@
+-- +goose Up
+-- create "resumable_uploads" table
+CREATE TABLE "resumable_uploads" ("id" character varying NOT NULL, "organization_id" character varying NULL, "upload_offset" bigint NOT NULL DEFAULT 0, "info" jsonb NOT NULL, "expires_at" timestamptz NULL, "created_at" timestamptz NOT NULL DEFAULT now(), "updated_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("id"));
+-- create index "resumable_uploads_expires_at_idx" to table: "resumable_uploads"
+CREATE INDEX "resumable_uploads_expires_at_idx" ON "resumable_uploads" ("expires_at");
+
+-- +goose Down
+-- reverse: create index "resumable_uploads_expires_at_idx" to table: "resumable_uploads"
+DROP INDEX "resumable_uploads_expires_at_idx";
+-- reverse: create "resumable_uploads" table
+DROP TABLE "resumable_uploads";
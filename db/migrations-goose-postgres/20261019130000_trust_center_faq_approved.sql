@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "trust_center_faqs" table
+ALTER TABLE "trust_center_faqs" ADD COLUMN "approved" boolean NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "trust_center_faqs" table
+ALTER TABLE "trust_center_faqs" DROP COLUMN "approved";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "trust_center_faqs_history" table
+ALTER TABLE "trust_center_faqs_history" ADD COLUMN "approved" boolean NULL DEFAULT false;
+
+-- +goose Down
+-- reverse: modify "trust_center_faqs_history" table
+ALTER TABLE "trust_center_faqs_history" DROP COLUMN "approved";
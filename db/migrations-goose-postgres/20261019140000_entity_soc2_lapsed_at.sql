@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "entities" table
+ALTER TABLE "entities" ADD COLUMN "soc2_lapsed_at" timestamptz NULL;
+
+-- +goose Down
+-- reverse: modify "entities" table
+ALTER TABLE "entities" DROP COLUMN "soc2_lapsed_at";
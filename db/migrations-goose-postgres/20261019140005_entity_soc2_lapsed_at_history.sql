@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "entity_history" table
+ALTER TABLE "entity_history" ADD COLUMN "soc2_lapsed_at" timestamptz NULL;
+
+-- +goose Down
+-- reverse: modify "entity_history" table
+ALTER TABLE "entity_history" DROP COLUMN "soc2_lapsed_at";
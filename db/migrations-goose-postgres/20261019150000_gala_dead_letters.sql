@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "gala_dead_letters" table
+CREATE TABLE "gala_dead_letters" ("id" text NOT NULL, "event_id" text NOT NULL, "topic" text NOT NULL, "listener" text NOT NULL DEFAULT '', "kind" text NOT NULL DEFAULT '', "queue" text NOT NULL, "job_id" bigint NOT NULL, "attempts" integer NOT NULL, "envelope" jsonb NOT NULL, "errors" jsonb NOT NULL, "failed_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "gala_dead_letters_job_id_idx" to table: "gala_dead_letters"
+CREATE UNIQUE INDEX "gala_dead_letters_job_id_idx" ON "gala_dead_letters" ("job_id");
+-- create index "gala_dead_letters_topic_listener_idx" to table: "gala_dead_letters"
+CREATE INDEX "gala_dead_letters_topic_listener_idx" ON "gala_dead_letters" ("topic", "listener");
+
+-- +goose Down
+-- reverse: create index "gala_dead_letters_topic_listener_idx" to table: "gala_dead_letters"
+DROP INDEX "gala_dead_letters_topic_listener_idx";
+-- reverse: create index "gala_dead_letters_job_id_idx" to table: "gala_dead_letters"
+DROP INDEX "gala_dead_letters_job_id_idx";
+-- reverse: create "gala_dead_letters" table
+DROP TABLE "gala_dead_letters";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- create "gala_outbox" table
+CREATE TABLE "gala_outbox" ("id" bigserial NOT NULL, "event_id" text NOT NULL, "topic" text NOT NULL, "envelope" jsonb NOT NULL, "created_at" timestamptz NOT NULL DEFAULT now(), "failed_at" timestamptz NULL, "error" text NULL, PRIMARY KEY ("id"));
+-- create index "gala_outbox_pending_idx" to table: "gala_outbox"
+CREATE INDEX "gala_outbox_pending_idx" ON "gala_outbox" ("id") WHERE (failed_at IS NULL);
+
+-- +goose Down
+-- reverse: create index "gala_outbox_pending_idx" to table: "gala_outbox"
+DROP INDEX "gala_outbox_pending_idx";
+-- reverse: create "gala_outbox" table
+DROP TABLE "gala_outbox";
@@ -0,0 +1,11 @@
+-- +goose Up
+-- create "file_search_documents" table
+CREATE TABLE "file_search_documents" ("file_id" text NOT NULL, "checksum" text NOT NULL DEFAULT '', "content" text NOT NULL, "search_vector" tsvector GENERATED ALWAYS AS (to_tsvector('english'::regconfig, content)) STORED, "indexed_at" timestamptz NOT NULL DEFAULT now(), PRIMARY KEY ("file_id"), CONSTRAINT "file_search_documents_files_file" FOREIGN KEY ("file_id") REFERENCES "files" ("id") ON UPDATE NO ACTION ON DELETE CASCADE);
+-- create index "file_search_documents_search_vector_idx" to table: "file_search_documents"
+CREATE INDEX "file_search_documents_search_vector_idx" ON "file_search_documents" USING gin ("search_vector");
+
+-- +goose Down
+-- reverse: create index "file_search_documents_search_vector_idx" to table: "file_search_documents"
+DROP INDEX "file_search_documents_search_vector_idx";
+-- reverse: create "file_search_documents" table
+DROP TABLE "file_search_documents";
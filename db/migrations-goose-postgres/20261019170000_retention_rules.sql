@@ -0,0 +1,15 @@
+-- +goose Up
+-- create "retention_rules" table
+CREATE TABLE "retention_rules" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "updated_by_impersonator" character varying NULL, "deleted_at" timestamptz NULL, "deleted_by" character varying NULL, "name" character varying NOT NULL, "description" character varying NULL, "category" character varying NULL, "object_type" character varying NULL, "retention_days" bigint NOT NULL, "purge" boolean NOT NULL DEFAULT false, "owner_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "retention_rules_organizations_retention_rules" FOREIGN KEY ("owner_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- create index "retention_rule_owner_id_idx" to table: "retention_rules"
+CREATE INDEX "retention_rule_owner_id_idx" ON "retention_rules" ("owner_id");
+-- create index "retentionrule_name_owner_id" to table: "retention_rules"
+CREATE UNIQUE INDEX "retentionrule_name_owner_id" ON "retention_rules" ("name", "owner_id") WHERE (deleted_at IS NULL);
+
+-- +goose Down
+-- reverse: create index "retentionrule_name_owner_id" to table: "retention_rules"
+DROP INDEX "retentionrule_name_owner_id";
+-- reverse: create index "retention_rule_owner_id_idx" to table: "retention_rules"
+DROP INDEX "retention_rule_owner_id_idx";
+-- reverse: create "retention_rules" table
+DROP TABLE "retention_rules";
@@ -0,0 +1,10 @@
+-- +goose Up
+-- modify "files" table
+ALTER TABLE "files" ADD COLUMN "scan_status" character varying NULL;
+-- move the scan status out of the user editable file metadata
+UPDATE "files" SET "scan_status" = "metadata" ->> 'scan_status', "metadata" = "metadata" - 'scan_status' WHERE "metadata" ? 'scan_status';
+
+-- +goose Down
+-- reverse: modify "files" table
+UPDATE "files" SET "metadata" = COALESCE("metadata", '{}'::jsonb) || jsonb_build_object('scan_status', "scan_status") WHERE "scan_status" IS NOT NULL;
+ALTER TABLE "files" DROP COLUMN "scan_status";
@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "file_history" table
+ALTER TABLE "file_history" ADD COLUMN "scan_status" character varying NULL;
+
+-- +goose Down
+-- reverse: modify "file_history" table
+ALTER TABLE "file_history" DROP COLUMN "scan_status";
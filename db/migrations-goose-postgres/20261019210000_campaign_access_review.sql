@@ -0,0 +1,10 @@
+-- +goose Up
+-- modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "access_review" jsonb NULL;
+-- move the access review out of the user editable campaign metadata
+UPDATE "campaigns" SET "access_review" = "metadata" -> 'access_review', "metadata" = "metadata" - 'access_review' WHERE "metadata" ? 'access_review';
+
+-- +goose Down
+-- reverse: modify "campaigns" table
+UPDATE "campaigns" SET "metadata" = COALESCE("metadata", '{}'::jsonb) || jsonb_build_object('access_review', "access_review") WHERE "access_review" IS NOT NULL;
+ALTER TABLE "campaigns" DROP COLUMN "access_review";
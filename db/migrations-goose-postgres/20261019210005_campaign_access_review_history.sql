@@ -0,0 +1,7 @@
+-- +goose Up
+-- modify "campaign_history" table
+ALTER TABLE "campaign_history" ADD COLUMN "access_review" jsonb NULL;
+
+-- +goose Down
+-- reverse: modify "campaign_history" table
+ALTER TABLE "campaign_history" DROP COLUMN "access_review";
@@ -0,0 +1,2 @@
+-- Modify "trust_center_faqs" table
+ALTER TABLE "trust_center_faqs" ADD COLUMN "approved" boolean NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "trust_center_faqs_history" table
+ALTER TABLE "trust_center_faqs_history" ADD COLUMN "approved" boolean NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Modify "entities" table
+ALTER TABLE "entities" ADD COLUMN "soc2_lapsed_at" timestamptz NULL;
@@ -0,0 +1,2 @@
+-- Modify "entity_history" table
+ALTER TABLE "entity_history" ADD COLUMN "soc2_lapsed_at" timestamptz NULL;
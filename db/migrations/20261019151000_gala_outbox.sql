@@ -0,0 +1,4 @@
+-- Create "gala_outbox" table
+CREATE TABLE "gala_outbox" ("id" bigserial NOT NULL, "event_id" text NOT NULL, "topic" text NOT NULL, "envelope" jsonb NOT NULL, "created_at" timestamptz NOT NULL DEFAULT now(), "failed_at" timestamptz NULL, "error" text NULL, PRIMARY KEY ("id"));
+-- Create index "gala_outbox_pending_idx" to table: "gala_outbox"
+CREATE INDEX "gala_outbox_pending_idx" ON "gala_outbox" ("id") WHERE (failed_at IS NULL);
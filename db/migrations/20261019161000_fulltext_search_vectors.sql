@@ -0,0 +1,246 @@
+-- Modify "action_plans" table
+ALTER TABLE "action_plans" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("details"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "action_plans_search_vector_idx" to table: "action_plans"
+CREATE INDEX IF NOT EXISTS "action_plans_search_vector_idx" ON "action_plans" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "action_plans"
+COMMENT ON COLUMN "action_plans"."search_vector" IS 'fulltext:b26dbd1d02efaef9';
+-- Modify "assessments" table
+ALTER TABLE "assessments" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "assessments_search_vector_idx" to table: "assessments"
+CREATE INDEX IF NOT EXISTS "assessments_search_vector_idx" ON "assessments" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "assessments"
+COMMENT ON COLUMN "assessments"."search_vector" IS 'fulltext:ca2fec18ff618bf1';
+-- Modify "assessment_responses" table
+ALTER TABLE "assessment_responses" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("display_name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("email"::text, '')), 'A')) STORED;
+-- Create index "assessment_responses_search_vector_idx" to table: "assessment_responses"
+CREATE INDEX IF NOT EXISTS "assessment_responses_search_vector_idx" ON "assessment_responses" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "assessment_responses"
+COMMENT ON COLUMN "assessment_responses"."search_vector" IS 'fulltext:88513ec8d1f7ed9b';
+-- Modify "assets" table
+ALTER TABLE "assets" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("display_name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("internal_owner"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "assets_search_vector_idx" to table: "assets"
+CREATE INDEX IF NOT EXISTS "assets_search_vector_idx" ON "assets" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "assets"
+COMMENT ON COLUMN "assets"."search_vector" IS 'fulltext:89ef758686569109';
+-- Modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("internal_owner"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "campaigns_search_vector_idx" to table: "campaigns"
+CREATE INDEX IF NOT EXISTS "campaigns_search_vector_idx" ON "campaigns" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "campaigns"
+COMMENT ON COLUMN "campaigns"."search_vector" IS 'fulltext:562dde547d07e297';
+-- Modify "campaign_targets" table
+ALTER TABLE "campaign_targets" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("email"::text, '')), 'A')) STORED;
+-- Create index "campaign_targets_search_vector_idx" to table: "campaign_targets"
+CREATE INDEX IF NOT EXISTS "campaign_targets_search_vector_idx" ON "campaign_targets" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "campaign_targets"
+COMMENT ON COLUMN "campaign_targets"."search_vector" IS 'fulltext:885734f5c94210a5';
+-- Modify "contacts" table
+ALTER TABLE "contacts" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("email"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("full_name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "contacts_search_vector_idx" to table: "contacts"
+CREATE INDEX IF NOT EXISTS "contacts_search_vector_idx" ON "contacts" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "contacts"
+COMMENT ON COLUMN "contacts"."search_vector" IS 'fulltext:ce35eadced8a8b81';
+-- Modify "controls" table
+ALTER TABLE "controls" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(jsonb_to_tsvector('english'::regconfig, coalesce("aliases", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("category"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("description"::text, '')), 'C') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("mapped_categories", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("public_representation"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("ref_code"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("subcategory"::text, '')), 'B') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("title"::text, '')), 'A')) STORED;
+-- Create index "controls_search_vector_idx" to table: "controls"
+CREATE INDEX IF NOT EXISTS "controls_search_vector_idx" ON "controls" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "controls"
+COMMENT ON COLUMN "controls"."search_vector" IS 'fulltext:ddabd160cffa5b74';
+-- Modify "control_objectives" table
+ALTER TABLE "control_objectives" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("category"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("subcategory"::text, '')), 'B') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "control_objectives_search_vector_idx" to table: "control_objectives"
+CREATE INDEX IF NOT EXISTS "control_objectives_search_vector_idx" ON "control_objectives" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "control_objectives"
+COMMENT ON COLUMN "control_objectives"."search_vector" IS 'fulltext:9e6b3aed23cc5758';
+-- Modify "custom_type_enums" table
+ALTER TABLE "custom_type_enums" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("object_type"::text, '')), 'B')) STORED;
+-- Create index "custom_type_enums_search_vector_idx" to table: "custom_type_enums"
+CREATE INDEX IF NOT EXISTS "custom_type_enums_search_vector_idx" ON "custom_type_enums" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "custom_type_enums"
+COMMENT ON COLUMN "custom_type_enums"."search_vector" IS 'fulltext:c420fd572bf941a2';
+-- Modify "email_templates" table
+ALTER TABLE "email_templates" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("key"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A')) STORED;
+-- Create index "email_templates_search_vector_idx" to table: "email_templates"
+CREATE INDEX IF NOT EXISTS "email_templates_search_vector_idx" ON "email_templates" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "email_templates"
+COMMENT ON COLUMN "email_templates"."search_vector" IS 'fulltext:4c179f1a67dd7532';
+-- Modify "entities" table
+ALTER TABLE "entities" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(jsonb_to_tsvector('english'::regconfig, coalesce("aliases", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("description"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("display_name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("internal_owner"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "entities_search_vector_idx" to table: "entities"
+CREATE INDEX IF NOT EXISTS "entities_search_vector_idx" ON "entities" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "entities"
+COMMENT ON COLUMN "entities"."search_vector" IS 'fulltext:b331232eda222562';
+-- Modify "evidences" table
+ALTER TABLE "evidences" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "evidences_search_vector_idx" to table: "evidences"
+CREATE INDEX IF NOT EXISTS "evidences_search_vector_idx" ON "evidences" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "evidences"
+COMMENT ON COLUMN "evidences"."search_vector" IS 'fulltext:ca2fec18ff618bf1';
+-- Modify "findings" table
+ALTER TABLE "findings" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("external_id"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("external_owner_id"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("severity"::text, '')), 'B') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "findings_search_vector_idx" to table: "findings"
+CREATE INDEX IF NOT EXISTS "findings_search_vector_idx" ON "findings" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "findings"
+COMMENT ON COLUMN "findings"."search_vector" IS 'fulltext:fbd9a24c51f30224';
+-- Modify "groups" table
+ALTER TABLE "groups" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("display_name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "groups_search_vector_idx" to table: "groups"
+CREATE INDEX IF NOT EXISTS "groups_search_vector_idx" ON "groups" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "groups"
+COMMENT ON COLUMN "groups"."search_vector" IS 'fulltext:ba9d85b44bae14f4';
+-- Modify "identity_holders" table
+ALTER TABLE "identity_holders" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("email"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("full_name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("internal_owner"::text, '')), 'B') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "identity_holders_search_vector_idx" to table: "identity_holders"
+CREATE INDEX IF NOT EXISTS "identity_holders_search_vector_idx" ON "identity_holders" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "identity_holders"
+COMMENT ON COLUMN "identity_holders"."search_vector" IS 'fulltext:d82fd29e83351bab';
+-- Modify "integrations" table
+ALTER TABLE "integrations" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("definition_id"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("definition_slug"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("kind"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "integrations_search_vector_idx" to table: "integrations"
+CREATE INDEX IF NOT EXISTS "integrations_search_vector_idx" ON "integrations" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "integrations"
+COMMENT ON COLUMN "integrations"."search_vector" IS 'fulltext:c0bdcbaf8ea4ad03';
+-- Modify "internal_policies" table
+ALTER TABLE "internal_policies" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("details"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "internal_policies_search_vector_idx" to table: "internal_policies"
+CREATE INDEX IF NOT EXISTS "internal_policies_search_vector_idx" ON "internal_policies" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "internal_policies"
+COMMENT ON COLUMN "internal_policies"."search_vector" IS 'fulltext:b26dbd1d02efaef9';
+-- Modify "invites" table
+ALTER TABLE "invites" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("recipient"::text, '')), 'A')) STORED;
+-- Create index "invites_search_vector_idx" to table: "invites"
+CREATE INDEX IF NOT EXISTS "invites_search_vector_idx" ON "invites" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "invites"
+COMMENT ON COLUMN "invites"."search_vector" IS 'fulltext:6d0f3b40b73f6c0d';
+-- Modify "job_runners" table
+ALTER TABLE "job_runners" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "job_runners_search_vector_idx" to table: "job_runners"
+CREATE INDEX IF NOT EXISTS "job_runners_search_vector_idx" ON "job_runners" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "job_runners"
+COMMENT ON COLUMN "job_runners"."search_vector" IS 'fulltext:ca2fec18ff618bf1';
+-- Modify "job_templates" table
+ALTER TABLE "job_templates" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("description"::text, '')), 'C') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("title"::text, '')), 'A')) STORED;
+-- Create index "job_templates_search_vector_idx" to table: "job_templates"
+CREATE INDEX IF NOT EXISTS "job_templates_search_vector_idx" ON "job_templates" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "job_templates"
+COMMENT ON COLUMN "job_templates"."search_vector" IS 'fulltext:1136a805ff8fd646';
+-- Modify "narratives" table
+ALTER TABLE "narratives" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("description"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "narratives_search_vector_idx" to table: "narratives"
+CREATE INDEX IF NOT EXISTS "narratives_search_vector_idx" ON "narratives" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "narratives"
+COMMENT ON COLUMN "narratives"."search_vector" IS 'fulltext:95504f7c1a64afc0';
+-- Modify "notification_templates" table
+ALTER TABLE "notification_templates" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("key"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A')) STORED;
+-- Create index "notification_templates_search_vector_idx" to table: "notification_templates"
+CREATE INDEX IF NOT EXISTS "notification_templates_search_vector_idx" ON "notification_templates" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "notification_templates"
+COMMENT ON COLUMN "notification_templates"."search_vector" IS 'fulltext:4c179f1a67dd7532';
+-- Modify "organizations" table
+ALTER TABLE "organizations" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("display_name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "organizations_search_vector_idx" to table: "organizations"
+CREATE INDEX IF NOT EXISTS "organizations_search_vector_idx" ON "organizations" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "organizations"
+COMMENT ON COLUMN "organizations"."search_vector" IS 'fulltext:ba9d85b44bae14f4';
+-- Modify "platforms" table
+ALTER TABLE "platforms" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("internal_owner"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "platforms_search_vector_idx" to table: "platforms"
+CREATE INDEX IF NOT EXISTS "platforms_search_vector_idx" ON "platforms" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "platforms"
+COMMENT ON COLUMN "platforms"."search_vector" IS 'fulltext:562dde547d07e297';
+-- Modify "procedures" table
+ALTER TABLE "procedures" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("details"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "procedures_search_vector_idx" to table: "procedures"
+CREATE INDEX IF NOT EXISTS "procedures_search_vector_idx" ON "procedures" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "procedures"
+COMMENT ON COLUMN "procedures"."search_vector" IS 'fulltext:b26dbd1d02efaef9';
+-- Modify "programs" table
+ALTER TABLE "programs" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("description"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "programs_search_vector_idx" to table: "programs"
+CREATE INDEX IF NOT EXISTS "programs_search_vector_idx" ON "programs" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "programs"
+COMMENT ON COLUMN "programs"."search_vector" IS 'fulltext:95504f7c1a64afc0';
+-- Modify "remediations" table
+ALTER TABLE "remediations" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("external_id"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("external_owner_id"::text, '')), 'C') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("title"::text, '')), 'A')) STORED;
+-- Create index "remediations_search_vector_idx" to table: "remediations"
+CREATE INDEX IF NOT EXISTS "remediations_search_vector_idx" ON "remediations" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "remediations"
+COMMENT ON COLUMN "remediations"."search_vector" IS 'fulltext:171718f438bb9a2e';
+-- Modify "reviews" table
+ALTER TABLE "reviews" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("external_id"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("external_owner_id"::text, '')), 'C') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("title"::text, '')), 'A')) STORED;
+-- Create index "reviews_search_vector_idx" to table: "reviews"
+CREATE INDEX IF NOT EXISTS "reviews_search_vector_idx" ON "reviews" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "reviews"
+COMMENT ON COLUMN "reviews"."search_vector" IS 'fulltext:171718f438bb9a2e';
+-- Modify "risks" table
+ALTER TABLE "risks" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "risks_search_vector_idx" to table: "risks"
+CREATE INDEX IF NOT EXISTS "risks_search_vector_idx" ON "risks" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "risks"
+COMMENT ON COLUMN "risks"."search_vector" IS 'fulltext:ca2fec18ff618bf1';
+-- Modify "scans" table
+ALTER TABLE "scans" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("target"::text, '')), 'A')) STORED;
+-- Create index "scans_search_vector_idx" to table: "scans"
+CREATE INDEX IF NOT EXISTS "scans_search_vector_idx" ON "scans" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "scans"
+COMMENT ON COLUMN "scans"."search_vector" IS 'fulltext:dc8950bd73a6761d';
+-- Modify "standards" table
+ALTER TABLE "standards" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(jsonb_to_tsvector('english'::regconfig, coalesce("domains", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("framework"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("governing_body"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("short_name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "standards_search_vector_idx" to table: "standards"
+CREATE INDEX IF NOT EXISTS "standards_search_vector_idx" ON "standards" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "standards"
+COMMENT ON COLUMN "standards"."search_vector" IS 'fulltext:c39b58d34c5d8db6';
+-- Modify "subcontrols" table
+ALTER TABLE "subcontrols" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(jsonb_to_tsvector('english'::regconfig, coalesce("aliases", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("category"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("description"::text, '')), 'C') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("mapped_categories", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("public_representation"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("ref_code"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("subcategory"::text, '')), 'B') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("title"::text, '')), 'A')) STORED;
+-- Create index "subcontrols_search_vector_idx" to table: "subcontrols"
+CREATE INDEX IF NOT EXISTS "subcontrols_search_vector_idx" ON "subcontrols" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "subcontrols"
+COMMENT ON COLUMN "subcontrols"."search_vector" IS 'fulltext:ddabd160cffa5b74';
+-- Modify "subprocessors" table
+ALTER TABLE "subprocessors" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "subprocessors_search_vector_idx" to table: "subprocessors"
+CREATE INDEX IF NOT EXISTS "subprocessors_search_vector_idx" ON "subprocessors" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "subprocessors"
+COMMENT ON COLUMN "subprocessors"."search_vector" IS 'fulltext:ca2fec18ff618bf1';
+-- Modify "subscribers" table
+ALTER TABLE "subscribers" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("email"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "subscribers_search_vector_idx" to table: "subscribers"
+CREATE INDEX IF NOT EXISTS "subscribers_search_vector_idx" ON "subscribers" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "subscribers"
+COMMENT ON COLUMN "subscribers"."search_vector" IS 'fulltext:410fed0a897a84f2';
+-- Modify "system_details" table
+ALTER TABLE "system_details" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("system_name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "system_details_search_vector_idx" to table: "system_details"
+CREATE INDEX IF NOT EXISTS "system_details_search_vector_idx" ON "system_details" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "system_details"
+COMMENT ON COLUMN "system_details"."search_vector" IS 'fulltext:0de362c373ed090d';
+-- Modify "tag_definitions" table
+ALTER TABLE "tag_definitions" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(jsonb_to_tsvector('english'::regconfig, coalesce("aliases", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("slug"::text, '')), 'A')) STORED;
+-- Create index "tag_definitions_search_vector_idx" to table: "tag_definitions"
+CREATE INDEX IF NOT EXISTS "tag_definitions_search_vector_idx" ON "tag_definitions" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "tag_definitions"
+COMMENT ON COLUMN "tag_definitions"."search_vector" IS 'fulltext:3d0d3fdc6d8991a7';
+-- Modify "tasks" table
+ALTER TABLE "tasks" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("title"::text, '')), 'A')) STORED;
+-- Create index "tasks_search_vector_idx" to table: "tasks"
+CREATE INDEX IF NOT EXISTS "tasks_search_vector_idx" ON "tasks" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "tasks"
+COMMENT ON COLUMN "tasks"."search_vector" IS 'fulltext:a2373e1afe795cfd';
+-- Modify "templates" table
+ALTER TABLE "templates" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "templates_search_vector_idx" to table: "templates"
+CREATE INDEX IF NOT EXISTS "templates_search_vector_idx" ON "templates" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "templates"
+COMMENT ON COLUMN "templates"."search_vector" IS 'fulltext:ca2fec18ff618bf1';
+-- Modify "trust_center_entities" table
+ALTER TABLE "trust_center_entities" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("url"::text, '')), 'B')) STORED;
+-- Create index "trust_center_entities_search_vector_idx" to table: "trust_center_entities"
+CREATE INDEX IF NOT EXISTS "trust_center_entities_search_vector_idx" ON "trust_center_entities" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "trust_center_entities"
+COMMENT ON COLUMN "trust_center_entities"."search_vector" IS 'fulltext:43a0373ab265b203';
+-- Modify "vulnerabilities" table
+ALTER TABLE "vulnerabilities" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (setweight(to_tsvector('english'::regconfig, coalesce("cve_id"::text, '')), 'A') || setweight(to_tsvector('english'::regconfig, coalesce("dismissed_reason"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("external_id"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("external_owner_id"::text, '')), 'C') || setweight(to_tsvector('english'::regconfig, coalesce("package_name"::text, '')), 'B') || setweight(to_tsvector('english'::regconfig, coalesce("severity"::text, '')), 'B') || setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')) STORED;
+-- Create index "vulnerabilities_search_vector_idx" to table: "vulnerabilities"
+CREATE INDEX IF NOT EXISTS "vulnerabilities_search_vector_idx" ON "vulnerabilities" USING GIN ("search_vector");
+-- Set comment to column: "search_vector" on table: "vulnerabilities"
+COMMENT ON COLUMN "vulnerabilities"."search_vector" IS 'fulltext:c3bb0a413389c0c3';
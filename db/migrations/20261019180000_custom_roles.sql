@@ -0,0 +1,6 @@
+-- Create "custom_roles" table
+CREATE TABLE "custom_roles" ("id" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "updated_by_impersonator" character varying NULL, "deleted_at" timestamptz NULL, "deleted_by" character varying NULL, "name" citext NOT NULL, "description" character varying NULL, "permissions" jsonb NOT NULL, "program_ids" jsonb NULL, "group_ids" jsonb NULL, "owner_id" character varying NULL, PRIMARY KEY ("id"), CONSTRAINT "custom_roles_organizations_custom_roles" FOREIGN KEY ("owner_id") REFERENCES "organizations" ("id") ON UPDATE NO ACTION ON DELETE SET NULL);
+-- Create index "custom_role_owner_id_idx" to table: "custom_roles"
+CREATE INDEX "custom_role_owner_id_idx" ON "custom_roles" ("owner_id");
+-- Create index "customrole_name_owner_id" to table: "custom_roles"
+CREATE UNIQUE INDEX "customrole_name_owner_id" ON "custom_roles" ("name", "owner_id") WHERE (deleted_at IS NULL);
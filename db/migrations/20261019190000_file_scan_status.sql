@@ -0,0 +1,4 @@
+-- Modify "files" table
+ALTER TABLE "files" ADD COLUMN "scan_status" character varying NULL;
+-- Move the scan status out of the user editable file metadata
+UPDATE "files" SET "scan_status" = "metadata" ->> 'scan_status', "metadata" = "metadata" - 'scan_status' WHERE "metadata" ? 'scan_status';
@@ -0,0 +1,2 @@
+-- Modify "file_history" table
+ALTER TABLE "file_history" ADD COLUMN "scan_status" character varying NULL;
@@ -0,0 +1,4 @@
+-- Create "retention_rule_history" table
+CREATE TABLE "retention_rule_history" ("id" character varying NOT NULL, "history_time" timestamptz NOT NULL, "ref" character varying NULL, "operation" character varying NOT NULL, "created_at" timestamptz NULL, "updated_at" timestamptz NULL, "created_by" character varying NULL, "updated_by" character varying NULL, "updated_by_impersonator" character varying NULL, "deleted_at" timestamptz NULL, "deleted_by" character varying NULL, "owner_id" character varying NULL, "name" character varying NOT NULL, "description" character varying NULL, "category" character varying NULL, "object_type" character varying NULL, "retention_days" bigint NOT NULL, "purge" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- Create index "retentionrulehistory_history_time" to table: "retention_rule_history"
+CREATE INDEX "retentionrulehistory_history_time" ON "retention_rule_history" ("history_time");
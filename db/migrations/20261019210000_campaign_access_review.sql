@@ -0,0 +1,4 @@
+-- Modify "campaigns" table
+ALTER TABLE "campaigns" ADD COLUMN "access_review" jsonb NULL;
+-- Move the access review out of the user editable campaign metadata
+UPDATE "campaigns" SET "access_review" = "metadata" -> 'access_review', "metadata" = "metadata" - 'access_review' WHERE "metadata" ? 'access_review';
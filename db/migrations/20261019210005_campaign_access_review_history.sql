@@ -0,0 +1,2 @@
+-- Modify "campaign_history" table
+ALTER TABLE "campaign_history" ADD COLUMN "access_review" jsonb NULL;
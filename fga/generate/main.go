@@ -30,8 +30,9 @@ const (
 	canViewPrefix           = "can_view_"
 	canCreatePrefix         = "can_create_"
 	creatorSuffix           = "_creator"
-	defaultRelations        = "[service, user, group#member]"
-	defaultRelationsNoGroup = "[service, user]"
+	defaultCreatorRelations = "[group#member, custom_role#assignee]"
+	defaultRelations        = "[service, user, group#member, custom_role#assignee]"
+	defaultRelationsNoGroup = "[service, user, custom_role#assignee]"
 )
 
 // this generator looks at all the ent schemas and their policy functions to determine the organization-level CRUD access for each schema and generates a crud.fga file that defines the appropriate relations for each schema based on that access. This allows us to have a consistent set of permissions for each schema in FGA that are automatically generated based on the presence of certain rules in the ent policies, which reduces the likelihood of human error in defining permissions and ensures that all schemas have a consistent permission structure in FGA.
@@ -122,7 +123,7 @@ func main() {
 		}
 
 		if si.HasCreate && si.HasGroupCreator {
-			buf.WriteString(fmt.Sprintf("    define %s%s: [group#member]\n", fgaType, creatorSuffix))
+			buf.WriteString(fmt.Sprintf("    define %s%s: %s\n", fgaType, creatorSuffix, defaultCreatorRelations))
 		}
 
 		var createExpr []string
@@ -0,0 +1,232 @@
+package model
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/pkg/errors"
+)
+
+const (
+	// CustomRoleType is the fga type of the roles organizations define from permission bundles
+	CustomRoleType = "custom_role"
+	// CustomRoleAssigneeRelation is the relation assigning a custom role to a user, service or group
+	CustomRoleAssigneeRelation = "assignee"
+
+	organizationType = "organization"
+	manageBundleVerb = "manage"
+	canCreatePrefix  = "can_create_"
+	creatorSuffix    = "_creator"
+)
+
+// ErrInvalidCustomRolePermission is returned when a permission cannot be granted by a custom role
+var ErrInvalidCustomRolePermission = errors.New("permission cannot be granted by a custom role")
+
+// PermissionBundle is a can_manage_<bundle> permission of the organization roles that can be added to a custom role
+type PermissionBundle struct {
+	// ID is the can_manage relation of the bundle, e.g. can_manage_compliance
+	ID string
+	// Role is the organization role granting the bundle, e.g. compliance_manager
+	Role string
+	// Name is the display name of the role granting the bundle
+	Name string
+	// Description describes what the bundle grants
+	Description string
+}
+
+var (
+	permissionBundlesOnce sync.Once
+	permissionBundles     []PermissionBundle
+	permissionBundlesErr  error
+)
+
+// PermissionBundles returns the can_manage_* bundles of the organization roles that custom roles can be composed from
+func PermissionBundles() ([]PermissionBundle, error) {
+	permissionBundlesOnce.Do(func() {
+		permissionBundles, permissionBundlesErr = parsePermissionBundles()
+	})
+
+	if permissionBundlesErr != nil {
+		return nil, permissionBundlesErr
+	}
+
+	bundles := make([]PermissionBundle, len(permissionBundles))
+	copy(bundles, permissionBundles)
+
+	return bundles, nil
+}
+
+func parsePermissionBundles() ([]PermissionBundle, error) {
+	model, err := GetRolesAuthorizationModel()
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := OrganizationRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	roleByID := make(map[string]PermissionBundle, len(roles))
+	for _, role := range roles {
+		roleByID[role.ID] = PermissionBundle{Role: role.ID, Name: role.Name, Description: role.Description}
+	}
+
+	assignable := customRoleAssignableRelations(model)
+	bundles := []PermissionBundle{}
+
+	for rel, userset := range organizationRelations(model) {
+		parts := strings.SplitN(rel, "_", relationPartsCount)
+		if len(parts) != relationPartsCount || parts[0] != "can" || parts[1] != manageBundleVerb {
+			continue
+		}
+
+		// the bundle is granted by the role relation in its union that custom roles can be assigned to
+		for _, granting := range computedRelations(userset) {
+			if _, ok := assignable[granting]; !ok {
+				continue
+			}
+
+			bundle, ok := roleByID[granting]
+			if !ok {
+				bundle = PermissionBundle{Role: granting}
+			}
+
+			bundle.ID = rel
+			bundles = append(bundles, bundle)
+
+			break
+		}
+	}
+
+	sort.Slice(bundles, func(i, j int) bool {
+		return bundles[i].ID < bundles[j].ID
+	})
+
+	return bundles, nil
+}
+
+// CustomRolePermissions returns every permission a custom role can grant, the permission bundles followed by the
+// can_view, can_edit, can_delete and can_create permissions of each object
+func CustomRolePermissions() ([]string, error) {
+	bundles, err := PermissionBundles()
+	if err != nil {
+		return nil, err
+	}
+
+	model, err := GetCrudAuthorizationModel()
+	if err != nil {
+		return nil, err
+	}
+
+	permissions := make([]string, 0, len(bundles))
+	for _, bundle := range bundles {
+		permissions = append(permissions, bundle.ID)
+	}
+
+	crud := []string{}
+
+	for rel := range customRoleAssignableRelations(model) {
+		if object, ok := strings.CutSuffix(rel, creatorSuffix); ok {
+			crud = append(crud, canCreatePrefix+object)
+			continue
+		}
+
+		crud = append(crud, rel)
+	}
+
+	sort.Strings(crud)
+
+	return append(permissions, crud...), nil
+}
+
+// CustomRoleRelation returns the organization relation a custom role is granted for a permission; bundles are
+// granted through the role relation of the bundle and can_create through the <object>_creator relation
+func CustomRoleRelation(permission string) (string, error) {
+	permission = strings.ToLower(strings.TrimSpace(permission))
+
+	bundles, err := PermissionBundles()
+	if err != nil {
+		return "", err
+	}
+
+	for _, bundle := range bundles {
+		if bundle.ID == permission {
+			return bundle.Role, nil
+		}
+	}
+
+	model, err := GetCrudAuthorizationModel()
+	if err != nil {
+		return "", err
+	}
+
+	relation := permission
+	if object, ok := strings.CutPrefix(permission, canCreatePrefix); ok {
+		relation = object + creatorSuffix
+	}
+
+	if _, ok := customRoleAssignableRelations(model)[relation]; !ok {
+		return "", errors.Wrap(ErrInvalidCustomRolePermission, permission)
+	}
+
+	return relation, nil
+}
+
+// organizationRelations returns the relations of the organization type in the model
+func organizationRelations(model *openfga.AuthorizationModel) map[string]openfga.Userset {
+	for _, td := range model.GetTypeDefinitions() {
+		if td.Type != organizationType || td.Relations == nil {
+			continue
+		}
+
+		return *td.Relations
+	}
+
+	return nil
+}
+
+// customRoleAssignableRelations returns the organization relations that accept the custom role assignee userset
+func customRoleAssignableRelations(model *openfga.AuthorizationModel) map[string]struct{} {
+	relations := map[string]struct{}{}
+
+	for _, td := range model.GetTypeDefinitions() {
+		if td.Type != organizationType || td.Metadata == nil || td.Metadata.Relations == nil {
+			continue
+		}
+
+		for rel, meta := range *td.Metadata.Relations {
+			if meta.DirectlyRelatedUserTypes == nil {
+				continue
+			}
+
+			for _, ref := range *meta.DirectlyRelatedUserTypes {
+				if ref.Type == CustomRoleType && ref.Relation != nil && *ref.Relation == CustomRoleAssigneeRelation {
+					relations[rel] = struct{}{}
+				}
+			}
+		}
+	}
+
+	return relations
+}
+
+// computedRelations returns the relations a userset is computed from, the children of a union or the relation itself
+func computedRelations(userset openfga.Userset) []string {
+	usersets := []openfga.Userset{userset}
+	if userset.Union != nil {
+		usersets = userset.Union.Child
+	}
+
+	relations := []string{}
+
+	for _, child := range usersets {
+		if child.ComputedUserset != nil && child.ComputedUserset.Relation != nil {
+			relations = append(relations, *child.ComputedUserset.Relation)
+		}
+	}
+
+	return relations
+}
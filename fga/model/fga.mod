@@ -34,3 +34,4 @@ contents:
     - trustcenter/domain.fga
 
     - roles/roles.fga
+    - roles/custom_role.fga
@@ -45,3 +45,46 @@ func TestScopeOptions(t *testing.T) {
 	assert.Check(t, is.Contains(opts["control"], "read"))
 	assert.Check(t, is.Contains(opts["control"], "write"))
 }
+
+func TestPermissionBundles(t *testing.T) {
+	bundles, err := PermissionBundles()
+	assert.NilError(t, err)
+
+	byID := map[string]PermissionBundle{}
+	for _, bundle := range bundles {
+		byID[bundle.ID] = bundle
+	}
+
+	assert.Check(t, is.Equal("compliance_manager", byID["can_manage_compliance"].Role))
+	assert.Check(t, is.Equal("Compliance Manager", byID["can_manage_compliance"].Name))
+	assert.Check(t, is.Equal("registry_manager", byID["can_manage_registry"].Role))
+}
+
+func TestCustomRoleRelation(t *testing.T) {
+	rel, err := CustomRoleRelation("can_manage_policies")
+	assert.NilError(t, err)
+	assert.Equal(t, "policy_manager", rel)
+
+	rel, err = CustomRoleRelation("can_edit_evidence")
+	assert.NilError(t, err)
+	assert.Equal(t, "can_edit_evidence", rel)
+
+	rel, err = CustomRoleRelation("can_create_control")
+	assert.NilError(t, err)
+	assert.Equal(t, "control_creator", rel)
+
+	_, err = CustomRoleRelation("owner")
+	assert.ErrorIs(t, err, ErrInvalidCustomRolePermission)
+
+	_, err = CustomRoleRelation("can_create_evidence")
+	assert.ErrorIs(t, err, ErrInvalidCustomRolePermission)
+}
+
+func TestCustomRolePermissions(t *testing.T) {
+	permissions, err := CustomRolePermissions()
+	assert.NilError(t, err)
+
+	assert.Check(t, is.Contains(permissions, "can_manage_compliance"))
+	assert.Check(t, is.Contains(permissions, "can_create_control"))
+	assert.Check(t, is.Contains(permissions, "can_edit_evidence"))
+}
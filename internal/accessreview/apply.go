@@ -0,0 +1,86 @@
+package accessreview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/apitoken"
+	"github.com/theopenlane/core/internal/ent/generated/group"
+	"github.com/theopenlane/core/internal/ent/generated/groupmembership"
+	"github.com/theopenlane/core/internal/ent/generated/organization"
+	"github.com/theopenlane/core/internal/ent/generated/orgmembership"
+	"github.com/theopenlane/core/internal/ent/generated/personalaccesstoken"
+	"github.com/theopenlane/core/internal/ent/generated/program"
+	"github.com/theopenlane/core/internal/ent/generated/programmembership"
+)
+
+// revokedReason is recorded on tokens revoked by a review
+const revokedReason = "revoked by access review"
+
+// Apply removes the access of every revoked entry not applied yet and records the outcome on the entry; a failure
+// is recorded on the entry rather than returned so one membership that can't be removed, e.g. the last owner of the
+// organization, does not block the others. The context must be allowed to remove the access of every user
+func Apply(ctx context.Context, db *generated.Client, orgID, actorID string, review *Review, now time.Time) {
+	for i := range review.Entries {
+		entry := &review.Entries[i]
+
+		if entry.Decision != DecisionRevoke || entry.AppliedAt != nil {
+			continue
+		}
+
+		if err := revoke(ctx, db, orgID, actorID, *entry, now); err != nil {
+			entry.ApplyError = err.Error()
+
+			continue
+		}
+
+		applied := now
+		entry.AppliedAt = &applied
+		entry.ApplyError = ""
+	}
+}
+
+// revoke removes the access granted by the entry; the entry ids come from the review stored on the campaign, so
+// every change is also scoped to the organization of the review rather than trusting the id alone
+func revoke(ctx context.Context, db *generated.Client, orgID, actorID string, entry Entry, now time.Time) error {
+	var err error
+
+	switch entry.Kind {
+	case KindOrgMembership:
+		err = db.OrgMembership.DeleteOneID(entry.ID).
+			Where(orgmembership.OrganizationID(orgID)).
+			Exec(ctx)
+	case KindGroupMembership:
+		err = db.GroupMembership.DeleteOneID(entry.ID).
+			Where(groupmembership.HasGroupWith(group.OwnerID(orgID))).
+			Exec(ctx)
+	case KindProgramMembership:
+		err = db.ProgramMembership.DeleteOneID(entry.ID).
+			Where(programmembership.HasProgramWith(program.OwnerID(orgID))).
+			Exec(ctx)
+	case KindAPIToken:
+		err = db.APIToken.UpdateOneID(entry.ID).
+			Where(apitoken.OwnerID(orgID)).
+			SetRevokedAt(now).
+			SetRevokedBy(actorID).
+			SetRevokedReason(revokedReason).
+			SetIsActive(false).
+			Exec(ctx)
+	case KindPersonalAccessToken:
+		// personal access tokens can be authorized for several organizations, only this one is removed
+		err = db.PersonalAccessToken.UpdateOneID(entry.ID).
+			Where(personalaccesstoken.HasOrganizationsWith(organization.ID(orgID))).
+			RemoveOrganizationIDs(orgID).
+			Exec(ctx)
+	default:
+		return fmt.Errorf("unknown access review entry kind %q", entry.Kind)
+	}
+
+	if generated.IsNotFound(err) {
+		return ErrEntryNotInOrganization
+	}
+
+	return err
+}
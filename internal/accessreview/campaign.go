@@ -0,0 +1,247 @@
+package accessreview
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/samber/lo"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/campaign"
+	"github.com/theopenlane/core/internal/ent/generated/campaigntarget"
+	"github.com/theopenlane/core/internal/ent/generated/user"
+	"github.com/theopenlane/core/internal/workflows"
+)
+
+// CreateInput is the campaign to create for a review
+type CreateInput struct {
+	// OrganizationID is the organization whose access is reviewed
+	OrganizationID string
+	// Name is the name of the campaign
+	Name string
+	// Description is the description of the campaign
+	Description string
+	// ReviewerIDs are the members of the organization assigned to review the entries
+	ReviewerIDs []string
+	// ControlIDs are the controls the signed-off review is linked to, defaults to the controls matching
+	// DefaultControlRefCodes
+	ControlIDs []string
+	// DueDate is when the review is due
+	DueDate *time.Time
+}
+
+// Create snapshots the access of the organization and creates an active campaign assigning the reviewers as its
+// users and targets; the context must be allowed to read the memberships and tokens of every user
+func Create(ctx context.Context, db *generated.Client, input CreateInput, now time.Time) (*generated.Campaign, *Review, error) {
+	if input.OrganizationID == "" {
+		return nil, nil, ErrOrganizationRequired
+	}
+
+	reviewerIDs := lo.Uniq(lo.Compact(input.ReviewerIDs))
+	if len(reviewerIDs) == 0 {
+		return nil, nil, ErrReviewersRequired
+	}
+
+	entries, err := Snapshot(ctx, db, input.OrganizationID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, id := range reviewerIDs {
+		if !slices.ContainsFunc(entries, func(e Entry) bool { return e.Kind == KindOrgMembership && e.SubjectID == id }) {
+			return nil, nil, ErrReviewerNotMember
+		}
+	}
+
+	controlIDs, err := ResolveControls(ctx, db, input.OrganizationID, input.ControlIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	review := &Review{
+		SnapshotAt:  now,
+		ReviewerIDs: reviewerIDs,
+		ControlIDs:  controlIDs,
+		Entries:     entries,
+	}
+
+	value, err := review.Encode()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	create := db.Campaign.Create().
+		SetOwnerID(input.OrganizationID).
+		SetName(input.Name).
+		SetCampaignType(enums.CampaignTypeAccessReview).
+		SetStatus(enums.CampaignStatusActive).
+		SetIsActive(true).
+		SetLaunchedAt(models.DateTime(now)).
+		SetRecipientCount(len(reviewerIDs)).
+		SetAccessReview(value).
+		AddUserIDs(reviewerIDs...)
+
+	if input.Description != "" {
+		create.SetDescription(input.Description)
+	}
+
+	if input.DueDate != nil {
+		create.SetDueDate(models.DateTime(*input.DueDate))
+	}
+
+	if len(controlIDs) > 0 {
+		create.AddControlIDs(controlIDs...)
+	}
+
+	camp, err := create.Save(WithManagedUpdate(ctx))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reviewers, err := db.User.Query().Where(user.IDIn(reviewerIDs...)).All(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builders := make([]*generated.CampaignTargetCreate, 0, len(reviewers))
+
+	for _, u := range reviewers {
+		b := db.CampaignTarget.Create().
+			SetOwnerID(input.OrganizationID).
+			SetCampaignID(camp.ID).
+			SetUserID(u.ID).
+			SetEmail(u.Email).
+			SetStatus(enums.AssessmentResponseStatusNotStarted)
+
+		if name := userName(u); name != "" {
+			b.SetFullName(name)
+		}
+
+		builders = append(builders, b)
+	}
+
+	if err := db.CampaignTarget.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return camp, review, nil
+}
+
+// Load returns the access review campaign along with its review
+func Load(ctx context.Context, db *generated.Client, campaignID string) (*generated.Campaign, *Review, error) {
+	camp, err := db.Campaign.Get(ctx, campaignID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if camp.CampaignType != enums.CampaignTypeAccessReview {
+		return nil, nil, ErrNotAccessReview
+	}
+
+	review, err := Decode(camp.AccessReview)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return camp, review, nil
+}
+
+// Decide records the decision of the reviewer on an entry of the campaign; the campaign row is locked for the
+// rest of the transaction so concurrent decisions on the same review are not lost
+func Decide(ctx context.Context, db *generated.Client, campaignID, reviewerID, entryID string, decision Decision, comment string, now time.Time) (*Review, error) {
+	return workflows.WithTx(ctx, db, nil, func(tx *generated.Tx) (*Review, error) {
+		db := tx.Client()
+
+		camp, review, err := loadForUpdate(ctx, db, campaignID)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := review.Decide(reviewerID, entryID, decision, comment, now); err != nil {
+			return nil, err
+		}
+
+		if err := save(ctx, db, camp, review); err != nil {
+			return nil, err
+		}
+
+		return review, nil
+	})
+}
+
+// Complete signs off the review of the campaign, applies the revocations, saves the review as evidence and marks
+// the campaign and its targets completed; the context must be allowed to remove the access of every user
+func Complete(ctx context.Context, db *generated.Client, campaignID, signerID string, now time.Time) (*Review, error) {
+	return workflows.WithTx(ctx, db, nil, func(tx *generated.Tx) (*Review, error) {
+		db := tx.Client()
+
+		camp, review, err := loadForUpdate(ctx, db, campaignID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := review.Complete(signerID, now); err != nil {
+			return nil, err
+		}
+
+		Apply(ctx, db, camp.OwnerID, signerID, review, now)
+
+		if err := SaveEvidence(ctx, db, camp.OwnerID, camp.Name, review); err != nil {
+			return nil, err
+		}
+
+		value, err := review.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := db.Campaign.UpdateOneID(camp.ID).
+			SetAccessReview(value).
+			SetStatus(enums.CampaignStatusCompleted).
+			SetIsActive(false).
+			SetCompletedAt(models.DateTime(now)).
+			Exec(WithManagedUpdate(ctx)); err != nil {
+			return nil, err
+		}
+
+		if err := db.CampaignTarget.Update().
+			Where(campaigntarget.CampaignID(camp.ID)).
+			SetStatus(enums.AssessmentResponseStatusCompleted).
+			SetCompletedAt(models.DateTime(now)).
+			Exec(ctx); err != nil {
+			return nil, err
+		}
+
+		return review, nil
+	})
+}
+
+// loadForUpdate loads the campaign and its review after taking a row lock on the campaign, so the review read is
+// the one the caller writes back; it must run inside a transaction
+func loadForUpdate(ctx context.Context, db *generated.Client, campaignID string) (*generated.Campaign, *Review, error) {
+	if _, err := db.Campaign.Query().
+		Where(campaign.ID(campaignID)).
+		Select(campaign.FieldID).
+		Modify(func(s *sql.Selector) { s.ForUpdate() }).
+		Strings(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	return Load(ctx, db, campaignID)
+}
+
+// save writes the review back to the campaign
+func save(ctx context.Context, db *generated.Client, camp *generated.Campaign, review *Review) error {
+	value, err := review.Encode()
+	if err != nil {
+		return err
+	}
+
+	return db.Campaign.UpdateOneID(camp.ID).
+		SetAccessReview(value).
+		Exec(WithManagedUpdate(ctx))
+}
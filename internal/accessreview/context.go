@@ -0,0 +1,19 @@
+package accessreview
+
+import "context"
+
+// managedUpdateKey marks a context as writing the review of a campaign on behalf of the access review workflow
+type managedUpdateKey struct{}
+
+// WithManagedUpdate returns a context permitted to write the access review of a campaign; it is used when the
+// review is created, when a decision is recorded and when the review is signed off
+func WithManagedUpdate(ctx context.Context) context.Context {
+	return context.WithValue(ctx, managedUpdateKey{}, true)
+}
+
+// IsManagedUpdate reports whether the context is permitted to write the access review of a campaign
+func IsManagedUpdate(ctx context.Context) bool {
+	managed, _ := ctx.Value(managedUpdateKey{}).(bool)
+
+	return managed
+}
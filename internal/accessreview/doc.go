@@ -0,0 +1,5 @@
+// Package accessreview runs access review campaigns over the access granted inside an organization. A review
+// snapshots the organization, group and program memberships along with the API tokens and personal access tokens
+// of the organization, the assigned reviewers certify or revoke each entry, and on completion the revocations are
+// applied and the signed-off review is saved as evidence linked to the access-control controls of the organization
+package accessreview
@@ -0,0 +1,32 @@
+package accessreview
+
+import "errors"
+
+var (
+	// ErrOrganizationRequired is returned when a review is created without an organization
+	ErrOrganizationRequired = errors.New("organization is required for an access review")
+	// ErrReviewersRequired is returned when a review is created without reviewers
+	ErrReviewersRequired = errors.New("at least one reviewer is required for an access review")
+	// ErrReviewerNotMember is returned when a reviewer is not a member of the organization
+	ErrReviewerNotMember = errors.New("access review reviewers must be members of the organization")
+	// ErrNotAccessReview is returned when the campaign is not an access review campaign
+	ErrNotAccessReview = errors.New("campaign is not an access review")
+	// ErrReviewMissing is returned when the campaign has no access review snapshot
+	ErrReviewMissing = errors.New("campaign has no access review snapshot")
+	// ErrReviewCompleted is returned when deciding on or completing a review that was already signed off
+	ErrReviewCompleted = errors.New("access review is already completed")
+	// ErrReviewerNotAssigned is returned when the caller is not an assigned reviewer of the review
+	ErrReviewerNotAssigned = errors.New("caller is not an assigned reviewer of the access review")
+	// ErrEntryNotFound is returned when the entry is not part of the review
+	ErrEntryNotFound = errors.New("access review entry not found")
+	// ErrInvalidDecision is returned when the decision is not CERTIFY or REVOKE
+	ErrInvalidDecision = errors.New("access review decision must be CERTIFY or REVOKE")
+	// ErrSelfReview is returned when a reviewer decides on their own access
+	ErrSelfReview = errors.New("reviewers cannot decide on their own access")
+	// ErrPendingEntries is returned when completing a review with entries left undecided
+	ErrPendingEntries = errors.New("every access review entry must be certified or revoked before completion")
+	// ErrReviewImmutable is returned when a mutation attempts to change the access review of a campaign directly
+	ErrReviewImmutable = errors.New("access review can only be changed through the access review decisions and sign-off")
+	// ErrEntryNotInOrganization is returned when the access granted by an entry is not found in the organization of the review
+	ErrEntryNotInOrganization = errors.New("access granted by the access review entry was not found in the organization of the review")
+)
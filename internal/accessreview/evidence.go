@@ -0,0 +1,130 @@
+package accessreview
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/control"
+)
+
+const (
+	// evidenceSource is the source recorded on the evidence saved from a review
+	evidenceSource = "access_review"
+	// evidenceCollectionProcedure describes how the evidence was collected
+	evidenceCollectionProcedure = "Snapshot of organization, group and program memberships, API tokens and personal access tokens " +
+		"certified or revoked by the assigned reviewers of an access review campaign; revocations were applied on completion"
+	// timeLayout is used for times written to the evidence report
+	timeLayout = time.RFC3339
+)
+
+// DefaultControlRefCodes are the ref codes of the access-control controls the review is linked to when no controls
+// are given, covering user provisioning, access reviews and removal across the common frameworks
+var DefaultControlRefCodes = []string{
+	// SOC 2
+	"CC6.1", "CC6.2", "CC6.3",
+	// ISO 27001:2022
+	"A.5.15", "A.5.18", "A.8.2",
+	// NIST 800-53
+	"AC-2", "AC-6",
+}
+
+// ResolveControls returns the controls of the organization the review is linked to; the given ids are used when
+// set, otherwise the controls matching DefaultControlRefCodes
+func ResolveControls(ctx context.Context, db *generated.Client, orgID string, controlIDs []string) ([]string, error) {
+	if len(controlIDs) > 0 {
+		return db.Control.Query().
+			Where(control.OwnerID(orgID), control.IDIn(controlIDs...)).
+			IDs(ctx)
+	}
+
+	return db.Control.Query().
+		Where(control.OwnerID(orgID), control.RefCodeIn(DefaultControlRefCodes...)).
+		IDs(ctx)
+}
+
+// SaveEvidence saves the signed-off review as evidence of the organization linked to the controls of the review
+// and records the evidence on the review
+func SaveEvidence(ctx context.Context, db *generated.Client, orgID, campaignName string, review *Review) error {
+	if !review.Completed() {
+		return ErrPendingEntries
+	}
+
+	create := db.Evidence.Create().
+		SetOwnerID(orgID).
+		SetName(fmt.Sprintf("%s sign-off", campaignName)).
+		SetDescription(Report(campaignName, review)).
+		SetCollectionProcedure(evidenceCollectionProcedure).
+		SetSource(evidenceSource).
+		SetIsAutomated(true).
+		SetStatus(enums.EvidenceStatusSubmitted).
+		SetCreationDate(models.DateTime(*review.CompletedAt))
+
+	if len(review.ControlIDs) > 0 {
+		create.AddControlIDs(review.ControlIDs...)
+	}
+
+	evidence, err := create.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	review.EvidenceID = evidence.ID
+
+	return nil
+}
+
+// Report renders the review as markdown listing every decision, the sign-off and the digest of the decisions
+func Report(campaignName string, review *Review) string {
+	var b strings.Builder
+
+	summary := review.Summary()
+
+	fmt.Fprintf(&b, "# %s\n\n", campaignName)
+	fmt.Fprintf(&b, "- Snapshot taken: %s\n", review.SnapshotAt.UTC().Format(timeLayout))
+
+	if review.Completed() {
+		fmt.Fprintf(&b, "- Signed off by: %s\n", review.CompletedBy)
+		fmt.Fprintf(&b, "- Signed off at: %s\n", review.CompletedAt.UTC().Format(timeLayout))
+	}
+
+	if review.Digest != "" {
+		fmt.Fprintf(&b, "- Digest (sha256): %s\n", review.Digest)
+	}
+
+	fmt.Fprintf(&b, "- Entries: %d, certified: %d, revoked: %d, revocations applied: %d, failed: %d\n\n",
+		summary.Total, summary.Certified, summary.Revoked, summary.Applied, summary.Failed)
+
+	b.WriteString("| Kind | Subject | Resource | Role | Decision | Reviewer | Comment |\n")
+	b.WriteString("|---|---|---|---|---|---|---|\n")
+
+	for _, e := range review.Entries {
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %s | %s | %s |\n",
+			e.Kind, cell(e.SubjectName), cell(e.ResourceName), cell(e.Role), outcome(e), cell(e.DecidedBy), cell(e.Comment))
+	}
+
+	return b.String()
+}
+
+// outcome returns the decision of the entry along with the result of applying a revocation
+func outcome(e Entry) string {
+	switch {
+	case e.Decision != DecisionRevoke:
+		return string(e.Decision)
+	case e.AppliedAt != nil:
+		return string(e.Decision) + " (applied)"
+	case e.ApplyError != "":
+		return string(e.Decision) + " (failed: " + cell(e.ApplyError) + ")"
+	}
+
+	return string(e.Decision)
+}
+
+// cell escapes a value for a markdown table cell
+func cell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,287 @@
+package accessreview
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+// Kind is the kind of access an entry grants
+type Kind string
+
+const (
+	// KindOrgMembership is a user's membership of the organization
+	KindOrgMembership Kind = "ORG_MEMBERSHIP"
+	// KindGroupMembership is a user's membership of a group of the organization
+	KindGroupMembership Kind = "GROUP_MEMBERSHIP"
+	// KindProgramMembership is a user's membership of a program of the organization
+	KindProgramMembership Kind = "PROGRAM_MEMBERSHIP"
+	// KindAPIToken is an API token owned by the organization
+	KindAPIToken Kind = "API_TOKEN"
+	// KindPersonalAccessToken is a personal access token authorized for the organization
+	KindPersonalAccessToken Kind = "PERSONAL_ACCESS_TOKEN"
+)
+
+// Decision is the outcome of the review of an entry
+type Decision string
+
+const (
+	// DecisionPending is an entry not reviewed yet
+	DecisionPending Decision = "PENDING"
+	// DecisionCertify keeps the access
+	DecisionCertify Decision = "CERTIFY"
+	// DecisionRevoke removes the access when the review is completed
+	DecisionRevoke Decision = "REVOKE"
+)
+
+// Entry is a single grant of access captured by the snapshot
+type Entry struct {
+	// ID is the id of the membership or token
+	ID string `json:"id"`
+	// Kind is the kind of access
+	Kind Kind `json:"kind"`
+	// SubjectID is the user holding the access, or the token itself for API tokens
+	SubjectID string `json:"subjectID"`
+	// SubjectName is the readable name of the subject
+	SubjectName string `json:"subjectName"`
+	// ResourceID is the organization, group or program the access is granted on
+	ResourceID string `json:"resourceID"`
+	// ResourceName is the readable name of the resource
+	ResourceName string `json:"resourceName"`
+	// Role is the membership role, empty for tokens
+	Role string `json:"role,omitempty"`
+	// Scopes are the scopes of a token
+	Scopes []string `json:"scopes,omitempty"`
+	// ExpiresAt is when a token expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// LastUsedAt is when a token was last used
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// Decision is the outcome of the review of the entry
+	Decision Decision `json:"decision"`
+	// DecidedBy is the reviewer who made the decision
+	DecidedBy string `json:"decidedBy,omitempty"`
+	// DecidedAt is when the decision was made
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	// Comment is the justification given by the reviewer
+	Comment string `json:"comment,omitempty"`
+	// AppliedAt is when a revocation was applied
+	AppliedAt *time.Time `json:"appliedAt,omitempty"`
+	// ApplyError is set when a revocation could not be applied
+	ApplyError string `json:"applyError,omitempty"`
+}
+
+// Review is the snapshot and the decisions of an access review campaign
+type Review struct {
+	// SnapshotAt is when the access was captured
+	SnapshotAt time.Time `json:"snapshotAt"`
+	// ReviewerIDs are the users assigned to review the entries
+	ReviewerIDs []string `json:"reviewerIDs"`
+	// ControlIDs are the controls the signed-off review is linked to as evidence
+	ControlIDs []string `json:"controlIDs"`
+	// Entries are the grants of access under review
+	Entries []Entry `json:"entries"`
+	// CompletedBy is the user who signed off the review
+	CompletedBy string `json:"completedBy,omitempty"`
+	// CompletedAt is when the review was signed off
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// EvidenceID is the evidence the signed-off review was saved as
+	EvidenceID string `json:"evidenceID,omitempty"`
+	// Digest is the sha256 of the decisions at sign-off, recorded on the evidence
+	Digest string `json:"digest,omitempty"`
+}
+
+// Summary counts the entries of a review by outcome
+type Summary struct {
+	// Total is the number of entries
+	Total int `json:"total"`
+	// Pending is the number of entries not reviewed yet
+	Pending int `json:"pending"`
+	// Certified is the number of entries kept
+	Certified int `json:"certified"`
+	// Revoked is the number of entries marked for revocation
+	Revoked int `json:"revoked"`
+	// Applied is the number of revocations applied
+	Applied int `json:"applied"`
+	// Failed is the number of revocations that could not be applied
+	Failed int `json:"failed"`
+}
+
+// Decode reads the review from the access review field of a campaign
+func Decode(value map[string]any) (*Review, error) {
+	if len(value) == 0 {
+		return nil, ErrReviewMissing
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	review := &Review{}
+	if err := json.Unmarshal(b, review); err != nil {
+		return nil, err
+	}
+
+	return review, nil
+}
+
+// Encode returns the review as the value of the access review field of a campaign
+func (r *Review) Encode() (map[string]any, error) {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var value map[string]any
+	if err := json.Unmarshal(b, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+// Completed reports whether the review was signed off
+func (r *Review) Completed() bool {
+	return r.CompletedAt != nil
+}
+
+// IsReviewer reports whether the user is assigned to the review
+func (r *Review) IsReviewer(userID string) bool {
+	return userID != "" && slices.Contains(r.ReviewerIDs, userID)
+}
+
+// Decide records the decision of the reviewer on the entry; deciding again replaces the earlier decision until the
+// review is completed
+func (r *Review) Decide(reviewerID, entryID string, decision Decision, comment string, now time.Time) (*Entry, error) {
+	if r.Completed() {
+		return nil, ErrReviewCompleted
+	}
+
+	if !r.IsReviewer(reviewerID) {
+		return nil, ErrReviewerNotAssigned
+	}
+
+	if decision != DecisionCertify && decision != DecisionRevoke {
+		return nil, ErrInvalidDecision
+	}
+
+	i := slices.IndexFunc(r.Entries, func(e Entry) bool { return e.ID == entryID })
+	if i < 0 {
+		return nil, ErrEntryNotFound
+	}
+
+	entry := &r.Entries[i]
+
+	// API tokens are their own subject, only user access can be self-reviewed
+	if entry.Kind != KindAPIToken && entry.SubjectID == reviewerID {
+		return nil, ErrSelfReview
+	}
+
+	entry.Decision = decision
+	entry.DecidedBy = reviewerID
+	entry.DecidedAt = &now
+	entry.Comment = comment
+
+	return entry, nil
+}
+
+// Complete signs off the review once every entry is decided and records the digest of the decisions
+func (r *Review) Complete(signerID string, now time.Time) error {
+	if r.Completed() {
+		return ErrReviewCompleted
+	}
+
+	if r.Summary().Pending > 0 {
+		return ErrPendingEntries
+	}
+
+	r.CompletedBy = signerID
+	r.CompletedAt = &now
+
+	digest, err := r.digest()
+	if err != nil {
+		return err
+	}
+
+	r.Digest = digest
+
+	return nil
+}
+
+// Summary counts the entries by outcome
+func (r *Review) Summary() Summary {
+	s := Summary{Total: len(r.Entries)}
+
+	for _, e := range r.Entries {
+		switch e.Decision {
+		case DecisionCertify:
+			s.Certified++
+		case DecisionRevoke:
+			s.Revoked++
+
+			switch {
+			case e.AppliedAt != nil:
+				s.Applied++
+			case e.ApplyError != "":
+				s.Failed++
+			}
+		default:
+			s.Pending++
+		}
+	}
+
+	return s
+}
+
+// digest hashes the decisions and the sign-off so the evidence can be matched to the review it was saved from;
+// the outcome of applying revocations is left out as it is recorded after sign-off
+func (r *Review) digest() (string, error) {
+	type decision struct {
+		ID        string     `json:"id"`
+		Kind      Kind       `json:"kind"`
+		SubjectID string     `json:"subjectID"`
+		Resource  string     `json:"resourceID"`
+		Decision  Decision   `json:"decision"`
+		DecidedBy string     `json:"decidedBy"`
+		DecidedAt *time.Time `json:"decidedAt"`
+		Comment   string     `json:"comment"`
+	}
+
+	decisions := make([]decision, 0, len(r.Entries))
+	for _, e := range r.Entries {
+		decisions = append(decisions, decision{
+			ID:        e.ID,
+			Kind:      e.Kind,
+			SubjectID: e.SubjectID,
+			Resource:  e.ResourceID,
+			Decision:  e.Decision,
+			DecidedBy: e.DecidedBy,
+			DecidedAt: e.DecidedAt,
+			Comment:   e.Comment,
+		})
+	}
+
+	slices.SortFunc(decisions, func(a, b decision) int { return cmp.Compare(a.ID, b.ID) })
+
+	b, err := json.Marshal(struct {
+		SnapshotAt  time.Time  `json:"snapshotAt"`
+		Decisions   []decision `json:"decisions"`
+		CompletedBy string     `json:"completedBy"`
+		CompletedAt *time.Time `json:"completedAt"`
+	}{
+		SnapshotAt:  r.SnapshotAt,
+		Decisions:   decisions,
+		CompletedBy: r.CompletedBy,
+		CompletedAt: r.CompletedAt,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
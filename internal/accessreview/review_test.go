@@ -0,0 +1,144 @@
+package accessreview
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testReview() *Review {
+	return &Review{
+		SnapshotAt:  time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		ReviewerIDs: []string{"reviewer"},
+		Entries: []Entry{
+			{ID: "om1", Kind: KindOrgMembership, SubjectID: "alice", SubjectName: "Alice", Role: "MEMBER", Decision: DecisionPending},
+			{ID: "om2", Kind: KindOrgMembership, SubjectID: "reviewer", SubjectName: "Reviewer", Role: "ADMIN", Decision: DecisionPending},
+			{ID: "tok1", Kind: KindAPIToken, SubjectID: "tok1", SubjectName: "ci | deploy", Decision: DecisionPending},
+		},
+	}
+}
+
+func TestDecide(t *testing.T) {
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		reviewer  string
+		entry     string
+		decision  Decision
+		completed bool
+		expectErr error
+	}{
+		{name: "certify", reviewer: "reviewer", entry: "om1", decision: DecisionCertify},
+		{name: "revoke token", reviewer: "reviewer", entry: "tok1", decision: DecisionRevoke},
+		{name: "not assigned", reviewer: "alice", entry: "tok1", decision: DecisionRevoke, expectErr: ErrReviewerNotAssigned},
+		{name: "self review", reviewer: "reviewer", entry: "om2", decision: DecisionCertify, expectErr: ErrSelfReview},
+		{name: "unknown entry", reviewer: "reviewer", entry: "missing", decision: DecisionCertify, expectErr: ErrEntryNotFound},
+		{name: "pending is not a decision", reviewer: "reviewer", entry: "om1", decision: DecisionPending, expectErr: ErrInvalidDecision},
+		{name: "completed", reviewer: "reviewer", entry: "om1", decision: DecisionCertify, completed: true, expectErr: ErrReviewCompleted},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			review := testReview()
+			if tc.completed {
+				review.CompletedAt = &now
+			}
+
+			entry, err := review.Decide(tc.reviewer, tc.entry, tc.decision, "ok", now)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.decision, entry.Decision)
+			assert.Equal(t, tc.reviewer, entry.DecidedBy)
+			assert.Equal(t, now, *entry.DecidedAt)
+		})
+	}
+}
+
+func TestComplete(t *testing.T) {
+	now := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	review := testReview()
+
+	_, err := review.Decide("reviewer", "om1", DecisionCertify, "", now)
+	require.NoError(t, err)
+
+	_, err = review.Decide("reviewer", "tok1", DecisionRevoke, "unused", now)
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, review.Complete("reviewer", now), ErrPendingEntries)
+
+	// the reviewer's own access is decided by someone else
+	review.ReviewerIDs = append(review.ReviewerIDs, "alice")
+	_, err = review.Decide("alice", "om2", DecisionCertify, "", now)
+	require.NoError(t, err)
+
+	require.NoError(t, review.Complete("reviewer", now))
+	assert.True(t, review.Completed())
+	assert.Len(t, review.Digest, 64)
+	assert.ErrorIs(t, review.Complete("reviewer", now), ErrReviewCompleted)
+
+	// the digest covers the decisions, not the outcome of applying them
+	digest := review.Digest
+	review.Entries[2].AppliedAt = &now
+	recomputed, err := review.digest()
+	require.NoError(t, err)
+	assert.Equal(t, digest, recomputed)
+
+	review.Entries[0].Comment = "changed"
+	recomputed, err = review.digest()
+	require.NoError(t, err)
+	assert.NotEqual(t, digest, recomputed)
+
+	assert.Equal(t, Summary{Total: 3, Certified: 2, Revoked: 1, Applied: 1}, review.Summary())
+}
+
+func TestEncode(t *testing.T) {
+	review := testReview()
+
+	value, err := review.Encode()
+	require.NoError(t, err)
+
+	got, err := Decode(value)
+	require.NoError(t, err)
+	assert.Equal(t, review, got)
+
+	_, err = Decode(map[string]any{})
+	assert.ErrorIs(t, err, ErrReviewMissing)
+
+	_, err = Decode(nil)
+	assert.ErrorIs(t, err, ErrReviewMissing)
+}
+
+func TestManagedUpdate(t *testing.T) {
+	ctx := context.Background()
+
+	assert.False(t, IsManagedUpdate(ctx))
+	assert.True(t, IsManagedUpdate(WithManagedUpdate(ctx)))
+}
+
+func TestReport(t *testing.T) {
+	now := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	review := testReview()
+	review.Entries[2].Decision = DecisionRevoke
+	review.Entries[2].ApplyError = "token not found"
+	review.CompletedBy = "reviewer"
+	review.CompletedAt = &now
+	review.Digest = "abc"
+
+	report := Report("Q1 access review", review)
+
+	assert.True(t, strings.HasPrefix(report, "# Q1 access review\n"))
+	assert.Contains(t, report, "- Signed off by: reviewer\n")
+	assert.Contains(t, report, "- Digest (sha256): abc\n")
+	assert.Contains(t, report, `| API_TOKEN | ci \| deploy |`)
+	assert.Contains(t, report, "REVOKE (failed: token not found)")
+}
@@ -0,0 +1,192 @@
+package accessreview
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/apitoken"
+	"github.com/theopenlane/core/internal/ent/generated/group"
+	"github.com/theopenlane/core/internal/ent/generated/groupmembership"
+	"github.com/theopenlane/core/internal/ent/generated/organization"
+	"github.com/theopenlane/core/internal/ent/generated/orgmembership"
+	"github.com/theopenlane/core/internal/ent/generated/personalaccesstoken"
+	"github.com/theopenlane/core/internal/ent/generated/program"
+	"github.com/theopenlane/core/internal/ent/generated/programmembership"
+)
+
+// Snapshot captures every grant of access of the organization; the context must be allowed to read the memberships
+// and tokens of every user. Managed groups are skipped, their members follow the organization roles
+func Snapshot(ctx context.Context, db *generated.Client, orgID string) ([]Entry, error) {
+	if orgID == "" {
+		return nil, ErrOrganizationRequired
+	}
+
+	org, err := db.Organization.Get(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+
+	orgMembers, err := db.OrgMembership.Query().
+		Where(orgmembership.OrganizationID(orgID)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range orgMembers {
+		entries = append(entries, Entry{
+			ID:           m.ID,
+			Kind:         KindOrgMembership,
+			SubjectID:    m.UserID,
+			SubjectName:  userName(m.Edges.User),
+			ResourceID:   orgID,
+			ResourceName: orgName(org),
+			Role:         m.Role.String(),
+		})
+	}
+
+	groupMembers, err := db.GroupMembership.Query().
+		Where(groupmembership.HasGroupWith(group.OwnerID(orgID), group.IsManaged(false))).
+		WithGroup().
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range groupMembers {
+		entry := Entry{
+			ID:          m.ID,
+			Kind:        KindGroupMembership,
+			SubjectID:   m.UserID,
+			SubjectName: userName(m.Edges.User),
+			ResourceID:  m.GroupID,
+			Role:        m.Role.String(),
+		}
+
+		if m.Edges.Group != nil {
+			entry.ResourceName = m.Edges.Group.Name
+		}
+
+		entries = append(entries, entry)
+	}
+
+	programMembers, err := db.ProgramMembership.Query().
+		Where(programmembership.HasProgramWith(program.OwnerID(orgID))).
+		WithProgram().
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, m := range programMembers {
+		entry := Entry{
+			ID:          m.ID,
+			Kind:        KindProgramMembership,
+			SubjectID:   m.UserID,
+			SubjectName: userName(m.Edges.User),
+			ResourceID:  m.ProgramID,
+			Role:        m.Role.String(),
+		}
+
+		if m.Edges.Program != nil {
+			entry.ResourceName = m.Edges.Program.Name
+		}
+
+		entries = append(entries, entry)
+	}
+
+	apiTokens, err := db.APIToken.Query().
+		Where(apitoken.OwnerID(orgID), apitoken.RevokedAtIsNil()).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range apiTokens {
+		entries = append(entries, Entry{
+			ID:           t.ID,
+			Kind:         KindAPIToken,
+			SubjectID:    t.ID,
+			SubjectName:  t.Name,
+			ResourceID:   orgID,
+			ResourceName: orgName(org),
+			Scopes:       t.Scopes,
+			ExpiresAt:    t.ExpiresAt,
+			LastUsedAt:   t.LastUsedAt,
+		})
+	}
+
+	pats, err := db.PersonalAccessToken.Query().
+		Where(
+			personalaccesstoken.HasOrganizationsWith(organization.ID(orgID)),
+			personalaccesstoken.RevokedAtIsNil(),
+		).
+		WithOwner().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range pats {
+		name := t.Name
+		if owner := userName(t.Edges.Owner); owner != "" {
+			name = owner + " (" + t.Name + ")"
+		}
+
+		entries = append(entries, Entry{
+			ID:           t.ID,
+			Kind:         KindPersonalAccessToken,
+			SubjectID:    t.OwnerID,
+			SubjectName:  name,
+			ResourceID:   orgID,
+			ResourceName: orgName(org),
+			Scopes:       t.Scopes,
+			ExpiresAt:    t.ExpiresAt,
+			LastUsedAt:   t.LastUsedAt,
+		})
+	}
+
+	for i := range entries {
+		entries[i].Decision = DecisionPending
+	}
+
+	slices.SortStableFunc(entries, func(a, b Entry) int {
+		return cmp.Or(cmp.Compare(a.Kind, b.Kind), cmp.Compare(a.ResourceName, b.ResourceName), cmp.Compare(a.SubjectName, b.SubjectName))
+	})
+
+	return entries, nil
+}
+
+// userName returns the display name of the user, falling back to the full name and the email
+func userName(u *generated.User) string {
+	if u == nil {
+		return ""
+	}
+
+	if u.DisplayName != "" {
+		return u.DisplayName
+	}
+
+	if name := strings.TrimSpace(u.FirstName + " " + u.LastName); name != "" {
+		return name
+	}
+
+	return u.Email
+}
+
+// orgName returns the display name of the organization, falling back to its name
+func orgName(org *generated.Organization) string {
+	if org.DisplayName != "" {
+		return org.DisplayName
+	}
+
+	return org.Name
+}
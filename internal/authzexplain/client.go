@@ -0,0 +1,68 @@
+package authzexplain
+
+import (
+	"context"
+
+	openfga "github.com/openfga/go-sdk"
+	ofgaclient "github.com/openfga/go-sdk/client"
+	"github.com/theopenlane/iam/fgax"
+)
+
+// Client is the subset of the fga api used to explain access
+type Client interface {
+	// Expand returns the userset tree of the relation on the object, e.g. program:123 and editor
+	Expand(ctx context.Context, object, relation string) (*openfga.Node, error)
+	// Check reports whether the subject of the request has the relation on the object
+	Check(ctx context.Context, req Request) (bool, error)
+	// ListRelations returns the relations the subject of the request has on the object out of the given relations
+	ListRelations(ctx context.Context, req Request, relations []string) ([]string, error)
+}
+
+// fgaClient explains access using the expand, check and list relations apis of the fgax client
+type fgaClient struct {
+	client *fgax.Client
+}
+
+// NewFGAClient returns a Client backed by the fgax client
+func NewFGAClient(c *fgax.Client) Client {
+	return &fgaClient{client: c}
+}
+
+// Expand satisfies the Client interface
+func (c *fgaClient) Expand(ctx context.Context, object, relation string) (*openfga.Node, error) {
+	resp, err := c.client.Ofga.Expand(ctx).Body(ofgaclient.ClientExpandRequest{
+		Object:   object,
+		Relation: relation,
+	}).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	if resp == nil || resp.Tree == nil {
+		return nil, nil
+	}
+
+	return resp.Tree.Root, nil
+}
+
+// Check satisfies the Client interface
+func (c *fgaClient) Check(ctx context.Context, req Request) (bool, error) {
+	return c.client.CheckAccess(ctx, fgax.AccessCheck{
+		SubjectID:   req.SubjectID,
+		SubjectType: req.SubjectType,
+		ObjectID:    req.ObjectID,
+		ObjectType:  fgax.Kind(req.ObjectType),
+		Relation:    req.Relation,
+	})
+}
+
+// ListRelations satisfies the Client interface
+func (c *fgaClient) ListRelations(ctx context.Context, req Request, relations []string) ([]string, error) {
+	return c.client.ListRelations(ctx, fgax.ListAccess{
+		SubjectID:   req.SubjectID,
+		SubjectType: req.SubjectType,
+		ObjectID:    req.ObjectID,
+		ObjectType:  fgax.Kind(req.ObjectType),
+		Relations:   relations,
+	})
+}
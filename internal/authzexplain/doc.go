@@ -0,0 +1,5 @@
+// Package authzexplain explains why a subject can or cannot access an object. The userset tree of the relation is
+// expanded through OpenFGA and walked down to the subject, so the answer is the resolution path through organization
+// roles, custom roles, group and program membership, parent inheritance and wildcard tuples; when access is denied
+// the closest missing link is returned instead, e.g. the group that would grant access if the subject were a member
+package authzexplain
@@ -0,0 +1,10 @@
+package authzexplain
+
+import "errors"
+
+var (
+	// ErrInvalidRequest is returned when the subject, object or relation of the request is missing
+	ErrInvalidRequest = errors.New("subject, object and relation are required to explain access")
+	// ErrClientRequired is returned when the explainer is created without an fga client
+	ErrClientRequired = errors.New("fga client is required to explain access")
+)
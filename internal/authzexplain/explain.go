@@ -0,0 +1,554 @@
+package authzexplain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/samber/lo"
+	"github.com/theopenlane/iam/fgax"
+
+	fgamodel "github.com/theopenlane/core/fga/model"
+)
+
+const (
+	// defaultMaxDepth is how many usersets deep the tree is followed before the walk gives up
+	defaultMaxDepth = 12
+	// defaultMaxExpansions is how many expand calls a single explanation may make
+	defaultMaxExpansions = 250
+
+	organizationType = "organization"
+	groupType        = "group"
+	programType      = "program"
+	wildcardID       = "*"
+)
+
+// Source is how a step of a resolution path grants the relation
+type Source string
+
+const (
+	// SourceDirect is a tuple granting the relation to the subject
+	SourceDirect Source = "DIRECT"
+	// SourceWildcard is a wildcard tuple granting the relation to every subject of the type, e.g. user:*
+	SourceWildcard Source = "WILDCARD"
+	// SourceOrgRole is a role of the subject in the organization
+	SourceOrgRole Source = "ORG_ROLE"
+	// SourceCustomRole is a custom role assigned to the subject
+	SourceCustomRole Source = "CUSTOM_ROLE"
+	// SourceGroupMembership is the membership of the subject in a group
+	SourceGroupMembership Source = "GROUP_MEMBERSHIP"
+	// SourceProgramMembership is the membership of the subject in a program
+	SourceProgramMembership Source = "PROGRAM_MEMBERSHIP"
+	// SourceParentInheritance is a relation inherited from the parent of the object
+	SourceParentInheritance Source = "PARENT_INHERITANCE"
+	// SourceComputed is a relation computed from another relation of the same object
+	SourceComputed Source = "COMPUTED"
+)
+
+// String returns the source as a string
+func (s Source) String() string {
+	return string(s)
+}
+
+// Request is the subject, object and relation to explain
+type Request struct {
+	// SubjectType is the type of the subject, e.g. user or service
+	SubjectType string
+	// SubjectID is the id of the subject
+	SubjectID string
+	// ObjectType is the fga type of the object, e.g. program
+	ObjectType string
+	// ObjectID is the id of the object
+	ObjectID string
+	// Relation is the relation to explain, e.g. can_edit
+	Relation string
+}
+
+// subject returns the subject in fga format, e.g. user:123
+func (r Request) subject() string {
+	return r.SubjectType + ":" + r.SubjectID
+}
+
+// object returns the object in fga format, e.g. program:123
+func (r Request) object() string {
+	return r.ObjectType + ":" + r.ObjectID
+}
+
+// validate ensures the subject, object and relation are set
+func (r Request) validate() error {
+	if r.SubjectType == "" || r.SubjectID == "" || r.ObjectType == "" || r.ObjectID == "" || r.Relation == "" {
+		return ErrInvalidRequest
+	}
+
+	return nil
+}
+
+// Step is a single link of a resolution path, the path starts at the requested object and relation and ends at
+// the tuple naming the subject
+type Step struct {
+	// Object is the object of the step in fga format, e.g. program:123
+	Object string `json:"object"`
+	// Relation is the relation on the object, e.g. editor
+	Relation string `json:"relation"`
+	// Source is how the step grants the relation
+	Source Source `json:"source"`
+	// Via is the userset or tupleset the path continues through, e.g. group:456#member or control:789#parent
+	Via string `json:"via,omitempty"`
+	// Description is a readable summary of the step
+	Description string `json:"description"`
+}
+
+// Explanation is why the subject can or cannot access the object
+type Explanation struct {
+	// Subject is the subject in fga format, e.g. user:123
+	Subject string `json:"subject"`
+	// Object is the object in fga format, e.g. program:123
+	Object string `json:"object"`
+	// Relation is the explained relation
+	Relation string `json:"relation"`
+	// Allowed is whether the subject has the relation, as answered by an fga check
+	Allowed bool `json:"allowed"`
+	// Path is the resolution path granting the relation when access is allowed
+	Path []Step `json:"path,omitempty"`
+	// MissingLink is the closest link that would grant the relation when access is denied
+	MissingLink *Step `json:"missing_link,omitempty"`
+	// GrantedRelations are the can_view, can_edit and can_delete relations and the explained relation the subject has
+	GrantedRelations []string `json:"granted_relations"`
+	// Truncated is set when the tree was too deep or wide to be fully walked
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Explainer explains access by walking the userset trees returned by the fga expand api
+type Explainer struct {
+	client        Client
+	maxDepth      int
+	maxExpansions int
+}
+
+// Option configures the Explainer
+type Option func(*Explainer)
+
+// WithMaxDepth sets how many usersets deep the tree is followed
+func WithMaxDepth(depth int) Option {
+	return func(e *Explainer) {
+		if depth > 0 {
+			e.maxDepth = depth
+		}
+	}
+}
+
+// WithMaxExpansions sets how many expand calls a single explanation may make
+func WithMaxExpansions(expansions int) Option {
+	return func(e *Explainer) {
+		if expansions > 0 {
+			e.maxExpansions = expansions
+		}
+	}
+}
+
+// New returns an Explainer using the fga client
+func New(client Client, opts ...Option) (*Explainer, error) {
+	if client == nil {
+		return nil, ErrClientRequired
+	}
+
+	e := &Explainer{
+		client:        client,
+		maxDepth:      defaultMaxDepth,
+		maxExpansions: defaultMaxExpansions,
+	}
+
+	for _, opt := range opts {
+		opt(e)
+	}
+
+	return e, nil
+}
+
+// Explain returns why the subject of the request can or cannot access the object; whether access is allowed is
+// answered by a check so conditions and exclusions are always respected, the path or missing link is found by
+// expanding the relation down to the subject
+func (e *Explainer) Explain(ctx context.Context, req Request) (*Explanation, error) {
+	if err := req.validate(); err != nil {
+		return nil, err
+	}
+
+	allowed, err := e.client.Check(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &walker{
+		client:        e.client,
+		subject:       req.subject(),
+		subjectType:   req.SubjectType,
+		maxDepth:      e.maxDepth,
+		maxExpansions: e.maxExpansions,
+		resolved:      map[string][]Step{},
+		inProgress:    map[string]bool{},
+	}
+
+	path, err := w.resolve(ctx, req.object(), req.Relation, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	relations := lo.Uniq([]string{fgax.CanView, fgax.CanEdit, fgax.CanDelete, req.Relation})
+
+	granted, err := e.client.ListRelations(ctx, req, relations)
+	if err != nil {
+		return nil, err
+	}
+
+	exp := &Explanation{
+		Subject:          req.subject(),
+		Object:           req.object(),
+		Relation:         req.Relation,
+		Allowed:          allowed,
+		GrantedRelations: granted,
+		Truncated:        w.truncated,
+	}
+
+	if allowed {
+		exp.Path = path
+	} else if w.closest != nil {
+		exp.MissingLink = &w.closest.step
+	}
+
+	return exp, nil
+}
+
+// candidate is a link that would grant the relation if it named the subject
+type candidate struct {
+	step       Step
+	depth      int
+	membership bool
+}
+
+// closerThan reports whether the candidate is a better missing link than the other; the membership of an existing
+// group, program or role is preferred over a direct tuple, and a shallower link over a deeper one
+func (c candidate) closerThan(other *candidate) bool {
+	if other == nil {
+		return true
+	}
+
+	if c.membership != other.membership {
+		return c.membership
+	}
+
+	return c.depth < other.depth
+}
+
+// walker holds the state of a single explanation
+type walker struct {
+	client        Client
+	subject       string
+	subjectType   string
+	maxDepth      int
+	maxExpansions int
+	expansions    int
+	truncated     bool
+	resolved      map[string][]Step
+	inProgress    map[string]bool
+	closest       *candidate
+}
+
+// resolve expands the relation on the object and returns the path down to the subject, nil when the relation does
+// not reach the subject
+func (w *walker) resolve(ctx context.Context, object, relation string, depth int) ([]Step, error) {
+	key := object + "#" + relation
+
+	if path, ok := w.resolved[key]; ok {
+		return path, nil
+	}
+
+	// cycles in the model, e.g. parent relations, are cut short
+	if w.inProgress[key] {
+		return nil, nil
+	}
+
+	if depth > w.maxDepth || w.expansions >= w.maxExpansions {
+		w.truncated = true
+
+		return nil, nil
+	}
+
+	w.expansions++
+	w.inProgress[key] = true
+
+	defer delete(w.inProgress, key)
+
+	root, err := w.client.Expand(ctx, object, relation)
+	if err != nil {
+		return nil, err
+	}
+
+	var path []Step
+
+	if root != nil {
+		if path, err = w.walk(ctx, object, relation, *root, depth); err != nil {
+			return nil, err
+		}
+	}
+
+	w.resolved[key] = path
+
+	return path, nil
+}
+
+// walk returns the path through a node of the userset tree
+func (w *walker) walk(ctx context.Context, object, relation string, node openfga.Node, depth int) ([]Step, error) {
+	if node.Name != "" {
+		object, relation = splitUserset(node.Name)
+	}
+
+	switch {
+	case node.Leaf != nil:
+		return w.walkLeaf(ctx, object, relation, *node.Leaf, depth)
+	case node.Union != nil:
+		for _, child := range node.Union.Nodes {
+			path, err := w.walk(ctx, object, relation, child, depth)
+			if err != nil || path != nil {
+				return path, err
+			}
+		}
+	case node.Intersection != nil:
+		var path []Step
+
+		for _, child := range node.Intersection.Nodes {
+			p, err := w.walk(ctx, object, relation, child, depth)
+			if err != nil || p == nil {
+				return nil, err
+			}
+
+			path = append(path, p...)
+		}
+
+		return path, nil
+	case node.Difference != nil:
+		base, err := w.walk(ctx, object, relation, node.Difference.Base, depth)
+		if err != nil || base == nil {
+			return nil, err
+		}
+
+		// links found while walking the exclusion would revoke access, not grant it
+		closest := w.closest
+
+		excluded, err := w.walk(ctx, object, relation, node.Difference.Subtract, depth)
+		w.closest = closest
+
+		if err != nil || excluded != nil {
+			return nil, err
+		}
+
+		return base, nil
+	}
+
+	return nil, nil
+}
+
+// walkLeaf returns the path through a leaf of the userset tree; a leaf lists the users of a direct relation, or
+// names the relation it is computed from or the tupleset it is inherited through
+func (w *walker) walkLeaf(ctx context.Context, object, relation string, leaf openfga.Leaf, depth int) ([]Step, error) {
+	switch {
+	case leaf.Users != nil:
+		return w.walkUsers(ctx, object, relation, leaf.Users.Users, depth)
+	case leaf.Computed != nil:
+		return w.follow(ctx, object, relation, leaf.Computed.Userset, "", depth)
+	case leaf.TupleToUserset != nil:
+		for _, computed := range leaf.TupleToUserset.Computed {
+			path, err := w.follow(ctx, object, relation, computed.Userset, leaf.TupleToUserset.Tupleset, depth)
+			if err != nil || path != nil {
+				return path, err
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// walkUsers returns the path through the users of a direct relation, the subject itself or a wildcard tuple of its
+// type end the path, usersets such as group:123#member are expanded in turn
+func (w *walker) walkUsers(ctx context.Context, object, relation string, users []string, depth int) ([]Step, error) {
+	objectType, _, _ := strings.Cut(object, ":")
+
+	for _, user := range users {
+		switch user {
+		case w.subject:
+			source := directSource(objectType, relation)
+
+			return []Step{{
+				Object:      object,
+				Relation:    relation,
+				Source:      source,
+				Description: fmt.Sprintf("%s is directly granted %s on %s", w.subject, relation, object),
+			}}, nil
+		case w.subjectType + ":" + wildcardID:
+			return []Step{{
+				Object:      object,
+				Relation:    relation,
+				Source:      SourceWildcard,
+				Via:         user,
+				Description: fmt.Sprintf("%s on %s is granted to every %s", relation, object, w.subjectType),
+			}}, nil
+		}
+	}
+
+	for _, user := range users {
+		target, targetRelation := splitUserset(user)
+		if targetRelation == "" {
+			continue
+		}
+
+		sub, err := w.resolve(ctx, target, targetRelation, depth+1)
+		if err != nil {
+			return nil, err
+		}
+
+		targetType, _, _ := strings.Cut(target, ":")
+		source := usersetSource(targetType)
+
+		if sub != nil {
+			step := Step{
+				Object:      object,
+				Relation:    relation,
+				Source:      source,
+				Via:         user,
+				Description: fmt.Sprintf("%s on %s is granted to %s", relation, object, user),
+			}
+
+			return append([]Step{step}, sub...), nil
+		}
+
+		w.consider(candidate{
+			depth:      depth + 1,
+			membership: true,
+			step: Step{
+				Object:      target,
+				Relation:    targetRelation,
+				Source:      source,
+				Via:         object + "#" + relation,
+				Description: fmt.Sprintf("%s is not %s of %s, which is granted %s on %s", w.subject, targetRelation, target, relation, object),
+			},
+		})
+	}
+
+	w.consider(candidate{
+		depth: depth,
+		step: Step{
+			Object:      object,
+			Relation:    relation,
+			Source:      directSource(objectType, relation),
+			Description: fmt.Sprintf("no tuple grants %s %s on %s", w.subject, relation, object),
+		},
+	})
+
+	return nil, nil
+}
+
+// follow returns the path through a relation computed from another relation of the object, or inherited from a
+// related object when the tupleset is set
+func (w *walker) follow(ctx context.Context, object, relation, userset, tupleset string, depth int) ([]Step, error) {
+	target, targetRelation := splitUserset(userset)
+	if targetRelation == "" {
+		return nil, nil
+	}
+
+	sub, err := w.resolve(ctx, target, targetRelation, depth+1)
+	if err != nil || sub == nil {
+		return nil, err
+	}
+
+	objectType, _, _ := strings.Cut(object, ":")
+
+	step := Step{
+		Object:      object,
+		Relation:    relation,
+		Source:      computedSource(objectType, targetRelation),
+		Via:         userset,
+		Description: fmt.Sprintf("%s on %s is granted by %s", relation, object, userset),
+	}
+
+	if tupleset != "" {
+		_, through := splitUserset(tupleset)
+
+		step.Source = SourceParentInheritance
+		step.Via = tupleset
+		step.Description = fmt.Sprintf("%s on %s is inherited from %s through its %s", relation, object, userset, through)
+	}
+
+	return append([]Step{step}, sub...), nil
+}
+
+// consider keeps the candidate when it is closer than the current missing link
+func (w *walker) consider(c candidate) {
+	if c.closerThan(w.closest) {
+		w.closest = &c
+	}
+}
+
+// splitUserset splits a userset such as group:123#member into its object and relation
+func splitUserset(userset string) (string, string) {
+	i := strings.LastIndex(userset, "#")
+	if i < 0 {
+		return userset, ""
+	}
+
+	return userset[:i], userset[i+1:]
+}
+
+// directSource returns the source of a tuple naming the subject directly
+func directSource(objectType, relation string) Source {
+	switch objectType {
+	case organizationType:
+		if isOrganizationRole(relation) {
+			return SourceOrgRole
+		}
+	case groupType, programType:
+		if relation == string(fgax.MemberRelation) || relation == string(fgax.AdminRelation) {
+			return usersetSource(objectType)
+		}
+	case fgamodel.CustomRoleType:
+		return SourceCustomRole
+	}
+
+	return SourceDirect
+}
+
+// usersetSource returns the source of a userset granting a relation, e.g. group:123#member
+func usersetSource(objectType string) Source {
+	switch objectType {
+	case organizationType:
+		return SourceOrgRole
+	case groupType:
+		return SourceGroupMembership
+	case programType:
+		return SourceProgramMembership
+	case fgamodel.CustomRoleType:
+		return SourceCustomRole
+	}
+
+	return SourceComputed
+}
+
+// computedSource returns the source of a relation computed from another relation of the same object
+func computedSource(objectType, relation string) Source {
+	if objectType == organizationType && isOrganizationRole(relation) {
+		return SourceOrgRole
+	}
+
+	return SourceComputed
+}
+
+// isOrganizationRole reports whether the organization relation is a role rather than a permission
+func isOrganizationRole(relation string) bool {
+	switch relation {
+	case string(fgax.OwnerRelation), string(fgax.AdminRelation), string(fgax.MemberRelation), string(fgax.AuditorRelation):
+		return true
+	}
+
+	ok, err := fgamodel.IsOrganizationRole(relation)
+
+	return err == nil && ok
+}
@@ -0,0 +1,161 @@
+package authzexplain
+
+import (
+	"context"
+	"testing"
+
+	openfga "github.com/openfga/go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClient answers expand calls from a fixed set of userset trees
+type fakeClient struct {
+	trees   map[string]openfga.Node
+	allowed bool
+	granted []string
+}
+
+func (f *fakeClient) Expand(_ context.Context, object, relation string) (*openfga.Node, error) {
+	node, ok := f.trees[object+"#"+relation]
+	if !ok {
+		return nil, nil
+	}
+
+	return &node, nil
+}
+
+func (f *fakeClient) Check(_ context.Context, _ Request) (bool, error) {
+	return f.allowed, nil
+}
+
+func (f *fakeClient) ListRelations(_ context.Context, _ Request, _ []string) ([]string, error) {
+	return f.granted, nil
+}
+
+func usersNode(name string, users ...string) openfga.Node {
+	return openfga.Node{Name: name, Leaf: &openfga.Leaf{Users: &openfga.Users{Users: users}}}
+}
+
+func computedNode(name, userset string) openfga.Node {
+	return openfga.Node{Name: name, Leaf: &openfga.Leaf{Computed: &openfga.Computed{Userset: userset}}}
+}
+
+func unionNode(name string, children ...openfga.Node) openfga.Node {
+	return openfga.Node{Name: name, Union: &openfga.Nodes{Nodes: children}}
+}
+
+func testTrees() map[string]openfga.Node {
+	return map[string]openfga.Node{
+		"program:p1#can_edit": computedNode("program:p1#can_edit", "program:p1#editor"),
+		"program:p1#editor":   usersNode("program:p1#editor", "group:g1#member"),
+		"group:g1#member":     usersNode("group:g1#member", "user:u1"),
+		"control:c1#can_view": unionNode("control:c1#can_view",
+			usersNode("control:c1#can_view", "user:*"),
+		),
+		"control:c1#can_edit": {
+			Name: "control:c1#can_edit",
+			Leaf: &openfga.Leaf{TupleToUserset: &openfga.UsersetTreeTupleToUserset{
+				Tupleset: "control:c1#owner",
+				Computed: []openfga.Computed{{Userset: "organization:o1#can_edit"}},
+			}},
+		},
+		"organization:o1#can_edit": computedNode("organization:o1#can_edit", "organization:o1#owner"),
+		"organization:o1#owner":    usersNode("organization:o1#owner", "user:u1"),
+	}
+}
+
+func TestExplain(t *testing.T) {
+	testCases := []struct {
+		name          string
+		req           Request
+		allowed       bool
+		expectSources []Source
+		expectMissing *Step
+		expectErr     error
+	}{
+		{
+			name:          "group membership",
+			req:           Request{SubjectType: "user", SubjectID: "u1", ObjectType: "program", ObjectID: "p1", Relation: "can_edit"},
+			allowed:       true,
+			expectSources: []Source{SourceComputed, SourceGroupMembership, SourceGroupMembership},
+		},
+		{
+			name:          "wildcard tuple",
+			req:           Request{SubjectType: "user", SubjectID: "u2", ObjectType: "control", ObjectID: "c1", Relation: "can_view"},
+			allowed:       true,
+			expectSources: []Source{SourceWildcard},
+		},
+		{
+			name:          "inherited from an organization role",
+			req:           Request{SubjectType: "user", SubjectID: "u1", ObjectType: "control", ObjectID: "c1", Relation: "can_edit"},
+			allowed:       true,
+			expectSources: []Source{SourceParentInheritance, SourceOrgRole, SourceOrgRole},
+		},
+		{
+			name:    "denied, missing group membership",
+			req:     Request{SubjectType: "user", SubjectID: "u2", ObjectType: "program", ObjectID: "p1", Relation: "can_edit"},
+			allowed: false,
+			expectMissing: &Step{
+				Object:   "group:g1",
+				Relation: "member",
+				Source:   SourceGroupMembership,
+				Via:      "program:p1#editor",
+			},
+		},
+		{
+			name:      "missing relation",
+			req:       Request{SubjectType: "user", SubjectID: "u1", ObjectType: "program", ObjectID: "p1"},
+			expectErr: ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			explainer, err := New(&fakeClient{trees: testTrees(), allowed: tc.allowed})
+			require.NoError(t, err)
+
+			exp, err := explainer.Explain(context.Background(), tc.req)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.allowed, exp.Allowed)
+			assert.False(t, exp.Truncated)
+
+			sources := make([]Source, 0, len(exp.Path))
+			for _, step := range exp.Path {
+				sources = append(sources, step.Source)
+			}
+
+			assert.Equal(t, tc.expectSources, sources)
+
+			if tc.expectMissing == nil {
+				assert.Nil(t, exp.MissingLink)
+				return
+			}
+
+			require.NotNil(t, exp.MissingLink)
+			assert.Equal(t, tc.expectMissing.Object, exp.MissingLink.Object)
+			assert.Equal(t, tc.expectMissing.Relation, exp.MissingLink.Relation)
+			assert.Equal(t, tc.expectMissing.Source, exp.MissingLink.Source)
+			assert.Equal(t, tc.expectMissing.Via, exp.MissingLink.Via)
+		})
+	}
+}
+
+func TestExplainTruncated(t *testing.T) {
+	explainer, err := New(&fakeClient{trees: testTrees(), allowed: true}, WithMaxDepth(1))
+	require.NoError(t, err)
+
+	exp, err := explainer.Explain(context.Background(), Request{
+		SubjectType: "user", SubjectID: "u1", ObjectType: "program", ObjectID: "p1", Relation: "can_edit",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, exp.Allowed)
+	assert.True(t, exp.Truncated)
+	assert.Empty(t, exp.Path)
+}
@@ -1095,6 +1095,10 @@ var CSVReferenceRegistry = map[string]CSVSchemaInfo{
 		SchemaName: "CustomDomain",
 		Rules:      []CSVReferenceRule{},
 	},
+	"CustomRole": {
+		SchemaName: "CustomRole",
+		Rules:      []CSVReferenceRule{},
+	},
 	"CustomTypeEnum": {
 		SchemaName: "CustomTypeEnum",
 		Rules:      []CSVReferenceRule{},
@@ -1581,6 +1585,10 @@ var CSVReferenceRegistry = map[string]CSVSchemaInfo{
 			},
 		},
 	},
+	"RetentionRule": {
+		SchemaName: "RetentionRule",
+		Rules:      []CSVReferenceRule{},
+	},
 	"Review": {
 		SchemaName: "Review",
 		Rules: []CSVReferenceRule{
@@ -2269,6 +2277,24 @@ type CustomDomainCSVUpdateInput struct {
 // CSVInputWrapper marks CustomDomainCSVUpdateInput for CSV header preprocessing.
 func (CustomDomainCSVUpdateInput) CSVInputWrapper() {}
 
+// CustomRoleCSVInput wraps CreateCustomRoleInput with CSV reference columns.
+type CustomRoleCSVInput struct {
+	Input generated.CreateCustomRoleInput
+}
+
+// CSVInputWrapper marks CustomRoleCSVInput for CSV header preprocessing.
+func (CustomRoleCSVInput) CSVInputWrapper() {}
+
+// CustomRoleCSVUpdateInput wraps UpdateCustomRoleInput with CSV reference columns for bulk updates.
+type CustomRoleCSVUpdateInput struct {
+	// ID is the entity ID to update
+	ID    string `csv:"ID"`
+	Input generated.UpdateCustomRoleInput
+}
+
+// CSVInputWrapper marks CustomRoleCSVUpdateInput for CSV header preprocessing.
+func (CustomRoleCSVUpdateInput) CSVInputWrapper() {}
+
 // CustomTypeEnumCSVInput wraps CreateCustomTypeEnumInput with CSV reference columns.
 type CustomTypeEnumCSVInput struct {
 	Input generated.CreateCustomTypeEnumInput
@@ -3143,6 +3169,24 @@ type RemediationCSVUpdateInput struct {
 // CSVInputWrapper marks RemediationCSVUpdateInput for CSV header preprocessing.
 func (RemediationCSVUpdateInput) CSVInputWrapper() {}
 
+// RetentionRuleCSVInput wraps CreateRetentionRuleInput with CSV reference columns.
+type RetentionRuleCSVInput struct {
+	Input generated.CreateRetentionRuleInput
+}
+
+// CSVInputWrapper marks RetentionRuleCSVInput for CSV header preprocessing.
+func (RetentionRuleCSVInput) CSVInputWrapper() {}
+
+// RetentionRuleCSVUpdateInput wraps UpdateRetentionRuleInput with CSV reference columns for bulk updates.
+type RetentionRuleCSVUpdateInput struct {
+	// ID is the entity ID to update
+	ID    string `csv:"ID"`
+	Input generated.UpdateRetentionRuleInput
+}
+
+// CSVInputWrapper marks RetentionRuleCSVUpdateInput for CSV header preprocessing.
+func (RetentionRuleCSVUpdateInput) CSVInputWrapper() {}
+
 // ReviewCSVInput wraps CreateReviewInput with CSV reference columns.
 type ReviewCSVInput struct {
 	Input           generated.CreateReviewInput
@@ -1590,6 +1590,28 @@ var (
 			return data, nil
 		},
 	}
+	SchemaCustomRole = &Schema{
+		SchemaDescriptor: SchemaDescriptor{
+			Name:  "CustomRole",
+			Snake: "custom_role",
+			Lower: "customrole",
+		},
+		Load: func(ctx context.Context, client *generated.Client, entityID string) (json.RawMessage, error) {
+			ref := SchemaRef{Schema: "custom_role", Operation: refOpLoad, EntityID: entityID}
+
+			entity, err := client.CustomRole.Get(ctx, entityID)
+			if err != nil {
+				return nil, logError(ctx, ref, ErrLoadFailed, err)
+			}
+
+			data, err := json.Marshal(entity)
+			if err != nil {
+				return nil, logError(ctx, ref, ErrMarshalFailed, err)
+			}
+
+			return data, nil
+		},
+	}
 	SchemaCustomTypeEnum = &Schema{
 		SchemaDescriptor: SchemaDescriptor{
 			Name:  "CustomTypeEnum",
@@ -3861,6 +3883,28 @@ var (
 			return entity, nil
 		},
 	}
+	SchemaRetentionRule = &Schema{
+		SchemaDescriptor: SchemaDescriptor{
+			Name:  "RetentionRule",
+			Snake: "retention_rule",
+			Lower: "retentionrule",
+		},
+		Load: func(ctx context.Context, client *generated.Client, entityID string) (json.RawMessage, error) {
+			ref := SchemaRef{Schema: "retention_rule", Operation: refOpLoad, EntityID: entityID}
+
+			entity, err := client.RetentionRule.Get(ctx, entityID)
+			if err != nil {
+				return nil, logError(ctx, ref, ErrLoadFailed, err)
+			}
+
+			data, err := json.Marshal(entity)
+			if err != nil {
+				return nil, logError(ctx, ref, ErrMarshalFailed, err)
+			}
+
+			return data, nil
+		},
+	}
 	SchemaReview = &Schema{
 		SchemaDescriptor: SchemaDescriptor{
 			Name:  "Review",
@@ -5524,6 +5568,21 @@ func init() {
 		{Name: "updated_by", Label: "UpdatedBy", Type: "string", MatchKey: true, Clearable: true},
 		{Name: "updated_by_impersonator", Label: "UpdatedByImpersonator", Type: "string", MatchKey: true, Clearable: true},
 	}
+	SchemaCustomRole.Fields = []FieldDescriptor{
+		{Name: "created_at", Label: "CreatedAt", Type: "time.Time", Clearable: true},
+		{Name: "created_by", Label: "CreatedBy", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "deleted_at", Label: "DeletedAt", Type: "time.Time", Clearable: true},
+		{Name: "deleted_by", Label: "DeletedBy", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "description", Label: "Description", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "group_ids", Label: "GroupIds", Type: "[]string", Clearable: true},
+		{Name: "name", Label: "Name", Type: "string", MatchKey: true},
+		{Name: "owner_id", Label: "OwnerID", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "permissions", Label: "Permissions", Type: "[]string"},
+		{Name: "program_ids", Label: "ProgramIds", Type: "[]string", Clearable: true},
+		{Name: "updated_at", Label: "UpdatedAt", Type: "time.Time", Clearable: true},
+		{Name: "updated_by", Label: "UpdatedBy", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "updated_by_impersonator", Label: "UpdatedByImpersonator", Type: "string", MatchKey: true, Clearable: true},
+	}
 	SchemaCustomTypeEnum.Fields = []FieldDescriptor{
 		{Name: "color", Label: "Color", Type: "string", MatchKey: true, Clearable: true},
 		{Name: "created_at", Label: "CreatedAt", Type: "time.Time", Clearable: true},
@@ -6968,6 +7027,22 @@ func init() {
 		{Name: "updated_by_impersonator", Label: "UpdatedByImpersonator", Type: "string", MatchKey: true, Clearable: true},
 		{Name: "workflow_eligible_marker", Label: "WorkflowEligibleMarker", Type: "bool", Clearable: true},
 	}
+	SchemaRetentionRule.Fields = []FieldDescriptor{
+		{Name: "category", Label: "Category", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "created_at", Label: "CreatedAt", Type: "time.Time", Clearable: true},
+		{Name: "created_by", Label: "CreatedBy", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "deleted_at", Label: "DeletedAt", Type: "time.Time", Clearable: true},
+		{Name: "deleted_by", Label: "DeletedBy", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "description", Label: "Description", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "name", Label: "Name", Type: "string", MatchKey: true},
+		{Name: "object_type", Label: "ObjectType", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "owner_id", Label: "OwnerID", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "purge", Label: "Purge", Type: "bool"},
+		{Name: "retention_days", Label: "RetentionDays", Type: "int"},
+		{Name: "updated_at", Label: "UpdatedAt", Type: "time.Time", Clearable: true},
+		{Name: "updated_by", Label: "UpdatedBy", Type: "string", MatchKey: true, Clearable: true},
+		{Name: "updated_by_impersonator", Label: "UpdatedByImpersonator", Type: "string", MatchKey: true, Clearable: true},
+	}
 	SchemaReview.Fields = []FieldDescriptor{
 		{Name: "approved", Label: "Approved", Type: "bool", Clearable: true},
 		{Name: "approved_at", Label: "ApprovedAt", Type: "models.DateTime", Clearable: true},
@@ -9377,6 +9452,17 @@ func init() {
 			Field:       "owner_id",
 		},
 	}
+	SchemaCustomRole.Edges = []EdgeDescriptor{
+		{
+			Name:        "owner",
+			Label:       "Owner",
+			Target:      SchemaOrganization,
+			TargetType:  "Organization",
+			Unique:      true,
+			CreateField: "owner_id",
+			Field:       "owner_id",
+		},
+	}
 	SchemaCustomTypeEnum.Edges = []EdgeDescriptor{
 		{
 			Name:        "action_plans",
@@ -13333,6 +13419,14 @@ func init() {
 			CreateField: "custom_domain_ids",
 			AddField:    "add_custom_domain_ids",
 		},
+		{
+			Name:        "custom_roles",
+			Label:       "CustomRoles",
+			Target:      SchemaCustomRole,
+			TargetType:  "CustomRole",
+			CreateField: "custom_role_ids",
+			AddField:    "add_custom_role_ids",
+		},
 		{
 			Name:        "custom_type_enum_creators",
 			Label:       "CustomTypeEnumCreators",
@@ -14014,6 +14108,14 @@ func init() {
 			CreateField: "remediation_ids",
 			AddField:    "add_remediation_ids",
 		},
+		{
+			Name:        "retention_rules",
+			Label:       "RetentionRules",
+			Target:      SchemaRetentionRule,
+			TargetType:  "RetentionRule",
+			CreateField: "retention_rule_ids",
+			AddField:    "add_retention_rule_ids",
+		},
 		{
 			Name:        "review_creators",
 			Label:       "ReviewCreators",
@@ -15533,6 +15635,17 @@ func init() {
 			AddField:    "add_workflow_object_ref_ids",
 		},
 	}
+	SchemaRetentionRule.Edges = []EdgeDescriptor{
+		{
+			Name:        "owner",
+			Label:       "Owner",
+			Target:      SchemaOrganization,
+			TargetType:  "Organization",
+			Unique:      true,
+			CreateField: "owner_id",
+			Field:       "owner_id",
+		},
+	}
 	SchemaReview.Edges = []EdgeDescriptor{
 		{
 			Name:        "action_plans",
@@ -20035,6 +20148,7 @@ var allSchemas = []*Schema{
 	SchemaControlImplementation,
 	SchemaControlObjective,
 	SchemaCustomDomain,
+	SchemaCustomRole,
 	SchemaCustomTypeEnum,
 	SchemaDNSVerification,
 	SchemaDirectoryAccount,
@@ -20092,6 +20206,7 @@ var allSchemas = []*Schema{
 	SchemaProgram,
 	SchemaProgramMembership,
 	SchemaRemediation,
+	SchemaRetentionRule,
 	SchemaReview,
 	SchemaRisk,
 	SchemaSLADefinition,
@@ -0,0 +1,297 @@
+package fulltext
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/actionplan"
+	"github.com/theopenlane/core/internal/ent/generated/contact"
+	"github.com/theopenlane/core/internal/ent/generated/entity"
+	"github.com/theopenlane/core/internal/ent/generated/evidence"
+	"github.com/theopenlane/core/internal/ent/generated/file"
+	"github.com/theopenlane/core/internal/ent/generated/identityholder"
+	"github.com/theopenlane/core/internal/ent/generated/internalpolicy"
+	"github.com/theopenlane/core/internal/ent/generated/platform"
+	"github.com/theopenlane/core/internal/ent/generated/procedure"
+	"github.com/theopenlane/core/internal/ent/generated/program"
+	"github.com/theopenlane/core/internal/ent/generated/review"
+	"github.com/theopenlane/core/internal/ent/generated/scan"
+	"github.com/theopenlane/core/internal/ent/generated/template"
+)
+
+const (
+	// DocumentTable holds the extracted text of uploaded files along with its search vector; it is created by the
+	// db migrations and its rows are removed with their file, or by the document content listener when the file is
+	// soft deleted
+	DocumentTable = "file_search_documents"
+	// documentFileColumn is the column of the document table referencing the file
+	documentFileColumn = "file_id"
+)
+
+// documentIndex is the GIN index on the search vector of the document table
+const documentIndex = DocumentTable + "_" + VectorColumn + "_idx"
+
+// Document is the extracted text of an uploaded file
+type Document struct {
+	// FileID is the id of the file the text was extracted from
+	FileID string
+	// Checksum is the checksum of the file contents the text was extracted from, used to skip unchanged files
+	Checksum string
+	// Content is the extracted text
+	Content string
+}
+
+// documentParent is an object type files are attached to; document matches are returned as matches of the
+// parent objects the caller can read
+type documentParent struct {
+	// Type is the ent type name of the parent
+	Type string
+	// Table is the table linking the parent to its files; empty when the parent holds the file id itself
+	Table string
+	// ParentColumn is the column of the link table referencing the parent
+	ParentColumn string
+	// FileColumn is the column referencing the file, on the link table or on the parent
+	FileColumn string
+	// scan runs a query against the parent type with the modifier applied and scans the rows into v
+	scan func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error
+}
+
+// documentParents are the object types whose attached files are included in search
+var documentParents = []documentParent{
+	{
+		Type:       "ActionPlan",
+		FileColumn: actionplan.FieldFileID,
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.ActionPlan.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:       "InternalPolicy",
+		FileColumn: internalpolicy.FieldFileID,
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.InternalPolicy.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:       "Procedure",
+		FileColumn: procedure.FieldFileID,
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Procedure.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Contact",
+		Table:        contact.FilesTable,
+		ParentColumn: contact.FilesPrimaryKey[0],
+		FileColumn:   contact.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Contact.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Entity",
+		Table:        entity.FilesTable,
+		ParentColumn: entity.FilesPrimaryKey[0],
+		FileColumn:   entity.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Entity.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Evidence",
+		Table:        evidence.FilesTable,
+		ParentColumn: evidence.FilesPrimaryKey[0],
+		FileColumn:   evidence.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Evidence.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "IdentityHolder",
+		Table:        identityholder.FilesTable,
+		ParentColumn: identityholder.FilesPrimaryKey[0],
+		FileColumn:   identityholder.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.IdentityHolder.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Platform",
+		Table:        platform.FilesTable,
+		ParentColumn: platform.FilesPrimaryKey[0],
+		FileColumn:   platform.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Platform.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Program",
+		Table:        program.FilesTable,
+		ParentColumn: program.FilesPrimaryKey[0],
+		FileColumn:   program.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Program.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Review",
+		Table:        review.FilesTable,
+		ParentColumn: review.FilesColumn,
+		FileColumn:   file.FieldID,
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Review.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Scan",
+		Table:        scan.FilesTable,
+		ParentColumn: scan.FilesPrimaryKey[0],
+		FileColumn:   scan.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Scan.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+	{
+		Type:         "Template",
+		Table:        template.FilesTable,
+		ParentColumn: template.FilesPrimaryKey[0],
+		FileColumn:   template.FilesPrimaryKey[1],
+		scan: func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error {
+			return c.Template.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+}
+
+// lookupDocumentParent returns the document parent of the entity type
+func lookupDocumentParent(typ string) (documentParent, bool) {
+	for _, p := range documentParents {
+		if p.Type == typ {
+			return p, true
+		}
+	}
+
+	return documentParent{}, false
+}
+
+// IndexDocument stores the extracted text of a file, replacing any text indexed for an earlier version
+func IndexDocument(ctx context.Context, client *ent.Client, doc Document) error {
+	return client.Driver().Exec(ctx, fmt.Sprintf(
+		`INSERT INTO %[1]s (%[2]s, checksum, content, indexed_at) VALUES ($1, $2, $3, now())
+		ON CONFLICT (%[2]s) DO UPDATE SET checksum = excluded.checksum, content = excluded.content, indexed_at = excluded.indexed_at`,
+		quote(DocumentTable), quote(documentFileColumn),
+	), []any{doc.FileID, doc.Checksum, doc.Content}, nil)
+}
+
+// RemoveDocument removes the indexed text of a file
+func RemoveDocument(ctx context.Context, client *ent.Client, fileID string) error {
+	return client.Driver().Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE %s = $1", quote(DocumentTable), quote(documentFileColumn)),
+		[]any{fileID}, nil)
+}
+
+// DocumentChecksum returns the checksum of the file contents last indexed for the file
+func DocumentChecksum(ctx context.Context, client *ent.Client, fileID string) (string, bool, error) {
+	var rows entsql.Rows
+
+	if err := client.Driver().Query(ctx, fmt.Sprintf("SELECT checksum FROM %s WHERE %s = $1", quote(DocumentTable), quote(documentFileColumn)),
+		[]any{fileID}, &rows); err != nil {
+		return "", false, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return "", false, rows.Err()
+	}
+
+	var checksum sql.NullString
+	if err := rows.Scan(&checksum); err != nil {
+		return "", false, err
+	}
+
+	return checksum.String, true, rows.Err()
+}
+
+// documentMatch is a file whose extracted text matches a query
+type documentMatch struct {
+	ID   string  `json:"id"`
+	Rank float64 `json:"rank"`
+}
+
+// rankDocuments returns the best matching files; the files are queried through the ent client so the file
+// interceptors and privacy rules still apply
+func rankDocuments(ctx context.Context, client *ent.Client, tsquery string, limit int) ([]documentMatch, error) {
+	var matches []documentMatch
+
+	err := client.File.Query().Modify(func(s *entsql.Selector) {
+		d := entsql.Table(DocumentTable)
+		vector := d.C(VectorColumn)
+
+		s.Join(d).On(s.C(file.FieldID), d.C(documentFileColumn)).
+			Select(s.C(file.FieldID)).
+			AppendSelectExprAs(rankExpr(vector, tsquery), "rank").
+			Where(entsql.P(func(b *entsql.Builder) {
+				b.Ident(vector).WriteString(" @@ ")
+				writeQuery(b, tsquery)
+			})).
+			OrderExpr(entsql.DescExpr(rankExpr(vector, tsquery))).
+			Limit(limit)
+	}).Scan(ctx, &matches)
+
+	return matches, err
+}
+
+// parentMatches returns the objects of the parent type the matched files are attached to, ranked by the
+// best matching file of each object
+func (p documentParent) parentMatches(ctx context.Context, client *ent.Client, matches []documentMatch) ([]Result, error) {
+	fileIDs := make([]string, 0, len(matches))
+	ranks := make(map[string]float64, len(matches))
+
+	for _, m := range matches {
+		fileIDs = append(fileIDs, m.ID)
+		ranks[m.ID] = m.Rank
+	}
+
+	var rows []struct {
+		ID     string `json:"id"`
+		FileID string `json:"file_id"`
+	}
+
+	err := p.scan(ctx, client, func(s *entsql.Selector) {
+		if p.Table == "" {
+			s.Select(s.C("id")).
+				AppendSelectAs(s.C(p.FileColumn), "file_id").
+				Where(entsql.InValues(s.C(p.FileColumn), toAny(fileIDs)...))
+
+			return
+		}
+
+		t := entsql.Table(p.Table)
+
+		s.Join(t).On(s.C("id"), t.C(p.ParentColumn)).
+			Select(s.C("id")).
+			AppendSelectAs(t.C(p.FileColumn), "file_id").
+			Where(entsql.InValues(t.C(p.FileColumn), toAny(fileIDs)...))
+	}, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	best := make(map[string]Result, len(rows))
+
+	for _, row := range rows {
+		if cur, ok := best[row.ID]; !ok || ranks[row.FileID] > cur.Rank {
+			best[row.ID] = Result{Type: p.Type, ID: row.ID, Rank: ranks[row.FileID], FileID: row.FileID}
+		}
+	}
+
+	results := make([]Result, 0, len(best))
+	for _, r := range best {
+		results = append(results, r)
+	}
+
+	return results, nil
+}
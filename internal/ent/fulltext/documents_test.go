@@ -0,0 +1,54 @@
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/internal/ent/generated/migrate"
+)
+
+func TestDocumentParentsMatchSchema(t *testing.T) {
+	tables := map[string]map[string]bool{}
+
+	for _, table := range migrate.Tables {
+		columns := map[string]bool{}
+		for _, c := range table.Columns {
+			columns[c.Name] = true
+		}
+
+		tables[table.Name] = columns
+	}
+
+	for _, p := range documentParents {
+		idx, ok := Lookup(p.Type)
+		require.True(t, ok, "document parent %s is not indexed for search", p.Type)
+		require.NotNil(t, p.scan, "document parent %s has no query", p.Type)
+
+		if p.Table == "" {
+			assert.True(t, tables[idx.Table][p.FileColumn], "column %s.%s does not exist", idx.Table, p.FileColumn)
+
+			continue
+		}
+
+		columns, ok := tables[p.Table]
+		require.True(t, ok, "table %s does not exist", p.Table)
+		assert.True(t, columns[p.ParentColumn], "column %s.%s does not exist", p.Table, p.ParentColumn)
+		assert.True(t, columns[p.FileColumn], "column %s.%s does not exist", p.Table, p.FileColumn)
+	}
+}
+
+func TestMergeResults(t *testing.T) {
+	results := mergeResults([]Result{
+		{Type: "Evidence", ID: "1", Rank: 0.1},
+		{Type: "Program", ID: "1", Rank: 0.2},
+		{Type: "Evidence", ID: "1", Rank: 0.4, FileID: "f1"},
+		{Type: "Program", ID: "1", Rank: 0.1, FileID: "f2"},
+	})
+
+	assert.Equal(t, []Result{
+		{Type: "Evidence", ID: "1", Rank: 0.4, FileID: "f1"},
+		{Type: "Program", ID: "1", Rank: 0.2},
+	}, results)
+}
@@ -0,0 +1,237 @@
+// Package fulltext provides Postgres full-text search over the entities included in global search. Each indexed
+// table gets a generated tsvector column and a GIN index built from its searchable fields, which replaces the
+// per-field ILIKE scans with ranked, index backed matches
+package fulltext
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	ent "github.com/theopenlane/core/internal/ent/generated"
+)
+
+const (
+	// Config is the text search configuration used for every search vector and query
+	Config = "english"
+	// VectorColumn is the generated column holding the search vector of each indexed table
+	VectorColumn = "search_vector"
+	// fingerprintPrefix marks the column comment recording which vector expression built the column
+	fingerprintPrefix = "fulltext:"
+)
+
+// Weight is the rank weight given to matches in a column; matches in A columns rank highest
+type Weight string
+
+const (
+	// WeightA is used for names, titles and identifiers
+	WeightA Weight = "A"
+	// WeightB is used for tags, aliases and categories
+	WeightB Weight = "B"
+	// WeightC is used for descriptions and other long form text
+	WeightC Weight = "C"
+	// WeightD is the lowest weight
+	WeightD Weight = "D"
+)
+
+// Kind is the storage kind of an indexed column
+type Kind int
+
+const (
+	// KindText is a text column
+	KindText Kind = iota
+	// KindArray is a jsonb array of strings, such as tags and aliases
+	KindArray
+)
+
+// Column is a searchable column of an indexed table
+type Column struct {
+	// Field is the name of the field on the ent type, used as the matched field in search context
+	Field string
+	// Name is the database column name
+	Name string
+	// Kind is the storage kind of the column
+	Kind Kind
+	// Weight is the rank weight of matches in the column
+	Weight Weight
+}
+
+// Index is an entity type included in full-text search
+type Index struct {
+	// Type is the ent type name, such as Control
+	Type string
+	// Table is the database table of the type
+	Table string
+	// Columns are the searchable columns that make up the search vector
+	Columns []Column
+	// scan runs a query against the type with the modifier applied and scans the rows into v; the query
+	// goes through the ent client so privacy rules and interceptors still apply
+	scan func(ctx context.Context, c *ent.Client, modify func(*entsql.Selector), v any) error
+}
+
+// ErrUnknownType is returned when a search references a type that is not indexed
+var ErrUnknownType = errors.New("type is not indexed for full-text search")
+
+// Lookup returns the index of the entity type
+func Lookup(typ string) (Index, bool) {
+	for _, idx := range Indexes {
+		if idx.Type == typ {
+			return idx, true
+		}
+	}
+
+	return Index{}, false
+}
+
+// vector returns the weighted tsvector expression of the column
+func (c Column) vector() string {
+	return fmt.Sprintf("setweight(%s, '%s')", c.tsvector(quote(c.Name)), c.Weight)
+}
+
+// tsvector returns the unweighted tsvector expression of the column referenced by ref
+func (c Column) tsvector(ref string) string {
+	if c.Kind == KindArray {
+		return fmt.Sprintf(`jsonb_to_tsvector('%s'::regconfig, coalesce(%s, '[]'::jsonb), '["string"]')`, Config, ref)
+	}
+
+	return fmt.Sprintf("to_tsvector('%s'::regconfig, coalesce(%s::text, ''))", Config, ref)
+}
+
+// document returns the plain text of the column referenced by ref, used to build snippets
+func (c Column) document(ref string) string {
+	if c.Kind == KindArray {
+		return fmt.Sprintf(`coalesce((SELECT string_agg(elem, ', ') FROM jsonb_array_elements_text(CASE WHEN jsonb_typeof(%s) = 'array' THEN %s ELSE '[]'::jsonb END) AS elem), '')`, ref, ref)
+	}
+
+	return fmt.Sprintf("coalesce(%s::text, '')", ref)
+}
+
+// VectorExpr returns the expression of the generated search vector column
+func (i Index) VectorExpr() string {
+	parts := make([]string, 0, len(i.Columns))
+	for _, c := range i.Columns {
+		parts = append(parts, c.vector())
+	}
+
+	return strings.Join(parts, " || ")
+}
+
+// IndexName returns the name of the GIN index on the search vector column
+func (i Index) IndexName() string {
+	return i.Table + "_" + VectorColumn + "_idx"
+}
+
+// Fingerprint identifies the vector expression so the column is rebuilt when the indexed columns change
+func (i Index) Fingerprint() string {
+	sum := sha256.Sum256([]byte(i.VectorExpr()))
+
+	return fingerprintPrefix + hex.EncodeToString(sum[:8])
+}
+
+// Statements returns the DDL creating the search vector column, its GIN index and the fingerprint comment
+func (i Index) Statements() []string {
+	table := quote(i.Table)
+	column := quote(VectorColumn)
+
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN %s tsvector GENERATED ALWAYS AS (%s) STORED", table, column, i.VectorExpr()),
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (%s)", quote(i.IndexName()), table, column),
+		fmt.Sprintf("COMMENT ON COLUMN %s.%s IS '%s'", table, column, i.Fingerprint()),
+	}
+}
+
+// Reindex rebuilds the search indexes of the given types, or of every indexed type and the document table when
+// no types are given, and returns the tables it reindexed. The GIN indexes are rebuilt concurrently so searches
+// keep working; with regenerate the search vector columns are dropped and generated again instead, which
+// recomputes the vector of every row and locks each table while it runs
+func Reindex(ctx context.Context, db *sql.DB, types []string, regenerate bool) ([]string, error) {
+	indexes, err := selectIndexes(types)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make([]string, 0, len(indexes)+1)
+
+	for _, idx := range indexes {
+		if regenerate {
+			err = rebuildIndex(ctx, db, idx)
+		} else {
+			_, err = db.ExecContext(ctx, reindexStatement(idx.IndexName()))
+		}
+
+		if err != nil {
+			return tables, fmt.Errorf("search index for %s: %w", idx.Table, err)
+		}
+
+		tables = append(tables, idx.Table)
+	}
+
+	if len(types) > 0 {
+		return tables, nil
+	}
+
+	if _, err := db.ExecContext(ctx, reindexStatement(documentIndex)); err != nil {
+		return tables, fmt.Errorf("search index for %s: %w", DocumentTable, err)
+	}
+
+	return append(tables, DocumentTable), nil
+}
+
+// selectIndexes returns the indexes of the types, or every index when no types are given
+func selectIndexes(types []string) ([]Index, error) {
+	if len(types) == 0 {
+		return Indexes, nil
+	}
+
+	indexes := make([]Index, 0, len(types))
+
+	for _, typ := range types {
+		idx, ok := Lookup(typ)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownType, typ)
+		}
+
+		indexes = append(indexes, idx)
+	}
+
+	return indexes, nil
+}
+
+// reindexStatement returns the statement rebuilding the index without blocking writes to its table
+func reindexStatement(name string) string {
+	return "REINDEX INDEX CONCURRENTLY " + quote(name)
+}
+
+// rebuildIndex drops and recreates the search vector column of a single table along with its index
+func rebuildIndex(ctx context.Context, db *sql.DB, idx Index) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback() //nolint:errcheck
+
+	// dropping the column also drops its index
+	if _, err := tx.ExecContext(ctx, fmt.Sprintf("ALTER TABLE %s DROP COLUMN IF EXISTS %s", quote(idx.Table), quote(VectorColumn))); err != nil {
+		return err
+	}
+
+	for _, stmt := range idx.Statements() {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+// quote returns the quoted postgres identifier
+func quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
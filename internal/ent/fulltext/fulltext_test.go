@@ -0,0 +1,171 @@
+package fulltext
+
+import (
+	"io/fs"
+	"regexp"
+	"strings"
+	"testing"
+
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	migratedb "github.com/theopenlane/core/db"
+	"github.com/theopenlane/core/internal/ent/generated/migrate"
+)
+
+func TestIndexesMatchSchema(t *testing.T) {
+	tables := map[string]map[string]field.Type{}
+
+	for _, table := range migrate.Tables {
+		columns := map[string]field.Type{}
+		for _, c := range table.Columns {
+			columns[c.Name] = c.Type
+		}
+
+		tables[table.Name] = columns
+	}
+
+	seen := map[string]bool{}
+
+	for _, idx := range Indexes {
+		assert.False(t, seen[idx.Type], "type %s is indexed twice", idx.Type)
+		seen[idx.Type] = true
+
+		columns, ok := tables[idx.Table]
+		require.True(t, ok, "table %s does not exist", idx.Table)
+		require.NotEmpty(t, idx.Columns, "type %s has no searchable columns", idx.Type)
+		require.NotNil(t, idx.scan, "type %s has no query", idx.Type)
+
+		for _, c := range idx.Columns {
+			typ, ok := columns[c.Name]
+			require.True(t, ok, "column %s.%s does not exist", idx.Table, c.Name)
+
+			if c.Kind == KindArray {
+				assert.Equal(t, field.TypeJSON, typ, "column %s.%s is not a json array", idx.Table, c.Name)
+			} else {
+				assert.Equal(t, field.TypeString, typ, "column %s.%s is not text", idx.Table, c.Name)
+			}
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	idx, ok := Lookup("Control")
+	require.True(t, ok)
+	assert.Equal(t, "controls", idx.Table)
+
+	_, ok = Lookup("Unknown")
+	assert.False(t, ok)
+}
+
+func TestSelectIndexes(t *testing.T) {
+	all, err := selectIndexes(nil)
+	require.NoError(t, err)
+	assert.Len(t, all, len(Indexes))
+
+	selected, err := selectIndexes([]string{"Risk", "Control"})
+	require.NoError(t, err)
+	require.Len(t, selected, 2)
+	assert.Equal(t, "risks", selected[0].Table)
+	assert.Equal(t, "controls", selected[1].Table)
+
+	_, err = selectIndexes([]string{"Control", "Unknown"})
+	assert.ErrorIs(t, err, ErrUnknownType)
+
+	assert.Equal(t, `REINDEX INDEX CONCURRENTLY "controls_search_vector_idx"`, reindexStatement(selected[1].IndexName()))
+}
+
+func TestIndexStatements(t *testing.T) {
+	idx := Index{
+		Type:  "Risk",
+		Table: "risks",
+		Columns: []Column{
+			{Field: "Name", Name: "name", Weight: WeightA},
+			{Field: "Tags", Name: "tags", Kind: KindArray, Weight: WeightB},
+		},
+	}
+
+	assert.Equal(t,
+		`setweight(to_tsvector('english'::regconfig, coalesce("name"::text, '')), 'A') || `+
+			`setweight(jsonb_to_tsvector('english'::regconfig, coalesce("tags", '[]'::jsonb), '["string"]'), 'B')`,
+		idx.VectorExpr())
+
+	stmts := idx.Statements()
+	require.Len(t, stmts, 3)
+	assert.True(t, strings.HasPrefix(stmts[0], `ALTER TABLE "risks" ADD COLUMN "search_vector" tsvector GENERATED ALWAYS AS (`))
+	assert.Equal(t, `CREATE INDEX IF NOT EXISTS "risks_search_vector_idx" ON "risks" USING GIN ("search_vector")`, stmts[1])
+	assert.Equal(t, `COMMENT ON COLUMN "risks"."search_vector" IS '`+idx.Fingerprint()+`'`, stmts[2])
+
+	changed := idx
+	changed.Columns = idx.Columns[:1]
+	assert.NotEqual(t, idx.Fingerprint(), changed.Fingerprint(), "the fingerprint changes with the indexed columns")
+}
+
+// searchVectorComment matches the statement recording the fingerprint of a search vector column
+var searchVectorComment = regexp.MustCompile(`COMMENT ON COLUMN "([^"]+)"\."search_vector" IS '([^']+)'`)
+
+func TestIndexesMatchMigrations(t *testing.T) {
+	files, err := fs.Glob(migratedb.GooseMigrationsPG, "migrations-goose-postgres/*.sql")
+	require.NoError(t, err)
+
+	// the last migration commenting a search vector column built the current one; files sort by version
+	fingerprints := map[string]string{}
+
+	for _, name := range files {
+		contents, err := fs.ReadFile(migratedb.GooseMigrationsPG, name)
+		require.NoError(t, err)
+
+		up, _, _ := strings.Cut(string(contents), "-- +goose Down")
+
+		for _, m := range searchVectorComment.FindAllStringSubmatch(up, -1) {
+			fingerprints[m[1]] = m[2]
+		}
+	}
+
+	for _, idx := range Indexes {
+		assert.Equal(t, idx.Fingerprint(), fingerprints[idx.Table],
+			"the search vector of %s is out of date, add a migration with the statements of its index", idx.Table)
+	}
+}
+
+func TestPreserveSearchVectors(t *testing.T) {
+	idx := Indexes[0]
+
+	vectorDrops := &atlas.ModifyTable{
+		T: atlas.NewTable(idx.Table),
+		Changes: []atlas.Change{
+			&atlas.DropColumn{C: atlas.NewColumn(VectorColumn)},
+			&atlas.DropIndex{I: atlas.NewIndex(idx.IndexName())},
+		},
+	}
+
+	otherDrops := &atlas.ModifyTable{
+		T: atlas.NewTable(idx.Table),
+		Changes: []atlas.Change{
+			&atlas.DropColumn{C: atlas.NewColumn(VectorColumn)},
+			&atlas.DropColumn{C: atlas.NewColumn("legacy")},
+			&atlas.DropIndex{I: atlas.NewIndex("legacy_idx")},
+		},
+	}
+
+	addTable := &atlas.AddTable{T: atlas.NewTable("widgets")}
+
+	differ := PreserveSearchVectors(schema.DiffFunc(func(_, _ *atlas.Schema) ([]atlas.Change, error) {
+		return []atlas.Change{vectorDrops, otherDrops, addTable}, nil
+	}))
+
+	changes, err := differ.Diff(nil, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+
+	modify, ok := changes[0].(*atlas.ModifyTable)
+	require.True(t, ok)
+	require.Len(t, modify.Changes, 2)
+	assert.Equal(t, "legacy", modify.Changes[0].(*atlas.DropColumn).C.Name)
+	assert.Equal(t, "legacy_idx", modify.Changes[1].(*atlas.DropIndex).I.Name)
+
+	assert.Equal(t, addTable, changes[1])
+}
@@ -0,0 +1,72 @@
+package fulltext
+
+import (
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql/schema"
+)
+
+// PreserveSearchVectors is a migration diff hook removing the drops of the search vector columns and their GIN
+// indexes from the planned changes; the columns are created by a versioned migration rather than the ent schema,
+// so a diff that drops deleted columns and indexes would otherwise remove them
+func PreserveSearchVectors(next schema.Differ) schema.Differ {
+	return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+		changes, err := next.Diff(current, desired)
+		if err != nil {
+			return nil, err
+		}
+
+		return withoutSearchVectorDrops(changes), nil
+	})
+}
+
+// withoutSearchVectorDrops returns the changes without the drops of search vector columns and indexes, leaving
+// out table modifications that have nothing else to change
+func withoutSearchVectorDrops(changes []atlas.Change) []atlas.Change {
+	kept := make([]atlas.Change, 0, len(changes))
+
+	for _, change := range changes {
+		modify, ok := change.(*atlas.ModifyTable)
+		if !ok {
+			kept = append(kept, change)
+
+			continue
+		}
+
+		tableChanges := make([]atlas.Change, 0, len(modify.Changes))
+
+		for _, c := range modify.Changes {
+			switch c := c.(type) {
+			case *atlas.DropColumn:
+				if c.C.Name == VectorColumn {
+					continue
+				}
+			case *atlas.DropIndex:
+				if isVectorIndex(modify.T.Name, c.I.Name) {
+					continue
+				}
+			}
+
+			tableChanges = append(tableChanges, c)
+		}
+
+		if len(tableChanges) == 0 {
+			continue
+		}
+
+		modify.Changes = tableChanges
+		kept = append(kept, modify)
+	}
+
+	return kept
+}
+
+// isVectorIndex reports whether the index is the GIN index on the search vector column of the table
+func isVectorIndex(table, name string) bool {
+	for _, idx := range Indexes {
+		if idx.Table == table && idx.IndexName() == name {
+			return true
+		}
+	}
+
+	return false
+}
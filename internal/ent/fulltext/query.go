@@ -0,0 +1,82 @@
+package fulltext
+
+import (
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ParseQuery converts a search query into a tsquery. Quoted text is matched as a phrase, every other word
+// is matched as a prefix, and all terms must match; characters with meaning in tsquery syntax are dropped
+// so user input can never produce an invalid query. An empty string is returned when nothing is searchable
+func ParseQuery(query string) string {
+	var terms []string
+
+	for i, part := range strings.Split(query, `"`) {
+		// odd parts sit between a pair of quotes
+		if i%2 == 1 {
+			if words := tokenize(part); len(words) > 0 {
+				terms = append(terms, phrase(words))
+			}
+
+			continue
+		}
+
+		for _, word := range tokenize(part) {
+			terms = append(terms, word+":*")
+		}
+	}
+
+	return strings.Join(terms, " & ")
+}
+
+// phrase joins the words so they must appear next to each other, in order
+func phrase(words []string) string {
+	if len(words) == 1 {
+		return words[0]
+	}
+
+	return "(" + strings.Join(words, " <-> ") + ")"
+}
+
+// tokenize splits the text into lower cased words made of letters and digits
+func tokenize(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// Match returns a predicate matching rows whose search vector matches the query; it matches nothing when the
+// query has no searchable terms. The predicate applies to any indexed type, for example control.Or(control.ID(q), fulltext.Match(q))
+func Match(query string) func(*sql.Selector) {
+	tsquery := ParseQuery(query)
+
+	return func(s *sql.Selector) {
+		if tsquery == "" {
+			s.Where(sql.False())
+
+			return
+		}
+
+		s.Where(sql.P(func(b *sql.Builder) {
+			b.Ident(s.C(VectorColumn)).WriteString(" @@ ")
+			writeQuery(b, tsquery)
+		}))
+	}
+}
+
+// rankExpr returns the relevance of the search vector for the query, using cover density so terms close to each
+// other rank higher
+func rankExpr(vector, tsquery string) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("ts_rank_cd(").Ident(vector).Comma()
+		writeQuery(b, tsquery)
+		b.WriteByte(')')
+	})
+}
+
+// writeQuery writes the tsquery with the query text as an argument
+func writeQuery(b *sql.Builder, tsquery string) {
+	b.WriteString("to_tsquery('" + Config + "', ").Arg(tsquery).WriteByte(')')
+}
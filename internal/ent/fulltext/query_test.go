@@ -0,0 +1,67 @@
+package fulltext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{
+			name:  "single word is a prefix",
+			query: "encrypt",
+			want:  "encrypt:*",
+		},
+		{
+			name:  "words must all match",
+			query: "Access Review",
+			want:  "access:* & review:*",
+		},
+		{
+			name:  "quoted text is a phrase",
+			query: `"incident response" plan`,
+			want:  "(incident <-> response) & plan:*",
+		},
+		{
+			name:  "quoted single word is exact",
+			query: `"soc"`,
+			want:  "soc",
+		},
+		{
+			name:  "unterminated quote is a phrase",
+			query: `policy "data retention`,
+			want:  "policy:* & (data <-> retention)",
+		},
+		{
+			name:  "tsquery syntax is dropped",
+			query: "a1cd2d & !(x:*) | 'y'",
+			want:  "a1cd2d:* & x:* & y:*",
+		},
+		{
+			name:  "punctuation splits words",
+			query: "CC6.1",
+			want:  "cc6:* & 1:*",
+		},
+		{
+			name:  "non ascii letters are kept",
+			query: "Übersicht",
+			want:  "übersicht:*",
+		},
+		{
+			name:  "nothing searchable",
+			query: `"" &|!`,
+			want:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ParseQuery(tc.query))
+		})
+	}
+}
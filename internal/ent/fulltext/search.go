@@ -0,0 +1,292 @@
+package fulltext
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+
+	"entgo.io/ent/dialect/sql"
+
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+)
+
+const (
+	// DefaultLimit is the number of results returned by a ranked search when no limit is set
+	DefaultLimit = 25
+	// MaxLimit is the maximum number of results returned by a ranked search
+	MaxLimit = 100
+	// headlineOptions keeps snippets short and centered on the matched terms
+	headlineOptions = `MaxWords=20, MinWords=8, MaxFragments=2, FragmentDelimiter=" ... "`
+)
+
+// Result is a single ranked search match
+type Result struct {
+	// Type is the ent type of the match
+	Type string `json:"type"`
+	// ID is the id of the matched entity
+	ID string `json:"id"`
+	// Rank is the relevance of the match; results are ordered by rank across all types
+	Rank float64 `json:"rank"`
+	// FileID is the id of the attached file whose contents matched, when the match came from a document
+	FileID string `json:"file_id,omitempty"`
+}
+
+// Options configures a ranked search
+type Options struct {
+	// Types limits the search to the listed types; every indexed type is searched when empty
+	Types []string
+	// Limit is the maximum number of results returned
+	Limit int
+}
+
+// Snippet is the matched content of a single field, highlighted by ts_headline
+type Snippet struct {
+	// Field is the name of the matched field on the ent type
+	Field string
+	// Text is the fragment of the field around the matched terms, with matches wrapped in <b> tags
+	Text string
+}
+
+// Search runs the query against every indexed type and the extracted text of the files attached to them, and
+// returns the best matches merged by rank. Each type is queried through the ent client so only entities the
+// caller can read are returned; types the caller is not allowed to query are skipped
+func Search(ctx context.Context, client *ent.Client, query string, opts Options) ([]Result, error) {
+	tsquery := ParseQuery(query)
+	if tsquery == "" {
+		return nil, nil
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+
+	limit = min(limit, MaxLimit)
+
+	indexes := Indexes
+	parents := documentParents
+
+	if len(opts.Types) > 0 {
+		indexes = make([]Index, 0, len(opts.Types))
+		parents = nil
+
+		for _, typ := range opts.Types {
+			idx, ok := Lookup(typ)
+			if !ok {
+				return nil, ErrUnknownType
+			}
+
+			indexes = append(indexes, idx)
+
+			if p, ok := lookupDocumentParent(typ); ok {
+				parents = append(parents, p)
+			}
+		}
+	}
+
+	// the types are queried one after another because the client may be bound to a single transaction
+	var results []Result
+
+	for _, idx := range indexes {
+		matches, err := idx.rank(ctx, client, tsquery, limit)
+		if err != nil {
+			if errors.Is(err, privacy.Deny) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		results = append(results, matches...)
+	}
+
+	matches, err := searchDocuments(ctx, client, tsquery, parents)
+	if err != nil {
+		return nil, err
+	}
+
+	results = mergeResults(append(results, matches...))
+
+	slices.SortFunc(results, func(a, b Result) int {
+		return cmp.Or(cmp.Compare(b.Rank, a.Rank), cmp.Compare(a.Type, b.Type), cmp.Compare(a.ID, b.ID))
+	})
+
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+// searchDocuments returns the objects of the parent types whose attached files match the query; files are
+// only matched through parents the caller can read
+func searchDocuments(ctx context.Context, client *ent.Client, tsquery string, parents []documentParent) ([]Result, error) {
+	if len(parents) == 0 {
+		return nil, nil
+	}
+
+	// a file can be attached to several objects, so candidates are not limited to the requested result count
+	files, err := rankDocuments(ctx, client, tsquery, MaxLimit)
+	if err != nil {
+		if errors.Is(err, privacy.Deny) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	var results []Result
+
+	for _, p := range parents {
+		matches, err := p.parentMatches(ctx, client, files)
+		if err != nil {
+			if errors.Is(err, privacy.Deny) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		results = append(results, matches...)
+	}
+
+	return results, nil
+}
+
+// mergeResults keeps the highest ranked result of each object matched by both its own fields and its documents
+func mergeResults(results []Result) []Result {
+	best := make(map[[2]string]int, len(results))
+	out := results[:0]
+
+	for _, r := range results {
+		key := [2]string{r.Type, r.ID}
+
+		i, ok := best[key]
+		if !ok {
+			best[key] = len(out)
+			out = append(out, r)
+
+			continue
+		}
+
+		if r.Rank > out[i].Rank {
+			out[i] = r
+		}
+	}
+
+	return out
+}
+
+// rank returns the best matches of a single type
+func (i Index) rank(ctx context.Context, client *ent.Client, tsquery string, limit int) ([]Result, error) {
+	var rows []struct {
+		ID   string  `json:"id"`
+		Rank float64 `json:"rank"`
+	}
+
+	err := i.scan(ctx, client, func(s *sql.Selector) {
+		s.Select(s.C("id")).
+			AppendSelectExprAs(rankExpr(s.C(VectorColumn), tsquery), "rank").
+			Where(sql.P(func(b *sql.Builder) {
+				b.Ident(s.C(VectorColumn)).WriteString(" @@ ")
+				writeQuery(b, tsquery)
+			})).
+			OrderExpr(sql.DescExpr(rankExpr(s.C(VectorColumn), tsquery))).
+			Limit(limit)
+	}, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]Result, 0, len(rows))
+	for _, row := range rows {
+		results = append(results, Result{Type: i.Type, ID: row.ID, Rank: row.Rank})
+	}
+
+	return results, nil
+}
+
+// Headlines returns the snippets of the fields matching the query for each of the entities, keyed by entity id;
+// entities with no matching field are left out
+func Headlines(ctx context.Context, client *ent.Client, typ, query string, ids []string) (map[string][]Snippet, error) {
+	idx, ok := Lookup(typ)
+	if !ok {
+		return nil, ErrUnknownType
+	}
+
+	tsquery := ParseQuery(query)
+	if tsquery == "" || len(ids) == 0 {
+		return map[string][]Snippet{}, nil
+	}
+
+	var rows []struct {
+		ID        string `json:"id"`
+		Headlines []byte `json:"headlines"`
+	}
+
+	err := idx.scan(ctx, client, func(s *sql.Selector) {
+		s.Select(s.C("id")).
+			AppendSelectExprAs(idx.headlineExpr(s, tsquery), "headlines").
+			Where(sql.InValues(s.C("id"), toAny(ids)...))
+	}, &rows)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string][]Snippet, len(rows))
+
+	for _, row := range rows {
+		var fields map[string]string
+		if err := json.Unmarshal(row.Headlines, &fields); err != nil {
+			return nil, err
+		}
+
+		for _, c := range idx.Columns {
+			if text, ok := fields[c.Name]; ok && text != "" {
+				out[row.ID] = append(out[row.ID], Snippet{Field: c.Field, Text: text})
+			}
+		}
+	}
+
+	return out, nil
+}
+
+// headlineExpr builds a json object holding the ts_headline of every column matching the query
+func (i Index) headlineExpr(s *sql.Selector, tsquery string) sql.Querier {
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.WriteString("jsonb_strip_nulls(jsonb_build_object(")
+
+		for n, c := range i.Columns {
+			if n > 0 {
+				b.Comma()
+			}
+
+			ref := s.C(c.Name)
+
+			b.WriteString("'" + c.Name + "', CASE WHEN " + c.tsvector(ref) + " @@ ")
+			writeQuery(b, tsquery)
+			b.WriteString(" THEN ts_headline('" + Config + "', " + c.document(ref) + ", ")
+			writeQuery(b, tsquery)
+			b.Comma().Arg(headlineOptions).WriteString(") END")
+		}
+
+		b.WriteString("))")
+	})
+}
+
+// toAny converts the ids into predicate arguments
+func toAny(ids []string) []any {
+	out := make([]any, 0, len(ids))
+	for _, id := range ids {
+		out = append(out, id)
+	}
+
+	return out
+}
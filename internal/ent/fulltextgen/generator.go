@@ -0,0 +1,237 @@
+package fulltextgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"text/template"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"golang.org/x/tools/imports"
+
+	"github.com/theopenlane/entx"
+)
+
+// exactMatchFields are searchable fields searched by equality rather than through the full-text index
+var exactMatchFields = []string{"id", "display_id"}
+
+// weightA columns hold names, titles and identifiers, which rank highest
+var weightA = []string{
+	"cve_id", "display_name", "email", "full_name", "key", "name", "recipient", "ref_code", "short_name", "slug",
+	"system_name", "target", "title",
+}
+
+// weightB columns hold tags, aliases, categories and other short labels
+var weightB = []string{
+	"aliases", "category", "definition_slug", "domains", "external_id", "framework", "governing_body",
+	"internal_owner", "kind", "mapped_categories", "object_type", "package_name", "severity", "subcategory", "tags",
+	"url",
+}
+
+// Generator emits the full-text search indexes built from the fields marked searchable in the ent schema.
+type Generator struct {
+	SchemaPath string
+	OutputPath string
+	Package    string
+}
+
+// New creates a new full-text index generator.
+func New(schemaPath, outputPath string) *Generator {
+	return &Generator{
+		SchemaPath: schemaPath,
+		OutputPath: outputPath,
+		Package:    "fulltext",
+	}
+}
+
+// Hook allows the generator to run in the post-generation hook pipeline.
+func (g *Generator) Hook() gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(graph *gen.Graph) error {
+			if err := next.Generate(graph); err != nil {
+				return err
+			}
+
+			return g.generateFromGraph(graph)
+		})
+	}
+}
+
+const generatedFileTemplate = `// Code generated by fulltextgen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+
+	ent "{{ .EntPackage }}"
+{{- range .Entries }}
+	"{{ $.EntPackage }}/{{ .Package }}"
+{{- end }}
+)
+
+// Indexes are the entity types included in global search and the columns indexed for each, built from the
+// fields marked searchable in the ent schema; a change to them needs a migration rebuilding the search vector
+var Indexes = []Index{
+{{- range $e := .Entries }}
+	{
+		Type:  "{{ $e.Type }}",
+		Table: {{ $e.Package }}.Table,
+		Columns: []Column{
+{{- range $e.Columns }}
+			{Field: "{{ .Field }}", Name: {{ $e.Package }}.{{ .Constant }}{{ if .Array }}, Kind: KindArray{{ end }}, Weight: Weight{{ .Weight }}},
+{{- end }}
+		},
+		scan: func(ctx context.Context, c *ent.Client, modify func(*sql.Selector), v any) error {
+			return c.{{ $e.Type }}.Query().Modify(modify).Scan(ctx, v)
+		},
+	},
+{{- end }}
+}
+`
+
+type column struct {
+	Field    string
+	Constant string
+	Array    bool
+	Weight   string
+}
+
+type entry struct {
+	Type    string
+	Package string
+	Columns []column
+}
+
+// searchAnnotation holds the parts of the entx search annotation the generator reads
+type searchAnnotation struct {
+	Searchable bool
+	JSONPath   string
+}
+
+// Generate reads the searchable schema fields and writes the generated index file.
+func (g *Generator) Generate(flags ...string) error {
+	graph, err := entc.LoadGraph(g.SchemaPath, &gen.Config{
+		BuildFlags: flags,
+	})
+	if err != nil {
+		return fmt.Errorf("loading graph: %w", err)
+	}
+
+	return g.generateFromGraph(graph)
+}
+
+func (g *Generator) generateFromGraph(graph *gen.Graph) error {
+	entries := make([]entry, 0)
+	annotationName := entx.FieldSearchable().Name()
+
+	for _, node := range graph.Nodes {
+		var columns []column
+
+		for _, f := range node.Fields {
+			raw, ok := f.Annotations[annotationName]
+			if !ok || slices.Contains(exactMatchFields, f.StorageKey()) {
+				continue
+			}
+
+			var decoded searchAnnotation
+			if err := decodeAnnotation(raw, &decoded); err != nil {
+				return fmt.Errorf("decoding search annotation of %s.%s: %w", node.Name, f.Name, err)
+			}
+
+			// fields searched by a json path keep their own predicate
+			if !decoded.Searchable || decoded.JSONPath != "" {
+				continue
+			}
+
+			columns = append(columns, column{
+				Field:    f.StructField(),
+				Constant: f.Constant(),
+				Array:    f.Type != nil && f.Type.Type == field.TypeJSON,
+				Weight:   weight(f.StorageKey()),
+			})
+		}
+
+		if len(columns) == 0 {
+			continue
+		}
+
+		sort.Slice(columns, func(i, j int) bool {
+			return columns[i].Field < columns[j].Field
+		})
+
+		entries = append(entries, entry{
+			Type:    node.Name,
+			Package: node.Package(),
+			Columns: columns,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Type < entries[j].Type
+	})
+
+	tmpl, err := template.New("generated").Parse(generatedFileTemplate)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(g.OutputPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		Package    string
+		EntPackage string
+		Entries    []entry
+	}{
+		Package:    g.Package,
+		EntPackage: graph.Config.Package,
+		Entries:    entries,
+	}); err != nil {
+		return err
+	}
+
+	formatted, err := imports.Process(g.OutputPath, buf.Bytes(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to format file", err)
+	}
+
+	if _, err := file.Write(formatted); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// decodeAnnotation decodes an annotation loaded with the graph into v
+func decodeAnnotation(raw any, v any) error {
+	encoded, err := json.Marshal(raw)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(encoded, v)
+}
+
+// weight returns the rank weight of matches in the column
+func weight(column string) string {
+	switch {
+	case slices.Contains(weightA, column):
+		return "A"
+	case slices.Contains(weightB, column):
+		return "B"
+	default:
+		return "C"
+	}
+}
@@ -0,0 +1,144 @@
+package fulltextgen
+
+import (
+	"bytes"
+	"go/format"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/99designs/gqlgen/codegen"
+)
+
+const (
+	// fullTextImport is the import of the package providing the full-text match predicate
+	fullTextImport = `"github.com/theopenlane/core/internal/ent/fulltext"`
+	// matchPredicate replaces the per field predicates of the searchable fields in the generated search
+	matchPredicate = "fulltext.Match(query), // search by the full-text index of the searchable fields"
+)
+
+var (
+	// searchFunc matches the start of a generated search function; the admin search functions keep their per
+	// field predicates
+	searchFunc = regexp.MustCompile(`^func search\w+\(`)
+	// orOpen matches the start of the predicates combined by a search
+	orOpen = regexp.MustCompile(`^(\t+)\w+\.Or\($`)
+	// containsFold matches the predicate of a searchable text field
+	containsFold = regexp.MustCompile(`^\w+\.\w+ContainsFold\(query\),`)
+)
+
+// SearchPlugin is a gqlgen plugin that runs after the search plugin and replaces the per field predicates the
+// search functions generate for the searchable fields with the full-text match those fields are indexed for.
+type SearchPlugin struct {
+	// FileName is the name of the generated search file in the resolver directory
+	FileName string
+}
+
+// NewSearchPlugin creates a new full-text search plugin.
+func NewSearchPlugin() *SearchPlugin {
+	return &SearchPlugin{FileName: "search.go"}
+}
+
+// Name returns the name of the plugin
+func (p *SearchPlugin) Name() string {
+	return "fulltextsearch"
+}
+
+// GenerateCode rewrites the search file generated by the search plugin
+func (p *SearchPlugin) GenerateCode(data *codegen.Data) error {
+	path := filepath.Join(data.Config.Resolver.Dir(), p.FileName)
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	rewritten, err := RewriteSearch(src)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, rewritten, 0o600)
+}
+
+// RewriteSearch replaces the contains and like predicates of the search functions with the full-text match;
+// the exact id matches and the json path predicates are kept. Rewriting a file twice leaves it unchanged
+func RewriteSearch(src []byte) ([]byte, error) {
+	lines := strings.Split(string(src), "\n")
+	out := make([]string, 0, len(lines))
+
+	inSearch := false
+	matched := false
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		switch {
+		case strings.HasPrefix(line, "func "):
+			inSearch = searchFunc.MatchString(line)
+		case line == `	"github.com/theopenlane/core/internal/ent/generated"` && !bytes.Contains(src, []byte(fullTextImport)):
+			out = append(out, "\t"+fullTextImport)
+		}
+
+		m := orOpen.FindStringSubmatch(line)
+		if !inSearch || m == nil {
+			out = append(out, line)
+
+			continue
+		}
+
+		out = append(out, line)
+
+		itemIndent := m[1] + "\t"
+		closing := m[1] + "),"
+		replaced := false
+
+		for i++; i < len(lines) && lines[i] != closing; i++ {
+			item := strings.TrimPrefix(lines[i], itemIndent)
+
+			// multi line predicates are closures; like scans are replaced, json path predicates are kept
+			if strings.HasPrefix(item, "func(") {
+				end := i
+				for end < len(lines) && lines[end] != itemIndent+"}," {
+					end++
+				}
+
+				block := lines[i : end+1]
+				if strings.Contains(strings.Join(block, "\n"), " LIKE $") {
+					replaced = true
+				} else {
+					out = append(out, block...)
+				}
+
+				i = end
+
+				continue
+			}
+
+			if containsFold.MatchString(item) {
+				replaced = true
+
+				continue
+			}
+
+			out = append(out, lines[i])
+		}
+
+		if replaced {
+			out = append(out, itemIndent+matchPredicate)
+			matched = true
+		}
+
+		if i < len(lines) {
+			out = append(out, lines[i])
+		}
+	}
+
+	rewritten := []byte(strings.Join(out, "\n"))
+	if !matched && !bytes.Contains(src, []byte("fulltext.Match(")) {
+		return src, nil
+	}
+
+	return format.Source(rewritten)
+}
@@ -0,0 +1,95 @@
+package fulltextgen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const generatedSearch = `package graphapi
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/control"
+)
+
+func searchControls(ctx context.Context, query string) ([]*generated.Control, error) {
+	return withTransactionalMutation(ctx).Control.Query().
+		Where(
+			control.Or(
+				control.DisplayID(query),         // search equal to DisplayID
+				control.ID(query),                // search equal to ID
+				control.TitleContainsFold(query), // search by Title
+				func(s *sql.Selector) {
+					likeQuery := "%" + query + "%"
+					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
+				},
+				func(s *sql.Selector) {
+					s.Where(sqljson.StringContains(control.FieldMetadata, query, sqljson.Path("$id"))) // search by Metadata at $id
+				},
+			),
+		).All(ctx)
+}
+
+func adminSearchControls(ctx context.Context, query string) ([]*generated.Control, error) {
+	return withTransactionalMutation(ctx).Control.Query().
+		Where(
+			control.Or(
+				control.ID(query),                // search equal to ID
+				control.TitleContainsFold(query), // search by Title
+			),
+		).All(ctx)
+}
+`
+
+const rewrittenSearch = `package graphapi
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/theopenlane/core/internal/ent/fulltext"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/control"
+)
+
+func searchControls(ctx context.Context, query string) ([]*generated.Control, error) {
+	return withTransactionalMutation(ctx).Control.Query().
+		Where(
+			control.Or(
+				control.DisplayID(query), // search equal to DisplayID
+				control.ID(query),        // search equal to ID
+				func(s *sql.Selector) {
+					s.Where(sqljson.StringContains(control.FieldMetadata, query, sqljson.Path("$id"))) // search by Metadata at $id
+				},
+				fulltext.Match(query), // search by the full-text index of the searchable fields
+			),
+		).All(ctx)
+}
+
+func adminSearchControls(ctx context.Context, query string) ([]*generated.Control, error) {
+	return withTransactionalMutation(ctx).Control.Query().
+		Where(
+			control.Or(
+				control.ID(query),                // search equal to ID
+				control.TitleContainsFold(query), // search by Title
+			),
+		).All(ctx)
+}
+`
+
+func TestRewriteSearch(t *testing.T) {
+	rewritten, err := RewriteSearch([]byte(generatedSearch))
+	require.NoError(t, err)
+	assert.Equal(t, rewrittenSearch, string(rewritten))
+
+	again, err := RewriteSearch(rewritten)
+	require.NoError(t, err)
+	assert.Equal(t, rewrittenSearch, string(again))
+}
@@ -21,7 +21,9 @@ import (
 	"github.com/theopenlane/core/common/enums/exportenums"
 	"github.com/theopenlane/core/internal/ent/entconfig"
 	"github.com/theopenlane/core/internal/ent/filecategorygen"
+	"github.com/theopenlane/core/internal/ent/fulltextgen"
 	"github.com/theopenlane/core/internal/ent/historygenerated"
+	"github.com/theopenlane/core/internal/ent/sensitivegen"
 	"github.com/theopenlane/core/internal/ent/validator"
 	"github.com/theopenlane/core/internal/entitlements/genfeatures"
 	"github.com/theopenlane/core/internal/genhelpers"
@@ -351,6 +353,8 @@ func runParallelPostGenHooks(g *gen.Graph) {
 	)
 
 	fileCategoryGen := filecategorygen.New(schemaPath, "internal/objects/store/file_category_generated.go")
+	sensitiveGen := sensitivegen.New(schemaPath, "internal/tenantexport/sensitive_generated.go")
+	fullTextGen := fulltextgen.New(schemaPath, "internal/ent/fulltext/indexes_generated.go")
 
 	entityOpsExt := entityops.New(
 		entityops.WithOutputDir(entityOpsGeneratedPath),
@@ -375,6 +379,8 @@ func runParallelPostGenHooks(g *gen.Graph) {
 			genhooks.WithCSVGenerateAllWrappers(true)),
 		accessMapExt.Hook(),
 		fileCategoryGen.Hook(),
+		sensitiveGen.Hook(),
+		fullTextGen.Hook(),
 		exportenums.New().Hook(),
 		entityOpsExt.Hook(),
 	}
@@ -489,6 +495,10 @@ func schemaGenerate(extensions ...entc.Extension) *gen.Graph {
 			entc.DependencyName("EntitlementManager"),
 			entc.DependencyType(&entitlements.StripeClient{}),
 		),
+		entc.Dependency(
+			entc.DependencyName("LicenseManager"),
+			entc.DependencyType(&entitlements.LicenseProvider{}),
+		),
 		entc.Dependency(
 			entc.DependencyName("ObjectManager"),
 			entc.DependencyType(&objects.Service{}),
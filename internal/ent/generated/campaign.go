@@ -113,6 +113,8 @@ type Campaign struct {
 	EmailBrandingID string `json:"email_branding_id,omitempty"`
 	// the trust center this campaign sends updates for, if any
 	TrustCenterID string `json:"trust_center_id,omitempty"`
+	// the snapshot and decisions of the access review run by the campaign; only written by the access review workflow
+	AccessReview map[string]interface{} `json:"access_review,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the CampaignQuery when eager-loading is set.
 	Edges        CampaignEdges `json:"edges"`
@@ -387,7 +389,7 @@ func (*Campaign) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(models.Cron)}
 		case campaign.FieldScheduledAt, campaign.FieldLaunchedAt, campaign.FieldCompletedAt, campaign.FieldDueDate, campaign.FieldLastRunAt, campaign.FieldNextRunAt, campaign.FieldRecurrenceEndAt, campaign.FieldLastResentAt:
 			values[i] = &sql.NullScanner{S: new(models.DateTime)}
-		case campaign.FieldTags, campaign.FieldMetadata:
+		case campaign.FieldTags, campaign.FieldMetadata, campaign.FieldAccessReview:
 			values[i] = new([]byte)
 		case campaign.FieldWorkflowEligibleMarker, campaign.FieldIsActive, campaign.FieldIsRecurring:
 			values[i] = new(sql.NullBool)
@@ -684,6 +686,14 @@ func (_m *Campaign) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TrustCenterID = value.String
 			}
+		case campaign.FieldAccessReview:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field access_review", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccessReview); err != nil {
+					return fmt.Errorf("unmarshal field access_review: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -965,6 +975,9 @@ func (_m *Campaign) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("trust_center_id=")
 	builder.WriteString(_m.TrustCenterID)
+	builder.WriteString(", ")
+	builder.WriteString("access_review=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessReview))
 	builder.WriteByte(')')
 	return builder.String()
 }
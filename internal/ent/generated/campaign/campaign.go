@@ -102,6 +102,8 @@ const (
 	FieldEmailBrandingID = "email_branding_id"
 	// FieldTrustCenterID holds the string denoting the trust_center_id field in the database.
 	FieldTrustCenterID = "trust_center_id"
+	// FieldAccessReview holds the string denoting the access_review field in the database.
+	FieldAccessReview = "access_review"
 	// EdgeOwner holds the string denoting the owner edge name in mutations.
 	EdgeOwner = "owner"
 	// EdgeBlockedGroups holds the string denoting the blocked_groups edge name in mutations.
@@ -315,6 +317,7 @@ var Columns = []string{
 	FieldIntegrationID,
 	FieldEmailBrandingID,
 	FieldTrustCenterID,
+	FieldAccessReview,
 }
 
 var (
@@ -360,7 +363,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/theopenlane/core/internal/ent/generated/runtime"
 var (
-	Hooks        [12]ent.Hook
+	Hooks        [13]ent.Hook
 	Interceptors [3]ent.Interceptor
 	Policy       ent.Policy
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -402,7 +405,7 @@ const DefaultCampaignType enums.CampaignType = "QUESTIONNAIRE"
 // CampaignTypeValidator is a validator for the "campaign_type" field enum values. It is called by the builders before save.
 func CampaignTypeValidator(ct enums.CampaignType) error {
 	switch ct.String() {
-	case "QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE":
+	case "QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE", "ACCESS_REVIEW":
 		return nil
 	default:
 		return fmt.Errorf("campaign: invalid enum value for campaign_type field: %q", ct)
@@ -2599,6 +2599,16 @@ func TrustCenterIDContainsFold(v string) predicate.Campaign {
 	return predicate.Campaign(sql.FieldContainsFold(FieldTrustCenterID, v))
 }
 
+// AccessReviewIsNil applies the IsNil predicate on the "access_review" field.
+func AccessReviewIsNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldIsNull(FieldAccessReview))
+}
+
+// AccessReviewNotNil applies the NotNil predicate on the "access_review" field.
+func AccessReviewNotNil() predicate.Campaign {
+	return predicate.Campaign(sql.FieldNotNull(FieldAccessReview))
+}
+
 // HasOwner applies the HasEdge predicate on the "owner" edge.
 func HasOwner() predicate.Campaign {
 	return predicate.Campaign(func(s *sql.Selector) {
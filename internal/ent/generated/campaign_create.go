@@ -593,6 +593,12 @@ func (_c *CampaignCreate) SetNillableTrustCenterID(v *string) *CampaignCreate {
 	return _c
 }
 
+// SetAccessReview sets the "access_review" field.
+func (_c *CampaignCreate) SetAccessReview(v map[string]interface{}) *CampaignCreate {
+	_c.mutation.SetAccessReview(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CampaignCreate) SetID(v string) *CampaignCreate {
 	_c.mutation.SetID(v)
@@ -1146,6 +1152,10 @@ func (_c *CampaignCreate) createSpec() (*Campaign, *sqlgraph.CreateSpec) {
 		_spec.SetField(campaign.FieldEmailBrandingID, field.TypeString, value)
 		_node.EmailBrandingID = value
 	}
+	if value, ok := _c.mutation.AccessReview(); ok {
+		_spec.SetField(campaign.FieldAccessReview, field.TypeJSON, value)
+		_node.AccessReview = value
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
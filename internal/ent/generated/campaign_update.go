@@ -781,6 +781,18 @@ func (_u *CampaignUpdate) ClearTrustCenterID() *CampaignUpdate {
 	return _u
 }
 
+// SetAccessReview sets the "access_review" field.
+func (_u *CampaignUpdate) SetAccessReview(v map[string]interface{}) *CampaignUpdate {
+	_u.mutation.SetAccessReview(v)
+	return _u
+}
+
+// ClearAccessReview clears the value of the "access_review" field.
+func (_u *CampaignUpdate) ClearAccessReview() *CampaignUpdate {
+	_u.mutation.ClearAccessReview()
+	return _u
+}
+
 // AddBlockedGroupIDs adds the "blocked_groups" edge to the Group entity by IDs.
 func (_u *CampaignUpdate) AddBlockedGroupIDs(ids ...string) *CampaignUpdate {
 	_u.mutation.AddBlockedGroupIDs(ids...)
@@ -1550,6 +1562,12 @@ func (_u *CampaignUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.EmailBrandingIDCleared() {
 		_spec.ClearField(campaign.FieldEmailBrandingID, field.TypeString)
 	}
+	if value, ok := _u.mutation.AccessReview(); ok {
+		_spec.SetField(campaign.FieldAccessReview, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessReviewCleared() {
+		_spec.ClearField(campaign.FieldAccessReview, field.TypeJSON)
+	}
 	if _u.mutation.BlockedGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -3083,6 +3101,18 @@ func (_u *CampaignUpdateOne) ClearTrustCenterID() *CampaignUpdateOne {
 	return _u
 }
 
+// SetAccessReview sets the "access_review" field.
+func (_u *CampaignUpdateOne) SetAccessReview(v map[string]interface{}) *CampaignUpdateOne {
+	_u.mutation.SetAccessReview(v)
+	return _u
+}
+
+// ClearAccessReview clears the value of the "access_review" field.
+func (_u *CampaignUpdateOne) ClearAccessReview() *CampaignUpdateOne {
+	_u.mutation.ClearAccessReview()
+	return _u
+}
+
 // AddBlockedGroupIDs adds the "blocked_groups" edge to the Group entity by IDs.
 func (_u *CampaignUpdateOne) AddBlockedGroupIDs(ids ...string) *CampaignUpdateOne {
 	_u.mutation.AddBlockedGroupIDs(ids...)
@@ -3882,6 +3912,12 @@ func (_u *CampaignUpdateOne) sqlSave(ctx context.Context) (_node *Campaign, err
 	if _u.mutation.EmailBrandingIDCleared() {
 		_spec.ClearField(campaign.FieldEmailBrandingID, field.TypeString)
 	}
+	if value, ok := _u.mutation.AccessReview(); ok {
+		_spec.SetField(campaign.FieldAccessReview, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessReviewCleared() {
+		_spec.ClearField(campaign.FieldAccessReview, field.TypeJSON)
+	}
 	if _u.mutation.BlockedGroupsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2M,
@@ -32,6 +32,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -89,6 +90,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -177,6 +179,8 @@ type Client struct {
 	ControlObjective *ControlObjectiveClient
 	// CustomDomain is the client for interacting with the CustomDomain builders.
 	CustomDomain *CustomDomainClient
+	// CustomRole is the client for interacting with the CustomRole builders.
+	CustomRole *CustomRoleClient
 	// CustomTypeEnum is the client for interacting with the CustomTypeEnum builders.
 	CustomTypeEnum *CustomTypeEnumClient
 	// DNSVerification is the client for interacting with the DNSVerification builders.
@@ -291,6 +295,8 @@ type Client struct {
 	ProgramMembership *ProgramMembershipClient
 	// Remediation is the client for interacting with the Remediation builders.
 	Remediation *RemediationClient
+	// RetentionRule is the client for interacting with the RetentionRule builders.
+	RetentionRule *RetentionRuleClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
 	// Risk is the client for interacting with the Risk builders.
@@ -398,6 +404,7 @@ func (c *Client) init() {
 	c.ControlImplementation = NewControlImplementationClient(c.config)
 	c.ControlObjective = NewControlObjectiveClient(c.config)
 	c.CustomDomain = NewCustomDomainClient(c.config)
+	c.CustomRole = NewCustomRoleClient(c.config)
 	c.CustomTypeEnum = NewCustomTypeEnumClient(c.config)
 	c.DNSVerification = NewDNSVerificationClient(c.config)
 	c.DirectoryAccount = NewDirectoryAccountClient(c.config)
@@ -455,6 +462,7 @@ func (c *Client) init() {
 	c.Program = NewProgramClient(c.config)
 	c.ProgramMembership = NewProgramMembershipClient(c.config)
 	c.Remediation = NewRemediationClient(c.config)
+	c.RetentionRule = NewRetentionRuleClient(c.config)
 	c.Review = NewReviewClient(c.config)
 	c.Risk = NewRiskClient(c.config)
 	c.SLADefinition = NewSLADefinitionClient(c.config)
@@ -515,6 +523,7 @@ type (
 		SessionConfig      *sessions.SessionConfig
 		TOTP               *totp.Client
 		EntitlementManager *entitlements.StripeClient
+		LicenseManager     *entitlements.LicenseProvider
 		ObjectManager      *objects.Service
 		Summarizer         *summarizer.Client
 		Shortlinks         *shortlinks.Client
@@ -630,6 +639,13 @@ func EntitlementManager(v *entitlements.StripeClient) Option {
 	}
 }
 
+// LicenseManager configures the LicenseManager.
+func LicenseManager(v *entitlements.LicenseProvider) Option {
+	return func(c *config) {
+		c.LicenseManager = v
+	}
+}
+
 // ObjectManager configures the ObjectManager.
 func ObjectManager(v *objects.Service) Option {
 	return func(c *config) {
@@ -712,6 +728,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ControlImplementation:      NewControlImplementationClient(cfg),
 		ControlObjective:           NewControlObjectiveClient(cfg),
 		CustomDomain:               NewCustomDomainClient(cfg),
+		CustomRole:                 NewCustomRoleClient(cfg),
 		CustomTypeEnum:             NewCustomTypeEnumClient(cfg),
 		DNSVerification:            NewDNSVerificationClient(cfg),
 		DirectoryAccount:           NewDirectoryAccountClient(cfg),
@@ -769,6 +786,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		Program:                    NewProgramClient(cfg),
 		ProgramMembership:          NewProgramMembershipClient(cfg),
 		Remediation:                NewRemediationClient(cfg),
+		RetentionRule:              NewRetentionRuleClient(cfg),
 		Review:                     NewReviewClient(cfg),
 		Risk:                       NewRiskClient(cfg),
 		SLADefinition:              NewSLADefinitionClient(cfg),
@@ -838,6 +856,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ControlImplementation:      NewControlImplementationClient(cfg),
 		ControlObjective:           NewControlObjectiveClient(cfg),
 		CustomDomain:               NewCustomDomainClient(cfg),
+		CustomRole:                 NewCustomRoleClient(cfg),
 		CustomTypeEnum:             NewCustomTypeEnumClient(cfg),
 		DNSVerification:            NewDNSVerificationClient(cfg),
 		DirectoryAccount:           NewDirectoryAccountClient(cfg),
@@ -895,6 +914,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		Program:                    NewProgramClient(cfg),
 		ProgramMembership:          NewProgramMembershipClient(cfg),
 		Remediation:                NewRemediationClient(cfg),
+		RetentionRule:              NewRetentionRuleClient(cfg),
 		Review:                     NewReviewClient(cfg),
 		Risk:                       NewRiskClient(cfg),
 		SLADefinition:              NewSLADefinitionClient(cfg),
@@ -963,12 +983,12 @@ func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
 		c.APIToken, c.ActionPlan, c.Assessment, c.AssessmentResponse, c.Asset,
 		c.Campaign, c.CampaignTarget, c.CheckResult, c.Contact, c.Control,
-		c.ControlImplementation, c.ControlObjective, c.CustomDomain, c.CustomTypeEnum,
-		c.DNSVerification, c.DirectoryAccount, c.DirectoryGroup, c.DirectoryMembership,
-		c.DirectorySyncRun, c.Discussion, c.DocumentData, c.EmailTemplate,
-		c.EmailVerificationToken, c.Entity, c.EntityType, c.Event, c.Evidence,
-		c.Export, c.File, c.FileDownloadToken, c.Finding, c.FindingControl, c.Group,
-		c.GroupMembership, c.GroupSetting, c.Hush, c.IdentityHolder,
+		c.ControlImplementation, c.ControlObjective, c.CustomDomain, c.CustomRole,
+		c.CustomTypeEnum, c.DNSVerification, c.DirectoryAccount, c.DirectoryGroup,
+		c.DirectoryMembership, c.DirectorySyncRun, c.Discussion, c.DocumentData,
+		c.EmailTemplate, c.EmailVerificationToken, c.Entity, c.EntityType, c.Event,
+		c.Evidence, c.Export, c.File, c.FileDownloadToken, c.Finding, c.FindingControl,
+		c.Group, c.GroupMembership, c.GroupSetting, c.Hush, c.IdentityHolder,
 		c.ImpersonationEvent, c.Integration, c.IntegrationRun, c.IntegrationWebhook,
 		c.InternalPolicy, c.Invite, c.JobResult, c.JobRunner,
 		c.JobRunnerRegistrationToken, c.JobRunnerToken, c.JobTemplate,
@@ -977,15 +997,16 @@ func (c *Client) Use(hooks ...Hook) {
 		c.OrgMembership, c.OrgModule, c.OrgPrice, c.OrgProduct, c.OrgSubscription,
 		c.Organization, c.OrganizationSetting, c.PasswordResetToken,
 		c.PersonalAccessToken, c.Platform, c.Procedure, c.Program, c.ProgramMembership,
-		c.Remediation, c.Review, c.Risk, c.SLADefinition, c.Scan, c.ScheduledJob,
-		c.ScheduledJobRun, c.Standard, c.Subcontrol, c.Subprocessor, c.Subscriber,
-		c.SystemDetail, c.TFASetting, c.TagDefinition, c.Task, c.Template,
-		c.TrustCenter, c.TrustCenterCompliance, c.TrustCenterDoc, c.TrustCenterEntity,
-		c.TrustCenterFAQ, c.TrustCenterNDARequest, c.TrustCenterSetting,
-		c.TrustCenterSubprocessor, c.TrustCenterWatermarkConfig, c.User, c.UserSetting,
-		c.VendorRiskScore, c.VendorScoringConfig, c.Vulnerability, c.Webauthn,
-		c.WorkflowAssignment, c.WorkflowAssignmentTarget, c.WorkflowDefinition,
-		c.WorkflowEvent, c.WorkflowInstance, c.WorkflowObjectRef, c.WorkflowProposal,
+		c.Remediation, c.RetentionRule, c.Review, c.Risk, c.SLADefinition, c.Scan,
+		c.ScheduledJob, c.ScheduledJobRun, c.Standard, c.Subcontrol, c.Subprocessor,
+		c.Subscriber, c.SystemDetail, c.TFASetting, c.TagDefinition, c.Task,
+		c.Template, c.TrustCenter, c.TrustCenterCompliance, c.TrustCenterDoc,
+		c.TrustCenterEntity, c.TrustCenterFAQ, c.TrustCenterNDARequest,
+		c.TrustCenterSetting, c.TrustCenterSubprocessor, c.TrustCenterWatermarkConfig,
+		c.User, c.UserSetting, c.VendorRiskScore, c.VendorScoringConfig,
+		c.Vulnerability, c.Webauthn, c.WorkflowAssignment, c.WorkflowAssignmentTarget,
+		c.WorkflowDefinition, c.WorkflowEvent, c.WorkflowInstance, c.WorkflowObjectRef,
+		c.WorkflowProposal,
 	} {
 		n.Use(hooks...)
 	}
@@ -997,12 +1018,12 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
 		c.APIToken, c.ActionPlan, c.Assessment, c.AssessmentResponse, c.Asset,
 		c.Campaign, c.CampaignTarget, c.CheckResult, c.Contact, c.Control,
-		c.ControlImplementation, c.ControlObjective, c.CustomDomain, c.CustomTypeEnum,
-		c.DNSVerification, c.DirectoryAccount, c.DirectoryGroup, c.DirectoryMembership,
-		c.DirectorySyncRun, c.Discussion, c.DocumentData, c.EmailTemplate,
-		c.EmailVerificationToken, c.Entity, c.EntityType, c.Event, c.Evidence,
-		c.Export, c.File, c.FileDownloadToken, c.Finding, c.FindingControl, c.Group,
-		c.GroupMembership, c.GroupSetting, c.Hush, c.IdentityHolder,
+		c.ControlImplementation, c.ControlObjective, c.CustomDomain, c.CustomRole,
+		c.CustomTypeEnum, c.DNSVerification, c.DirectoryAccount, c.DirectoryGroup,
+		c.DirectoryMembership, c.DirectorySyncRun, c.Discussion, c.DocumentData,
+		c.EmailTemplate, c.EmailVerificationToken, c.Entity, c.EntityType, c.Event,
+		c.Evidence, c.Export, c.File, c.FileDownloadToken, c.Finding, c.FindingControl,
+		c.Group, c.GroupMembership, c.GroupSetting, c.Hush, c.IdentityHolder,
 		c.ImpersonationEvent, c.Integration, c.IntegrationRun, c.IntegrationWebhook,
 		c.InternalPolicy, c.Invite, c.JobResult, c.JobRunner,
 		c.JobRunnerRegistrationToken, c.JobRunnerToken, c.JobTemplate,
@@ -1011,15 +1032,16 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.OrgMembership, c.OrgModule, c.OrgPrice, c.OrgProduct, c.OrgSubscription,
 		c.Organization, c.OrganizationSetting, c.PasswordResetToken,
 		c.PersonalAccessToken, c.Platform, c.Procedure, c.Program, c.ProgramMembership,
-		c.Remediation, c.Review, c.Risk, c.SLADefinition, c.Scan, c.ScheduledJob,
-		c.ScheduledJobRun, c.Standard, c.Subcontrol, c.Subprocessor, c.Subscriber,
-		c.SystemDetail, c.TFASetting, c.TagDefinition, c.Task, c.Template,
-		c.TrustCenter, c.TrustCenterCompliance, c.TrustCenterDoc, c.TrustCenterEntity,
-		c.TrustCenterFAQ, c.TrustCenterNDARequest, c.TrustCenterSetting,
-		c.TrustCenterSubprocessor, c.TrustCenterWatermarkConfig, c.User, c.UserSetting,
-		c.VendorRiskScore, c.VendorScoringConfig, c.Vulnerability, c.Webauthn,
-		c.WorkflowAssignment, c.WorkflowAssignmentTarget, c.WorkflowDefinition,
-		c.WorkflowEvent, c.WorkflowInstance, c.WorkflowObjectRef, c.WorkflowProposal,
+		c.Remediation, c.RetentionRule, c.Review, c.Risk, c.SLADefinition, c.Scan,
+		c.ScheduledJob, c.ScheduledJobRun, c.Standard, c.Subcontrol, c.Subprocessor,
+		c.Subscriber, c.SystemDetail, c.TFASetting, c.TagDefinition, c.Task,
+		c.Template, c.TrustCenter, c.TrustCenterCompliance, c.TrustCenterDoc,
+		c.TrustCenterEntity, c.TrustCenterFAQ, c.TrustCenterNDARequest,
+		c.TrustCenterSetting, c.TrustCenterSubprocessor, c.TrustCenterWatermarkConfig,
+		c.User, c.UserSetting, c.VendorRiskScore, c.VendorScoringConfig,
+		c.Vulnerability, c.Webauthn, c.WorkflowAssignment, c.WorkflowAssignmentTarget,
+		c.WorkflowDefinition, c.WorkflowEvent, c.WorkflowInstance, c.WorkflowObjectRef,
+		c.WorkflowProposal,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -1126,6 +1148,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ControlObjective.mutate(ctx, m)
 	case *CustomDomainMutation:
 		return c.CustomDomain.mutate(ctx, m)
+	case *CustomRoleMutation:
+		return c.CustomRole.mutate(ctx, m)
 	case *CustomTypeEnumMutation:
 		return c.CustomTypeEnum.mutate(ctx, m)
 	case *DNSVerificationMutation:
@@ -1240,6 +1264,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ProgramMembership.mutate(ctx, m)
 	case *RemediationMutation:
 		return c.Remediation.mutate(ctx, m)
+	case *RetentionRuleMutation:
+		return c.RetentionRule.mutate(ctx, m)
 	case *ReviewMutation:
 		return c.Review.mutate(ctx, m)
 	case *RiskMutation:
@@ -6304,6 +6330,160 @@ func (c *CustomDomainClient) mutate(ctx context.Context, m *CustomDomainMutation
 	}
 }
 
+// CustomRoleClient is a client for the CustomRole schema.
+type CustomRoleClient struct {
+	config
+}
+
+// NewCustomRoleClient returns a client for the CustomRole from the given config.
+func NewCustomRoleClient(c config) *CustomRoleClient {
+	return &CustomRoleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `customrole.Hooks(f(g(h())))`.
+func (c *CustomRoleClient) Use(hooks ...Hook) {
+	c.hooks.CustomRole = append(c.hooks.CustomRole, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `customrole.Intercept(f(g(h())))`.
+func (c *CustomRoleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.CustomRole = append(c.inters.CustomRole, interceptors...)
+}
+
+// Create returns a builder for creating a CustomRole entity.
+func (c *CustomRoleClient) Create() *CustomRoleCreate {
+	mutation := newCustomRoleMutation(c.config, OpCreate)
+	return &CustomRoleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of CustomRole entities.
+func (c *CustomRoleClient) CreateBulk(builders ...*CustomRoleCreate) *CustomRoleCreateBulk {
+	return &CustomRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CustomRoleClient) MapCreateBulk(slice any, setFunc func(*CustomRoleCreate, int)) *CustomRoleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CustomRoleCreateBulk{err: fmt.Errorf("calling to CustomRoleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CustomRoleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CustomRoleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for CustomRole.
+func (c *CustomRoleClient) Update() *CustomRoleUpdate {
+	mutation := newCustomRoleMutation(c.config, OpUpdate)
+	return &CustomRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CustomRoleClient) UpdateOne(_m *CustomRole) *CustomRoleUpdateOne {
+	mutation := newCustomRoleMutation(c.config, OpUpdateOne, withCustomRole(_m))
+	return &CustomRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CustomRoleClient) UpdateOneID(id string) *CustomRoleUpdateOne {
+	mutation := newCustomRoleMutation(c.config, OpUpdateOne, withCustomRoleID(id))
+	return &CustomRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for CustomRole.
+func (c *CustomRoleClient) Delete() *CustomRoleDelete {
+	mutation := newCustomRoleMutation(c.config, OpDelete)
+	return &CustomRoleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CustomRoleClient) DeleteOne(_m *CustomRole) *CustomRoleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CustomRoleClient) DeleteOneID(id string) *CustomRoleDeleteOne {
+	builder := c.Delete().Where(customrole.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CustomRoleDeleteOne{builder}
+}
+
+// Query returns a query builder for CustomRole.
+func (c *CustomRoleClient) Query() *CustomRoleQuery {
+	return &CustomRoleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCustomRole},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a CustomRole entity by its id.
+func (c *CustomRoleClient) Get(ctx context.Context, id string) (*CustomRole, error) {
+	return c.Query().Where(customrole.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CustomRoleClient) GetX(ctx context.Context, id string) *CustomRole {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a CustomRole.
+func (c *CustomRoleClient) QueryOwner(_m *CustomRole) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(customrole.Table, customrole.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, customrole.OwnerTable, customrole.OwnerColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.CustomRole
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CustomRoleClient) Hooks() []Hook {
+	hooks := c.hooks.CustomRole
+	return append(hooks[:len(hooks):len(hooks)], customrole.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *CustomRoleClient) Interceptors() []Interceptor {
+	inters := c.inters.CustomRole
+	return append(inters[:len(inters):len(inters)], customrole.Interceptors[:]...)
+}
+
+func (c *CustomRoleClient) mutate(ctx context.Context, m *CustomRoleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CustomRoleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CustomRoleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CustomRoleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CustomRoleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown CustomRole mutation op: %q", m.Op())
+	}
+}
+
 // CustomTypeEnumClient is a client for the CustomTypeEnum schema.
 type CustomTypeEnumClient struct {
 	config
@@ -24272,6 +24452,44 @@ func (c *OrganizationClient) QueryVendorRiskScores(_m *Organization) *VendorRisk
 	return query
 }
 
+// QueryRetentionRules queries the retention_rules edge of a Organization.
+func (c *OrganizationClient) QueryRetentionRules(_m *Organization) *RetentionRuleQuery {
+	query := (&RetentionRuleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(retentionrule.Table, retentionrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.RetentionRulesTable, organization.RetentionRulesColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.RetentionRule
+		step.Edge.Schema = schemaConfig.RetentionRule
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryCustomRoles queries the custom_roles edge of a Organization.
+func (c *OrganizationClient) QueryCustomRoles(_m *Organization) *CustomRoleQuery {
+	query := (&CustomRoleClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, id),
+			sqlgraph.To(customrole.Table, customrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.CustomRolesTable, organization.CustomRolesColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.CustomRole
+		step.Edge.Schema = schemaConfig.CustomRole
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // QueryMembers queries the members edge of a Organization.
 func (c *OrganizationClient) QueryMembers(_m *Organization) *OrgMembershipQuery {
 	query := (&OrgMembershipClient{config: c.config}).Query()
@@ -27697,6 +27915,160 @@ func (c *RemediationClient) mutate(ctx context.Context, m *RemediationMutation)
 	}
 }
 
+// RetentionRuleClient is a client for the RetentionRule schema.
+type RetentionRuleClient struct {
+	config
+}
+
+// NewRetentionRuleClient returns a client for the RetentionRule from the given config.
+func NewRetentionRuleClient(c config) *RetentionRuleClient {
+	return &RetentionRuleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `retentionrule.Hooks(f(g(h())))`.
+func (c *RetentionRuleClient) Use(hooks ...Hook) {
+	c.hooks.RetentionRule = append(c.hooks.RetentionRule, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `retentionrule.Intercept(f(g(h())))`.
+func (c *RetentionRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RetentionRule = append(c.inters.RetentionRule, interceptors...)
+}
+
+// Create returns a builder for creating a RetentionRule entity.
+func (c *RetentionRuleClient) Create() *RetentionRuleCreate {
+	mutation := newRetentionRuleMutation(c.config, OpCreate)
+	return &RetentionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RetentionRule entities.
+func (c *RetentionRuleClient) CreateBulk(builders ...*RetentionRuleCreate) *RetentionRuleCreateBulk {
+	return &RetentionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RetentionRuleClient) MapCreateBulk(slice any, setFunc func(*RetentionRuleCreate, int)) *RetentionRuleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RetentionRuleCreateBulk{err: fmt.Errorf("calling to RetentionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RetentionRuleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RetentionRuleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RetentionRule.
+func (c *RetentionRuleClient) Update() *RetentionRuleUpdate {
+	mutation := newRetentionRuleMutation(c.config, OpUpdate)
+	return &RetentionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RetentionRuleClient) UpdateOne(_m *RetentionRule) *RetentionRuleUpdateOne {
+	mutation := newRetentionRuleMutation(c.config, OpUpdateOne, withRetentionRule(_m))
+	return &RetentionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RetentionRuleClient) UpdateOneID(id string) *RetentionRuleUpdateOne {
+	mutation := newRetentionRuleMutation(c.config, OpUpdateOne, withRetentionRuleID(id))
+	return &RetentionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RetentionRule.
+func (c *RetentionRuleClient) Delete() *RetentionRuleDelete {
+	mutation := newRetentionRuleMutation(c.config, OpDelete)
+	return &RetentionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RetentionRuleClient) DeleteOne(_m *RetentionRule) *RetentionRuleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RetentionRuleClient) DeleteOneID(id string) *RetentionRuleDeleteOne {
+	builder := c.Delete().Where(retentionrule.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RetentionRuleDeleteOne{builder}
+}
+
+// Query returns a query builder for RetentionRule.
+func (c *RetentionRuleClient) Query() *RetentionRuleQuery {
+	return &RetentionRuleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRetentionRule},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RetentionRule entity by its id.
+func (c *RetentionRuleClient) Get(ctx context.Context, id string) (*RetentionRule, error) {
+	return c.Query().Where(retentionrule.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RetentionRuleClient) GetX(ctx context.Context, id string) *RetentionRule {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryOwner queries the owner edge of a RetentionRule.
+func (c *RetentionRuleClient) QueryOwner(_m *RetentionRule) *OrganizationQuery {
+	query := (&OrganizationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(retentionrule.Table, retentionrule.FieldID, id),
+			sqlgraph.To(organization.Table, organization.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, retentionrule.OwnerTable, retentionrule.OwnerColumn),
+		)
+		schemaConfig := _m.schemaConfig
+		step.To.Schema = schemaConfig.Organization
+		step.Edge.Schema = schemaConfig.RetentionRule
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *RetentionRuleClient) Hooks() []Hook {
+	hooks := c.hooks.RetentionRule
+	return append(hooks[:len(hooks):len(hooks)], retentionrule.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RetentionRuleClient) Interceptors() []Interceptor {
+	inters := c.inters.RetentionRule
+	return append(inters[:len(inters):len(inters)], retentionrule.Interceptors[:]...)
+}
+
+func (c *RetentionRuleClient) mutate(ctx context.Context, m *RetentionRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RetentionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RetentionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RetentionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RetentionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("generated: unknown RetentionRule mutation op: %q", m.Op())
+	}
+}
+
 // ReviewClient is a client for the Review schema.
 type ReviewClient struct {
 	config
@@ -39708,7 +40080,7 @@ type (
 	hooks struct {
 		APIToken, ActionPlan, Assessment, AssessmentResponse, Asset, Campaign,
 		CampaignTarget, CheckResult, Contact, Control, ControlImplementation,
-		ControlObjective, CustomDomain, CustomTypeEnum, DNSVerification,
+		ControlObjective, CustomDomain, CustomRole, CustomTypeEnum, DNSVerification,
 		DirectoryAccount, DirectoryGroup, DirectoryMembership, DirectorySyncRun,
 		Discussion, DocumentData, EmailTemplate, EmailVerificationToken, Entity,
 		EntityType, Event, Evidence, Export, File, FileDownloadToken, Finding,
@@ -39719,8 +40091,8 @@ type (
 		Notification, NotificationPreference, NotificationTemplate, Onboarding,
 		OrgMembership, OrgModule, OrgPrice, OrgProduct, OrgSubscription, Organization,
 		OrganizationSetting, PasswordResetToken, PersonalAccessToken, Platform,
-		Procedure, Program, ProgramMembership, Remediation, Review, Risk,
-		SLADefinition, Scan, ScheduledJob, ScheduledJobRun, Standard, Subcontrol,
+		Procedure, Program, ProgramMembership, Remediation, RetentionRule, Review,
+		Risk, SLADefinition, Scan, ScheduledJob, ScheduledJobRun, Standard, Subcontrol,
 		Subprocessor, Subscriber, SystemDetail, TFASetting, TagDefinition, Task,
 		Template, TrustCenter, TrustCenterCompliance, TrustCenterDoc,
 		TrustCenterEntity, TrustCenterFAQ, TrustCenterNDARequest, TrustCenterSetting,
@@ -39732,7 +40104,7 @@ type (
 	inters struct {
 		APIToken, ActionPlan, Assessment, AssessmentResponse, Asset, Campaign,
 		CampaignTarget, CheckResult, Contact, Control, ControlImplementation,
-		ControlObjective, CustomDomain, CustomTypeEnum, DNSVerification,
+		ControlObjective, CustomDomain, CustomRole, CustomTypeEnum, DNSVerification,
 		DirectoryAccount, DirectoryGroup, DirectoryMembership, DirectorySyncRun,
 		Discussion, DocumentData, EmailTemplate, EmailVerificationToken, Entity,
 		EntityType, Event, Evidence, Export, File, FileDownloadToken, Finding,
@@ -39743,8 +40115,8 @@ type (
 		Notification, NotificationPreference, NotificationTemplate, Onboarding,
 		OrgMembership, OrgModule, OrgPrice, OrgProduct, OrgSubscription, Organization,
 		OrganizationSetting, PasswordResetToken, PersonalAccessToken, Platform,
-		Procedure, Program, ProgramMembership, Remediation, Review, Risk,
-		SLADefinition, Scan, ScheduledJob, ScheduledJobRun, Standard, Subcontrol,
+		Procedure, Program, ProgramMembership, Remediation, RetentionRule, Review,
+		Risk, SLADefinition, Scan, ScheduledJob, ScheduledJobRun, Standard, Subcontrol,
 		Subprocessor, Subscriber, SystemDetail, TFASetting, TagDefinition, Task,
 		Template, TrustCenter, TrustCenterCompliance, TrustCenterDoc,
 		TrustCenterEntity, TrustCenterFAQ, TrustCenterNDARequest, TrustCenterSetting,
@@ -20,6 +20,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -73,6 +74,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -227,6 +229,12 @@ func CustomDomainEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func CustomRoleEdgeCleanup(ctx context.Context, id string) error {
+	ctx = entfga.WithDeleteTuplesFirst(privacy.DecisionContext(ctx, privacy.Allowf("cleanup customrole edge")))
+
+	return nil
+}
+
 func CustomTypeEnumEdgeCleanup(ctx context.Context, id string) error {
 	ctx = entfga.WithDeleteTuplesFirst(privacy.DecisionContext(ctx, privacy.Allowf("cleanup customtypeenum edge")))
 
@@ -2454,6 +2462,49 @@ func OrganizationEdgeCleanup(ctx context.Context, id string) error {
 		}
 	}
 
+	{
+		ids, err := FromContext(ctx).RetentionRule.Query().Where(retentionrule.HasOwnerWith(organization.ID(id))).IDs(ctx)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Msg("error querying retentionrule ids for cleanup")
+			return err
+		}
+		for _, edgeID := range ids {
+			if err := RetentionRuleEdgeCleanup(ctx, edgeID); err != nil {
+				logx.FromContext(ctx).Error().Err(err).Str("id", edgeID).Msg("error cleaning up retentionrule edges")
+				return err
+			}
+		}
+	}
+	if exists, err := FromContext(ctx).RetentionRule.Query().Where((retentionrule.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
+		if err := PurgeRetentionRuleHistory(ctx, retentionrule.HasOwnerWith(organization.ID(id))); err != nil {
+			return err
+		}
+		if retentionruleCount, err := FromContext(ctx).RetentionRule.Delete().Where(retentionrule.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
+			logx.FromContext(ctx).Error().Err(err).Int("count", retentionruleCount).Msg("error deleting retentionrule")
+			return err
+		}
+	}
+
+	{
+		ids, err := FromContext(ctx).CustomRole.Query().Where(customrole.HasOwnerWith(organization.ID(id))).IDs(ctx)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Msg("error querying customrole ids for cleanup")
+			return err
+		}
+		for _, edgeID := range ids {
+			if err := CustomRoleEdgeCleanup(ctx, edgeID); err != nil {
+				logx.FromContext(ctx).Error().Err(err).Str("id", edgeID).Msg("error cleaning up customrole edges")
+				return err
+			}
+		}
+	}
+	if exists, err := FromContext(ctx).CustomRole.Query().Where((customrole.HasOwnerWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
+		if customroleCount, err := FromContext(ctx).CustomRole.Delete().Where(customrole.HasOwnerWith(organization.ID(id))).Exec(ctx); err != nil {
+			logx.FromContext(ctx).Error().Err(err).Int("count", customroleCount).Msg("error deleting customrole")
+			return err
+		}
+	}
+
 	if exists, err := FromContext(ctx).OrgMembership.Query().Where((orgmembership.HasOrganizationWith(organization.ID(id)))).Exist(ctx); err == nil && exists {
 		if err := PurgeOrgMembershipHistory(ctx, orgmembership.HasOrganizationWith(organization.ID(id))); err != nil {
 			return err
@@ -2527,6 +2578,12 @@ func RemediationEdgeCleanup(ctx context.Context, id string) error {
 	return nil
 }
 
+func RetentionRuleEdgeCleanup(ctx context.Context, id string) error {
+	ctx = entfga.WithDeleteTuplesFirst(privacy.DecisionContext(ctx, privacy.Allowf("cleanup retentionrule edge")))
+
+	return nil
+}
+
 func ReviewEdgeCleanup(ctx context.Context, id string) error {
 	ctx = entfga.WithDeleteTuplesFirst(privacy.DecisionContext(ctx, privacy.Allowf("cleanup review edge")))
 
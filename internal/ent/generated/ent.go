@@ -25,6 +25,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -82,6 +83,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -192,6 +194,7 @@ func checkColumn(t, c string) error {
 			controlimplementation.Table:      controlimplementation.ValidColumn,
 			controlobjective.Table:           controlobjective.ValidColumn,
 			customdomain.Table:               customdomain.ValidColumn,
+			customrole.Table:                 customrole.ValidColumn,
 			customtypeenum.Table:             customtypeenum.ValidColumn,
 			dnsverification.Table:            dnsverification.ValidColumn,
 			directoryaccount.Table:           directoryaccount.ValidColumn,
@@ -249,6 +252,7 @@ func checkColumn(t, c string) error {
 			program.Table:                    program.ValidColumn,
 			programmembership.Table:          programmembership.ValidColumn,
 			remediation.Table:                remediation.ValidColumn,
+			retentionrule.Table:              retentionrule.ValidColumn,
 			review.Table:                     review.ValidColumn,
 			risk.Table:                       risk.ValidColumn,
 			sladefinition.Table:              sladefinition.ValidColumn,
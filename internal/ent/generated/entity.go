@@ -106,6 +106,8 @@ type Entity struct {
 	HasSoc2 bool `json:"has_soc2,omitempty"`
 	// SOC 2 reporting period end date
 	Soc2PeriodEnd *models.DateTime `json:"soc2_period_end,omitempty"`
+	// when the SOC 2 reporting period on file was found to have lapsed; cleared when the reporting period end changes
+	Soc2LapsedAt *models.DateTime `json:"soc2_lapsed_at,omitempty"`
 	// start date for the entity contract
 	ContractStartDate *models.DateTime `json:"contract_start_date,omitempty"`
 	// end date for the entity contract
@@ -661,7 +663,7 @@ func (*Entity) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case entity.FieldLastReviewedAt, entity.FieldSoc2PeriodEnd, entity.FieldContractStartDate, entity.FieldContractEndDate, entity.FieldNextReviewAt, entity.FieldContractRenewalAt, entity.FieldObservedAt:
+		case entity.FieldLastReviewedAt, entity.FieldSoc2PeriodEnd, entity.FieldSoc2LapsedAt, entity.FieldContractStartDate, entity.FieldContractEndDate, entity.FieldNextReviewAt, entity.FieldContractRenewalAt, entity.FieldObservedAt:
 			values[i] = &sql.NullScanner{S: new(models.DateTime)}
 		case entity.FieldTags, entity.FieldDomains, entity.FieldAliases, entity.FieldLinkedAssetIds, entity.FieldProvidedServices, entity.FieldLinks, entity.FieldVendorMetadata:
 			values[i] = new([]byte)
@@ -953,6 +955,13 @@ func (_m *Entity) assignValues(columns []string, values []any) error {
 				_m.Soc2PeriodEnd = new(models.DateTime)
 				*_m.Soc2PeriodEnd = *value.S.(*models.DateTime)
 			}
+		case entity.FieldSoc2LapsedAt:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field soc2_lapsed_at", values[i])
+			} else if value.Valid {
+				_m.Soc2LapsedAt = new(models.DateTime)
+				*_m.Soc2LapsedAt = *value.S.(*models.DateTime)
+			}
 		case entity.FieldContractStartDate:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field contract_start_date", values[i])
@@ -1497,6 +1506,11 @@ func (_m *Entity) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.Soc2LapsedAt; v != nil {
+		builder.WriteString("soc2_lapsed_at=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.ContractStartDate; v != nil {
 		builder.WriteString("contract_start_date=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
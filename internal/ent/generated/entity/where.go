@@ -244,6 +244,11 @@ func Soc2PeriodEnd(v models.DateTime) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldSoc2PeriodEnd, v))
 }
 
+// Soc2LapsedAt applies equality check predicate on the "soc2_lapsed_at" field. It's identical to Soc2LapsedAtEQ.
+func Soc2LapsedAt(v models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldSoc2LapsedAt, v))
+}
+
 // ContractStartDate applies equality check predicate on the "contract_start_date" field. It's identical to ContractStartDateEQ.
 func ContractStartDate(v models.DateTime) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldContractStartDate, v))
@@ -2764,6 +2769,56 @@ func Soc2PeriodEndNotNil() predicate.Entity {
 	return predicate.Entity(sql.FieldNotNull(FieldSoc2PeriodEnd))
 }
 
+// Soc2LapsedAtEQ applies the EQ predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtEQ(v models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldEQ(FieldSoc2LapsedAt, v))
+}
+
+// Soc2LapsedAtNEQ applies the NEQ predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtNEQ(v models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldNEQ(FieldSoc2LapsedAt, v))
+}
+
+// Soc2LapsedAtIn applies the In predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtIn(vs ...models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldIn(FieldSoc2LapsedAt, vs...))
+}
+
+// Soc2LapsedAtNotIn applies the NotIn predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtNotIn(vs ...models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldNotIn(FieldSoc2LapsedAt, vs...))
+}
+
+// Soc2LapsedAtGT applies the GT predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtGT(v models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldGT(FieldSoc2LapsedAt, v))
+}
+
+// Soc2LapsedAtGTE applies the GTE predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtGTE(v models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldGTE(FieldSoc2LapsedAt, v))
+}
+
+// Soc2LapsedAtLT applies the LT predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtLT(v models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldLT(FieldSoc2LapsedAt, v))
+}
+
+// Soc2LapsedAtLTE applies the LTE predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtLTE(v models.DateTime) predicate.Entity {
+	return predicate.Entity(sql.FieldLTE(FieldSoc2LapsedAt, v))
+}
+
+// Soc2LapsedAtIsNil applies the IsNil predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtIsNil() predicate.Entity {
+	return predicate.Entity(sql.FieldIsNull(FieldSoc2LapsedAt))
+}
+
+// Soc2LapsedAtNotNil applies the NotNil predicate on the "soc2_lapsed_at" field.
+func Soc2LapsedAtNotNil() predicate.Entity {
+	return predicate.Entity(sql.FieldNotNull(FieldSoc2LapsedAt))
+}
+
 // ContractStartDateEQ applies the EQ predicate on the "contract_start_date" field.
 func ContractStartDateEQ(v models.DateTime) predicate.Entity {
 	return predicate.Entity(sql.FieldEQ(FieldContractStartDate, v))
@@ -575,6 +575,20 @@ func (_c *EntityCreate) SetNillableSoc2PeriodEnd(v *models.DateTime) *EntityCrea
 	return _c
 }
 
+// SetSoc2LapsedAt sets the "soc2_lapsed_at" field.
+func (_c *EntityCreate) SetSoc2LapsedAt(v models.DateTime) *EntityCreate {
+	_c.mutation.SetSoc2LapsedAt(v)
+	return _c
+}
+
+// SetNillableSoc2LapsedAt sets the "soc2_lapsed_at" field if the given value is not nil.
+func (_c *EntityCreate) SetNillableSoc2LapsedAt(v *models.DateTime) *EntityCreate {
+	if v != nil {
+		_c.SetSoc2LapsedAt(*v)
+	}
+	return _c
+}
+
 // SetContractStartDate sets the "contract_start_date" field.
 func (_c *EntityCreate) SetContractStartDate(v models.DateTime) *EntityCreate {
 	_c.mutation.SetContractStartDate(v)
@@ -1719,6 +1733,10 @@ func (_c *EntityCreate) createSpec() (*Entity, *sqlgraph.CreateSpec) {
 		_spec.SetField(entity.FieldSoc2PeriodEnd, field.TypeTime, value)
 		_node.Soc2PeriodEnd = &value
 	}
+	if value, ok := _c.mutation.Soc2LapsedAt(); ok {
+		_spec.SetField(entity.FieldSoc2LapsedAt, field.TypeTime, value)
+		_node.Soc2LapsedAt = &value
+	}
 	if value, ok := _c.mutation.ContractStartDate(); ok {
 		_spec.SetField(entity.FieldContractStartDate, field.TypeTime, value)
 		_node.ContractStartDate = &value
@@ -763,6 +763,26 @@ func (_u *EntityUpdate) ClearSoc2PeriodEnd() *EntityUpdate {
 	return _u
 }
 
+// SetSoc2LapsedAt sets the "soc2_lapsed_at" field.
+func (_u *EntityUpdate) SetSoc2LapsedAt(v models.DateTime) *EntityUpdate {
+	_u.mutation.SetSoc2LapsedAt(v)
+	return _u
+}
+
+// SetNillableSoc2LapsedAt sets the "soc2_lapsed_at" field if the given value is not nil.
+func (_u *EntityUpdate) SetNillableSoc2LapsedAt(v *models.DateTime) *EntityUpdate {
+	if v != nil {
+		_u.SetSoc2LapsedAt(*v)
+	}
+	return _u
+}
+
+// ClearSoc2LapsedAt clears the value of the "soc2_lapsed_at" field.
+func (_u *EntityUpdate) ClearSoc2LapsedAt() *EntityUpdate {
+	_u.mutation.ClearSoc2LapsedAt()
+	return _u
+}
+
 // SetContractStartDate sets the "contract_start_date" field.
 func (_u *EntityUpdate) SetContractStartDate(v models.DateTime) *EntityUpdate {
 	_u.mutation.SetContractStartDate(v)
@@ -2692,6 +2712,12 @@ func (_u *EntityUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.Soc2PeriodEndCleared() {
 		_spec.ClearField(entity.FieldSoc2PeriodEnd, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Soc2LapsedAt(); ok {
+		_spec.SetField(entity.FieldSoc2LapsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.Soc2LapsedAtCleared() {
+		_spec.ClearField(entity.FieldSoc2LapsedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ContractStartDate(); ok {
 		_spec.SetField(entity.FieldContractStartDate, field.TypeTime, value)
 	}
@@ -5229,6 +5255,26 @@ func (_u *EntityUpdateOne) ClearSoc2PeriodEnd() *EntityUpdateOne {
 	return _u
 }
 
+// SetSoc2LapsedAt sets the "soc2_lapsed_at" field.
+func (_u *EntityUpdateOne) SetSoc2LapsedAt(v models.DateTime) *EntityUpdateOne {
+	_u.mutation.SetSoc2LapsedAt(v)
+	return _u
+}
+
+// SetNillableSoc2LapsedAt sets the "soc2_lapsed_at" field if the given value is not nil.
+func (_u *EntityUpdateOne) SetNillableSoc2LapsedAt(v *models.DateTime) *EntityUpdateOne {
+	if v != nil {
+		_u.SetSoc2LapsedAt(*v)
+	}
+	return _u
+}
+
+// ClearSoc2LapsedAt clears the value of the "soc2_lapsed_at" field.
+func (_u *EntityUpdateOne) ClearSoc2LapsedAt() *EntityUpdateOne {
+	_u.mutation.ClearSoc2LapsedAt()
+	return _u
+}
+
 // SetContractStartDate sets the "contract_start_date" field.
 func (_u *EntityUpdateOne) SetContractStartDate(v models.DateTime) *EntityUpdateOne {
 	_u.mutation.SetContractStartDate(v)
@@ -7188,6 +7234,12 @@ func (_u *EntityUpdateOne) sqlSave(ctx context.Context) (_node *Entity, err erro
 	if _u.mutation.Soc2PeriodEndCleared() {
 		_spec.ClearField(entity.FieldSoc2PeriodEnd, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Soc2LapsedAt(); ok {
+		_spec.SetField(entity.FieldSoc2LapsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.Soc2LapsedAtCleared() {
+		_spec.ClearField(entity.FieldSoc2LapsedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ContractStartDate(); ok {
 		_spec.SetField(entity.FieldContractStartDate, field.TypeTime, value)
 	}
@@ -87,6 +87,8 @@ const (
 	FieldStorageProvider = "storage_provider"
 	// FieldLastAccessedAt holds the string denoting the last_accessed_at field in the database.
 	FieldLastAccessedAt = "last_accessed_at"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
 	// EdgeEnvironment holds the string denoting the environment edge name in mutations.
 	EdgeEnvironment = "environment"
 	// EdgeScope holds the string denoting the scope edge name in mutations.
@@ -291,6 +293,7 @@ var Columns = []string{
 	FieldStorageRegion,
 	FieldStorageProvider,
 	FieldLastAccessedAt,
+	FieldScanStatus,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "files"
@@ -375,7 +378,7 @@ func ValidColumn(column string) bool {
 //
 //	import _ "github.com/theopenlane/core/internal/ent/generated/runtime"
 var (
-	Hooks        [10]ent.Hook
+	Hooks        [11]ent.Hook
 	Interceptors [4]ent.Interceptor
 	Policy       ent.Policy
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -569,6 +572,11 @@ func ByLastAccessedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastAccessedAt, opts...).ToFunc()
 }
 
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
 // ByEnvironmentField orders the results by environment field.
 func ByEnvironmentField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -237,6 +237,11 @@ func LastAccessedAt(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldLastAccessedAt, v))
 }
 
+// ScanStatus applies equality check predicate on the "scan_status" field. It's identical to ScanStatusEQ.
+func ScanStatus(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.File {
 	return predicate.File(sql.FieldEQ(FieldCreatedAt, v))
@@ -2547,6 +2552,81 @@ func LastAccessedAtNotNil() predicate.File {
 	return predicate.File(sql.FieldNotNull(FieldLastAccessedAt))
 }
 
+// ScanStatusEQ applies the EQ predicate on the "scan_status" field.
+func ScanStatusEQ(v string) predicate.File {
+	return predicate.File(sql.FieldEQ(FieldScanStatus, v))
+}
+
+// ScanStatusNEQ applies the NEQ predicate on the "scan_status" field.
+func ScanStatusNEQ(v string) predicate.File {
+	return predicate.File(sql.FieldNEQ(FieldScanStatus, v))
+}
+
+// ScanStatusIn applies the In predicate on the "scan_status" field.
+func ScanStatusIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusNotIn applies the NotIn predicate on the "scan_status" field.
+func ScanStatusNotIn(vs ...string) predicate.File {
+	return predicate.File(sql.FieldNotIn(FieldScanStatus, vs...))
+}
+
+// ScanStatusGT applies the GT predicate on the "scan_status" field.
+func ScanStatusGT(v string) predicate.File {
+	return predicate.File(sql.FieldGT(FieldScanStatus, v))
+}
+
+// ScanStatusGTE applies the GTE predicate on the "scan_status" field.
+func ScanStatusGTE(v string) predicate.File {
+	return predicate.File(sql.FieldGTE(FieldScanStatus, v))
+}
+
+// ScanStatusLT applies the LT predicate on the "scan_status" field.
+func ScanStatusLT(v string) predicate.File {
+	return predicate.File(sql.FieldLT(FieldScanStatus, v))
+}
+
+// ScanStatusLTE applies the LTE predicate on the "scan_status" field.
+func ScanStatusLTE(v string) predicate.File {
+	return predicate.File(sql.FieldLTE(FieldScanStatus, v))
+}
+
+// ScanStatusContains applies the Contains predicate on the "scan_status" field.
+func ScanStatusContains(v string) predicate.File {
+	return predicate.File(sql.FieldContains(FieldScanStatus, v))
+}
+
+// ScanStatusHasPrefix applies the HasPrefix predicate on the "scan_status" field.
+func ScanStatusHasPrefix(v string) predicate.File {
+	return predicate.File(sql.FieldHasPrefix(FieldScanStatus, v))
+}
+
+// ScanStatusHasSuffix applies the HasSuffix predicate on the "scan_status" field.
+func ScanStatusHasSuffix(v string) predicate.File {
+	return predicate.File(sql.FieldHasSuffix(FieldScanStatus, v))
+}
+
+// ScanStatusIsNil applies the IsNil predicate on the "scan_status" field.
+func ScanStatusIsNil() predicate.File {
+	return predicate.File(sql.FieldIsNull(FieldScanStatus))
+}
+
+// ScanStatusNotNil applies the NotNil predicate on the "scan_status" field.
+func ScanStatusNotNil() predicate.File {
+	return predicate.File(sql.FieldNotNull(FieldScanStatus))
+}
+
+// ScanStatusEqualFold applies the EqualFold predicate on the "scan_status" field.
+func ScanStatusEqualFold(v string) predicate.File {
+	return predicate.File(sql.FieldEqualFold(FieldScanStatus, v))
+}
+
+// ScanStatusContainsFold applies the ContainsFold predicate on the "scan_status" field.
+func ScanStatusContainsFold(v string) predicate.File {
+	return predicate.File(sql.FieldContainsFold(FieldScanStatus, v))
+}
+
 // HasEnvironment applies the HasEdge predicate on the "environment" edge.
 func HasEnvironment() predicate.File {
 	return predicate.File(func(s *sql.Selector) {
@@ -494,6 +494,20 @@ func (_c *FileCreate) SetNillableLastAccessedAt(v *time.Time) *FileCreate {
 	return _c
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_c *FileCreate) SetScanStatus(v string) *FileCreate {
+	_c.mutation.SetScanStatus(v)
+	return _c
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_c *FileCreate) SetNillableScanStatus(v *string) *FileCreate {
+	if v != nil {
+		_c.SetScanStatus(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FileCreate) SetID(v string) *FileCreate {
 	_c.mutation.SetID(v)
@@ -1051,6 +1065,10 @@ func (_c *FileCreate) createSpec() (*File, *sqlgraph.CreateSpec) {
 		_spec.SetField(file.FieldLastAccessedAt, field.TypeTime, value)
 		_node.LastAccessedAt = &value
 	}
+	if value, ok := _c.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeString, value)
+		_node.ScanStatus = value
+	}
 	if nodes := _c.mutation.EnvironmentIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -680,6 +680,26 @@ func (_u *FileUpdate) ClearLastAccessedAt() *FileUpdate {
 	return _u
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdate) SetScanStatus(v string) *FileUpdate {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdate) SetNillableScanStatus(v *string) *FileUpdate {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileUpdate) ClearScanStatus() *FileUpdate {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
 // SetEnvironment sets the "environment" edge to the CustomTypeEnum entity.
 func (_u *FileUpdate) SetEnvironment(v *CustomTypeEnum) *FileUpdate {
 	return _u.SetEnvironmentID(v.ID)
@@ -1632,6 +1652,12 @@ func (_u *FileUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.LastAccessedAtCleared() {
 		_spec.ClearField(file.FieldLastAccessedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeString, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(file.FieldScanStatus, field.TypeString)
+	}
 	if _u.mutation.EnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3243,6 +3269,26 @@ func (_u *FileUpdateOne) ClearLastAccessedAt() *FileUpdateOne {
 	return _u
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileUpdateOne) SetScanStatus(v string) *FileUpdateOne {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileUpdateOne) SetNillableScanStatus(v *string) *FileUpdateOne {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileUpdateOne) ClearScanStatus() *FileUpdateOne {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
 // SetEnvironment sets the "environment" edge to the CustomTypeEnum entity.
 func (_u *FileUpdateOne) SetEnvironment(v *CustomTypeEnum) *FileUpdateOne {
 	return _u.SetEnvironmentID(v.ID)
@@ -4225,6 +4271,12 @@ func (_u *FileUpdateOne) sqlSave(ctx context.Context) (_node *File, err error) {
 	if _u.mutation.LastAccessedAtCleared() {
 		_spec.ClearField(file.FieldLastAccessedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(file.FieldScanStatus, field.TypeString, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(file.FieldScanStatus, field.TypeString)
+	}
 	if _u.mutation.EnvironmentCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -23,6 +23,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -71,6 +72,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -14087,6 +14089,166 @@ func newCustomDomainPaginateArgs(rv map[string]any) *customdomainPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *CustomRoleQuery) CollectFields(ctx context.Context, satisfies ...string) (*CustomRoleQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *CustomRoleQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(customrole.Columns))
+		selectedFields = []string{customrole.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, organizationImplementors)...); err != nil {
+				return err
+			}
+			_q.withOwner = query
+			if _, ok := fieldSeen[customrole.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldOwnerID)
+				fieldSeen[customrole.FieldOwnerID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[customrole.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldCreatedAt)
+				fieldSeen[customrole.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[customrole.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldUpdatedAt)
+				fieldSeen[customrole.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[customrole.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldCreatedBy)
+				fieldSeen[customrole.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[customrole.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldUpdatedBy)
+				fieldSeen[customrole.FieldUpdatedBy] = struct{}{}
+			}
+		case "updatedByImpersonator":
+			if _, ok := fieldSeen[customrole.FieldUpdatedByImpersonator]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldUpdatedByImpersonator)
+				fieldSeen[customrole.FieldUpdatedByImpersonator] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[customrole.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldOwnerID)
+				fieldSeen[customrole.FieldOwnerID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[customrole.FieldName]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldName)
+				fieldSeen[customrole.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[customrole.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldDescription)
+				fieldSeen[customrole.FieldDescription] = struct{}{}
+			}
+		case "permissions":
+			if _, ok := fieldSeen[customrole.FieldPermissions]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldPermissions)
+				fieldSeen[customrole.FieldPermissions] = struct{}{}
+			}
+		case "programIds":
+			if _, ok := fieldSeen[customrole.FieldProgramIds]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldProgramIds)
+				fieldSeen[customrole.FieldProgramIds] = struct{}{}
+			}
+		case "groupIds":
+			if _, ok := fieldSeen[customrole.FieldGroupIds]; !ok {
+				selectedFields = append(selectedFields, customrole.FieldGroupIds)
+				fieldSeen[customrole.FieldGroupIds] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type customrolePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []CustomRolePaginateOption
+}
+
+func newCustomRolePaginateArgs(rv map[string]any) *customrolePaginateArgs {
+	args := &customrolePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*CustomRoleOrder:
+			args.opts = append(args.opts, WithCustomRoleOrder(v))
+		case []any:
+			var orders []*CustomRoleOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &CustomRoleOrder{Field: &CustomRoleOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithCustomRoleOrder(orders))
+		}
+	}
+	if v, ok := rv[whereField].(*CustomRoleWhereInput); ok {
+		args.opts = append(args.opts, WithCustomRoleFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *CustomTypeEnumQuery) CollectFields(ctx context.Context, satisfies ...string) (*CustomTypeEnumQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -22328,6 +22490,11 @@ func (_q *EntityQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 				selectedFields = append(selectedFields, entity.FieldSoc2PeriodEnd)
 				fieldSeen[entity.FieldSoc2PeriodEnd] = struct{}{}
 			}
+		case "soc2LapsedAt":
+			if _, ok := fieldSeen[entity.FieldSoc2LapsedAt]; !ok {
+				selectedFields = append(selectedFields, entity.FieldSoc2LapsedAt)
+				fieldSeen[entity.FieldSoc2LapsedAt] = struct{}{}
+			}
 		case "contractStartDate":
 			if _, ok := fieldSeen[entity.FieldContractStartDate]; !ok {
 				selectedFields = append(selectedFields, entity.FieldContractStartDate)
@@ -26508,6 +26675,11 @@ func (_q *FileQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 				selectedFields = append(selectedFields, file.FieldLastAccessedAt)
 				fieldSeen[file.FieldLastAccessedAt] = struct{}{}
 			}
+		case "scanStatus":
+			if _, ok := fieldSeen[file.FieldScanStatus]; !ok {
+				selectedFields = append(selectedFields, file.FieldScanStatus)
+				fieldSeen[file.FieldScanStatus] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -60985,6 +61157,184 @@ func (_q *OrganizationQuery) collectField(ctx context.Context, oneNode bool, opC
 				*wq = *query
 			})
 
+		case "retentionRules":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&RetentionRuleClient{config: _q.config}).Query()
+			)
+			args := newRetentionRulePaginateArgs(fieldArgs(ctx, new(RetentionRuleWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newRetentionRulePager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*Organization) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID string `sql:"owner_id"`
+							Count  int    `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(organization.RetentionRulesColumn), ids...))
+						})
+						if err := query.GroupBy(organization.RetentionRulesColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[string]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[162] == nil {
+								nodes[i].Edges.totalCount[162] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[162][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Organization) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.RetentionRules)
+							if nodes[i].Edges.totalCount[162] == nil {
+								nodes[i].Edges.totalCount[162] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[162][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, retentionruleImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(organization.RetentionRulesColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedRetentionRules(alias, func(wq *RetentionRuleQuery) {
+				*wq = *query
+			})
+
+		case "customRoles":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&CustomRoleClient{config: _q.config}).Query()
+			)
+			args := newCustomRolePaginateArgs(fieldArgs(ctx, new(CustomRoleWhereInput), path...))
+			if err := validateFirstLast(args.first, args.last); err != nil {
+				return fmt.Errorf("validate first and last in path %q: %w", path, err)
+			}
+			pager, err := newCustomRolePager(args.opts, args.last != nil)
+			if err != nil {
+				return fmt.Errorf("create new pager in path %q: %w", path, err)
+			}
+			if query, err = pager.applyFilter(query); err != nil {
+				return err
+			}
+			ignoredEdges := !hasCollectedField(ctx, append(path, edgesField)...)
+			if hasCollectedField(ctx, append(path, totalCountField)...) || hasCollectedField(ctx, append(path, pageInfoField)...) {
+				hasPagination := args.after != nil || args.first != nil || args.before != nil || args.last != nil
+				if hasPagination || ignoredEdges {
+					query := query.Clone()
+					_q.loadTotal = append(_q.loadTotal, func(ctx context.Context, nodes []*Organization) error {
+						ids := make([]driver.Value, len(nodes))
+						for i := range nodes {
+							ids[i] = nodes[i].ID
+						}
+						var v []struct {
+							NodeID string `sql:"owner_id"`
+							Count  int    `sql:"count"`
+						}
+						query.Where(func(s *sql.Selector) {
+							s.Where(sql.InValues(s.C(organization.CustomRolesColumn), ids...))
+						})
+						if err := query.GroupBy(organization.CustomRolesColumn).Aggregate(Count()).Scan(ctx, &v); err != nil {
+							return err
+						}
+						m := make(map[string]int, len(v))
+						for i := range v {
+							m[v[i].NodeID] = v[i].Count
+						}
+						for i := range nodes {
+							n := m[nodes[i].ID]
+							if nodes[i].Edges.totalCount[163] == nil {
+								nodes[i].Edges.totalCount[163] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[163][alias] = n
+						}
+						return nil
+					})
+				} else {
+					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Organization) error {
+						for i := range nodes {
+							n := len(nodes[i].Edges.CustomRoles)
+							if nodes[i].Edges.totalCount[163] == nil {
+								nodes[i].Edges.totalCount[163] = make(map[string]int)
+							}
+							nodes[i].Edges.totalCount[163][alias] = n
+						}
+						return nil
+					})
+				}
+			}
+			if ignoredEdges || (args.first != nil && *args.first == 0) || (args.last != nil && *args.last == 0) {
+				continue
+			}
+			if query, err = pager.applyCursors(query, args.after, args.before); err != nil {
+				return err
+			}
+			path = append(path, edgesField, nodeField)
+			if field := collectedField(ctx, path...); field != nil {
+				if err := query.collectField(ctx, false, opCtx, *field, path, mayAddCondition(satisfies, customroleImplementors)...); err != nil {
+					return err
+				}
+			}
+			if limit := paginateLimit(args.first, args.last); limit > 0 {
+				if oneNode {
+					pager.applyOrder(query.Limit(limit))
+				} else {
+					modify := entgql.LimitPerRow(organization.CustomRolesColumn, limit, pager.orderExpr(query))
+					query.modifiers = append(query.modifiers, modify)
+				}
+			} else {
+				query = pager.applyOrder(query)
+			}
+			_q.WithNamedCustomRoles(alias, func(wq *CustomRoleQuery) {
+				*wq = *query
+			})
+
 		case "members":
 			var (
 				alias = field.Alias
@@ -61028,10 +61378,10 @@ func (_q *OrganizationQuery) collectField(ctx context.Context, oneNode bool, opC
 						}
 						for i := range nodes {
 							n := m[nodes[i].ID]
-							if nodes[i].Edges.totalCount[162] == nil {
-								nodes[i].Edges.totalCount[162] = make(map[string]int)
+							if nodes[i].Edges.totalCount[164] == nil {
+								nodes[i].Edges.totalCount[164] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[162][alias] = n
+							nodes[i].Edges.totalCount[164][alias] = n
 						}
 						return nil
 					})
@@ -61039,10 +61389,10 @@ func (_q *OrganizationQuery) collectField(ctx context.Context, oneNode bool, opC
 					_q.loadTotal = append(_q.loadTotal, func(_ context.Context, nodes []*Organization) error {
 						for i := range nodes {
 							n := len(nodes[i].Edges.Members)
-							if nodes[i].Edges.totalCount[162] == nil {
-								nodes[i].Edges.totalCount[162] = make(map[string]int)
+							if nodes[i].Edges.totalCount[164] == nil {
+								nodes[i].Edges.totalCount[164] = make(map[string]int)
 							}
-							nodes[i].Edges.totalCount[162][alias] = n
+							nodes[i].Edges.totalCount[164][alias] = n
 						}
 						return nil
 					})
@@ -71205,6 +71555,171 @@ func newRemediationPaginateArgs(rv map[string]any) *remediationPaginateArgs {
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *RetentionRuleQuery) CollectFields(ctx context.Context, satisfies ...string) (*RetentionRuleQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *RetentionRuleQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(retentionrule.Columns))
+		selectedFields = []string{retentionrule.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+
+		case "owner":
+			var (
+				alias = field.Alias
+				path  = append(path, alias)
+				query = (&OrganizationClient{config: _q.config}).Query()
+			)
+			if err := query.collectField(ctx, oneNode, opCtx, field, path, mayAddCondition(satisfies, organizationImplementors)...); err != nil {
+				return err
+			}
+			_q.withOwner = query
+			if _, ok := fieldSeen[retentionrule.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldOwnerID)
+				fieldSeen[retentionrule.FieldOwnerID] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[retentionrule.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldCreatedAt)
+				fieldSeen[retentionrule.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[retentionrule.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldUpdatedAt)
+				fieldSeen[retentionrule.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[retentionrule.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldCreatedBy)
+				fieldSeen[retentionrule.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[retentionrule.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldUpdatedBy)
+				fieldSeen[retentionrule.FieldUpdatedBy] = struct{}{}
+			}
+		case "updatedByImpersonator":
+			if _, ok := fieldSeen[retentionrule.FieldUpdatedByImpersonator]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldUpdatedByImpersonator)
+				fieldSeen[retentionrule.FieldUpdatedByImpersonator] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[retentionrule.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldOwnerID)
+				fieldSeen[retentionrule.FieldOwnerID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[retentionrule.FieldName]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldName)
+				fieldSeen[retentionrule.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[retentionrule.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldDescription)
+				fieldSeen[retentionrule.FieldDescription] = struct{}{}
+			}
+		case "category":
+			if _, ok := fieldSeen[retentionrule.FieldCategory]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldCategory)
+				fieldSeen[retentionrule.FieldCategory] = struct{}{}
+			}
+		case "objectType":
+			if _, ok := fieldSeen[retentionrule.FieldObjectType]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldObjectType)
+				fieldSeen[retentionrule.FieldObjectType] = struct{}{}
+			}
+		case "retentionDays":
+			if _, ok := fieldSeen[retentionrule.FieldRetentionDays]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldRetentionDays)
+				fieldSeen[retentionrule.FieldRetentionDays] = struct{}{}
+			}
+		case "purge":
+			if _, ok := fieldSeen[retentionrule.FieldPurge]; !ok {
+				selectedFields = append(selectedFields, retentionrule.FieldPurge)
+				fieldSeen[retentionrule.FieldPurge] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type retentionrulePaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RetentionRulePaginateOption
+}
+
+func newRetentionRulePaginateArgs(rv map[string]any) *retentionrulePaginateArgs {
+	args := &retentionrulePaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case []*RetentionRuleOrder:
+			args.opts = append(args.opts, WithRetentionRuleOrder(v))
+		case []any:
+			var orders []*RetentionRuleOrder
+			for i := range v {
+				mv, ok := v[i].(map[string]any)
+				if !ok {
+					continue
+				}
+				var (
+					err1, err2 error
+					order      = &RetentionRuleOrder{Field: &RetentionRuleOrderField{}, Direction: entgql.OrderDirectionAsc}
+				)
+				if d, ok := mv[directionField]; ok {
+					err1 = order.Direction.UnmarshalGQL(d)
+				}
+				if f, ok := mv[fieldField]; ok {
+					err2 = order.Field.UnmarshalGQL(f)
+				}
+				if err1 == nil && err2 == nil {
+					orders = append(orders, order)
+				}
+			}
+			args.opts = append(args.opts, WithRetentionRuleOrder(orders))
+		}
+	}
+	if v, ok := rv[whereField].(*RetentionRuleWhereInput); ok {
+		args.opts = append(args.opts, WithRetentionRuleFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *ReviewQuery) CollectFields(ctx context.Context, satisfies ...string) (*ReviewQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -88218,6 +88733,11 @@ func (_q *TrustCenterFAQQuery) collectField(ctx context.Context, oneNode bool, o
 				selectedFields = append(selectedFields, trustcenterfaq.FieldDisplayOrder)
 				fieldSeen[trustcenterfaq.FieldDisplayOrder] = struct{}{}
 			}
+		case "approved":
+			if _, ok := fieldSeen[trustcenterfaq.FieldApproved]; !ok {
+				selectedFields = append(selectedFields, trustcenterfaq.FieldApproved)
+				fieldSeen[trustcenterfaq.FieldApproved] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -2795,6 +2795,14 @@ func (_m *CustomDomain) DNSVerification(ctx context.Context) (*DNSVerification,
 	return result, MaskNotFound(err)
 }
 
+func (_m *CustomRole) Owner(ctx context.Context) (*Organization, error) {
+	result, err := _m.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryOwner().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (_m *CustomTypeEnum) Owner(ctx context.Context) (*Organization, error) {
 	result, err := _m.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -12600,6 +12608,48 @@ func (_m *Organization) VendorRiskScores(
 	return _m.QueryVendorRiskScores().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (_m *Organization) RetentionRules(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*RetentionRuleOrder, where *RetentionRuleWhereInput,
+) (*RetentionRuleConnection, error) {
+	opts := []RetentionRulePaginateOption{
+		WithRetentionRuleOrder(orderBy),
+		WithRetentionRuleFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[162][alias]
+	if nodes, err := _m.NamedRetentionRules(alias); err == nil || hasTotalCount {
+		pager, err := newRetentionRulePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &RetentionRuleConnection{Edges: []*RetentionRuleEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryRetentionRules().Paginate(ctx, after, first, before, last, opts...)
+}
+
+func (_m *Organization) CustomRoles(
+	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*CustomRoleOrder, where *CustomRoleWhereInput,
+) (*CustomRoleConnection, error) {
+	opts := []CustomRolePaginateOption{
+		WithCustomRoleOrder(orderBy),
+		WithCustomRoleFilter(where.Filter),
+	}
+	alias := graphql.GetFieldContext(ctx).Field.Alias
+	totalCount, hasTotalCount := _m.Edges.totalCount[163][alias]
+	if nodes, err := _m.NamedCustomRoles(alias); err == nil || hasTotalCount {
+		pager, err := newCustomRolePager(opts, last != nil)
+		if err != nil {
+			return nil, err
+		}
+		conn := &CustomRoleConnection{Edges: []*CustomRoleEdge{}, TotalCount: totalCount}
+		conn.build(nodes, pager, after, first, before, last)
+		return conn, nil
+	}
+	return _m.QueryCustomRoles().Paginate(ctx, after, first, before, last, opts...)
+}
+
 func (_m *Organization) Members(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*OrgMembershipOrder, where *OrgMembershipWhereInput,
 ) (*OrgMembershipConnection, error) {
@@ -12608,7 +12658,7 @@ func (_m *Organization) Members(
 		WithOrgMembershipFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := _m.Edges.totalCount[162][alias]
+	totalCount, hasTotalCount := _m.Edges.totalCount[164][alias]
 	if nodes, err := _m.NamedMembers(alias); err == nil || hasTotalCount {
 		pager, err := newOrgMembershipPager(opts, last != nil)
 		if err != nil {
@@ -14665,6 +14715,14 @@ func (_m *Remediation) WorkflowObjectRefs(
 	return _m.QueryWorkflowObjectRefs().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (_m *RetentionRule) Owner(ctx context.Context) (*Organization, error) {
+	result, err := _m.Edges.OwnerOrErr()
+	if IsNotLoaded(err) {
+		result, err = _m.QueryOwner().Only(ctx)
+	}
+	return result, MaskNotFound(err)
+}
+
 func (_m *Review) Owner(ctx context.Context) (*Organization, error) {
 	result, err := _m.Edges.OwnerOrErr()
 	if IsNotLoaded(err) {
@@ -5069,6 +5069,114 @@ func (c *CustomDomainUpdateOne) SetInput(i UpdateCustomDomainInput) *CustomDomai
 	return c
 }
 
+// CreateCustomRoleInput represents a mutation input for creating customroles.
+type CreateCustomRoleInput struct {
+	Name        string   `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	ProgramIds  []string `json:"program_ids,omitempty"`
+	GroupIds    []string `json:"group_ids,omitempty"`
+	OwnerID     *string  `json:"owner_id,omitempty"`
+}
+
+// Mutate applies the CreateCustomRoleInput on the CustomRoleMutation builder.
+func (i *CreateCustomRoleInput) Mutate(m *CustomRoleMutation) {
+	m.SetName(i.Name)
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Permissions; v != nil {
+		m.SetPermissions(v)
+	}
+	if v := i.ProgramIds; v != nil {
+		m.SetProgramIds(v)
+	}
+	if v := i.GroupIds; v != nil {
+		m.SetGroupIds(v)
+	}
+	if v := i.OwnerID; v != nil {
+		m.SetOwnerID(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateCustomRoleInput on the CustomRoleCreate builder.
+func (c *CustomRoleCreate) SetInput(i CreateCustomRoleInput) *CustomRoleCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateCustomRoleInput represents a mutation input for updating customroles.
+type UpdateCustomRoleInput struct {
+	Name              *string `json:"name,omitempty"`
+	ClearDescription  bool
+	Description       *string  `json:"description,omitempty"`
+	Permissions       []string `json:"permissions,omitempty"`
+	AppendPermissions []string
+	ClearProgramIds   bool
+	ProgramIds        []string `json:"program_ids,omitempty"`
+	AppendProgramIds  []string
+	ClearGroupIds     bool
+	GroupIds          []string `json:"group_ids,omitempty"`
+	AppendGroupIds    []string
+	ClearOwner        bool
+	OwnerID           *string `json:"owner_id,omitempty"`
+}
+
+// Mutate applies the UpdateCustomRoleInput on the CustomRoleMutation builder.
+func (i *UpdateCustomRoleInput) Mutate(m *CustomRoleMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Permissions; v != nil {
+		m.SetPermissions(v)
+	}
+	if i.AppendPermissions != nil {
+		m.AppendPermissions(i.Permissions)
+	}
+	if i.ClearProgramIds {
+		m.ClearProgramIds()
+	}
+	if v := i.ProgramIds; v != nil {
+		m.SetProgramIds(v)
+	}
+	if i.AppendProgramIds != nil {
+		m.AppendProgramIds(i.ProgramIds)
+	}
+	if i.ClearGroupIds {
+		m.ClearGroupIds()
+	}
+	if v := i.GroupIds; v != nil {
+		m.SetGroupIds(v)
+	}
+	if i.AppendGroupIds != nil {
+		m.AppendGroupIds(i.GroupIds)
+	}
+	if i.ClearOwner {
+		m.ClearOwner()
+	}
+	if v := i.OwnerID; v != nil {
+		m.SetOwnerID(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateCustomRoleInput on the CustomRoleUpdate builder.
+func (c *CustomRoleUpdate) SetInput(i UpdateCustomRoleInput) *CustomRoleUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateCustomRoleInput on the CustomRoleUpdateOne builder.
+func (c *CustomRoleUpdateOne) SetInput(i UpdateCustomRoleInput) *CustomRoleUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateCustomTypeEnumInput represents a mutation input for creating customtypeenums.
 type CreateCustomTypeEnumInput struct {
 	InternalNotes     *string  `json:"internal_notes,omitempty"`
@@ -16627,6 +16735,8 @@ type CreateOrganizationInput struct {
 	DiscussionIDs                        []string   `json:"discussion_ids,omitempty"`
 	VendorScoringConfigIDs               []string   `json:"vendor_scoring_config_ids,omitempty"`
 	VendorRiskScoreIDs                   []string   `json:"vendor_risk_score_ids,omitempty"`
+	RetentionRuleIDs                     []string   `json:"retention_rule_ids,omitempty"`
+	CustomRoleIDs                        []string   `json:"custom_role_ids,omitempty"`
 }
 
 // Mutate applies the CreateOrganizationInput on the OrganizationMutation builder.
@@ -17127,6 +17237,12 @@ func (i *CreateOrganizationInput) Mutate(m *OrganizationMutation) {
 	if v := i.VendorRiskScoreIDs; len(v) > 0 {
 		m.AddVendorRiskScoreIDs(v...)
 	}
+	if v := i.RetentionRuleIDs; len(v) > 0 {
+		m.AddRetentionRuleIDs(v...)
+	}
+	if v := i.CustomRoleIDs; len(v) > 0 {
+		m.AddCustomRoleIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the CreateOrganizationInput on the OrganizationCreate builder.
@@ -17619,6 +17735,12 @@ type UpdateOrganizationInput struct {
 	ClearVendorRiskScores                      bool
 	AddVendorRiskScoreIDs                      []string `json:"add_vendor_risk_score_ids,omitempty"`
 	RemoveVendorRiskScoreIDs                   []string `json:"remove_vendor_risk_score_ids,omitempty"`
+	ClearRetentionRules                        bool
+	AddRetentionRuleIDs                        []string `json:"add_retention_rule_ids,omitempty"`
+	RemoveRetentionRuleIDs                     []string `json:"remove_retention_rule_ids,omitempty"`
+	ClearCustomRoles                           bool
+	AddCustomRoleIDs                           []string `json:"add_custom_role_ids,omitempty"`
+	RemoveCustomRoleIDs                        []string `json:"remove_custom_role_ids,omitempty"`
 }
 
 // Mutate applies the UpdateOrganizationInput on the OrganizationMutation builder.
@@ -19069,6 +19191,24 @@ func (i *UpdateOrganizationInput) Mutate(m *OrganizationMutation) {
 	if v := i.RemoveVendorRiskScoreIDs; len(v) > 0 {
 		m.RemoveVendorRiskScoreIDs(v...)
 	}
+	if i.ClearRetentionRules {
+		m.ClearRetentionRules()
+	}
+	if v := i.AddRetentionRuleIDs; len(v) > 0 {
+		m.AddRetentionRuleIDs(v...)
+	}
+	if v := i.RemoveRetentionRuleIDs; len(v) > 0 {
+		m.RemoveRetentionRuleIDs(v...)
+	}
+	if i.ClearCustomRoles {
+		m.ClearCustomRoles()
+	}
+	if v := i.AddCustomRoleIDs; len(v) > 0 {
+		m.AddCustomRoleIDs(v...)
+	}
+	if v := i.RemoveCustomRoleIDs; len(v) > 0 {
+		m.RemoveCustomRoleIDs(v...)
+	}
 }
 
 // SetInput applies the change-set in the UpdateOrganizationInput on the OrganizationUpdate builder.
@@ -22649,6 +22789,108 @@ func (c *RemediationUpdateOne) SetInput(i UpdateRemediationInput) *RemediationUp
 	return c
 }
 
+// CreateRetentionRuleInput represents a mutation input for creating retentionrules.
+type CreateRetentionRuleInput struct {
+	Name          string  `json:"name,omitempty"`
+	Description   *string `json:"description,omitempty"`
+	Category      *string `json:"category,omitempty"`
+	ObjectType    *string `json:"object_type,omitempty"`
+	RetentionDays int     `json:"retention_days,omitempty"`
+	Purge         *bool   `json:"purge,omitempty"`
+	OwnerID       *string `json:"owner_id,omitempty"`
+}
+
+// Mutate applies the CreateRetentionRuleInput on the RetentionRuleMutation builder.
+func (i *CreateRetentionRuleInput) Mutate(m *RetentionRuleMutation) {
+	m.SetName(i.Name)
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if v := i.Category; v != nil {
+		m.SetCategory(*v)
+	}
+	if v := i.ObjectType; v != nil {
+		m.SetObjectType(*v)
+	}
+	m.SetRetentionDays(i.RetentionDays)
+	if v := i.Purge; v != nil {
+		m.SetPurge(*v)
+	}
+	if v := i.OwnerID; v != nil {
+		m.SetOwnerID(*v)
+	}
+}
+
+// SetInput applies the change-set in the CreateRetentionRuleInput on the RetentionRuleCreate builder.
+func (c *RetentionRuleCreate) SetInput(i CreateRetentionRuleInput) *RetentionRuleCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// UpdateRetentionRuleInput represents a mutation input for updating retentionrules.
+type UpdateRetentionRuleInput struct {
+	Name             *string `json:"name,omitempty"`
+	ClearDescription bool
+	Description      *string `json:"description,omitempty"`
+	ClearCategory    bool
+	Category         *string `json:"category,omitempty"`
+	ClearObjectType  bool
+	ObjectType       *string `json:"object_type,omitempty"`
+	RetentionDays    *int    `json:"retention_days,omitempty"`
+	Purge            *bool   `json:"purge,omitempty"`
+	ClearOwner       bool
+	OwnerID          *string `json:"owner_id,omitempty"`
+}
+
+// Mutate applies the UpdateRetentionRuleInput on the RetentionRuleMutation builder.
+func (i *UpdateRetentionRuleInput) Mutate(m *RetentionRuleMutation) {
+	if v := i.Name; v != nil {
+		m.SetName(*v)
+	}
+	if i.ClearDescription {
+		m.ClearDescription()
+	}
+	if v := i.Description; v != nil {
+		m.SetDescription(*v)
+	}
+	if i.ClearCategory {
+		m.ClearCategory()
+	}
+	if v := i.Category; v != nil {
+		m.SetCategory(*v)
+	}
+	if i.ClearObjectType {
+		m.ClearObjectType()
+	}
+	if v := i.ObjectType; v != nil {
+		m.SetObjectType(*v)
+	}
+	if v := i.RetentionDays; v != nil {
+		m.SetRetentionDays(*v)
+	}
+	if v := i.Purge; v != nil {
+		m.SetPurge(*v)
+	}
+	if i.ClearOwner {
+		m.ClearOwner()
+	}
+	if v := i.OwnerID; v != nil {
+		m.SetOwnerID(*v)
+	}
+}
+
+// SetInput applies the change-set in the UpdateRetentionRuleInput on the RetentionRuleUpdate builder.
+func (c *RetentionRuleUpdate) SetInput(i UpdateRetentionRuleInput) *RetentionRuleUpdate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
+// SetInput applies the change-set in the UpdateRetentionRuleInput on the RetentionRuleUpdateOne builder.
+func (c *RetentionRuleUpdateOne) SetInput(i UpdateRetentionRuleInput) *RetentionRuleUpdateOne {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateReviewInput represents a mutation input for creating reviews.
 type CreateReviewInput struct {
 	Tags              []string               `json:"tags,omitempty"`
@@ -28482,6 +28724,7 @@ type CreateTrustCenterFAQInput struct {
 	TrustCenterFaqKindName *string  `json:"trust_center_faq_kind_name,omitempty"`
 	ReferenceLink          *string  `json:"reference_link,omitempty"`
 	DisplayOrder           *int     `json:"display_order,omitempty"`
+	Approved               *bool    `json:"approved,omitempty"`
 	TrustCenterFaqKindID   *string  `json:"trust_center_faq_kind_id,omitempty"`
 	BlockedGroupIDs        []string `json:"blocked_group_ids,omitempty"`
 	EditorIDs              []string `json:"editor_ids,omitempty"`
@@ -28500,6 +28743,9 @@ func (i *CreateTrustCenterFAQInput) Mutate(m *TrustCenterFAQMutation) {
 	if v := i.DisplayOrder; v != nil {
 		m.SetDisplayOrder(*v)
 	}
+	if v := i.Approved; v != nil {
+		m.SetApproved(*v)
+	}
 	if v := i.TrustCenterFaqKindID; v != nil {
 		m.SetTrustCenterFaqKindID(*v)
 	}
@@ -28529,6 +28775,8 @@ type UpdateTrustCenterFAQInput struct {
 	ReferenceLink               *string `json:"reference_link,omitempty"`
 	ClearDisplayOrder           bool
 	DisplayOrder                *int `json:"display_order,omitempty"`
+	ClearApproved               bool
+	Approved                    *bool `json:"approved,omitempty"`
 	ClearTrustCenterFaqKind     bool
 	TrustCenterFaqKindID        *string `json:"trust_center_faq_kind_id,omitempty"`
 	ClearBlockedGroups          bool
@@ -28559,6 +28807,12 @@ func (i *UpdateTrustCenterFAQInput) Mutate(m *TrustCenterFAQMutation) {
 	if v := i.DisplayOrder; v != nil {
 		m.SetDisplayOrder(*v)
 	}
+	if i.ClearApproved {
+		m.ClearApproved()
+	}
+	if v := i.Approved; v != nil {
+		m.SetApproved(*v)
+	}
 	if i.ClearTrustCenterFaqKind {
 		m.ClearTrustCenterFaqKind()
 	}
@@ -22,6 +22,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -70,6 +71,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -179,6 +181,11 @@ var customdomainImplementors = []string{"CustomDomain", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*CustomDomain) IsNode() {}
 
+var customroleImplementors = []string{"CustomRole", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*CustomRole) IsNode() {}
+
 var customtypeenumImplementors = []string{"CustomTypeEnum", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -419,6 +426,11 @@ var remediationImplementors = []string{"Remediation", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*Remediation) IsNode() {}
 
+var retentionruleImplementors = []string{"RetentionRule", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*RetentionRule) IsNode() {}
+
 var reviewImplementors = []string{"Review", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -779,6 +791,15 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			}
 		}
 		return query.Only(ctx)
+	case customrole.Table:
+		query := c.CustomRole.Query().
+			Where(customrole.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, customroleImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case customtypeenum.Table:
 		query := c.CustomTypeEnum.Query().
 			Where(customtypeenum.ID(id))
@@ -1211,6 +1232,15 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			}
 		}
 		return query.Only(ctx)
+	case retentionrule.Table:
+		query := c.RetentionRule.Query().
+			Where(retentionrule.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, retentionruleImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case review.Table:
 		query := c.Review.Query().
 			Where(review.ID(id))
@@ -1825,6 +1855,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case customrole.Table:
+		query := c.CustomRole.Query().
+			Where(customrole.IDIn(ids...))
+		query, err := query.CollectFields(ctx, customroleImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case customtypeenum.Table:
 		query := c.CustomTypeEnum.Query().
 			Where(customtypeenum.IDIn(ids...))
@@ -2593,6 +2639,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case retentionrule.Table:
+		query := c.RetentionRule.Query().
+			Where(retentionrule.IDIn(ids...))
+		query, err := query.CollectFields(ctx, retentionruleImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case review.Table:
 		query := c.Review.Query().
 			Where(review.IDIn(ids...))
@@ -27,6 +27,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -75,6 +76,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -6900,20 +6902,20 @@ func (_m *CustomDomain) ToEdge(order *CustomDomainOrder) *CustomDomainEdge {
 	}
 }
 
-// CustomTypeEnumEdge is the edge representation of CustomTypeEnum.
-type CustomTypeEnumEdge struct {
-	Node   *CustomTypeEnum `json:"node"`
-	Cursor Cursor          `json:"cursor"`
+// CustomRoleEdge is the edge representation of CustomRole.
+type CustomRoleEdge struct {
+	Node   *CustomRole `json:"node"`
+	Cursor Cursor      `json:"cursor"`
 }
 
-// CustomTypeEnumConnection is the connection containing edges to CustomTypeEnum.
-type CustomTypeEnumConnection struct {
-	Edges      []*CustomTypeEnumEdge `json:"edges"`
-	PageInfo   PageInfo              `json:"pageInfo"`
-	TotalCount int                   `json:"totalCount"`
+// CustomRoleConnection is the connection containing edges to CustomRole.
+type CustomRoleConnection struct {
+	Edges      []*CustomRoleEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
 }
 
-func (c *CustomTypeEnumConnection) build(nodes []*CustomTypeEnum, pager *customtypeenumPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *CustomRoleConnection) build(nodes []*CustomRole, pager *customrolePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -6923,21 +6925,21 @@ func (c *CustomTypeEnumConnection) build(nodes []*CustomTypeEnum, pager *customt
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *CustomTypeEnum
+	var nodeAt func(int) *CustomRole
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *CustomTypeEnum {
+		nodeAt = func(i int) *CustomRole {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *CustomTypeEnum {
+		nodeAt = func(i int) *CustomRole {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*CustomTypeEnumEdge, len(nodes))
+	c.Edges = make([]*CustomRoleEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &CustomTypeEnumEdge{
+		c.Edges[i] = &CustomRoleEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -6951,12 +6953,12 @@ func (c *CustomTypeEnumConnection) build(nodes []*CustomTypeEnum, pager *customt
 	}
 }
 
-// CustomTypeEnumPaginateOption enables pagination customization.
-type CustomTypeEnumPaginateOption func(*customtypeenumPager) error
+// CustomRolePaginateOption enables pagination customization.
+type CustomRolePaginateOption func(*customrolePager) error
 
-// WithCustomTypeEnumOrder configures pagination ordering.
-func WithCustomTypeEnumOrder(order []*CustomTypeEnumOrder) CustomTypeEnumPaginateOption {
-	return func(pager *customtypeenumPager) error {
+// WithCustomRoleOrder configures pagination ordering.
+func WithCustomRoleOrder(order []*CustomRoleOrder) CustomRolePaginateOption {
+	return func(pager *customrolePager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -6967,25 +6969,25 @@ func WithCustomTypeEnumOrder(order []*CustomTypeEnumOrder) CustomTypeEnumPaginat
 	}
 }
 
-// WithCustomTypeEnumFilter configures pagination filter.
-func WithCustomTypeEnumFilter(filter func(*CustomTypeEnumQuery) (*CustomTypeEnumQuery, error)) CustomTypeEnumPaginateOption {
-	return func(pager *customtypeenumPager) error {
+// WithCustomRoleFilter configures pagination filter.
+func WithCustomRoleFilter(filter func(*CustomRoleQuery) (*CustomRoleQuery, error)) CustomRolePaginateOption {
+	return func(pager *customrolePager) error {
 		if filter == nil {
-			return errors.New("CustomTypeEnumQuery filter cannot be nil")
+			return errors.New("CustomRoleQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type customtypeenumPager struct {
+type customrolePager struct {
 	reverse bool
-	order   []*CustomTypeEnumOrder
-	filter  func(*CustomTypeEnumQuery) (*CustomTypeEnumQuery, error)
+	order   []*CustomRoleOrder
+	filter  func(*CustomRoleQuery) (*CustomRoleQuery, error)
 }
 
-func newCustomTypeEnumPager(opts []CustomTypeEnumPaginateOption, reverse bool) (*customtypeenumPager, error) {
-	pager := &customtypeenumPager{reverse: reverse}
+func newCustomRolePager(opts []CustomRolePaginateOption, reverse bool) (*customrolePager, error) {
+	pager := &customrolePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -6999,14 +7001,14 @@ func newCustomTypeEnumPager(opts []CustomTypeEnumPaginateOption, reverse bool) (
 	return pager, nil
 }
 
-func (p *customtypeenumPager) applyFilter(query *CustomTypeEnumQuery) (*CustomTypeEnumQuery, error) {
+func (p *customrolePager) applyFilter(query *CustomRoleQuery) (*CustomRoleQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *customtypeenumPager) toCursor(_m *CustomTypeEnum) Cursor {
+func (p *customrolePager) toCursor(_m *CustomRole) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -7014,7 +7016,7 @@ func (p *customtypeenumPager) toCursor(_m *CustomTypeEnum) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *customtypeenumPager) applyCursors(query *CustomTypeEnumQuery, after, before *Cursor) (*CustomTypeEnumQuery, error) {
+func (p *customrolePager) applyCursors(query *CustomRoleQuery, after, before *Cursor) (*CustomRoleQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -7029,7 +7031,7 @@ func (p *customtypeenumPager) applyCursors(query *CustomTypeEnumQuery, after, be
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultCustomTypeEnumOrder.Field.column,
+		FieldID:     DefaultCustomRoleOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -7048,7 +7050,7 @@ func (p *customtypeenumPager) applyCursors(query *CustomTypeEnumQuery, after, be
 	return query, nil
 }
 
-func (p *customtypeenumPager) applyOrder(query *CustomTypeEnumQuery) *CustomTypeEnumQuery {
+func (p *customrolePager) applyOrder(query *CustomRoleQuery) *CustomRoleQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -7056,7 +7058,7 @@ func (p *customtypeenumPager) applyOrder(query *CustomTypeEnumQuery) *CustomType
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultCustomTypeEnumOrder.Field.column {
+		if o.Field.column == DefaultCustomRoleOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -7068,12 +7070,12 @@ func (p *customtypeenumPager) applyOrder(query *CustomTypeEnumQuery) *CustomType
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultCustomTypeEnumOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultCustomRoleOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *customtypeenumPager) orderExpr(query *CustomTypeEnumQuery) sql.Querier {
+func (p *customrolePager) orderExpr(query *CustomRoleQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -7092,26 +7094,26 @@ func (p *customtypeenumPager) orderExpr(query *CustomTypeEnumQuery) sql.Querier
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultCustomTypeEnumOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultCustomRoleOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to CustomTypeEnum.
-func (_m *CustomTypeEnumQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to CustomRole.
+func (_m *CustomRoleQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...CustomTypeEnumPaginateOption,
-) (*CustomTypeEnumConnection, error) {
+	before *Cursor, last *int, opts ...CustomRolePaginateOption,
+) (*CustomRoleConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newCustomTypeEnumPager(opts, last != nil)
+	pager, err := newCustomRolePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &CustomTypeEnumConnection{Edges: []*CustomTypeEnumEdge{}}
+	conn := &CustomRoleConnection{Edges: []*CustomRoleEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -7131,7 +7133,7 @@ func (_m *CustomTypeEnumQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimitSingle(first, last)
+	limit := paginateLimit(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -7150,70 +7152,42 @@ func (_m *CustomTypeEnumQuery) Paginate(
 }
 
 var (
-	// CustomTypeEnumOrderFieldCreatedAt orders CustomTypeEnum by created_at.
-	CustomTypeEnumOrderFieldCreatedAt = &CustomTypeEnumOrderField{
-		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
+	// CustomRoleOrderFieldCreatedAt orders CustomRole by created_at.
+	CustomRoleOrderFieldCreatedAt = &CustomRoleOrderField{
+		Value: func(_m *CustomRole) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: customtypeenum.FieldCreatedAt,
-		toTerm: customtypeenum.ByCreatedAt,
-		toCursor: func(_m *CustomTypeEnum) Cursor {
+		column: customrole.FieldCreatedAt,
+		toTerm: customrole.ByCreatedAt,
+		toCursor: func(_m *CustomRole) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// CustomTypeEnumOrderFieldUpdatedAt orders CustomTypeEnum by updated_at.
-	CustomTypeEnumOrderFieldUpdatedAt = &CustomTypeEnumOrderField{
-		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
+	// CustomRoleOrderFieldUpdatedAt orders CustomRole by updated_at.
+	CustomRoleOrderFieldUpdatedAt = &CustomRoleOrderField{
+		Value: func(_m *CustomRole) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: customtypeenum.FieldUpdatedAt,
-		toTerm: customtypeenum.ByUpdatedAt,
-		toCursor: func(_m *CustomTypeEnum) Cursor {
+		column: customrole.FieldUpdatedAt,
+		toTerm: customrole.ByUpdatedAt,
+		toCursor: func(_m *CustomRole) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// CustomTypeEnumOrderFieldObjectType orders CustomTypeEnum by object_type.
-	CustomTypeEnumOrderFieldObjectType = &CustomTypeEnumOrderField{
-		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
-			return _m.ObjectType, nil
-		},
-		column: customtypeenum.FieldObjectType,
-		toTerm: customtypeenum.ByObjectType,
-		toCursor: func(_m *CustomTypeEnum) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ObjectType,
-			}
-		},
-	}
-	// CustomTypeEnumOrderFieldField orders CustomTypeEnum by field.
-	CustomTypeEnumOrderFieldField = &CustomTypeEnumOrderField{
-		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
-			return _m.Field, nil
-		},
-		column: customtypeenum.FieldField,
-		toTerm: customtypeenum.ByField,
-		toCursor: func(_m *CustomTypeEnum) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Field,
-			}
-		},
-	}
-	// CustomTypeEnumOrderFieldName orders CustomTypeEnum by name.
-	CustomTypeEnumOrderFieldName = &CustomTypeEnumOrderField{
-		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
+	// CustomRoleOrderFieldName orders CustomRole by name.
+	CustomRoleOrderFieldName = &CustomRoleOrderField{
+		Value: func(_m *CustomRole) (ent.Value, error) {
 			return _m.Name, nil
 		},
-		column: customtypeenum.FieldName,
-		toTerm: customtypeenum.ByName,
-		toCursor: func(_m *CustomTypeEnum) Cursor {
+		column: customrole.FieldName,
+		toTerm: customrole.ByName,
+		toCursor: func(_m *CustomRole) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.Name,
@@ -7223,106 +7197,98 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f CustomTypeEnumOrderField) String() string {
+func (f CustomRoleOrderField) String() string {
 	var str string
 	switch f.column {
-	case CustomTypeEnumOrderFieldCreatedAt.column:
+	case CustomRoleOrderFieldCreatedAt.column:
 		str = "created_at"
-	case CustomTypeEnumOrderFieldUpdatedAt.column:
+	case CustomRoleOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case CustomTypeEnumOrderFieldObjectType.column:
-		str = "object_type"
-	case CustomTypeEnumOrderFieldField.column:
-		str = "field"
-	case CustomTypeEnumOrderFieldName.column:
+	case CustomRoleOrderFieldName.column:
 		str = "name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f CustomTypeEnumOrderField) MarshalGQL(w io.Writer) {
+func (f CustomRoleOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *CustomTypeEnumOrderField) UnmarshalGQL(v interface{}) error {
+func (f *CustomRoleOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("CustomTypeEnumOrderField %T must be a string", v)
+		return fmt.Errorf("CustomRoleOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *CustomTypeEnumOrderFieldCreatedAt
+		*f = *CustomRoleOrderFieldCreatedAt
 	case "updated_at":
-		*f = *CustomTypeEnumOrderFieldUpdatedAt
-	case "object_type":
-		*f = *CustomTypeEnumOrderFieldObjectType
-	case "field":
-		*f = *CustomTypeEnumOrderFieldField
+		*f = *CustomRoleOrderFieldUpdatedAt
 	case "name":
-		*f = *CustomTypeEnumOrderFieldName
+		*f = *CustomRoleOrderFieldName
 	default:
-		return fmt.Errorf("%s is not a valid CustomTypeEnumOrderField", str)
+		return fmt.Errorf("%s is not a valid CustomRoleOrderField", str)
 	}
 	return nil
 }
 
-// CustomTypeEnumOrderField defines the ordering field of CustomTypeEnum.
-type CustomTypeEnumOrderField struct {
-	// Value extracts the ordering value from the given CustomTypeEnum.
-	Value    func(*CustomTypeEnum) (ent.Value, error)
+// CustomRoleOrderField defines the ordering field of CustomRole.
+type CustomRoleOrderField struct {
+	// Value extracts the ordering value from the given CustomRole.
+	Value    func(*CustomRole) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) customtypeenum.OrderOption
-	toCursor func(*CustomTypeEnum) Cursor
+	toTerm   func(...sql.OrderTermOption) customrole.OrderOption
+	toCursor func(*CustomRole) Cursor
 }
 
-// CustomTypeEnumOrder defines the ordering of CustomTypeEnum.
-type CustomTypeEnumOrder struct {
-	Direction OrderDirection            `json:"direction"`
-	Field     *CustomTypeEnumOrderField `json:"field"`
+// CustomRoleOrder defines the ordering of CustomRole.
+type CustomRoleOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *CustomRoleOrderField `json:"field"`
 }
 
-// DefaultCustomTypeEnumOrder is the default ordering of CustomTypeEnum.
-var DefaultCustomTypeEnumOrder = &CustomTypeEnumOrder{
+// DefaultCustomRoleOrder is the default ordering of CustomRole.
+var DefaultCustomRoleOrder = &CustomRoleOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &CustomTypeEnumOrderField{
-		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
+	Field: &CustomRoleOrderField{
+		Value: func(_m *CustomRole) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: customtypeenum.FieldID,
-		toTerm: customtypeenum.ByID,
-		toCursor: func(_m *CustomTypeEnum) Cursor {
+		column: customrole.FieldID,
+		toTerm: customrole.ByID,
+		toCursor: func(_m *CustomRole) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts CustomTypeEnum into CustomTypeEnumEdge.
-func (_m *CustomTypeEnum) ToEdge(order *CustomTypeEnumOrder) *CustomTypeEnumEdge {
+// ToEdge converts CustomRole into CustomRoleEdge.
+func (_m *CustomRole) ToEdge(order *CustomRoleOrder) *CustomRoleEdge {
 	if order == nil {
-		order = DefaultCustomTypeEnumOrder
+		order = DefaultCustomRoleOrder
 	}
-	return &CustomTypeEnumEdge{
+	return &CustomRoleEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DNSVerificationEdge is the edge representation of DNSVerification.
-type DNSVerificationEdge struct {
-	Node   *DNSVerification `json:"node"`
-	Cursor Cursor           `json:"cursor"`
+// CustomTypeEnumEdge is the edge representation of CustomTypeEnum.
+type CustomTypeEnumEdge struct {
+	Node   *CustomTypeEnum `json:"node"`
+	Cursor Cursor          `json:"cursor"`
 }
 
-// DNSVerificationConnection is the connection containing edges to DNSVerification.
-type DNSVerificationConnection struct {
-	Edges      []*DNSVerificationEdge `json:"edges"`
-	PageInfo   PageInfo               `json:"pageInfo"`
-	TotalCount int                    `json:"totalCount"`
+// CustomTypeEnumConnection is the connection containing edges to CustomTypeEnum.
+type CustomTypeEnumConnection struct {
+	Edges      []*CustomTypeEnumEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
 }
 
-func (c *DNSVerificationConnection) build(nodes []*DNSVerification, pager *dnsverificationPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *CustomTypeEnumConnection) build(nodes []*CustomTypeEnum, pager *customtypeenumPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -7332,21 +7298,21 @@ func (c *DNSVerificationConnection) build(nodes []*DNSVerification, pager *dnsve
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *DNSVerification
+	var nodeAt func(int) *CustomTypeEnum
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *DNSVerification {
+		nodeAt = func(i int) *CustomTypeEnum {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *DNSVerification {
+		nodeAt = func(i int) *CustomTypeEnum {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DNSVerificationEdge, len(nodes))
+	c.Edges = make([]*CustomTypeEnumEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DNSVerificationEdge{
+		c.Edges[i] = &CustomTypeEnumEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -7360,12 +7326,12 @@ func (c *DNSVerificationConnection) build(nodes []*DNSVerification, pager *dnsve
 	}
 }
 
-// DNSVerificationPaginateOption enables pagination customization.
-type DNSVerificationPaginateOption func(*dnsverificationPager) error
+// CustomTypeEnumPaginateOption enables pagination customization.
+type CustomTypeEnumPaginateOption func(*customtypeenumPager) error
 
-// WithDNSVerificationOrder configures pagination ordering.
-func WithDNSVerificationOrder(order []*DNSVerificationOrder) DNSVerificationPaginateOption {
-	return func(pager *dnsverificationPager) error {
+// WithCustomTypeEnumOrder configures pagination ordering.
+func WithCustomTypeEnumOrder(order []*CustomTypeEnumOrder) CustomTypeEnumPaginateOption {
+	return func(pager *customtypeenumPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -7376,25 +7342,25 @@ func WithDNSVerificationOrder(order []*DNSVerificationOrder) DNSVerificationPagi
 	}
 }
 
-// WithDNSVerificationFilter configures pagination filter.
-func WithDNSVerificationFilter(filter func(*DNSVerificationQuery) (*DNSVerificationQuery, error)) DNSVerificationPaginateOption {
-	return func(pager *dnsverificationPager) error {
+// WithCustomTypeEnumFilter configures pagination filter.
+func WithCustomTypeEnumFilter(filter func(*CustomTypeEnumQuery) (*CustomTypeEnumQuery, error)) CustomTypeEnumPaginateOption {
+	return func(pager *customtypeenumPager) error {
 		if filter == nil {
-			return errors.New("DNSVerificationQuery filter cannot be nil")
+			return errors.New("CustomTypeEnumQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type dnsverificationPager struct {
+type customtypeenumPager struct {
 	reverse bool
-	order   []*DNSVerificationOrder
-	filter  func(*DNSVerificationQuery) (*DNSVerificationQuery, error)
+	order   []*CustomTypeEnumOrder
+	filter  func(*CustomTypeEnumQuery) (*CustomTypeEnumQuery, error)
 }
 
-func newDNSVerificationPager(opts []DNSVerificationPaginateOption, reverse bool) (*dnsverificationPager, error) {
-	pager := &dnsverificationPager{reverse: reverse}
+func newCustomTypeEnumPager(opts []CustomTypeEnumPaginateOption, reverse bool) (*customtypeenumPager, error) {
+	pager := &customtypeenumPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -7408,14 +7374,14 @@ func newDNSVerificationPager(opts []DNSVerificationPaginateOption, reverse bool)
 	return pager, nil
 }
 
-func (p *dnsverificationPager) applyFilter(query *DNSVerificationQuery) (*DNSVerificationQuery, error) {
+func (p *customtypeenumPager) applyFilter(query *CustomTypeEnumQuery) (*CustomTypeEnumQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *dnsverificationPager) toCursor(_m *DNSVerification) Cursor {
+func (p *customtypeenumPager) toCursor(_m *CustomTypeEnum) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -7423,7 +7389,7 @@ func (p *dnsverificationPager) toCursor(_m *DNSVerification) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *dnsverificationPager) applyCursors(query *DNSVerificationQuery, after, before *Cursor) (*DNSVerificationQuery, error) {
+func (p *customtypeenumPager) applyCursors(query *CustomTypeEnumQuery, after, before *Cursor) (*CustomTypeEnumQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -7438,7 +7404,7 @@ func (p *dnsverificationPager) applyCursors(query *DNSVerificationQuery, after,
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDNSVerificationOrder.Field.column,
+		FieldID:     DefaultCustomTypeEnumOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -7457,7 +7423,7 @@ func (p *dnsverificationPager) applyCursors(query *DNSVerificationQuery, after,
 	return query, nil
 }
 
-func (p *dnsverificationPager) applyOrder(query *DNSVerificationQuery) *DNSVerificationQuery {
+func (p *customtypeenumPager) applyOrder(query *CustomTypeEnumQuery) *CustomTypeEnumQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -7465,7 +7431,7 @@ func (p *dnsverificationPager) applyOrder(query *DNSVerificationQuery) *DNSVerif
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDNSVerificationOrder.Field.column {
+		if o.Field.column == DefaultCustomTypeEnumOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -7477,12 +7443,12 @@ func (p *dnsverificationPager) applyOrder(query *DNSVerificationQuery) *DNSVerif
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultDNSVerificationOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultCustomTypeEnumOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *dnsverificationPager) orderExpr(query *DNSVerificationQuery) sql.Querier {
+func (p *customtypeenumPager) orderExpr(query *CustomTypeEnumQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -7501,26 +7467,26 @@ func (p *dnsverificationPager) orderExpr(query *DNSVerificationQuery) sql.Querie
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultDNSVerificationOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultCustomTypeEnumOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to DNSVerification.
-func (_m *DNSVerificationQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to CustomTypeEnum.
+func (_m *CustomTypeEnumQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DNSVerificationPaginateOption,
-) (*DNSVerificationConnection, error) {
+	before *Cursor, last *int, opts ...CustomTypeEnumPaginateOption,
+) (*CustomTypeEnumConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDNSVerificationPager(opts, last != nil)
+	pager, err := newCustomTypeEnumPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DNSVerificationConnection{Edges: []*DNSVerificationEdge{}}
+	conn := &CustomTypeEnumConnection{Edges: []*CustomTypeEnumEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -7559,125 +7525,179 @@ func (_m *DNSVerificationQuery) Paginate(
 }
 
 var (
-	// DNSVerificationOrderFieldCreatedAt orders DNSVerification by created_at.
-	DNSVerificationOrderFieldCreatedAt = &DNSVerificationOrderField{
-		Value: func(_m *DNSVerification) (ent.Value, error) {
+	// CustomTypeEnumOrderFieldCreatedAt orders CustomTypeEnum by created_at.
+	CustomTypeEnumOrderFieldCreatedAt = &CustomTypeEnumOrderField{
+		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: dnsverification.FieldCreatedAt,
-		toTerm: dnsverification.ByCreatedAt,
-		toCursor: func(_m *DNSVerification) Cursor {
+		column: customtypeenum.FieldCreatedAt,
+		toTerm: customtypeenum.ByCreatedAt,
+		toCursor: func(_m *CustomTypeEnum) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DNSVerificationOrderFieldUpdatedAt orders DNSVerification by updated_at.
-	DNSVerificationOrderFieldUpdatedAt = &DNSVerificationOrderField{
-		Value: func(_m *DNSVerification) (ent.Value, error) {
+	// CustomTypeEnumOrderFieldUpdatedAt orders CustomTypeEnum by updated_at.
+	CustomTypeEnumOrderFieldUpdatedAt = &CustomTypeEnumOrderField{
+		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: dnsverification.FieldUpdatedAt,
-		toTerm: dnsverification.ByUpdatedAt,
-		toCursor: func(_m *DNSVerification) Cursor {
+		column: customtypeenum.FieldUpdatedAt,
+		toTerm: customtypeenum.ByUpdatedAt,
+		toCursor: func(_m *CustomTypeEnum) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-)
-
-// String implement fmt.Stringer interface.
-func (f DNSVerificationOrderField) String() string {
-	var str string
-	switch f.column {
-	case DNSVerificationOrderFieldCreatedAt.column:
-		str = "created_at"
-	case DNSVerificationOrderFieldUpdatedAt.column:
+	// CustomTypeEnumOrderFieldObjectType orders CustomTypeEnum by object_type.
+	CustomTypeEnumOrderFieldObjectType = &CustomTypeEnumOrderField{
+		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
+			return _m.ObjectType, nil
+		},
+		column: customtypeenum.FieldObjectType,
+		toTerm: customtypeenum.ByObjectType,
+		toCursor: func(_m *CustomTypeEnum) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ObjectType,
+			}
+		},
+	}
+	// CustomTypeEnumOrderFieldField orders CustomTypeEnum by field.
+	CustomTypeEnumOrderFieldField = &CustomTypeEnumOrderField{
+		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
+			return _m.Field, nil
+		},
+		column: customtypeenum.FieldField,
+		toTerm: customtypeenum.ByField,
+		toCursor: func(_m *CustomTypeEnum) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Field,
+			}
+		},
+	}
+	// CustomTypeEnumOrderFieldName orders CustomTypeEnum by name.
+	CustomTypeEnumOrderFieldName = &CustomTypeEnumOrderField{
+		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: customtypeenum.FieldName,
+		toTerm: customtypeenum.ByName,
+		toCursor: func(_m *CustomTypeEnum) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f CustomTypeEnumOrderField) String() string {
+	var str string
+	switch f.column {
+	case CustomTypeEnumOrderFieldCreatedAt.column:
+		str = "created_at"
+	case CustomTypeEnumOrderFieldUpdatedAt.column:
 		str = "updated_at"
+	case CustomTypeEnumOrderFieldObjectType.column:
+		str = "object_type"
+	case CustomTypeEnumOrderFieldField.column:
+		str = "field"
+	case CustomTypeEnumOrderFieldName.column:
+		str = "name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DNSVerificationOrderField) MarshalGQL(w io.Writer) {
+func (f CustomTypeEnumOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DNSVerificationOrderField) UnmarshalGQL(v interface{}) error {
+func (f *CustomTypeEnumOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DNSVerificationOrderField %T must be a string", v)
+		return fmt.Errorf("CustomTypeEnumOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *DNSVerificationOrderFieldCreatedAt
+		*f = *CustomTypeEnumOrderFieldCreatedAt
 	case "updated_at":
-		*f = *DNSVerificationOrderFieldUpdatedAt
+		*f = *CustomTypeEnumOrderFieldUpdatedAt
+	case "object_type":
+		*f = *CustomTypeEnumOrderFieldObjectType
+	case "field":
+		*f = *CustomTypeEnumOrderFieldField
+	case "name":
+		*f = *CustomTypeEnumOrderFieldName
 	default:
-		return fmt.Errorf("%s is not a valid DNSVerificationOrderField", str)
+		return fmt.Errorf("%s is not a valid CustomTypeEnumOrderField", str)
 	}
 	return nil
 }
 
-// DNSVerificationOrderField defines the ordering field of DNSVerification.
-type DNSVerificationOrderField struct {
-	// Value extracts the ordering value from the given DNSVerification.
-	Value    func(*DNSVerification) (ent.Value, error)
+// CustomTypeEnumOrderField defines the ordering field of CustomTypeEnum.
+type CustomTypeEnumOrderField struct {
+	// Value extracts the ordering value from the given CustomTypeEnum.
+	Value    func(*CustomTypeEnum) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) dnsverification.OrderOption
-	toCursor func(*DNSVerification) Cursor
+	toTerm   func(...sql.OrderTermOption) customtypeenum.OrderOption
+	toCursor func(*CustomTypeEnum) Cursor
 }
 
-// DNSVerificationOrder defines the ordering of DNSVerification.
-type DNSVerificationOrder struct {
-	Direction OrderDirection             `json:"direction"`
-	Field     *DNSVerificationOrderField `json:"field"`
+// CustomTypeEnumOrder defines the ordering of CustomTypeEnum.
+type CustomTypeEnumOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *CustomTypeEnumOrderField `json:"field"`
 }
 
-// DefaultDNSVerificationOrder is the default ordering of DNSVerification.
-var DefaultDNSVerificationOrder = &DNSVerificationOrder{
+// DefaultCustomTypeEnumOrder is the default ordering of CustomTypeEnum.
+var DefaultCustomTypeEnumOrder = &CustomTypeEnumOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DNSVerificationOrderField{
-		Value: func(_m *DNSVerification) (ent.Value, error) {
+	Field: &CustomTypeEnumOrderField{
+		Value: func(_m *CustomTypeEnum) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: dnsverification.FieldID,
-		toTerm: dnsverification.ByID,
-		toCursor: func(_m *DNSVerification) Cursor {
+		column: customtypeenum.FieldID,
+		toTerm: customtypeenum.ByID,
+		toCursor: func(_m *CustomTypeEnum) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts DNSVerification into DNSVerificationEdge.
-func (_m *DNSVerification) ToEdge(order *DNSVerificationOrder) *DNSVerificationEdge {
+// ToEdge converts CustomTypeEnum into CustomTypeEnumEdge.
+func (_m *CustomTypeEnum) ToEdge(order *CustomTypeEnumOrder) *CustomTypeEnumEdge {
 	if order == nil {
-		order = DefaultDNSVerificationOrder
+		order = DefaultCustomTypeEnumOrder
 	}
-	return &DNSVerificationEdge{
+	return &CustomTypeEnumEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DirectoryAccountEdge is the edge representation of DirectoryAccount.
-type DirectoryAccountEdge struct {
-	Node   *DirectoryAccount `json:"node"`
-	Cursor Cursor            `json:"cursor"`
+// DNSVerificationEdge is the edge representation of DNSVerification.
+type DNSVerificationEdge struct {
+	Node   *DNSVerification `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-// DirectoryAccountConnection is the connection containing edges to DirectoryAccount.
-type DirectoryAccountConnection struct {
-	Edges      []*DirectoryAccountEdge `json:"edges"`
-	PageInfo   PageInfo                `json:"pageInfo"`
-	TotalCount int                     `json:"totalCount"`
+// DNSVerificationConnection is the connection containing edges to DNSVerification.
+type DNSVerificationConnection struct {
+	Edges      []*DNSVerificationEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *DirectoryAccountConnection) build(nodes []*DirectoryAccount, pager *directoryaccountPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DNSVerificationConnection) build(nodes []*DNSVerification, pager *dnsverificationPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -7687,21 +7707,21 @@ func (c *DirectoryAccountConnection) build(nodes []*DirectoryAccount, pager *dir
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *DirectoryAccount
+	var nodeAt func(int) *DNSVerification
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *DirectoryAccount {
+		nodeAt = func(i int) *DNSVerification {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *DirectoryAccount {
+		nodeAt = func(i int) *DNSVerification {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DirectoryAccountEdge, len(nodes))
+	c.Edges = make([]*DNSVerificationEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DirectoryAccountEdge{
+		c.Edges[i] = &DNSVerificationEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -7715,12 +7735,12 @@ func (c *DirectoryAccountConnection) build(nodes []*DirectoryAccount, pager *dir
 	}
 }
 
-// DirectoryAccountPaginateOption enables pagination customization.
-type DirectoryAccountPaginateOption func(*directoryaccountPager) error
+// DNSVerificationPaginateOption enables pagination customization.
+type DNSVerificationPaginateOption func(*dnsverificationPager) error
 
-// WithDirectoryAccountOrder configures pagination ordering.
-func WithDirectoryAccountOrder(order []*DirectoryAccountOrder) DirectoryAccountPaginateOption {
-	return func(pager *directoryaccountPager) error {
+// WithDNSVerificationOrder configures pagination ordering.
+func WithDNSVerificationOrder(order []*DNSVerificationOrder) DNSVerificationPaginateOption {
+	return func(pager *dnsverificationPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -7731,25 +7751,25 @@ func WithDirectoryAccountOrder(order []*DirectoryAccountOrder) DirectoryAccountP
 	}
 }
 
-// WithDirectoryAccountFilter configures pagination filter.
-func WithDirectoryAccountFilter(filter func(*DirectoryAccountQuery) (*DirectoryAccountQuery, error)) DirectoryAccountPaginateOption {
-	return func(pager *directoryaccountPager) error {
+// WithDNSVerificationFilter configures pagination filter.
+func WithDNSVerificationFilter(filter func(*DNSVerificationQuery) (*DNSVerificationQuery, error)) DNSVerificationPaginateOption {
+	return func(pager *dnsverificationPager) error {
 		if filter == nil {
-			return errors.New("DirectoryAccountQuery filter cannot be nil")
+			return errors.New("DNSVerificationQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type directoryaccountPager struct {
+type dnsverificationPager struct {
 	reverse bool
-	order   []*DirectoryAccountOrder
-	filter  func(*DirectoryAccountQuery) (*DirectoryAccountQuery, error)
+	order   []*DNSVerificationOrder
+	filter  func(*DNSVerificationQuery) (*DNSVerificationQuery, error)
 }
 
-func newDirectoryAccountPager(opts []DirectoryAccountPaginateOption, reverse bool) (*directoryaccountPager, error) {
-	pager := &directoryaccountPager{reverse: reverse}
+func newDNSVerificationPager(opts []DNSVerificationPaginateOption, reverse bool) (*dnsverificationPager, error) {
+	pager := &dnsverificationPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -7763,14 +7783,14 @@ func newDirectoryAccountPager(opts []DirectoryAccountPaginateOption, reverse boo
 	return pager, nil
 }
 
-func (p *directoryaccountPager) applyFilter(query *DirectoryAccountQuery) (*DirectoryAccountQuery, error) {
+func (p *dnsverificationPager) applyFilter(query *DNSVerificationQuery) (*DNSVerificationQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *directoryaccountPager) toCursor(_m *DirectoryAccount) Cursor {
+func (p *dnsverificationPager) toCursor(_m *DNSVerification) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -7778,7 +7798,7 @@ func (p *directoryaccountPager) toCursor(_m *DirectoryAccount) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *directoryaccountPager) applyCursors(query *DirectoryAccountQuery, after, before *Cursor) (*DirectoryAccountQuery, error) {
+func (p *dnsverificationPager) applyCursors(query *DNSVerificationQuery, after, before *Cursor) (*DNSVerificationQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -7793,7 +7813,7 @@ func (p *directoryaccountPager) applyCursors(query *DirectoryAccountQuery, after
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDirectoryAccountOrder.Field.column,
+		FieldID:     DefaultDNSVerificationOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -7812,7 +7832,7 @@ func (p *directoryaccountPager) applyCursors(query *DirectoryAccountQuery, after
 	return query, nil
 }
 
-func (p *directoryaccountPager) applyOrder(query *DirectoryAccountQuery) *DirectoryAccountQuery {
+func (p *dnsverificationPager) applyOrder(query *DNSVerificationQuery) *DNSVerificationQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -7820,7 +7840,7 @@ func (p *directoryaccountPager) applyOrder(query *DirectoryAccountQuery) *Direct
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDirectoryAccountOrder.Field.column {
+		if o.Field.column == DefaultDNSVerificationOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -7832,12 +7852,12 @@ func (p *directoryaccountPager) applyOrder(query *DirectoryAccountQuery) *Direct
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultDirectoryAccountOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultDNSVerificationOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *directoryaccountPager) orderExpr(query *DirectoryAccountQuery) sql.Querier {
+func (p *dnsverificationPager) orderExpr(query *DNSVerificationQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -7856,26 +7876,26 @@ func (p *directoryaccountPager) orderExpr(query *DirectoryAccountQuery) sql.Quer
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultDirectoryAccountOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultDNSVerificationOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to DirectoryAccount.
-func (_m *DirectoryAccountQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to DNSVerification.
+func (_m *DNSVerificationQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DirectoryAccountPaginateOption,
-) (*DirectoryAccountConnection, error) {
+	before *Cursor, last *int, opts ...DNSVerificationPaginateOption,
+) (*DNSVerificationConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDirectoryAccountPager(opts, last != nil)
+	pager, err := newDNSVerificationPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DirectoryAccountConnection{Edges: []*DirectoryAccountEdge{}}
+	conn := &DNSVerificationConnection{Edges: []*DNSVerificationEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -7895,7 +7915,7 @@ func (_m *DirectoryAccountQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimit(first, last)
+	limit := paginateLimitSingle(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -7914,254 +7934,125 @@ func (_m *DirectoryAccountQuery) Paginate(
 }
 
 var (
-	// DirectoryAccountOrderFieldCreatedAt orders DirectoryAccount by created_at.
-	DirectoryAccountOrderFieldCreatedAt = &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
+	// DNSVerificationOrderFieldCreatedAt orders DNSVerification by created_at.
+	DNSVerificationOrderFieldCreatedAt = &DNSVerificationOrderField{
+		Value: func(_m *DNSVerification) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: directoryaccount.FieldCreatedAt,
-		toTerm: directoryaccount.ByCreatedAt,
-		toCursor: func(_m *DirectoryAccount) Cursor {
+		column: dnsverification.FieldCreatedAt,
+		toTerm: dnsverification.ByCreatedAt,
+		toCursor: func(_m *DNSVerification) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DirectoryAccountOrderFieldUpdatedAt orders DirectoryAccount by updated_at.
-	DirectoryAccountOrderFieldUpdatedAt = &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
+	// DNSVerificationOrderFieldUpdatedAt orders DNSVerification by updated_at.
+	DNSVerificationOrderFieldUpdatedAt = &DNSVerificationOrderField{
+		Value: func(_m *DNSVerification) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: directoryaccount.FieldUpdatedAt,
-		toTerm: directoryaccount.ByUpdatedAt,
-		toCursor: func(_m *DirectoryAccount) Cursor {
+		column: dnsverification.FieldUpdatedAt,
+		toTerm: dnsverification.ByUpdatedAt,
+		toCursor: func(_m *DNSVerification) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// DirectoryAccountOrderFieldDirectoryInstanceID orders DirectoryAccount by directory_instance_id.
-	DirectoryAccountOrderFieldDirectoryInstanceID = &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.DirectoryInstanceID == nil {
-				return nil, nil
-			}
-			return _m.DirectoryInstanceID, nil
-		},
-		column: directoryaccount.FieldDirectoryInstanceID,
-		toTerm: func(opts ...sql.OrderTermOption) directoryaccount.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return directoryaccount.ByDirectoryInstanceID(opts...)
-		},
-		toCursor: func(_m *DirectoryAccount) Cursor {
-			if _m.DirectoryInstanceID == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.DirectoryInstanceID,
-			}
-		},
-	}
-	// DirectoryAccountOrderFieldDirectoryName orders DirectoryAccount by directory_name.
-	DirectoryAccountOrderFieldDirectoryName = &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.DirectoryName == nil {
-				return nil, nil
-			}
-			return _m.DirectoryName, nil
-		},
-		column: directoryaccount.FieldDirectoryName,
-		toTerm: func(opts ...sql.OrderTermOption) directoryaccount.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return directoryaccount.ByDirectoryName(opts...)
-		},
-		toCursor: func(_m *DirectoryAccount) Cursor {
-			if _m.DirectoryName == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.DirectoryName,
-			}
-		},
-	}
-	// DirectoryAccountOrderFieldExternalID orders DirectoryAccount by external_id.
-	DirectoryAccountOrderFieldExternalID = &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
-			return _m.ExternalID, nil
-		},
-		column: directoryaccount.FieldExternalID,
-		toTerm: directoryaccount.ByExternalID,
-		toCursor: func(_m *DirectoryAccount) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ExternalID,
-			}
-		},
-	}
-	// DirectoryAccountOrderFieldCanonicalEmail orders DirectoryAccount by canonical_email.
-	DirectoryAccountOrderFieldCanonicalEmail = &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.CanonicalEmail == nil {
-				return nil, nil
-			}
-			return _m.CanonicalEmail, nil
-		},
-		column: directoryaccount.FieldCanonicalEmail,
-		toTerm: func(opts ...sql.OrderTermOption) directoryaccount.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return directoryaccount.ByCanonicalEmail(opts...)
-		},
-		toCursor: func(_m *DirectoryAccount) Cursor {
-			if _m.CanonicalEmail == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.CanonicalEmail,
-			}
-		},
-	}
-	// DirectoryAccountOrderFieldDisplayName orders DirectoryAccount by display_name.
-	DirectoryAccountOrderFieldDisplayName = &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
-			return _m.DisplayName, nil
-		},
-		column: directoryaccount.FieldDisplayName,
-		toTerm: directoryaccount.ByDisplayName,
-		toCursor: func(_m *DirectoryAccount) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.DisplayName,
-			}
-		},
-	}
 )
 
 // String implement fmt.Stringer interface.
-func (f DirectoryAccountOrderField) String() string {
+func (f DNSVerificationOrderField) String() string {
 	var str string
 	switch f.column {
-	case DirectoryAccountOrderFieldCreatedAt.column:
+	case DNSVerificationOrderFieldCreatedAt.column:
 		str = "created_at"
-	case DirectoryAccountOrderFieldUpdatedAt.column:
+	case DNSVerificationOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case DirectoryAccountOrderFieldDirectoryInstanceID.column:
-		str = "directory_instance_id"
-	case DirectoryAccountOrderFieldDirectoryName.column:
-		str = "directory_name"
-	case DirectoryAccountOrderFieldExternalID.column:
-		str = "external_id"
-	case DirectoryAccountOrderFieldCanonicalEmail.column:
-		str = "canonical_email"
-	case DirectoryAccountOrderFieldDisplayName.column:
-		str = "display_name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DirectoryAccountOrderField) MarshalGQL(w io.Writer) {
+func (f DNSVerificationOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DirectoryAccountOrderField) UnmarshalGQL(v interface{}) error {
+func (f *DNSVerificationOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DirectoryAccountOrderField %T must be a string", v)
+		return fmt.Errorf("DNSVerificationOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *DirectoryAccountOrderFieldCreatedAt
+		*f = *DNSVerificationOrderFieldCreatedAt
 	case "updated_at":
-		*f = *DirectoryAccountOrderFieldUpdatedAt
-	case "directory_instance_id":
-		*f = *DirectoryAccountOrderFieldDirectoryInstanceID
-	case "directory_name":
-		*f = *DirectoryAccountOrderFieldDirectoryName
-	case "external_id":
-		*f = *DirectoryAccountOrderFieldExternalID
-	case "canonical_email":
-		*f = *DirectoryAccountOrderFieldCanonicalEmail
-	case "display_name":
-		*f = *DirectoryAccountOrderFieldDisplayName
+		*f = *DNSVerificationOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid DirectoryAccountOrderField", str)
+		return fmt.Errorf("%s is not a valid DNSVerificationOrderField", str)
 	}
 	return nil
 }
 
-// DirectoryAccountOrderField defines the ordering field of DirectoryAccount.
-type DirectoryAccountOrderField struct {
-	// Value extracts the ordering value from the given DirectoryAccount.
-	Value    func(*DirectoryAccount) (ent.Value, error)
+// DNSVerificationOrderField defines the ordering field of DNSVerification.
+type DNSVerificationOrderField struct {
+	// Value extracts the ordering value from the given DNSVerification.
+	Value    func(*DNSVerification) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) directoryaccount.OrderOption
-	toCursor func(*DirectoryAccount) Cursor
+	toTerm   func(...sql.OrderTermOption) dnsverification.OrderOption
+	toCursor func(*DNSVerification) Cursor
 }
 
-// DirectoryAccountOrder defines the ordering of DirectoryAccount.
-type DirectoryAccountOrder struct {
-	Direction OrderDirection              `json:"direction"`
-	Field     *DirectoryAccountOrderField `json:"field"`
+// DNSVerificationOrder defines the ordering of DNSVerification.
+type DNSVerificationOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *DNSVerificationOrderField `json:"field"`
 }
 
-// DefaultDirectoryAccountOrder is the default ordering of DirectoryAccount.
-var DefaultDirectoryAccountOrder = &DirectoryAccountOrder{
+// DefaultDNSVerificationOrder is the default ordering of DNSVerification.
+var DefaultDNSVerificationOrder = &DNSVerificationOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DirectoryAccountOrderField{
-		Value: func(_m *DirectoryAccount) (ent.Value, error) {
+	Field: &DNSVerificationOrderField{
+		Value: func(_m *DNSVerification) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: directoryaccount.FieldID,
-		toTerm: directoryaccount.ByID,
-		toCursor: func(_m *DirectoryAccount) Cursor {
+		column: dnsverification.FieldID,
+		toTerm: dnsverification.ByID,
+		toCursor: func(_m *DNSVerification) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts DirectoryAccount into DirectoryAccountEdge.
-func (_m *DirectoryAccount) ToEdge(order *DirectoryAccountOrder) *DirectoryAccountEdge {
+// ToEdge converts DNSVerification into DNSVerificationEdge.
+func (_m *DNSVerification) ToEdge(order *DNSVerificationOrder) *DNSVerificationEdge {
 	if order == nil {
-		order = DefaultDirectoryAccountOrder
+		order = DefaultDNSVerificationOrder
 	}
-	return &DirectoryAccountEdge{
+	return &DNSVerificationEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DirectoryGroupEdge is the edge representation of DirectoryGroup.
-type DirectoryGroupEdge struct {
-	Node   *DirectoryGroup `json:"node"`
-	Cursor Cursor          `json:"cursor"`
+// DirectoryAccountEdge is the edge representation of DirectoryAccount.
+type DirectoryAccountEdge struct {
+	Node   *DirectoryAccount `json:"node"`
+	Cursor Cursor            `json:"cursor"`
 }
 
-// DirectoryGroupConnection is the connection containing edges to DirectoryGroup.
-type DirectoryGroupConnection struct {
-	Edges      []*DirectoryGroupEdge `json:"edges"`
-	PageInfo   PageInfo              `json:"pageInfo"`
-	TotalCount int                   `json:"totalCount"`
+// DirectoryAccountConnection is the connection containing edges to DirectoryAccount.
+type DirectoryAccountConnection struct {
+	Edges      []*DirectoryAccountEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
 }
 
-func (c *DirectoryGroupConnection) build(nodes []*DirectoryGroup, pager *directorygroupPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DirectoryAccountConnection) build(nodes []*DirectoryAccount, pager *directoryaccountPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -8171,21 +8062,21 @@ func (c *DirectoryGroupConnection) build(nodes []*DirectoryGroup, pager *directo
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *DirectoryGroup
+	var nodeAt func(int) *DirectoryAccount
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *DirectoryGroup {
+		nodeAt = func(i int) *DirectoryAccount {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *DirectoryGroup {
+		nodeAt = func(i int) *DirectoryAccount {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DirectoryGroupEdge, len(nodes))
+	c.Edges = make([]*DirectoryAccountEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DirectoryGroupEdge{
+		c.Edges[i] = &DirectoryAccountEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -8199,12 +8090,12 @@ func (c *DirectoryGroupConnection) build(nodes []*DirectoryGroup, pager *directo
 	}
 }
 
-// DirectoryGroupPaginateOption enables pagination customization.
-type DirectoryGroupPaginateOption func(*directorygroupPager) error
+// DirectoryAccountPaginateOption enables pagination customization.
+type DirectoryAccountPaginateOption func(*directoryaccountPager) error
 
-// WithDirectoryGroupOrder configures pagination ordering.
-func WithDirectoryGroupOrder(order []*DirectoryGroupOrder) DirectoryGroupPaginateOption {
-	return func(pager *directorygroupPager) error {
+// WithDirectoryAccountOrder configures pagination ordering.
+func WithDirectoryAccountOrder(order []*DirectoryAccountOrder) DirectoryAccountPaginateOption {
+	return func(pager *directoryaccountPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -8215,25 +8106,25 @@ func WithDirectoryGroupOrder(order []*DirectoryGroupOrder) DirectoryGroupPaginat
 	}
 }
 
-// WithDirectoryGroupFilter configures pagination filter.
-func WithDirectoryGroupFilter(filter func(*DirectoryGroupQuery) (*DirectoryGroupQuery, error)) DirectoryGroupPaginateOption {
-	return func(pager *directorygroupPager) error {
+// WithDirectoryAccountFilter configures pagination filter.
+func WithDirectoryAccountFilter(filter func(*DirectoryAccountQuery) (*DirectoryAccountQuery, error)) DirectoryAccountPaginateOption {
+	return func(pager *directoryaccountPager) error {
 		if filter == nil {
-			return errors.New("DirectoryGroupQuery filter cannot be nil")
+			return errors.New("DirectoryAccountQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type directorygroupPager struct {
+type directoryaccountPager struct {
 	reverse bool
-	order   []*DirectoryGroupOrder
-	filter  func(*DirectoryGroupQuery) (*DirectoryGroupQuery, error)
+	order   []*DirectoryAccountOrder
+	filter  func(*DirectoryAccountQuery) (*DirectoryAccountQuery, error)
 }
 
-func newDirectoryGroupPager(opts []DirectoryGroupPaginateOption, reverse bool) (*directorygroupPager, error) {
-	pager := &directorygroupPager{reverse: reverse}
+func newDirectoryAccountPager(opts []DirectoryAccountPaginateOption, reverse bool) (*directoryaccountPager, error) {
+	pager := &directoryaccountPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -8247,14 +8138,14 @@ func newDirectoryGroupPager(opts []DirectoryGroupPaginateOption, reverse bool) (
 	return pager, nil
 }
 
-func (p *directorygroupPager) applyFilter(query *DirectoryGroupQuery) (*DirectoryGroupQuery, error) {
+func (p *directoryaccountPager) applyFilter(query *DirectoryAccountQuery) (*DirectoryAccountQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *directorygroupPager) toCursor(_m *DirectoryGroup) Cursor {
+func (p *directoryaccountPager) toCursor(_m *DirectoryAccount) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -8262,7 +8153,7 @@ func (p *directorygroupPager) toCursor(_m *DirectoryGroup) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *directorygroupPager) applyCursors(query *DirectoryGroupQuery, after, before *Cursor) (*DirectoryGroupQuery, error) {
+func (p *directoryaccountPager) applyCursors(query *DirectoryAccountQuery, after, before *Cursor) (*DirectoryAccountQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -8277,7 +8168,7 @@ func (p *directorygroupPager) applyCursors(query *DirectoryGroupQuery, after, be
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDirectoryGroupOrder.Field.column,
+		FieldID:     DefaultDirectoryAccountOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -8296,7 +8187,7 @@ func (p *directorygroupPager) applyCursors(query *DirectoryGroupQuery, after, be
 	return query, nil
 }
 
-func (p *directorygroupPager) applyOrder(query *DirectoryGroupQuery) *DirectoryGroupQuery {
+func (p *directoryaccountPager) applyOrder(query *DirectoryAccountQuery) *DirectoryAccountQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -8304,7 +8195,7 @@ func (p *directorygroupPager) applyOrder(query *DirectoryGroupQuery) *DirectoryG
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDirectoryGroupOrder.Field.column {
+		if o.Field.column == DefaultDirectoryAccountOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -8316,12 +8207,12 @@ func (p *directorygroupPager) applyOrder(query *DirectoryGroupQuery) *DirectoryG
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultDirectoryGroupOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultDirectoryAccountOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *directorygroupPager) orderExpr(query *DirectoryGroupQuery) sql.Querier {
+func (p *directoryaccountPager) orderExpr(query *DirectoryAccountQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -8340,26 +8231,26 @@ func (p *directorygroupPager) orderExpr(query *DirectoryGroupQuery) sql.Querier
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultDirectoryGroupOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultDirectoryAccountOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to DirectoryGroup.
-func (_m *DirectoryGroupQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to DirectoryAccount.
+func (_m *DirectoryAccountQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DirectoryGroupPaginateOption,
-) (*DirectoryGroupConnection, error) {
+	before *Cursor, last *int, opts ...DirectoryAccountPaginateOption,
+) (*DirectoryAccountConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDirectoryGroupPager(opts, last != nil)
+	pager, err := newDirectoryAccountPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DirectoryGroupConnection{Edges: []*DirectoryGroupEdge{}}
+	conn := &DirectoryAccountConnection{Edges: []*DirectoryAccountEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -8379,7 +8270,7 @@ func (_m *DirectoryGroupQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimitSingle(first, last)
+	limit := paginateLimit(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -8398,49 +8289,49 @@ func (_m *DirectoryGroupQuery) Paginate(
 }
 
 var (
-	// DirectoryGroupOrderFieldCreatedAt orders DirectoryGroup by created_at.
-	DirectoryGroupOrderFieldCreatedAt = &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+	// DirectoryAccountOrderFieldCreatedAt orders DirectoryAccount by created_at.
+	DirectoryAccountOrderFieldCreatedAt = &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: directorygroup.FieldCreatedAt,
-		toTerm: directorygroup.ByCreatedAt,
-		toCursor: func(_m *DirectoryGroup) Cursor {
+		column: directoryaccount.FieldCreatedAt,
+		toTerm: directoryaccount.ByCreatedAt,
+		toCursor: func(_m *DirectoryAccount) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DirectoryGroupOrderFieldUpdatedAt orders DirectoryGroup by updated_at.
-	DirectoryGroupOrderFieldUpdatedAt = &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+	// DirectoryAccountOrderFieldUpdatedAt orders DirectoryAccount by updated_at.
+	DirectoryAccountOrderFieldUpdatedAt = &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: directorygroup.FieldUpdatedAt,
-		toTerm: directorygroup.ByUpdatedAt,
-		toCursor: func(_m *DirectoryGroup) Cursor {
+		column: directoryaccount.FieldUpdatedAt,
+		toTerm: directoryaccount.ByUpdatedAt,
+		toCursor: func(_m *DirectoryAccount) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// DirectoryGroupOrderFieldDirectoryInstanceID orders DirectoryGroup by directory_instance_id.
-	DirectoryGroupOrderFieldDirectoryInstanceID = &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+	// DirectoryAccountOrderFieldDirectoryInstanceID orders DirectoryAccount by directory_instance_id.
+	DirectoryAccountOrderFieldDirectoryInstanceID = &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
 			// allow for nil values for fields
 			if _m.DirectoryInstanceID == nil {
 				return nil, nil
 			}
 			return _m.DirectoryInstanceID, nil
 		},
-		column: directorygroup.FieldDirectoryInstanceID,
-		toTerm: func(opts ...sql.OrderTermOption) directorygroup.OrderOption {
+		column: directoryaccount.FieldDirectoryInstanceID,
+		toTerm: func(opts ...sql.OrderTermOption) directoryaccount.OrderOption {
 			opts = append(opts, sql.OrderNullsLast())
-			return directorygroup.ByDirectoryInstanceID(opts...)
+			return directoryaccount.ByDirectoryInstanceID(opts...)
 		},
-		toCursor: func(_m *DirectoryGroup) Cursor {
+		toCursor: func(_m *DirectoryAccount) Cursor {
 			if _m.DirectoryInstanceID == nil {
 				return Cursor{
 					ID:    _m.ID,
@@ -8453,36 +8344,22 @@ var (
 			}
 		},
 	}
-	// DirectoryGroupOrderFieldExternalID orders DirectoryGroup by external_id.
-	DirectoryGroupOrderFieldExternalID = &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
-			return _m.ExternalID, nil
-		},
-		column: directorygroup.FieldExternalID,
-		toTerm: directorygroup.ByExternalID,
-		toCursor: func(_m *DirectoryGroup) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ExternalID,
-			}
-		},
-	}
-	// DirectoryGroupOrderFieldEmail orders DirectoryGroup by email.
-	DirectoryGroupOrderFieldEmail = &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+	// DirectoryAccountOrderFieldDirectoryName orders DirectoryAccount by directory_name.
+	DirectoryAccountOrderFieldDirectoryName = &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
 			// allow for nil values for fields
-			if _m.Email == nil {
+			if _m.DirectoryName == nil {
 				return nil, nil
 			}
-			return _m.Email, nil
+			return _m.DirectoryName, nil
 		},
-		column: directorygroup.FieldEmail,
-		toTerm: func(opts ...sql.OrderTermOption) directorygroup.OrderOption {
+		column: directoryaccount.FieldDirectoryName,
+		toTerm: func(opts ...sql.OrderTermOption) directoryaccount.OrderOption {
 			opts = append(opts, sql.OrderNullsLast())
-			return directorygroup.ByEmail(opts...)
+			return directoryaccount.ByDirectoryName(opts...)
 		},
-		toCursor: func(_m *DirectoryGroup) Cursor {
-			if _m.Email == nil {
+		toCursor: func(_m *DirectoryAccount) Cursor {
+			if _m.DirectoryName == nil {
 				return Cursor{
 					ID:    _m.ID,
 					Value: nil, // handle nil values for fields
@@ -8490,40 +8367,40 @@ var (
 			}
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Email,
+				Value: _m.DirectoryName,
 			}
 		},
 	}
-	// DirectoryGroupOrderFieldDisplayName orders DirectoryGroup by display_name.
-	DirectoryGroupOrderFieldDisplayName = &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
-			return _m.DisplayName, nil
+	// DirectoryAccountOrderFieldExternalID orders DirectoryAccount by external_id.
+	DirectoryAccountOrderFieldExternalID = &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
+			return _m.ExternalID, nil
 		},
-		column: directorygroup.FieldDisplayName,
-		toTerm: directorygroup.ByDisplayName,
-		toCursor: func(_m *DirectoryGroup) Cursor {
+		column: directoryaccount.FieldExternalID,
+		toTerm: directoryaccount.ByExternalID,
+		toCursor: func(_m *DirectoryAccount) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.DisplayName,
+				Value: _m.ExternalID,
 			}
 		},
 	}
-	// DirectoryGroupOrderFieldDirectoryName orders DirectoryGroup by directory_name.
-	DirectoryGroupOrderFieldDirectoryName = &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+	// DirectoryAccountOrderFieldCanonicalEmail orders DirectoryAccount by canonical_email.
+	DirectoryAccountOrderFieldCanonicalEmail = &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
 			// allow for nil values for fields
-			if _m.DirectoryName == nil {
+			if _m.CanonicalEmail == nil {
 				return nil, nil
 			}
-			return _m.DirectoryName, nil
+			return _m.CanonicalEmail, nil
 		},
-		column: directorygroup.FieldDirectoryName,
-		toTerm: func(opts ...sql.OrderTermOption) directorygroup.OrderOption {
+		column: directoryaccount.FieldCanonicalEmail,
+		toTerm: func(opts ...sql.OrderTermOption) directoryaccount.OrderOption {
 			opts = append(opts, sql.OrderNullsLast())
-			return directorygroup.ByDirectoryName(opts...)
+			return directoryaccount.ByCanonicalEmail(opts...)
 		},
-		toCursor: func(_m *DirectoryGroup) Cursor {
-			if _m.DirectoryName == nil {
+		toCursor: func(_m *DirectoryAccount) Cursor {
+			if _m.CanonicalEmail == nil {
 				return Cursor{
 					ID:    _m.ID,
 					Value: nil, // handle nil values for fields
@@ -8531,121 +8408,135 @@ var (
 			}
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.DirectoryName,
+				Value: _m.CanonicalEmail,
+			}
+		},
+	}
+	// DirectoryAccountOrderFieldDisplayName orders DirectoryAccount by display_name.
+	DirectoryAccountOrderFieldDisplayName = &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
+			return _m.DisplayName, nil
+		},
+		column: directoryaccount.FieldDisplayName,
+		toTerm: directoryaccount.ByDisplayName,
+		toCursor: func(_m *DirectoryAccount) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.DisplayName,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f DirectoryGroupOrderField) String() string {
+func (f DirectoryAccountOrderField) String() string {
 	var str string
 	switch f.column {
-	case DirectoryGroupOrderFieldCreatedAt.column:
+	case DirectoryAccountOrderFieldCreatedAt.column:
 		str = "created_at"
-	case DirectoryGroupOrderFieldUpdatedAt.column:
+	case DirectoryAccountOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case DirectoryGroupOrderFieldDirectoryInstanceID.column:
+	case DirectoryAccountOrderFieldDirectoryInstanceID.column:
 		str = "directory_instance_id"
-	case DirectoryGroupOrderFieldExternalID.column:
+	case DirectoryAccountOrderFieldDirectoryName.column:
+		str = "directory_name"
+	case DirectoryAccountOrderFieldExternalID.column:
 		str = "external_id"
-	case DirectoryGroupOrderFieldEmail.column:
-		str = "email"
-	case DirectoryGroupOrderFieldDisplayName.column:
+	case DirectoryAccountOrderFieldCanonicalEmail.column:
+		str = "canonical_email"
+	case DirectoryAccountOrderFieldDisplayName.column:
 		str = "display_name"
-	case DirectoryGroupOrderFieldDirectoryName.column:
-		str = "directory_name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DirectoryGroupOrderField) MarshalGQL(w io.Writer) {
+func (f DirectoryAccountOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DirectoryGroupOrderField) UnmarshalGQL(v interface{}) error {
+func (f *DirectoryAccountOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DirectoryGroupOrderField %T must be a string", v)
+		return fmt.Errorf("DirectoryAccountOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *DirectoryGroupOrderFieldCreatedAt
+		*f = *DirectoryAccountOrderFieldCreatedAt
 	case "updated_at":
-		*f = *DirectoryGroupOrderFieldUpdatedAt
+		*f = *DirectoryAccountOrderFieldUpdatedAt
 	case "directory_instance_id":
-		*f = *DirectoryGroupOrderFieldDirectoryInstanceID
+		*f = *DirectoryAccountOrderFieldDirectoryInstanceID
+	case "directory_name":
+		*f = *DirectoryAccountOrderFieldDirectoryName
 	case "external_id":
-		*f = *DirectoryGroupOrderFieldExternalID
-	case "email":
-		*f = *DirectoryGroupOrderFieldEmail
+		*f = *DirectoryAccountOrderFieldExternalID
+	case "canonical_email":
+		*f = *DirectoryAccountOrderFieldCanonicalEmail
 	case "display_name":
-		*f = *DirectoryGroupOrderFieldDisplayName
-	case "directory_name":
-		*f = *DirectoryGroupOrderFieldDirectoryName
+		*f = *DirectoryAccountOrderFieldDisplayName
 	default:
-		return fmt.Errorf("%s is not a valid DirectoryGroupOrderField", str)
+		return fmt.Errorf("%s is not a valid DirectoryAccountOrderField", str)
 	}
 	return nil
 }
 
-// DirectoryGroupOrderField defines the ordering field of DirectoryGroup.
-type DirectoryGroupOrderField struct {
-	// Value extracts the ordering value from the given DirectoryGroup.
-	Value    func(*DirectoryGroup) (ent.Value, error)
+// DirectoryAccountOrderField defines the ordering field of DirectoryAccount.
+type DirectoryAccountOrderField struct {
+	// Value extracts the ordering value from the given DirectoryAccount.
+	Value    func(*DirectoryAccount) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) directorygroup.OrderOption
-	toCursor func(*DirectoryGroup) Cursor
+	toTerm   func(...sql.OrderTermOption) directoryaccount.OrderOption
+	toCursor func(*DirectoryAccount) Cursor
 }
 
-// DirectoryGroupOrder defines the ordering of DirectoryGroup.
-type DirectoryGroupOrder struct {
-	Direction OrderDirection            `json:"direction"`
-	Field     *DirectoryGroupOrderField `json:"field"`
+// DirectoryAccountOrder defines the ordering of DirectoryAccount.
+type DirectoryAccountOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *DirectoryAccountOrderField `json:"field"`
 }
 
-// DefaultDirectoryGroupOrder is the default ordering of DirectoryGroup.
-var DefaultDirectoryGroupOrder = &DirectoryGroupOrder{
+// DefaultDirectoryAccountOrder is the default ordering of DirectoryAccount.
+var DefaultDirectoryAccountOrder = &DirectoryAccountOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DirectoryGroupOrderField{
-		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+	Field: &DirectoryAccountOrderField{
+		Value: func(_m *DirectoryAccount) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: directorygroup.FieldID,
-		toTerm: directorygroup.ByID,
-		toCursor: func(_m *DirectoryGroup) Cursor {
+		column: directoryaccount.FieldID,
+		toTerm: directoryaccount.ByID,
+		toCursor: func(_m *DirectoryAccount) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts DirectoryGroup into DirectoryGroupEdge.
-func (_m *DirectoryGroup) ToEdge(order *DirectoryGroupOrder) *DirectoryGroupEdge {
+// ToEdge converts DirectoryAccount into DirectoryAccountEdge.
+func (_m *DirectoryAccount) ToEdge(order *DirectoryAccountOrder) *DirectoryAccountEdge {
 	if order == nil {
-		order = DefaultDirectoryGroupOrder
+		order = DefaultDirectoryAccountOrder
 	}
-	return &DirectoryGroupEdge{
+	return &DirectoryAccountEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DirectoryMembershipEdge is the edge representation of DirectoryMembership.
-type DirectoryMembershipEdge struct {
-	Node   *DirectoryMembership `json:"node"`
-	Cursor Cursor               `json:"cursor"`
+// DirectoryGroupEdge is the edge representation of DirectoryGroup.
+type DirectoryGroupEdge struct {
+	Node   *DirectoryGroup `json:"node"`
+	Cursor Cursor          `json:"cursor"`
 }
 
-// DirectoryMembershipConnection is the connection containing edges to DirectoryMembership.
-type DirectoryMembershipConnection struct {
-	Edges      []*DirectoryMembershipEdge `json:"edges"`
-	PageInfo   PageInfo                   `json:"pageInfo"`
-	TotalCount int                        `json:"totalCount"`
+// DirectoryGroupConnection is the connection containing edges to DirectoryGroup.
+type DirectoryGroupConnection struct {
+	Edges      []*DirectoryGroupEdge `json:"edges"`
+	PageInfo   PageInfo              `json:"pageInfo"`
+	TotalCount int                   `json:"totalCount"`
 }
 
-func (c *DirectoryMembershipConnection) build(nodes []*DirectoryMembership, pager *directorymembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DirectoryGroupConnection) build(nodes []*DirectoryGroup, pager *directorygroupPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -8655,21 +8546,21 @@ func (c *DirectoryMembershipConnection) build(nodes []*DirectoryMembership, page
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *DirectoryMembership
+	var nodeAt func(int) *DirectoryGroup
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *DirectoryMembership {
+		nodeAt = func(i int) *DirectoryGroup {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *DirectoryMembership {
+		nodeAt = func(i int) *DirectoryGroup {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DirectoryMembershipEdge, len(nodes))
+	c.Edges = make([]*DirectoryGroupEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DirectoryMembershipEdge{
+		c.Edges[i] = &DirectoryGroupEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -8683,12 +8574,12 @@ func (c *DirectoryMembershipConnection) build(nodes []*DirectoryMembership, page
 	}
 }
 
-// DirectoryMembershipPaginateOption enables pagination customization.
-type DirectoryMembershipPaginateOption func(*directorymembershipPager) error
+// DirectoryGroupPaginateOption enables pagination customization.
+type DirectoryGroupPaginateOption func(*directorygroupPager) error
 
-// WithDirectoryMembershipOrder configures pagination ordering.
-func WithDirectoryMembershipOrder(order []*DirectoryMembershipOrder) DirectoryMembershipPaginateOption {
-	return func(pager *directorymembershipPager) error {
+// WithDirectoryGroupOrder configures pagination ordering.
+func WithDirectoryGroupOrder(order []*DirectoryGroupOrder) DirectoryGroupPaginateOption {
+	return func(pager *directorygroupPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -8699,25 +8590,25 @@ func WithDirectoryMembershipOrder(order []*DirectoryMembershipOrder) DirectoryMe
 	}
 }
 
-// WithDirectoryMembershipFilter configures pagination filter.
-func WithDirectoryMembershipFilter(filter func(*DirectoryMembershipQuery) (*DirectoryMembershipQuery, error)) DirectoryMembershipPaginateOption {
-	return func(pager *directorymembershipPager) error {
+// WithDirectoryGroupFilter configures pagination filter.
+func WithDirectoryGroupFilter(filter func(*DirectoryGroupQuery) (*DirectoryGroupQuery, error)) DirectoryGroupPaginateOption {
+	return func(pager *directorygroupPager) error {
 		if filter == nil {
-			return errors.New("DirectoryMembershipQuery filter cannot be nil")
+			return errors.New("DirectoryGroupQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type directorymembershipPager struct {
+type directorygroupPager struct {
 	reverse bool
-	order   []*DirectoryMembershipOrder
-	filter  func(*DirectoryMembershipQuery) (*DirectoryMembershipQuery, error)
+	order   []*DirectoryGroupOrder
+	filter  func(*DirectoryGroupQuery) (*DirectoryGroupQuery, error)
 }
 
-func newDirectoryMembershipPager(opts []DirectoryMembershipPaginateOption, reverse bool) (*directorymembershipPager, error) {
-	pager := &directorymembershipPager{reverse: reverse}
+func newDirectoryGroupPager(opts []DirectoryGroupPaginateOption, reverse bool) (*directorygroupPager, error) {
+	pager := &directorygroupPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -8731,14 +8622,14 @@ func newDirectoryMembershipPager(opts []DirectoryMembershipPaginateOption, rever
 	return pager, nil
 }
 
-func (p *directorymembershipPager) applyFilter(query *DirectoryMembershipQuery) (*DirectoryMembershipQuery, error) {
+func (p *directorygroupPager) applyFilter(query *DirectoryGroupQuery) (*DirectoryGroupQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *directorymembershipPager) toCursor(_m *DirectoryMembership) Cursor {
+func (p *directorygroupPager) toCursor(_m *DirectoryGroup) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -8746,7 +8637,7 @@ func (p *directorymembershipPager) toCursor(_m *DirectoryMembership) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *directorymembershipPager) applyCursors(query *DirectoryMembershipQuery, after, before *Cursor) (*DirectoryMembershipQuery, error) {
+func (p *directorygroupPager) applyCursors(query *DirectoryGroupQuery, after, before *Cursor) (*DirectoryGroupQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -8761,7 +8652,7 @@ func (p *directorymembershipPager) applyCursors(query *DirectoryMembershipQuery,
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDirectoryMembershipOrder.Field.column,
+		FieldID:     DefaultDirectoryGroupOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -8780,7 +8671,7 @@ func (p *directorymembershipPager) applyCursors(query *DirectoryMembershipQuery,
 	return query, nil
 }
 
-func (p *directorymembershipPager) applyOrder(query *DirectoryMembershipQuery) *DirectoryMembershipQuery {
+func (p *directorygroupPager) applyOrder(query *DirectoryGroupQuery) *DirectoryGroupQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -8788,7 +8679,7 @@ func (p *directorymembershipPager) applyOrder(query *DirectoryMembershipQuery) *
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDirectoryMembershipOrder.Field.column {
+		if o.Field.column == DefaultDirectoryGroupOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -8800,12 +8691,12 @@ func (p *directorymembershipPager) applyOrder(query *DirectoryMembershipQuery) *
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultDirectoryMembershipOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultDirectoryGroupOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *directorymembershipPager) orderExpr(query *DirectoryMembershipQuery) sql.Querier {
+func (p *directorygroupPager) orderExpr(query *DirectoryGroupQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -8824,26 +8715,26 @@ func (p *directorymembershipPager) orderExpr(query *DirectoryMembershipQuery) sq
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultDirectoryMembershipOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultDirectoryGroupOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to DirectoryMembership.
-func (_m *DirectoryMembershipQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to DirectoryGroup.
+func (_m *DirectoryGroupQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DirectoryMembershipPaginateOption,
-) (*DirectoryMembershipConnection, error) {
+	before *Cursor, last *int, opts ...DirectoryGroupPaginateOption,
+) (*DirectoryGroupConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDirectoryMembershipPager(opts, last != nil)
+	pager, err := newDirectoryGroupPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DirectoryMembershipConnection{Edges: []*DirectoryMembershipEdge{}}
+	conn := &DirectoryGroupConnection{Edges: []*DirectoryGroupEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -8882,49 +8773,131 @@ func (_m *DirectoryMembershipQuery) Paginate(
 }
 
 var (
-	// DirectoryMembershipOrderFieldCreatedAt orders DirectoryMembership by created_at.
-	DirectoryMembershipOrderFieldCreatedAt = &DirectoryMembershipOrderField{
-		Value: func(_m *DirectoryMembership) (ent.Value, error) {
+	// DirectoryGroupOrderFieldCreatedAt orders DirectoryGroup by created_at.
+	DirectoryGroupOrderFieldCreatedAt = &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: directorymembership.FieldCreatedAt,
-		toTerm: directorymembership.ByCreatedAt,
-		toCursor: func(_m *DirectoryMembership) Cursor {
+		column: directorygroup.FieldCreatedAt,
+		toTerm: directorygroup.ByCreatedAt,
+		toCursor: func(_m *DirectoryGroup) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DirectoryMembershipOrderFieldUpdatedAt orders DirectoryMembership by updated_at.
-	DirectoryMembershipOrderFieldUpdatedAt = &DirectoryMembershipOrderField{
-		Value: func(_m *DirectoryMembership) (ent.Value, error) {
+	// DirectoryGroupOrderFieldUpdatedAt orders DirectoryGroup by updated_at.
+	DirectoryGroupOrderFieldUpdatedAt = &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: directorymembership.FieldUpdatedAt,
-		toTerm: directorymembership.ByUpdatedAt,
-		toCursor: func(_m *DirectoryMembership) Cursor {
+		column: directorygroup.FieldUpdatedAt,
+		toTerm: directorygroup.ByUpdatedAt,
+		toCursor: func(_m *DirectoryGroup) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// DirectoryMembershipOrderFieldDirectoryName orders DirectoryMembership by directory_name.
-	DirectoryMembershipOrderFieldDirectoryName = &DirectoryMembershipOrderField{
-		Value: func(_m *DirectoryMembership) (ent.Value, error) {
+	// DirectoryGroupOrderFieldDirectoryInstanceID orders DirectoryGroup by directory_instance_id.
+	DirectoryGroupOrderFieldDirectoryInstanceID = &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.DirectoryInstanceID == nil {
+				return nil, nil
+			}
+			return _m.DirectoryInstanceID, nil
+		},
+		column: directorygroup.FieldDirectoryInstanceID,
+		toTerm: func(opts ...sql.OrderTermOption) directorygroup.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return directorygroup.ByDirectoryInstanceID(opts...)
+		},
+		toCursor: func(_m *DirectoryGroup) Cursor {
+			if _m.DirectoryInstanceID == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.DirectoryInstanceID,
+			}
+		},
+	}
+	// DirectoryGroupOrderFieldExternalID orders DirectoryGroup by external_id.
+	DirectoryGroupOrderFieldExternalID = &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+			return _m.ExternalID, nil
+		},
+		column: directorygroup.FieldExternalID,
+		toTerm: directorygroup.ByExternalID,
+		toCursor: func(_m *DirectoryGroup) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ExternalID,
+			}
+		},
+	}
+	// DirectoryGroupOrderFieldEmail orders DirectoryGroup by email.
+	DirectoryGroupOrderFieldEmail = &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.Email == nil {
+				return nil, nil
+			}
+			return _m.Email, nil
+		},
+		column: directorygroup.FieldEmail,
+		toTerm: func(opts ...sql.OrderTermOption) directorygroup.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return directorygroup.ByEmail(opts...)
+		},
+		toCursor: func(_m *DirectoryGroup) Cursor {
+			if _m.Email == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Email,
+			}
+		},
+	}
+	// DirectoryGroupOrderFieldDisplayName orders DirectoryGroup by display_name.
+	DirectoryGroupOrderFieldDisplayName = &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
+			return _m.DisplayName, nil
+		},
+		column: directorygroup.FieldDisplayName,
+		toTerm: directorygroup.ByDisplayName,
+		toCursor: func(_m *DirectoryGroup) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.DisplayName,
+			}
+		},
+	}
+	// DirectoryGroupOrderFieldDirectoryName orders DirectoryGroup by directory_name.
+	DirectoryGroupOrderFieldDirectoryName = &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
 			// allow for nil values for fields
 			if _m.DirectoryName == nil {
 				return nil, nil
 			}
 			return _m.DirectoryName, nil
 		},
-		column: directorymembership.FieldDirectoryName,
-		toTerm: func(opts ...sql.OrderTermOption) directorymembership.OrderOption {
+		column: directorygroup.FieldDirectoryName,
+		toTerm: func(opts ...sql.OrderTermOption) directorygroup.OrderOption {
 			opts = append(opts, sql.OrderNullsLast())
-			return directorymembership.ByDirectoryName(opts...)
+			return directorygroup.ByDirectoryName(opts...)
 		},
-		toCursor: func(_m *DirectoryMembership) Cursor {
+		toCursor: func(_m *DirectoryGroup) Cursor {
 			if _m.DirectoryName == nil {
 				return Cursor{
 					ID:    _m.ID,
@@ -8940,98 +8913,114 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f DirectoryMembershipOrderField) String() string {
+func (f DirectoryGroupOrderField) String() string {
 	var str string
 	switch f.column {
-	case DirectoryMembershipOrderFieldCreatedAt.column:
+	case DirectoryGroupOrderFieldCreatedAt.column:
 		str = "created_at"
-	case DirectoryMembershipOrderFieldUpdatedAt.column:
+	case DirectoryGroupOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case DirectoryMembershipOrderFieldDirectoryName.column:
+	case DirectoryGroupOrderFieldDirectoryInstanceID.column:
+		str = "directory_instance_id"
+	case DirectoryGroupOrderFieldExternalID.column:
+		str = "external_id"
+	case DirectoryGroupOrderFieldEmail.column:
+		str = "email"
+	case DirectoryGroupOrderFieldDisplayName.column:
+		str = "display_name"
+	case DirectoryGroupOrderFieldDirectoryName.column:
 		str = "directory_name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DirectoryMembershipOrderField) MarshalGQL(w io.Writer) {
+func (f DirectoryGroupOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DirectoryMembershipOrderField) UnmarshalGQL(v interface{}) error {
+func (f *DirectoryGroupOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DirectoryMembershipOrderField %T must be a string", v)
+		return fmt.Errorf("DirectoryGroupOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *DirectoryMembershipOrderFieldCreatedAt
+		*f = *DirectoryGroupOrderFieldCreatedAt
 	case "updated_at":
-		*f = *DirectoryMembershipOrderFieldUpdatedAt
+		*f = *DirectoryGroupOrderFieldUpdatedAt
+	case "directory_instance_id":
+		*f = *DirectoryGroupOrderFieldDirectoryInstanceID
+	case "external_id":
+		*f = *DirectoryGroupOrderFieldExternalID
+	case "email":
+		*f = *DirectoryGroupOrderFieldEmail
+	case "display_name":
+		*f = *DirectoryGroupOrderFieldDisplayName
 	case "directory_name":
-		*f = *DirectoryMembershipOrderFieldDirectoryName
+		*f = *DirectoryGroupOrderFieldDirectoryName
 	default:
-		return fmt.Errorf("%s is not a valid DirectoryMembershipOrderField", str)
+		return fmt.Errorf("%s is not a valid DirectoryGroupOrderField", str)
 	}
 	return nil
 }
 
-// DirectoryMembershipOrderField defines the ordering field of DirectoryMembership.
-type DirectoryMembershipOrderField struct {
-	// Value extracts the ordering value from the given DirectoryMembership.
-	Value    func(*DirectoryMembership) (ent.Value, error)
+// DirectoryGroupOrderField defines the ordering field of DirectoryGroup.
+type DirectoryGroupOrderField struct {
+	// Value extracts the ordering value from the given DirectoryGroup.
+	Value    func(*DirectoryGroup) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) directorymembership.OrderOption
-	toCursor func(*DirectoryMembership) Cursor
+	toTerm   func(...sql.OrderTermOption) directorygroup.OrderOption
+	toCursor func(*DirectoryGroup) Cursor
 }
 
-// DirectoryMembershipOrder defines the ordering of DirectoryMembership.
-type DirectoryMembershipOrder struct {
-	Direction OrderDirection                 `json:"direction"`
-	Field     *DirectoryMembershipOrderField `json:"field"`
+// DirectoryGroupOrder defines the ordering of DirectoryGroup.
+type DirectoryGroupOrder struct {
+	Direction OrderDirection            `json:"direction"`
+	Field     *DirectoryGroupOrderField `json:"field"`
 }
 
-// DefaultDirectoryMembershipOrder is the default ordering of DirectoryMembership.
-var DefaultDirectoryMembershipOrder = &DirectoryMembershipOrder{
+// DefaultDirectoryGroupOrder is the default ordering of DirectoryGroup.
+var DefaultDirectoryGroupOrder = &DirectoryGroupOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DirectoryMembershipOrderField{
-		Value: func(_m *DirectoryMembership) (ent.Value, error) {
+	Field: &DirectoryGroupOrderField{
+		Value: func(_m *DirectoryGroup) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: directorymembership.FieldID,
-		toTerm: directorymembership.ByID,
-		toCursor: func(_m *DirectoryMembership) Cursor {
+		column: directorygroup.FieldID,
+		toTerm: directorygroup.ByID,
+		toCursor: func(_m *DirectoryGroup) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts DirectoryMembership into DirectoryMembershipEdge.
-func (_m *DirectoryMembership) ToEdge(order *DirectoryMembershipOrder) *DirectoryMembershipEdge {
+// ToEdge converts DirectoryGroup into DirectoryGroupEdge.
+func (_m *DirectoryGroup) ToEdge(order *DirectoryGroupOrder) *DirectoryGroupEdge {
 	if order == nil {
-		order = DefaultDirectoryMembershipOrder
+		order = DefaultDirectoryGroupOrder
 	}
-	return &DirectoryMembershipEdge{
+	return &DirectoryGroupEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DirectorySyncRunEdge is the edge representation of DirectorySyncRun.
-type DirectorySyncRunEdge struct {
-	Node   *DirectorySyncRun `json:"node"`
-	Cursor Cursor            `json:"cursor"`
+// DirectoryMembershipEdge is the edge representation of DirectoryMembership.
+type DirectoryMembershipEdge struct {
+	Node   *DirectoryMembership `json:"node"`
+	Cursor Cursor               `json:"cursor"`
 }
 
-// DirectorySyncRunConnection is the connection containing edges to DirectorySyncRun.
-type DirectorySyncRunConnection struct {
-	Edges      []*DirectorySyncRunEdge `json:"edges"`
-	PageInfo   PageInfo                `json:"pageInfo"`
-	TotalCount int                     `json:"totalCount"`
+// DirectoryMembershipConnection is the connection containing edges to DirectoryMembership.
+type DirectoryMembershipConnection struct {
+	Edges      []*DirectoryMembershipEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *DirectorySyncRunConnection) build(nodes []*DirectorySyncRun, pager *directorysyncrunPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DirectoryMembershipConnection) build(nodes []*DirectoryMembership, pager *directorymembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -9041,21 +9030,21 @@ func (c *DirectorySyncRunConnection) build(nodes []*DirectorySyncRun, pager *dir
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *DirectorySyncRun
+	var nodeAt func(int) *DirectoryMembership
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *DirectorySyncRun {
+		nodeAt = func(i int) *DirectoryMembership {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *DirectorySyncRun {
+		nodeAt = func(i int) *DirectoryMembership {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DirectorySyncRunEdge, len(nodes))
+	c.Edges = make([]*DirectoryMembershipEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DirectorySyncRunEdge{
+		c.Edges[i] = &DirectoryMembershipEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -9069,12 +9058,12 @@ func (c *DirectorySyncRunConnection) build(nodes []*DirectorySyncRun, pager *dir
 	}
 }
 
-// DirectorySyncRunPaginateOption enables pagination customization.
-type DirectorySyncRunPaginateOption func(*directorysyncrunPager) error
+// DirectoryMembershipPaginateOption enables pagination customization.
+type DirectoryMembershipPaginateOption func(*directorymembershipPager) error
 
-// WithDirectorySyncRunOrder configures pagination ordering.
-func WithDirectorySyncRunOrder(order []*DirectorySyncRunOrder) DirectorySyncRunPaginateOption {
-	return func(pager *directorysyncrunPager) error {
+// WithDirectoryMembershipOrder configures pagination ordering.
+func WithDirectoryMembershipOrder(order []*DirectoryMembershipOrder) DirectoryMembershipPaginateOption {
+	return func(pager *directorymembershipPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -9085,25 +9074,25 @@ func WithDirectorySyncRunOrder(order []*DirectorySyncRunOrder) DirectorySyncRunP
 	}
 }
 
-// WithDirectorySyncRunFilter configures pagination filter.
-func WithDirectorySyncRunFilter(filter func(*DirectorySyncRunQuery) (*DirectorySyncRunQuery, error)) DirectorySyncRunPaginateOption {
-	return func(pager *directorysyncrunPager) error {
+// WithDirectoryMembershipFilter configures pagination filter.
+func WithDirectoryMembershipFilter(filter func(*DirectoryMembershipQuery) (*DirectoryMembershipQuery, error)) DirectoryMembershipPaginateOption {
+	return func(pager *directorymembershipPager) error {
 		if filter == nil {
-			return errors.New("DirectorySyncRunQuery filter cannot be nil")
+			return errors.New("DirectoryMembershipQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type directorysyncrunPager struct {
+type directorymembershipPager struct {
 	reverse bool
-	order   []*DirectorySyncRunOrder
-	filter  func(*DirectorySyncRunQuery) (*DirectorySyncRunQuery, error)
+	order   []*DirectoryMembershipOrder
+	filter  func(*DirectoryMembershipQuery) (*DirectoryMembershipQuery, error)
 }
 
-func newDirectorySyncRunPager(opts []DirectorySyncRunPaginateOption, reverse bool) (*directorysyncrunPager, error) {
-	pager := &directorysyncrunPager{reverse: reverse}
+func newDirectoryMembershipPager(opts []DirectoryMembershipPaginateOption, reverse bool) (*directorymembershipPager, error) {
+	pager := &directorymembershipPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -9117,14 +9106,14 @@ func newDirectorySyncRunPager(opts []DirectorySyncRunPaginateOption, reverse boo
 	return pager, nil
 }
 
-func (p *directorysyncrunPager) applyFilter(query *DirectorySyncRunQuery) (*DirectorySyncRunQuery, error) {
+func (p *directorymembershipPager) applyFilter(query *DirectoryMembershipQuery) (*DirectoryMembershipQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *directorysyncrunPager) toCursor(_m *DirectorySyncRun) Cursor {
+func (p *directorymembershipPager) toCursor(_m *DirectoryMembership) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -9132,7 +9121,7 @@ func (p *directorysyncrunPager) toCursor(_m *DirectorySyncRun) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *directorysyncrunPager) applyCursors(query *DirectorySyncRunQuery, after, before *Cursor) (*DirectorySyncRunQuery, error) {
+func (p *directorymembershipPager) applyCursors(query *DirectoryMembershipQuery, after, before *Cursor) (*DirectoryMembershipQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -9147,7 +9136,7 @@ func (p *directorysyncrunPager) applyCursors(query *DirectorySyncRunQuery, after
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDirectorySyncRunOrder.Field.column,
+		FieldID:     DefaultDirectoryMembershipOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -9166,7 +9155,7 @@ func (p *directorysyncrunPager) applyCursors(query *DirectorySyncRunQuery, after
 	return query, nil
 }
 
-func (p *directorysyncrunPager) applyOrder(query *DirectorySyncRunQuery) *DirectorySyncRunQuery {
+func (p *directorymembershipPager) applyOrder(query *DirectoryMembershipQuery) *DirectoryMembershipQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -9174,7 +9163,7 @@ func (p *directorysyncrunPager) applyOrder(query *DirectorySyncRunQuery) *Direct
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDirectorySyncRunOrder.Field.column {
+		if o.Field.column == DefaultDirectoryMembershipOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -9186,12 +9175,12 @@ func (p *directorysyncrunPager) applyOrder(query *DirectorySyncRunQuery) *Direct
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultDirectorySyncRunOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultDirectoryMembershipOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *directorysyncrunPager) orderExpr(query *DirectorySyncRunQuery) sql.Querier {
+func (p *directorymembershipPager) orderExpr(query *DirectoryMembershipQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -9210,26 +9199,26 @@ func (p *directorysyncrunPager) orderExpr(query *DirectorySyncRunQuery) sql.Quer
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultDirectorySyncRunOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultDirectoryMembershipOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to DirectorySyncRun.
-func (_m *DirectorySyncRunQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to DirectoryMembership.
+func (_m *DirectoryMembershipQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DirectorySyncRunPaginateOption,
-) (*DirectorySyncRunConnection, error) {
+	before *Cursor, last *int, opts ...DirectoryMembershipPaginateOption,
+) (*DirectoryMembershipConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDirectorySyncRunPager(opts, last != nil)
+	pager, err := newDirectoryMembershipPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DirectorySyncRunConnection{Edges: []*DirectorySyncRunEdge{}}
+	conn := &DirectoryMembershipConnection{Edges: []*DirectoryMembershipEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -9268,143 +9257,156 @@ func (_m *DirectorySyncRunQuery) Paginate(
 }
 
 var (
-	// DirectorySyncRunOrderFieldCreatedAt orders DirectorySyncRun by created_at.
-	DirectorySyncRunOrderFieldCreatedAt = &DirectorySyncRunOrderField{
-		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
+	// DirectoryMembershipOrderFieldCreatedAt orders DirectoryMembership by created_at.
+	DirectoryMembershipOrderFieldCreatedAt = &DirectoryMembershipOrderField{
+		Value: func(_m *DirectoryMembership) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: directorysyncrun.FieldCreatedAt,
-		toTerm: directorysyncrun.ByCreatedAt,
-		toCursor: func(_m *DirectorySyncRun) Cursor {
+		column: directorymembership.FieldCreatedAt,
+		toTerm: directorymembership.ByCreatedAt,
+		toCursor: func(_m *DirectoryMembership) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DirectorySyncRunOrderFieldUpdatedAt orders DirectorySyncRun by updated_at.
-	DirectorySyncRunOrderFieldUpdatedAt = &DirectorySyncRunOrderField{
-		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
+	// DirectoryMembershipOrderFieldUpdatedAt orders DirectoryMembership by updated_at.
+	DirectoryMembershipOrderFieldUpdatedAt = &DirectoryMembershipOrderField{
+		Value: func(_m *DirectoryMembership) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: directorysyncrun.FieldUpdatedAt,
-		toTerm: directorysyncrun.ByUpdatedAt,
-		toCursor: func(_m *DirectorySyncRun) Cursor {
+		column: directorymembership.FieldUpdatedAt,
+		toTerm: directorymembership.ByUpdatedAt,
+		toCursor: func(_m *DirectoryMembership) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// DirectorySyncRunOrderFieldStartedAt orders DirectorySyncRun by started_at.
-	DirectorySyncRunOrderFieldStartedAt = &DirectorySyncRunOrderField{
-		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
-			return _m.StartedAt, nil
+	// DirectoryMembershipOrderFieldDirectoryName orders DirectoryMembership by directory_name.
+	DirectoryMembershipOrderFieldDirectoryName = &DirectoryMembershipOrderField{
+		Value: func(_m *DirectoryMembership) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.DirectoryName == nil {
+				return nil, nil
+			}
+			return _m.DirectoryName, nil
 		},
-		column: directorysyncrun.FieldStartedAt,
-		toTerm: directorysyncrun.ByStartedAt,
-		toCursor: func(_m *DirectorySyncRun) Cursor {
+		column: directorymembership.FieldDirectoryName,
+		toTerm: func(opts ...sql.OrderTermOption) directorymembership.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return directorymembership.ByDirectoryName(opts...)
+		},
+		toCursor: func(_m *DirectoryMembership) Cursor {
+			if _m.DirectoryName == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.StartedAt,
+				Value: _m.DirectoryName,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f DirectorySyncRunOrderField) String() string {
+func (f DirectoryMembershipOrderField) String() string {
 	var str string
 	switch f.column {
-	case DirectorySyncRunOrderFieldCreatedAt.column:
+	case DirectoryMembershipOrderFieldCreatedAt.column:
 		str = "created_at"
-	case DirectorySyncRunOrderFieldUpdatedAt.column:
+	case DirectoryMembershipOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case DirectorySyncRunOrderFieldStartedAt.column:
-		str = "started_at"
+	case DirectoryMembershipOrderFieldDirectoryName.column:
+		str = "directory_name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DirectorySyncRunOrderField) MarshalGQL(w io.Writer) {
+func (f DirectoryMembershipOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DirectorySyncRunOrderField) UnmarshalGQL(v interface{}) error {
+func (f *DirectoryMembershipOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DirectorySyncRunOrderField %T must be a string", v)
+		return fmt.Errorf("DirectoryMembershipOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *DirectorySyncRunOrderFieldCreatedAt
+		*f = *DirectoryMembershipOrderFieldCreatedAt
 	case "updated_at":
-		*f = *DirectorySyncRunOrderFieldUpdatedAt
-	case "started_at":
-		*f = *DirectorySyncRunOrderFieldStartedAt
+		*f = *DirectoryMembershipOrderFieldUpdatedAt
+	case "directory_name":
+		*f = *DirectoryMembershipOrderFieldDirectoryName
 	default:
-		return fmt.Errorf("%s is not a valid DirectorySyncRunOrderField", str)
+		return fmt.Errorf("%s is not a valid DirectoryMembershipOrderField", str)
 	}
 	return nil
 }
 
-// DirectorySyncRunOrderField defines the ordering field of DirectorySyncRun.
-type DirectorySyncRunOrderField struct {
-	// Value extracts the ordering value from the given DirectorySyncRun.
-	Value    func(*DirectorySyncRun) (ent.Value, error)
+// DirectoryMembershipOrderField defines the ordering field of DirectoryMembership.
+type DirectoryMembershipOrderField struct {
+	// Value extracts the ordering value from the given DirectoryMembership.
+	Value    func(*DirectoryMembership) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) directorysyncrun.OrderOption
-	toCursor func(*DirectorySyncRun) Cursor
+	toTerm   func(...sql.OrderTermOption) directorymembership.OrderOption
+	toCursor func(*DirectoryMembership) Cursor
 }
 
-// DirectorySyncRunOrder defines the ordering of DirectorySyncRun.
-type DirectorySyncRunOrder struct {
-	Direction OrderDirection              `json:"direction"`
-	Field     *DirectorySyncRunOrderField `json:"field"`
+// DirectoryMembershipOrder defines the ordering of DirectoryMembership.
+type DirectoryMembershipOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *DirectoryMembershipOrderField `json:"field"`
 }
 
-// DefaultDirectorySyncRunOrder is the default ordering of DirectorySyncRun.
-var DefaultDirectorySyncRunOrder = &DirectorySyncRunOrder{
+// DefaultDirectoryMembershipOrder is the default ordering of DirectoryMembership.
+var DefaultDirectoryMembershipOrder = &DirectoryMembershipOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DirectorySyncRunOrderField{
-		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
+	Field: &DirectoryMembershipOrderField{
+		Value: func(_m *DirectoryMembership) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: directorysyncrun.FieldID,
-		toTerm: directorysyncrun.ByID,
-		toCursor: func(_m *DirectorySyncRun) Cursor {
+		column: directorymembership.FieldID,
+		toTerm: directorymembership.ByID,
+		toCursor: func(_m *DirectoryMembership) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts DirectorySyncRun into DirectorySyncRunEdge.
-func (_m *DirectorySyncRun) ToEdge(order *DirectorySyncRunOrder) *DirectorySyncRunEdge {
+// ToEdge converts DirectoryMembership into DirectoryMembershipEdge.
+func (_m *DirectoryMembership) ToEdge(order *DirectoryMembershipOrder) *DirectoryMembershipEdge {
 	if order == nil {
-		order = DefaultDirectorySyncRunOrder
+		order = DefaultDirectoryMembershipOrder
 	}
-	return &DirectorySyncRunEdge{
+	return &DirectoryMembershipEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DiscussionEdge is the edge representation of Discussion.
-type DiscussionEdge struct {
-	Node   *Discussion `json:"node"`
-	Cursor Cursor      `json:"cursor"`
+// DirectorySyncRunEdge is the edge representation of DirectorySyncRun.
+type DirectorySyncRunEdge struct {
+	Node   *DirectorySyncRun `json:"node"`
+	Cursor Cursor            `json:"cursor"`
 }
 
-// DiscussionConnection is the connection containing edges to Discussion.
-type DiscussionConnection struct {
-	Edges      []*DiscussionEdge `json:"edges"`
-	PageInfo   PageInfo          `json:"pageInfo"`
-	TotalCount int               `json:"totalCount"`
+// DirectorySyncRunConnection is the connection containing edges to DirectorySyncRun.
+type DirectorySyncRunConnection struct {
+	Edges      []*DirectorySyncRunEdge `json:"edges"`
+	PageInfo   PageInfo                `json:"pageInfo"`
+	TotalCount int                     `json:"totalCount"`
 }
 
-func (c *DiscussionConnection) build(nodes []*Discussion, pager *discussionPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DirectorySyncRunConnection) build(nodes []*DirectorySyncRun, pager *directorysyncrunPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -9414,21 +9416,21 @@ func (c *DiscussionConnection) build(nodes []*Discussion, pager *discussionPager
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *Discussion
+	var nodeAt func(int) *DirectorySyncRun
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Discussion {
+		nodeAt = func(i int) *DirectorySyncRun {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Discussion {
+		nodeAt = func(i int) *DirectorySyncRun {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DiscussionEdge, len(nodes))
+	c.Edges = make([]*DirectorySyncRunEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DiscussionEdge{
+		c.Edges[i] = &DirectorySyncRunEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -9442,12 +9444,12 @@ func (c *DiscussionConnection) build(nodes []*Discussion, pager *discussionPager
 	}
 }
 
-// DiscussionPaginateOption enables pagination customization.
-type DiscussionPaginateOption func(*discussionPager) error
+// DirectorySyncRunPaginateOption enables pagination customization.
+type DirectorySyncRunPaginateOption func(*directorysyncrunPager) error
 
-// WithDiscussionOrder configures pagination ordering.
-func WithDiscussionOrder(order []*DiscussionOrder) DiscussionPaginateOption {
-	return func(pager *discussionPager) error {
+// WithDirectorySyncRunOrder configures pagination ordering.
+func WithDirectorySyncRunOrder(order []*DirectorySyncRunOrder) DirectorySyncRunPaginateOption {
+	return func(pager *directorysyncrunPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -9458,25 +9460,25 @@ func WithDiscussionOrder(order []*DiscussionOrder) DiscussionPaginateOption {
 	}
 }
 
-// WithDiscussionFilter configures pagination filter.
-func WithDiscussionFilter(filter func(*DiscussionQuery) (*DiscussionQuery, error)) DiscussionPaginateOption {
-	return func(pager *discussionPager) error {
+// WithDirectorySyncRunFilter configures pagination filter.
+func WithDirectorySyncRunFilter(filter func(*DirectorySyncRunQuery) (*DirectorySyncRunQuery, error)) DirectorySyncRunPaginateOption {
+	return func(pager *directorysyncrunPager) error {
 		if filter == nil {
-			return errors.New("DiscussionQuery filter cannot be nil")
+			return errors.New("DirectorySyncRunQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type discussionPager struct {
+type directorysyncrunPager struct {
 	reverse bool
-	order   []*DiscussionOrder
-	filter  func(*DiscussionQuery) (*DiscussionQuery, error)
+	order   []*DirectorySyncRunOrder
+	filter  func(*DirectorySyncRunQuery) (*DirectorySyncRunQuery, error)
 }
 
-func newDiscussionPager(opts []DiscussionPaginateOption, reverse bool) (*discussionPager, error) {
-	pager := &discussionPager{reverse: reverse}
+func newDirectorySyncRunPager(opts []DirectorySyncRunPaginateOption, reverse bool) (*directorysyncrunPager, error) {
+	pager := &directorysyncrunPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -9490,14 +9492,14 @@ func newDiscussionPager(opts []DiscussionPaginateOption, reverse bool) (*discuss
 	return pager, nil
 }
 
-func (p *discussionPager) applyFilter(query *DiscussionQuery) (*DiscussionQuery, error) {
+func (p *directorysyncrunPager) applyFilter(query *DirectorySyncRunQuery) (*DirectorySyncRunQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *discussionPager) toCursor(_m *Discussion) Cursor {
+func (p *directorysyncrunPager) toCursor(_m *DirectorySyncRun) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -9505,7 +9507,7 @@ func (p *discussionPager) toCursor(_m *Discussion) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *discussionPager) applyCursors(query *DiscussionQuery, after, before *Cursor) (*DiscussionQuery, error) {
+func (p *directorysyncrunPager) applyCursors(query *DirectorySyncRunQuery, after, before *Cursor) (*DirectorySyncRunQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -9520,7 +9522,7 @@ func (p *discussionPager) applyCursors(query *DiscussionQuery, after, before *Cu
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDiscussionOrder.Field.column,
+		FieldID:     DefaultDirectorySyncRunOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -9539,7 +9541,7 @@ func (p *discussionPager) applyCursors(query *DiscussionQuery, after, before *Cu
 	return query, nil
 }
 
-func (p *discussionPager) applyOrder(query *DiscussionQuery) *DiscussionQuery {
+func (p *directorysyncrunPager) applyOrder(query *DirectorySyncRunQuery) *DirectorySyncRunQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -9547,7 +9549,7 @@ func (p *discussionPager) applyOrder(query *DiscussionQuery) *DiscussionQuery {
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDiscussionOrder.Field.column {
+		if o.Field.column == DefaultDirectorySyncRunOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -9559,12 +9561,12 @@ func (p *discussionPager) applyOrder(query *DiscussionQuery) *DiscussionQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultDiscussionOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultDirectorySyncRunOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *discussionPager) orderExpr(query *DiscussionQuery) sql.Querier {
+func (p *directorysyncrunPager) orderExpr(query *DirectorySyncRunQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -9583,26 +9585,26 @@ func (p *discussionPager) orderExpr(query *DiscussionQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultDiscussionOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultDirectorySyncRunOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Discussion.
-func (_m *DiscussionQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to DirectorySyncRun.
+func (_m *DirectorySyncRunQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DiscussionPaginateOption,
-) (*DiscussionConnection, error) {
+	before *Cursor, last *int, opts ...DirectorySyncRunPaginateOption,
+) (*DirectorySyncRunConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDiscussionPager(opts, last != nil)
+	pager, err := newDirectorySyncRunPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DiscussionConnection{Edges: []*DiscussionEdge{}}
+	conn := &DirectorySyncRunConnection{Edges: []*DirectorySyncRunEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -9622,7 +9624,7 @@ func (_m *DiscussionQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimit(first, last)
+	limit := paginateLimitSingle(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -9641,125 +9643,143 @@ func (_m *DiscussionQuery) Paginate(
 }
 
 var (
-	// DiscussionOrderFieldCreatedAt orders Discussion by created_at.
-	DiscussionOrderFieldCreatedAt = &DiscussionOrderField{
-		Value: func(_m *Discussion) (ent.Value, error) {
+	// DirectorySyncRunOrderFieldCreatedAt orders DirectorySyncRun by created_at.
+	DirectorySyncRunOrderFieldCreatedAt = &DirectorySyncRunOrderField{
+		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: discussion.FieldCreatedAt,
-		toTerm: discussion.ByCreatedAt,
-		toCursor: func(_m *Discussion) Cursor {
+		column: directorysyncrun.FieldCreatedAt,
+		toTerm: directorysyncrun.ByCreatedAt,
+		toCursor: func(_m *DirectorySyncRun) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DiscussionOrderFieldUpdatedAt orders Discussion by updated_at.
-	DiscussionOrderFieldUpdatedAt = &DiscussionOrderField{
-		Value: func(_m *Discussion) (ent.Value, error) {
+	// DirectorySyncRunOrderFieldUpdatedAt orders DirectorySyncRun by updated_at.
+	DirectorySyncRunOrderFieldUpdatedAt = &DirectorySyncRunOrderField{
+		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: discussion.FieldUpdatedAt,
-		toTerm: discussion.ByUpdatedAt,
-		toCursor: func(_m *Discussion) Cursor {
+		column: directorysyncrun.FieldUpdatedAt,
+		toTerm: directorysyncrun.ByUpdatedAt,
+		toCursor: func(_m *DirectorySyncRun) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
+	// DirectorySyncRunOrderFieldStartedAt orders DirectorySyncRun by started_at.
+	DirectorySyncRunOrderFieldStartedAt = &DirectorySyncRunOrderField{
+		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
+			return _m.StartedAt, nil
+		},
+		column: directorysyncrun.FieldStartedAt,
+		toTerm: directorysyncrun.ByStartedAt,
+		toCursor: func(_m *DirectorySyncRun) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.StartedAt,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
-func (f DiscussionOrderField) String() string {
+func (f DirectorySyncRunOrderField) String() string {
 	var str string
 	switch f.column {
-	case DiscussionOrderFieldCreatedAt.column:
+	case DirectorySyncRunOrderFieldCreatedAt.column:
 		str = "created_at"
-	case DiscussionOrderFieldUpdatedAt.column:
+	case DirectorySyncRunOrderFieldUpdatedAt.column:
 		str = "updated_at"
+	case DirectorySyncRunOrderFieldStartedAt.column:
+		str = "started_at"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DiscussionOrderField) MarshalGQL(w io.Writer) {
+func (f DirectorySyncRunOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DiscussionOrderField) UnmarshalGQL(v interface{}) error {
+func (f *DirectorySyncRunOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DiscussionOrderField %T must be a string", v)
+		return fmt.Errorf("DirectorySyncRunOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *DiscussionOrderFieldCreatedAt
+		*f = *DirectorySyncRunOrderFieldCreatedAt
 	case "updated_at":
-		*f = *DiscussionOrderFieldUpdatedAt
+		*f = *DirectorySyncRunOrderFieldUpdatedAt
+	case "started_at":
+		*f = *DirectorySyncRunOrderFieldStartedAt
 	default:
-		return fmt.Errorf("%s is not a valid DiscussionOrderField", str)
+		return fmt.Errorf("%s is not a valid DirectorySyncRunOrderField", str)
 	}
 	return nil
 }
 
-// DiscussionOrderField defines the ordering field of Discussion.
-type DiscussionOrderField struct {
-	// Value extracts the ordering value from the given Discussion.
-	Value    func(*Discussion) (ent.Value, error)
+// DirectorySyncRunOrderField defines the ordering field of DirectorySyncRun.
+type DirectorySyncRunOrderField struct {
+	// Value extracts the ordering value from the given DirectorySyncRun.
+	Value    func(*DirectorySyncRun) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) discussion.OrderOption
-	toCursor func(*Discussion) Cursor
+	toTerm   func(...sql.OrderTermOption) directorysyncrun.OrderOption
+	toCursor func(*DirectorySyncRun) Cursor
 }
 
-// DiscussionOrder defines the ordering of Discussion.
-type DiscussionOrder struct {
-	Direction OrderDirection        `json:"direction"`
-	Field     *DiscussionOrderField `json:"field"`
+// DirectorySyncRunOrder defines the ordering of DirectorySyncRun.
+type DirectorySyncRunOrder struct {
+	Direction OrderDirection              `json:"direction"`
+	Field     *DirectorySyncRunOrderField `json:"field"`
 }
 
-// DefaultDiscussionOrder is the default ordering of Discussion.
-var DefaultDiscussionOrder = &DiscussionOrder{
+// DefaultDirectorySyncRunOrder is the default ordering of DirectorySyncRun.
+var DefaultDirectorySyncRunOrder = &DirectorySyncRunOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DiscussionOrderField{
-		Value: func(_m *Discussion) (ent.Value, error) {
+	Field: &DirectorySyncRunOrderField{
+		Value: func(_m *DirectorySyncRun) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: discussion.FieldID,
-		toTerm: discussion.ByID,
-		toCursor: func(_m *Discussion) Cursor {
+		column: directorysyncrun.FieldID,
+		toTerm: directorysyncrun.ByID,
+		toCursor: func(_m *DirectorySyncRun) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Discussion into DiscussionEdge.
-func (_m *Discussion) ToEdge(order *DiscussionOrder) *DiscussionEdge {
+// ToEdge converts DirectorySyncRun into DirectorySyncRunEdge.
+func (_m *DirectorySyncRun) ToEdge(order *DirectorySyncRunOrder) *DirectorySyncRunEdge {
 	if order == nil {
-		order = DefaultDiscussionOrder
+		order = DefaultDirectorySyncRunOrder
 	}
-	return &DiscussionEdge{
+	return &DirectorySyncRunEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// DocumentDataEdge is the edge representation of DocumentData.
-type DocumentDataEdge struct {
-	Node   *DocumentData `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// DiscussionEdge is the edge representation of Discussion.
+type DiscussionEdge struct {
+	Node   *Discussion `json:"node"`
+	Cursor Cursor      `json:"cursor"`
 }
 
-// DocumentDataConnection is the connection containing edges to DocumentData.
-type DocumentDataConnection struct {
-	Edges      []*DocumentDataEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// DiscussionConnection is the connection containing edges to Discussion.
+type DiscussionConnection struct {
+	Edges      []*DiscussionEdge `json:"edges"`
+	PageInfo   PageInfo          `json:"pageInfo"`
+	TotalCount int               `json:"totalCount"`
 }
 
-func (c *DocumentDataConnection) build(nodes []*DocumentData, pager *documentdataPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DiscussionConnection) build(nodes []*Discussion, pager *discussionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -9769,21 +9789,21 @@ func (c *DocumentDataConnection) build(nodes []*DocumentData, pager *documentdat
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *DocumentData
+	var nodeAt func(int) *Discussion
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *DocumentData {
+		nodeAt = func(i int) *Discussion {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *DocumentData {
+		nodeAt = func(i int) *Discussion {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*DocumentDataEdge, len(nodes))
+	c.Edges = make([]*DiscussionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &DocumentDataEdge{
+		c.Edges[i] = &DiscussionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -9797,12 +9817,12 @@ func (c *DocumentDataConnection) build(nodes []*DocumentData, pager *documentdat
 	}
 }
 
-// DocumentDataPaginateOption enables pagination customization.
-type DocumentDataPaginateOption func(*documentdataPager) error
+// DiscussionPaginateOption enables pagination customization.
+type DiscussionPaginateOption func(*discussionPager) error
 
-// WithDocumentDataOrder configures pagination ordering.
-func WithDocumentDataOrder(order []*DocumentDataOrder) DocumentDataPaginateOption {
-	return func(pager *documentdataPager) error {
+// WithDiscussionOrder configures pagination ordering.
+func WithDiscussionOrder(order []*DiscussionOrder) DiscussionPaginateOption {
+	return func(pager *discussionPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -9813,25 +9833,25 @@ func WithDocumentDataOrder(order []*DocumentDataOrder) DocumentDataPaginateOptio
 	}
 }
 
-// WithDocumentDataFilter configures pagination filter.
-func WithDocumentDataFilter(filter func(*DocumentDataQuery) (*DocumentDataQuery, error)) DocumentDataPaginateOption {
-	return func(pager *documentdataPager) error {
+// WithDiscussionFilter configures pagination filter.
+func WithDiscussionFilter(filter func(*DiscussionQuery) (*DiscussionQuery, error)) DiscussionPaginateOption {
+	return func(pager *discussionPager) error {
 		if filter == nil {
-			return errors.New("DocumentDataQuery filter cannot be nil")
+			return errors.New("DiscussionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type documentdataPager struct {
+type discussionPager struct {
 	reverse bool
-	order   []*DocumentDataOrder
-	filter  func(*DocumentDataQuery) (*DocumentDataQuery, error)
+	order   []*DiscussionOrder
+	filter  func(*DiscussionQuery) (*DiscussionQuery, error)
 }
 
-func newDocumentDataPager(opts []DocumentDataPaginateOption, reverse bool) (*documentdataPager, error) {
-	pager := &documentdataPager{reverse: reverse}
+func newDiscussionPager(opts []DiscussionPaginateOption, reverse bool) (*discussionPager, error) {
+	pager := &discussionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -9845,14 +9865,14 @@ func newDocumentDataPager(opts []DocumentDataPaginateOption, reverse bool) (*doc
 	return pager, nil
 }
 
-func (p *documentdataPager) applyFilter(query *DocumentDataQuery) (*DocumentDataQuery, error) {
+func (p *discussionPager) applyFilter(query *DiscussionQuery) (*DiscussionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *documentdataPager) toCursor(_m *DocumentData) Cursor {
+func (p *discussionPager) toCursor(_m *Discussion) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -9860,7 +9880,7 @@ func (p *documentdataPager) toCursor(_m *DocumentData) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *documentdataPager) applyCursors(query *DocumentDataQuery, after, before *Cursor) (*DocumentDataQuery, error) {
+func (p *discussionPager) applyCursors(query *DiscussionQuery, after, before *Cursor) (*DiscussionQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -9875,7 +9895,7 @@ func (p *documentdataPager) applyCursors(query *DocumentDataQuery, after, before
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultDocumentDataOrder.Field.column,
+		FieldID:     DefaultDiscussionOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -9894,7 +9914,7 @@ func (p *documentdataPager) applyCursors(query *DocumentDataQuery, after, before
 	return query, nil
 }
 
-func (p *documentdataPager) applyOrder(query *DocumentDataQuery) *DocumentDataQuery {
+func (p *discussionPager) applyOrder(query *DiscussionQuery) *DiscussionQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -9902,7 +9922,7 @@ func (p *documentdataPager) applyOrder(query *DocumentDataQuery) *DocumentDataQu
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultDocumentDataOrder.Field.column {
+		if o.Field.column == DefaultDiscussionOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -9914,12 +9934,12 @@ func (p *documentdataPager) applyOrder(query *DocumentDataQuery) *DocumentDataQu
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultDocumentDataOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultDiscussionOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *documentdataPager) orderExpr(query *DocumentDataQuery) sql.Querier {
+func (p *discussionPager) orderExpr(query *DiscussionQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -9938,26 +9958,26 @@ func (p *documentdataPager) orderExpr(query *DocumentDataQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultDocumentDataOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultDiscussionOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to DocumentData.
-func (_m *DocumentDataQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Discussion.
+func (_m *DiscussionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...DocumentDataPaginateOption,
-) (*DocumentDataConnection, error) {
+	before *Cursor, last *int, opts ...DiscussionPaginateOption,
+) (*DiscussionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newDocumentDataPager(opts, last != nil)
+	pager, err := newDiscussionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &DocumentDataConnection{Edges: []*DocumentDataEdge{}}
+	conn := &DiscussionConnection{Edges: []*DiscussionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -9996,28 +10016,28 @@ func (_m *DocumentDataQuery) Paginate(
 }
 
 var (
-	// DocumentDataOrderFieldCreatedAt orders DocumentData by created_at.
-	DocumentDataOrderFieldCreatedAt = &DocumentDataOrderField{
-		Value: func(_m *DocumentData) (ent.Value, error) {
+	// DiscussionOrderFieldCreatedAt orders Discussion by created_at.
+	DiscussionOrderFieldCreatedAt = &DiscussionOrderField{
+		Value: func(_m *Discussion) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: documentdata.FieldCreatedAt,
-		toTerm: documentdata.ByCreatedAt,
-		toCursor: func(_m *DocumentData) Cursor {
+		column: discussion.FieldCreatedAt,
+		toTerm: discussion.ByCreatedAt,
+		toCursor: func(_m *Discussion) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// DocumentDataOrderFieldUpdatedAt orders DocumentData by updated_at.
-	DocumentDataOrderFieldUpdatedAt = &DocumentDataOrderField{
-		Value: func(_m *DocumentData) (ent.Value, error) {
+	// DiscussionOrderFieldUpdatedAt orders Discussion by updated_at.
+	DiscussionOrderFieldUpdatedAt = &DiscussionOrderField{
+		Value: func(_m *Discussion) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: documentdata.FieldUpdatedAt,
-		toTerm: documentdata.ByUpdatedAt,
-		toCursor: func(_m *DocumentData) Cursor {
+		column: discussion.FieldUpdatedAt,
+		toTerm: discussion.ByUpdatedAt,
+		toCursor: func(_m *Discussion) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
@@ -10027,94 +10047,94 @@ var (
 )
 
 // String implement fmt.Stringer interface.
-func (f DocumentDataOrderField) String() string {
+func (f DiscussionOrderField) String() string {
 	var str string
 	switch f.column {
-	case DocumentDataOrderFieldCreatedAt.column:
+	case DiscussionOrderFieldCreatedAt.column:
 		str = "created_at"
-	case DocumentDataOrderFieldUpdatedAt.column:
+	case DiscussionOrderFieldUpdatedAt.column:
 		str = "updated_at"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f DocumentDataOrderField) MarshalGQL(w io.Writer) {
+func (f DiscussionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *DocumentDataOrderField) UnmarshalGQL(v interface{}) error {
+func (f *DiscussionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("DocumentDataOrderField %T must be a string", v)
+		return fmt.Errorf("DiscussionOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *DocumentDataOrderFieldCreatedAt
+		*f = *DiscussionOrderFieldCreatedAt
 	case "updated_at":
-		*f = *DocumentDataOrderFieldUpdatedAt
+		*f = *DiscussionOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid DocumentDataOrderField", str)
+		return fmt.Errorf("%s is not a valid DiscussionOrderField", str)
 	}
 	return nil
 }
 
-// DocumentDataOrderField defines the ordering field of DocumentData.
-type DocumentDataOrderField struct {
-	// Value extracts the ordering value from the given DocumentData.
-	Value    func(*DocumentData) (ent.Value, error)
+// DiscussionOrderField defines the ordering field of Discussion.
+type DiscussionOrderField struct {
+	// Value extracts the ordering value from the given Discussion.
+	Value    func(*Discussion) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) documentdata.OrderOption
-	toCursor func(*DocumentData) Cursor
+	toTerm   func(...sql.OrderTermOption) discussion.OrderOption
+	toCursor func(*Discussion) Cursor
 }
 
-// DocumentDataOrder defines the ordering of DocumentData.
-type DocumentDataOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *DocumentDataOrderField `json:"field"`
+// DiscussionOrder defines the ordering of Discussion.
+type DiscussionOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *DiscussionOrderField `json:"field"`
 }
 
-// DefaultDocumentDataOrder is the default ordering of DocumentData.
-var DefaultDocumentDataOrder = &DocumentDataOrder{
+// DefaultDiscussionOrder is the default ordering of Discussion.
+var DefaultDiscussionOrder = &DiscussionOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &DocumentDataOrderField{
-		Value: func(_m *DocumentData) (ent.Value, error) {
+	Field: &DiscussionOrderField{
+		Value: func(_m *Discussion) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: documentdata.FieldID,
-		toTerm: documentdata.ByID,
-		toCursor: func(_m *DocumentData) Cursor {
+		column: discussion.FieldID,
+		toTerm: discussion.ByID,
+		toCursor: func(_m *Discussion) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts DocumentData into DocumentDataEdge.
-func (_m *DocumentData) ToEdge(order *DocumentDataOrder) *DocumentDataEdge {
+// ToEdge converts Discussion into DiscussionEdge.
+func (_m *Discussion) ToEdge(order *DiscussionOrder) *DiscussionEdge {
 	if order == nil {
-		order = DefaultDocumentDataOrder
+		order = DefaultDiscussionOrder
 	}
-	return &DocumentDataEdge{
+	return &DiscussionEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// EmailTemplateEdge is the edge representation of EmailTemplate.
-type EmailTemplateEdge struct {
-	Node   *EmailTemplate `json:"node"`
-	Cursor Cursor         `json:"cursor"`
+// DocumentDataEdge is the edge representation of DocumentData.
+type DocumentDataEdge struct {
+	Node   *DocumentData `json:"node"`
+	Cursor Cursor        `json:"cursor"`
 }
 
-// EmailTemplateConnection is the connection containing edges to EmailTemplate.
-type EmailTemplateConnection struct {
-	Edges      []*EmailTemplateEdge `json:"edges"`
-	PageInfo   PageInfo             `json:"pageInfo"`
-	TotalCount int                  `json:"totalCount"`
+// DocumentDataConnection is the connection containing edges to DocumentData.
+type DocumentDataConnection struct {
+	Edges      []*DocumentDataEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
 }
 
-func (c *EmailTemplateConnection) build(nodes []*EmailTemplate, pager *emailtemplatePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *DocumentDataConnection) build(nodes []*DocumentData, pager *documentdataPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -10124,21 +10144,21 @@ func (c *EmailTemplateConnection) build(nodes []*EmailTemplate, pager *emailtemp
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *EmailTemplate
+	var nodeAt func(int) *DocumentData
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *EmailTemplate {
+		nodeAt = func(i int) *DocumentData {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *EmailTemplate {
+		nodeAt = func(i int) *DocumentData {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*EmailTemplateEdge, len(nodes))
+	c.Edges = make([]*DocumentDataEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &EmailTemplateEdge{
+		c.Edges[i] = &DocumentDataEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -10152,12 +10172,12 @@ func (c *EmailTemplateConnection) build(nodes []*EmailTemplate, pager *emailtemp
 	}
 }
 
-// EmailTemplatePaginateOption enables pagination customization.
-type EmailTemplatePaginateOption func(*emailtemplatePager) error
+// DocumentDataPaginateOption enables pagination customization.
+type DocumentDataPaginateOption func(*documentdataPager) error
 
-// WithEmailTemplateOrder configures pagination ordering.
-func WithEmailTemplateOrder(order []*EmailTemplateOrder) EmailTemplatePaginateOption {
-	return func(pager *emailtemplatePager) error {
+// WithDocumentDataOrder configures pagination ordering.
+func WithDocumentDataOrder(order []*DocumentDataOrder) DocumentDataPaginateOption {
+	return func(pager *documentdataPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -10168,25 +10188,25 @@ func WithEmailTemplateOrder(order []*EmailTemplateOrder) EmailTemplatePaginateOp
 	}
 }
 
-// WithEmailTemplateFilter configures pagination filter.
-func WithEmailTemplateFilter(filter func(*EmailTemplateQuery) (*EmailTemplateQuery, error)) EmailTemplatePaginateOption {
-	return func(pager *emailtemplatePager) error {
+// WithDocumentDataFilter configures pagination filter.
+func WithDocumentDataFilter(filter func(*DocumentDataQuery) (*DocumentDataQuery, error)) DocumentDataPaginateOption {
+	return func(pager *documentdataPager) error {
 		if filter == nil {
-			return errors.New("EmailTemplateQuery filter cannot be nil")
+			return errors.New("DocumentDataQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type emailtemplatePager struct {
+type documentdataPager struct {
 	reverse bool
-	order   []*EmailTemplateOrder
-	filter  func(*EmailTemplateQuery) (*EmailTemplateQuery, error)
+	order   []*DocumentDataOrder
+	filter  func(*DocumentDataQuery) (*DocumentDataQuery, error)
 }
 
-func newEmailTemplatePager(opts []EmailTemplatePaginateOption, reverse bool) (*emailtemplatePager, error) {
-	pager := &emailtemplatePager{reverse: reverse}
+func newDocumentDataPager(opts []DocumentDataPaginateOption, reverse bool) (*documentdataPager, error) {
+	pager := &documentdataPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -10200,14 +10220,14 @@ func newEmailTemplatePager(opts []EmailTemplatePaginateOption, reverse bool) (*e
 	return pager, nil
 }
 
-func (p *emailtemplatePager) applyFilter(query *EmailTemplateQuery) (*EmailTemplateQuery, error) {
+func (p *documentdataPager) applyFilter(query *DocumentDataQuery) (*DocumentDataQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *emailtemplatePager) toCursor(_m *EmailTemplate) Cursor {
+func (p *documentdataPager) toCursor(_m *DocumentData) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -10215,7 +10235,7 @@ func (p *emailtemplatePager) toCursor(_m *EmailTemplate) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *emailtemplatePager) applyCursors(query *EmailTemplateQuery, after, before *Cursor) (*EmailTemplateQuery, error) {
+func (p *documentdataPager) applyCursors(query *DocumentDataQuery, after, before *Cursor) (*DocumentDataQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -10230,7 +10250,7 @@ func (p *emailtemplatePager) applyCursors(query *EmailTemplateQuery, after, befo
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultEmailTemplateOrder.Field.column,
+		FieldID:     DefaultDocumentDataOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -10249,7 +10269,7 @@ func (p *emailtemplatePager) applyCursors(query *EmailTemplateQuery, after, befo
 	return query, nil
 }
 
-func (p *emailtemplatePager) applyOrder(query *EmailTemplateQuery) *EmailTemplateQuery {
+func (p *documentdataPager) applyOrder(query *DocumentDataQuery) *DocumentDataQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -10257,7 +10277,7 @@ func (p *emailtemplatePager) applyOrder(query *EmailTemplateQuery) *EmailTemplat
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultEmailTemplateOrder.Field.column {
+		if o.Field.column == DefaultDocumentDataOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -10269,12 +10289,12 @@ func (p *emailtemplatePager) applyOrder(query *EmailTemplateQuery) *EmailTemplat
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultEmailTemplateOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultDocumentDataOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *emailtemplatePager) orderExpr(query *EmailTemplateQuery) sql.Querier {
+func (p *documentdataPager) orderExpr(query *DocumentDataQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -10293,26 +10313,26 @@ func (p *emailtemplatePager) orderExpr(query *EmailTemplateQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultEmailTemplateOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultDocumentDataOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to EmailTemplate.
-func (_m *EmailTemplateQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to DocumentData.
+func (_m *DocumentDataQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...EmailTemplatePaginateOption,
-) (*EmailTemplateConnection, error) {
+	before *Cursor, last *int, opts ...DocumentDataPaginateOption,
+) (*DocumentDataConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newEmailTemplatePager(opts, last != nil)
+	pager, err := newDocumentDataPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &EmailTemplateConnection{Edges: []*EmailTemplateEdge{}}
+	conn := &DocumentDataConnection{Edges: []*DocumentDataEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -10351,269 +10371,125 @@ func (_m *EmailTemplateQuery) Paginate(
 }
 
 var (
-	// EmailTemplateOrderFieldCreatedAt orders EmailTemplate by created_at.
-	EmailTemplateOrderFieldCreatedAt = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
+	// DocumentDataOrderFieldCreatedAt orders DocumentData by created_at.
+	DocumentDataOrderFieldCreatedAt = &DocumentDataOrderField{
+		Value: func(_m *DocumentData) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: emailtemplate.FieldCreatedAt,
-		toTerm: emailtemplate.ByCreatedAt,
-		toCursor: func(_m *EmailTemplate) Cursor {
+		column: documentdata.FieldCreatedAt,
+		toTerm: documentdata.ByCreatedAt,
+		toCursor: func(_m *DocumentData) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// EmailTemplateOrderFieldUpdatedAt orders EmailTemplate by updated_at.
-	EmailTemplateOrderFieldUpdatedAt = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
+	// DocumentDataOrderFieldUpdatedAt orders DocumentData by updated_at.
+	DocumentDataOrderFieldUpdatedAt = &DocumentDataOrderField{
+		Value: func(_m *DocumentData) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: emailtemplate.FieldUpdatedAt,
-		toTerm: emailtemplate.ByUpdatedAt,
-		toCursor: func(_m *EmailTemplate) Cursor {
+		column: documentdata.FieldUpdatedAt,
+		toTerm: documentdata.ByUpdatedAt,
+		toCursor: func(_m *DocumentData) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// EmailTemplateOrderFieldRevision orders EmailTemplate by revision.
-	EmailTemplateOrderFieldRevision = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.Revision, nil
-		},
-		column: emailtemplate.FieldRevision,
-		toTerm: emailtemplate.ByRevision,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Revision,
-			}
-		},
+)
+
+// String implement fmt.Stringer interface.
+func (f DocumentDataOrderField) String() string {
+	var str string
+	switch f.column {
+	case DocumentDataOrderFieldCreatedAt.column:
+		str = "created_at"
+	case DocumentDataOrderFieldUpdatedAt.column:
+		str = "updated_at"
 	}
-	// EmailTemplateOrderFieldKey orders EmailTemplate by key.
-	EmailTemplateOrderFieldKey = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.Key, nil
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f DocumentDataOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *DocumentDataOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("DocumentDataOrderField %T must be a string", v)
+	}
+	switch str {
+	case "created_at":
+		*f = *DocumentDataOrderFieldCreatedAt
+	case "updated_at":
+		*f = *DocumentDataOrderFieldUpdatedAt
+	default:
+		return fmt.Errorf("%s is not a valid DocumentDataOrderField", str)
+	}
+	return nil
+}
+
+// DocumentDataOrderField defines the ordering field of DocumentData.
+type DocumentDataOrderField struct {
+	// Value extracts the ordering value from the given DocumentData.
+	Value    func(*DocumentData) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) documentdata.OrderOption
+	toCursor func(*DocumentData) Cursor
+}
+
+// DocumentDataOrder defines the ordering of DocumentData.
+type DocumentDataOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *DocumentDataOrderField `json:"field"`
+}
+
+// DefaultDocumentDataOrder is the default ordering of DocumentData.
+var DefaultDocumentDataOrder = &DocumentDataOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &DocumentDataOrderField{
+		Value: func(_m *DocumentData) (ent.Value, error) {
+			return _m.ID, nil
 		},
-		column: emailtemplate.FieldKey,
-		toTerm: emailtemplate.ByKey,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Key,
-			}
+		column: documentdata.FieldID,
+		toTerm: documentdata.ByID,
+		toCursor: func(_m *DocumentData) Cursor {
+			return Cursor{ID: _m.ID}
 		},
+	},
+}
+
+// ToEdge converts DocumentData into DocumentDataEdge.
+func (_m *DocumentData) ToEdge(order *DocumentDataOrder) *DocumentDataEdge {
+	if order == nil {
+		order = DefaultDocumentDataOrder
 	}
-	// EmailTemplateOrderFieldName orders EmailTemplate by name.
-	EmailTemplateOrderFieldName = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.Name, nil
-		},
-		column: emailtemplate.FieldName,
-		toTerm: emailtemplate.ByName,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Name,
-			}
-		},
-	}
-	// EmailTemplateOrderFieldFormat orders EmailTemplate by format.
-	EmailTemplateOrderFieldFormat = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.Format, nil
-		},
-		column: emailtemplate.FieldFormat,
-		toTerm: emailtemplate.ByFormat,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Format,
-			}
-		},
-	}
-	// EmailTemplateOrderFieldLocale orders EmailTemplate by locale.
-	EmailTemplateOrderFieldLocale = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.Locale, nil
-		},
-		column: emailtemplate.FieldLocale,
-		toTerm: emailtemplate.ByLocale,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Locale,
-			}
-		},
-	}
-	// EmailTemplateOrderFieldActive orders EmailTemplate by active.
-	EmailTemplateOrderFieldActive = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.Active, nil
-		},
-		column: emailtemplate.FieldActive,
-		toTerm: emailtemplate.ByActive,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Active,
-			}
-		},
-	}
-	// EmailTemplateOrderFieldVersion orders EmailTemplate by version.
-	EmailTemplateOrderFieldVersion = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.Version, nil
-		},
-		column: emailtemplate.FieldVersion,
-		toTerm: emailtemplate.ByVersion,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Version,
-			}
-		},
-	}
-	// EmailTemplateOrderFieldTemplateContext orders EmailTemplate by template_context.
-	EmailTemplateOrderFieldTemplateContext = &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.TemplateContext, nil
-		},
-		column: emailtemplate.FieldTemplateContext,
-		toTerm: emailtemplate.ByTemplateContext,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.TemplateContext,
-			}
-		},
-	}
-)
-
-// String implement fmt.Stringer interface.
-func (f EmailTemplateOrderField) String() string {
-	var str string
-	switch f.column {
-	case EmailTemplateOrderFieldCreatedAt.column:
-		str = "created_at"
-	case EmailTemplateOrderFieldUpdatedAt.column:
-		str = "updated_at"
-	case EmailTemplateOrderFieldRevision.column:
-		str = "revision"
-	case EmailTemplateOrderFieldKey.column:
-		str = "KEY"
-	case EmailTemplateOrderFieldName.column:
-		str = "NAME"
-	case EmailTemplateOrderFieldFormat.column:
-		str = "FORMAT"
-	case EmailTemplateOrderFieldLocale.column:
-		str = "LOCALE"
-	case EmailTemplateOrderFieldActive.column:
-		str = "ACTIVE"
-	case EmailTemplateOrderFieldVersion.column:
-		str = "VERSION"
-	case EmailTemplateOrderFieldTemplateContext.column:
-		str = "TEMPLATE_CONTEXT"
-	}
-	return str
-}
-
-// MarshalGQL implements graphql.Marshaler interface.
-func (f EmailTemplateOrderField) MarshalGQL(w io.Writer) {
-	io.WriteString(w, strconv.Quote(f.String()))
-}
-
-// UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *EmailTemplateOrderField) UnmarshalGQL(v interface{}) error {
-	str, ok := v.(string)
-	if !ok {
-		return fmt.Errorf("EmailTemplateOrderField %T must be a string", v)
-	}
-	switch str {
-	case "created_at":
-		*f = *EmailTemplateOrderFieldCreatedAt
-	case "updated_at":
-		*f = *EmailTemplateOrderFieldUpdatedAt
-	case "revision":
-		*f = *EmailTemplateOrderFieldRevision
-	case "KEY":
-		*f = *EmailTemplateOrderFieldKey
-	case "NAME":
-		*f = *EmailTemplateOrderFieldName
-	case "FORMAT":
-		*f = *EmailTemplateOrderFieldFormat
-	case "LOCALE":
-		*f = *EmailTemplateOrderFieldLocale
-	case "ACTIVE":
-		*f = *EmailTemplateOrderFieldActive
-	case "VERSION":
-		*f = *EmailTemplateOrderFieldVersion
-	case "TEMPLATE_CONTEXT":
-		*f = *EmailTemplateOrderFieldTemplateContext
-	default:
-		return fmt.Errorf("%s is not a valid EmailTemplateOrderField", str)
-	}
-	return nil
-}
-
-// EmailTemplateOrderField defines the ordering field of EmailTemplate.
-type EmailTemplateOrderField struct {
-	// Value extracts the ordering value from the given EmailTemplate.
-	Value    func(*EmailTemplate) (ent.Value, error)
-	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) emailtemplate.OrderOption
-	toCursor func(*EmailTemplate) Cursor
-}
-
-// EmailTemplateOrder defines the ordering of EmailTemplate.
-type EmailTemplateOrder struct {
-	Direction OrderDirection           `json:"direction"`
-	Field     *EmailTemplateOrderField `json:"field"`
-}
-
-// DefaultEmailTemplateOrder is the default ordering of EmailTemplate.
-var DefaultEmailTemplateOrder = &EmailTemplateOrder{
-	Direction: entgql.OrderDirectionAsc,
-	Field: &EmailTemplateOrderField{
-		Value: func(_m *EmailTemplate) (ent.Value, error) {
-			return _m.ID, nil
-		},
-		column: emailtemplate.FieldID,
-		toTerm: emailtemplate.ByID,
-		toCursor: func(_m *EmailTemplate) Cursor {
-			return Cursor{ID: _m.ID}
-		},
-	},
-}
-
-// ToEdge converts EmailTemplate into EmailTemplateEdge.
-func (_m *EmailTemplate) ToEdge(order *EmailTemplateOrder) *EmailTemplateEdge {
-	if order == nil {
-		order = DefaultEmailTemplateOrder
-	}
-	return &EmailTemplateEdge{
+	return &DocumentDataEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// EntityEdge is the edge representation of Entity.
-type EntityEdge struct {
-	Node   *Entity `json:"node"`
-	Cursor Cursor  `json:"cursor"`
+// EmailTemplateEdge is the edge representation of EmailTemplate.
+type EmailTemplateEdge struct {
+	Node   *EmailTemplate `json:"node"`
+	Cursor Cursor         `json:"cursor"`
 }
 
-// EntityConnection is the connection containing edges to Entity.
-type EntityConnection struct {
-	Edges      []*EntityEdge `json:"edges"`
-	PageInfo   PageInfo      `json:"pageInfo"`
-	TotalCount int           `json:"totalCount"`
+// EmailTemplateConnection is the connection containing edges to EmailTemplate.
+type EmailTemplateConnection struct {
+	Edges      []*EmailTemplateEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
 }
 
-func (c *EntityConnection) build(nodes []*Entity, pager *entityPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *EmailTemplateConnection) build(nodes []*EmailTemplate, pager *emailtemplatePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -10623,21 +10499,21 @@ func (c *EntityConnection) build(nodes []*Entity, pager *entityPager, after *Cur
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *Entity
+	var nodeAt func(int) *EmailTemplate
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Entity {
+		nodeAt = func(i int) *EmailTemplate {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Entity {
+		nodeAt = func(i int) *EmailTemplate {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*EntityEdge, len(nodes))
+	c.Edges = make([]*EmailTemplateEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &EntityEdge{
+		c.Edges[i] = &EmailTemplateEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -10651,12 +10527,12 @@ func (c *EntityConnection) build(nodes []*Entity, pager *entityPager, after *Cur
 	}
 }
 
-// EntityPaginateOption enables pagination customization.
-type EntityPaginateOption func(*entityPager) error
+// EmailTemplatePaginateOption enables pagination customization.
+type EmailTemplatePaginateOption func(*emailtemplatePager) error
 
-// WithEntityOrder configures pagination ordering.
-func WithEntityOrder(order []*EntityOrder) EntityPaginateOption {
-	return func(pager *entityPager) error {
+// WithEmailTemplateOrder configures pagination ordering.
+func WithEmailTemplateOrder(order []*EmailTemplateOrder) EmailTemplatePaginateOption {
+	return func(pager *emailtemplatePager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -10667,25 +10543,25 @@ func WithEntityOrder(order []*EntityOrder) EntityPaginateOption {
 	}
 }
 
-// WithEntityFilter configures pagination filter.
-func WithEntityFilter(filter func(*EntityQuery) (*EntityQuery, error)) EntityPaginateOption {
-	return func(pager *entityPager) error {
+// WithEmailTemplateFilter configures pagination filter.
+func WithEmailTemplateFilter(filter func(*EmailTemplateQuery) (*EmailTemplateQuery, error)) EmailTemplatePaginateOption {
+	return func(pager *emailtemplatePager) error {
 		if filter == nil {
-			return errors.New("EntityQuery filter cannot be nil")
+			return errors.New("EmailTemplateQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type entityPager struct {
+type emailtemplatePager struct {
 	reverse bool
-	order   []*EntityOrder
-	filter  func(*EntityQuery) (*EntityQuery, error)
+	order   []*EmailTemplateOrder
+	filter  func(*EmailTemplateQuery) (*EmailTemplateQuery, error)
 }
 
-func newEntityPager(opts []EntityPaginateOption, reverse bool) (*entityPager, error) {
-	pager := &entityPager{reverse: reverse}
+func newEmailTemplatePager(opts []EmailTemplatePaginateOption, reverse bool) (*emailtemplatePager, error) {
+	pager := &emailtemplatePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -10699,14 +10575,14 @@ func newEntityPager(opts []EntityPaginateOption, reverse bool) (*entityPager, er
 	return pager, nil
 }
 
-func (p *entityPager) applyFilter(query *EntityQuery) (*EntityQuery, error) {
+func (p *emailtemplatePager) applyFilter(query *EmailTemplateQuery) (*EmailTemplateQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *entityPager) toCursor(_m *Entity) Cursor {
+func (p *emailtemplatePager) toCursor(_m *EmailTemplate) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -10714,7 +10590,7 @@ func (p *entityPager) toCursor(_m *Entity) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *entityPager) applyCursors(query *EntityQuery, after, before *Cursor) (*EntityQuery, error) {
+func (p *emailtemplatePager) applyCursors(query *EmailTemplateQuery, after, before *Cursor) (*EmailTemplateQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -10729,7 +10605,7 @@ func (p *entityPager) applyCursors(query *EntityQuery, after, before *Cursor) (*
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultEntityOrder.Field.column,
+		FieldID:     DefaultEmailTemplateOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -10748,7 +10624,7 @@ func (p *entityPager) applyCursors(query *EntityQuery, after, before *Cursor) (*
 	return query, nil
 }
 
-func (p *entityPager) applyOrder(query *EntityQuery) *EntityQuery {
+func (p *emailtemplatePager) applyOrder(query *EmailTemplateQuery) *EmailTemplateQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -10756,7 +10632,7 @@ func (p *entityPager) applyOrder(query *EntityQuery) *EntityQuery {
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultEntityOrder.Field.column {
+		if o.Field.column == DefaultEmailTemplateOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -10768,12 +10644,12 @@ func (p *entityPager) applyOrder(query *EntityQuery) *EntityQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultEntityOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultEmailTemplateOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *entityPager) orderExpr(query *EntityQuery) sql.Querier {
+func (p *emailtemplatePager) orderExpr(query *EmailTemplateQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -10792,26 +10668,26 @@ func (p *entityPager) orderExpr(query *EntityQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultEntityOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultEmailTemplateOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Entity.
-func (_m *EntityQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to EmailTemplate.
+func (_m *EmailTemplateQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...EntityPaginateOption,
-) (*EntityConnection, error) {
+	before *Cursor, last *int, opts ...EmailTemplatePaginateOption,
+) (*EmailTemplateConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newEntityPager(opts, last != nil)
+	pager, err := newEmailTemplatePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &EntityConnection{Edges: []*EntityEdge{}}
+	conn := &EmailTemplateConnection{Edges: []*EmailTemplateEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -10831,7 +10707,7 @@ func (_m *EntityQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimitSingle(first, last)
+	limit := paginateLimit(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -10850,234 +10726,733 @@ func (_m *EntityQuery) Paginate(
 }
 
 var (
-	// EntityOrderFieldCreatedAt orders Entity by created_at.
-	EntityOrderFieldCreatedAt = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
+	// EmailTemplateOrderFieldCreatedAt orders EmailTemplate by created_at.
+	EmailTemplateOrderFieldCreatedAt = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: entity.FieldCreatedAt,
-		toTerm: entity.ByCreatedAt,
-		toCursor: func(_m *Entity) Cursor {
+		column: emailtemplate.FieldCreatedAt,
+		toTerm: emailtemplate.ByCreatedAt,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// EntityOrderFieldUpdatedAt orders Entity by updated_at.
-	EntityOrderFieldUpdatedAt = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
+	// EmailTemplateOrderFieldUpdatedAt orders EmailTemplate by updated_at.
+	EmailTemplateOrderFieldUpdatedAt = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: entity.FieldUpdatedAt,
-		toTerm: entity.ByUpdatedAt,
-		toCursor: func(_m *Entity) Cursor {
+		column: emailtemplate.FieldUpdatedAt,
+		toTerm: emailtemplate.ByUpdatedAt,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// EntityOrderFieldInternalOwner orders Entity by internal_owner.
-	EntityOrderFieldInternalOwner = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			return _m.InternalOwner, nil
-		},
-		column: entity.FieldInternalOwner,
-		toTerm: entity.ByInternalOwner,
-		toCursor: func(_m *Entity) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.InternalOwner,
-			}
-		},
-	}
-	// EntityOrderFieldReviewedBy orders Entity by reviewed_by.
-	EntityOrderFieldReviewedBy = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			return _m.ReviewedBy, nil
+	// EmailTemplateOrderFieldRevision orders EmailTemplate by revision.
+	EmailTemplateOrderFieldRevision = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.Revision, nil
 		},
-		column: entity.FieldReviewedBy,
-		toTerm: entity.ByReviewedBy,
-		toCursor: func(_m *Entity) Cursor {
+		column: emailtemplate.FieldRevision,
+		toTerm: emailtemplate.ByRevision,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ReviewedBy,
+				Value: _m.Revision,
 			}
 		},
 	}
-	// EntityOrderFieldLastReviewedAt orders Entity by last_reviewed_at.
-	EntityOrderFieldLastReviewedAt = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.LastReviewedAt == nil {
-				return nil, nil
-			}
-			return _m.LastReviewedAt, nil
-		},
-		column: entity.FieldLastReviewedAt,
-		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return entity.ByLastReviewedAt(opts...)
+	// EmailTemplateOrderFieldKey orders EmailTemplate by key.
+	EmailTemplateOrderFieldKey = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.Key, nil
 		},
-		toCursor: func(_m *Entity) Cursor {
-			if _m.LastReviewedAt == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
+		column: emailtemplate.FieldKey,
+		toTerm: emailtemplate.ByKey,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.LastReviewedAt,
+				Value: _m.Key,
 			}
 		},
 	}
-	// EntityOrderFieldName orders Entity by name.
-	EntityOrderFieldName = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
+	// EmailTemplateOrderFieldName orders EmailTemplate by name.
+	EmailTemplateOrderFieldName = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
 			return _m.Name, nil
 		},
-		column: entity.FieldName,
-		toTerm: entity.ByName,
-		toCursor: func(_m *Entity) Cursor {
+		column: emailtemplate.FieldName,
+		toTerm: emailtemplate.ByName,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.Name,
 			}
 		},
 	}
-	// EntityOrderFieldDisplayName orders Entity by display_name.
-	EntityOrderFieldDisplayName = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			return _m.DisplayName, nil
-		},
-		column: entity.FieldDisplayName,
-		toTerm: entity.ByDisplayName,
-		toCursor: func(_m *Entity) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.DisplayName,
-			}
-		},
-	}
-	// EntityOrderFieldStatus orders Entity by status.
-	EntityOrderFieldStatus = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			return _m.Status, nil
+	// EmailTemplateOrderFieldFormat orders EmailTemplate by format.
+	EmailTemplateOrderFieldFormat = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.Format, nil
 		},
-		column: entity.FieldStatus,
-		toTerm: entity.ByStatus,
-		toCursor: func(_m *Entity) Cursor {
+		column: emailtemplate.FieldFormat,
+		toTerm: emailtemplate.ByFormat,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Status,
+				Value: _m.Format,
 			}
 		},
 	}
-	// EntityOrderFieldApprovedForUse orders Entity by approved_for_use.
-	EntityOrderFieldApprovedForUse = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			return _m.ApprovedForUse, nil
+	// EmailTemplateOrderFieldLocale orders EmailTemplate by locale.
+	EmailTemplateOrderFieldLocale = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.Locale, nil
 		},
-		column: entity.FieldApprovedForUse,
-		toTerm: entity.ByApprovedForUse,
-		toCursor: func(_m *Entity) Cursor {
+		column: emailtemplate.FieldLocale,
+		toTerm: emailtemplate.ByLocale,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ApprovedForUse,
+				Value: _m.Locale,
 			}
 		},
 	}
-	// EntityOrderFieldHasSoc2 orders Entity by has_soc2.
-	EntityOrderFieldHasSoc2 = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			return _m.HasSoc2, nil
+	// EmailTemplateOrderFieldActive orders EmailTemplate by active.
+	EmailTemplateOrderFieldActive = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.Active, nil
 		},
-		column: entity.FieldHasSoc2,
-		toTerm: entity.ByHasSoc2,
-		toCursor: func(_m *Entity) Cursor {
+		column: emailtemplate.FieldActive,
+		toTerm: emailtemplate.ByActive,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.HasSoc2,
+				Value: _m.Active,
 			}
 		},
 	}
-	// EntityOrderFieldSoc2PeriodEnd orders Entity by soc2_period_end.
-	EntityOrderFieldSoc2PeriodEnd = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.Soc2PeriodEnd == nil {
-				return nil, nil
-			}
-			return _m.Soc2PeriodEnd, nil
-		},
-		column: entity.FieldSoc2PeriodEnd,
-		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return entity.BySoc2PeriodEnd(opts...)
+	// EmailTemplateOrderFieldVersion orders EmailTemplate by version.
+	EmailTemplateOrderFieldVersion = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.Version, nil
 		},
-		toCursor: func(_m *Entity) Cursor {
-			if _m.Soc2PeriodEnd == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
+		column: emailtemplate.FieldVersion,
+		toTerm: emailtemplate.ByVersion,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Soc2PeriodEnd,
+				Value: _m.Version,
 			}
 		},
 	}
-	// EntityOrderFieldContractStartDate orders Entity by contract_start_date.
-	EntityOrderFieldContractStartDate = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.ContractStartDate == nil {
-				return nil, nil
-			}
-			return _m.ContractStartDate, nil
-		},
-		column: entity.FieldContractStartDate,
-		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return entity.ByContractStartDate(opts...)
+	// EmailTemplateOrderFieldTemplateContext orders EmailTemplate by template_context.
+	EmailTemplateOrderFieldTemplateContext = &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.TemplateContext, nil
 		},
-		toCursor: func(_m *Entity) Cursor {
-			if _m.ContractStartDate == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
+		column: emailtemplate.FieldTemplateContext,
+		toTerm: emailtemplate.ByTemplateContext,
+		toCursor: func(_m *EmailTemplate) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ContractStartDate,
+				Value: _m.TemplateContext,
 			}
 		},
 	}
-	// EntityOrderFieldContractEndDate orders Entity by contract_end_date.
-	EntityOrderFieldContractEndDate = &EntityOrderField{
-		Value: func(_m *Entity) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.ContractEndDate == nil {
-				return nil, nil
-			}
-			return _m.ContractEndDate, nil
-		},
-		column: entity.FieldContractEndDate,
-		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return entity.ByContractEndDate(opts...)
-		},
-		toCursor: func(_m *Entity) Cursor {
-			if _m.ContractEndDate == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
+)
+
+// String implement fmt.Stringer interface.
+func (f EmailTemplateOrderField) String() string {
+	var str string
+	switch f.column {
+	case EmailTemplateOrderFieldCreatedAt.column:
+		str = "created_at"
+	case EmailTemplateOrderFieldUpdatedAt.column:
+		str = "updated_at"
+	case EmailTemplateOrderFieldRevision.column:
+		str = "revision"
+	case EmailTemplateOrderFieldKey.column:
+		str = "KEY"
+	case EmailTemplateOrderFieldName.column:
+		str = "NAME"
+	case EmailTemplateOrderFieldFormat.column:
+		str = "FORMAT"
+	case EmailTemplateOrderFieldLocale.column:
+		str = "LOCALE"
+	case EmailTemplateOrderFieldActive.column:
+		str = "ACTIVE"
+	case EmailTemplateOrderFieldVersion.column:
+		str = "VERSION"
+	case EmailTemplateOrderFieldTemplateContext.column:
+		str = "TEMPLATE_CONTEXT"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f EmailTemplateOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *EmailTemplateOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("EmailTemplateOrderField %T must be a string", v)
+	}
+	switch str {
+	case "created_at":
+		*f = *EmailTemplateOrderFieldCreatedAt
+	case "updated_at":
+		*f = *EmailTemplateOrderFieldUpdatedAt
+	case "revision":
+		*f = *EmailTemplateOrderFieldRevision
+	case "KEY":
+		*f = *EmailTemplateOrderFieldKey
+	case "NAME":
+		*f = *EmailTemplateOrderFieldName
+	case "FORMAT":
+		*f = *EmailTemplateOrderFieldFormat
+	case "LOCALE":
+		*f = *EmailTemplateOrderFieldLocale
+	case "ACTIVE":
+		*f = *EmailTemplateOrderFieldActive
+	case "VERSION":
+		*f = *EmailTemplateOrderFieldVersion
+	case "TEMPLATE_CONTEXT":
+		*f = *EmailTemplateOrderFieldTemplateContext
+	default:
+		return fmt.Errorf("%s is not a valid EmailTemplateOrderField", str)
+	}
+	return nil
+}
+
+// EmailTemplateOrderField defines the ordering field of EmailTemplate.
+type EmailTemplateOrderField struct {
+	// Value extracts the ordering value from the given EmailTemplate.
+	Value    func(*EmailTemplate) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) emailtemplate.OrderOption
+	toCursor func(*EmailTemplate) Cursor
+}
+
+// EmailTemplateOrder defines the ordering of EmailTemplate.
+type EmailTemplateOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *EmailTemplateOrderField `json:"field"`
+}
+
+// DefaultEmailTemplateOrder is the default ordering of EmailTemplate.
+var DefaultEmailTemplateOrder = &EmailTemplateOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &EmailTemplateOrderField{
+		Value: func(_m *EmailTemplate) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: emailtemplate.FieldID,
+		toTerm: emailtemplate.ByID,
+		toCursor: func(_m *EmailTemplate) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts EmailTemplate into EmailTemplateEdge.
+func (_m *EmailTemplate) ToEdge(order *EmailTemplateOrder) *EmailTemplateEdge {
+	if order == nil {
+		order = DefaultEmailTemplateOrder
+	}
+	return &EmailTemplateEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// EntityEdge is the edge representation of Entity.
+type EntityEdge struct {
+	Node   *Entity `json:"node"`
+	Cursor Cursor  `json:"cursor"`
+}
+
+// EntityConnection is the connection containing edges to Entity.
+type EntityConnection struct {
+	Edges      []*EntityEdge `json:"edges"`
+	PageInfo   PageInfo      `json:"pageInfo"`
+	TotalCount int           `json:"totalCount"`
+}
+
+func (c *EntityConnection) build(nodes []*Entity, pager *entityPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && len(nodes) >= *first+1 {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	} else if last != nil && len(nodes) >= *last+1 {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:*last]
+	}
+	var nodeAt func(int) *Entity
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *Entity {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *Entity {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*EntityEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &EntityEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// EntityPaginateOption enables pagination customization.
+type EntityPaginateOption func(*entityPager) error
+
+// WithEntityOrder configures pagination ordering.
+func WithEntityOrder(order []*EntityOrder) EntityPaginateOption {
+	return func(pager *entityPager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+		}
+		pager.order = append(pager.order, order...)
+		return nil
+	}
+}
+
+// WithEntityFilter configures pagination filter.
+func WithEntityFilter(filter func(*EntityQuery) (*EntityQuery, error)) EntityPaginateOption {
+	return func(pager *entityPager) error {
+		if filter == nil {
+			return errors.New("EntityQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type entityPager struct {
+	reverse bool
+	order   []*EntityOrder
+	filter  func(*EntityQuery) (*EntityQuery, error)
+}
+
+func newEntityPager(opts []EntityPaginateOption, reverse bool) (*entityPager, error) {
+	pager := &entityPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
+	}
+	return pager, nil
+}
+
+func (p *entityPager) applyFilter(query *EntityQuery) (*EntityQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *entityPager) toCursor(_m *Entity) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
+	}
+	return Cursor{ID: _m.ID, Value: cs_}
+}
+
+func (p *entityPager) applyCursors(query *EntityQuery, after, before *Cursor) (*EntityQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
+	if p.reverse {
+		idDirection = entgql.OrderDirectionDesc
+	}
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultEntityOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, predicate := range predicates {
+		query = query.Where(func(s *sql.Selector) {
+			predicate(s)
+			if i < len(fields) {
+				s.Or().Where(sql.IsNull(fields[i]))
+			}
+		})
+	}
+	return query, nil
+}
+
+func (p *entityPager) applyOrder(query *EntityQuery) *EntityQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultEntityOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultEntityOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	return query
+}
+
+func (p *entityPager) orderExpr(query *EntityQuery) sql.Querier {
+	if len(query.ctx.Fields) > 0 {
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		b.Ident(DefaultEntityOrder.Field.column).Pad().WriteString(string(direction))
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Entity.
+func (_m *EntityQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...EntityPaginateOption,
+) (*EntityConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newEntityPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &EntityConnection{Edges: []*EntityEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.CountIDs(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimitSingle(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// EntityOrderFieldCreatedAt orders Entity by created_at.
+	EntityOrderFieldCreatedAt = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: entity.FieldCreatedAt,
+		toTerm: entity.ByCreatedAt,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// EntityOrderFieldUpdatedAt orders Entity by updated_at.
+	EntityOrderFieldUpdatedAt = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: entity.FieldUpdatedAt,
+		toTerm: entity.ByUpdatedAt,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+	// EntityOrderFieldInternalOwner orders Entity by internal_owner.
+	EntityOrderFieldInternalOwner = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.InternalOwner, nil
+		},
+		column: entity.FieldInternalOwner,
+		toTerm: entity.ByInternalOwner,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.InternalOwner,
+			}
+		},
+	}
+	// EntityOrderFieldReviewedBy orders Entity by reviewed_by.
+	EntityOrderFieldReviewedBy = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.ReviewedBy, nil
+		},
+		column: entity.FieldReviewedBy,
+		toTerm: entity.ByReviewedBy,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ReviewedBy,
+			}
+		},
+	}
+	// EntityOrderFieldLastReviewedAt orders Entity by last_reviewed_at.
+	EntityOrderFieldLastReviewedAt = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.LastReviewedAt == nil {
+				return nil, nil
+			}
+			return _m.LastReviewedAt, nil
+		},
+		column: entity.FieldLastReviewedAt,
+		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return entity.ByLastReviewedAt(opts...)
+		},
+		toCursor: func(_m *Entity) Cursor {
+			if _m.LastReviewedAt == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.LastReviewedAt,
+			}
+		},
+	}
+	// EntityOrderFieldName orders Entity by name.
+	EntityOrderFieldName = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: entity.FieldName,
+		toTerm: entity.ByName,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
+	// EntityOrderFieldDisplayName orders Entity by display_name.
+	EntityOrderFieldDisplayName = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.DisplayName, nil
+		},
+		column: entity.FieldDisplayName,
+		toTerm: entity.ByDisplayName,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.DisplayName,
+			}
+		},
+	}
+	// EntityOrderFieldStatus orders Entity by status.
+	EntityOrderFieldStatus = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.Status, nil
+		},
+		column: entity.FieldStatus,
+		toTerm: entity.ByStatus,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Status,
+			}
+		},
+	}
+	// EntityOrderFieldApprovedForUse orders Entity by approved_for_use.
+	EntityOrderFieldApprovedForUse = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.ApprovedForUse, nil
+		},
+		column: entity.FieldApprovedForUse,
+		toTerm: entity.ByApprovedForUse,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ApprovedForUse,
+			}
+		},
+	}
+	// EntityOrderFieldHasSoc2 orders Entity by has_soc2.
+	EntityOrderFieldHasSoc2 = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			return _m.HasSoc2, nil
+		},
+		column: entity.FieldHasSoc2,
+		toTerm: entity.ByHasSoc2,
+		toCursor: func(_m *Entity) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.HasSoc2,
+			}
+		},
+	}
+	// EntityOrderFieldSoc2PeriodEnd orders Entity by soc2_period_end.
+	EntityOrderFieldSoc2PeriodEnd = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.Soc2PeriodEnd == nil {
+				return nil, nil
+			}
+			return _m.Soc2PeriodEnd, nil
+		},
+		column: entity.FieldSoc2PeriodEnd,
+		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return entity.BySoc2PeriodEnd(opts...)
+		},
+		toCursor: func(_m *Entity) Cursor {
+			if _m.Soc2PeriodEnd == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Soc2PeriodEnd,
+			}
+		},
+	}
+	// EntityOrderFieldContractStartDate orders Entity by contract_start_date.
+	EntityOrderFieldContractStartDate = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.ContractStartDate == nil {
+				return nil, nil
+			}
+			return _m.ContractStartDate, nil
+		},
+		column: entity.FieldContractStartDate,
+		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return entity.ByContractStartDate(opts...)
+		},
+		toCursor: func(_m *Entity) Cursor {
+			if _m.ContractStartDate == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ContractStartDate,
+			}
+		},
+	}
+	// EntityOrderFieldContractEndDate orders Entity by contract_end_date.
+	EntityOrderFieldContractEndDate = &EntityOrderField{
+		Value: func(_m *Entity) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.ContractEndDate == nil {
+				return nil, nil
+			}
+			return _m.ContractEndDate, nil
+		},
+		column: entity.FieldContractEndDate,
+		toTerm: func(opts ...sql.OrderTermOption) entity.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return entity.ByContractEndDate(opts...)
+		},
+		toCursor: func(_m *Entity) Cursor {
+			if _m.ContractEndDate == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.ContractEndDate,
@@ -22896,110 +23271,400 @@ func WithOnboardingOrder(order *OnboardingOrder) OnboardingPaginateOption {
 		if o.Field == nil {
 			o.Field = DefaultOnboardingOrder.Field
 		}
-		pager.order = &o
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithOnboardingFilter configures pagination filter.
+func WithOnboardingFilter(filter func(*OnboardingQuery) (*OnboardingQuery, error)) OnboardingPaginateOption {
+	return func(pager *onboardingPager) error {
+		if filter == nil {
+			return errors.New("OnboardingQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type onboardingPager struct {
+	reverse bool
+	order   *OnboardingOrder
+	filter  func(*OnboardingQuery) (*OnboardingQuery, error)
+}
+
+func newOnboardingPager(opts []OnboardingPaginateOption, reverse bool) (*onboardingPager, error) {
+	pager := &onboardingPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultOnboardingOrder
+	}
+	return pager, nil
+}
+
+func (p *onboardingPager) applyFilter(query *OnboardingQuery) (*OnboardingQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *onboardingPager) toCursor(_m *Onboarding) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *onboardingPager) applyCursors(query *OnboardingQuery, after, before *Cursor) (*OnboardingQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOnboardingOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *onboardingPager) applyOrder(query *OnboardingQuery) *OnboardingQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOnboardingOrder.Field {
+		query = query.Order(DefaultOnboardingOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *onboardingPager) orderExpr(query *OnboardingQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOnboardingOrder.Field {
+			b.Comma().Ident(DefaultOnboardingOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to Onboarding.
+func (_m *OnboardingQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...OnboardingPaginateOption,
+) (*OnboardingConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newOnboardingPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &OnboardingConnection{Edges: []*OnboardingEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.CountIDs(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+// OnboardingOrderField defines the ordering field of Onboarding.
+type OnboardingOrderField struct {
+	// Value extracts the ordering value from the given Onboarding.
+	Value    func(*Onboarding) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) onboarding.OrderOption
+	toCursor func(*Onboarding) Cursor
+}
+
+// OnboardingOrder defines the ordering of Onboarding.
+type OnboardingOrder struct {
+	Direction OrderDirection        `json:"direction"`
+	Field     *OnboardingOrderField `json:"field"`
+}
+
+// DefaultOnboardingOrder is the default ordering of Onboarding.
+var DefaultOnboardingOrder = &OnboardingOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &OnboardingOrderField{
+		Value: func(_m *Onboarding) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: onboarding.FieldID,
+		toTerm: onboarding.ByID,
+		toCursor: func(_m *Onboarding) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts Onboarding into OnboardingEdge.
+func (_m *Onboarding) ToEdge(order *OnboardingOrder) *OnboardingEdge {
+	if order == nil {
+		order = DefaultOnboardingOrder
+	}
+	return &OnboardingEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
+// OrgMembershipEdge is the edge representation of OrgMembership.
+type OrgMembershipEdge struct {
+	Node   *OrgMembership `json:"node"`
+	Cursor Cursor         `json:"cursor"`
+}
+
+// OrgMembershipConnection is the connection containing edges to OrgMembership.
+type OrgMembershipConnection struct {
+	Edges      []*OrgMembershipEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
+}
+
+func (c *OrgMembershipConnection) build(nodes []*OrgMembership, pager *orgmembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && len(nodes) >= *first+1 {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	} else if last != nil && len(nodes) >= *last+1 {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:*last]
+	}
+	var nodeAt func(int) *OrgMembership
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *OrgMembership {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *OrgMembership {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*OrgMembershipEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &OrgMembershipEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// OrgMembershipPaginateOption enables pagination customization.
+type OrgMembershipPaginateOption func(*orgmembershipPager) error
+
+// WithOrgMembershipOrder configures pagination ordering.
+func WithOrgMembershipOrder(order []*OrgMembershipOrder) OrgMembershipPaginateOption {
+	return func(pager *orgmembershipPager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
+		}
+		pager.order = append(pager.order, order...)
 		return nil
 	}
 }
 
-// WithOnboardingFilter configures pagination filter.
-func WithOnboardingFilter(filter func(*OnboardingQuery) (*OnboardingQuery, error)) OnboardingPaginateOption {
-	return func(pager *onboardingPager) error {
+// WithOrgMembershipFilter configures pagination filter.
+func WithOrgMembershipFilter(filter func(*OrgMembershipQuery) (*OrgMembershipQuery, error)) OrgMembershipPaginateOption {
+	return func(pager *orgmembershipPager) error {
 		if filter == nil {
-			return errors.New("OnboardingQuery filter cannot be nil")
+			return errors.New("OrgMembershipQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type onboardingPager struct {
+type orgmembershipPager struct {
 	reverse bool
-	order   *OnboardingOrder
-	filter  func(*OnboardingQuery) (*OnboardingQuery, error)
+	order   []*OrgMembershipOrder
+	filter  func(*OrgMembershipQuery) (*OrgMembershipQuery, error)
 }
 
-func newOnboardingPager(opts []OnboardingPaginateOption, reverse bool) (*onboardingPager, error) {
-	pager := &onboardingPager{reverse: reverse}
+func newOrgMembershipPager(opts []OrgMembershipPaginateOption, reverse bool) (*orgmembershipPager, error) {
+	pager := &orgmembershipPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
-	if pager.order == nil {
-		pager.order = DefaultOnboardingOrder
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
 	}
 	return pager, nil
 }
 
-func (p *onboardingPager) applyFilter(query *OnboardingQuery) (*OnboardingQuery, error) {
+func (p *orgmembershipPager) applyFilter(query *OrgMembershipQuery) (*OrgMembershipQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *onboardingPager) toCursor(_m *Onboarding) Cursor {
-	return p.order.Field.toCursor(_m)
+func (p *orgmembershipPager) toCursor(_m *OrgMembership) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
+	}
+	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *onboardingPager) applyCursors(query *OnboardingQuery, after, before *Cursor) (*OnboardingQuery, error) {
-	direction := p.order.Direction
+func (p *orgmembershipPager) applyCursors(query *OrgMembershipQuery, after, before *Cursor) (*OrgMembershipQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
-		direction = direction.Reverse()
+		idDirection = entgql.OrderDirectionDesc
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOnboardingOrder.Field.column, p.order.Field.column, direction) {
-		query = query.Where(predicate)
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultOrgMembershipOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, predicate := range predicates {
+		query = query.Where(func(s *sql.Selector) {
+			predicate(s)
+			if i < len(fields) {
+				s.Or().Where(sql.IsNull(fields[i]))
+			}
+		})
 	}
 	return query, nil
 }
 
-func (p *onboardingPager) applyOrder(query *OnboardingQuery) *OnboardingQuery {
-	direction := p.order.Direction
-	if p.reverse {
-		direction = direction.Reverse()
-	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultOnboardingOrder.Field {
-		query = query.Order(DefaultOnboardingOrder.Field.toTerm(direction.OrderTermOption()))
+func (p *orgmembershipPager) applyOrder(query *OrgMembershipQuery) *OrgMembershipQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultOrgMembershipOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(p.order.Field.column)
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultOrgMembershipOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *onboardingPager) orderExpr(query *OnboardingQuery) sql.Querier {
-	direction := p.order.Direction
-	if p.reverse {
-		direction = direction.Reverse()
-	}
+func (p *orgmembershipPager) orderExpr(query *OrgMembershipQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(p.order.Field.column)
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultOnboardingOrder.Field {
-			b.Comma().Ident(DefaultOnboardingOrder.Field.column).Pad().WriteString(string(direction))
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
 		}
+		b.Ident(DefaultOrgMembershipOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Onboarding.
-func (_m *OnboardingQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to OrgMembership.
+func (_m *OrgMembershipQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...OnboardingPaginateOption,
-) (*OnboardingConnection, error) {
+	before *Cursor, last *int, opts ...OrgMembershipPaginateOption,
+) (*OrgMembershipConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newOnboardingPager(opts, last != nil)
+	pager, err := newOrgMembershipPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &OnboardingConnection{Edges: []*OnboardingEdge{}}
+	conn := &OrgMembershipConnection{Edges: []*OrgMembershipEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -23037,61 +23702,144 @@ func (_m *OnboardingQuery) Paginate(
 	return conn, nil
 }
 
-// OnboardingOrderField defines the ordering field of Onboarding.
-type OnboardingOrderField struct {
-	// Value extracts the ordering value from the given Onboarding.
-	Value    func(*Onboarding) (ent.Value, error)
+var (
+	// OrgMembershipOrderFieldCreatedAt orders OrgMembership by created_at.
+	OrgMembershipOrderFieldCreatedAt = &OrgMembershipOrderField{
+		Value: func(_m *OrgMembership) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: orgmembership.FieldCreatedAt,
+		toTerm: orgmembership.ByCreatedAt,
+		toCursor: func(_m *OrgMembership) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// OrgMembershipOrderFieldUpdatedAt orders OrgMembership by updated_at.
+	OrgMembershipOrderFieldUpdatedAt = &OrgMembershipOrderField{
+		Value: func(_m *OrgMembership) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: orgmembership.FieldUpdatedAt,
+		toTerm: orgmembership.ByUpdatedAt,
+		toCursor: func(_m *OrgMembership) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+	// OrgMembershipOrderFieldRole orders OrgMembership by role.
+	OrgMembershipOrderFieldRole = &OrgMembershipOrderField{
+		Value: func(_m *OrgMembership) (ent.Value, error) {
+			return _m.Role, nil
+		},
+		column: orgmembership.FieldRole,
+		toTerm: orgmembership.ByRole,
+		toCursor: func(_m *OrgMembership) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Role,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f OrgMembershipOrderField) String() string {
+	var str string
+	switch f.column {
+	case OrgMembershipOrderFieldCreatedAt.column:
+		str = "created_at"
+	case OrgMembershipOrderFieldUpdatedAt.column:
+		str = "updated_at"
+	case OrgMembershipOrderFieldRole.column:
+		str = "ROLE"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f OrgMembershipOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *OrgMembershipOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("OrgMembershipOrderField %T must be a string", v)
+	}
+	switch str {
+	case "created_at":
+		*f = *OrgMembershipOrderFieldCreatedAt
+	case "updated_at":
+		*f = *OrgMembershipOrderFieldUpdatedAt
+	case "ROLE":
+		*f = *OrgMembershipOrderFieldRole
+	default:
+		return fmt.Errorf("%s is not a valid OrgMembershipOrderField", str)
+	}
+	return nil
+}
+
+// OrgMembershipOrderField defines the ordering field of OrgMembership.
+type OrgMembershipOrderField struct {
+	// Value extracts the ordering value from the given OrgMembership.
+	Value    func(*OrgMembership) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) onboarding.OrderOption
-	toCursor func(*Onboarding) Cursor
+	toTerm   func(...sql.OrderTermOption) orgmembership.OrderOption
+	toCursor func(*OrgMembership) Cursor
 }
 
-// OnboardingOrder defines the ordering of Onboarding.
-type OnboardingOrder struct {
-	Direction OrderDirection        `json:"direction"`
-	Field     *OnboardingOrderField `json:"field"`
+// OrgMembershipOrder defines the ordering of OrgMembership.
+type OrgMembershipOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *OrgMembershipOrderField `json:"field"`
 }
 
-// DefaultOnboardingOrder is the default ordering of Onboarding.
-var DefaultOnboardingOrder = &OnboardingOrder{
+// DefaultOrgMembershipOrder is the default ordering of OrgMembership.
+var DefaultOrgMembershipOrder = &OrgMembershipOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &OnboardingOrderField{
-		Value: func(_m *Onboarding) (ent.Value, error) {
+	Field: &OrgMembershipOrderField{
+		Value: func(_m *OrgMembership) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: onboarding.FieldID,
-		toTerm: onboarding.ByID,
-		toCursor: func(_m *Onboarding) Cursor {
+		column: orgmembership.FieldID,
+		toTerm: orgmembership.ByID,
+		toCursor: func(_m *OrgMembership) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Onboarding into OnboardingEdge.
-func (_m *Onboarding) ToEdge(order *OnboardingOrder) *OnboardingEdge {
+// ToEdge converts OrgMembership into OrgMembershipEdge.
+func (_m *OrgMembership) ToEdge(order *OrgMembershipOrder) *OrgMembershipEdge {
 	if order == nil {
-		order = DefaultOnboardingOrder
+		order = DefaultOrgMembershipOrder
 	}
-	return &OnboardingEdge{
+	return &OrgMembershipEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// OrgMembershipEdge is the edge representation of OrgMembership.
-type OrgMembershipEdge struct {
-	Node   *OrgMembership `json:"node"`
-	Cursor Cursor         `json:"cursor"`
+// OrgSubscriptionEdge is the edge representation of OrgSubscription.
+type OrgSubscriptionEdge struct {
+	Node   *OrgSubscription `json:"node"`
+	Cursor Cursor           `json:"cursor"`
 }
 
-// OrgMembershipConnection is the connection containing edges to OrgMembership.
-type OrgMembershipConnection struct {
-	Edges      []*OrgMembershipEdge `json:"edges"`
-	PageInfo   PageInfo             `json:"pageInfo"`
-	TotalCount int                  `json:"totalCount"`
+// OrgSubscriptionConnection is the connection containing edges to OrgSubscription.
+type OrgSubscriptionConnection struct {
+	Edges      []*OrgSubscriptionEdge `json:"edges"`
+	PageInfo   PageInfo               `json:"pageInfo"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-func (c *OrgMembershipConnection) build(nodes []*OrgMembership, pager *orgmembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *OrgSubscriptionConnection) build(nodes []*OrgSubscription, pager *orgsubscriptionPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -23101,21 +23849,21 @@ func (c *OrgMembershipConnection) build(nodes []*OrgMembership, pager *orgmember
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *OrgMembership
+	var nodeAt func(int) *OrgSubscription
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *OrgMembership {
+		nodeAt = func(i int) *OrgSubscription {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *OrgMembership {
+		nodeAt = func(i int) *OrgSubscription {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*OrgMembershipEdge, len(nodes))
+	c.Edges = make([]*OrgSubscriptionEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &OrgMembershipEdge{
+		c.Edges[i] = &OrgSubscriptionEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -23129,167 +23877,126 @@ func (c *OrgMembershipConnection) build(nodes []*OrgMembership, pager *orgmember
 	}
 }
 
-// OrgMembershipPaginateOption enables pagination customization.
-type OrgMembershipPaginateOption func(*orgmembershipPager) error
+// OrgSubscriptionPaginateOption enables pagination customization.
+type OrgSubscriptionPaginateOption func(*orgsubscriptionPager) error
 
-// WithOrgMembershipOrder configures pagination ordering.
-func WithOrgMembershipOrder(order []*OrgMembershipOrder) OrgMembershipPaginateOption {
-	return func(pager *orgmembershipPager) error {
-		for _, o := range order {
-			if err := o.Direction.Validate(); err != nil {
-				return err
-			}
+// WithOrgSubscriptionOrder configures pagination ordering.
+func WithOrgSubscriptionOrder(order *OrgSubscriptionOrder) OrgSubscriptionPaginateOption {
+	if order == nil {
+		order = DefaultOrgSubscriptionOrder
+	}
+	o := *order
+	return func(pager *orgsubscriptionPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
 		}
-		pager.order = append(pager.order, order...)
+		if o.Field == nil {
+			o.Field = DefaultOrgSubscriptionOrder.Field
+		}
+		pager.order = &o
 		return nil
 	}
 }
 
-// WithOrgMembershipFilter configures pagination filter.
-func WithOrgMembershipFilter(filter func(*OrgMembershipQuery) (*OrgMembershipQuery, error)) OrgMembershipPaginateOption {
-	return func(pager *orgmembershipPager) error {
+// WithOrgSubscriptionFilter configures pagination filter.
+func WithOrgSubscriptionFilter(filter func(*OrgSubscriptionQuery) (*OrgSubscriptionQuery, error)) OrgSubscriptionPaginateOption {
+	return func(pager *orgsubscriptionPager) error {
 		if filter == nil {
-			return errors.New("OrgMembershipQuery filter cannot be nil")
+			return errors.New("OrgSubscriptionQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type orgmembershipPager struct {
+type orgsubscriptionPager struct {
 	reverse bool
-	order   []*OrgMembershipOrder
-	filter  func(*OrgMembershipQuery) (*OrgMembershipQuery, error)
+	order   *OrgSubscriptionOrder
+	filter  func(*OrgSubscriptionQuery) (*OrgSubscriptionQuery, error)
 }
 
-func newOrgMembershipPager(opts []OrgMembershipPaginateOption, reverse bool) (*orgmembershipPager, error) {
-	pager := &orgmembershipPager{reverse: reverse}
+func newOrgSubscriptionPager(opts []OrgSubscriptionPaginateOption, reverse bool) (*orgsubscriptionPager, error) {
+	pager := &orgsubscriptionPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
-	for i, o := range pager.order {
-		if i > 0 && o.Field == pager.order[i-1].Field {
-			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
-		}
+	if pager.order == nil {
+		pager.order = DefaultOrgSubscriptionOrder
 	}
 	return pager, nil
 }
 
-func (p *orgmembershipPager) applyFilter(query *OrgMembershipQuery) (*OrgMembershipQuery, error) {
+func (p *orgsubscriptionPager) applyFilter(query *OrgSubscriptionQuery) (*OrgSubscriptionQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *orgmembershipPager) toCursor(_m *OrgMembership) Cursor {
-	cs_ := make([]any, 0, len(p.order))
-	for _, o_ := range p.order {
-		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
-	}
-	return Cursor{ID: _m.ID, Value: cs_}
+func (p *orgsubscriptionPager) toCursor(_m *OrgSubscription) Cursor {
+	return p.order.Field.toCursor(_m)
 }
 
-func (p *orgmembershipPager) applyCursors(query *OrgMembershipQuery, after, before *Cursor) (*OrgMembershipQuery, error) {
-	idDirection := entgql.OrderDirectionAsc
+func (p *orgsubscriptionPager) applyCursors(query *OrgSubscriptionQuery, after, before *Cursor) (*OrgSubscriptionQuery, error) {
+	direction := p.order.Direction
 	if p.reverse {
-		idDirection = entgql.OrderDirectionDesc
-	}
-	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
-	for _, o := range p.order {
-		fields = append(fields, o.Field.column)
-		direction := o.Direction
-		if p.reverse {
-			direction = direction.Reverse()
-		}
-		directions = append(directions, direction)
-	}
-	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultOrgMembershipOrder.Field.column,
-		DirectionID: idDirection,
-		Fields:      fields,
-		Directions:  directions,
-	})
-	if err != nil {
-		return nil, err
+		direction = direction.Reverse()
 	}
-	for i, predicate := range predicates {
-		query = query.Where(func(s *sql.Selector) {
-			predicate(s)
-			if i < len(fields) {
-				s.Or().Where(sql.IsNull(fields[i]))
-			}
-		})
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOrgSubscriptionOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
 	}
 	return query, nil
 }
 
-func (p *orgmembershipPager) applyOrder(query *OrgMembershipQuery) *OrgMembershipQuery {
-	var defaultOrdered bool
-	for _, o := range p.order {
-		direction := o.Direction
-		if p.reverse {
-			direction = direction.Reverse()
-		}
-		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultOrgMembershipOrder.Field.column {
-			defaultOrdered = true
-		}
-		if len(query.ctx.Fields) > 0 {
-			query.ctx.AppendFieldOnce(o.Field.column)
-		}
+func (p *orgsubscriptionPager) applyOrder(query *OrgSubscriptionQuery) *OrgSubscriptionQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
 	}
-	if !defaultOrdered {
-		direction := entgql.OrderDirectionAsc
-		if p.reverse {
-			direction = direction.Reverse()
-		}
-		query = query.Order(DefaultOrgMembershipOrder.Field.toTerm(direction.OrderTermOption()))
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultOrgSubscriptionOrder.Field {
+		query = query.Order(DefaultOrgSubscriptionOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return query
 }
 
-func (p *orgmembershipPager) orderExpr(query *OrgMembershipQuery) sql.Querier {
+func (p *orgsubscriptionPager) orderExpr(query *OrgSubscriptionQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
 	if len(query.ctx.Fields) > 0 {
-		for _, o := range p.order {
-			query.ctx.AppendFieldOnce(o.Field.column)
-		}
+		query.ctx.AppendFieldOnce(p.order.Field.column)
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		for _, o := range p.order {
-			direction := o.Direction
-			if p.reverse {
-				direction = direction.Reverse()
-			}
-			b.Ident(o.Field.column).Pad().WriteString(string(direction))
-			b.Comma()
-		}
-		direction := entgql.OrderDirectionAsc
-		if p.reverse {
-			direction = direction.Reverse()
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultOrgSubscriptionOrder.Field {
+			b.Comma().Ident(DefaultOrgSubscriptionOrder.Field.column).Pad().WriteString(string(direction))
 		}
-		b.Ident(DefaultOrgMembershipOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to OrgMembership.
-func (_m *OrgMembershipQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to OrgSubscription.
+func (_m *OrgSubscriptionQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...OrgMembershipPaginateOption,
-) (*OrgMembershipConnection, error) {
+	before *Cursor, last *int, opts ...OrgSubscriptionPaginateOption,
+) (*OrgSubscriptionConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newOrgMembershipPager(opts, last != nil)
+	pager, err := newOrgSubscriptionPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &OrgMembershipConnection{Edges: []*OrgMembershipEdge{}}
+	conn := &OrgSubscriptionConnection{Edges: []*OrgSubscriptionEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -23309,7 +24016,7 @@ func (_m *OrgMembershipQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimit(first, last)
+	limit := paginateLimitSingle(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -23323,148 +24030,259 @@ func (_m *OrgMembershipQuery) Paginate(
 	if err != nil {
 		return nil, err
 	}
-	conn.build(nodes, pager, after, first, before, last)
-	return conn, nil
-}
-
-var (
-	// OrgMembershipOrderFieldCreatedAt orders OrgMembership by created_at.
-	OrgMembershipOrderFieldCreatedAt = &OrgMembershipOrderField{
-		Value: func(_m *OrgMembership) (ent.Value, error) {
-			return _m.CreatedAt, nil
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// OrgSubscriptionOrderFieldCreatedAt orders OrgSubscription by created_at.
+	OrgSubscriptionOrderFieldCreatedAt = &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: orgsubscription.FieldCreatedAt,
+		toTerm: orgsubscription.ByCreatedAt,
+		toCursor: func(_m *OrgSubscription) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// OrgSubscriptionOrderFieldUpdatedAt orders OrgSubscription by updated_at.
+	OrgSubscriptionOrderFieldUpdatedAt = &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: orgsubscription.FieldUpdatedAt,
+		toTerm: orgsubscription.ByUpdatedAt,
+		toCursor: func(_m *OrgSubscription) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+	// OrgSubscriptionOrderFieldStripeSubscriptionStatus orders OrgSubscription by stripe_subscription_status.
+	OrgSubscriptionOrderFieldStripeSubscriptionStatus = &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
+			return _m.StripeSubscriptionStatus, nil
+		},
+		column: orgsubscription.FieldStripeSubscriptionStatus,
+		toTerm: orgsubscription.ByStripeSubscriptionStatus,
+		toCursor: func(_m *OrgSubscription) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.StripeSubscriptionStatus,
+			}
+		},
+	}
+	// OrgSubscriptionOrderFieldActive orders OrgSubscription by active.
+	OrgSubscriptionOrderFieldActive = &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
+			return _m.Active, nil
+		},
+		column: orgsubscription.FieldActive,
+		toTerm: orgsubscription.ByActive,
+		toCursor: func(_m *OrgSubscription) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Active,
+			}
+		},
+	}
+	// OrgSubscriptionOrderFieldExpiresAt orders OrgSubscription by expires_at.
+	OrgSubscriptionOrderFieldExpiresAt = &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.ExpiresAt == nil {
+				return nil, nil
+			}
+			return _m.ExpiresAt, nil
 		},
-		column: orgmembership.FieldCreatedAt,
-		toTerm: orgmembership.ByCreatedAt,
-		toCursor: func(_m *OrgMembership) Cursor {
+		column: orgsubscription.FieldExpiresAt,
+		toTerm: func(opts ...sql.OrderTermOption) orgsubscription.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return orgsubscription.ByExpiresAt(opts...)
+		},
+		toCursor: func(_m *OrgSubscription) Cursor {
+			if _m.ExpiresAt == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.CreatedAt,
+				Value: _m.ExpiresAt,
 			}
 		},
 	}
-	// OrgMembershipOrderFieldUpdatedAt orders OrgMembership by updated_at.
-	OrgMembershipOrderFieldUpdatedAt = &OrgMembershipOrderField{
-		Value: func(_m *OrgMembership) (ent.Value, error) {
-			return _m.UpdatedAt, nil
+	// OrgSubscriptionOrderFieldTrialExpiresAt orders OrgSubscription by trial_expires_at.
+	OrgSubscriptionOrderFieldTrialExpiresAt = &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.TrialExpiresAt == nil {
+				return nil, nil
+			}
+			return _m.TrialExpiresAt, nil
 		},
-		column: orgmembership.FieldUpdatedAt,
-		toTerm: orgmembership.ByUpdatedAt,
-		toCursor: func(_m *OrgMembership) Cursor {
+		column: orgsubscription.FieldTrialExpiresAt,
+		toTerm: func(opts ...sql.OrderTermOption) orgsubscription.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return orgsubscription.ByTrialExpiresAt(opts...)
+		},
+		toCursor: func(_m *OrgSubscription) Cursor {
+			if _m.TrialExpiresAt == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.UpdatedAt,
+				Value: _m.TrialExpiresAt,
 			}
 		},
 	}
-	// OrgMembershipOrderFieldRole orders OrgMembership by role.
-	OrgMembershipOrderFieldRole = &OrgMembershipOrderField{
-		Value: func(_m *OrgMembership) (ent.Value, error) {
-			return _m.Role, nil
+	// OrgSubscriptionOrderFieldDaysUntilDue orders OrgSubscription by days_until_due.
+	OrgSubscriptionOrderFieldDaysUntilDue = &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.DaysUntilDue == nil {
+				return nil, nil
+			}
+			return _m.DaysUntilDue, nil
 		},
-		column: orgmembership.FieldRole,
-		toTerm: orgmembership.ByRole,
-		toCursor: func(_m *OrgMembership) Cursor {
+		column: orgsubscription.FieldDaysUntilDue,
+		toTerm: func(opts ...sql.OrderTermOption) orgsubscription.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return orgsubscription.ByDaysUntilDue(opts...)
+		},
+		toCursor: func(_m *OrgSubscription) Cursor {
+			if _m.DaysUntilDue == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Role,
+				Value: _m.DaysUntilDue,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f OrgMembershipOrderField) String() string {
+func (f OrgSubscriptionOrderField) String() string {
 	var str string
 	switch f.column {
-	case OrgMembershipOrderFieldCreatedAt.column:
+	case OrgSubscriptionOrderFieldCreatedAt.column:
 		str = "created_at"
-	case OrgMembershipOrderFieldUpdatedAt.column:
+	case OrgSubscriptionOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case OrgMembershipOrderFieldRole.column:
-		str = "ROLE"
+	case OrgSubscriptionOrderFieldStripeSubscriptionStatus.column:
+		str = "stripe_subscription_status"
+	case OrgSubscriptionOrderFieldActive.column:
+		str = "active"
+	case OrgSubscriptionOrderFieldExpiresAt.column:
+		str = "expires_at"
+	case OrgSubscriptionOrderFieldTrialExpiresAt.column:
+		str = "trial_expires_at"
+	case OrgSubscriptionOrderFieldDaysUntilDue.column:
+		str = "days_until_due"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f OrgMembershipOrderField) MarshalGQL(w io.Writer) {
+func (f OrgSubscriptionOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *OrgMembershipOrderField) UnmarshalGQL(v interface{}) error {
+func (f *OrgSubscriptionOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("OrgMembershipOrderField %T must be a string", v)
+		return fmt.Errorf("OrgSubscriptionOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *OrgMembershipOrderFieldCreatedAt
+		*f = *OrgSubscriptionOrderFieldCreatedAt
 	case "updated_at":
-		*f = *OrgMembershipOrderFieldUpdatedAt
-	case "ROLE":
-		*f = *OrgMembershipOrderFieldRole
+		*f = *OrgSubscriptionOrderFieldUpdatedAt
+	case "stripe_subscription_status":
+		*f = *OrgSubscriptionOrderFieldStripeSubscriptionStatus
+	case "active":
+		*f = *OrgSubscriptionOrderFieldActive
+	case "expires_at":
+		*f = *OrgSubscriptionOrderFieldExpiresAt
+	case "trial_expires_at":
+		*f = *OrgSubscriptionOrderFieldTrialExpiresAt
+	case "days_until_due":
+		*f = *OrgSubscriptionOrderFieldDaysUntilDue
 	default:
-		return fmt.Errorf("%s is not a valid OrgMembershipOrderField", str)
+		return fmt.Errorf("%s is not a valid OrgSubscriptionOrderField", str)
 	}
 	return nil
 }
 
-// OrgMembershipOrderField defines the ordering field of OrgMembership.
-type OrgMembershipOrderField struct {
-	// Value extracts the ordering value from the given OrgMembership.
-	Value    func(*OrgMembership) (ent.Value, error)
+// OrgSubscriptionOrderField defines the ordering field of OrgSubscription.
+type OrgSubscriptionOrderField struct {
+	// Value extracts the ordering value from the given OrgSubscription.
+	Value    func(*OrgSubscription) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) orgmembership.OrderOption
-	toCursor func(*OrgMembership) Cursor
+	toTerm   func(...sql.OrderTermOption) orgsubscription.OrderOption
+	toCursor func(*OrgSubscription) Cursor
 }
 
-// OrgMembershipOrder defines the ordering of OrgMembership.
-type OrgMembershipOrder struct {
-	Direction OrderDirection           `json:"direction"`
-	Field     *OrgMembershipOrderField `json:"field"`
+// OrgSubscriptionOrder defines the ordering of OrgSubscription.
+type OrgSubscriptionOrder struct {
+	Direction OrderDirection             `json:"direction"`
+	Field     *OrgSubscriptionOrderField `json:"field"`
 }
 
-// DefaultOrgMembershipOrder is the default ordering of OrgMembership.
-var DefaultOrgMembershipOrder = &OrgMembershipOrder{
+// DefaultOrgSubscriptionOrder is the default ordering of OrgSubscription.
+var DefaultOrgSubscriptionOrder = &OrgSubscriptionOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &OrgMembershipOrderField{
-		Value: func(_m *OrgMembership) (ent.Value, error) {
+	Field: &OrgSubscriptionOrderField{
+		Value: func(_m *OrgSubscription) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: orgmembership.FieldID,
-		toTerm: orgmembership.ByID,
-		toCursor: func(_m *OrgMembership) Cursor {
+		column: orgsubscription.FieldID,
+		toTerm: orgsubscription.ByID,
+		toCursor: func(_m *OrgSubscription) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts OrgMembership into OrgMembershipEdge.
-func (_m *OrgMembership) ToEdge(order *OrgMembershipOrder) *OrgMembershipEdge {
+// ToEdge converts OrgSubscription into OrgSubscriptionEdge.
+func (_m *OrgSubscription) ToEdge(order *OrgSubscriptionOrder) *OrgSubscriptionEdge {
 	if order == nil {
-		order = DefaultOrgMembershipOrder
+		order = DefaultOrgSubscriptionOrder
 	}
-	return &OrgMembershipEdge{
+	return &OrgSubscriptionEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// OrgSubscriptionEdge is the edge representation of OrgSubscription.
-type OrgSubscriptionEdge struct {
-	Node   *OrgSubscription `json:"node"`
-	Cursor Cursor           `json:"cursor"`
+// OrganizationEdge is the edge representation of Organization.
+type OrganizationEdge struct {
+	Node   *Organization `json:"node"`
+	Cursor Cursor        `json:"cursor"`
 }
 
-// OrgSubscriptionConnection is the connection containing edges to OrgSubscription.
-type OrgSubscriptionConnection struct {
-	Edges      []*OrgSubscriptionEdge `json:"edges"`
-	PageInfo   PageInfo               `json:"pageInfo"`
-	TotalCount int                    `json:"totalCount"`
+// OrganizationConnection is the connection containing edges to Organization.
+type OrganizationConnection struct {
+	Edges      []*OrganizationEdge `json:"edges"`
+	PageInfo   PageInfo            `json:"pageInfo"`
+	TotalCount int                 `json:"totalCount"`
 }
 
-func (c *OrgSubscriptionConnection) build(nodes []*OrgSubscription, pager *orgsubscriptionPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *OrganizationConnection) build(nodes []*Organization, pager *organizationPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -23474,21 +24292,21 @@ func (c *OrgSubscriptionConnection) build(nodes []*OrgSubscription, pager *orgsu
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *OrgSubscription
+	var nodeAt func(int) *Organization
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *OrgSubscription {
+		nodeAt = func(i int) *Organization {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *OrgSubscription {
+		nodeAt = func(i int) *Organization {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*OrgSubscriptionEdge, len(nodes))
+	c.Edges = make([]*OrganizationEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &OrgSubscriptionEdge{
+		c.Edges[i] = &OrganizationEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -23502,126 +24320,167 @@ func (c *OrgSubscriptionConnection) build(nodes []*OrgSubscription, pager *orgsu
 	}
 }
 
-// OrgSubscriptionPaginateOption enables pagination customization.
-type OrgSubscriptionPaginateOption func(*orgsubscriptionPager) error
+// OrganizationPaginateOption enables pagination customization.
+type OrganizationPaginateOption func(*organizationPager) error
 
-// WithOrgSubscriptionOrder configures pagination ordering.
-func WithOrgSubscriptionOrder(order *OrgSubscriptionOrder) OrgSubscriptionPaginateOption {
-	if order == nil {
-		order = DefaultOrgSubscriptionOrder
-	}
-	o := *order
-	return func(pager *orgsubscriptionPager) error {
-		if err := o.Direction.Validate(); err != nil {
-			return err
-		}
-		if o.Field == nil {
-			o.Field = DefaultOrgSubscriptionOrder.Field
+// WithOrganizationOrder configures pagination ordering.
+func WithOrganizationOrder(order []*OrganizationOrder) OrganizationPaginateOption {
+	return func(pager *organizationPager) error {
+		for _, o := range order {
+			if err := o.Direction.Validate(); err != nil {
+				return err
+			}
 		}
-		pager.order = &o
+		pager.order = append(pager.order, order...)
 		return nil
 	}
 }
 
-// WithOrgSubscriptionFilter configures pagination filter.
-func WithOrgSubscriptionFilter(filter func(*OrgSubscriptionQuery) (*OrgSubscriptionQuery, error)) OrgSubscriptionPaginateOption {
-	return func(pager *orgsubscriptionPager) error {
+// WithOrganizationFilter configures pagination filter.
+func WithOrganizationFilter(filter func(*OrganizationQuery) (*OrganizationQuery, error)) OrganizationPaginateOption {
+	return func(pager *organizationPager) error {
 		if filter == nil {
-			return errors.New("OrgSubscriptionQuery filter cannot be nil")
+			return errors.New("OrganizationQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type orgsubscriptionPager struct {
+type organizationPager struct {
 	reverse bool
-	order   *OrgSubscriptionOrder
-	filter  func(*OrgSubscriptionQuery) (*OrgSubscriptionQuery, error)
+	order   []*OrganizationOrder
+	filter  func(*OrganizationQuery) (*OrganizationQuery, error)
 }
 
-func newOrgSubscriptionPager(opts []OrgSubscriptionPaginateOption, reverse bool) (*orgsubscriptionPager, error) {
-	pager := &orgsubscriptionPager{reverse: reverse}
+func newOrganizationPager(opts []OrganizationPaginateOption, reverse bool) (*organizationPager, error) {
+	pager := &organizationPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
 		}
 	}
-	if pager.order == nil {
-		pager.order = DefaultOrgSubscriptionOrder
-	}
+	for i, o := range pager.order {
+		if i > 0 && o.Field == pager.order[i-1].Field {
+			return nil, fmt.Errorf("duplicate order direction %q", o.Direction)
+		}
+	}
 	return pager, nil
 }
 
-func (p *orgsubscriptionPager) applyFilter(query *OrgSubscriptionQuery) (*OrgSubscriptionQuery, error) {
+func (p *organizationPager) applyFilter(query *OrganizationQuery) (*OrganizationQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *orgsubscriptionPager) toCursor(_m *OrgSubscription) Cursor {
-	return p.order.Field.toCursor(_m)
+func (p *organizationPager) toCursor(_m *Organization) Cursor {
+	cs_ := make([]any, 0, len(p.order))
+	for _, o_ := range p.order {
+		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
+	}
+	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *orgsubscriptionPager) applyCursors(query *OrgSubscriptionQuery, after, before *Cursor) (*OrgSubscriptionQuery, error) {
-	direction := p.order.Direction
+func (p *organizationPager) applyCursors(query *OrganizationQuery, after, before *Cursor) (*OrganizationQuery, error) {
+	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
-		direction = direction.Reverse()
+		idDirection = entgql.OrderDirectionDesc
 	}
-	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultOrgSubscriptionOrder.Field.column, p.order.Field.column, direction) {
-		query = query.Where(predicate)
+	fields, directions := make([]string, 0, len(p.order)), make([]OrderDirection, 0, len(p.order))
+	for _, o := range p.order {
+		fields = append(fields, o.Field.column)
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		directions = append(directions, direction)
+	}
+	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
+		FieldID:     DefaultOrganizationOrder.Field.column,
+		DirectionID: idDirection,
+		Fields:      fields,
+		Directions:  directions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, predicate := range predicates {
+		query = query.Where(func(s *sql.Selector) {
+			predicate(s)
+			if i < len(fields) {
+				s.Or().Where(sql.IsNull(fields[i]))
+			}
+		})
 	}
 	return query, nil
 }
 
-func (p *orgsubscriptionPager) applyOrder(query *OrgSubscriptionQuery) *OrgSubscriptionQuery {
-	direction := p.order.Direction
-	if p.reverse {
-		direction = direction.Reverse()
-	}
-	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
-	if p.order.Field != DefaultOrgSubscriptionOrder.Field {
-		query = query.Order(DefaultOrgSubscriptionOrder.Field.toTerm(direction.OrderTermOption()))
+func (p *organizationPager) applyOrder(query *OrganizationQuery) *OrganizationQuery {
+	var defaultOrdered bool
+	for _, o := range p.order {
+		direction := o.Direction
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
+		if o.Field.column == DefaultOrganizationOrder.Field.column {
+			defaultOrdered = true
+		}
+		if len(query.ctx.Fields) > 0 {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
 	}
-	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(p.order.Field.column)
+	if !defaultOrdered {
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
+		}
+		query = query.Order(DefaultOrganizationOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *orgsubscriptionPager) orderExpr(query *OrgSubscriptionQuery) sql.Querier {
-	direction := p.order.Direction
-	if p.reverse {
-		direction = direction.Reverse()
-	}
+func (p *organizationPager) orderExpr(query *OrganizationQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
-		query.ctx.AppendFieldOnce(p.order.Field.column)
+		for _, o := range p.order {
+			query.ctx.AppendFieldOnce(o.Field.column)
+		}
 	}
 	return sql.ExprFunc(func(b *sql.Builder) {
-		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
-		if p.order.Field != DefaultOrgSubscriptionOrder.Field {
-			b.Comma().Ident(DefaultOrgSubscriptionOrder.Field.column).Pad().WriteString(string(direction))
+		for _, o := range p.order {
+			direction := o.Direction
+			if p.reverse {
+				direction = direction.Reverse()
+			}
+			b.Ident(o.Field.column).Pad().WriteString(string(direction))
+			b.Comma()
+		}
+		direction := entgql.OrderDirectionAsc
+		if p.reverse {
+			direction = direction.Reverse()
 		}
+		b.Ident(DefaultOrganizationOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to OrgSubscription.
-func (_m *OrgSubscriptionQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Organization.
+func (_m *OrganizationQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...OrgSubscriptionPaginateOption,
-) (*OrgSubscriptionConnection, error) {
+	before *Cursor, last *int, opts ...OrganizationPaginateOption,
+) (*OrganizationConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newOrgSubscriptionPager(opts, last != nil)
+	pager, err := newOrganizationPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &OrgSubscriptionConnection{Edges: []*OrgSubscriptionEdge{}}
+	conn := &OrganizationConnection{Edges: []*OrganizationEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -23641,7 +24500,7 @@ func (_m *OrgSubscriptionQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimitSingle(first, last)
+	limit := paginateLimit(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -23660,254 +24519,161 @@ func (_m *OrgSubscriptionQuery) Paginate(
 }
 
 var (
-	// OrgSubscriptionOrderFieldCreatedAt orders OrgSubscription by created_at.
-	OrgSubscriptionOrderFieldCreatedAt = &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
+	// OrganizationOrderFieldCreatedAt orders Organization by created_at.
+	OrganizationOrderFieldCreatedAt = &OrganizationOrderField{
+		Value: func(_m *Organization) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: orgsubscription.FieldCreatedAt,
-		toTerm: orgsubscription.ByCreatedAt,
-		toCursor: func(_m *OrgSubscription) Cursor {
+		column: organization.FieldCreatedAt,
+		toTerm: organization.ByCreatedAt,
+		toCursor: func(_m *Organization) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// OrgSubscriptionOrderFieldUpdatedAt orders OrgSubscription by updated_at.
-	OrgSubscriptionOrderFieldUpdatedAt = &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
+	// OrganizationOrderFieldUpdatedAt orders Organization by updated_at.
+	OrganizationOrderFieldUpdatedAt = &OrganizationOrderField{
+		Value: func(_m *Organization) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: orgsubscription.FieldUpdatedAt,
-		toTerm: orgsubscription.ByUpdatedAt,
-		toCursor: func(_m *OrgSubscription) Cursor {
+		column: organization.FieldUpdatedAt,
+		toTerm: organization.ByUpdatedAt,
+		toCursor: func(_m *Organization) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// OrgSubscriptionOrderFieldStripeSubscriptionStatus orders OrgSubscription by stripe_subscription_status.
-	OrgSubscriptionOrderFieldStripeSubscriptionStatus = &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
-			return _m.StripeSubscriptionStatus, nil
-		},
-		column: orgsubscription.FieldStripeSubscriptionStatus,
-		toTerm: orgsubscription.ByStripeSubscriptionStatus,
-		toCursor: func(_m *OrgSubscription) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.StripeSubscriptionStatus,
-			}
-		},
-	}
-	// OrgSubscriptionOrderFieldActive orders OrgSubscription by active.
-	OrgSubscriptionOrderFieldActive = &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
-			return _m.Active, nil
-		},
-		column: orgsubscription.FieldActive,
-		toTerm: orgsubscription.ByActive,
-		toCursor: func(_m *OrgSubscription) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Active,
-			}
-		},
-	}
-	// OrgSubscriptionOrderFieldExpiresAt orders OrgSubscription by expires_at.
-	OrgSubscriptionOrderFieldExpiresAt = &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.ExpiresAt == nil {
-				return nil, nil
-			}
-			return _m.ExpiresAt, nil
-		},
-		column: orgsubscription.FieldExpiresAt,
-		toTerm: func(opts ...sql.OrderTermOption) orgsubscription.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return orgsubscription.ByExpiresAt(opts...)
-		},
-		toCursor: func(_m *OrgSubscription) Cursor {
-			if _m.ExpiresAt == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ExpiresAt,
-			}
-		},
-	}
-	// OrgSubscriptionOrderFieldTrialExpiresAt orders OrgSubscription by trial_expires_at.
-	OrgSubscriptionOrderFieldTrialExpiresAt = &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.TrialExpiresAt == nil {
-				return nil, nil
-			}
-			return _m.TrialExpiresAt, nil
-		},
-		column: orgsubscription.FieldTrialExpiresAt,
-		toTerm: func(opts ...sql.OrderTermOption) orgsubscription.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return orgsubscription.ByTrialExpiresAt(opts...)
+	// OrganizationOrderFieldName orders Organization by name.
+	OrganizationOrderFieldName = &OrganizationOrderField{
+		Value: func(_m *Organization) (ent.Value, error) {
+			return _m.Name, nil
 		},
-		toCursor: func(_m *OrgSubscription) Cursor {
-			if _m.TrialExpiresAt == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
+		column: organization.FieldName,
+		toTerm: organization.ByName,
+		toCursor: func(_m *Organization) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.TrialExpiresAt,
+				Value: _m.Name,
 			}
 		},
 	}
-	// OrgSubscriptionOrderFieldDaysUntilDue orders OrgSubscription by days_until_due.
-	OrgSubscriptionOrderFieldDaysUntilDue = &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.DaysUntilDue == nil {
-				return nil, nil
-			}
-			return _m.DaysUntilDue, nil
-		},
-		column: orgsubscription.FieldDaysUntilDue,
-		toTerm: func(opts ...sql.OrderTermOption) orgsubscription.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return orgsubscription.ByDaysUntilDue(opts...)
+	// OrganizationOrderFieldDisplayName orders Organization by display_name.
+	OrganizationOrderFieldDisplayName = &OrganizationOrderField{
+		Value: func(_m *Organization) (ent.Value, error) {
+			return _m.DisplayName, nil
 		},
-		toCursor: func(_m *OrgSubscription) Cursor {
-			if _m.DaysUntilDue == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
+		column: organization.FieldDisplayName,
+		toTerm: organization.ByDisplayName,
+		toCursor: func(_m *Organization) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.DaysUntilDue,
+				Value: _m.DisplayName,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f OrgSubscriptionOrderField) String() string {
+func (f OrganizationOrderField) String() string {
 	var str string
 	switch f.column {
-	case OrgSubscriptionOrderFieldCreatedAt.column:
+	case OrganizationOrderFieldCreatedAt.column:
 		str = "created_at"
-	case OrgSubscriptionOrderFieldUpdatedAt.column:
+	case OrganizationOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case OrgSubscriptionOrderFieldStripeSubscriptionStatus.column:
-		str = "stripe_subscription_status"
-	case OrgSubscriptionOrderFieldActive.column:
-		str = "active"
-	case OrgSubscriptionOrderFieldExpiresAt.column:
-		str = "expires_at"
-	case OrgSubscriptionOrderFieldTrialExpiresAt.column:
-		str = "trial_expires_at"
-	case OrgSubscriptionOrderFieldDaysUntilDue.column:
-		str = "days_until_due"
+	case OrganizationOrderFieldName.column:
+		str = "name"
+	case OrganizationOrderFieldDisplayName.column:
+		str = "display_name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f OrgSubscriptionOrderField) MarshalGQL(w io.Writer) {
+func (f OrganizationOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *OrgSubscriptionOrderField) UnmarshalGQL(v interface{}) error {
+func (f *OrganizationOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("OrgSubscriptionOrderField %T must be a string", v)
+		return fmt.Errorf("OrganizationOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *OrgSubscriptionOrderFieldCreatedAt
+		*f = *OrganizationOrderFieldCreatedAt
 	case "updated_at":
-		*f = *OrgSubscriptionOrderFieldUpdatedAt
-	case "stripe_subscription_status":
-		*f = *OrgSubscriptionOrderFieldStripeSubscriptionStatus
-	case "active":
-		*f = *OrgSubscriptionOrderFieldActive
-	case "expires_at":
-		*f = *OrgSubscriptionOrderFieldExpiresAt
-	case "trial_expires_at":
-		*f = *OrgSubscriptionOrderFieldTrialExpiresAt
-	case "days_until_due":
-		*f = *OrgSubscriptionOrderFieldDaysUntilDue
+		*f = *OrganizationOrderFieldUpdatedAt
+	case "name":
+		*f = *OrganizationOrderFieldName
+	case "display_name":
+		*f = *OrganizationOrderFieldDisplayName
 	default:
-		return fmt.Errorf("%s is not a valid OrgSubscriptionOrderField", str)
+		return fmt.Errorf("%s is not a valid OrganizationOrderField", str)
 	}
 	return nil
 }
 
-// OrgSubscriptionOrderField defines the ordering field of OrgSubscription.
-type OrgSubscriptionOrderField struct {
-	// Value extracts the ordering value from the given OrgSubscription.
-	Value    func(*OrgSubscription) (ent.Value, error)
+// OrganizationOrderField defines the ordering field of Organization.
+type OrganizationOrderField struct {
+	// Value extracts the ordering value from the given Organization.
+	Value    func(*Organization) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) orgsubscription.OrderOption
-	toCursor func(*OrgSubscription) Cursor
+	toTerm   func(...sql.OrderTermOption) organization.OrderOption
+	toCursor func(*Organization) Cursor
 }
 
-// OrgSubscriptionOrder defines the ordering of OrgSubscription.
-type OrgSubscriptionOrder struct {
-	Direction OrderDirection             `json:"direction"`
-	Field     *OrgSubscriptionOrderField `json:"field"`
+// OrganizationOrder defines the ordering of Organization.
+type OrganizationOrder struct {
+	Direction OrderDirection          `json:"direction"`
+	Field     *OrganizationOrderField `json:"field"`
 }
 
-// DefaultOrgSubscriptionOrder is the default ordering of OrgSubscription.
-var DefaultOrgSubscriptionOrder = &OrgSubscriptionOrder{
+// DefaultOrganizationOrder is the default ordering of Organization.
+var DefaultOrganizationOrder = &OrganizationOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &OrgSubscriptionOrderField{
-		Value: func(_m *OrgSubscription) (ent.Value, error) {
+	Field: &OrganizationOrderField{
+		Value: func(_m *Organization) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: orgsubscription.FieldID,
-		toTerm: orgsubscription.ByID,
-		toCursor: func(_m *OrgSubscription) Cursor {
+		column: organization.FieldID,
+		toTerm: organization.ByID,
+		toCursor: func(_m *Organization) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts OrgSubscription into OrgSubscriptionEdge.
-func (_m *OrgSubscription) ToEdge(order *OrgSubscriptionOrder) *OrgSubscriptionEdge {
+// ToEdge converts Organization into OrganizationEdge.
+func (_m *Organization) ToEdge(order *OrganizationOrder) *OrganizationEdge {
 	if order == nil {
-		order = DefaultOrgSubscriptionOrder
+		order = DefaultOrganizationOrder
 	}
-	return &OrgSubscriptionEdge{
+	return &OrganizationEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// OrganizationEdge is the edge representation of Organization.
-type OrganizationEdge struct {
-	Node   *Organization `json:"node"`
-	Cursor Cursor        `json:"cursor"`
+// OrganizationSettingEdge is the edge representation of OrganizationSetting.
+type OrganizationSettingEdge struct {
+	Node   *OrganizationSetting `json:"node"`
+	Cursor Cursor               `json:"cursor"`
 }
 
-// OrganizationConnection is the connection containing edges to Organization.
-type OrganizationConnection struct {
-	Edges      []*OrganizationEdge `json:"edges"`
-	PageInfo   PageInfo            `json:"pageInfo"`
-	TotalCount int                 `json:"totalCount"`
+// OrganizationSettingConnection is the connection containing edges to OrganizationSetting.
+type OrganizationSettingConnection struct {
+	Edges      []*OrganizationSettingEdge `json:"edges"`
+	PageInfo   PageInfo                   `json:"pageInfo"`
+	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *OrganizationConnection) build(nodes []*Organization, pager *organizationPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, pager *organizationsettingPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -23917,21 +24683,21 @@ func (c *OrganizationConnection) build(nodes []*Organization, pager *organizatio
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *Organization
+	var nodeAt func(int) *OrganizationSetting
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Organization {
+		nodeAt = func(i int) *OrganizationSetting {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Organization {
+		nodeAt = func(i int) *OrganizationSetting {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*OrganizationEdge, len(nodes))
+	c.Edges = make([]*OrganizationSettingEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &OrganizationEdge{
+		c.Edges[i] = &OrganizationSettingEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -23945,12 +24711,12 @@ func (c *OrganizationConnection) build(nodes []*Organization, pager *organizatio
 	}
 }
 
-// OrganizationPaginateOption enables pagination customization.
-type OrganizationPaginateOption func(*organizationPager) error
+// OrganizationSettingPaginateOption enables pagination customization.
+type OrganizationSettingPaginateOption func(*organizationsettingPager) error
 
-// WithOrganizationOrder configures pagination ordering.
-func WithOrganizationOrder(order []*OrganizationOrder) OrganizationPaginateOption {
-	return func(pager *organizationPager) error {
+// WithOrganizationSettingOrder configures pagination ordering.
+func WithOrganizationSettingOrder(order []*OrganizationSettingOrder) OrganizationSettingPaginateOption {
+	return func(pager *organizationsettingPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -23961,25 +24727,25 @@ func WithOrganizationOrder(order []*OrganizationOrder) OrganizationPaginateOptio
 	}
 }
 
-// WithOrganizationFilter configures pagination filter.
-func WithOrganizationFilter(filter func(*OrganizationQuery) (*OrganizationQuery, error)) OrganizationPaginateOption {
-	return func(pager *organizationPager) error {
+// WithOrganizationSettingFilter configures pagination filter.
+func WithOrganizationSettingFilter(filter func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)) OrganizationSettingPaginateOption {
+	return func(pager *organizationsettingPager) error {
 		if filter == nil {
-			return errors.New("OrganizationQuery filter cannot be nil")
+			return errors.New("OrganizationSettingQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type organizationPager struct {
+type organizationsettingPager struct {
 	reverse bool
-	order   []*OrganizationOrder
-	filter  func(*OrganizationQuery) (*OrganizationQuery, error)
+	order   []*OrganizationSettingOrder
+	filter  func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)
 }
 
-func newOrganizationPager(opts []OrganizationPaginateOption, reverse bool) (*organizationPager, error) {
-	pager := &organizationPager{reverse: reverse}
+func newOrganizationSettingPager(opts []OrganizationSettingPaginateOption, reverse bool) (*organizationsettingPager, error) {
+	pager := &organizationsettingPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -23993,14 +24759,14 @@ func newOrganizationPager(opts []OrganizationPaginateOption, reverse bool) (*org
 	return pager, nil
 }
 
-func (p *organizationPager) applyFilter(query *OrganizationQuery) (*OrganizationQuery, error) {
+func (p *organizationsettingPager) applyFilter(query *OrganizationSettingQuery) (*OrganizationSettingQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *organizationPager) toCursor(_m *Organization) Cursor {
+func (p *organizationsettingPager) toCursor(_m *OrganizationSetting) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -24008,7 +24774,7 @@ func (p *organizationPager) toCursor(_m *Organization) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *organizationPager) applyCursors(query *OrganizationQuery, after, before *Cursor) (*OrganizationQuery, error) {
+func (p *organizationsettingPager) applyCursors(query *OrganizationSettingQuery, after, before *Cursor) (*OrganizationSettingQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -24023,7 +24789,7 @@ func (p *organizationPager) applyCursors(query *OrganizationQuery, after, before
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultOrganizationOrder.Field.column,
+		FieldID:     DefaultOrganizationSettingOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -24042,7 +24808,7 @@ func (p *organizationPager) applyCursors(query *OrganizationQuery, after, before
 	return query, nil
 }
 
-func (p *organizationPager) applyOrder(query *OrganizationQuery) *OrganizationQuery {
+func (p *organizationsettingPager) applyOrder(query *OrganizationSettingQuery) *OrganizationSettingQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -24050,7 +24816,7 @@ func (p *organizationPager) applyOrder(query *OrganizationQuery) *OrganizationQu
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultOrganizationOrder.Field.column {
+		if o.Field.column == DefaultOrganizationSettingOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -24062,12 +24828,12 @@ func (p *organizationPager) applyOrder(query *OrganizationQuery) *OrganizationQu
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultOrganizationOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultOrganizationSettingOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *organizationPager) orderExpr(query *OrganizationQuery) sql.Querier {
+func (p *organizationsettingPager) orderExpr(query *OrganizationSettingQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -24086,26 +24852,26 @@ func (p *organizationPager) orderExpr(query *OrganizationQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultOrganizationOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultOrganizationSettingOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Organization.
-func (_m *OrganizationQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to OrganizationSetting.
+func (_m *OrganizationSettingQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...OrganizationPaginateOption,
-) (*OrganizationConnection, error) {
+	before *Cursor, last *int, opts ...OrganizationSettingPaginateOption,
+) (*OrganizationSettingConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newOrganizationPager(opts, last != nil)
+	pager, err := newOrganizationSettingPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &OrganizationConnection{Edges: []*OrganizationEdge{}}
+	conn := &OrganizationSettingConnection{Edges: []*OrganizationSettingEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -24144,161 +24910,125 @@ func (_m *OrganizationQuery) Paginate(
 }
 
 var (
-	// OrganizationOrderFieldCreatedAt orders Organization by created_at.
-	OrganizationOrderFieldCreatedAt = &OrganizationOrderField{
-		Value: func(_m *Organization) (ent.Value, error) {
+	// OrganizationSettingOrderFieldCreatedAt orders OrganizationSetting by created_at.
+	OrganizationSettingOrderFieldCreatedAt = &OrganizationSettingOrderField{
+		Value: func(_m *OrganizationSetting) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: organization.FieldCreatedAt,
-		toTerm: organization.ByCreatedAt,
-		toCursor: func(_m *Organization) Cursor {
+		column: organizationsetting.FieldCreatedAt,
+		toTerm: organizationsetting.ByCreatedAt,
+		toCursor: func(_m *OrganizationSetting) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// OrganizationOrderFieldUpdatedAt orders Organization by updated_at.
-	OrganizationOrderFieldUpdatedAt = &OrganizationOrderField{
-		Value: func(_m *Organization) (ent.Value, error) {
+	// OrganizationSettingOrderFieldUpdatedAt orders OrganizationSetting by updated_at.
+	OrganizationSettingOrderFieldUpdatedAt = &OrganizationSettingOrderField{
+		Value: func(_m *OrganizationSetting) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: organization.FieldUpdatedAt,
-		toTerm: organization.ByUpdatedAt,
-		toCursor: func(_m *Organization) Cursor {
+		column: organizationsetting.FieldUpdatedAt,
+		toTerm: organizationsetting.ByUpdatedAt,
+		toCursor: func(_m *OrganizationSetting) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// OrganizationOrderFieldName orders Organization by name.
-	OrganizationOrderFieldName = &OrganizationOrderField{
-		Value: func(_m *Organization) (ent.Value, error) {
-			return _m.Name, nil
-		},
-		column: organization.FieldName,
-		toTerm: organization.ByName,
-		toCursor: func(_m *Organization) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Name,
-			}
-		},
-	}
-	// OrganizationOrderFieldDisplayName orders Organization by display_name.
-	OrganizationOrderFieldDisplayName = &OrganizationOrderField{
-		Value: func(_m *Organization) (ent.Value, error) {
-			return _m.DisplayName, nil
-		},
-		column: organization.FieldDisplayName,
-		toTerm: organization.ByDisplayName,
-		toCursor: func(_m *Organization) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.DisplayName,
-			}
-		},
-	}
 )
 
 // String implement fmt.Stringer interface.
-func (f OrganizationOrderField) String() string {
+func (f OrganizationSettingOrderField) String() string {
 	var str string
 	switch f.column {
-	case OrganizationOrderFieldCreatedAt.column:
+	case OrganizationSettingOrderFieldCreatedAt.column:
 		str = "created_at"
-	case OrganizationOrderFieldUpdatedAt.column:
+	case OrganizationSettingOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case OrganizationOrderFieldName.column:
-		str = "name"
-	case OrganizationOrderFieldDisplayName.column:
-		str = "display_name"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f OrganizationOrderField) MarshalGQL(w io.Writer) {
+func (f OrganizationSettingOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *OrganizationOrderField) UnmarshalGQL(v interface{}) error {
+func (f *OrganizationSettingOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("OrganizationOrderField %T must be a string", v)
+		return fmt.Errorf("OrganizationSettingOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *OrganizationOrderFieldCreatedAt
+		*f = *OrganizationSettingOrderFieldCreatedAt
 	case "updated_at":
-		*f = *OrganizationOrderFieldUpdatedAt
-	case "name":
-		*f = *OrganizationOrderFieldName
-	case "display_name":
-		*f = *OrganizationOrderFieldDisplayName
+		*f = *OrganizationSettingOrderFieldUpdatedAt
 	default:
-		return fmt.Errorf("%s is not a valid OrganizationOrderField", str)
+		return fmt.Errorf("%s is not a valid OrganizationSettingOrderField", str)
 	}
 	return nil
 }
 
-// OrganizationOrderField defines the ordering field of Organization.
-type OrganizationOrderField struct {
-	// Value extracts the ordering value from the given Organization.
-	Value    func(*Organization) (ent.Value, error)
+// OrganizationSettingOrderField defines the ordering field of OrganizationSetting.
+type OrganizationSettingOrderField struct {
+	// Value extracts the ordering value from the given OrganizationSetting.
+	Value    func(*OrganizationSetting) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) organization.OrderOption
-	toCursor func(*Organization) Cursor
+	toTerm   func(...sql.OrderTermOption) organizationsetting.OrderOption
+	toCursor func(*OrganizationSetting) Cursor
 }
 
-// OrganizationOrder defines the ordering of Organization.
-type OrganizationOrder struct {
-	Direction OrderDirection          `json:"direction"`
-	Field     *OrganizationOrderField `json:"field"`
+// OrganizationSettingOrder defines the ordering of OrganizationSetting.
+type OrganizationSettingOrder struct {
+	Direction OrderDirection                 `json:"direction"`
+	Field     *OrganizationSettingOrderField `json:"field"`
 }
 
-// DefaultOrganizationOrder is the default ordering of Organization.
-var DefaultOrganizationOrder = &OrganizationOrder{
+// DefaultOrganizationSettingOrder is the default ordering of OrganizationSetting.
+var DefaultOrganizationSettingOrder = &OrganizationSettingOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &OrganizationOrderField{
-		Value: func(_m *Organization) (ent.Value, error) {
+	Field: &OrganizationSettingOrderField{
+		Value: func(_m *OrganizationSetting) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: organization.FieldID,
-		toTerm: organization.ByID,
-		toCursor: func(_m *Organization) Cursor {
+		column: organizationsetting.FieldID,
+		toTerm: organizationsetting.ByID,
+		toCursor: func(_m *OrganizationSetting) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Organization into OrganizationEdge.
-func (_m *Organization) ToEdge(order *OrganizationOrder) *OrganizationEdge {
+// ToEdge converts OrganizationSetting into OrganizationSettingEdge.
+func (_m *OrganizationSetting) ToEdge(order *OrganizationSettingOrder) *OrganizationSettingEdge {
 	if order == nil {
-		order = DefaultOrganizationOrder
+		order = DefaultOrganizationSettingOrder
 	}
-	return &OrganizationEdge{
+	return &OrganizationSettingEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// OrganizationSettingEdge is the edge representation of OrganizationSetting.
-type OrganizationSettingEdge struct {
-	Node   *OrganizationSetting `json:"node"`
+// PersonalAccessTokenEdge is the edge representation of PersonalAccessToken.
+type PersonalAccessTokenEdge struct {
+	Node   *PersonalAccessToken `json:"node"`
 	Cursor Cursor               `json:"cursor"`
 }
 
-// OrganizationSettingConnection is the connection containing edges to OrganizationSetting.
-type OrganizationSettingConnection struct {
-	Edges      []*OrganizationSettingEdge `json:"edges"`
+// PersonalAccessTokenConnection is the connection containing edges to PersonalAccessToken.
+type PersonalAccessTokenConnection struct {
+	Edges      []*PersonalAccessTokenEdge `json:"edges"`
 	PageInfo   PageInfo                   `json:"pageInfo"`
 	TotalCount int                        `json:"totalCount"`
 }
 
-func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, pager *organizationsettingPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *PersonalAccessTokenConnection) build(nodes []*PersonalAccessToken, pager *personalaccesstokenPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -24308,21 +25038,21 @@ func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, page
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *OrganizationSetting
+	var nodeAt func(int) *PersonalAccessToken
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *OrganizationSetting {
+		nodeAt = func(i int) *PersonalAccessToken {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *OrganizationSetting {
+		nodeAt = func(i int) *PersonalAccessToken {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*OrganizationSettingEdge, len(nodes))
+	c.Edges = make([]*PersonalAccessTokenEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &OrganizationSettingEdge{
+		c.Edges[i] = &PersonalAccessTokenEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -24336,12 +25066,12 @@ func (c *OrganizationSettingConnection) build(nodes []*OrganizationSetting, page
 	}
 }
 
-// OrganizationSettingPaginateOption enables pagination customization.
-type OrganizationSettingPaginateOption func(*organizationsettingPager) error
+// PersonalAccessTokenPaginateOption enables pagination customization.
+type PersonalAccessTokenPaginateOption func(*personalaccesstokenPager) error
 
-// WithOrganizationSettingOrder configures pagination ordering.
-func WithOrganizationSettingOrder(order []*OrganizationSettingOrder) OrganizationSettingPaginateOption {
-	return func(pager *organizationsettingPager) error {
+// WithPersonalAccessTokenOrder configures pagination ordering.
+func WithPersonalAccessTokenOrder(order []*PersonalAccessTokenOrder) PersonalAccessTokenPaginateOption {
+	return func(pager *personalaccesstokenPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -24352,25 +25082,25 @@ func WithOrganizationSettingOrder(order []*OrganizationSettingOrder) Organizatio
 	}
 }
 
-// WithOrganizationSettingFilter configures pagination filter.
-func WithOrganizationSettingFilter(filter func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)) OrganizationSettingPaginateOption {
-	return func(pager *organizationsettingPager) error {
+// WithPersonalAccessTokenFilter configures pagination filter.
+func WithPersonalAccessTokenFilter(filter func(*PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error)) PersonalAccessTokenPaginateOption {
+	return func(pager *personalaccesstokenPager) error {
 		if filter == nil {
-			return errors.New("OrganizationSettingQuery filter cannot be nil")
+			return errors.New("PersonalAccessTokenQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type organizationsettingPager struct {
+type personalaccesstokenPager struct {
 	reverse bool
-	order   []*OrganizationSettingOrder
-	filter  func(*OrganizationSettingQuery) (*OrganizationSettingQuery, error)
+	order   []*PersonalAccessTokenOrder
+	filter  func(*PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error)
 }
 
-func newOrganizationSettingPager(opts []OrganizationSettingPaginateOption, reverse bool) (*organizationsettingPager, error) {
-	pager := &organizationsettingPager{reverse: reverse}
+func newPersonalAccessTokenPager(opts []PersonalAccessTokenPaginateOption, reverse bool) (*personalaccesstokenPager, error) {
+	pager := &personalaccesstokenPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -24384,14 +25114,14 @@ func newOrganizationSettingPager(opts []OrganizationSettingPaginateOption, rever
 	return pager, nil
 }
 
-func (p *organizationsettingPager) applyFilter(query *OrganizationSettingQuery) (*OrganizationSettingQuery, error) {
+func (p *personalaccesstokenPager) applyFilter(query *PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *organizationsettingPager) toCursor(_m *OrganizationSetting) Cursor {
+func (p *personalaccesstokenPager) toCursor(_m *PersonalAccessToken) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -24399,7 +25129,7 @@ func (p *organizationsettingPager) toCursor(_m *OrganizationSetting) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *organizationsettingPager) applyCursors(query *OrganizationSettingQuery, after, before *Cursor) (*OrganizationSettingQuery, error) {
+func (p *personalaccesstokenPager) applyCursors(query *PersonalAccessTokenQuery, after, before *Cursor) (*PersonalAccessTokenQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -24414,7 +25144,7 @@ func (p *organizationsettingPager) applyCursors(query *OrganizationSettingQuery,
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultOrganizationSettingOrder.Field.column,
+		FieldID:     DefaultPersonalAccessTokenOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -24433,7 +25163,7 @@ func (p *organizationsettingPager) applyCursors(query *OrganizationSettingQuery,
 	return query, nil
 }
 
-func (p *organizationsettingPager) applyOrder(query *OrganizationSettingQuery) *OrganizationSettingQuery {
+func (p *personalaccesstokenPager) applyOrder(query *PersonalAccessTokenQuery) *PersonalAccessTokenQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -24441,7 +25171,7 @@ func (p *organizationsettingPager) applyOrder(query *OrganizationSettingQuery) *
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultOrganizationSettingOrder.Field.column {
+		if o.Field.column == DefaultPersonalAccessTokenOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -24453,12 +25183,12 @@ func (p *organizationsettingPager) applyOrder(query *OrganizationSettingQuery) *
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultOrganizationSettingOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultPersonalAccessTokenOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *organizationsettingPager) orderExpr(query *OrganizationSettingQuery) sql.Querier {
+func (p *personalaccesstokenPager) orderExpr(query *PersonalAccessTokenQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -24477,26 +25207,26 @@ func (p *organizationsettingPager) orderExpr(query *OrganizationSettingQuery) sq
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultOrganizationSettingOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultPersonalAccessTokenOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to OrganizationSetting.
-func (_m *OrganizationSettingQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to PersonalAccessToken.
+func (_m *PersonalAccessTokenQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...OrganizationSettingPaginateOption,
-) (*OrganizationSettingConnection, error) {
+	before *Cursor, last *int, opts ...PersonalAccessTokenPaginateOption,
+) (*PersonalAccessTokenConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newOrganizationSettingPager(opts, last != nil)
+	pager, err := newPersonalAccessTokenPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &OrganizationSettingConnection{Edges: []*OrganizationSettingEdge{}}
+	conn := &PersonalAccessTokenConnection{Edges: []*PersonalAccessTokenEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -24535,125 +25265,223 @@ func (_m *OrganizationSettingQuery) Paginate(
 }
 
 var (
-	// OrganizationSettingOrderFieldCreatedAt orders OrganizationSetting by created_at.
-	OrganizationSettingOrderFieldCreatedAt = &OrganizationSettingOrderField{
-		Value: func(_m *OrganizationSetting) (ent.Value, error) {
+	// PersonalAccessTokenOrderFieldCreatedAt orders PersonalAccessToken by created_at.
+	PersonalAccessTokenOrderFieldCreatedAt = &PersonalAccessTokenOrderField{
+		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: organizationsetting.FieldCreatedAt,
-		toTerm: organizationsetting.ByCreatedAt,
-		toCursor: func(_m *OrganizationSetting) Cursor {
+		column: personalaccesstoken.FieldCreatedAt,
+		toTerm: personalaccesstoken.ByCreatedAt,
+		toCursor: func(_m *PersonalAccessToken) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// OrganizationSettingOrderFieldUpdatedAt orders OrganizationSetting by updated_at.
-	OrganizationSettingOrderFieldUpdatedAt = &OrganizationSettingOrderField{
-		Value: func(_m *OrganizationSetting) (ent.Value, error) {
+	// PersonalAccessTokenOrderFieldUpdatedAt orders PersonalAccessToken by updated_at.
+	PersonalAccessTokenOrderFieldUpdatedAt = &PersonalAccessTokenOrderField{
+		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: organizationsetting.FieldUpdatedAt,
-		toTerm: organizationsetting.ByUpdatedAt,
-		toCursor: func(_m *OrganizationSetting) Cursor {
+		column: personalaccesstoken.FieldUpdatedAt,
+		toTerm: personalaccesstoken.ByUpdatedAt,
+		toCursor: func(_m *PersonalAccessToken) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
+	// PersonalAccessTokenOrderFieldName orders PersonalAccessToken by name.
+	PersonalAccessTokenOrderFieldName = &PersonalAccessTokenOrderField{
+		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: personalaccesstoken.FieldName,
+		toTerm: personalaccesstoken.ByName,
+		toCursor: func(_m *PersonalAccessToken) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
+	// PersonalAccessTokenOrderFieldExpiresAt orders PersonalAccessToken by expires_at.
+	PersonalAccessTokenOrderFieldExpiresAt = &PersonalAccessTokenOrderField{
+		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.ExpiresAt == nil {
+				return nil, nil
+			}
+			return _m.ExpiresAt, nil
+		},
+		column: personalaccesstoken.FieldExpiresAt,
+		toTerm: func(opts ...sql.OrderTermOption) personalaccesstoken.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return personalaccesstoken.ByExpiresAt(opts...)
+		},
+		toCursor: func(_m *PersonalAccessToken) Cursor {
+			if _m.ExpiresAt == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ExpiresAt,
+			}
+		},
+	}
+	// PersonalAccessTokenOrderFieldLastUsedAt orders PersonalAccessToken by last_used_at.
+	PersonalAccessTokenOrderFieldLastUsedAt = &PersonalAccessTokenOrderField{
+		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
+			// allow for nil values for fields
+			if _m.LastUsedAt == nil {
+				return nil, nil
+			}
+			return _m.LastUsedAt, nil
+		},
+		column: personalaccesstoken.FieldLastUsedAt,
+		toTerm: func(opts ...sql.OrderTermOption) personalaccesstoken.OrderOption {
+			opts = append(opts, sql.OrderNullsLast())
+			return personalaccesstoken.ByLastUsedAt(opts...)
+		},
+		toCursor: func(_m *PersonalAccessToken) Cursor {
+			if _m.LastUsedAt == nil {
+				return Cursor{
+					ID:    _m.ID,
+					Value: nil, // handle nil values for fields
+				}
+			}
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.LastUsedAt,
+			}
+		},
+	}
+	// PersonalAccessTokenOrderFieldIsActive orders PersonalAccessToken by is_active.
+	PersonalAccessTokenOrderFieldIsActive = &PersonalAccessTokenOrderField{
+		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
+			return _m.IsActive, nil
+		},
+		column: personalaccesstoken.FieldIsActive,
+		toTerm: personalaccesstoken.ByIsActive,
+		toCursor: func(_m *PersonalAccessToken) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.IsActive,
+			}
+		},
+	}
 )
 
 // String implement fmt.Stringer interface.
-func (f OrganizationSettingOrderField) String() string {
+func (f PersonalAccessTokenOrderField) String() string {
 	var str string
 	switch f.column {
-	case OrganizationSettingOrderFieldCreatedAt.column:
+	case PersonalAccessTokenOrderFieldCreatedAt.column:
 		str = "created_at"
-	case OrganizationSettingOrderFieldUpdatedAt.column:
+	case PersonalAccessTokenOrderFieldUpdatedAt.column:
 		str = "updated_at"
+	case PersonalAccessTokenOrderFieldName.column:
+		str = "name"
+	case PersonalAccessTokenOrderFieldExpiresAt.column:
+		str = "expires_at"
+	case PersonalAccessTokenOrderFieldLastUsedAt.column:
+		str = "last_used_at"
+	case PersonalAccessTokenOrderFieldIsActive.column:
+		str = "is_active"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f OrganizationSettingOrderField) MarshalGQL(w io.Writer) {
+func (f PersonalAccessTokenOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *OrganizationSettingOrderField) UnmarshalGQL(v interface{}) error {
+func (f *PersonalAccessTokenOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("OrganizationSettingOrderField %T must be a string", v)
+		return fmt.Errorf("PersonalAccessTokenOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *OrganizationSettingOrderFieldCreatedAt
+		*f = *PersonalAccessTokenOrderFieldCreatedAt
 	case "updated_at":
-		*f = *OrganizationSettingOrderFieldUpdatedAt
+		*f = *PersonalAccessTokenOrderFieldUpdatedAt
+	case "name":
+		*f = *PersonalAccessTokenOrderFieldName
+	case "expires_at":
+		*f = *PersonalAccessTokenOrderFieldExpiresAt
+	case "last_used_at":
+		*f = *PersonalAccessTokenOrderFieldLastUsedAt
+	case "is_active":
+		*f = *PersonalAccessTokenOrderFieldIsActive
 	default:
-		return fmt.Errorf("%s is not a valid OrganizationSettingOrderField", str)
+		return fmt.Errorf("%s is not a valid PersonalAccessTokenOrderField", str)
 	}
 	return nil
 }
 
-// OrganizationSettingOrderField defines the ordering field of OrganizationSetting.
-type OrganizationSettingOrderField struct {
-	// Value extracts the ordering value from the given OrganizationSetting.
-	Value    func(*OrganizationSetting) (ent.Value, error)
+// PersonalAccessTokenOrderField defines the ordering field of PersonalAccessToken.
+type PersonalAccessTokenOrderField struct {
+	// Value extracts the ordering value from the given PersonalAccessToken.
+	Value    func(*PersonalAccessToken) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) organizationsetting.OrderOption
-	toCursor func(*OrganizationSetting) Cursor
+	toTerm   func(...sql.OrderTermOption) personalaccesstoken.OrderOption
+	toCursor func(*PersonalAccessToken) Cursor
 }
 
-// OrganizationSettingOrder defines the ordering of OrganizationSetting.
-type OrganizationSettingOrder struct {
+// PersonalAccessTokenOrder defines the ordering of PersonalAccessToken.
+type PersonalAccessTokenOrder struct {
 	Direction OrderDirection                 `json:"direction"`
-	Field     *OrganizationSettingOrderField `json:"field"`
+	Field     *PersonalAccessTokenOrderField `json:"field"`
 }
 
-// DefaultOrganizationSettingOrder is the default ordering of OrganizationSetting.
-var DefaultOrganizationSettingOrder = &OrganizationSettingOrder{
+// DefaultPersonalAccessTokenOrder is the default ordering of PersonalAccessToken.
+var DefaultPersonalAccessTokenOrder = &PersonalAccessTokenOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &OrganizationSettingOrderField{
-		Value: func(_m *OrganizationSetting) (ent.Value, error) {
+	Field: &PersonalAccessTokenOrderField{
+		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: organizationsetting.FieldID,
-		toTerm: organizationsetting.ByID,
-		toCursor: func(_m *OrganizationSetting) Cursor {
+		column: personalaccesstoken.FieldID,
+		toTerm: personalaccesstoken.ByID,
+		toCursor: func(_m *PersonalAccessToken) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts OrganizationSetting into OrganizationSettingEdge.
-func (_m *OrganizationSetting) ToEdge(order *OrganizationSettingOrder) *OrganizationSettingEdge {
+// ToEdge converts PersonalAccessToken into PersonalAccessTokenEdge.
+func (_m *PersonalAccessToken) ToEdge(order *PersonalAccessTokenOrder) *PersonalAccessTokenEdge {
 	if order == nil {
-		order = DefaultOrganizationSettingOrder
+		order = DefaultPersonalAccessTokenOrder
 	}
-	return &OrganizationSettingEdge{
+	return &PersonalAccessTokenEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// PersonalAccessTokenEdge is the edge representation of PersonalAccessToken.
-type PersonalAccessTokenEdge struct {
-	Node   *PersonalAccessToken `json:"node"`
-	Cursor Cursor               `json:"cursor"`
+// PlatformEdge is the edge representation of Platform.
+type PlatformEdge struct {
+	Node   *Platform `json:"node"`
+	Cursor Cursor    `json:"cursor"`
 }
 
-// PersonalAccessTokenConnection is the connection containing edges to PersonalAccessToken.
-type PersonalAccessTokenConnection struct {
-	Edges      []*PersonalAccessTokenEdge `json:"edges"`
-	PageInfo   PageInfo                   `json:"pageInfo"`
-	TotalCount int                        `json:"totalCount"`
+// PlatformConnection is the connection containing edges to Platform.
+type PlatformConnection struct {
+	Edges      []*PlatformEdge `json:"edges"`
+	PageInfo   PageInfo        `json:"pageInfo"`
+	TotalCount int             `json:"totalCount"`
 }
 
-func (c *PersonalAccessTokenConnection) build(nodes []*PersonalAccessToken, pager *personalaccesstokenPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *PlatformConnection) build(nodes []*Platform, pager *platformPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -24663,21 +25491,21 @@ func (c *PersonalAccessTokenConnection) build(nodes []*PersonalAccessToken, page
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *PersonalAccessToken
+	var nodeAt func(int) *Platform
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *PersonalAccessToken {
+		nodeAt = func(i int) *Platform {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *PersonalAccessToken {
+		nodeAt = func(i int) *Platform {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*PersonalAccessTokenEdge, len(nodes))
+	c.Edges = make([]*PlatformEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &PersonalAccessTokenEdge{
+		c.Edges[i] = &PlatformEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -24691,12 +25519,12 @@ func (c *PersonalAccessTokenConnection) build(nodes []*PersonalAccessToken, page
 	}
 }
 
-// PersonalAccessTokenPaginateOption enables pagination customization.
-type PersonalAccessTokenPaginateOption func(*personalaccesstokenPager) error
+// PlatformPaginateOption enables pagination customization.
+type PlatformPaginateOption func(*platformPager) error
 
-// WithPersonalAccessTokenOrder configures pagination ordering.
-func WithPersonalAccessTokenOrder(order []*PersonalAccessTokenOrder) PersonalAccessTokenPaginateOption {
-	return func(pager *personalaccesstokenPager) error {
+// WithPlatformOrder configures pagination ordering.
+func WithPlatformOrder(order []*PlatformOrder) PlatformPaginateOption {
+	return func(pager *platformPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -24707,25 +25535,25 @@ func WithPersonalAccessTokenOrder(order []*PersonalAccessTokenOrder) PersonalAcc
 	}
 }
 
-// WithPersonalAccessTokenFilter configures pagination filter.
-func WithPersonalAccessTokenFilter(filter func(*PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error)) PersonalAccessTokenPaginateOption {
-	return func(pager *personalaccesstokenPager) error {
+// WithPlatformFilter configures pagination filter.
+func WithPlatformFilter(filter func(*PlatformQuery) (*PlatformQuery, error)) PlatformPaginateOption {
+	return func(pager *platformPager) error {
 		if filter == nil {
-			return errors.New("PersonalAccessTokenQuery filter cannot be nil")
+			return errors.New("PlatformQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type personalaccesstokenPager struct {
+type platformPager struct {
 	reverse bool
-	order   []*PersonalAccessTokenOrder
-	filter  func(*PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error)
+	order   []*PlatformOrder
+	filter  func(*PlatformQuery) (*PlatformQuery, error)
 }
 
-func newPersonalAccessTokenPager(opts []PersonalAccessTokenPaginateOption, reverse bool) (*personalaccesstokenPager, error) {
-	pager := &personalaccesstokenPager{reverse: reverse}
+func newPlatformPager(opts []PlatformPaginateOption, reverse bool) (*platformPager, error) {
+	pager := &platformPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -24739,14 +25567,14 @@ func newPersonalAccessTokenPager(opts []PersonalAccessTokenPaginateOption, rever
 	return pager, nil
 }
 
-func (p *personalaccesstokenPager) applyFilter(query *PersonalAccessTokenQuery) (*PersonalAccessTokenQuery, error) {
+func (p *platformPager) applyFilter(query *PlatformQuery) (*PlatformQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *personalaccesstokenPager) toCursor(_m *PersonalAccessToken) Cursor {
+func (p *platformPager) toCursor(_m *Platform) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -24754,7 +25582,7 @@ func (p *personalaccesstokenPager) toCursor(_m *PersonalAccessToken) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *personalaccesstokenPager) applyCursors(query *PersonalAccessTokenQuery, after, before *Cursor) (*PersonalAccessTokenQuery, error) {
+func (p *platformPager) applyCursors(query *PlatformQuery, after, before *Cursor) (*PlatformQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -24769,7 +25597,7 @@ func (p *personalaccesstokenPager) applyCursors(query *PersonalAccessTokenQuery,
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultPersonalAccessTokenOrder.Field.column,
+		FieldID:     DefaultPlatformOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -24788,7 +25616,7 @@ func (p *personalaccesstokenPager) applyCursors(query *PersonalAccessTokenQuery,
 	return query, nil
 }
 
-func (p *personalaccesstokenPager) applyOrder(query *PersonalAccessTokenQuery) *PersonalAccessTokenQuery {
+func (p *platformPager) applyOrder(query *PlatformQuery) *PlatformQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -24796,7 +25624,7 @@ func (p *personalaccesstokenPager) applyOrder(query *PersonalAccessTokenQuery) *
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultPersonalAccessTokenOrder.Field.column {
+		if o.Field.column == DefaultPlatformOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -24808,12 +25636,12 @@ func (p *personalaccesstokenPager) applyOrder(query *PersonalAccessTokenQuery) *
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultPersonalAccessTokenOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultPlatformOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *personalaccesstokenPager) orderExpr(query *PersonalAccessTokenQuery) sql.Querier {
+func (p *platformPager) orderExpr(query *PlatformQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -24832,26 +25660,26 @@ func (p *personalaccesstokenPager) orderExpr(query *PersonalAccessTokenQuery) sq
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultPersonalAccessTokenOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultPlatformOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to PersonalAccessToken.
-func (_m *PersonalAccessTokenQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Platform.
+func (_m *PlatformQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...PersonalAccessTokenPaginateOption,
-) (*PersonalAccessTokenConnection, error) {
+	before *Cursor, last *int, opts ...PlatformPaginateOption,
+) (*PlatformConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newPersonalAccessTokenPager(opts, last != nil)
+	pager, err := newPlatformPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &PersonalAccessTokenConnection{Edges: []*PersonalAccessTokenEdge{}}
+	conn := &PlatformConnection{Edges: []*PlatformEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -24875,106 +25703,261 @@ func (_m *PersonalAccessTokenQuery) Paginate(
 	if limit != 0 {
 		_m.Limit(limit)
 	}
-	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
-			return nil, err
-		}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// PlatformOrderFieldCreatedAt orders Platform by created_at.
+	PlatformOrderFieldCreatedAt = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: platform.FieldCreatedAt,
+		toTerm: platform.ByCreatedAt,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// PlatformOrderFieldUpdatedAt orders Platform by updated_at.
+	PlatformOrderFieldUpdatedAt = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: platform.FieldUpdatedAt,
+		toTerm: platform.ByUpdatedAt,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+	// PlatformOrderFieldInternalOwner orders Platform by internal_owner.
+	PlatformOrderFieldInternalOwner = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.InternalOwner, nil
+		},
+		column: platform.FieldInternalOwner,
+		toTerm: platform.ByInternalOwner,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.InternalOwner,
+			}
+		},
+	}
+	// PlatformOrderFieldBusinessOwner orders Platform by business_owner.
+	PlatformOrderFieldBusinessOwner = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.BusinessOwner, nil
+		},
+		column: platform.FieldBusinessOwner,
+		toTerm: platform.ByBusinessOwner,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.BusinessOwner,
+			}
+		},
+	}
+	// PlatformOrderFieldTechnicalOwner orders Platform by technical_owner.
+	PlatformOrderFieldTechnicalOwner = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.TechnicalOwner, nil
+		},
+		column: platform.FieldTechnicalOwner,
+		toTerm: platform.ByTechnicalOwner,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.TechnicalOwner,
+			}
+		},
+	}
+	// PlatformOrderFieldSecurityOwner orders Platform by security_owner.
+	PlatformOrderFieldSecurityOwner = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.SecurityOwner, nil
+		},
+		column: platform.FieldSecurityOwner,
+		toTerm: platform.BySecurityOwner,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.SecurityOwner,
+			}
+		},
+	}
+	// PlatformOrderFieldName orders Platform by name.
+	PlatformOrderFieldName = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: platform.FieldName,
+		toTerm: platform.ByName,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
+	// PlatformOrderFieldBusinessPurpose orders Platform by business_purpose.
+	PlatformOrderFieldBusinessPurpose = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.BusinessPurpose, nil
+		},
+		column: platform.FieldBusinessPurpose,
+		toTerm: platform.ByBusinessPurpose,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.BusinessPurpose,
+			}
+		},
+	}
+	// PlatformOrderFieldStatus orders Platform by status.
+	PlatformOrderFieldStatus = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.Status, nil
+		},
+		column: platform.FieldStatus,
+		toTerm: platform.ByStatus,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Status,
+			}
+		},
 	}
-	_m = pager.applyOrder(_m)
-	nodes, err := _m.All(ctx)
-	if err != nil {
-		return nil, err
+	// PlatformOrderFieldPhysicalLocation orders Platform by physical_location.
+	PlatformOrderFieldPhysicalLocation = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.PhysicalLocation, nil
+		},
+		column: platform.FieldPhysicalLocation,
+		toTerm: platform.ByPhysicalLocation,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.PhysicalLocation,
+			}
+		},
 	}
-	conn.build(nodes, pager, after, first, before, last)
-	return conn, nil
-}
-
-var (
-	// PersonalAccessTokenOrderFieldCreatedAt orders PersonalAccessToken by created_at.
-	PersonalAccessTokenOrderFieldCreatedAt = &PersonalAccessTokenOrderField{
-		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
-			return _m.CreatedAt, nil
+	// PlatformOrderFieldRegion orders Platform by region.
+	PlatformOrderFieldRegion = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.Region, nil
 		},
-		column: personalaccesstoken.FieldCreatedAt,
-		toTerm: personalaccesstoken.ByCreatedAt,
-		toCursor: func(_m *PersonalAccessToken) Cursor {
+		column: platform.FieldRegion,
+		toTerm: platform.ByRegion,
+		toCursor: func(_m *Platform) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.CreatedAt,
+				Value: _m.Region,
 			}
 		},
 	}
-	// PersonalAccessTokenOrderFieldUpdatedAt orders PersonalAccessToken by updated_at.
-	PersonalAccessTokenOrderFieldUpdatedAt = &PersonalAccessTokenOrderField{
-		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
-			return _m.UpdatedAt, nil
+	// PlatformOrderFieldContainsPii orders Platform by contains_pii.
+	PlatformOrderFieldContainsPii = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.ContainsPii, nil
 		},
-		column: personalaccesstoken.FieldUpdatedAt,
-		toTerm: personalaccesstoken.ByUpdatedAt,
-		toCursor: func(_m *PersonalAccessToken) Cursor {
+		column: platform.FieldContainsPii,
+		toTerm: platform.ByContainsPii,
+		toCursor: func(_m *Platform) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.UpdatedAt,
+				Value: _m.ContainsPii,
 			}
 		},
 	}
-	// PersonalAccessTokenOrderFieldName orders PersonalAccessToken by name.
-	PersonalAccessTokenOrderFieldName = &PersonalAccessTokenOrderField{
-		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
-			return _m.Name, nil
+	// PlatformOrderFieldSourceType orders Platform by source_type.
+	PlatformOrderFieldSourceType = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.SourceType, nil
 		},
-		column: personalaccesstoken.FieldName,
-		toTerm: personalaccesstoken.ByName,
-		toCursor: func(_m *PersonalAccessToken) Cursor {
+		column: platform.FieldSourceType,
+		toTerm: platform.BySourceType,
+		toCursor: func(_m *Platform) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Name,
+				Value: _m.SourceType,
 			}
 		},
 	}
-	// PersonalAccessTokenOrderFieldExpiresAt orders PersonalAccessToken by expires_at.
-	PersonalAccessTokenOrderFieldExpiresAt = &PersonalAccessTokenOrderField{
-		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.ExpiresAt == nil {
-				return nil, nil
+	// PlatformOrderFieldSourceIdentifier orders Platform by source_identifier.
+	PlatformOrderFieldSourceIdentifier = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.SourceIdentifier, nil
+		},
+		column: platform.FieldSourceIdentifier,
+		toTerm: platform.BySourceIdentifier,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.SourceIdentifier,
 			}
-			return _m.ExpiresAt, nil
 		},
-		column: personalaccesstoken.FieldExpiresAt,
-		toTerm: func(opts ...sql.OrderTermOption) personalaccesstoken.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return personalaccesstoken.ByExpiresAt(opts...)
+	}
+	// PlatformOrderFieldCostCenter orders Platform by cost_center.
+	PlatformOrderFieldCostCenter = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.CostCenter, nil
 		},
-		toCursor: func(_m *PersonalAccessToken) Cursor {
-			if _m.ExpiresAt == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
+		column: platform.FieldCostCenter,
+		toTerm: platform.ByCostCenter,
+		toCursor: func(_m *Platform) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CostCenter,
 			}
+		},
+	}
+	// PlatformOrderFieldEstimatedMonthlyCost orders Platform by estimated_monthly_cost.
+	PlatformOrderFieldEstimatedMonthlyCost = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.EstimatedMonthlyCost, nil
+		},
+		column: platform.FieldEstimatedMonthlyCost,
+		toTerm: platform.ByEstimatedMonthlyCost,
+		toCursor: func(_m *Platform) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ExpiresAt,
+				Value: _m.EstimatedMonthlyCost,
 			}
 		},
 	}
-	// PersonalAccessTokenOrderFieldLastUsedAt orders PersonalAccessToken by last_used_at.
-	PersonalAccessTokenOrderFieldLastUsedAt = &PersonalAccessTokenOrderField{
-		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
+	// PlatformOrderFieldPurchaseDate orders Platform by purchase_date.
+	PlatformOrderFieldPurchaseDate = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
 			// allow for nil values for fields
-			if _m.LastUsedAt == nil {
+			if _m.PurchaseDate == nil {
 				return nil, nil
 			}
-			return _m.LastUsedAt, nil
+			return _m.PurchaseDate, nil
 		},
-		column: personalaccesstoken.FieldLastUsedAt,
-		toTerm: func(opts ...sql.OrderTermOption) personalaccesstoken.OrderOption {
+		column: platform.FieldPurchaseDate,
+		toTerm: func(opts ...sql.OrderTermOption) platform.OrderOption {
 			opts = append(opts, sql.OrderNullsLast())
-			return personalaccesstoken.ByLastUsedAt(opts...)
+			return platform.ByPurchaseDate(opts...)
 		},
-		toCursor: func(_m *PersonalAccessToken) Cursor {
-			if _m.LastUsedAt == nil {
+		toCursor: func(_m *Platform) Cursor {
+			if _m.PurchaseDate == nil {
 				return Cursor{
 					ID:    _m.ID,
 					Value: nil, // handle nil values for fields
@@ -24982,131 +25965,179 @@ var (
 			}
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.LastUsedAt,
+				Value: _m.PurchaseDate,
 			}
 		},
 	}
-	// PersonalAccessTokenOrderFieldIsActive orders PersonalAccessToken by is_active.
-	PersonalAccessTokenOrderFieldIsActive = &PersonalAccessTokenOrderField{
-		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
-			return _m.IsActive, nil
+	// PlatformOrderFieldExternalReferenceID orders Platform by external_reference_id.
+	PlatformOrderFieldExternalReferenceID = &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
+			return _m.ExternalReferenceID, nil
 		},
-		column: personalaccesstoken.FieldIsActive,
-		toTerm: personalaccesstoken.ByIsActive,
-		toCursor: func(_m *PersonalAccessToken) Cursor {
+		column: platform.FieldExternalReferenceID,
+		toTerm: platform.ByExternalReferenceID,
+		toCursor: func(_m *Platform) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.IsActive,
+				Value: _m.ExternalReferenceID,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f PersonalAccessTokenOrderField) String() string {
+func (f PlatformOrderField) String() string {
 	var str string
 	switch f.column {
-	case PersonalAccessTokenOrderFieldCreatedAt.column:
+	case PlatformOrderFieldCreatedAt.column:
 		str = "created_at"
-	case PersonalAccessTokenOrderFieldUpdatedAt.column:
+	case PlatformOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case PersonalAccessTokenOrderFieldName.column:
+	case PlatformOrderFieldInternalOwner.column:
+		str = "internal_owner"
+	case PlatformOrderFieldBusinessOwner.column:
+		str = "business_owner"
+	case PlatformOrderFieldTechnicalOwner.column:
+		str = "technical_owner"
+	case PlatformOrderFieldSecurityOwner.column:
+		str = "security_owner"
+	case PlatformOrderFieldName.column:
 		str = "name"
-	case PersonalAccessTokenOrderFieldExpiresAt.column:
-		str = "expires_at"
-	case PersonalAccessTokenOrderFieldLastUsedAt.column:
-		str = "last_used_at"
-	case PersonalAccessTokenOrderFieldIsActive.column:
-		str = "is_active"
+	case PlatformOrderFieldBusinessPurpose.column:
+		str = "business_purpose"
+	case PlatformOrderFieldStatus.column:
+		str = "STATUS"
+	case PlatformOrderFieldPhysicalLocation.column:
+		str = "physical_location"
+	case PlatformOrderFieldRegion.column:
+		str = "region"
+	case PlatformOrderFieldContainsPii.column:
+		str = "contains_pii"
+	case PlatformOrderFieldSourceType.column:
+		str = "SOURCE_TYPE"
+	case PlatformOrderFieldSourceIdentifier.column:
+		str = "source_identifier"
+	case PlatformOrderFieldCostCenter.column:
+		str = "cost_center"
+	case PlatformOrderFieldEstimatedMonthlyCost.column:
+		str = "estimated_monthly_cost"
+	case PlatformOrderFieldPurchaseDate.column:
+		str = "purchase_date"
+	case PlatformOrderFieldExternalReferenceID.column:
+		str = "external_reference_id"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f PersonalAccessTokenOrderField) MarshalGQL(w io.Writer) {
+func (f PlatformOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *PersonalAccessTokenOrderField) UnmarshalGQL(v interface{}) error {
+func (f *PlatformOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("PersonalAccessTokenOrderField %T must be a string", v)
+		return fmt.Errorf("PlatformOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *PersonalAccessTokenOrderFieldCreatedAt
+		*f = *PlatformOrderFieldCreatedAt
 	case "updated_at":
-		*f = *PersonalAccessTokenOrderFieldUpdatedAt
+		*f = *PlatformOrderFieldUpdatedAt
+	case "internal_owner":
+		*f = *PlatformOrderFieldInternalOwner
+	case "business_owner":
+		*f = *PlatformOrderFieldBusinessOwner
+	case "technical_owner":
+		*f = *PlatformOrderFieldTechnicalOwner
+	case "security_owner":
+		*f = *PlatformOrderFieldSecurityOwner
 	case "name":
-		*f = *PersonalAccessTokenOrderFieldName
-	case "expires_at":
-		*f = *PersonalAccessTokenOrderFieldExpiresAt
-	case "last_used_at":
-		*f = *PersonalAccessTokenOrderFieldLastUsedAt
-	case "is_active":
-		*f = *PersonalAccessTokenOrderFieldIsActive
+		*f = *PlatformOrderFieldName
+	case "business_purpose":
+		*f = *PlatformOrderFieldBusinessPurpose
+	case "STATUS":
+		*f = *PlatformOrderFieldStatus
+	case "physical_location":
+		*f = *PlatformOrderFieldPhysicalLocation
+	case "region":
+		*f = *PlatformOrderFieldRegion
+	case "contains_pii":
+		*f = *PlatformOrderFieldContainsPii
+	case "SOURCE_TYPE":
+		*f = *PlatformOrderFieldSourceType
+	case "source_identifier":
+		*f = *PlatformOrderFieldSourceIdentifier
+	case "cost_center":
+		*f = *PlatformOrderFieldCostCenter
+	case "estimated_monthly_cost":
+		*f = *PlatformOrderFieldEstimatedMonthlyCost
+	case "purchase_date":
+		*f = *PlatformOrderFieldPurchaseDate
+	case "external_reference_id":
+		*f = *PlatformOrderFieldExternalReferenceID
 	default:
-		return fmt.Errorf("%s is not a valid PersonalAccessTokenOrderField", str)
+		return fmt.Errorf("%s is not a valid PlatformOrderField", str)
 	}
 	return nil
 }
 
-// PersonalAccessTokenOrderField defines the ordering field of PersonalAccessToken.
-type PersonalAccessTokenOrderField struct {
-	// Value extracts the ordering value from the given PersonalAccessToken.
-	Value    func(*PersonalAccessToken) (ent.Value, error)
+// PlatformOrderField defines the ordering field of Platform.
+type PlatformOrderField struct {
+	// Value extracts the ordering value from the given Platform.
+	Value    func(*Platform) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) personalaccesstoken.OrderOption
-	toCursor func(*PersonalAccessToken) Cursor
+	toTerm   func(...sql.OrderTermOption) platform.OrderOption
+	toCursor func(*Platform) Cursor
 }
 
-// PersonalAccessTokenOrder defines the ordering of PersonalAccessToken.
-type PersonalAccessTokenOrder struct {
-	Direction OrderDirection                 `json:"direction"`
-	Field     *PersonalAccessTokenOrderField `json:"field"`
+// PlatformOrder defines the ordering of Platform.
+type PlatformOrder struct {
+	Direction OrderDirection      `json:"direction"`
+	Field     *PlatformOrderField `json:"field"`
 }
 
-// DefaultPersonalAccessTokenOrder is the default ordering of PersonalAccessToken.
-var DefaultPersonalAccessTokenOrder = &PersonalAccessTokenOrder{
+// DefaultPlatformOrder is the default ordering of Platform.
+var DefaultPlatformOrder = &PlatformOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &PersonalAccessTokenOrderField{
-		Value: func(_m *PersonalAccessToken) (ent.Value, error) {
+	Field: &PlatformOrderField{
+		Value: func(_m *Platform) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: personalaccesstoken.FieldID,
-		toTerm: personalaccesstoken.ByID,
-		toCursor: func(_m *PersonalAccessToken) Cursor {
+		column: platform.FieldID,
+		toTerm: platform.ByID,
+		toCursor: func(_m *Platform) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts PersonalAccessToken into PersonalAccessTokenEdge.
-func (_m *PersonalAccessToken) ToEdge(order *PersonalAccessTokenOrder) *PersonalAccessTokenEdge {
+// ToEdge converts Platform into PlatformEdge.
+func (_m *Platform) ToEdge(order *PlatformOrder) *PlatformEdge {
 	if order == nil {
-		order = DefaultPersonalAccessTokenOrder
+		order = DefaultPlatformOrder
 	}
-	return &PersonalAccessTokenEdge{
+	return &PlatformEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// PlatformEdge is the edge representation of Platform.
-type PlatformEdge struct {
-	Node   *Platform `json:"node"`
-	Cursor Cursor    `json:"cursor"`
+// ProcedureEdge is the edge representation of Procedure.
+type ProcedureEdge struct {
+	Node   *Procedure `json:"node"`
+	Cursor Cursor     `json:"cursor"`
 }
 
-// PlatformConnection is the connection containing edges to Platform.
-type PlatformConnection struct {
-	Edges      []*PlatformEdge `json:"edges"`
-	PageInfo   PageInfo        `json:"pageInfo"`
-	TotalCount int             `json:"totalCount"`
+// ProcedureConnection is the connection containing edges to Procedure.
+type ProcedureConnection struct {
+	Edges      []*ProcedureEdge `json:"edges"`
+	PageInfo   PageInfo         `json:"pageInfo"`
+	TotalCount int              `json:"totalCount"`
 }
 
-func (c *PlatformConnection) build(nodes []*Platform, pager *platformPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProcedureConnection) build(nodes []*Procedure, pager *procedurePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -25116,21 +26147,21 @@ func (c *PlatformConnection) build(nodes []*Platform, pager *platformPager, afte
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *Platform
+	var nodeAt func(int) *Procedure
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Platform {
+		nodeAt = func(i int) *Procedure {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Platform {
+		nodeAt = func(i int) *Procedure {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*PlatformEdge, len(nodes))
+	c.Edges = make([]*ProcedureEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &PlatformEdge{
+		c.Edges[i] = &ProcedureEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -25144,12 +26175,12 @@ func (c *PlatformConnection) build(nodes []*Platform, pager *platformPager, afte
 	}
 }
 
-// PlatformPaginateOption enables pagination customization.
-type PlatformPaginateOption func(*platformPager) error
+// ProcedurePaginateOption enables pagination customization.
+type ProcedurePaginateOption func(*procedurePager) error
 
-// WithPlatformOrder configures pagination ordering.
-func WithPlatformOrder(order []*PlatformOrder) PlatformPaginateOption {
-	return func(pager *platformPager) error {
+// WithProcedureOrder configures pagination ordering.
+func WithProcedureOrder(order []*ProcedureOrder) ProcedurePaginateOption {
+	return func(pager *procedurePager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -25160,25 +26191,25 @@ func WithPlatformOrder(order []*PlatformOrder) PlatformPaginateOption {
 	}
 }
 
-// WithPlatformFilter configures pagination filter.
-func WithPlatformFilter(filter func(*PlatformQuery) (*PlatformQuery, error)) PlatformPaginateOption {
-	return func(pager *platformPager) error {
+// WithProcedureFilter configures pagination filter.
+func WithProcedureFilter(filter func(*ProcedureQuery) (*ProcedureQuery, error)) ProcedurePaginateOption {
+	return func(pager *procedurePager) error {
 		if filter == nil {
-			return errors.New("PlatformQuery filter cannot be nil")
+			return errors.New("ProcedureQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type platformPager struct {
+type procedurePager struct {
 	reverse bool
-	order   []*PlatformOrder
-	filter  func(*PlatformQuery) (*PlatformQuery, error)
+	order   []*ProcedureOrder
+	filter  func(*ProcedureQuery) (*ProcedureQuery, error)
 }
 
-func newPlatformPager(opts []PlatformPaginateOption, reverse bool) (*platformPager, error) {
-	pager := &platformPager{reverse: reverse}
+func newProcedurePager(opts []ProcedurePaginateOption, reverse bool) (*procedurePager, error) {
+	pager := &procedurePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -25192,14 +26223,14 @@ func newPlatformPager(opts []PlatformPaginateOption, reverse bool) (*platformPag
 	return pager, nil
 }
 
-func (p *platformPager) applyFilter(query *PlatformQuery) (*PlatformQuery, error) {
+func (p *procedurePager) applyFilter(query *ProcedureQuery) (*ProcedureQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *platformPager) toCursor(_m *Platform) Cursor {
+func (p *procedurePager) toCursor(_m *Procedure) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -25207,7 +26238,7 @@ func (p *platformPager) toCursor(_m *Platform) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *platformPager) applyCursors(query *PlatformQuery, after, before *Cursor) (*PlatformQuery, error) {
+func (p *procedurePager) applyCursors(query *ProcedureQuery, after, before *Cursor) (*ProcedureQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -25222,7 +26253,7 @@ func (p *platformPager) applyCursors(query *PlatformQuery, after, before *Cursor
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultPlatformOrder.Field.column,
+		FieldID:     DefaultProcedureOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -25241,7 +26272,7 @@ func (p *platformPager) applyCursors(query *PlatformQuery, after, before *Cursor
 	return query, nil
 }
 
-func (p *platformPager) applyOrder(query *PlatformQuery) *PlatformQuery {
+func (p *procedurePager) applyOrder(query *ProcedureQuery) *ProcedureQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -25249,7 +26280,7 @@ func (p *platformPager) applyOrder(query *PlatformQuery) *PlatformQuery {
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultPlatformOrder.Field.column {
+		if o.Field.column == DefaultProcedureOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -25261,12 +26292,12 @@ func (p *platformPager) applyOrder(query *PlatformQuery) *PlatformQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultPlatformOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultProcedureOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *platformPager) orderExpr(query *PlatformQuery) sql.Querier {
+func (p *procedurePager) orderExpr(query *ProcedureQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -25285,484 +26316,291 @@ func (p *platformPager) orderExpr(query *PlatformQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultPlatformOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultProcedureOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Platform.
-func (_m *PlatformQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Procedure.
+func (_m *ProcedureQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...PlatformPaginateOption,
-) (*PlatformConnection, error) {
+	before *Cursor, last *int, opts ...ProcedurePaginateOption,
+) (*ProcedureConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newPlatformPager(opts, last != nil)
-	if err != nil {
-		return nil, err
-	}
-	if _m, err = pager.applyFilter(_m); err != nil {
-		return nil, err
-	}
-	conn := &PlatformConnection{Edges: []*PlatformEdge{}}
-	ignoredEdges := !hasCollectedField(ctx, edgesField)
-	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
-		hasPagination := after != nil || first != nil || before != nil || last != nil
-		if hasPagination || ignoredEdges {
-			c := _m.Clone()
-			c.ctx.Fields = nil
-			if conn.TotalCount, err = c.CountIDs(ctx); err != nil {
-				return nil, err
-			}
-			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
-			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
-		}
-	}
-	if (first != nil && *first == 0) || (last != nil && *last == 0) {
-		return conn, nil
-	}
-	if _m, err = pager.applyCursors(_m, after, before); err != nil {
-		return nil, err
-	}
-	limit := paginateLimit(first, last)
-	if limit != 0 {
-		_m.Limit(limit)
-	}
-	if field := collectedField(ctx, edgesField, nodeField); field != nil {
-		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
-			return nil, err
-		}
-	}
-	_m = pager.applyOrder(_m)
-	nodes, err := _m.All(ctx)
+	pager, err := newProcedurePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
-	conn.build(nodes, pager, after, first, before, last)
-	return conn, nil
-}
-
-var (
-	// PlatformOrderFieldCreatedAt orders Platform by created_at.
-	PlatformOrderFieldCreatedAt = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.CreatedAt, nil
-		},
-		column: platform.FieldCreatedAt,
-		toTerm: platform.ByCreatedAt,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.CreatedAt,
-			}
-		},
-	}
-	// PlatformOrderFieldUpdatedAt orders Platform by updated_at.
-	PlatformOrderFieldUpdatedAt = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.UpdatedAt, nil
-		},
-		column: platform.FieldUpdatedAt,
-		toTerm: platform.ByUpdatedAt,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.UpdatedAt,
-			}
-		},
-	}
-	// PlatformOrderFieldInternalOwner orders Platform by internal_owner.
-	PlatformOrderFieldInternalOwner = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.InternalOwner, nil
-		},
-		column: platform.FieldInternalOwner,
-		toTerm: platform.ByInternalOwner,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.InternalOwner,
-			}
-		},
-	}
-	// PlatformOrderFieldBusinessOwner orders Platform by business_owner.
-	PlatformOrderFieldBusinessOwner = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.BusinessOwner, nil
-		},
-		column: platform.FieldBusinessOwner,
-		toTerm: platform.ByBusinessOwner,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.BusinessOwner,
-			}
-		},
-	}
-	// PlatformOrderFieldTechnicalOwner orders Platform by technical_owner.
-	PlatformOrderFieldTechnicalOwner = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.TechnicalOwner, nil
-		},
-		column: platform.FieldTechnicalOwner,
-		toTerm: platform.ByTechnicalOwner,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.TechnicalOwner,
-			}
-		},
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
 	}
-	// PlatformOrderFieldSecurityOwner orders Platform by security_owner.
-	PlatformOrderFieldSecurityOwner = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.SecurityOwner, nil
-		},
-		column: platform.FieldSecurityOwner,
-		toTerm: platform.BySecurityOwner,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.SecurityOwner,
+	conn := &ProcedureConnection{Edges: []*ProcedureEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.CountIDs(ctx); err != nil {
+				return nil, err
 			}
-		},
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
 	}
-	// PlatformOrderFieldName orders Platform by name.
-	PlatformOrderFieldName = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.Name, nil
-		},
-		column: platform.FieldName,
-		toTerm: platform.ByName,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Name,
-			}
-		},
+	if (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
 	}
-	// PlatformOrderFieldBusinessPurpose orders Platform by business_purpose.
-	PlatformOrderFieldBusinessPurpose = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.BusinessPurpose, nil
-		},
-		column: platform.FieldBusinessPurpose,
-		toTerm: platform.ByBusinessPurpose,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.BusinessPurpose,
-			}
-		},
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
 	}
-	// PlatformOrderFieldStatus orders Platform by status.
-	PlatformOrderFieldStatus = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.Status, nil
-		},
-		column: platform.FieldStatus,
-		toTerm: platform.ByStatus,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Status,
-			}
-		},
+	limit := paginateLimitSingle(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
 	}
-	// PlatformOrderFieldPhysicalLocation orders Platform by physical_location.
-	PlatformOrderFieldPhysicalLocation = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.PhysicalLocation, nil
-		},
-		column: platform.FieldPhysicalLocation,
-		toTerm: platform.ByPhysicalLocation,
-		toCursor: func(_m *Platform) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.PhysicalLocation,
-			}
-		},
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
 	}
-	// PlatformOrderFieldRegion orders Platform by region.
-	PlatformOrderFieldRegion = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.Region, nil
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// ProcedureOrderFieldCreatedAt orders Procedure by created_at.
+	ProcedureOrderFieldCreatedAt = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.CreatedAt, nil
 		},
-		column: platform.FieldRegion,
-		toTerm: platform.ByRegion,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldCreatedAt,
+		toTerm: procedure.ByCreatedAt,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Region,
+				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// PlatformOrderFieldContainsPii orders Platform by contains_pii.
-	PlatformOrderFieldContainsPii = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.ContainsPii, nil
+	// ProcedureOrderFieldUpdatedAt orders Procedure by updated_at.
+	ProcedureOrderFieldUpdatedAt = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.UpdatedAt, nil
 		},
-		column: platform.FieldContainsPii,
-		toTerm: platform.ByContainsPii,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldUpdatedAt,
+		toTerm: procedure.ByUpdatedAt,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ContainsPii,
+				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// PlatformOrderFieldSourceType orders Platform by source_type.
-	PlatformOrderFieldSourceType = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.SourceType, nil
+	// ProcedureOrderFieldRevision orders Procedure by revision.
+	ProcedureOrderFieldRevision = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.Revision, nil
 		},
-		column: platform.FieldSourceType,
-		toTerm: platform.BySourceType,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldRevision,
+		toTerm: procedure.ByRevision,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.SourceType,
+				Value: _m.Revision,
 			}
 		},
 	}
-	// PlatformOrderFieldSourceIdentifier orders Platform by source_identifier.
-	PlatformOrderFieldSourceIdentifier = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.SourceIdentifier, nil
+	// ProcedureOrderFieldName orders Procedure by name.
+	ProcedureOrderFieldName = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.Name, nil
 		},
-		column: platform.FieldSourceIdentifier,
-		toTerm: platform.BySourceIdentifier,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldName,
+		toTerm: procedure.ByName,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.SourceIdentifier,
+				Value: _m.Name,
 			}
 		},
 	}
-	// PlatformOrderFieldCostCenter orders Platform by cost_center.
-	PlatformOrderFieldCostCenter = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.CostCenter, nil
+	// ProcedureOrderFieldStatus orders Procedure by status.
+	ProcedureOrderFieldStatus = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.Status, nil
 		},
-		column: platform.FieldCostCenter,
-		toTerm: platform.ByCostCenter,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldStatus,
+		toTerm: procedure.ByStatus,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.CostCenter,
+				Value: _m.Status,
 			}
 		},
 	}
-	// PlatformOrderFieldEstimatedMonthlyCost orders Platform by estimated_monthly_cost.
-	PlatformOrderFieldEstimatedMonthlyCost = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.EstimatedMonthlyCost, nil
+	// ProcedureOrderFieldManagementMode orders Procedure by management_mode.
+	ProcedureOrderFieldManagementMode = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.ManagementMode, nil
 		},
-		column: platform.FieldEstimatedMonthlyCost,
-		toTerm: platform.ByEstimatedMonthlyCost,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldManagementMode,
+		toTerm: procedure.ByManagementMode,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.EstimatedMonthlyCost,
+				Value: _m.ManagementMode,
 			}
 		},
 	}
-	// PlatformOrderFieldPurchaseDate orders Platform by purchase_date.
-	PlatformOrderFieldPurchaseDate = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			// allow for nil values for fields
-			if _m.PurchaseDate == nil {
-				return nil, nil
-			}
-			return _m.PurchaseDate, nil
-		},
-		column: platform.FieldPurchaseDate,
-		toTerm: func(opts ...sql.OrderTermOption) platform.OrderOption {
-			opts = append(opts, sql.OrderNullsLast())
-			return platform.ByPurchaseDate(opts...)
+	// ProcedureOrderFieldReviewDue orders Procedure by review_due.
+	ProcedureOrderFieldReviewDue = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.ReviewDue, nil
 		},
-		toCursor: func(_m *Platform) Cursor {
-			if _m.PurchaseDate == nil {
-				return Cursor{
-					ID:    _m.ID,
-					Value: nil, // handle nil values for fields
-				}
-			}
+		column: procedure.FieldReviewDue,
+		toTerm: procedure.ByReviewDue,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.PurchaseDate,
+				Value: _m.ReviewDue,
 			}
 		},
 	}
-	// PlatformOrderFieldExternalReferenceID orders Platform by external_reference_id.
-	PlatformOrderFieldExternalReferenceID = &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
-			return _m.ExternalReferenceID, nil
+	// ProcedureOrderFieldReviewFrequency orders Procedure by review_frequency.
+	ProcedureOrderFieldReviewFrequency = &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
+			return _m.ReviewFrequency, nil
 		},
-		column: platform.FieldExternalReferenceID,
-		toTerm: platform.ByExternalReferenceID,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldReviewFrequency,
+		toTerm: procedure.ByReviewFrequency,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ExternalReferenceID,
+				Value: _m.ReviewFrequency,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f PlatformOrderField) String() string {
+func (f ProcedureOrderField) String() string {
 	var str string
 	switch f.column {
-	case PlatformOrderFieldCreatedAt.column:
+	case ProcedureOrderFieldCreatedAt.column:
 		str = "created_at"
-	case PlatformOrderFieldUpdatedAt.column:
-		str = "updated_at"
-	case PlatformOrderFieldInternalOwner.column:
-		str = "internal_owner"
-	case PlatformOrderFieldBusinessOwner.column:
-		str = "business_owner"
-	case PlatformOrderFieldTechnicalOwner.column:
-		str = "technical_owner"
-	case PlatformOrderFieldSecurityOwner.column:
-		str = "security_owner"
-	case PlatformOrderFieldName.column:
+	case ProcedureOrderFieldUpdatedAt.column:
+		str = "updated_at"
+	case ProcedureOrderFieldRevision.column:
+		str = "revision"
+	case ProcedureOrderFieldName.column:
 		str = "name"
-	case PlatformOrderFieldBusinessPurpose.column:
-		str = "business_purpose"
-	case PlatformOrderFieldStatus.column:
+	case ProcedureOrderFieldStatus.column:
 		str = "STATUS"
-	case PlatformOrderFieldPhysicalLocation.column:
-		str = "physical_location"
-	case PlatformOrderFieldRegion.column:
-		str = "region"
-	case PlatformOrderFieldContainsPii.column:
-		str = "contains_pii"
-	case PlatformOrderFieldSourceType.column:
-		str = "SOURCE_TYPE"
-	case PlatformOrderFieldSourceIdentifier.column:
-		str = "source_identifier"
-	case PlatformOrderFieldCostCenter.column:
-		str = "cost_center"
-	case PlatformOrderFieldEstimatedMonthlyCost.column:
-		str = "estimated_monthly_cost"
-	case PlatformOrderFieldPurchaseDate.column:
-		str = "purchase_date"
-	case PlatformOrderFieldExternalReferenceID.column:
-		str = "external_reference_id"
+	case ProcedureOrderFieldManagementMode.column:
+		str = "MANAGEMENT_MODE"
+	case ProcedureOrderFieldReviewDue.column:
+		str = "review_due"
+	case ProcedureOrderFieldReviewFrequency.column:
+		str = "REVIEW_FREQUENCY"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f PlatformOrderField) MarshalGQL(w io.Writer) {
+func (f ProcedureOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *PlatformOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ProcedureOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("PlatformOrderField %T must be a string", v)
+		return fmt.Errorf("ProcedureOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *PlatformOrderFieldCreatedAt
+		*f = *ProcedureOrderFieldCreatedAt
 	case "updated_at":
-		*f = *PlatformOrderFieldUpdatedAt
-	case "internal_owner":
-		*f = *PlatformOrderFieldInternalOwner
-	case "business_owner":
-		*f = *PlatformOrderFieldBusinessOwner
-	case "technical_owner":
-		*f = *PlatformOrderFieldTechnicalOwner
-	case "security_owner":
-		*f = *PlatformOrderFieldSecurityOwner
+		*f = *ProcedureOrderFieldUpdatedAt
+	case "revision":
+		*f = *ProcedureOrderFieldRevision
 	case "name":
-		*f = *PlatformOrderFieldName
-	case "business_purpose":
-		*f = *PlatformOrderFieldBusinessPurpose
+		*f = *ProcedureOrderFieldName
 	case "STATUS":
-		*f = *PlatformOrderFieldStatus
-	case "physical_location":
-		*f = *PlatformOrderFieldPhysicalLocation
-	case "region":
-		*f = *PlatformOrderFieldRegion
-	case "contains_pii":
-		*f = *PlatformOrderFieldContainsPii
-	case "SOURCE_TYPE":
-		*f = *PlatformOrderFieldSourceType
-	case "source_identifier":
-		*f = *PlatformOrderFieldSourceIdentifier
-	case "cost_center":
-		*f = *PlatformOrderFieldCostCenter
-	case "estimated_monthly_cost":
-		*f = *PlatformOrderFieldEstimatedMonthlyCost
-	case "purchase_date":
-		*f = *PlatformOrderFieldPurchaseDate
-	case "external_reference_id":
-		*f = *PlatformOrderFieldExternalReferenceID
+		*f = *ProcedureOrderFieldStatus
+	case "MANAGEMENT_MODE":
+		*f = *ProcedureOrderFieldManagementMode
+	case "review_due":
+		*f = *ProcedureOrderFieldReviewDue
+	case "REVIEW_FREQUENCY":
+		*f = *ProcedureOrderFieldReviewFrequency
 	default:
-		return fmt.Errorf("%s is not a valid PlatformOrderField", str)
+		return fmt.Errorf("%s is not a valid ProcedureOrderField", str)
 	}
 	return nil
 }
 
-// PlatformOrderField defines the ordering field of Platform.
-type PlatformOrderField struct {
-	// Value extracts the ordering value from the given Platform.
-	Value    func(*Platform) (ent.Value, error)
+// ProcedureOrderField defines the ordering field of Procedure.
+type ProcedureOrderField struct {
+	// Value extracts the ordering value from the given Procedure.
+	Value    func(*Procedure) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) platform.OrderOption
-	toCursor func(*Platform) Cursor
+	toTerm   func(...sql.OrderTermOption) procedure.OrderOption
+	toCursor func(*Procedure) Cursor
 }
 
-// PlatformOrder defines the ordering of Platform.
-type PlatformOrder struct {
-	Direction OrderDirection      `json:"direction"`
-	Field     *PlatformOrderField `json:"field"`
+// ProcedureOrder defines the ordering of Procedure.
+type ProcedureOrder struct {
+	Direction OrderDirection       `json:"direction"`
+	Field     *ProcedureOrderField `json:"field"`
 }
 
-// DefaultPlatformOrder is the default ordering of Platform.
-var DefaultPlatformOrder = &PlatformOrder{
+// DefaultProcedureOrder is the default ordering of Procedure.
+var DefaultProcedureOrder = &ProcedureOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &PlatformOrderField{
-		Value: func(_m *Platform) (ent.Value, error) {
+	Field: &ProcedureOrderField{
+		Value: func(_m *Procedure) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: platform.FieldID,
-		toTerm: platform.ByID,
-		toCursor: func(_m *Platform) Cursor {
+		column: procedure.FieldID,
+		toTerm: procedure.ByID,
+		toCursor: func(_m *Procedure) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Platform into PlatformEdge.
-func (_m *Platform) ToEdge(order *PlatformOrder) *PlatformEdge {
+// ToEdge converts Procedure into ProcedureEdge.
+func (_m *Procedure) ToEdge(order *ProcedureOrder) *ProcedureEdge {
 	if order == nil {
-		order = DefaultPlatformOrder
+		order = DefaultProcedureOrder
 	}
-	return &PlatformEdge{
+	return &ProcedureEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ProcedureEdge is the edge representation of Procedure.
-type ProcedureEdge struct {
-	Node   *Procedure `json:"node"`
-	Cursor Cursor     `json:"cursor"`
+// ProgramEdge is the edge representation of Program.
+type ProgramEdge struct {
+	Node   *Program `json:"node"`
+	Cursor Cursor   `json:"cursor"`
 }
 
-// ProcedureConnection is the connection containing edges to Procedure.
-type ProcedureConnection struct {
-	Edges      []*ProcedureEdge `json:"edges"`
-	PageInfo   PageInfo         `json:"pageInfo"`
-	TotalCount int              `json:"totalCount"`
+// ProgramConnection is the connection containing edges to Program.
+type ProgramConnection struct {
+	Edges      []*ProgramEdge `json:"edges"`
+	PageInfo   PageInfo       `json:"pageInfo"`
+	TotalCount int            `json:"totalCount"`
 }
 
-func (c *ProcedureConnection) build(nodes []*Procedure, pager *procedurePager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProgramConnection) build(nodes []*Program, pager *programPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -25772,21 +26610,21 @@ func (c *ProcedureConnection) build(nodes []*Procedure, pager *procedurePager, a
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *Procedure
+	var nodeAt func(int) *Program
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Procedure {
+		nodeAt = func(i int) *Program {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Procedure {
+		nodeAt = func(i int) *Program {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProcedureEdge, len(nodes))
+	c.Edges = make([]*ProgramEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProcedureEdge{
+		c.Edges[i] = &ProgramEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -25800,12 +26638,12 @@ func (c *ProcedureConnection) build(nodes []*Procedure, pager *procedurePager, a
 	}
 }
 
-// ProcedurePaginateOption enables pagination customization.
-type ProcedurePaginateOption func(*procedurePager) error
+// ProgramPaginateOption enables pagination customization.
+type ProgramPaginateOption func(*programPager) error
 
-// WithProcedureOrder configures pagination ordering.
-func WithProcedureOrder(order []*ProcedureOrder) ProcedurePaginateOption {
-	return func(pager *procedurePager) error {
+// WithProgramOrder configures pagination ordering.
+func WithProgramOrder(order []*ProgramOrder) ProgramPaginateOption {
+	return func(pager *programPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -25816,25 +26654,25 @@ func WithProcedureOrder(order []*ProcedureOrder) ProcedurePaginateOption {
 	}
 }
 
-// WithProcedureFilter configures pagination filter.
-func WithProcedureFilter(filter func(*ProcedureQuery) (*ProcedureQuery, error)) ProcedurePaginateOption {
-	return func(pager *procedurePager) error {
+// WithProgramFilter configures pagination filter.
+func WithProgramFilter(filter func(*ProgramQuery) (*ProgramQuery, error)) ProgramPaginateOption {
+	return func(pager *programPager) error {
 		if filter == nil {
-			return errors.New("ProcedureQuery filter cannot be nil")
+			return errors.New("ProgramQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type procedurePager struct {
+type programPager struct {
 	reverse bool
-	order   []*ProcedureOrder
-	filter  func(*ProcedureQuery) (*ProcedureQuery, error)
+	order   []*ProgramOrder
+	filter  func(*ProgramQuery) (*ProgramQuery, error)
 }
 
-func newProcedurePager(opts []ProcedurePaginateOption, reverse bool) (*procedurePager, error) {
-	pager := &procedurePager{reverse: reverse}
+func newProgramPager(opts []ProgramPaginateOption, reverse bool) (*programPager, error) {
+	pager := &programPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -25848,14 +26686,14 @@ func newProcedurePager(opts []ProcedurePaginateOption, reverse bool) (*procedure
 	return pager, nil
 }
 
-func (p *procedurePager) applyFilter(query *ProcedureQuery) (*ProcedureQuery, error) {
+func (p *programPager) applyFilter(query *ProgramQuery) (*ProgramQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *procedurePager) toCursor(_m *Procedure) Cursor {
+func (p *programPager) toCursor(_m *Program) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -25863,7 +26701,7 @@ func (p *procedurePager) toCursor(_m *Procedure) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *procedurePager) applyCursors(query *ProcedureQuery, after, before *Cursor) (*ProcedureQuery, error) {
+func (p *programPager) applyCursors(query *ProgramQuery, after, before *Cursor) (*ProgramQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -25878,7 +26716,7 @@ func (p *procedurePager) applyCursors(query *ProcedureQuery, after, before *Curs
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultProcedureOrder.Field.column,
+		FieldID:     DefaultProgramOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -25897,7 +26735,7 @@ func (p *procedurePager) applyCursors(query *ProcedureQuery, after, before *Curs
 	return query, nil
 }
 
-func (p *procedurePager) applyOrder(query *ProcedureQuery) *ProcedureQuery {
+func (p *programPager) applyOrder(query *ProgramQuery) *ProgramQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -25905,7 +26743,7 @@ func (p *procedurePager) applyOrder(query *ProcedureQuery) *ProcedureQuery {
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultProcedureOrder.Field.column {
+		if o.Field.column == DefaultProgramOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -25917,12 +26755,12 @@ func (p *procedurePager) applyOrder(query *ProcedureQuery) *ProcedureQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultProcedureOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultProgramOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *procedurePager) orderExpr(query *ProcedureQuery) sql.Querier {
+func (p *programPager) orderExpr(query *ProgramQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -25941,26 +26779,26 @@ func (p *procedurePager) orderExpr(query *ProcedureQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultProcedureOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultProgramOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Procedure.
-func (_m *ProcedureQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Program.
+func (_m *ProgramQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProcedurePaginateOption,
-) (*ProcedureConnection, error) {
+	before *Cursor, last *int, opts ...ProgramPaginateOption,
+) (*ProgramConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProcedurePager(opts, last != nil)
+	pager, err := newProgramPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ProcedureConnection{Edges: []*ProcedureEdge{}}
+	conn := &ProgramConnection{Edges: []*ProgramEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -25999,233 +26837,287 @@ func (_m *ProcedureQuery) Paginate(
 }
 
 var (
-	// ProcedureOrderFieldCreatedAt orders Procedure by created_at.
-	ProcedureOrderFieldCreatedAt = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
+	// ProgramOrderFieldCreatedAt orders Program by created_at.
+	ProgramOrderFieldCreatedAt = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: procedure.FieldCreatedAt,
-		toTerm: procedure.ByCreatedAt,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldCreatedAt,
+		toTerm: program.ByCreatedAt,
+		toCursor: func(_m *Program) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// ProgramOrderFieldUpdatedAt orders Program by updated_at.
+	ProgramOrderFieldUpdatedAt = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: program.FieldUpdatedAt,
+		toTerm: program.ByUpdatedAt,
+		toCursor: func(_m *Program) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+	// ProgramOrderFieldName orders Program by name.
+	ProgramOrderFieldName = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: program.FieldName,
+		toTerm: program.ByName,
+		toCursor: func(_m *Program) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
+	// ProgramOrderFieldStatus orders Program by status.
+	ProgramOrderFieldStatus = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.Status, nil
+		},
+		column: program.FieldStatus,
+		toTerm: program.ByStatus,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.CreatedAt,
+				Value: _m.Status,
 			}
 		},
 	}
-	// ProcedureOrderFieldUpdatedAt orders Procedure by updated_at.
-	ProcedureOrderFieldUpdatedAt = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
-			return _m.UpdatedAt, nil
+	// ProgramOrderFieldFrameworkName orders Program by framework_name.
+	ProgramOrderFieldFrameworkName = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.FrameworkName, nil
 		},
-		column: procedure.FieldUpdatedAt,
-		toTerm: procedure.ByUpdatedAt,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldFrameworkName,
+		toTerm: program.ByFrameworkName,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.UpdatedAt,
+				Value: _m.FrameworkName,
 			}
 		},
 	}
-	// ProcedureOrderFieldRevision orders Procedure by revision.
-	ProcedureOrderFieldRevision = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
-			return _m.Revision, nil
+	// ProgramOrderFieldStartDate orders Program by start_date.
+	ProgramOrderFieldStartDate = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.StartDate, nil
 		},
-		column: procedure.FieldRevision,
-		toTerm: procedure.ByRevision,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldStartDate,
+		toTerm: program.ByStartDate,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Revision,
+				Value: _m.StartDate,
 			}
 		},
 	}
-	// ProcedureOrderFieldName orders Procedure by name.
-	ProcedureOrderFieldName = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
-			return _m.Name, nil
+	// ProgramOrderFieldEndDate orders Program by end_date.
+	ProgramOrderFieldEndDate = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.EndDate, nil
 		},
-		column: procedure.FieldName,
-		toTerm: procedure.ByName,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldEndDate,
+		toTerm: program.ByEndDate,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Name,
+				Value: _m.EndDate,
 			}
 		},
 	}
-	// ProcedureOrderFieldStatus orders Procedure by status.
-	ProcedureOrderFieldStatus = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
-			return _m.Status, nil
+	// ProgramOrderFieldObservationPeriodStartDate orders Program by observation_period_start_date.
+	ProgramOrderFieldObservationPeriodStartDate = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.ObservationPeriodStartDate, nil
 		},
-		column: procedure.FieldStatus,
-		toTerm: procedure.ByStatus,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldObservationPeriodStartDate,
+		toTerm: program.ByObservationPeriodStartDate,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Status,
+				Value: _m.ObservationPeriodStartDate,
 			}
 		},
 	}
-	// ProcedureOrderFieldManagementMode orders Procedure by management_mode.
-	ProcedureOrderFieldManagementMode = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
-			return _m.ManagementMode, nil
+	// ProgramOrderFieldObservationPeriodEndDate orders Program by observation_period_end_date.
+	ProgramOrderFieldObservationPeriodEndDate = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.ObservationPeriodEndDate, nil
 		},
-		column: procedure.FieldManagementMode,
-		toTerm: procedure.ByManagementMode,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldObservationPeriodEndDate,
+		toTerm: program.ByObservationPeriodEndDate,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ManagementMode,
+				Value: _m.ObservationPeriodEndDate,
 			}
 		},
 	}
-	// ProcedureOrderFieldReviewDue orders Procedure by review_due.
-	ProcedureOrderFieldReviewDue = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
-			return _m.ReviewDue, nil
+	// ProgramOrderFieldFieldworkStartDate orders Program by fieldwork_start_date.
+	ProgramOrderFieldFieldworkStartDate = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.FieldworkStartDate, nil
 		},
-		column: procedure.FieldReviewDue,
-		toTerm: procedure.ByReviewDue,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldFieldworkStartDate,
+		toTerm: program.ByFieldworkStartDate,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ReviewDue,
+				Value: _m.FieldworkStartDate,
 			}
 		},
 	}
-	// ProcedureOrderFieldReviewFrequency orders Procedure by review_frequency.
-	ProcedureOrderFieldReviewFrequency = &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
-			return _m.ReviewFrequency, nil
+	// ProgramOrderFieldFieldworkEndDate orders Program by fieldwork_end_date.
+	ProgramOrderFieldFieldworkEndDate = &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
+			return _m.FieldworkEndDate, nil
 		},
-		column: procedure.FieldReviewFrequency,
-		toTerm: procedure.ByReviewFrequency,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldFieldworkEndDate,
+		toTerm: program.ByFieldworkEndDate,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.ReviewFrequency,
+				Value: _m.FieldworkEndDate,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ProcedureOrderField) String() string {
+func (f ProgramOrderField) String() string {
 	var str string
 	switch f.column {
-	case ProcedureOrderFieldCreatedAt.column:
+	case ProgramOrderFieldCreatedAt.column:
 		str = "created_at"
-	case ProcedureOrderFieldUpdatedAt.column:
+	case ProgramOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case ProcedureOrderFieldRevision.column:
-		str = "revision"
-	case ProcedureOrderFieldName.column:
+	case ProgramOrderFieldName.column:
 		str = "name"
-	case ProcedureOrderFieldStatus.column:
+	case ProgramOrderFieldStatus.column:
 		str = "STATUS"
-	case ProcedureOrderFieldManagementMode.column:
-		str = "MANAGEMENT_MODE"
-	case ProcedureOrderFieldReviewDue.column:
-		str = "review_due"
-	case ProcedureOrderFieldReviewFrequency.column:
-		str = "REVIEW_FREQUENCY"
+	case ProgramOrderFieldFrameworkName.column:
+		str = "framework"
+	case ProgramOrderFieldStartDate.column:
+		str = "start_date"
+	case ProgramOrderFieldEndDate.column:
+		str = "end_date"
+	case ProgramOrderFieldObservationPeriodStartDate.column:
+		str = "observation_period_start_date"
+	case ProgramOrderFieldObservationPeriodEndDate.column:
+		str = "observation_period_end_date"
+	case ProgramOrderFieldFieldworkStartDate.column:
+		str = "fieldwork_start_date"
+	case ProgramOrderFieldFieldworkEndDate.column:
+		str = "fieldwork_end_date"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ProcedureOrderField) MarshalGQL(w io.Writer) {
+func (f ProgramOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ProcedureOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ProgramOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ProcedureOrderField %T must be a string", v)
+		return fmt.Errorf("ProgramOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *ProcedureOrderFieldCreatedAt
+		*f = *ProgramOrderFieldCreatedAt
 	case "updated_at":
-		*f = *ProcedureOrderFieldUpdatedAt
-	case "revision":
-		*f = *ProcedureOrderFieldRevision
+		*f = *ProgramOrderFieldUpdatedAt
 	case "name":
-		*f = *ProcedureOrderFieldName
+		*f = *ProgramOrderFieldName
 	case "STATUS":
-		*f = *ProcedureOrderFieldStatus
-	case "MANAGEMENT_MODE":
-		*f = *ProcedureOrderFieldManagementMode
-	case "review_due":
-		*f = *ProcedureOrderFieldReviewDue
-	case "REVIEW_FREQUENCY":
-		*f = *ProcedureOrderFieldReviewFrequency
+		*f = *ProgramOrderFieldStatus
+	case "framework":
+		*f = *ProgramOrderFieldFrameworkName
+	case "start_date":
+		*f = *ProgramOrderFieldStartDate
+	case "end_date":
+		*f = *ProgramOrderFieldEndDate
+	case "observation_period_start_date":
+		*f = *ProgramOrderFieldObservationPeriodStartDate
+	case "observation_period_end_date":
+		*f = *ProgramOrderFieldObservationPeriodEndDate
+	case "fieldwork_start_date":
+		*f = *ProgramOrderFieldFieldworkStartDate
+	case "fieldwork_end_date":
+		*f = *ProgramOrderFieldFieldworkEndDate
 	default:
-		return fmt.Errorf("%s is not a valid ProcedureOrderField", str)
+		return fmt.Errorf("%s is not a valid ProgramOrderField", str)
 	}
 	return nil
 }
 
-// ProcedureOrderField defines the ordering field of Procedure.
-type ProcedureOrderField struct {
-	// Value extracts the ordering value from the given Procedure.
-	Value    func(*Procedure) (ent.Value, error)
+// ProgramOrderField defines the ordering field of Program.
+type ProgramOrderField struct {
+	// Value extracts the ordering value from the given Program.
+	Value    func(*Program) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) procedure.OrderOption
-	toCursor func(*Procedure) Cursor
+	toTerm   func(...sql.OrderTermOption) program.OrderOption
+	toCursor func(*Program) Cursor
 }
 
-// ProcedureOrder defines the ordering of Procedure.
-type ProcedureOrder struct {
-	Direction OrderDirection       `json:"direction"`
-	Field     *ProcedureOrderField `json:"field"`
+// ProgramOrder defines the ordering of Program.
+type ProgramOrder struct {
+	Direction OrderDirection     `json:"direction"`
+	Field     *ProgramOrderField `json:"field"`
 }
 
-// DefaultProcedureOrder is the default ordering of Procedure.
-var DefaultProcedureOrder = &ProcedureOrder{
+// DefaultProgramOrder is the default ordering of Program.
+var DefaultProgramOrder = &ProgramOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProcedureOrderField{
-		Value: func(_m *Procedure) (ent.Value, error) {
+	Field: &ProgramOrderField{
+		Value: func(_m *Program) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: procedure.FieldID,
-		toTerm: procedure.ByID,
-		toCursor: func(_m *Procedure) Cursor {
+		column: program.FieldID,
+		toTerm: program.ByID,
+		toCursor: func(_m *Program) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Procedure into ProcedureEdge.
-func (_m *Procedure) ToEdge(order *ProcedureOrder) *ProcedureEdge {
+// ToEdge converts Program into ProgramEdge.
+func (_m *Program) ToEdge(order *ProgramOrder) *ProgramEdge {
 	if order == nil {
-		order = DefaultProcedureOrder
+		order = DefaultProgramOrder
 	}
-	return &ProcedureEdge{
+	return &ProgramEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ProgramEdge is the edge representation of Program.
-type ProgramEdge struct {
-	Node   *Program `json:"node"`
-	Cursor Cursor   `json:"cursor"`
+// ProgramMembershipEdge is the edge representation of ProgramMembership.
+type ProgramMembershipEdge struct {
+	Node   *ProgramMembership `json:"node"`
+	Cursor Cursor             `json:"cursor"`
 }
 
-// ProgramConnection is the connection containing edges to Program.
-type ProgramConnection struct {
-	Edges      []*ProgramEdge `json:"edges"`
-	PageInfo   PageInfo       `json:"pageInfo"`
-	TotalCount int            `json:"totalCount"`
+// ProgramMembershipConnection is the connection containing edges to ProgramMembership.
+type ProgramMembershipConnection struct {
+	Edges      []*ProgramMembershipEdge `json:"edges"`
+	PageInfo   PageInfo                 `json:"pageInfo"`
+	TotalCount int                      `json:"totalCount"`
 }
 
-func (c *ProgramConnection) build(nodes []*Program, pager *programPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *ProgramMembershipConnection) build(nodes []*ProgramMembership, pager *programmembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -26235,21 +27127,21 @@ func (c *ProgramConnection) build(nodes []*Program, pager *programPager, after *
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *Program
+	var nodeAt func(int) *ProgramMembership
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Program {
+		nodeAt = func(i int) *ProgramMembership {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Program {
+		nodeAt = func(i int) *ProgramMembership {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProgramEdge, len(nodes))
+	c.Edges = make([]*ProgramMembershipEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProgramEdge{
+		c.Edges[i] = &ProgramMembershipEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -26263,12 +27155,12 @@ func (c *ProgramConnection) build(nodes []*Program, pager *programPager, after *
 	}
 }
 
-// ProgramPaginateOption enables pagination customization.
-type ProgramPaginateOption func(*programPager) error
+// ProgramMembershipPaginateOption enables pagination customization.
+type ProgramMembershipPaginateOption func(*programmembershipPager) error
 
-// WithProgramOrder configures pagination ordering.
-func WithProgramOrder(order []*ProgramOrder) ProgramPaginateOption {
-	return func(pager *programPager) error {
+// WithProgramMembershipOrder configures pagination ordering.
+func WithProgramMembershipOrder(order []*ProgramMembershipOrder) ProgramMembershipPaginateOption {
+	return func(pager *programmembershipPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -26279,25 +27171,25 @@ func WithProgramOrder(order []*ProgramOrder) ProgramPaginateOption {
 	}
 }
 
-// WithProgramFilter configures pagination filter.
-func WithProgramFilter(filter func(*ProgramQuery) (*ProgramQuery, error)) ProgramPaginateOption {
-	return func(pager *programPager) error {
+// WithProgramMembershipFilter configures pagination filter.
+func WithProgramMembershipFilter(filter func(*ProgramMembershipQuery) (*ProgramMembershipQuery, error)) ProgramMembershipPaginateOption {
+	return func(pager *programmembershipPager) error {
 		if filter == nil {
-			return errors.New("ProgramQuery filter cannot be nil")
+			return errors.New("ProgramMembershipQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type programPager struct {
+type programmembershipPager struct {
 	reverse bool
-	order   []*ProgramOrder
-	filter  func(*ProgramQuery) (*ProgramQuery, error)
+	order   []*ProgramMembershipOrder
+	filter  func(*ProgramMembershipQuery) (*ProgramMembershipQuery, error)
 }
 
-func newProgramPager(opts []ProgramPaginateOption, reverse bool) (*programPager, error) {
-	pager := &programPager{reverse: reverse}
+func newProgramMembershipPager(opts []ProgramMembershipPaginateOption, reverse bool) (*programmembershipPager, error) {
+	pager := &programmembershipPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -26311,14 +27203,14 @@ func newProgramPager(opts []ProgramPaginateOption, reverse bool) (*programPager,
 	return pager, nil
 }
 
-func (p *programPager) applyFilter(query *ProgramQuery) (*ProgramQuery, error) {
+func (p *programmembershipPager) applyFilter(query *ProgramMembershipQuery) (*ProgramMembershipQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *programPager) toCursor(_m *Program) Cursor {
+func (p *programmembershipPager) toCursor(_m *ProgramMembership) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -26326,7 +27218,7 @@ func (p *programPager) toCursor(_m *Program) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *programPager) applyCursors(query *ProgramQuery, after, before *Cursor) (*ProgramQuery, error) {
+func (p *programmembershipPager) applyCursors(query *ProgramMembershipQuery, after, before *Cursor) (*ProgramMembershipQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -26341,7 +27233,7 @@ func (p *programPager) applyCursors(query *ProgramQuery, after, before *Cursor)
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultProgramOrder.Field.column,
+		FieldID:     DefaultProgramMembershipOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -26360,7 +27252,7 @@ func (p *programPager) applyCursors(query *ProgramQuery, after, before *Cursor)
 	return query, nil
 }
 
-func (p *programPager) applyOrder(query *ProgramQuery) *ProgramQuery {
+func (p *programmembershipPager) applyOrder(query *ProgramMembershipQuery) *ProgramMembershipQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -26368,7 +27260,7 @@ func (p *programPager) applyOrder(query *ProgramQuery) *ProgramQuery {
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultProgramOrder.Field.column {
+		if o.Field.column == DefaultProgramMembershipOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -26380,12 +27272,12 @@ func (p *programPager) applyOrder(query *ProgramQuery) *ProgramQuery {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultProgramOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultProgramMembershipOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *programPager) orderExpr(query *ProgramQuery) sql.Querier {
+func (p *programmembershipPager) orderExpr(query *ProgramMembershipQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -26404,26 +27296,26 @@ func (p *programPager) orderExpr(query *ProgramQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultProgramOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultProgramMembershipOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Program.
-func (_m *ProgramQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to ProgramMembership.
+func (_m *ProgramMembershipQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProgramPaginateOption,
-) (*ProgramConnection, error) {
+	before *Cursor, last *int, opts ...ProgramMembershipPaginateOption,
+) (*ProgramMembershipConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProgramPager(opts, last != nil)
+	pager, err := newProgramMembershipPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ProgramConnection{Edges: []*ProgramEdge{}}
+	conn := &ProgramMembershipConnection{Edges: []*ProgramMembershipEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -26443,7 +27335,7 @@ func (_m *ProgramQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimitSingle(first, last)
+	limit := paginateLimit(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -26462,287 +27354,143 @@ func (_m *ProgramQuery) Paginate(
 }
 
 var (
-	// ProgramOrderFieldCreatedAt orders Program by created_at.
-	ProgramOrderFieldCreatedAt = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
+	// ProgramMembershipOrderFieldCreatedAt orders ProgramMembership by created_at.
+	ProgramMembershipOrderFieldCreatedAt = &ProgramMembershipOrderField{
+		Value: func(_m *ProgramMembership) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: program.FieldCreatedAt,
-		toTerm: program.ByCreatedAt,
-		toCursor: func(_m *Program) Cursor {
+		column: programmembership.FieldCreatedAt,
+		toTerm: programmembership.ByCreatedAt,
+		toCursor: func(_m *ProgramMembership) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ProgramOrderFieldUpdatedAt orders Program by updated_at.
-	ProgramOrderFieldUpdatedAt = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
+	// ProgramMembershipOrderFieldUpdatedAt orders ProgramMembership by updated_at.
+	ProgramMembershipOrderFieldUpdatedAt = &ProgramMembershipOrderField{
+		Value: func(_m *ProgramMembership) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: program.FieldUpdatedAt,
-		toTerm: program.ByUpdatedAt,
-		toCursor: func(_m *Program) Cursor {
+		column: programmembership.FieldUpdatedAt,
+		toTerm: programmembership.ByUpdatedAt,
+		toCursor: func(_m *ProgramMembership) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// ProgramOrderFieldName orders Program by name.
-	ProgramOrderFieldName = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.Name, nil
-		},
-		column: program.FieldName,
-		toTerm: program.ByName,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Name,
-			}
-		},
-	}
-	// ProgramOrderFieldStatus orders Program by status.
-	ProgramOrderFieldStatus = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.Status, nil
-		},
-		column: program.FieldStatus,
-		toTerm: program.ByStatus,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Status,
-			}
-		},
-	}
-	// ProgramOrderFieldFrameworkName orders Program by framework_name.
-	ProgramOrderFieldFrameworkName = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.FrameworkName, nil
-		},
-		column: program.FieldFrameworkName,
-		toTerm: program.ByFrameworkName,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.FrameworkName,
-			}
-		},
-	}
-	// ProgramOrderFieldStartDate orders Program by start_date.
-	ProgramOrderFieldStartDate = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.StartDate, nil
-		},
-		column: program.FieldStartDate,
-		toTerm: program.ByStartDate,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.StartDate,
-			}
-		},
-	}
-	// ProgramOrderFieldEndDate orders Program by end_date.
-	ProgramOrderFieldEndDate = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.EndDate, nil
-		},
-		column: program.FieldEndDate,
-		toTerm: program.ByEndDate,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.EndDate,
-			}
-		},
-	}
-	// ProgramOrderFieldObservationPeriodStartDate orders Program by observation_period_start_date.
-	ProgramOrderFieldObservationPeriodStartDate = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.ObservationPeriodStartDate, nil
-		},
-		column: program.FieldObservationPeriodStartDate,
-		toTerm: program.ByObservationPeriodStartDate,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ObservationPeriodStartDate,
-			}
-		},
-	}
-	// ProgramOrderFieldObservationPeriodEndDate orders Program by observation_period_end_date.
-	ProgramOrderFieldObservationPeriodEndDate = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.ObservationPeriodEndDate, nil
-		},
-		column: program.FieldObservationPeriodEndDate,
-		toTerm: program.ByObservationPeriodEndDate,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ObservationPeriodEndDate,
-			}
-		},
-	}
-	// ProgramOrderFieldFieldworkStartDate orders Program by fieldwork_start_date.
-	ProgramOrderFieldFieldworkStartDate = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.FieldworkStartDate, nil
-		},
-		column: program.FieldFieldworkStartDate,
-		toTerm: program.ByFieldworkStartDate,
-		toCursor: func(_m *Program) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.FieldworkStartDate,
-			}
-		},
-	}
-	// ProgramOrderFieldFieldworkEndDate orders Program by fieldwork_end_date.
-	ProgramOrderFieldFieldworkEndDate = &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
-			return _m.FieldworkEndDate, nil
+	// ProgramMembershipOrderFieldRole orders ProgramMembership by role.
+	ProgramMembershipOrderFieldRole = &ProgramMembershipOrderField{
+		Value: func(_m *ProgramMembership) (ent.Value, error) {
+			return _m.Role, nil
 		},
-		column: program.FieldFieldworkEndDate,
-		toTerm: program.ByFieldworkEndDate,
-		toCursor: func(_m *Program) Cursor {
+		column: programmembership.FieldRole,
+		toTerm: programmembership.ByRole,
+		toCursor: func(_m *ProgramMembership) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.FieldworkEndDate,
+				Value: _m.Role,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ProgramOrderField) String() string {
+func (f ProgramMembershipOrderField) String() string {
 	var str string
 	switch f.column {
-	case ProgramOrderFieldCreatedAt.column:
+	case ProgramMembershipOrderFieldCreatedAt.column:
 		str = "created_at"
-	case ProgramOrderFieldUpdatedAt.column:
+	case ProgramMembershipOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case ProgramOrderFieldName.column:
-		str = "name"
-	case ProgramOrderFieldStatus.column:
-		str = "STATUS"
-	case ProgramOrderFieldFrameworkName.column:
-		str = "framework"
-	case ProgramOrderFieldStartDate.column:
-		str = "start_date"
-	case ProgramOrderFieldEndDate.column:
-		str = "end_date"
-	case ProgramOrderFieldObservationPeriodStartDate.column:
-		str = "observation_period_start_date"
-	case ProgramOrderFieldObservationPeriodEndDate.column:
-		str = "observation_period_end_date"
-	case ProgramOrderFieldFieldworkStartDate.column:
-		str = "fieldwork_start_date"
-	case ProgramOrderFieldFieldworkEndDate.column:
-		str = "fieldwork_end_date"
+	case ProgramMembershipOrderFieldRole.column:
+		str = "ROLE"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ProgramOrderField) MarshalGQL(w io.Writer) {
+func (f ProgramMembershipOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ProgramOrderField) UnmarshalGQL(v interface{}) error {
+func (f *ProgramMembershipOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ProgramOrderField %T must be a string", v)
+		return fmt.Errorf("ProgramMembershipOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *ProgramOrderFieldCreatedAt
+		*f = *ProgramMembershipOrderFieldCreatedAt
 	case "updated_at":
-		*f = *ProgramOrderFieldUpdatedAt
-	case "name":
-		*f = *ProgramOrderFieldName
-	case "STATUS":
-		*f = *ProgramOrderFieldStatus
-	case "framework":
-		*f = *ProgramOrderFieldFrameworkName
-	case "start_date":
-		*f = *ProgramOrderFieldStartDate
-	case "end_date":
-		*f = *ProgramOrderFieldEndDate
-	case "observation_period_start_date":
-		*f = *ProgramOrderFieldObservationPeriodStartDate
-	case "observation_period_end_date":
-		*f = *ProgramOrderFieldObservationPeriodEndDate
-	case "fieldwork_start_date":
-		*f = *ProgramOrderFieldFieldworkStartDate
-	case "fieldwork_end_date":
-		*f = *ProgramOrderFieldFieldworkEndDate
+		*f = *ProgramMembershipOrderFieldUpdatedAt
+	case "ROLE":
+		*f = *ProgramMembershipOrderFieldRole
 	default:
-		return fmt.Errorf("%s is not a valid ProgramOrderField", str)
+		return fmt.Errorf("%s is not a valid ProgramMembershipOrderField", str)
 	}
 	return nil
 }
 
-// ProgramOrderField defines the ordering field of Program.
-type ProgramOrderField struct {
-	// Value extracts the ordering value from the given Program.
-	Value    func(*Program) (ent.Value, error)
+// ProgramMembershipOrderField defines the ordering field of ProgramMembership.
+type ProgramMembershipOrderField struct {
+	// Value extracts the ordering value from the given ProgramMembership.
+	Value    func(*ProgramMembership) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) program.OrderOption
-	toCursor func(*Program) Cursor
+	toTerm   func(...sql.OrderTermOption) programmembership.OrderOption
+	toCursor func(*ProgramMembership) Cursor
 }
 
-// ProgramOrder defines the ordering of Program.
-type ProgramOrder struct {
-	Direction OrderDirection     `json:"direction"`
-	Field     *ProgramOrderField `json:"field"`
+// ProgramMembershipOrder defines the ordering of ProgramMembership.
+type ProgramMembershipOrder struct {
+	Direction OrderDirection               `json:"direction"`
+	Field     *ProgramMembershipOrderField `json:"field"`
 }
 
-// DefaultProgramOrder is the default ordering of Program.
-var DefaultProgramOrder = &ProgramOrder{
+// DefaultProgramMembershipOrder is the default ordering of ProgramMembership.
+var DefaultProgramMembershipOrder = &ProgramMembershipOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProgramOrderField{
-		Value: func(_m *Program) (ent.Value, error) {
+	Field: &ProgramMembershipOrderField{
+		Value: func(_m *ProgramMembership) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: program.FieldID,
-		toTerm: program.ByID,
-		toCursor: func(_m *Program) Cursor {
+		column: programmembership.FieldID,
+		toTerm: programmembership.ByID,
+		toCursor: func(_m *ProgramMembership) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Program into ProgramEdge.
-func (_m *Program) ToEdge(order *ProgramOrder) *ProgramEdge {
+// ToEdge converts ProgramMembership into ProgramMembershipEdge.
+func (_m *ProgramMembership) ToEdge(order *ProgramMembershipOrder) *ProgramMembershipEdge {
 	if order == nil {
-		order = DefaultProgramOrder
+		order = DefaultProgramMembershipOrder
 	}
-	return &ProgramEdge{
+	return &ProgramMembershipEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// ProgramMembershipEdge is the edge representation of ProgramMembership.
-type ProgramMembershipEdge struct {
-	Node   *ProgramMembership `json:"node"`
-	Cursor Cursor             `json:"cursor"`
+// RemediationEdge is the edge representation of Remediation.
+type RemediationEdge struct {
+	Node   *Remediation `json:"node"`
+	Cursor Cursor       `json:"cursor"`
 }
 
-// ProgramMembershipConnection is the connection containing edges to ProgramMembership.
-type ProgramMembershipConnection struct {
-	Edges      []*ProgramMembershipEdge `json:"edges"`
-	PageInfo   PageInfo                 `json:"pageInfo"`
-	TotalCount int                      `json:"totalCount"`
+// RemediationConnection is the connection containing edges to Remediation.
+type RemediationConnection struct {
+	Edges      []*RemediationEdge `json:"edges"`
+	PageInfo   PageInfo           `json:"pageInfo"`
+	TotalCount int                `json:"totalCount"`
 }
 
-func (c *ProgramMembershipConnection) build(nodes []*ProgramMembership, pager *programmembershipPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *RemediationConnection) build(nodes []*Remediation, pager *remediationPager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -26752,21 +27500,21 @@ func (c *ProgramMembershipConnection) build(nodes []*ProgramMembership, pager *p
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *ProgramMembership
+	var nodeAt func(int) *Remediation
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *ProgramMembership {
+		nodeAt = func(i int) *Remediation {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *ProgramMembership {
+		nodeAt = func(i int) *Remediation {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*ProgramMembershipEdge, len(nodes))
+	c.Edges = make([]*RemediationEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &ProgramMembershipEdge{
+		c.Edges[i] = &RemediationEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -26780,12 +27528,12 @@ func (c *ProgramMembershipConnection) build(nodes []*ProgramMembership, pager *p
 	}
 }
 
-// ProgramMembershipPaginateOption enables pagination customization.
-type ProgramMembershipPaginateOption func(*programmembershipPager) error
+// RemediationPaginateOption enables pagination customization.
+type RemediationPaginateOption func(*remediationPager) error
 
-// WithProgramMembershipOrder configures pagination ordering.
-func WithProgramMembershipOrder(order []*ProgramMembershipOrder) ProgramMembershipPaginateOption {
-	return func(pager *programmembershipPager) error {
+// WithRemediationOrder configures pagination ordering.
+func WithRemediationOrder(order []*RemediationOrder) RemediationPaginateOption {
+	return func(pager *remediationPager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -26796,25 +27544,25 @@ func WithProgramMembershipOrder(order []*ProgramMembershipOrder) ProgramMembersh
 	}
 }
 
-// WithProgramMembershipFilter configures pagination filter.
-func WithProgramMembershipFilter(filter func(*ProgramMembershipQuery) (*ProgramMembershipQuery, error)) ProgramMembershipPaginateOption {
-	return func(pager *programmembershipPager) error {
+// WithRemediationFilter configures pagination filter.
+func WithRemediationFilter(filter func(*RemediationQuery) (*RemediationQuery, error)) RemediationPaginateOption {
+	return func(pager *remediationPager) error {
 		if filter == nil {
-			return errors.New("ProgramMembershipQuery filter cannot be nil")
+			return errors.New("RemediationQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type programmembershipPager struct {
+type remediationPager struct {
 	reverse bool
-	order   []*ProgramMembershipOrder
-	filter  func(*ProgramMembershipQuery) (*ProgramMembershipQuery, error)
+	order   []*RemediationOrder
+	filter  func(*RemediationQuery) (*RemediationQuery, error)
 }
 
-func newProgramMembershipPager(opts []ProgramMembershipPaginateOption, reverse bool) (*programmembershipPager, error) {
-	pager := &programmembershipPager{reverse: reverse}
+func newRemediationPager(opts []RemediationPaginateOption, reverse bool) (*remediationPager, error) {
+	pager := &remediationPager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -26828,14 +27576,14 @@ func newProgramMembershipPager(opts []ProgramMembershipPaginateOption, reverse b
 	return pager, nil
 }
 
-func (p *programmembershipPager) applyFilter(query *ProgramMembershipQuery) (*ProgramMembershipQuery, error) {
+func (p *remediationPager) applyFilter(query *RemediationQuery) (*RemediationQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *programmembershipPager) toCursor(_m *ProgramMembership) Cursor {
+func (p *remediationPager) toCursor(_m *Remediation) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -26843,7 +27591,7 @@ func (p *programmembershipPager) toCursor(_m *ProgramMembership) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *programmembershipPager) applyCursors(query *ProgramMembershipQuery, after, before *Cursor) (*ProgramMembershipQuery, error) {
+func (p *remediationPager) applyCursors(query *RemediationQuery, after, before *Cursor) (*RemediationQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -26858,7 +27606,7 @@ func (p *programmembershipPager) applyCursors(query *ProgramMembershipQuery, aft
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultProgramMembershipOrder.Field.column,
+		FieldID:     DefaultRemediationOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -26877,7 +27625,7 @@ func (p *programmembershipPager) applyCursors(query *ProgramMembershipQuery, aft
 	return query, nil
 }
 
-func (p *programmembershipPager) applyOrder(query *ProgramMembershipQuery) *ProgramMembershipQuery {
+func (p *remediationPager) applyOrder(query *RemediationQuery) *RemediationQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -26885,7 +27633,7 @@ func (p *programmembershipPager) applyOrder(query *ProgramMembershipQuery) *Prog
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultProgramMembershipOrder.Field.column {
+		if o.Field.column == DefaultRemediationOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -26897,12 +27645,12 @@ func (p *programmembershipPager) applyOrder(query *ProgramMembershipQuery) *Prog
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultProgramMembershipOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultRemediationOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *programmembershipPager) orderExpr(query *ProgramMembershipQuery) sql.Querier {
+func (p *remediationPager) orderExpr(query *RemediationQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -26921,26 +27669,26 @@ func (p *programmembershipPager) orderExpr(query *ProgramMembershipQuery) sql.Qu
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultProgramMembershipOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultRemediationOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to ProgramMembership.
-func (_m *ProgramMembershipQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to Remediation.
+func (_m *RemediationQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...ProgramMembershipPaginateOption,
-) (*ProgramMembershipConnection, error) {
+	before *Cursor, last *int, opts ...RemediationPaginateOption,
+) (*RemediationConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newProgramMembershipPager(opts, last != nil)
+	pager, err := newRemediationPager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &ProgramMembershipConnection{Edges: []*ProgramMembershipEdge{}}
+	conn := &RemediationConnection{Edges: []*RemediationEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -26960,7 +27708,7 @@ func (_m *ProgramMembershipQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimit(first, last)
+	limit := paginateLimitSingle(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -26979,143 +27727,215 @@ func (_m *ProgramMembershipQuery) Paginate(
 }
 
 var (
-	// ProgramMembershipOrderFieldCreatedAt orders ProgramMembership by created_at.
-	ProgramMembershipOrderFieldCreatedAt = &ProgramMembershipOrderField{
-		Value: func(_m *ProgramMembership) (ent.Value, error) {
+	// RemediationOrderFieldCreatedAt orders Remediation by created_at.
+	RemediationOrderFieldCreatedAt = &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: programmembership.FieldCreatedAt,
-		toTerm: programmembership.ByCreatedAt,
-		toCursor: func(_m *ProgramMembership) Cursor {
+		column: remediation.FieldCreatedAt,
+		toTerm: remediation.ByCreatedAt,
+		toCursor: func(_m *Remediation) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// ProgramMembershipOrderFieldUpdatedAt orders ProgramMembership by updated_at.
-	ProgramMembershipOrderFieldUpdatedAt = &ProgramMembershipOrderField{
-		Value: func(_m *ProgramMembership) (ent.Value, error) {
+	// RemediationOrderFieldUpdatedAt orders Remediation by updated_at.
+	RemediationOrderFieldUpdatedAt = &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: programmembership.FieldUpdatedAt,
-		toTerm: programmembership.ByUpdatedAt,
-		toCursor: func(_m *ProgramMembership) Cursor {
+		column: remediation.FieldUpdatedAt,
+		toTerm: remediation.ByUpdatedAt,
+		toCursor: func(_m *Remediation) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// ProgramMembershipOrderFieldRole orders ProgramMembership by role.
-	ProgramMembershipOrderFieldRole = &ProgramMembershipOrderField{
-		Value: func(_m *ProgramMembership) (ent.Value, error) {
-			return _m.Role, nil
+	// RemediationOrderFieldExternalID orders Remediation by external_id.
+	RemediationOrderFieldExternalID = &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
+			return _m.ExternalID, nil
 		},
-		column: programmembership.FieldRole,
-		toTerm: programmembership.ByRole,
-		toCursor: func(_m *ProgramMembership) Cursor {
+		column: remediation.FieldExternalID,
+		toTerm: remediation.ByExternalID,
+		toCursor: func(_m *Remediation) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Role,
+				Value: _m.ExternalID,
+			}
+		},
+	}
+	// RemediationOrderFieldExternalOwnerID orders Remediation by external_owner_id.
+	RemediationOrderFieldExternalOwnerID = &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
+			return _m.ExternalOwnerID, nil
+		},
+		column: remediation.FieldExternalOwnerID,
+		toTerm: remediation.ByExternalOwnerID,
+		toCursor: func(_m *Remediation) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.ExternalOwnerID,
+			}
+		},
+	}
+	// RemediationOrderFieldTitle orders Remediation by title.
+	RemediationOrderFieldTitle = &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
+			return _m.Title, nil
+		},
+		column: remediation.FieldTitle,
+		toTerm: remediation.ByTitle,
+		toCursor: func(_m *Remediation) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Title,
+			}
+		},
+	}
+	// RemediationOrderFieldStatus orders Remediation by status.
+	RemediationOrderFieldStatus = &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
+			return _m.Status, nil
+		},
+		column: remediation.FieldStatus,
+		toTerm: remediation.ByStatus,
+		toCursor: func(_m *Remediation) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Status,
+			}
+		},
+	}
+	// RemediationOrderFieldState orders Remediation by state.
+	RemediationOrderFieldState = &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
+			return _m.State, nil
+		},
+		column: remediation.FieldState,
+		toTerm: remediation.ByState,
+		toCursor: func(_m *Remediation) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.State,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f ProgramMembershipOrderField) String() string {
+func (f RemediationOrderField) String() string {
 	var str string
 	switch f.column {
-	case ProgramMembershipOrderFieldCreatedAt.column:
+	case RemediationOrderFieldCreatedAt.column:
 		str = "created_at"
-	case ProgramMembershipOrderFieldUpdatedAt.column:
+	case RemediationOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case ProgramMembershipOrderFieldRole.column:
-		str = "ROLE"
+	case RemediationOrderFieldExternalID.column:
+		str = "external_id"
+	case RemediationOrderFieldExternalOwnerID.column:
+		str = "external_owner_id"
+	case RemediationOrderFieldTitle.column:
+		str = "title"
+	case RemediationOrderFieldStatus.column:
+		str = "status"
+	case RemediationOrderFieldState.column:
+		str = "state"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f ProgramMembershipOrderField) MarshalGQL(w io.Writer) {
+func (f RemediationOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *ProgramMembershipOrderField) UnmarshalGQL(v interface{}) error {
+func (f *RemediationOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("ProgramMembershipOrderField %T must be a string", v)
+		return fmt.Errorf("RemediationOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *ProgramMembershipOrderFieldCreatedAt
+		*f = *RemediationOrderFieldCreatedAt
 	case "updated_at":
-		*f = *ProgramMembershipOrderFieldUpdatedAt
-	case "ROLE":
-		*f = *ProgramMembershipOrderFieldRole
+		*f = *RemediationOrderFieldUpdatedAt
+	case "external_id":
+		*f = *RemediationOrderFieldExternalID
+	case "external_owner_id":
+		*f = *RemediationOrderFieldExternalOwnerID
+	case "title":
+		*f = *RemediationOrderFieldTitle
+	case "status":
+		*f = *RemediationOrderFieldStatus
+	case "state":
+		*f = *RemediationOrderFieldState
 	default:
-		return fmt.Errorf("%s is not a valid ProgramMembershipOrderField", str)
+		return fmt.Errorf("%s is not a valid RemediationOrderField", str)
 	}
 	return nil
 }
 
-// ProgramMembershipOrderField defines the ordering field of ProgramMembership.
-type ProgramMembershipOrderField struct {
-	// Value extracts the ordering value from the given ProgramMembership.
-	Value    func(*ProgramMembership) (ent.Value, error)
+// RemediationOrderField defines the ordering field of Remediation.
+type RemediationOrderField struct {
+	// Value extracts the ordering value from the given Remediation.
+	Value    func(*Remediation) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) programmembership.OrderOption
-	toCursor func(*ProgramMembership) Cursor
+	toTerm   func(...sql.OrderTermOption) remediation.OrderOption
+	toCursor func(*Remediation) Cursor
 }
 
-// ProgramMembershipOrder defines the ordering of ProgramMembership.
-type ProgramMembershipOrder struct {
-	Direction OrderDirection               `json:"direction"`
-	Field     *ProgramMembershipOrderField `json:"field"`
+// RemediationOrder defines the ordering of Remediation.
+type RemediationOrder struct {
+	Direction OrderDirection         `json:"direction"`
+	Field     *RemediationOrderField `json:"field"`
 }
 
-// DefaultProgramMembershipOrder is the default ordering of ProgramMembership.
-var DefaultProgramMembershipOrder = &ProgramMembershipOrder{
+// DefaultRemediationOrder is the default ordering of Remediation.
+var DefaultRemediationOrder = &RemediationOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &ProgramMembershipOrderField{
-		Value: func(_m *ProgramMembership) (ent.Value, error) {
+	Field: &RemediationOrderField{
+		Value: func(_m *Remediation) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: programmembership.FieldID,
-		toTerm: programmembership.ByID,
-		toCursor: func(_m *ProgramMembership) Cursor {
+		column: remediation.FieldID,
+		toTerm: remediation.ByID,
+		toCursor: func(_m *Remediation) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts ProgramMembership into ProgramMembershipEdge.
-func (_m *ProgramMembership) ToEdge(order *ProgramMembershipOrder) *ProgramMembershipEdge {
+// ToEdge converts Remediation into RemediationEdge.
+func (_m *Remediation) ToEdge(order *RemediationOrder) *RemediationEdge {
 	if order == nil {
-		order = DefaultProgramMembershipOrder
+		order = DefaultRemediationOrder
 	}
-	return &ProgramMembershipEdge{
+	return &RemediationEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
 }
 
-// RemediationEdge is the edge representation of Remediation.
-type RemediationEdge struct {
-	Node   *Remediation `json:"node"`
-	Cursor Cursor       `json:"cursor"`
+// RetentionRuleEdge is the edge representation of RetentionRule.
+type RetentionRuleEdge struct {
+	Node   *RetentionRule `json:"node"`
+	Cursor Cursor         `json:"cursor"`
 }
 
-// RemediationConnection is the connection containing edges to Remediation.
-type RemediationConnection struct {
-	Edges      []*RemediationEdge `json:"edges"`
-	PageInfo   PageInfo           `json:"pageInfo"`
-	TotalCount int                `json:"totalCount"`
+// RetentionRuleConnection is the connection containing edges to RetentionRule.
+type RetentionRuleConnection struct {
+	Edges      []*RetentionRuleEdge `json:"edges"`
+	PageInfo   PageInfo             `json:"pageInfo"`
+	TotalCount int                  `json:"totalCount"`
 }
 
-func (c *RemediationConnection) build(nodes []*Remediation, pager *remediationPager, after *Cursor, first *int, before *Cursor, last *int) {
+func (c *RetentionRuleConnection) build(nodes []*RetentionRule, pager *retentionrulePager, after *Cursor, first *int, before *Cursor, last *int) {
 	c.PageInfo.HasNextPage = before != nil
 	c.PageInfo.HasPreviousPage = after != nil
 	if first != nil && len(nodes) >= *first+1 {
@@ -27125,21 +27945,21 @@ func (c *RemediationConnection) build(nodes []*Remediation, pager *remediationPa
 		c.PageInfo.HasPreviousPage = true
 		nodes = nodes[:*last]
 	}
-	var nodeAt func(int) *Remediation
+	var nodeAt func(int) *RetentionRule
 	if last != nil {
 		n := len(nodes) - 1
-		nodeAt = func(i int) *Remediation {
+		nodeAt = func(i int) *RetentionRule {
 			return nodes[n-i]
 		}
 	} else {
-		nodeAt = func(i int) *Remediation {
+		nodeAt = func(i int) *RetentionRule {
 			return nodes[i]
 		}
 	}
-	c.Edges = make([]*RemediationEdge, len(nodes))
+	c.Edges = make([]*RetentionRuleEdge, len(nodes))
 	for i := range nodes {
 		node := nodeAt(i)
-		c.Edges[i] = &RemediationEdge{
+		c.Edges[i] = &RetentionRuleEdge{
 			Node:   node,
 			Cursor: pager.toCursor(node),
 		}
@@ -27153,12 +27973,12 @@ func (c *RemediationConnection) build(nodes []*Remediation, pager *remediationPa
 	}
 }
 
-// RemediationPaginateOption enables pagination customization.
-type RemediationPaginateOption func(*remediationPager) error
+// RetentionRulePaginateOption enables pagination customization.
+type RetentionRulePaginateOption func(*retentionrulePager) error
 
-// WithRemediationOrder configures pagination ordering.
-func WithRemediationOrder(order []*RemediationOrder) RemediationPaginateOption {
-	return func(pager *remediationPager) error {
+// WithRetentionRuleOrder configures pagination ordering.
+func WithRetentionRuleOrder(order []*RetentionRuleOrder) RetentionRulePaginateOption {
+	return func(pager *retentionrulePager) error {
 		for _, o := range order {
 			if err := o.Direction.Validate(); err != nil {
 				return err
@@ -27169,25 +27989,25 @@ func WithRemediationOrder(order []*RemediationOrder) RemediationPaginateOption {
 	}
 }
 
-// WithRemediationFilter configures pagination filter.
-func WithRemediationFilter(filter func(*RemediationQuery) (*RemediationQuery, error)) RemediationPaginateOption {
-	return func(pager *remediationPager) error {
+// WithRetentionRuleFilter configures pagination filter.
+func WithRetentionRuleFilter(filter func(*RetentionRuleQuery) (*RetentionRuleQuery, error)) RetentionRulePaginateOption {
+	return func(pager *retentionrulePager) error {
 		if filter == nil {
-			return errors.New("RemediationQuery filter cannot be nil")
+			return errors.New("RetentionRuleQuery filter cannot be nil")
 		}
 		pager.filter = filter
 		return nil
 	}
 }
 
-type remediationPager struct {
+type retentionrulePager struct {
 	reverse bool
-	order   []*RemediationOrder
-	filter  func(*RemediationQuery) (*RemediationQuery, error)
+	order   []*RetentionRuleOrder
+	filter  func(*RetentionRuleQuery) (*RetentionRuleQuery, error)
 }
 
-func newRemediationPager(opts []RemediationPaginateOption, reverse bool) (*remediationPager, error) {
-	pager := &remediationPager{reverse: reverse}
+func newRetentionRulePager(opts []RetentionRulePaginateOption, reverse bool) (*retentionrulePager, error) {
+	pager := &retentionrulePager{reverse: reverse}
 	for _, opt := range opts {
 		if err := opt(pager); err != nil {
 			return nil, err
@@ -27201,14 +28021,14 @@ func newRemediationPager(opts []RemediationPaginateOption, reverse bool) (*remed
 	return pager, nil
 }
 
-func (p *remediationPager) applyFilter(query *RemediationQuery) (*RemediationQuery, error) {
+func (p *retentionrulePager) applyFilter(query *RetentionRuleQuery) (*RetentionRuleQuery, error) {
 	if p.filter != nil {
 		return p.filter(query)
 	}
 	return query, nil
 }
 
-func (p *remediationPager) toCursor(_m *Remediation) Cursor {
+func (p *retentionrulePager) toCursor(_m *RetentionRule) Cursor {
 	cs_ := make([]any, 0, len(p.order))
 	for _, o_ := range p.order {
 		cs_ = append(cs_, o_.Field.toCursor(_m).Value)
@@ -27216,7 +28036,7 @@ func (p *remediationPager) toCursor(_m *Remediation) Cursor {
 	return Cursor{ID: _m.ID, Value: cs_}
 }
 
-func (p *remediationPager) applyCursors(query *RemediationQuery, after, before *Cursor) (*RemediationQuery, error) {
+func (p *retentionrulePager) applyCursors(query *RetentionRuleQuery, after, before *Cursor) (*RetentionRuleQuery, error) {
 	idDirection := entgql.OrderDirectionAsc
 	if p.reverse {
 		idDirection = entgql.OrderDirectionDesc
@@ -27231,7 +28051,7 @@ func (p *remediationPager) applyCursors(query *RemediationQuery, after, before *
 		directions = append(directions, direction)
 	}
 	predicates, err := entgql.MultiCursorsPredicate(after, before, &entgql.MultiCursorsOptions{
-		FieldID:     DefaultRemediationOrder.Field.column,
+		FieldID:     DefaultRetentionRuleOrder.Field.column,
 		DirectionID: idDirection,
 		Fields:      fields,
 		Directions:  directions,
@@ -27250,7 +28070,7 @@ func (p *remediationPager) applyCursors(query *RemediationQuery, after, before *
 	return query, nil
 }
 
-func (p *remediationPager) applyOrder(query *RemediationQuery) *RemediationQuery {
+func (p *retentionrulePager) applyOrder(query *RetentionRuleQuery) *RetentionRuleQuery {
 	var defaultOrdered bool
 	for _, o := range p.order {
 		direction := o.Direction
@@ -27258,7 +28078,7 @@ func (p *remediationPager) applyOrder(query *RemediationQuery) *RemediationQuery
 			direction = direction.Reverse()
 		}
 		query = query.Order(o.Field.toTerm(direction.OrderTermOption()))
-		if o.Field.column == DefaultRemediationOrder.Field.column {
+		if o.Field.column == DefaultRetentionRuleOrder.Field.column {
 			defaultOrdered = true
 		}
 		if len(query.ctx.Fields) > 0 {
@@ -27270,12 +28090,12 @@ func (p *remediationPager) applyOrder(query *RemediationQuery) *RemediationQuery
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		query = query.Order(DefaultRemediationOrder.Field.toTerm(direction.OrderTermOption()))
+		query = query.Order(DefaultRetentionRuleOrder.Field.toTerm(direction.OrderTermOption()))
 	}
 	return query
 }
 
-func (p *remediationPager) orderExpr(query *RemediationQuery) sql.Querier {
+func (p *retentionrulePager) orderExpr(query *RetentionRuleQuery) sql.Querier {
 	if len(query.ctx.Fields) > 0 {
 		for _, o := range p.order {
 			query.ctx.AppendFieldOnce(o.Field.column)
@@ -27294,26 +28114,26 @@ func (p *remediationPager) orderExpr(query *RemediationQuery) sql.Querier {
 		if p.reverse {
 			direction = direction.Reverse()
 		}
-		b.Ident(DefaultRemediationOrder.Field.column).Pad().WriteString(string(direction))
+		b.Ident(DefaultRetentionRuleOrder.Field.column).Pad().WriteString(string(direction))
 	})
 }
 
-// Paginate executes the query and returns a relay based cursor connection to Remediation.
-func (_m *RemediationQuery) Paginate(
+// Paginate executes the query and returns a relay based cursor connection to RetentionRule.
+func (_m *RetentionRuleQuery) Paginate(
 	ctx context.Context, after *Cursor, first *int,
-	before *Cursor, last *int, opts ...RemediationPaginateOption,
-) (*RemediationConnection, error) {
+	before *Cursor, last *int, opts ...RetentionRulePaginateOption,
+) (*RetentionRuleConnection, error) {
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
-	pager, err := newRemediationPager(opts, last != nil)
+	pager, err := newRetentionRulePager(opts, last != nil)
 	if err != nil {
 		return nil, err
 	}
 	if _m, err = pager.applyFilter(_m); err != nil {
 		return nil, err
 	}
-	conn := &RemediationConnection{Edges: []*RemediationEdge{}}
+	conn := &RetentionRuleConnection{Edges: []*RetentionRuleEdge{}}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -27333,7 +28153,7 @@ func (_m *RemediationQuery) Paginate(
 	if _m, err = pager.applyCursors(_m, after, before); err != nil {
 		return nil, err
 	}
-	limit := paginateLimitSingle(first, last)
+	limit := paginateLimit(first, last)
 	if limit != 0 {
 		_m.Limit(limit)
 	}
@@ -27352,196 +28172,142 @@ func (_m *RemediationQuery) Paginate(
 }
 
 var (
-	// RemediationOrderFieldCreatedAt orders Remediation by created_at.
-	RemediationOrderFieldCreatedAt = &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
+	// RetentionRuleOrderFieldCreatedAt orders RetentionRule by created_at.
+	RetentionRuleOrderFieldCreatedAt = &RetentionRuleOrderField{
+		Value: func(_m *RetentionRule) (ent.Value, error) {
 			return _m.CreatedAt, nil
 		},
-		column: remediation.FieldCreatedAt,
-		toTerm: remediation.ByCreatedAt,
-		toCursor: func(_m *Remediation) Cursor {
+		column: retentionrule.FieldCreatedAt,
+		toTerm: retentionrule.ByCreatedAt,
+		toCursor: func(_m *RetentionRule) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.CreatedAt,
 			}
 		},
 	}
-	// RemediationOrderFieldUpdatedAt orders Remediation by updated_at.
-	RemediationOrderFieldUpdatedAt = &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
+	// RetentionRuleOrderFieldUpdatedAt orders RetentionRule by updated_at.
+	RetentionRuleOrderFieldUpdatedAt = &RetentionRuleOrderField{
+		Value: func(_m *RetentionRule) (ent.Value, error) {
 			return _m.UpdatedAt, nil
 		},
-		column: remediation.FieldUpdatedAt,
-		toTerm: remediation.ByUpdatedAt,
-		toCursor: func(_m *Remediation) Cursor {
+		column: retentionrule.FieldUpdatedAt,
+		toTerm: retentionrule.ByUpdatedAt,
+		toCursor: func(_m *RetentionRule) Cursor {
 			return Cursor{
 				ID:    _m.ID,
 				Value: _m.UpdatedAt,
 			}
 		},
 	}
-	// RemediationOrderFieldExternalID orders Remediation by external_id.
-	RemediationOrderFieldExternalID = &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
-			return _m.ExternalID, nil
-		},
-		column: remediation.FieldExternalID,
-		toTerm: remediation.ByExternalID,
-		toCursor: func(_m *Remediation) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ExternalID,
-			}
-		},
-	}
-	// RemediationOrderFieldExternalOwnerID orders Remediation by external_owner_id.
-	RemediationOrderFieldExternalOwnerID = &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
-			return _m.ExternalOwnerID, nil
-		},
-		column: remediation.FieldExternalOwnerID,
-		toTerm: remediation.ByExternalOwnerID,
-		toCursor: func(_m *Remediation) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.ExternalOwnerID,
-			}
-		},
-	}
-	// RemediationOrderFieldTitle orders Remediation by title.
-	RemediationOrderFieldTitle = &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
-			return _m.Title, nil
-		},
-		column: remediation.FieldTitle,
-		toTerm: remediation.ByTitle,
-		toCursor: func(_m *Remediation) Cursor {
-			return Cursor{
-				ID:    _m.ID,
-				Value: _m.Title,
-			}
-		},
-	}
-	// RemediationOrderFieldStatus orders Remediation by status.
-	RemediationOrderFieldStatus = &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
-			return _m.Status, nil
+	// RetentionRuleOrderFieldName orders RetentionRule by name.
+	RetentionRuleOrderFieldName = &RetentionRuleOrderField{
+		Value: func(_m *RetentionRule) (ent.Value, error) {
+			return _m.Name, nil
 		},
-		column: remediation.FieldStatus,
-		toTerm: remediation.ByStatus,
-		toCursor: func(_m *Remediation) Cursor {
+		column: retentionrule.FieldName,
+		toTerm: retentionrule.ByName,
+		toCursor: func(_m *RetentionRule) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.Status,
+				Value: _m.Name,
 			}
 		},
 	}
-	// RemediationOrderFieldState orders Remediation by state.
-	RemediationOrderFieldState = &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
-			return _m.State, nil
+	// RetentionRuleOrderFieldRetentionDays orders RetentionRule by retention_days.
+	RetentionRuleOrderFieldRetentionDays = &RetentionRuleOrderField{
+		Value: func(_m *RetentionRule) (ent.Value, error) {
+			return _m.RetentionDays, nil
 		},
-		column: remediation.FieldState,
-		toTerm: remediation.ByState,
-		toCursor: func(_m *Remediation) Cursor {
+		column: retentionrule.FieldRetentionDays,
+		toTerm: retentionrule.ByRetentionDays,
+		toCursor: func(_m *RetentionRule) Cursor {
 			return Cursor{
 				ID:    _m.ID,
-				Value: _m.State,
+				Value: _m.RetentionDays,
 			}
 		},
 	}
 )
 
 // String implement fmt.Stringer interface.
-func (f RemediationOrderField) String() string {
+func (f RetentionRuleOrderField) String() string {
 	var str string
 	switch f.column {
-	case RemediationOrderFieldCreatedAt.column:
+	case RetentionRuleOrderFieldCreatedAt.column:
 		str = "created_at"
-	case RemediationOrderFieldUpdatedAt.column:
+	case RetentionRuleOrderFieldUpdatedAt.column:
 		str = "updated_at"
-	case RemediationOrderFieldExternalID.column:
-		str = "external_id"
-	case RemediationOrderFieldExternalOwnerID.column:
-		str = "external_owner_id"
-	case RemediationOrderFieldTitle.column:
-		str = "title"
-	case RemediationOrderFieldStatus.column:
-		str = "status"
-	case RemediationOrderFieldState.column:
-		str = "state"
+	case RetentionRuleOrderFieldName.column:
+		str = "name"
+	case RetentionRuleOrderFieldRetentionDays.column:
+		str = "retention_days"
 	}
 	return str
 }
 
 // MarshalGQL implements graphql.Marshaler interface.
-func (f RemediationOrderField) MarshalGQL(w io.Writer) {
+func (f RetentionRuleOrderField) MarshalGQL(w io.Writer) {
 	io.WriteString(w, strconv.Quote(f.String()))
 }
 
 // UnmarshalGQL implements graphql.Unmarshaler interface.
-func (f *RemediationOrderField) UnmarshalGQL(v interface{}) error {
+func (f *RetentionRuleOrderField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
-		return fmt.Errorf("RemediationOrderField %T must be a string", v)
+		return fmt.Errorf("RetentionRuleOrderField %T must be a string", v)
 	}
 	switch str {
 	case "created_at":
-		*f = *RemediationOrderFieldCreatedAt
+		*f = *RetentionRuleOrderFieldCreatedAt
 	case "updated_at":
-		*f = *RemediationOrderFieldUpdatedAt
-	case "external_id":
-		*f = *RemediationOrderFieldExternalID
-	case "external_owner_id":
-		*f = *RemediationOrderFieldExternalOwnerID
-	case "title":
-		*f = *RemediationOrderFieldTitle
-	case "status":
-		*f = *RemediationOrderFieldStatus
-	case "state":
-		*f = *RemediationOrderFieldState
+		*f = *RetentionRuleOrderFieldUpdatedAt
+	case "name":
+		*f = *RetentionRuleOrderFieldName
+	case "retention_days":
+		*f = *RetentionRuleOrderFieldRetentionDays
 	default:
-		return fmt.Errorf("%s is not a valid RemediationOrderField", str)
+		return fmt.Errorf("%s is not a valid RetentionRuleOrderField", str)
 	}
 	return nil
 }
 
-// RemediationOrderField defines the ordering field of Remediation.
-type RemediationOrderField struct {
-	// Value extracts the ordering value from the given Remediation.
-	Value    func(*Remediation) (ent.Value, error)
+// RetentionRuleOrderField defines the ordering field of RetentionRule.
+type RetentionRuleOrderField struct {
+	// Value extracts the ordering value from the given RetentionRule.
+	Value    func(*RetentionRule) (ent.Value, error)
 	column   string // field or computed.
-	toTerm   func(...sql.OrderTermOption) remediation.OrderOption
-	toCursor func(*Remediation) Cursor
+	toTerm   func(...sql.OrderTermOption) retentionrule.OrderOption
+	toCursor func(*RetentionRule) Cursor
 }
 
-// RemediationOrder defines the ordering of Remediation.
-type RemediationOrder struct {
-	Direction OrderDirection         `json:"direction"`
-	Field     *RemediationOrderField `json:"field"`
+// RetentionRuleOrder defines the ordering of RetentionRule.
+type RetentionRuleOrder struct {
+	Direction OrderDirection           `json:"direction"`
+	Field     *RetentionRuleOrderField `json:"field"`
 }
 
-// DefaultRemediationOrder is the default ordering of Remediation.
-var DefaultRemediationOrder = &RemediationOrder{
+// DefaultRetentionRuleOrder is the default ordering of RetentionRule.
+var DefaultRetentionRuleOrder = &RetentionRuleOrder{
 	Direction: entgql.OrderDirectionAsc,
-	Field: &RemediationOrderField{
-		Value: func(_m *Remediation) (ent.Value, error) {
+	Field: &RetentionRuleOrderField{
+		Value: func(_m *RetentionRule) (ent.Value, error) {
 			return _m.ID, nil
 		},
-		column: remediation.FieldID,
-		toTerm: remediation.ByID,
-		toCursor: func(_m *Remediation) Cursor {
+		column: retentionrule.FieldID,
+		toTerm: retentionrule.ByID,
+		toCursor: func(_m *RetentionRule) Cursor {
 			return Cursor{ID: _m.ID}
 		},
 	},
 }
 
-// ToEdge converts Remediation into RemediationEdge.
-func (_m *Remediation) ToEdge(order *RemediationOrder) *RemediationEdge {
+// ToEdge converts RetentionRule into RetentionRuleEdge.
+func (_m *RetentionRule) ToEdge(order *RetentionRuleOrder) *RetentionRuleEdge {
 	if order == nil {
-		order = DefaultRemediationOrder
+		order = DefaultRetentionRuleOrder
 	}
-	return &RemediationEdge{
+	return &RetentionRuleEdge{
 		Node:   _m,
 		Cursor: order.Field.toCursor(_m),
 	}
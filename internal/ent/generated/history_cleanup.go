@@ -50,6 +50,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scheduledjob"
@@ -118,6 +119,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -1428,6 +1430,37 @@ func PurgeRemediationHistory(ctx context.Context, ps ...predicate.Remediation) e
 	return nil
 }
 
+// PurgeRetentionRuleHistory removes the history rows belonging to every retentionrule matching
+// the given predicates. It is a no-op unless the context opts in via contextx.WithPurgeHistory, so
+// deletes that should keep their audit trail are unaffected.
+// This has to run before the retentionrule records themselves are deleted, the rows are matched
+// with a sub-select against the retentionrule table
+func PurgeRetentionRuleHistory(ctx context.Context, ps ...predicate.RetentionRule) error {
+	if !contextx.PurgeHistoryEnabled(ctx) {
+		return nil
+	}
+
+	client := FromContext(ctx)
+	if client == nil || client.HistoryClient == nil {
+		return nil
+	}
+
+	refs := sql.Select(retentionrule.FieldID).From(sql.Table(retentionrule.Table))
+	for _, p := range ps {
+		p(refs)
+	}
+
+	if _, err := client.HistoryClient.RetentionRuleHistory.Delete().Where(func(s *sql.Selector) {
+		s.Where(sql.In(retentionrulehistory.FieldRef, refs))
+	}).Exec(history.WithContext(ctx)); err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("error purging retentionrule history")
+
+		return err
+	}
+
+	return nil
+}
+
 // PurgeReviewHistory removes the history rows belonging to every review matching
 // the given predicates. It is a no-op unless the context opts in via contextx.WithPurgeHistory, so
 // deletes that should keep their audit trail are unaffected.
@@ -133,6 +133,9 @@ func (c *Client) WithHistory() {
 	for _, hook := range history.Hooks[*RemediationMutation]() {
 		c.Remediation.Use(hook)
 	}
+	for _, hook := range history.Hooks[*RetentionRuleMutation]() {
+		c.RetentionRule.Use(hook)
+	}
 	for _, hook := range history.Hooks[*ReviewMutation]() {
 		c.Review.Use(hook)
 	}
@@ -2306,6 +2306,10 @@ func (m *CampaignMutation) CreateHistoryFromCreate(ctx context.Context) error {
 		create = create.SetTrustCenterID(trustCenterID)
 	}
 
+	if accessReview, exists := m.AccessReview(); exists {
+		create = create.SetAccessReview(accessReview)
+	}
+
 	_, err := create.Save(ctx)
 
 	return err
@@ -2592,6 +2596,12 @@ func (m *CampaignMutation) CreateHistoryFromUpdate(ctx context.Context) error {
 			create = create.SetTrustCenterID(campaign.TrustCenterID)
 		}
 
+		if accessReview, exists := m.AccessReview(); exists {
+			create = create.SetAccessReview(accessReview)
+		} else {
+			create = create.SetAccessReview(campaign.AccessReview)
+		}
+
 		if _, err := create.Save(ctx); err != nil {
 			return err
 		}
@@ -2672,6 +2682,7 @@ func (m *CampaignMutation) CreateHistoryFromDelete(ctx context.Context) error {
 			SetIntegrationID(campaign.IntegrationID).
 			SetEmailBrandingID(campaign.EmailBrandingID).
 			SetTrustCenterID(campaign.TrustCenterID).
+			SetAccessReview(campaign.AccessReview).
 			Save(ctx)
 		if err != nil {
 			return err
@@ -6157,6 +6168,10 @@ func (m *EntityMutation) CreateHistoryFromCreate(ctx context.Context) error {
 		create = create.SetNillableSoc2PeriodEnd(&soc2PeriodEnd)
 	}
 
+	if soc2LapsedAt, exists := m.Soc2LapsedAt(); exists {
+		create = create.SetNillableSoc2LapsedAt(&soc2LapsedAt)
+	}
+
 	if contractStartDate, exists := m.ContractStartDate(); exists {
 		create = create.SetNillableContractStartDate(&contractStartDate)
 	}
@@ -6535,6 +6550,12 @@ func (m *EntityMutation) CreateHistoryFromUpdate(ctx context.Context) error {
 			create = create.SetNillableSoc2PeriodEnd(entity.Soc2PeriodEnd)
 		}
 
+		if soc2LapsedAt, exists := m.Soc2LapsedAt(); exists {
+			create = create.SetNillableSoc2LapsedAt(&soc2LapsedAt)
+		} else {
+			create = create.SetNillableSoc2LapsedAt(entity.Soc2LapsedAt)
+		}
+
 		if contractStartDate, exists := m.ContractStartDate(); exists {
 			create = create.SetNillableContractStartDate(&contractStartDate)
 		} else {
@@ -6769,6 +6790,7 @@ func (m *EntityMutation) CreateHistoryFromDelete(ctx context.Context) error {
 			SetLinkedAssetIds(entity.LinkedAssetIds).
 			SetHasSoc2(entity.HasSoc2).
 			SetNillableSoc2PeriodEnd(entity.Soc2PeriodEnd).
+			SetNillableSoc2LapsedAt(entity.Soc2LapsedAt).
 			SetNillableContractStartDate(entity.ContractStartDate).
 			SetNillableContractEndDate(entity.ContractEndDate).
 			SetAutoRenews(entity.AutoRenews).
@@ -7632,6 +7654,10 @@ func (m *FileMutation) CreateHistoryFromCreate(ctx context.Context) error {
 		create = create.SetNillableLastAccessedAt(&lastAccessedAt)
 	}
 
+	if scanStatus, exists := m.ScanStatus(); exists {
+		create = create.SetScanStatus(scanStatus)
+	}
+
 	_, err := create.Save(ctx)
 
 	return err
@@ -7882,6 +7908,12 @@ func (m *FileMutation) CreateHistoryFromUpdate(ctx context.Context) error {
 			create = create.SetNillableLastAccessedAt(file.LastAccessedAt)
 		}
 
+		if scanStatus, exists := m.ScanStatus(); exists {
+			create = create.SetScanStatus(scanStatus)
+		} else {
+			create = create.SetScanStatus(file.ScanStatus)
+		}
+
 		if _, err := create.Save(ctx); err != nil {
 			return err
 		}
@@ -7956,6 +7988,7 @@ func (m *FileMutation) CreateHistoryFromDelete(ctx context.Context) error {
 			SetStorageRegion(file.StorageRegion).
 			SetStorageProvider(file.StorageProvider).
 			SetNillableLastAccessedAt(file.LastAccessedAt).
+			SetScanStatus(file.ScanStatus).
 			Save(ctx)
 		if err != nil {
 			return err
@@ -17306,6 +17339,272 @@ func (m *RemediationMutation) CreateHistoryFromDelete(ctx context.Context) error
 	return nil
 }
 
+func (m *RetentionRuleMutation) skipper(ctx context.Context) bool {
+
+	if PurgeHistoryEnabled(ctx) {
+		return true
+	}
+
+	caller, _ := auth.CallerFromContext(ctx)
+
+	return caller.HasInLineage(auth.CapBypassAuditLog)
+
+}
+
+func (m *RetentionRuleMutation) CreateHistoryFromCreate(ctx context.Context) error {
+	ctx = history.WithContext(ctx)
+	if m.skipper(ctx) {
+		return nil
+	}
+	client := m.Client()
+
+	id, ok := m.ID()
+	if !ok {
+		return idNotFoundError
+	}
+
+	create := client.HistoryClient.RetentionRuleHistory.Create()
+
+	create = create.
+		SetOperation(EntOpToHistoryOp(m.Op())).
+		SetHistoryTime(time.Now()).
+		SetRef(id)
+
+	if createdAt, exists := m.CreatedAt(); exists {
+		create = create.SetCreatedAt(createdAt)
+	}
+
+	if updatedAt, exists := m.UpdatedAt(); exists {
+		create = create.SetUpdatedAt(updatedAt)
+	}
+
+	if createdBy, exists := m.CreatedBy(); exists {
+		create = create.SetCreatedBy(createdBy)
+	}
+
+	if updatedBy, exists := m.UpdatedBy(); exists {
+		create = create.SetUpdatedBy(updatedBy)
+	}
+
+	if updatedByImpersonator, exists := m.UpdatedByImpersonator(); exists {
+		create = create.SetNillableUpdatedByImpersonator(&updatedByImpersonator)
+	}
+
+	if deletedAt, exists := m.DeletedAt(); exists {
+		create = create.SetDeletedAt(deletedAt)
+	}
+
+	if deletedBy, exists := m.DeletedBy(); exists {
+		create = create.SetDeletedBy(deletedBy)
+	}
+
+	if ownerID, exists := m.OwnerID(); exists {
+		create = create.SetOwnerID(ownerID)
+	}
+
+	if name, exists := m.Name(); exists {
+		create = create.SetName(name)
+	}
+
+	if description, exists := m.Description(); exists {
+		create = create.SetDescription(description)
+	}
+
+	if category, exists := m.Category(); exists {
+		create = create.SetCategory(category)
+	}
+
+	if objectType, exists := m.ObjectType(); exists {
+		create = create.SetObjectType(objectType)
+	}
+
+	if retentionDays, exists := m.RetentionDays(); exists {
+		create = create.SetRetentionDays(retentionDays)
+	}
+
+	if purge, exists := m.Purge(); exists {
+		create = create.SetPurge(purge)
+	}
+
+	_, err := create.Save(ctx)
+
+	return err
+}
+
+func (m *RetentionRuleMutation) CreateHistoryFromUpdate(ctx context.Context) error {
+	ctx = history.WithContext(ctx)
+	if m.skipper(ctx) {
+		return nil
+	}
+	// check for soft delete operation and delete instead
+	if entx.CheckIsSoftDeleteType(ctx, m.Type()) {
+		return m.CreateHistoryFromDelete(ctx)
+	}
+	client := m.Client()
+
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("getting ids: %w", err)
+	}
+
+	for _, id := range ids {
+		retentionrule, err := client.RetentionRule.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		create := client.HistoryClient.RetentionRuleHistory.Create()
+
+		create = create.
+			SetOperation(EntOpToHistoryOp(m.Op())).
+			SetHistoryTime(time.Now()).
+			SetRef(id)
+
+		if createdAt, exists := m.CreatedAt(); exists {
+			create = create.SetCreatedAt(createdAt)
+		} else {
+			create = create.SetCreatedAt(retentionrule.CreatedAt)
+		}
+
+		if updatedAt, exists := m.UpdatedAt(); exists {
+			create = create.SetUpdatedAt(updatedAt)
+		} else {
+			create = create.SetUpdatedAt(retentionrule.UpdatedAt)
+		}
+
+		if createdBy, exists := m.CreatedBy(); exists {
+			create = create.SetCreatedBy(createdBy)
+		} else {
+			create = create.SetCreatedBy(retentionrule.CreatedBy)
+		}
+
+		if updatedBy, exists := m.UpdatedBy(); exists {
+			create = create.SetUpdatedBy(updatedBy)
+		} else {
+			create = create.SetUpdatedBy(retentionrule.UpdatedBy)
+		}
+
+		if updatedByImpersonator, exists := m.UpdatedByImpersonator(); exists {
+			create = create.SetNillableUpdatedByImpersonator(&updatedByImpersonator)
+		} else {
+			create = create.SetNillableUpdatedByImpersonator(retentionrule.UpdatedByImpersonator)
+		}
+
+		if deletedAt, exists := m.DeletedAt(); exists {
+			create = create.SetDeletedAt(deletedAt)
+		} else {
+			create = create.SetDeletedAt(retentionrule.DeletedAt)
+		}
+
+		if deletedBy, exists := m.DeletedBy(); exists {
+			create = create.SetDeletedBy(deletedBy)
+		} else {
+			create = create.SetDeletedBy(retentionrule.DeletedBy)
+		}
+
+		if ownerID, exists := m.OwnerID(); exists {
+			create = create.SetOwnerID(ownerID)
+		} else {
+			create = create.SetOwnerID(retentionrule.OwnerID)
+		}
+
+		if name, exists := m.Name(); exists {
+			create = create.SetName(name)
+		} else {
+			create = create.SetName(retentionrule.Name)
+		}
+
+		if description, exists := m.Description(); exists {
+			create = create.SetDescription(description)
+		} else {
+			create = create.SetDescription(retentionrule.Description)
+		}
+
+		if category, exists := m.Category(); exists {
+			create = create.SetCategory(category)
+		} else {
+			create = create.SetCategory(retentionrule.Category)
+		}
+
+		if objectType, exists := m.ObjectType(); exists {
+			create = create.SetObjectType(objectType)
+		} else {
+			create = create.SetObjectType(retentionrule.ObjectType)
+		}
+
+		if retentionDays, exists := m.RetentionDays(); exists {
+			create = create.SetRetentionDays(retentionDays)
+		} else {
+			create = create.SetRetentionDays(retentionrule.RetentionDays)
+		}
+
+		if purge, exists := m.Purge(); exists {
+			create = create.SetPurge(purge)
+		} else {
+			create = create.SetPurge(retentionrule.Purge)
+		}
+
+		if _, err := create.Save(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *RetentionRuleMutation) CreateHistoryFromDelete(ctx context.Context) error {
+	ctx = history.WithContext(ctx)
+	if m.skipper(ctx) {
+		return nil
+	}
+
+	// check for soft delete operation and skip so it happens on update
+	if entx.CheckIsSoftDeleteType(ctx, m.Type()) {
+		return nil
+	}
+
+	client := m.Client()
+
+	ids, err := m.IDs(ctx)
+	if err != nil {
+		return fmt.Errorf("getting ids: %w", err)
+	}
+
+	for _, id := range ids {
+		retentionrule, err := client.RetentionRule.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		create := client.HistoryClient.RetentionRuleHistory.Create()
+
+		_, err = create.
+			SetOperation(EntOpToHistoryOp(m.Op())).
+			SetHistoryTime(time.Now()).
+			SetRef(id).
+			SetCreatedAt(retentionrule.CreatedAt).
+			SetUpdatedAt(retentionrule.UpdatedAt).
+			SetCreatedBy(retentionrule.CreatedBy).
+			SetUpdatedBy(retentionrule.UpdatedBy).
+			SetNillableUpdatedByImpersonator(retentionrule.UpdatedByImpersonator).
+			SetDeletedAt(retentionrule.DeletedAt).
+			SetDeletedBy(retentionrule.DeletedBy).
+			SetOwnerID(retentionrule.OwnerID).
+			SetName(retentionrule.Name).
+			SetDescription(retentionrule.Description).
+			SetCategory(retentionrule.Category).
+			SetObjectType(retentionrule.ObjectType).
+			SetRetentionDays(retentionrule.RetentionDays).
+			SetPurge(retentionrule.Purge).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *ReviewMutation) skipper(ctx context.Context) bool {
 
 	if PurgeHistoryEnabled(ctx) {
@@ -22598,6 +22897,10 @@ func (m *TrustCenterFAQMutation) CreateHistoryFromCreate(ctx context.Context) er
 		create = create.SetDisplayOrder(displayOrder)
 	}
 
+	if approved, exists := m.Approved(); exists {
+		create = create.SetApproved(approved)
+	}
+
 	_, err := create.Save(ctx)
 
 	return err
@@ -22710,6 +23013,12 @@ func (m *TrustCenterFAQMutation) CreateHistoryFromUpdate(ctx context.Context) er
 			create = create.SetDisplayOrder(trustcenterfaq.DisplayOrder)
 		}
 
+		if approved, exists := m.Approved(); exists {
+			create = create.SetApproved(approved)
+		} else {
+			create = create.SetApproved(trustcenterfaq.Approved)
+		}
+
 		if _, err := create.Save(ctx); err != nil {
 			return err
 		}
@@ -22761,6 +23070,7 @@ func (m *TrustCenterFAQMutation) CreateHistoryFromDelete(ctx context.Context) er
 			SetTrustCenterID(trustcenterfaq.TrustCenterID).
 			SetReferenceLink(trustcenterfaq.ReferenceLink).
 			SetDisplayOrder(trustcenterfaq.DisplayOrder).
+			SetApproved(trustcenterfaq.Approved).
 			Save(ctx)
 		if err != nil {
 			return err
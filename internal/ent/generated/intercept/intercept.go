@@ -21,6 +21,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -79,6 +80,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -525,6 +527,33 @@ func (f TraverseCustomDomain) Traverse(ctx context.Context, q generated.Query) e
 	return fmt.Errorf("unexpected query type %T. expect *generated.CustomDomainQuery", q)
 }
 
+// The CustomRoleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type CustomRoleFunc func(context.Context, *generated.CustomRoleQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f CustomRoleFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.CustomRoleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.CustomRoleQuery", q)
+}
+
+// The TraverseCustomRole type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseCustomRole func(context.Context, *generated.CustomRoleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseCustomRole) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseCustomRole) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.CustomRoleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.CustomRoleQuery", q)
+}
+
 // The CustomTypeEnumFunc type is an adapter to allow the use of ordinary function as a Querier.
 type CustomTypeEnumFunc func(context.Context, *generated.CustomTypeEnumQuery) (generated.Value, error)
 
@@ -2064,6 +2093,33 @@ func (f TraverseRemediation) Traverse(ctx context.Context, q generated.Query) er
 	return fmt.Errorf("unexpected query type %T. expect *generated.RemediationQuery", q)
 }
 
+// The RetentionRuleFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RetentionRuleFunc func(context.Context, *generated.RetentionRuleQuery) (generated.Value, error)
+
+// Query calls f(ctx, q).
+func (f RetentionRuleFunc) Query(ctx context.Context, q generated.Query) (generated.Value, error) {
+	if q, ok := q.(*generated.RetentionRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *generated.RetentionRuleQuery", q)
+}
+
+// The TraverseRetentionRule type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRetentionRule func(context.Context, *generated.RetentionRuleQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRetentionRule) Intercept(next generated.Querier) generated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRetentionRule) Traverse(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RetentionRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *generated.RetentionRuleQuery", q)
+}
+
 // The ReviewFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ReviewFunc func(context.Context, *generated.ReviewQuery) (generated.Value, error)
 
@@ -3092,6 +3148,8 @@ func NewQuery(q generated.Query) (Query, error) {
 		return &query[*generated.ControlObjectiveQuery, predicate.ControlObjective, controlobjective.OrderOption]{typ: generated.TypeControlObjective, tq: q}, nil
 	case *generated.CustomDomainQuery:
 		return &query[*generated.CustomDomainQuery, predicate.CustomDomain, customdomain.OrderOption]{typ: generated.TypeCustomDomain, tq: q}, nil
+	case *generated.CustomRoleQuery:
+		return &query[*generated.CustomRoleQuery, predicate.CustomRole, customrole.OrderOption]{typ: generated.TypeCustomRole, tq: q}, nil
 	case *generated.CustomTypeEnumQuery:
 		return &query[*generated.CustomTypeEnumQuery, predicate.CustomTypeEnum, customtypeenum.OrderOption]{typ: generated.TypeCustomTypeEnum, tq: q}, nil
 	case *generated.DNSVerificationQuery:
@@ -3206,6 +3264,8 @@ func NewQuery(q generated.Query) (Query, error) {
 		return &query[*generated.ProgramMembershipQuery, predicate.ProgramMembership, programmembership.OrderOption]{typ: generated.TypeProgramMembership, tq: q}, nil
 	case *generated.RemediationQuery:
 		return &query[*generated.RemediationQuery, predicate.Remediation, remediation.OrderOption]{typ: generated.TypeRemediation, tq: q}, nil
+	case *generated.RetentionRuleQuery:
+		return &query[*generated.RetentionRuleQuery, predicate.RetentionRule, retentionrule.OrderOption]{typ: generated.TypeRetentionRule, tq: q}, nil
 	case *generated.ReviewQuery:
 		return &query[*generated.ReviewQuery, predicate.Review, review.OrderOption]{typ: generated.TypeReview, tq: q}, nil
 	case *generated.RiskQuery:
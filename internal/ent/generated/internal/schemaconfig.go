@@ -56,6 +56,7 @@ type SchemaConfig struct {
 	ControlObjectiveViewers            string // ControlObjective-viewers->Group table.
 	ControlObjectiveTasks              string // ControlObjective-tasks->Task table.
 	CustomDomain                       string // CustomDomain table.
+	CustomRole                         string // CustomRole table.
 	CustomTypeEnum                     string // CustomTypeEnum table.
 	DNSVerification                    string // DNSVerification table.
 	DirectoryAccount                   string // DirectoryAccount table.
@@ -245,6 +246,7 @@ type SchemaConfig struct {
 	RemediationPrograms                string // Remediation-programs->Program table.
 	RemediationAssets                  string // Remediation-assets->Asset table.
 	RemediationEntities                string // Remediation-entities->Entity table.
+	RetentionRule                      string // RetentionRule table.
 	Review                             string // Review table.
 	ReviewBlockedGroups                string // Review-blocked_groups->Group table.
 	ReviewEditors                      string // Review-editors->Group table.
@@ -567,7 +567,7 @@ var (
 		{Name: "workflow_eligible_marker", Type: field.TypeBool, Nullable: true, Default: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "campaign_type", Type: field.TypeEnum, Enums: []string{"QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE"}, Default: "QUESTIONNAIRE"},
+		{Name: "campaign_type", Type: field.TypeEnum, Enums: []string{"QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE", "ACCESS_REVIEW"}, Default: "QUESTIONNAIRE"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"DRAFT", "SCHEDULED", "ACTIVE", "COMPLETED", "CANCELED"}, Default: "DRAFT"},
 		{Name: "is_active", Type: field.TypeBool, Default: false},
 		{Name: "scheduled_at", Type: field.TypeTime, Nullable: true},
@@ -587,6 +587,7 @@ var (
 		{Name: "last_resent_at", Type: field.TypeTime, Nullable: true},
 		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
 		{Name: "email_branding_id", Type: field.TypeString, Nullable: true},
+		{Name: "access_review", Type: field.TypeJSON, Nullable: true},
 		{Name: "assessment_id", Type: field.TypeString, Nullable: true},
 		{Name: "internal_owner_user_id", Type: field.TypeString, Nullable: true},
 		{Name: "internal_owner_group_id", Type: field.TypeString, Nullable: true},
@@ -605,55 +606,55 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "campaigns_assessments_campaigns",
-				Columns:    []*schema.Column{CampaignsColumns[34]},
+				Columns:    []*schema.Column{CampaignsColumns[35]},
 				RefColumns: []*schema.Column{AssessmentsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_users_internal_owner_user",
-				Columns:    []*schema.Column{CampaignsColumns[35]},
+				Columns:    []*schema.Column{CampaignsColumns[36]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_groups_internal_owner_group",
-				Columns:    []*schema.Column{CampaignsColumns[36]},
+				Columns:    []*schema.Column{CampaignsColumns[37]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_email_templates_campaigns",
-				Columns:    []*schema.Column{CampaignsColumns[37]},
+				Columns:    []*schema.Column{CampaignsColumns[38]},
 				RefColumns: []*schema.Column{EmailTemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_entities_campaigns",
-				Columns:    []*schema.Column{CampaignsColumns[38]},
+				Columns:    []*schema.Column{CampaignsColumns[39]},
 				RefColumns: []*schema.Column{EntitiesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_integrations_campaigns",
-				Columns:    []*schema.Column{CampaignsColumns[39]},
+				Columns:    []*schema.Column{CampaignsColumns[40]},
 				RefColumns: []*schema.Column{IntegrationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_organizations_campaigns",
-				Columns:    []*schema.Column{CampaignsColumns[40]},
+				Columns:    []*schema.Column{CampaignsColumns[41]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_templates_campaigns",
-				Columns:    []*schema.Column{CampaignsColumns[41]},
+				Columns:    []*schema.Column{CampaignsColumns[42]},
 				RefColumns: []*schema.Column{TemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "campaigns_trust_centers_campaigns",
-				Columns:    []*schema.Column{CampaignsColumns[42]},
+				Columns:    []*schema.Column{CampaignsColumns[43]},
 				RefColumns: []*schema.Column{TrustCentersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -662,42 +663,42 @@ var (
 			{
 				Name:    "campaign_assessment_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[34]},
+				Columns: []*schema.Column{CampaignsColumns[35]},
 			},
 			{
 				Name:    "campaign_template_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[41]},
+				Columns: []*schema.Column{CampaignsColumns[42]},
 			},
 			{
 				Name:    "campaign_integration_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[39]},
+				Columns: []*schema.Column{CampaignsColumns[40]},
 			},
 			{
 				Name:    "campaign_email_template_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[37]},
+				Columns: []*schema.Column{CampaignsColumns[38]},
 			},
 			{
 				Name:    "campaign_trust_center_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[42]},
+				Columns: []*schema.Column{CampaignsColumns[43]},
 			},
 			{
 				Name:    "campaign_display_id_owner_id",
 				Unique:  true,
-				Columns: []*schema.Column{CampaignsColumns[8], CampaignsColumns[40]},
+				Columns: []*schema.Column{CampaignsColumns[8], CampaignsColumns[41]},
 			},
 			{
 				Name:    "campaign_owner_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[40]},
+				Columns: []*schema.Column{CampaignsColumns[41]},
 			},
 			{
 				Name:    "campaign_name_owner_id",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[12], CampaignsColumns[40]},
+				Columns: []*schema.Column{CampaignsColumns[12], CampaignsColumns[41]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at is NULL",
 				},
@@ -705,7 +706,7 @@ var (
 			{
 				Name:    "campaign_entity_id",
 				Unique:  false,
-				Columns: []*schema.Column{CampaignsColumns[38]},
+				Columns: []*schema.Column{CampaignsColumns[39]},
 			},
 		},
 	}
@@ -1285,6 +1286,52 @@ var (
 			},
 		},
 	}
+	// CustomRolesColumns holds the columns for the "custom_roles" table.
+	CustomRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by_impersonator", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString, SchemaType: map[string]string{"postgres": "citext"}},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "program_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "group_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+	}
+	// CustomRolesTable holds the schema information for the "custom_roles" table.
+	CustomRolesTable = &schema.Table{
+		Name:       "custom_roles",
+		Columns:    CustomRolesColumns,
+		PrimaryKey: []*schema.Column{CustomRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "custom_roles_organizations_custom_roles",
+				Columns:    []*schema.Column{CustomRolesColumns[13]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "custom_role_owner_id_idx",
+				Unique:  false,
+				Columns: []*schema.Column{CustomRolesColumns[13]},
+			},
+			{
+				Name:    "customrole_name_owner_id",
+				Unique:  true,
+				Columns: []*schema.Column{CustomRolesColumns[8], CustomRolesColumns[13]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at is NULL",
+				},
+			},
+		},
+	}
 	// CustomTypeEnumsColumns holds the columns for the "custom_type_enums" table.
 	CustomTypeEnumsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -2266,6 +2313,7 @@ var (
 		{Name: "linked_asset_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "has_soc2", Type: field.TypeBool, Nullable: true, Default: false},
 		{Name: "soc2_period_end", Type: field.TypeTime, Nullable: true},
+		{Name: "soc2_lapsed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "contract_start_date", Type: field.TypeTime, Nullable: true},
 		{Name: "contract_end_date", Type: field.TypeTime, Nullable: true},
 		{Name: "auto_renews", Type: field.TypeBool, Nullable: true, Default: false},
@@ -2314,85 +2362,85 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "entities_users_internal_owner_user",
-				Columns:    []*schema.Column{EntitiesColumns[55]},
+				Columns:    []*schema.Column{EntitiesColumns[56]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_groups_internal_owner_group",
-				Columns:    []*schema.Column{EntitiesColumns[56]},
+				Columns:    []*schema.Column{EntitiesColumns[57]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_users_reviewed_by_user",
-				Columns:    []*schema.Column{EntitiesColumns[57]},
+				Columns:    []*schema.Column{EntitiesColumns[58]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_groups_reviewed_by_group",
-				Columns:    []*schema.Column{EntitiesColumns[58]},
+				Columns:    []*schema.Column{EntitiesColumns[59]},
 				RefColumns: []*schema.Column{GroupsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_custom_type_enums_entity_relationship_state",
-				Columns:    []*schema.Column{EntitiesColumns[59]},
+				Columns:    []*schema.Column{EntitiesColumns[60]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_custom_type_enums_entity_security_questionnaire_status",
-				Columns:    []*schema.Column{EntitiesColumns[60]},
+				Columns:    []*schema.Column{EntitiesColumns[61]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_custom_type_enums_entity_source_type",
-				Columns:    []*schema.Column{EntitiesColumns[61]},
+				Columns:    []*schema.Column{EntitiesColumns[62]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_custom_type_enums_environment",
-				Columns:    []*schema.Column{EntitiesColumns[62]},
+				Columns:    []*schema.Column{EntitiesColumns[63]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_custom_type_enums_scope",
-				Columns:    []*schema.Column{EntitiesColumns[63]},
+				Columns:    []*schema.Column{EntitiesColumns[64]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_entity_types_entity_type",
-				Columns:    []*schema.Column{EntitiesColumns[64]},
+				Columns:    []*schema.Column{EntitiesColumns[65]},
 				RefColumns: []*schema.Column{EntityTypesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_files_logo_file",
-				Columns:    []*schema.Column{EntitiesColumns[65]},
+				Columns:    []*schema.Column{EntitiesColumns[66]},
 				RefColumns: []*schema.Column{FilesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_entity_types_entities",
-				Columns:    []*schema.Column{EntitiesColumns[66]},
+				Columns:    []*schema.Column{EntitiesColumns[67]},
 				RefColumns: []*schema.Column{EntityTypesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_organizations_entities",
-				Columns:    []*schema.Column{EntitiesColumns[67]},
+				Columns:    []*schema.Column{EntitiesColumns[68]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "entities_risks_entities",
-				Columns:    []*schema.Column{EntitiesColumns[68]},
+				Columns:    []*schema.Column{EntitiesColumns[69]},
 				RefColumns: []*schema.Column{RisksColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -2401,22 +2449,22 @@ var (
 			{
 				Name:    "entity_entity_type_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[64]},
+				Columns: []*schema.Column{EntitiesColumns[65]},
 			},
 			{
 				Name:    "entity_logo_file_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[65]},
+				Columns: []*schema.Column{EntitiesColumns[66]},
 			},
 			{
 				Name:    "entity_owner_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[67]},
+				Columns: []*schema.Column{EntitiesColumns[68]},
 			},
 			{
 				Name:    "entity_name_owner_id",
 				Unique:  true,
-				Columns: []*schema.Column{EntitiesColumns[20], EntitiesColumns[67]},
+				Columns: []*schema.Column{EntitiesColumns[20], EntitiesColumns[68]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at is NULL",
 				},
@@ -2424,7 +2472,7 @@ var (
 			{
 				Name:    "entity_reviewed_by_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{EntitiesColumns[57]},
+				Columns: []*schema.Column{EntitiesColumns[58]},
 			},
 		},
 	}
@@ -2663,6 +2711,7 @@ var (
 		{Name: "storage_region", Type: field.TypeString, Nullable: true},
 		{Name: "storage_provider", Type: field.TypeString, Nullable: true},
 		{Name: "last_accessed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scan_status", Type: field.TypeString, Nullable: true},
 		{Name: "email_template_files", Type: field.TypeString, Nullable: true},
 		{Name: "export_files", Type: field.TypeString, Nullable: true},
 		{Name: "environment_id", Type: field.TypeString, Nullable: true},
@@ -2686,85 +2735,85 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "files_email_templates_files",
-				Columns:    []*schema.Column{FilesColumns[34]},
+				Columns:    []*schema.Column{FilesColumns[35]},
 				RefColumns: []*schema.Column{EmailTemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_exports_files",
-				Columns:    []*schema.Column{FilesColumns[35]},
+				Columns:    []*schema.Column{FilesColumns[36]},
 				RefColumns: []*schema.Column{ExportsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_custom_type_enums_environment",
-				Columns:    []*schema.Column{FilesColumns[36]},
+				Columns:    []*schema.Column{FilesColumns[37]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_custom_type_enums_scope",
-				Columns:    []*schema.Column{FilesColumns[37]},
+				Columns:    []*schema.Column{FilesColumns[38]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_custom_type_enums_category",
-				Columns:    []*schema.Column{FilesColumns[38]},
+				Columns:    []*schema.Column{FilesColumns[39]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_findings_files",
-				Columns:    []*schema.Column{FilesColumns[39]},
+				Columns:    []*schema.Column{FilesColumns[40]},
 				RefColumns: []*schema.Column{FindingsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_integrations_files",
-				Columns:    []*schema.Column{FilesColumns[40]},
+				Columns:    []*schema.Column{FilesColumns[41]},
 				RefColumns: []*schema.Column{IntegrationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_notes_files",
-				Columns:    []*schema.Column{FilesColumns[41]},
+				Columns:    []*schema.Column{FilesColumns[42]},
 				RefColumns: []*schema.Column{NotesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_platforms_architecture_diagrams",
-				Columns:    []*schema.Column{FilesColumns[42]},
+				Columns:    []*schema.Column{FilesColumns[43]},
 				RefColumns: []*schema.Column{PlatformsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_platforms_data_flow_diagrams",
-				Columns:    []*schema.Column{FilesColumns[43]},
+				Columns:    []*schema.Column{FilesColumns[44]},
 				RefColumns: []*schema.Column{PlatformsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_platforms_trust_boundary_diagrams",
-				Columns:    []*schema.Column{FilesColumns[44]},
+				Columns:    []*schema.Column{FilesColumns[45]},
 				RefColumns: []*schema.Column{PlatformsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_remediations_files",
-				Columns:    []*schema.Column{FilesColumns[45]},
+				Columns:    []*schema.Column{FilesColumns[46]},
 				RefColumns: []*schema.Column{RemediationsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_reviews_files",
-				Columns:    []*schema.Column{FilesColumns[46]},
+				Columns:    []*schema.Column{FilesColumns[47]},
 				RefColumns: []*schema.Column{ReviewsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "files_vulnerabilities_files",
-				Columns:    []*schema.Column{FilesColumns[47]},
+				Columns:    []*schema.Column{FilesColumns[48]},
 				RefColumns: []*schema.Column{VulnerabilitiesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -6644,6 +6693,53 @@ var (
 			},
 		},
 	}
+	// RetentionRulesColumns holds the columns for the "retention_rules" table.
+	RetentionRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by_impersonator", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "object_type", Type: field.TypeString, Nullable: true},
+		{Name: "retention_days", Type: field.TypeInt},
+		{Name: "purge", Type: field.TypeBool, Default: false},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+	}
+	// RetentionRulesTable holds the schema information for the "retention_rules" table.
+	RetentionRulesTable = &schema.Table{
+		Name:       "retention_rules",
+		Columns:    RetentionRulesColumns,
+		PrimaryKey: []*schema.Column{RetentionRulesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "retention_rules_organizations_retention_rules",
+				Columns:    []*schema.Column{RetentionRulesColumns[14]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "retention_rule_owner_id_idx",
+				Unique:  false,
+				Columns: []*schema.Column{RetentionRulesColumns[14]},
+			},
+			{
+				Name:    "retentionrule_name_owner_id",
+				Unique:  true,
+				Columns: []*schema.Column{RetentionRulesColumns[8], RetentionRulesColumns[14]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "deleted_at is NULL",
+				},
+			},
+		},
+	}
 	// ReviewsColumns holds the columns for the "reviews" table.
 	ReviewsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -8298,6 +8394,7 @@ var (
 		{Name: "trust_center_faq_kind_name", Type: field.TypeString, Nullable: true},
 		{Name: "reference_link", Type: field.TypeString, Nullable: true},
 		{Name: "display_order", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "approved", Type: field.TypeBool, Nullable: true, Default: false},
 		{Name: "note_id", Type: field.TypeString},
 		{Name: "trust_center_id", Type: field.TypeString, Nullable: true},
 		{Name: "trust_center_faq_kind_id", Type: field.TypeString, Nullable: true},
@@ -8310,19 +8407,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "trust_center_faqs_notes_trust_center_faqs",
-				Columns:    []*schema.Column{TrustCenterFaqsColumns[11]},
+				Columns:    []*schema.Column{TrustCenterFaqsColumns[12]},
 				RefColumns: []*schema.Column{NotesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "trust_center_faqs_trust_centers_trust_center_faqs",
-				Columns:    []*schema.Column{TrustCenterFaqsColumns[12]},
+				Columns:    []*schema.Column{TrustCenterFaqsColumns[13]},
 				RefColumns: []*schema.Column{TrustCentersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "trust_center_faqs_custom_type_enums_trust_center_faq_kind",
-				Columns:    []*schema.Column{TrustCenterFaqsColumns[13]},
+				Columns:    []*schema.Column{TrustCenterFaqsColumns[14]},
 				RefColumns: []*schema.Column{CustomTypeEnumsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -8331,12 +8428,12 @@ var (
 			{
 				Name:    "trust_center_faq_trust_center_id_idx",
 				Unique:  false,
-				Columns: []*schema.Column{TrustCenterFaqsColumns[12]},
+				Columns: []*schema.Column{TrustCenterFaqsColumns[13]},
 			},
 			{
 				Name:    "trustcenterfaq_note_id_trust_center_id",
 				Unique:  true,
-				Columns: []*schema.Column{TrustCenterFaqsColumns[11], TrustCenterFaqsColumns[12]},
+				Columns: []*schema.Column{TrustCenterFaqsColumns[12], TrustCenterFaqsColumns[13]},
 				Annotation: &entsql.IndexAnnotation{
 					Where: "deleted_at is NULL",
 				},
@@ -15606,6 +15703,7 @@ var (
 		ControlImplementationsTable,
 		ControlObjectivesTable,
 		CustomDomainsTable,
+		CustomRolesTable,
 		CustomTypeEnumsTable,
 		DNSVerificationsTable,
 		DirectoryAccountsTable,
@@ -15663,6 +15761,7 @@ var (
 		ProgramsTable,
 		ProgramMembershipsTable,
 		RemediationsTable,
+		RetentionRulesTable,
 		ReviewsTable,
 		RisksTable,
 		SLADefinitionsTable,
@@ -15988,6 +16087,7 @@ func init() {
 	CustomDomainsTable.ForeignKeys[2].RefTable = DNSVerificationsTable
 	CustomDomainsTable.ForeignKeys[3].RefTable = MappableDomainsTable
 	CustomDomainsTable.ForeignKeys[4].RefTable = OrganizationsTable
+	CustomRolesTable.ForeignKeys[0].RefTable = OrganizationsTable
 	CustomTypeEnumsTable.ForeignKeys[0].RefTable = EntitiesTable
 	CustomTypeEnumsTable.ForeignKeys[1].RefTable = OrganizationsTable
 	DNSVerificationsTable.ForeignKeys[0].RefTable = OrganizationsTable
@@ -16338,6 +16438,7 @@ func init() {
 	RemediationsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	RemediationsTable.ForeignKeys[1].RefTable = CustomTypeEnumsTable
 	RemediationsTable.ForeignKeys[2].RefTable = CustomTypeEnumsTable
+	RetentionRulesTable.ForeignKeys[0].RefTable = OrganizationsTable
 	ReviewsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	ReviewsTable.ForeignKeys[1].RefTable = CustomTypeEnumsTable
 	ReviewsTable.ForeignKeys[2].RefTable = CustomTypeEnumsTable
@@ -404,13 +404,17 @@ type OrganizationEdges struct {
 	VendorScoringConfigs []*VendorScoringConfig `json:"vendor_scoring_configs,omitempty"`
 	// VendorRiskScores holds the value of the vendor_risk_scores edge.
 	VendorRiskScores []*VendorRiskScore `json:"vendor_risk_scores,omitempty"`
+	// RetentionRules holds the value of the retention_rules edge.
+	RetentionRules []*RetentionRule `json:"retention_rules,omitempty"`
+	// CustomRoles holds the value of the custom_roles edge.
+	CustomRoles []*CustomRole `json:"custom_roles,omitempty"`
 	// Members holds the value of the members edge.
 	Members []*OrgMembership `json:"members,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [171]bool
+	loadedTypes [173]bool
 	// totalCount holds the count of the edges above.
-	totalCount [163]map[string]int
+	totalCount [165]map[string]int
 
 	namedActionPlanCreators                 map[string][]*Group
 	namedAPITokenCreators                   map[string][]*Group
@@ -579,6 +583,8 @@ type OrganizationEdges struct {
 	namedDiscussions                        map[string][]*Discussion
 	namedVendorScoringConfigs               map[string][]*VendorScoringConfig
 	namedVendorRiskScores                   map[string][]*VendorRiskScore
+	namedRetentionRules                     map[string][]*RetentionRule
+	namedCustomRoles                        map[string][]*CustomRole
 	namedMembers                            map[string][]*OrgMembership
 }
 
@@ -2118,10 +2124,28 @@ func (e OrganizationEdges) VendorRiskScoresOrErr() ([]*VendorRiskScore, error) {
 	return nil, &NotLoadedError{edge: "vendor_risk_scores"}
 }
 
+// RetentionRulesOrErr returns the RetentionRules value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) RetentionRulesOrErr() ([]*RetentionRule, error) {
+	if e.loadedTypes[170] {
+		return e.RetentionRules, nil
+	}
+	return nil, &NotLoadedError{edge: "retention_rules"}
+}
+
+// CustomRolesOrErr returns the CustomRoles value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) CustomRolesOrErr() ([]*CustomRole, error) {
+	if e.loadedTypes[171] {
+		return e.CustomRoles, nil
+	}
+	return nil, &NotLoadedError{edge: "custom_roles"}
+}
+
 // MembersOrErr returns the Members value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) MembersOrErr() ([]*OrgMembership, error) {
-	if e.loadedTypes[170] {
+	if e.loadedTypes[172] {
 		return e.Members, nil
 	}
 	return nil, &NotLoadedError{edge: "members"}
@@ -3139,6 +3163,16 @@ func (_m *Organization) QueryVendorRiskScores() *VendorRiskScoreQuery {
 	return NewOrganizationClient(_m.config).QueryVendorRiskScores(_m)
 }
 
+// QueryRetentionRules queries the "retention_rules" edge of the Organization entity.
+func (_m *Organization) QueryRetentionRules() *RetentionRuleQuery {
+	return NewOrganizationClient(_m.config).QueryRetentionRules(_m)
+}
+
+// QueryCustomRoles queries the "custom_roles" edge of the Organization entity.
+func (_m *Organization) QueryCustomRoles() *CustomRoleQuery {
+	return NewOrganizationClient(_m.config).QueryCustomRoles(_m)
+}
+
 // QueryMembers queries the "members" edge of the Organization entity.
 func (_m *Organization) QueryMembers() *OrgMembershipQuery {
 	return NewOrganizationClient(_m.config).QueryMembers(_m)
@@ -7242,6 +7276,54 @@ func (_m *Organization) appendNamedVendorRiskScores(name string, edges ...*Vendo
 	}
 }
 
+// NamedRetentionRules returns the RetentionRules named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Organization) NamedRetentionRules(name string) ([]*RetentionRule, error) {
+	if _m.Edges.namedRetentionRules == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedRetentionRules[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Organization) appendNamedRetentionRules(name string, edges ...*RetentionRule) {
+	if _m.Edges.namedRetentionRules == nil {
+		_m.Edges.namedRetentionRules = make(map[string][]*RetentionRule)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedRetentionRules[name] = []*RetentionRule{}
+	} else {
+		_m.Edges.namedRetentionRules[name] = append(_m.Edges.namedRetentionRules[name], edges...)
+	}
+}
+
+// NamedCustomRoles returns the CustomRoles named value or an error if the edge was not
+// loaded in eager-loading with this name.
+func (_m *Organization) NamedCustomRoles(name string) ([]*CustomRole, error) {
+	if _m.Edges.namedCustomRoles == nil {
+		return nil, &NotLoadedError{edge: name}
+	}
+	nodes, ok := _m.Edges.namedCustomRoles[name]
+	if !ok {
+		return nil, &NotLoadedError{edge: name}
+	}
+	return nodes, nil
+}
+
+func (_m *Organization) appendNamedCustomRoles(name string, edges ...*CustomRole) {
+	if _m.Edges.namedCustomRoles == nil {
+		_m.Edges.namedCustomRoles = make(map[string][]*CustomRole)
+	}
+	if len(edges) == 0 {
+		_m.Edges.namedCustomRoles[name] = []*CustomRole{}
+	} else {
+		_m.Edges.namedCustomRoles[name] = append(_m.Edges.namedCustomRoles[name], edges...)
+	}
+}
+
 // NamedMembers returns the Members named value or an error if the edge was not
 // loaded in eager-loading with this name.
 func (_m *Organization) NamedMembers(name string) ([]*OrgMembership, error) {
@@ -391,6 +391,10 @@ const (
 	EdgeVendorScoringConfigs = "vendor_scoring_configs"
 	// EdgeVendorRiskScores holds the string denoting the vendor_risk_scores edge name in mutations.
 	EdgeVendorRiskScores = "vendor_risk_scores"
+	// EdgeRetentionRules holds the string denoting the retention_rules edge name in mutations.
+	EdgeRetentionRules = "retention_rules"
+	// EdgeCustomRoles holds the string denoting the custom_roles edge name in mutations.
+	EdgeCustomRoles = "custom_roles"
 	// EdgeMembers holds the string denoting the members edge name in mutations.
 	EdgeMembers = "members"
 	// Table holds the table name of the organization in the database.
@@ -1571,6 +1575,20 @@ const (
 	VendorRiskScoresInverseTable = "vendor_risk_scores"
 	// VendorRiskScoresColumn is the table column denoting the vendor_risk_scores relation/edge.
 	VendorRiskScoresColumn = "owner_id"
+	// RetentionRulesTable is the table that holds the retention_rules relation/edge.
+	RetentionRulesTable = "retention_rules"
+	// RetentionRulesInverseTable is the table name for the RetentionRule entity.
+	// It exists in this package in order to avoid circular dependency with the "retentionrule" package.
+	RetentionRulesInverseTable = "retention_rules"
+	// RetentionRulesColumn is the table column denoting the retention_rules relation/edge.
+	RetentionRulesColumn = "owner_id"
+	// CustomRolesTable is the table that holds the custom_roles relation/edge.
+	CustomRolesTable = "custom_roles"
+	// CustomRolesInverseTable is the table name for the CustomRole entity.
+	// It exists in this package in order to avoid circular dependency with the "customrole" package.
+	CustomRolesInverseTable = "custom_roles"
+	// CustomRolesColumn is the table column denoting the custom_roles relation/edge.
+	CustomRolesColumn = "owner_id"
 	// MembersTable is the table that holds the members relation/edge.
 	MembersTable = "org_memberships"
 	// MembersInverseTable is the table name for the OrgMembership entity.
@@ -4115,6 +4133,34 @@ func ByVendorRiskScores(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption
 	}
 }
 
+// ByRetentionRulesCount orders the results by retention_rules count.
+func ByRetentionRulesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRetentionRulesStep(), opts...)
+	}
+}
+
+// ByRetentionRules orders the results by retention_rules terms.
+func ByRetentionRules(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRetentionRulesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByCustomRolesCount orders the results by custom_roles count.
+func ByCustomRolesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCustomRolesStep(), opts...)
+	}
+}
+
+// ByCustomRoles orders the results by custom_roles terms.
+func ByCustomRoles(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCustomRolesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByMembersCount orders the results by members count.
 func ByMembersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -5318,6 +5364,20 @@ func newVendorRiskScoresStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, VendorRiskScoresTable, VendorRiskScoresColumn),
 	)
 }
+func newRetentionRulesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RetentionRulesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RetentionRulesTable, RetentionRulesColumn),
+	)
+}
+func newCustomRolesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CustomRolesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CustomRolesTable, CustomRolesColumn),
+	)
+}
 func newMembersStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -6192,6 +6192,64 @@ func HasVendorRiskScoresWith(preds ...predicate.VendorRiskScore) predicate.Organ
 	})
 }
 
+// HasRetentionRules applies the HasEdge predicate on the "retention_rules" edge.
+func HasRetentionRules() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RetentionRulesTable, RetentionRulesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.RetentionRule
+		step.Edge.Schema = schemaConfig.RetentionRule
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRetentionRulesWith applies the HasEdge predicate on the "retention_rules" edge with a given conditions (other predicates).
+func HasRetentionRulesWith(preds ...predicate.RetentionRule) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newRetentionRulesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.RetentionRule
+		step.Edge.Schema = schemaConfig.RetentionRule
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasCustomRoles applies the HasEdge predicate on the "custom_roles" edge.
+func HasCustomRoles() predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CustomRolesTable, CustomRolesColumn),
+		)
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.CustomRole
+		step.Edge.Schema = schemaConfig.CustomRole
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCustomRolesWith applies the HasEdge predicate on the "custom_roles" edge with a given conditions (other predicates).
+func HasCustomRolesWith(preds ...predicate.CustomRole) predicate.Organization {
+	return predicate.Organization(func(s *sql.Selector) {
+		step := newCustomRolesStep()
+		schemaConfig := internal.SchemaConfigFromContext(s.Context())
+		step.To.Schema = schemaConfig.CustomRole
+		step.Edge.Schema = schemaConfig.CustomRole
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasMembers applies the HasEdge predicate on the "members" edge.
 func HasMembers() predicate.Organization {
 	return predicate.Organization(func(s *sql.Selector) {
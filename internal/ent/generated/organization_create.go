@@ -22,6 +22,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -71,6 +72,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/procedure"
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -2919,6 +2921,36 @@ func (_c *OrganizationCreate) AddVendorRiskScores(v ...*VendorRiskScore) *Organi
 	return _c.AddVendorRiskScoreIDs(ids...)
 }
 
+// AddRetentionRuleIDs adds the "retention_rules" edge to the RetentionRule entity by IDs.
+func (_c *OrganizationCreate) AddRetentionRuleIDs(ids ...string) *OrganizationCreate {
+	_c.mutation.AddRetentionRuleIDs(ids...)
+	return _c
+}
+
+// AddRetentionRules adds the "retention_rules" edges to the RetentionRule entity.
+func (_c *OrganizationCreate) AddRetentionRules(v ...*RetentionRule) *OrganizationCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRetentionRuleIDs(ids...)
+}
+
+// AddCustomRoleIDs adds the "custom_roles" edge to the CustomRole entity by IDs.
+func (_c *OrganizationCreate) AddCustomRoleIDs(ids ...string) *OrganizationCreate {
+	_c.mutation.AddCustomRoleIDs(ids...)
+	return _c
+}
+
+// AddCustomRoles adds the "custom_roles" edges to the CustomRole entity.
+func (_c *OrganizationCreate) AddCustomRoles(v ...*CustomRole) *OrganizationCreate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddCustomRoleIDs(ids...)
+}
+
 // AddMemberIDs adds the "members" edge to the OrgMembership entity by IDs.
 func (_c *OrganizationCreate) AddMemberIDs(ids ...string) *OrganizationCreate {
 	_c.mutation.AddMemberIDs(ids...)
@@ -6041,6 +6073,42 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RetentionRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RetentionRulesTable,
+			Columns: []string{organization.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _c.schemaConfig.RetentionRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+	if nodes := _c.mutation.CustomRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomRolesTable,
+			Columns: []string{organization.CustomRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customrole.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _c.schemaConfig.CustomRole
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
 	if nodes := _c.mutation.MembersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -25,6 +25,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -75,6 +76,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/procedure"
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -284,6 +286,8 @@ type OrganizationQuery struct {
 	withDiscussions                             *DiscussionQuery
 	withVendorScoringConfigs                    *VendorScoringConfigQuery
 	withVendorRiskScores                        *VendorRiskScoreQuery
+	withRetentionRules                          *RetentionRuleQuery
+	withCustomRoles                             *CustomRoleQuery
 	withMembers                                 *OrgMembershipQuery
 	loadTotal                                   []func(context.Context, []*Organization) error
 	modifiers                                   []func(*sql.Selector)
@@ -454,6 +458,8 @@ type OrganizationQuery struct {
 	withNamedDiscussions                        map[string]*DiscussionQuery
 	withNamedVendorScoringConfigs               map[string]*VendorScoringConfigQuery
 	withNamedVendorRiskScores                   map[string]*VendorRiskScoreQuery
+	withNamedRetentionRules                     map[string]*RetentionRuleQuery
+	withNamedCustomRoles                        map[string]*CustomRoleQuery
 	withNamedMembers                            map[string]*OrgMembershipQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
@@ -4741,6 +4747,56 @@ func (_q *OrganizationQuery) QueryVendorRiskScores() *VendorRiskScoreQuery {
 	return query
 }
 
+// QueryRetentionRules chains the current query on the "retention_rules" edge.
+func (_q *OrganizationQuery) QueryRetentionRules() *RetentionRuleQuery {
+	query := (&RetentionRuleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(retentionrule.Table, retentionrule.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.RetentionRulesTable, organization.RetentionRulesColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.RetentionRule
+		step.Edge.Schema = schemaConfig.RetentionRule
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryCustomRoles chains the current query on the "custom_roles" edge.
+func (_q *OrganizationQuery) QueryCustomRoles() *CustomRoleQuery {
+	query := (&CustomRoleClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(organization.Table, organization.FieldID, selector),
+			sqlgraph.To(customrole.Table, customrole.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, organization.CustomRolesTable, organization.CustomRolesColumn),
+		)
+		schemaConfig := _q.schemaConfig
+		step.To.Schema = schemaConfig.CustomRole
+		step.Edge.Schema = schemaConfig.CustomRole
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryMembers chains the current query on the "members" edge.
 func (_q *OrganizationQuery) QueryMembers() *OrgMembershipQuery {
 	query := (&OrgMembershipClient{config: _q.config}).Query()
@@ -5128,6 +5184,8 @@ func (_q *OrganizationQuery) Clone() *OrganizationQuery {
 		withDiscussions:                        _q.withDiscussions.Clone(),
 		withVendorScoringConfigs:               _q.withVendorScoringConfigs.Clone(),
 		withVendorRiskScores:                   _q.withVendorRiskScores.Clone(),
+		withRetentionRules:                     _q.withRetentionRules.Clone(),
+		withCustomRoles:                        _q.withCustomRoles.Clone(),
 		withMembers:                            _q.withMembers.Clone(),
 		// clone intermediate query.
 		sql:       _q.sql.Clone(),
@@ -7006,6 +7064,30 @@ func (_q *OrganizationQuery) WithVendorRiskScores(opts ...func(*VendorRiskScoreQ
 	return _q
 }
 
+// WithRetentionRules tells the query-builder to eager-load the nodes that are connected to
+// the "retention_rules" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithRetentionRules(opts ...func(*RetentionRuleQuery)) *OrganizationQuery {
+	query := (&RetentionRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRetentionRules = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// WithCustomRoles tells the query-builder to eager-load the nodes that are connected to
+// the "custom_roles" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithCustomRoles(opts ...func(*CustomRoleQuery)) *OrganizationQuery {
+	query := (&CustomRoleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withCustomRoles = query
+	return _q
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
 // WithMembers tells the query-builder to eager-load the nodes that are connected to
 // the "members" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *OrganizationQuery) WithMembers(opts ...func(*OrgMembershipQuery)) *OrganizationQuery {
@@ -7101,7 +7183,7 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 	var (
 		nodes       = []*Organization{}
 		_spec       = _q.querySpec()
-		loadedTypes = [171]bool{
+		loadedTypes = [173]bool{
 			_q.withActionPlanCreators != nil,
 			_q.withAPITokenCreators != nil,
 			_q.withAssessmentCreators != nil,
@@ -7272,6 +7354,8 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			_q.withDiscussions != nil,
 			_q.withVendorScoringConfigs != nil,
 			_q.withVendorRiskScores != nil,
+			_q.withRetentionRules != nil,
+			_q.withCustomRoles != nil,
 			_q.withMembers != nil,
 		}
 	)
@@ -8613,6 +8697,20 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	if query := _q.withRetentionRules; query != nil {
+		if err := _q.loadRetentionRules(ctx, query, nodes,
+			func(n *Organization) { n.Edges.RetentionRules = []*RetentionRule{} },
+			func(n *Organization, e *RetentionRule) { n.Edges.RetentionRules = append(n.Edges.RetentionRules, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withCustomRoles; query != nil {
+		if err := _q.loadCustomRoles(ctx, query, nodes,
+			func(n *Organization) { n.Edges.CustomRoles = []*CustomRole{} },
+			func(n *Organization, e *CustomRole) { n.Edges.CustomRoles = append(n.Edges.CustomRoles, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withMembers; query != nil {
 		if err := _q.loadMembers(ctx, query, nodes,
 			func(n *Organization) { n.Edges.Members = []*OrgMembership{} },
@@ -9793,6 +9891,20 @@ func (_q *OrganizationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]
 			return nil, err
 		}
 	}
+	for name, query := range _q.withNamedRetentionRules {
+		if err := _q.loadRetentionRules(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedRetentionRules(name) },
+			func(n *Organization, e *RetentionRule) { n.appendNamedRetentionRules(name, e) }); err != nil {
+			return nil, err
+		}
+	}
+	for name, query := range _q.withNamedCustomRoles {
+		if err := _q.loadCustomRoles(ctx, query, nodes,
+			func(n *Organization) { n.appendNamedCustomRoles(name) },
+			func(n *Organization, e *CustomRole) { n.appendNamedCustomRoles(name, e) }); err != nil {
+			return nil, err
+		}
+	}
 	for name, query := range _q.withNamedMembers {
 		if err := _q.loadMembers(ctx, query, nodes,
 			func(n *Organization) { n.appendNamedMembers(name) },
@@ -15144,6 +15256,66 @@ func (_q *OrganizationQuery) loadVendorRiskScores(ctx context.Context, query *Ve
 	}
 	return nil
 }
+func (_q *OrganizationQuery) loadRetentionRules(ctx context.Context, query *RetentionRuleQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *RetentionRule)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(retentionrule.FieldOwnerID)
+	}
+	query.Where(predicate.RetentionRule(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.RetentionRulesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *OrganizationQuery) loadCustomRoles(ctx context.Context, query *CustomRoleQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *CustomRole)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[string]*Organization)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(customrole.FieldOwnerID)
+	}
+	query.Where(predicate.CustomRole(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(organization.CustomRolesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.OwnerID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "owner_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *OrganizationQuery) loadMembers(ctx context.Context, query *OrgMembershipQuery, nodes []*Organization, init func(*Organization), assign func(*Organization, *OrgMembership)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[string]*Organization)
@@ -17617,6 +17789,34 @@ func (_q *OrganizationQuery) WithNamedVendorRiskScores(name string, opts ...func
 	return _q
 }
 
+// WithNamedRetentionRules tells the query-builder to eager-load the nodes that are connected to the "retention_rules"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithNamedRetentionRules(name string, opts ...func(*RetentionRuleQuery)) *OrganizationQuery {
+	query := (&RetentionRuleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedRetentionRules == nil {
+		_q.withNamedRetentionRules = make(map[string]*RetentionRuleQuery)
+	}
+	_q.withNamedRetentionRules[name] = query
+	return _q
+}
+
+// WithNamedCustomRoles tells the query-builder to eager-load the nodes that are connected to the "custom_roles"
+// edge with the given name. The optional arguments are used to configure the query builder of the edge.
+func (_q *OrganizationQuery) WithNamedCustomRoles(name string, opts ...func(*CustomRoleQuery)) *OrganizationQuery {
+	query := (&CustomRoleClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	if _q.withNamedCustomRoles == nil {
+		_q.withNamedCustomRoles = make(map[string]*CustomRoleQuery)
+	}
+	_q.withNamedCustomRoles[name] = query
+	return _q
+}
+
 // WithNamedMembers tells the query-builder to eager-load the nodes that are connected to the "members"
 // edge with the given name. The optional arguments are used to configure the query builder of the edge.
 func (_q *OrganizationQuery) WithNamedMembers(name string, opts ...func(*OrgMembershipQuery)) *OrganizationQuery {
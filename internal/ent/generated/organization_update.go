@@ -24,6 +24,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -74,6 +75,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/procedure"
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -2906,6 +2908,36 @@ func (_u *OrganizationUpdate) AddVendorRiskScores(v ...*VendorRiskScore) *Organi
 	return _u.AddVendorRiskScoreIDs(ids...)
 }
 
+// AddRetentionRuleIDs adds the "retention_rules" edge to the RetentionRule entity by IDs.
+func (_u *OrganizationUpdate) AddRetentionRuleIDs(ids ...string) *OrganizationUpdate {
+	_u.mutation.AddRetentionRuleIDs(ids...)
+	return _u
+}
+
+// AddRetentionRules adds the "retention_rules" edges to the RetentionRule entity.
+func (_u *OrganizationUpdate) AddRetentionRules(v ...*RetentionRule) *OrganizationUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRetentionRuleIDs(ids...)
+}
+
+// AddCustomRoleIDs adds the "custom_roles" edge to the CustomRole entity by IDs.
+func (_u *OrganizationUpdate) AddCustomRoleIDs(ids ...string) *OrganizationUpdate {
+	_u.mutation.AddCustomRoleIDs(ids...)
+	return _u
+}
+
+// AddCustomRoles adds the "custom_roles" edges to the CustomRole entity.
+func (_u *OrganizationUpdate) AddCustomRoles(v ...*CustomRole) *OrganizationUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCustomRoleIDs(ids...)
+}
+
 // AddMemberIDs adds the "members" edge to the OrgMembership entity by IDs.
 func (_u *OrganizationUpdate) AddMemberIDs(ids ...string) *OrganizationUpdate {
 	_u.mutation.AddMemberIDs(ids...)
@@ -6445,6 +6477,48 @@ func (_u *OrganizationUpdate) RemoveVendorRiskScores(v ...*VendorRiskScore) *Org
 	return _u.RemoveVendorRiskScoreIDs(ids...)
 }
 
+// ClearRetentionRules clears all "retention_rules" edges to the RetentionRule entity.
+func (_u *OrganizationUpdate) ClearRetentionRules() *OrganizationUpdate {
+	_u.mutation.ClearRetentionRules()
+	return _u
+}
+
+// RemoveRetentionRuleIDs removes the "retention_rules" edge to RetentionRule entities by IDs.
+func (_u *OrganizationUpdate) RemoveRetentionRuleIDs(ids ...string) *OrganizationUpdate {
+	_u.mutation.RemoveRetentionRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRetentionRules removes "retention_rules" edges to RetentionRule entities.
+func (_u *OrganizationUpdate) RemoveRetentionRules(v ...*RetentionRule) *OrganizationUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRetentionRuleIDs(ids...)
+}
+
+// ClearCustomRoles clears all "custom_roles" edges to the CustomRole entity.
+func (_u *OrganizationUpdate) ClearCustomRoles() *OrganizationUpdate {
+	_u.mutation.ClearCustomRoles()
+	return _u
+}
+
+// RemoveCustomRoleIDs removes the "custom_roles" edge to CustomRole entities by IDs.
+func (_u *OrganizationUpdate) RemoveCustomRoleIDs(ids ...string) *OrganizationUpdate {
+	_u.mutation.RemoveCustomRoleIDs(ids...)
+	return _u
+}
+
+// RemoveCustomRoles removes "custom_roles" edges to CustomRole entities.
+func (_u *OrganizationUpdate) RemoveCustomRoles(v ...*CustomRole) *OrganizationUpdate {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCustomRoleIDs(ids...)
+}
+
 // ClearMembers clears all "members" edges to the OrgMembership entity.
 func (_u *OrganizationUpdate) ClearMembers() *OrganizationUpdate {
 	_u.mutation.ClearMembers()
@@ -14728,6 +14802,102 @@ func (_u *OrganizationUpdate) sqlSave(ctx context.Context) (_node int, err error
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RetentionRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RetentionRulesTable,
+			Columns: []string{organization.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.RetentionRule
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRetentionRulesIDs(); len(nodes) > 0 && !_u.mutation.RetentionRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RetentionRulesTable,
+			Columns: []string{organization.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.RetentionRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RetentionRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RetentionRulesTable,
+			Columns: []string{organization.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.RetentionRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CustomRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomRolesTable,
+			Columns: []string{organization.CustomRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customrole.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.CustomRole
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCustomRolesIDs(); len(nodes) > 0 && !_u.mutation.CustomRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomRolesTable,
+			Columns: []string{organization.CustomRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customrole.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.CustomRole
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomRolesTable,
+			Columns: []string{organization.CustomRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customrole.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.CustomRole
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.MembersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -17587,6 +17757,36 @@ func (_u *OrganizationUpdateOne) AddVendorRiskScores(v ...*VendorRiskScore) *Org
 	return _u.AddVendorRiskScoreIDs(ids...)
 }
 
+// AddRetentionRuleIDs adds the "retention_rules" edge to the RetentionRule entity by IDs.
+func (_u *OrganizationUpdateOne) AddRetentionRuleIDs(ids ...string) *OrganizationUpdateOne {
+	_u.mutation.AddRetentionRuleIDs(ids...)
+	return _u
+}
+
+// AddRetentionRules adds the "retention_rules" edges to the RetentionRule entity.
+func (_u *OrganizationUpdateOne) AddRetentionRules(v ...*RetentionRule) *OrganizationUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRetentionRuleIDs(ids...)
+}
+
+// AddCustomRoleIDs adds the "custom_roles" edge to the CustomRole entity by IDs.
+func (_u *OrganizationUpdateOne) AddCustomRoleIDs(ids ...string) *OrganizationUpdateOne {
+	_u.mutation.AddCustomRoleIDs(ids...)
+	return _u
+}
+
+// AddCustomRoles adds the "custom_roles" edges to the CustomRole entity.
+func (_u *OrganizationUpdateOne) AddCustomRoles(v ...*CustomRole) *OrganizationUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddCustomRoleIDs(ids...)
+}
+
 // AddMemberIDs adds the "members" edge to the OrgMembership entity by IDs.
 func (_u *OrganizationUpdateOne) AddMemberIDs(ids ...string) *OrganizationUpdateOne {
 	_u.mutation.AddMemberIDs(ids...)
@@ -21126,6 +21326,48 @@ func (_u *OrganizationUpdateOne) RemoveVendorRiskScores(v ...*VendorRiskScore) *
 	return _u.RemoveVendorRiskScoreIDs(ids...)
 }
 
+// ClearRetentionRules clears all "retention_rules" edges to the RetentionRule entity.
+func (_u *OrganizationUpdateOne) ClearRetentionRules() *OrganizationUpdateOne {
+	_u.mutation.ClearRetentionRules()
+	return _u
+}
+
+// RemoveRetentionRuleIDs removes the "retention_rules" edge to RetentionRule entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveRetentionRuleIDs(ids ...string) *OrganizationUpdateOne {
+	_u.mutation.RemoveRetentionRuleIDs(ids...)
+	return _u
+}
+
+// RemoveRetentionRules removes "retention_rules" edges to RetentionRule entities.
+func (_u *OrganizationUpdateOne) RemoveRetentionRules(v ...*RetentionRule) *OrganizationUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRetentionRuleIDs(ids...)
+}
+
+// ClearCustomRoles clears all "custom_roles" edges to the CustomRole entity.
+func (_u *OrganizationUpdateOne) ClearCustomRoles() *OrganizationUpdateOne {
+	_u.mutation.ClearCustomRoles()
+	return _u
+}
+
+// RemoveCustomRoleIDs removes the "custom_roles" edge to CustomRole entities by IDs.
+func (_u *OrganizationUpdateOne) RemoveCustomRoleIDs(ids ...string) *OrganizationUpdateOne {
+	_u.mutation.RemoveCustomRoleIDs(ids...)
+	return _u
+}
+
+// RemoveCustomRoles removes "custom_roles" edges to CustomRole entities.
+func (_u *OrganizationUpdateOne) RemoveCustomRoles(v ...*CustomRole) *OrganizationUpdateOne {
+	ids := make([]string, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveCustomRoleIDs(ids...)
+}
+
 // ClearMembers clears all "members" edges to the OrgMembership entity.
 func (_u *OrganizationUpdateOne) ClearMembers() *OrganizationUpdateOne {
 	_u.mutation.ClearMembers()
@@ -29439,6 +29681,102 @@ func (_u *OrganizationUpdateOne) sqlSave(ctx context.Context) (_node *Organizati
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RetentionRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RetentionRulesTable,
+			Columns: []string{organization.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.RetentionRule
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRetentionRulesIDs(); len(nodes) > 0 && !_u.mutation.RetentionRulesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RetentionRulesTable,
+			Columns: []string{organization.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.RetentionRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RetentionRulesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RetentionRulesTable,
+			Columns: []string{organization.RetentionRulesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(retentionrule.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.RetentionRule
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.CustomRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomRolesTable,
+			Columns: []string{organization.CustomRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customrole.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.CustomRole
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedCustomRolesIDs(); len(nodes) > 0 && !_u.mutation.CustomRolesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomRolesTable,
+			Columns: []string{organization.CustomRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customrole.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.CustomRole
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.CustomRolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.CustomRolesTable,
+			Columns: []string{organization.CustomRolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(customrole.FieldID, field.TypeString),
+			},
+		}
+		edge.Schema = _u.schemaConfig.CustomRole
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.MembersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -45,6 +45,9 @@ type ControlObjective func(*sql.Selector)
 // CustomDomain is the predicate function for customdomain builders.
 type CustomDomain func(*sql.Selector)
 
+// CustomRole is the predicate function for customrole builders.
+type CustomRole func(*sql.Selector)
+
 // CustomTypeEnum is the predicate function for customtypeenum builders.
 type CustomTypeEnum func(*sql.Selector)
 
@@ -216,6 +219,9 @@ type ProgramMembership func(*sql.Selector)
 // Remediation is the predicate function for remediation builders.
 type Remediation func(*sql.Selector)
 
+// RetentionRule is the predicate function for retentionrule builders.
+type RetentionRule func(*sql.Selector)
+
 // Review is the predicate function for review builders.
 type Review func(*sql.Selector)
 
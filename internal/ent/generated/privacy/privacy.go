@@ -423,6 +423,30 @@ func (f CustomDomainMutationRuleFunc) EvalMutation(ctx context.Context, m genera
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.CustomDomainMutation", m)
 }
 
+// The CustomRoleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type CustomRoleQueryRuleFunc func(context.Context, *generated.CustomRoleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f CustomRoleQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.CustomRoleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.CustomRoleQuery", q)
+}
+
+// The CustomRoleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type CustomRoleMutationRuleFunc func(context.Context, *generated.CustomRoleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f CustomRoleMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.CustomRoleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.CustomRoleMutation", m)
+}
+
 // The CustomTypeEnumQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type CustomTypeEnumQueryRuleFunc func(context.Context, *generated.CustomTypeEnumQuery) error
@@ -1791,6 +1815,30 @@ func (f RemediationMutationRuleFunc) EvalMutation(ctx context.Context, m generat
 	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.RemediationMutation", m)
 }
 
+// The RetentionRuleQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RetentionRuleQueryRuleFunc func(context.Context, *generated.RetentionRuleQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RetentionRuleQueryRuleFunc) EvalQuery(ctx context.Context, q generated.Query) error {
+	if q, ok := q.(*generated.RetentionRuleQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("generated/privacy: unexpected query type %T, expect *generated.RetentionRuleQuery", q)
+}
+
+// The RetentionRuleMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RetentionRuleMutationRuleFunc func(context.Context, *generated.RetentionRuleMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RetentionRuleMutationRuleFunc) EvalMutation(ctx context.Context, m generated.Mutation) error {
+	if m, ok := m.(*generated.RetentionRuleMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("generated/privacy: unexpected mutation type %T, expect *generated.RetentionRuleMutation", m)
+}
+
 // The ReviewQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ReviewQueryRuleFunc func(context.Context, *generated.ReviewQuery) error
@@ -2740,6 +2788,8 @@ func queryFilter(q generated.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *generated.CustomDomainQuery:
 		return q.Filter(), nil
+	case *generated.CustomRoleQuery:
+		return q.Filter(), nil
 	case *generated.CustomTypeEnumQuery:
 		return q.Filter(), nil
 	case *generated.DNSVerificationQuery:
@@ -2854,6 +2904,8 @@ func queryFilter(q generated.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *generated.RemediationQuery:
 		return q.Filter(), nil
+	case *generated.RetentionRuleQuery:
+		return q.Filter(), nil
 	case *generated.ReviewQuery:
 		return q.Filter(), nil
 	case *generated.RiskQuery:
@@ -2961,6 +3013,8 @@ func mutationFilter(m generated.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *generated.CustomDomainMutation:
 		return m.Filter(), nil
+	case *generated.CustomRoleMutation:
+		return m.Filter(), nil
 	case *generated.CustomTypeEnumMutation:
 		return m.Filter(), nil
 	case *generated.DNSVerificationMutation:
@@ -3075,6 +3129,8 @@ func mutationFilter(m generated.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *generated.RemediationMutation:
 		return m.Filter(), nil
+	case *generated.RetentionRuleMutation:
+		return m.Filter(), nil
 	case *generated.ReviewMutation:
 		return m.Filter(), nil
 	case *generated.RiskMutation:
@@ -20,6 +20,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/controlimplementation"
 	"github.com/theopenlane/core/internal/ent/generated/controlobjective"
 	"github.com/theopenlane/core/internal/ent/generated/customdomain"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
 	"github.com/theopenlane/core/internal/ent/generated/customtypeenum"
 	"github.com/theopenlane/core/internal/ent/generated/directoryaccount"
 	"github.com/theopenlane/core/internal/ent/generated/directorygroup"
@@ -77,6 +78,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/program"
 	"github.com/theopenlane/core/internal/ent/generated/programmembership"
 	"github.com/theopenlane/core/internal/ent/generated/remediation"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/internal/ent/generated/review"
 	"github.com/theopenlane/core/internal/ent/generated/risk"
 	"github.com/theopenlane/core/internal/ent/generated/scan"
@@ -734,6 +736,7 @@ func init() {
 	campaignMixinHooks7 := campaignMixin[7].Hooks()
 	campaignMixinHooks8 := campaignMixin[8].Hooks()
 	campaignMixinHooks10 := campaignMixin[10].Hooks()
+	campaignHooks := schema.Campaign{}.Hooks()
 
 	campaign.Hooks[1] = campaignMixinHooks0[0]
 
@@ -756,6 +759,8 @@ func init() {
 	campaign.Hooks[10] = campaignMixinHooks8[2]
 
 	campaign.Hooks[11] = campaignMixinHooks10[0]
+
+	campaign.Hooks[12] = campaignHooks[0]
 	campaignMixinInters3 := campaignMixin[3].Interceptors()
 	campaignMixinInters7 := campaignMixin[7].Interceptors()
 	campaign.Interceptors[0] = campaignMixinInters3[0]
@@ -1476,6 +1481,69 @@ func init() {
 	customdomainDescID := customdomainMixinFields4[0].Descriptor()
 	// customdomain.DefaultID holds the default value on creation for the id field.
 	customdomain.DefaultID = customdomainDescID.Default.(func() string)
+	customroleMixin := schema.CustomRole{}.Mixin()
+	customrole.Policy = privacy.NewPolicies(schema.CustomRole{})
+	customrole.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := customrole.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	customroleMixinHooks0 := customroleMixin[0].Hooks()
+	customroleMixinHooks1 := customroleMixin[1].Hooks()
+	customroleMixinHooks3 := customroleMixin[3].Hooks()
+	customroleMixinHooks6 := customroleMixin[6].Hooks()
+	customroleHooks := schema.CustomRole{}.Hooks()
+
+	customrole.Hooks[1] = customroleMixinHooks0[0]
+
+	customrole.Hooks[2] = customroleMixinHooks1[0]
+
+	customrole.Hooks[3] = customroleMixinHooks3[0]
+
+	customrole.Hooks[4] = customroleMixinHooks6[0]
+
+	customrole.Hooks[5] = customroleHooks[0]
+	customroleMixinInters3 := customroleMixin[3].Interceptors()
+	customroleMixinInters6 := customroleMixin[6].Interceptors()
+	customrole.Interceptors[0] = customroleMixinInters3[0]
+	customrole.Interceptors[1] = customroleMixinInters6[0]
+	customroleMixinFields0 := customroleMixin[0].Fields()
+	_ = customroleMixinFields0
+	customroleMixinFields4 := customroleMixin[4].Fields()
+	_ = customroleMixinFields4
+	customroleMixinFields6 := customroleMixin[6].Fields()
+	_ = customroleMixinFields6
+	customroleFields := schema.CustomRole{}.Fields()
+	_ = customroleFields
+	// customroleDescCreatedAt is the schema descriptor for created_at field.
+	customroleDescCreatedAt := customroleMixinFields0[0].Descriptor()
+	// customrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	customrole.DefaultCreatedAt = customroleDescCreatedAt.Default.(func() time.Time)
+	// customroleDescUpdatedAt is the schema descriptor for updated_at field.
+	customroleDescUpdatedAt := customroleMixinFields0[1].Descriptor()
+	// customrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	customrole.DefaultUpdatedAt = customroleDescUpdatedAt.Default.(func() time.Time)
+	// customrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	customrole.UpdateDefaultUpdatedAt = customroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// customroleDescOwnerID is the schema descriptor for owner_id field.
+	customroleDescOwnerID := customroleMixinFields6[0].Descriptor()
+	// customrole.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	customrole.OwnerIDValidator = customroleDescOwnerID.Validators[0].(func(string) error)
+	// customroleDescName is the schema descriptor for name field.
+	customroleDescName := customroleFields[0].Descriptor()
+	// customrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	customrole.NameValidator = customroleDescName.Validators[0].(func(string) error)
+	// customroleDescPermissions is the schema descriptor for permissions field.
+	customroleDescPermissions := customroleFields[2].Descriptor()
+	// customrole.PermissionsValidator is a validator for the "permissions" field. It is called by the builders before save.
+	customrole.PermissionsValidator = customroleDescPermissions.Validators[0].(func([]string) error)
+	// customroleDescID is the schema descriptor for id field.
+	customroleDescID := customroleMixinFields4[0].Descriptor()
+	// customrole.DefaultID holds the default value on creation for the id field.
+	customrole.DefaultID = customroleDescID.Default.(func() string)
 	customtypeenumMixin := schema.CustomTypeEnum{}.Mixin()
 	customtypeenum.Policy = privacy.NewPolicies(customtypeenumMixin[7], schema.CustomTypeEnum{})
 	customtypeenum.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -2546,41 +2614,41 @@ func init() {
 	// entity.DefaultHasSoc2 holds the default value on creation for the has_soc2 field.
 	entity.DefaultHasSoc2 = entityDescHasSoc2.Default.(bool)
 	// entityDescAutoRenews is the schema descriptor for auto_renews field.
-	entityDescAutoRenews := entityFields[13].Descriptor()
+	entityDescAutoRenews := entityFields[14].Descriptor()
 	// entity.DefaultAutoRenews holds the default value on creation for the auto_renews field.
 	entity.DefaultAutoRenews = entityDescAutoRenews.Default.(bool)
 	// entityDescSpendCurrency is the schema descriptor for spend_currency field.
-	entityDescSpendCurrency := entityFields[16].Descriptor()
+	entityDescSpendCurrency := entityFields[17].Descriptor()
 	// entity.DefaultSpendCurrency holds the default value on creation for the spend_currency field.
 	entity.DefaultSpendCurrency = entityDescSpendCurrency.Default.(string)
 	// entityDescSSOEnforced is the schema descriptor for sso_enforced field.
-	entityDescSSOEnforced := entityFields[19].Descriptor()
+	entityDescSSOEnforced := entityFields[20].Descriptor()
 	// entity.DefaultSSOEnforced holds the default value on creation for the sso_enforced field.
 	entity.DefaultSSOEnforced = entityDescSSOEnforced.Default.(bool)
 	// entityDescMfaSupported is the schema descriptor for mfa_supported field.
-	entityDescMfaSupported := entityFields[20].Descriptor()
+	entityDescMfaSupported := entityFields[21].Descriptor()
 	// entity.DefaultMfaSupported holds the default value on creation for the mfa_supported field.
 	entity.DefaultMfaSupported = entityDescMfaSupported.Default.(bool)
 	// entityDescMfaEnforced is the schema descriptor for mfa_enforced field.
-	entityDescMfaEnforced := entityFields[21].Descriptor()
+	entityDescMfaEnforced := entityFields[22].Descriptor()
 	// entity.DefaultMfaEnforced holds the default value on creation for the mfa_enforced field.
 	entity.DefaultMfaEnforced = entityDescMfaEnforced.Default.(bool)
 	// entityDescStatusPageURL is the schema descriptor for status_page_url field.
-	entityDescStatusPageURL := entityFields[22].Descriptor()
+	entityDescStatusPageURL := entityFields[23].Descriptor()
 	// entity.StatusPageURLValidator is a validator for the "status_page_url" field. It is called by the builders before save.
 	entity.StatusPageURLValidator = entityDescStatusPageURL.Validators[0].(func(string) error)
 	// entityDescProvidedServices is the schema descriptor for provided_services field.
-	entityDescProvidedServices := entityFields[23].Descriptor()
+	entityDescProvidedServices := entityFields[24].Descriptor()
 	// entity.DefaultProvidedServices holds the default value on creation for the provided_services field.
 	entity.DefaultProvidedServices = entityDescProvidedServices.Default.([]string)
 	// entityDescLinks is the schema descriptor for links field.
-	entityDescLinks := entityFields[24].Descriptor()
+	entityDescLinks := entityFields[25].Descriptor()
 	// entity.DefaultLinks holds the default value on creation for the links field.
 	entity.DefaultLinks = entityDescLinks.Default.([]string)
 	// entity.LinksValidator is a validator for the "links" field. It is called by the builders before save.
 	entity.LinksValidator = entityDescLinks.Validators[0].(func([]string) error)
 	// entityDescLogoRemoteURL is the schema descriptor for logo_remote_url field.
-	entityDescLogoRemoteURL := entityFields[33].Descriptor()
+	entityDescLogoRemoteURL := entityFields[34].Descriptor()
 	// entity.LogoRemoteURLValidator is a validator for the "logo_remote_url" field. It is called by the builders before save.
 	entity.LogoRemoteURLValidator = func() func(string) error {
 		validators := entityDescLogoRemoteURL.Validators
@@ -2940,6 +3008,8 @@ func init() {
 	file.Hooks[8] = fileMixinHooks11[0]
 
 	file.Hooks[9] = fileHooks[0]
+
+	file.Hooks[10] = fileHooks[1]
 	fileMixinInters3 := fileMixin[3].Interceptors()
 	fileMixinInters7 := fileMixin[7].Interceptors()
 	fileInters := schema.File{}.Interceptors()
@@ -6524,6 +6594,70 @@ func init() {
 	remediationDescID := remediationMixinFields4[0].Descriptor()
 	// remediation.DefaultID holds the default value on creation for the id field.
 	remediation.DefaultID = remediationDescID.Default.(func() string)
+	retentionruleMixin := schema.RetentionRule{}.Mixin()
+	retentionrule.Policy = privacy.NewPolicies(schema.RetentionRule{})
+	retentionrule.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := retentionrule.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	retentionruleMixinHooks0 := retentionruleMixin[0].Hooks()
+	retentionruleMixinHooks1 := retentionruleMixin[1].Hooks()
+	retentionruleMixinHooks3 := retentionruleMixin[3].Hooks()
+	retentionruleMixinHooks6 := retentionruleMixin[6].Hooks()
+
+	retentionrule.Hooks[1] = retentionruleMixinHooks0[0]
+
+	retentionrule.Hooks[2] = retentionruleMixinHooks1[0]
+
+	retentionrule.Hooks[3] = retentionruleMixinHooks3[0]
+
+	retentionrule.Hooks[4] = retentionruleMixinHooks6[0]
+	retentionruleMixinInters3 := retentionruleMixin[3].Interceptors()
+	retentionruleMixinInters6 := retentionruleMixin[6].Interceptors()
+	retentionrule.Interceptors[0] = retentionruleMixinInters3[0]
+	retentionrule.Interceptors[1] = retentionruleMixinInters6[0]
+	retentionruleMixinFields0 := retentionruleMixin[0].Fields()
+	_ = retentionruleMixinFields0
+	retentionruleMixinFields4 := retentionruleMixin[4].Fields()
+	_ = retentionruleMixinFields4
+	retentionruleMixinFields6 := retentionruleMixin[6].Fields()
+	_ = retentionruleMixinFields6
+	retentionruleFields := schema.RetentionRule{}.Fields()
+	_ = retentionruleFields
+	// retentionruleDescCreatedAt is the schema descriptor for created_at field.
+	retentionruleDescCreatedAt := retentionruleMixinFields0[0].Descriptor()
+	// retentionrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	retentionrule.DefaultCreatedAt = retentionruleDescCreatedAt.Default.(func() time.Time)
+	// retentionruleDescUpdatedAt is the schema descriptor for updated_at field.
+	retentionruleDescUpdatedAt := retentionruleMixinFields0[1].Descriptor()
+	// retentionrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	retentionrule.DefaultUpdatedAt = retentionruleDescUpdatedAt.Default.(func() time.Time)
+	// retentionrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	retentionrule.UpdateDefaultUpdatedAt = retentionruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// retentionruleDescOwnerID is the schema descriptor for owner_id field.
+	retentionruleDescOwnerID := retentionruleMixinFields6[0].Descriptor()
+	// retentionrule.OwnerIDValidator is a validator for the "owner_id" field. It is called by the builders before save.
+	retentionrule.OwnerIDValidator = retentionruleDescOwnerID.Validators[0].(func(string) error)
+	// retentionruleDescName is the schema descriptor for name field.
+	retentionruleDescName := retentionruleFields[0].Descriptor()
+	// retentionrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	retentionrule.NameValidator = retentionruleDescName.Validators[0].(func(string) error)
+	// retentionruleDescRetentionDays is the schema descriptor for retention_days field.
+	retentionruleDescRetentionDays := retentionruleFields[4].Descriptor()
+	// retentionrule.RetentionDaysValidator is a validator for the "retention_days" field. It is called by the builders before save.
+	retentionrule.RetentionDaysValidator = retentionruleDescRetentionDays.Validators[0].(func(int) error)
+	// retentionruleDescPurge is the schema descriptor for purge field.
+	retentionruleDescPurge := retentionruleFields[5].Descriptor()
+	// retentionrule.DefaultPurge holds the default value on creation for the purge field.
+	retentionrule.DefaultPurge = retentionruleDescPurge.Default.(bool)
+	// retentionruleDescID is the schema descriptor for id field.
+	retentionruleDescID := retentionruleMixinFields4[0].Descriptor()
+	// retentionrule.DefaultID holds the default value on creation for the id field.
+	retentionrule.DefaultID = retentionruleDescID.Default.(func() string)
 	reviewMixin := schema.Review{}.Mixin()
 	review.Policy = privacy.NewPolicies(reviewMixin[9], schema.Review{})
 	review.Hooks[0] = func(next ent.Mutator) ent.Mutator {
@@ -8314,6 +8448,10 @@ func init() {
 	trustcenterfaqDescDisplayOrder := trustcenterfaqFields[3].Descriptor()
 	// trustcenterfaq.DefaultDisplayOrder holds the default value on creation for the display_order field.
 	trustcenterfaq.DefaultDisplayOrder = trustcenterfaqDescDisplayOrder.Default.(int)
+	// trustcenterfaqDescApproved is the schema descriptor for approved field.
+	trustcenterfaqDescApproved := trustcenterfaqFields[4].Descriptor()
+	// trustcenterfaq.DefaultApproved holds the default value on creation for the approved field.
+	trustcenterfaq.DefaultApproved = trustcenterfaqDescApproved.Default.(bool)
 	// trustcenterfaqDescID is the schema descriptor for id field.
 	trustcenterfaqDescID := trustcenterfaqMixinFields4[0].Descriptor()
 	// trustcenterfaq.DefaultID holds the default value on creation for the id field.
@@ -46,6 +46,8 @@ type TrustCenterFAQ struct {
 	ReferenceLink string `json:"reference_link,omitempty"`
 	// display order of the FAQ
 	DisplayOrder int `json:"display_order,omitempty"`
+	// whether the FAQ answer is approved for reuse, e.g. when answering security questionnaires
+	Approved bool `json:"approved,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the TrustCenterFAQQuery when eager-loading is set.
 	Edges        TrustCenterFAQEdges `json:"edges"`
@@ -130,6 +132,8 @@ func (*TrustCenterFAQ) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case trustcenterfaq.FieldApproved:
+			values[i] = new(sql.NullBool)
 		case trustcenterfaq.FieldDisplayOrder:
 			values[i] = new(sql.NullInt64)
 		case trustcenterfaq.FieldID, trustcenterfaq.FieldCreatedBy, trustcenterfaq.FieldUpdatedBy, trustcenterfaq.FieldUpdatedByImpersonator, trustcenterfaq.FieldDeletedBy, trustcenterfaq.FieldTrustCenterFaqKindName, trustcenterfaq.FieldTrustCenterFaqKindID, trustcenterfaq.FieldNoteID, trustcenterfaq.FieldTrustCenterID, trustcenterfaq.FieldReferenceLink:
@@ -236,6 +240,12 @@ func (_m *TrustCenterFAQ) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.DisplayOrder = int(value.Int64)
 			}
+		case trustcenterfaq.FieldApproved:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field approved", values[i])
+			} else if value.Valid {
+				_m.Approved = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -337,6 +347,9 @@ func (_m *TrustCenterFAQ) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("display_order=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("approved=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Approved))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -41,6 +41,8 @@ const (
 	FieldReferenceLink = "reference_link"
 	// FieldDisplayOrder holds the string denoting the display_order field in the database.
 	FieldDisplayOrder = "display_order"
+	// FieldApproved holds the string denoting the approved field in the database.
+	FieldApproved = "approved"
 	// EdgeTrustCenterFaqKind holds the string denoting the trust_center_faq_kind edge name in mutations.
 	EdgeTrustCenterFaqKind = "trust_center_faq_kind"
 	// EdgeBlockedGroups holds the string denoting the blocked_groups edge name in mutations.
@@ -106,6 +108,7 @@ var Columns = []string{
 	FieldTrustCenterID,
 	FieldReferenceLink,
 	FieldDisplayOrder,
+	FieldApproved,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -141,6 +144,8 @@ var (
 	ReferenceLinkValidator func(string) error
 	// DefaultDisplayOrder holds the default value on creation for the "display_order" field.
 	DefaultDisplayOrder int
+	// DefaultApproved holds the default value on creation for the "approved" field.
+	DefaultApproved bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -218,6 +223,11 @@ func ByDisplayOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDisplayOrder, opts...).ToFunc()
 }
 
+// ByApproved orders the results by the approved field.
+func ByApproved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApproved, opts...).ToFunc()
+}
+
 // ByTrustCenterFaqKindField orders the results by trust_center_faq_kind field.
 func ByTrustCenterFaqKindField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -132,6 +132,11 @@ func DisplayOrder(v int) predicate.TrustCenterFAQ {
 	return predicate.TrustCenterFAQ(sql.FieldEQ(FieldDisplayOrder, v))
 }
 
+// Approved applies equality check predicate on the "approved" field. It's identical to ApprovedEQ.
+func Approved(v bool) predicate.TrustCenterFAQ {
+	return predicate.TrustCenterFAQ(sql.FieldEQ(FieldApproved, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.TrustCenterFAQ {
 	return predicate.TrustCenterFAQ(sql.FieldEQ(FieldCreatedAt, v))
@@ -997,6 +1002,26 @@ func DisplayOrderNotNil() predicate.TrustCenterFAQ {
 	return predicate.TrustCenterFAQ(sql.FieldNotNull(FieldDisplayOrder))
 }
 
+// ApprovedEQ applies the EQ predicate on the "approved" field.
+func ApprovedEQ(v bool) predicate.TrustCenterFAQ {
+	return predicate.TrustCenterFAQ(sql.FieldEQ(FieldApproved, v))
+}
+
+// ApprovedNEQ applies the NEQ predicate on the "approved" field.
+func ApprovedNEQ(v bool) predicate.TrustCenterFAQ {
+	return predicate.TrustCenterFAQ(sql.FieldNEQ(FieldApproved, v))
+}
+
+// ApprovedIsNil applies the IsNil predicate on the "approved" field.
+func ApprovedIsNil() predicate.TrustCenterFAQ {
+	return predicate.TrustCenterFAQ(sql.FieldIsNull(FieldApproved))
+}
+
+// ApprovedNotNil applies the NotNil predicate on the "approved" field.
+func ApprovedNotNil() predicate.TrustCenterFAQ {
+	return predicate.TrustCenterFAQ(sql.FieldNotNull(FieldApproved))
+}
+
 // HasTrustCenterFaqKind applies the HasEdge predicate on the "trust_center_faq_kind" edge.
 func HasTrustCenterFaqKind() predicate.TrustCenterFAQ {
 	return predicate.TrustCenterFAQ(func(s *sql.Selector) {
@@ -198,6 +198,20 @@ func (_c *TrustCenterFAQCreate) SetNillableDisplayOrder(v *int) *TrustCenterFAQC
 	return _c
 }
 
+// SetApproved sets the "approved" field.
+func (_c *TrustCenterFAQCreate) SetApproved(v bool) *TrustCenterFAQCreate {
+	_c.mutation.SetApproved(v)
+	return _c
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (_c *TrustCenterFAQCreate) SetNillableApproved(v *bool) *TrustCenterFAQCreate {
+	if v != nil {
+		_c.SetApproved(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TrustCenterFAQCreate) SetID(v string) *TrustCenterFAQCreate {
 	_c.mutation.SetID(v)
@@ -312,6 +326,10 @@ func (_c *TrustCenterFAQCreate) defaults() error {
 		v := trustcenterfaq.DefaultDisplayOrder
 		_c.mutation.SetDisplayOrder(v)
 	}
+	if _, ok := _c.mutation.Approved(); !ok {
+		v := trustcenterfaq.DefaultApproved
+		_c.mutation.SetApproved(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		if trustcenterfaq.DefaultID == nil {
 			return fmt.Errorf("generated: uninitialized trustcenterfaq.DefaultID (forgotten import generated/runtime?)")
@@ -421,6 +439,10 @@ func (_c *TrustCenterFAQCreate) createSpec() (*TrustCenterFAQ, *sqlgraph.CreateS
 		_spec.SetField(trustcenterfaq.FieldDisplayOrder, field.TypeInt, value)
 		_node.DisplayOrder = value
 	}
+	if value, ok := _c.mutation.Approved(); ok {
+		_spec.SetField(trustcenterfaq.FieldApproved, field.TypeBool, value)
+		_node.Approved = value
+	}
 	if nodes := _c.mutation.TrustCenterFaqKindIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
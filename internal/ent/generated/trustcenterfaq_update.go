@@ -212,6 +212,26 @@ func (_u *TrustCenterFAQUpdate) ClearDisplayOrder() *TrustCenterFAQUpdate {
 	return _u
 }
 
+// SetApproved sets the "approved" field.
+func (_u *TrustCenterFAQUpdate) SetApproved(v bool) *TrustCenterFAQUpdate {
+	_u.mutation.SetApproved(v)
+	return _u
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (_u *TrustCenterFAQUpdate) SetNillableApproved(v *bool) *TrustCenterFAQUpdate {
+	if v != nil {
+		_u.SetApproved(*v)
+	}
+	return _u
+}
+
+// ClearApproved clears the value of the "approved" field.
+func (_u *TrustCenterFAQUpdate) ClearApproved() *TrustCenterFAQUpdate {
+	_u.mutation.ClearApproved()
+	return _u
+}
+
 // SetTrustCenterFaqKind sets the "trust_center_faq_kind" edge to the CustomTypeEnum entity.
 func (_u *TrustCenterFAQUpdate) SetTrustCenterFaqKind(v *CustomTypeEnum) *TrustCenterFAQUpdate {
 	return _u.SetTrustCenterFaqKindID(v.ID)
@@ -430,6 +450,12 @@ func (_u *TrustCenterFAQUpdate) sqlSave(ctx context.Context) (_node int, err err
 	if _u.mutation.DisplayOrderCleared() {
 		_spec.ClearField(trustcenterfaq.FieldDisplayOrder, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Approved(); ok {
+		_spec.SetField(trustcenterfaq.FieldApproved, field.TypeBool, value)
+	}
+	if _u.mutation.ApprovedCleared() {
+		_spec.ClearField(trustcenterfaq.FieldApproved, field.TypeBool)
+	}
 	if _u.mutation.TrustCenterFaqKindCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -760,6 +786,26 @@ func (_u *TrustCenterFAQUpdateOne) ClearDisplayOrder() *TrustCenterFAQUpdateOne
 	return _u
 }
 
+// SetApproved sets the "approved" field.
+func (_u *TrustCenterFAQUpdateOne) SetApproved(v bool) *TrustCenterFAQUpdateOne {
+	_u.mutation.SetApproved(v)
+	return _u
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (_u *TrustCenterFAQUpdateOne) SetNillableApproved(v *bool) *TrustCenterFAQUpdateOne {
+	if v != nil {
+		_u.SetApproved(*v)
+	}
+	return _u
+}
+
+// ClearApproved clears the value of the "approved" field.
+func (_u *TrustCenterFAQUpdateOne) ClearApproved() *TrustCenterFAQUpdateOne {
+	_u.mutation.ClearApproved()
+	return _u
+}
+
 // SetTrustCenterFaqKind sets the "trust_center_faq_kind" edge to the CustomTypeEnum entity.
 func (_u *TrustCenterFAQUpdateOne) SetTrustCenterFaqKind(v *CustomTypeEnum) *TrustCenterFAQUpdateOne {
 	return _u.SetTrustCenterFaqKindID(v.ID)
@@ -1008,6 +1054,12 @@ func (_u *TrustCenterFAQUpdateOne) sqlSave(ctx context.Context) (_node *TrustCen
 	if _u.mutation.DisplayOrderCleared() {
 		_spec.ClearField(trustcenterfaq.FieldDisplayOrder, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Approved(); ok {
+		_spec.SetField(trustcenterfaq.FieldApproved, field.TypeBool, value)
+	}
+	if _u.mutation.ApprovedCleared() {
+		_spec.ClearField(trustcenterfaq.FieldApproved, field.TypeBool)
+	}
 	if _u.mutation.TrustCenterFaqKindCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -38,6 +38,8 @@ type Tx struct {
 	ControlObjective *ControlObjectiveClient
 	// CustomDomain is the client for interacting with the CustomDomain builders.
 	CustomDomain *CustomDomainClient
+	// CustomRole is the client for interacting with the CustomRole builders.
+	CustomRole *CustomRoleClient
 	// CustomTypeEnum is the client for interacting with the CustomTypeEnum builders.
 	CustomTypeEnum *CustomTypeEnumClient
 	// DNSVerification is the client for interacting with the DNSVerification builders.
@@ -152,6 +154,8 @@ type Tx struct {
 	ProgramMembership *ProgramMembershipClient
 	// Remediation is the client for interacting with the Remediation builders.
 	Remediation *RemediationClient
+	// RetentionRule is the client for interacting with the RetentionRule builders.
+	RetentionRule *RetentionRuleClient
 	// Review is the client for interacting with the Review builders.
 	Review *ReviewClient
 	// Risk is the client for interacting with the Risk builders.
@@ -370,6 +374,7 @@ func (tx *Tx) init() {
 	tx.ControlImplementation = NewControlImplementationClient(tx.config)
 	tx.ControlObjective = NewControlObjectiveClient(tx.config)
 	tx.CustomDomain = NewCustomDomainClient(tx.config)
+	tx.CustomRole = NewCustomRoleClient(tx.config)
 	tx.CustomTypeEnum = NewCustomTypeEnumClient(tx.config)
 	tx.DNSVerification = NewDNSVerificationClient(tx.config)
 	tx.DirectoryAccount = NewDirectoryAccountClient(tx.config)
@@ -427,6 +432,7 @@ func (tx *Tx) init() {
 	tx.Program = NewProgramClient(tx.config)
 	tx.ProgramMembership = NewProgramMembershipClient(tx.config)
 	tx.Remediation = NewRemediationClient(tx.config)
+	tx.RetentionRule = NewRetentionRuleClient(tx.config)
 	tx.Review = NewReviewClient(tx.config)
 	tx.Risk = NewRiskClient(tx.config)
 	tx.SLADefinition = NewSLADefinitionClient(tx.config)
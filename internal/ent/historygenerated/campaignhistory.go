@@ -113,7 +113,9 @@ type CampaignHistory struct {
 	EmailBrandingID string `json:"email_branding_id,omitempty"`
 	// the trust center this campaign sends updates for, if any
 	TrustCenterID string `json:"trust_center_id,omitempty"`
-	selectValues  sql.SelectValues
+	// the snapshot and decisions of the access review run by the campaign; only written by the access review workflow
+	AccessReview map[string]interface{} `json:"access_review,omitempty"`
+	selectValues sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -125,7 +127,7 @@ func (*CampaignHistory) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(models.Cron)}
 		case campaignhistory.FieldScheduledAt, campaignhistory.FieldLaunchedAt, campaignhistory.FieldCompletedAt, campaignhistory.FieldDueDate, campaignhistory.FieldLastRunAt, campaignhistory.FieldNextRunAt, campaignhistory.FieldRecurrenceEndAt, campaignhistory.FieldLastResentAt:
 			values[i] = &sql.NullScanner{S: new(models.DateTime)}
-		case campaignhistory.FieldTags, campaignhistory.FieldMetadata:
+		case campaignhistory.FieldTags, campaignhistory.FieldMetadata, campaignhistory.FieldAccessReview:
 			values[i] = new([]byte)
 		case campaignhistory.FieldOperation:
 			values[i] = new(history.OpType)
@@ -442,6 +444,14 @@ func (_m *CampaignHistory) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.TrustCenterID = value.String
 			}
+		case campaignhistory.FieldAccessReview:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field access_review", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AccessReview); err != nil {
+					return fmt.Errorf("unmarshal field access_review: %w", err)
+				}
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -632,6 +642,9 @@ func (_m *CampaignHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("trust_center_id=")
 	builder.WriteString(_m.TrustCenterID)
+	builder.WriteString(", ")
+	builder.WriteString("access_review=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccessReview))
 	builder.WriteByte(')')
 	return builder.String()
 }
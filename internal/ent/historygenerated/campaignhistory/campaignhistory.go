@@ -110,6 +110,8 @@ const (
 	FieldEmailBrandingID = "email_branding_id"
 	// FieldTrustCenterID holds the string denoting the trust_center_id field in the database.
 	FieldTrustCenterID = "trust_center_id"
+	// FieldAccessReview holds the string denoting the access_review field in the database.
+	FieldAccessReview = "access_review"
 	// Table holds the table name of the campaignhistory in the database.
 	Table = "campaign_history"
 )
@@ -162,6 +164,7 @@ var Columns = []string{
 	FieldIntegrationID,
 	FieldEmailBrandingID,
 	FieldTrustCenterID,
+	FieldAccessReview,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -224,7 +227,7 @@ const DefaultCampaignType enums.CampaignType = "QUESTIONNAIRE"
 // CampaignTypeValidator is a validator for the "campaign_type" field enum values. It is called by the builders before save.
 func CampaignTypeValidator(ct enums.CampaignType) error {
 	switch ct.String() {
-	case "QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE":
+	case "QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE", "ACCESS_REVIEW":
 		return nil
 	default:
 		return fmt.Errorf("campaignhistory: invalid enum value for campaign_type field: %q", ct)
@@ -2744,6 +2744,16 @@ func TrustCenterIDContainsFold(v string) predicate.CampaignHistory {
 	return predicate.CampaignHistory(sql.FieldContainsFold(FieldTrustCenterID, v))
 }
 
+// AccessReviewIsNil applies the IsNil predicate on the "access_review" field.
+func AccessReviewIsNil() predicate.CampaignHistory {
+	return predicate.CampaignHistory(sql.FieldIsNull(FieldAccessReview))
+}
+
+// AccessReviewNotNil applies the NotNil predicate on the "access_review" field.
+func AccessReviewNotNil() predicate.CampaignHistory {
+	return predicate.CampaignHistory(sql.FieldNotNull(FieldAccessReview))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.CampaignHistory) predicate.CampaignHistory {
 	return predicate.CampaignHistory(sql.AndPredicates(predicates...))
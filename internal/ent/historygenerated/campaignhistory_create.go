@@ -615,6 +615,12 @@ func (_c *CampaignHistoryCreate) SetNillableTrustCenterID(v *string) *CampaignHi
 	return _c
 }
 
+// SetAccessReview sets the "access_review" field.
+func (_c *CampaignHistoryCreate) SetAccessReview(v map[string]interface{}) *CampaignHistoryCreate {
+	_c.mutation.SetAccessReview(v)
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *CampaignHistoryCreate) SetID(v string) *CampaignHistoryCreate {
 	_c.mutation.SetID(v)
@@ -1004,6 +1010,10 @@ func (_c *CampaignHistoryCreate) createSpec() (*CampaignHistory, *sqlgraph.Creat
 		_spec.SetField(campaignhistory.FieldTrustCenterID, field.TypeString, value)
 		_node.TrustCenterID = value
 	}
+	if value, ok := _c.mutation.AccessReview(); ok {
+		_spec.SetField(campaignhistory.FieldAccessReview, field.TypeJSON, value)
+		_node.AccessReview = value
+	}
 	return _node, _spec
 }
 
@@ -769,6 +769,18 @@ func (_u *CampaignHistoryUpdate) ClearTrustCenterID() *CampaignHistoryUpdate {
 	return _u
 }
 
+// SetAccessReview sets the "access_review" field.
+func (_u *CampaignHistoryUpdate) SetAccessReview(v map[string]interface{}) *CampaignHistoryUpdate {
+	_u.mutation.SetAccessReview(v)
+	return _u
+}
+
+// ClearAccessReview clears the value of the "access_review" field.
+func (_u *CampaignHistoryUpdate) ClearAccessReview() *CampaignHistoryUpdate {
+	_u.mutation.ClearAccessReview()
+	return _u
+}
+
 // Mutation returns the CampaignHistoryMutation object of the builder.
 func (_u *CampaignHistoryUpdate) Mutation() *CampaignHistoryMutation {
 	return _u.mutation
@@ -1098,6 +1110,12 @@ func (_u *CampaignHistoryUpdate) sqlSave(ctx context.Context) (_node int, err er
 	if _u.mutation.TrustCenterIDCleared() {
 		_spec.ClearField(campaignhistory.FieldTrustCenterID, field.TypeString)
 	}
+	if value, ok := _u.mutation.AccessReview(); ok {
+		_spec.SetField(campaignhistory.FieldAccessReview, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessReviewCleared() {
+		_spec.ClearField(campaignhistory.FieldAccessReview, field.TypeJSON)
+	}
 	_spec.Node.Schema = _u.schemaConfig.CampaignHistory
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -1855,6 +1873,18 @@ func (_u *CampaignHistoryUpdateOne) ClearTrustCenterID() *CampaignHistoryUpdateO
 	return _u
 }
 
+// SetAccessReview sets the "access_review" field.
+func (_u *CampaignHistoryUpdateOne) SetAccessReview(v map[string]interface{}) *CampaignHistoryUpdateOne {
+	_u.mutation.SetAccessReview(v)
+	return _u
+}
+
+// ClearAccessReview clears the value of the "access_review" field.
+func (_u *CampaignHistoryUpdateOne) ClearAccessReview() *CampaignHistoryUpdateOne {
+	_u.mutation.ClearAccessReview()
+	return _u
+}
+
 // Mutation returns the CampaignHistoryMutation object of the builder.
 func (_u *CampaignHistoryUpdateOne) Mutation() *CampaignHistoryMutation {
 	return _u.mutation
@@ -2214,6 +2244,12 @@ func (_u *CampaignHistoryUpdateOne) sqlSave(ctx context.Context) (_node *Campaig
 	if _u.mutation.TrustCenterIDCleared() {
 		_spec.ClearField(campaignhistory.FieldTrustCenterID, field.TypeString)
 	}
+	if value, ok := _u.mutation.AccessReview(); ok {
+		_spec.SetField(campaignhistory.FieldAccessReview, field.TypeJSON, value)
+	}
+	if _u.mutation.AccessReviewCleared() {
+		_spec.ClearField(campaignhistory.FieldAccessReview, field.TypeJSON)
+	}
 	_spec.Node.Schema = _u.schemaConfig.CampaignHistory
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
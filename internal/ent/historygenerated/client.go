@@ -61,6 +61,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -182,6 +183,8 @@ type Client struct {
 	ProgramMembershipHistory *ProgramMembershipHistoryClient
 	// RemediationHistory is the client for interacting with the RemediationHistory builders.
 	RemediationHistory *RemediationHistoryClient
+	// RetentionRuleHistory is the client for interacting with the RetentionRuleHistory builders.
+	RetentionRuleHistory *RetentionRuleHistoryClient
 	// ReviewHistory is the client for interacting with the ReviewHistory builders.
 	ReviewHistory *ReviewHistoryClient
 	// RiskHistory is the client for interacting with the RiskHistory builders.
@@ -294,6 +297,7 @@ func (c *Client) init() {
 	c.ProgramHistory = NewProgramHistoryClient(c.config)
 	c.ProgramMembershipHistory = NewProgramMembershipHistoryClient(c.config)
 	c.RemediationHistory = NewRemediationHistoryClient(c.config)
+	c.RetentionRuleHistory = NewRetentionRuleHistoryClient(c.config)
 	c.ReviewHistory = NewReviewHistoryClient(c.config)
 	c.RiskHistory = NewRiskHistoryClient(c.config)
 	c.SLADefinitionHistory = NewSLADefinitionHistoryClient(c.config)
@@ -481,6 +485,7 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 		ProgramHistory:                    NewProgramHistoryClient(cfg),
 		ProgramMembershipHistory:          NewProgramMembershipHistoryClient(cfg),
 		RemediationHistory:                NewRemediationHistoryClient(cfg),
+		RetentionRuleHistory:              NewRetentionRuleHistoryClient(cfg),
 		ReviewHistory:                     NewReviewHistoryClient(cfg),
 		RiskHistory:                       NewRiskHistoryClient(cfg),
 		SLADefinitionHistory:              NewSLADefinitionHistoryClient(cfg),
@@ -568,6 +573,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 		ProgramHistory:                    NewProgramHistoryClient(cfg),
 		ProgramMembershipHistory:          NewProgramMembershipHistoryClient(cfg),
 		RemediationHistory:                NewRemediationHistoryClient(cfg),
+		RetentionRuleHistory:              NewRetentionRuleHistoryClient(cfg),
 		ReviewHistory:                     NewReviewHistoryClient(cfg),
 		RiskHistory:                       NewRiskHistoryClient(cfg),
 		SLADefinitionHistory:              NewSLADefinitionHistoryClient(cfg),
@@ -636,8 +642,8 @@ func (c *Client) Use(hooks ...Hook) {
 		c.NoteHistory, c.NotificationPreferenceHistory, c.NotificationTemplateHistory,
 		c.OrgMembershipHistory, c.OrganizationHistory, c.OrganizationSettingHistory,
 		c.PlatformHistory, c.ProcedureHistory, c.ProgramHistory,
-		c.ProgramMembershipHistory, c.RemediationHistory, c.ReviewHistory,
-		c.RiskHistory, c.SLADefinitionHistory, c.ScheduledJobHistory,
+		c.ProgramMembershipHistory, c.RemediationHistory, c.RetentionRuleHistory,
+		c.ReviewHistory, c.RiskHistory, c.SLADefinitionHistory, c.ScheduledJobHistory,
 		c.StandardHistory, c.SubcontrolHistory, c.SubprocessorHistory,
 		c.SystemDetailHistory, c.TaskHistory, c.TemplateHistory,
 		c.TrustCenterComplianceHistory, c.TrustCenterDocHistory,
@@ -669,8 +675,8 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 		c.NoteHistory, c.NotificationPreferenceHistory, c.NotificationTemplateHistory,
 		c.OrgMembershipHistory, c.OrganizationHistory, c.OrganizationSettingHistory,
 		c.PlatformHistory, c.ProcedureHistory, c.ProgramHistory,
-		c.ProgramMembershipHistory, c.RemediationHistory, c.ReviewHistory,
-		c.RiskHistory, c.SLADefinitionHistory, c.ScheduledJobHistory,
+		c.ProgramMembershipHistory, c.RemediationHistory, c.RetentionRuleHistory,
+		c.ReviewHistory, c.RiskHistory, c.SLADefinitionHistory, c.ScheduledJobHistory,
 		c.StandardHistory, c.SubcontrolHistory, c.SubprocessorHistory,
 		c.SystemDetailHistory, c.TaskHistory, c.TemplateHistory,
 		c.TrustCenterComplianceHistory, c.TrustCenterDocHistory,
@@ -823,6 +829,8 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.ProgramMembershipHistory.mutate(ctx, m)
 	case *RemediationHistoryMutation:
 		return c.RemediationHistory.mutate(ctx, m)
+	case *RetentionRuleHistoryMutation:
+		return c.RetentionRuleHistory.mutate(ctx, m)
 	case *ReviewHistoryMutation:
 		return c.ReviewHistory.mutate(ctx, m)
 	case *RiskHistoryMutation:
@@ -6417,6 +6425,141 @@ func (c *RemediationHistoryClient) mutate(ctx context.Context, m *RemediationHis
 	}
 }
 
+// RetentionRuleHistoryClient is a client for the RetentionRuleHistory schema.
+type RetentionRuleHistoryClient struct {
+	config
+}
+
+// NewRetentionRuleHistoryClient returns a client for the RetentionRuleHistory from the given config.
+func NewRetentionRuleHistoryClient(c config) *RetentionRuleHistoryClient {
+	return &RetentionRuleHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `retentionrulehistory.Hooks(f(g(h())))`.
+func (c *RetentionRuleHistoryClient) Use(hooks ...Hook) {
+	c.hooks.RetentionRuleHistory = append(c.hooks.RetentionRuleHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `retentionrulehistory.Intercept(f(g(h())))`.
+func (c *RetentionRuleHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RetentionRuleHistory = append(c.inters.RetentionRuleHistory, interceptors...)
+}
+
+// Create returns a builder for creating a RetentionRuleHistory entity.
+func (c *RetentionRuleHistoryClient) Create() *RetentionRuleHistoryCreate {
+	mutation := newRetentionRuleHistoryMutation(c.config, OpCreate)
+	return &RetentionRuleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RetentionRuleHistory entities.
+func (c *RetentionRuleHistoryClient) CreateBulk(builders ...*RetentionRuleHistoryCreate) *RetentionRuleHistoryCreateBulk {
+	return &RetentionRuleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RetentionRuleHistoryClient) MapCreateBulk(slice any, setFunc func(*RetentionRuleHistoryCreate, int)) *RetentionRuleHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RetentionRuleHistoryCreateBulk{err: fmt.Errorf("calling to RetentionRuleHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RetentionRuleHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RetentionRuleHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RetentionRuleHistory.
+func (c *RetentionRuleHistoryClient) Update() *RetentionRuleHistoryUpdate {
+	mutation := newRetentionRuleHistoryMutation(c.config, OpUpdate)
+	return &RetentionRuleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RetentionRuleHistoryClient) UpdateOne(_m *RetentionRuleHistory) *RetentionRuleHistoryUpdateOne {
+	mutation := newRetentionRuleHistoryMutation(c.config, OpUpdateOne, withRetentionRuleHistory(_m))
+	return &RetentionRuleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RetentionRuleHistoryClient) UpdateOneID(id string) *RetentionRuleHistoryUpdateOne {
+	mutation := newRetentionRuleHistoryMutation(c.config, OpUpdateOne, withRetentionRuleHistoryID(id))
+	return &RetentionRuleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RetentionRuleHistory.
+func (c *RetentionRuleHistoryClient) Delete() *RetentionRuleHistoryDelete {
+	mutation := newRetentionRuleHistoryMutation(c.config, OpDelete)
+	return &RetentionRuleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RetentionRuleHistoryClient) DeleteOne(_m *RetentionRuleHistory) *RetentionRuleHistoryDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RetentionRuleHistoryClient) DeleteOneID(id string) *RetentionRuleHistoryDeleteOne {
+	builder := c.Delete().Where(retentionrulehistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RetentionRuleHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for RetentionRuleHistory.
+func (c *RetentionRuleHistoryClient) Query() *RetentionRuleHistoryQuery {
+	return &RetentionRuleHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRetentionRuleHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RetentionRuleHistory entity by its id.
+func (c *RetentionRuleHistoryClient) Get(ctx context.Context, id string) (*RetentionRuleHistory, error) {
+	return c.Query().Where(retentionrulehistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RetentionRuleHistoryClient) GetX(ctx context.Context, id string) *RetentionRuleHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RetentionRuleHistoryClient) Hooks() []Hook {
+	hooks := c.hooks.RetentionRuleHistory
+	return append(hooks[:len(hooks):len(hooks)], retentionrulehistory.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *RetentionRuleHistoryClient) Interceptors() []Interceptor {
+	inters := c.inters.RetentionRuleHistory
+	return append(inters[:len(inters):len(inters)], retentionrulehistory.Interceptors[:]...)
+}
+
+func (c *RetentionRuleHistoryClient) mutate(ctx context.Context, m *RetentionRuleHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RetentionRuleHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RetentionRuleHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RetentionRuleHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RetentionRuleHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("historygenerated: unknown RetentionRuleHistory mutation op: %q", m.Op())
+	}
+}
+
 // ReviewHistoryClient is a client for the ReviewHistory schema.
 type ReviewHistoryClient struct {
 	config
@@ -10076,9 +10219,9 @@ type (
 		NotificationPreferenceHistory, NotificationTemplateHistory,
 		OrgMembershipHistory, OrganizationHistory, OrganizationSettingHistory,
 		PlatformHistory, ProcedureHistory, ProgramHistory, ProgramMembershipHistory,
-		RemediationHistory, ReviewHistory, RiskHistory, SLADefinitionHistory,
-		ScheduledJobHistory, StandardHistory, SubcontrolHistory, SubprocessorHistory,
-		SystemDetailHistory, TaskHistory, TemplateHistory,
+		RemediationHistory, RetentionRuleHistory, ReviewHistory, RiskHistory,
+		SLADefinitionHistory, ScheduledJobHistory, StandardHistory, SubcontrolHistory,
+		SubprocessorHistory, SystemDetailHistory, TaskHistory, TemplateHistory,
 		TrustCenterComplianceHistory, TrustCenterDocHistory, TrustCenterEntityHistory,
 		TrustCenterFAQHistory, TrustCenterHistory, TrustCenterNDARequestHistory,
 		TrustCenterSettingHistory, TrustCenterSubprocessorHistory,
@@ -10099,9 +10242,9 @@ type (
 		NotificationPreferenceHistory, NotificationTemplateHistory,
 		OrgMembershipHistory, OrganizationHistory, OrganizationSettingHistory,
 		PlatformHistory, ProcedureHistory, ProgramHistory, ProgramMembershipHistory,
-		RemediationHistory, ReviewHistory, RiskHistory, SLADefinitionHistory,
-		ScheduledJobHistory, StandardHistory, SubcontrolHistory, SubprocessorHistory,
-		SystemDetailHistory, TaskHistory, TemplateHistory,
+		RemediationHistory, RetentionRuleHistory, ReviewHistory, RiskHistory,
+		SLADefinitionHistory, ScheduledJobHistory, StandardHistory, SubcontrolHistory,
+		SubprocessorHistory, SystemDetailHistory, TaskHistory, TemplateHistory,
 		TrustCenterComplianceHistory, TrustCenterDocHistory, TrustCenterEntityHistory,
 		TrustCenterFAQHistory, TrustCenterHistory, TrustCenterNDARequestHistory,
 		TrustCenterSettingHistory, TrustCenterSubprocessorHistory,
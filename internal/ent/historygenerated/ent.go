@@ -55,6 +55,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -183,6 +184,7 @@ func checkColumn(t, c string) error {
 			programhistory.Table:                    programhistory.ValidColumn,
 			programmembershiphistory.Table:          programmembershiphistory.ValidColumn,
 			remediationhistory.Table:                remediationhistory.ValidColumn,
+			retentionrulehistory.Table:              retentionrulehistory.ValidColumn,
 			reviewhistory.Table:                     reviewhistory.ValidColumn,
 			riskhistory.Table:                       riskhistory.ValidColumn,
 			sladefinitionhistory.Table:              sladefinitionhistory.ValidColumn,
@@ -109,6 +109,8 @@ type EntityHistory struct {
 	HasSoc2 bool `json:"has_soc2,omitempty"`
 	// SOC 2 reporting period end date
 	Soc2PeriodEnd *models.DateTime `json:"soc2_period_end,omitempty"`
+	// when the SOC 2 reporting period on file was found to have lapsed; cleared when the reporting period end changes
+	Soc2LapsedAt *models.DateTime `json:"soc2_lapsed_at,omitempty"`
 	// start date for the entity contract
 	ContractStartDate *models.DateTime `json:"contract_start_date,omitempty"`
 	// end date for the entity contract
@@ -169,7 +171,7 @@ func (*EntityHistory) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case entityhistory.FieldLastReviewedAt, entityhistory.FieldSoc2PeriodEnd, entityhistory.FieldContractStartDate, entityhistory.FieldContractEndDate, entityhistory.FieldNextReviewAt, entityhistory.FieldContractRenewalAt, entityhistory.FieldObservedAt:
+		case entityhistory.FieldLastReviewedAt, entityhistory.FieldSoc2PeriodEnd, entityhistory.FieldSoc2LapsedAt, entityhistory.FieldContractStartDate, entityhistory.FieldContractEndDate, entityhistory.FieldNextReviewAt, entityhistory.FieldContractRenewalAt, entityhistory.FieldObservedAt:
 			values[i] = &sql.NullScanner{S: new(models.DateTime)}
 		case entityhistory.FieldTags, entityhistory.FieldDomains, entityhistory.FieldAliases, entityhistory.FieldLinkedAssetIds, entityhistory.FieldProvidedServices, entityhistory.FieldLinks, entityhistory.FieldVendorMetadata:
 			values[i] = new([]byte)
@@ -477,6 +479,13 @@ func (_m *EntityHistory) assignValues(columns []string, values []any) error {
 				_m.Soc2PeriodEnd = new(models.DateTime)
 				*_m.Soc2PeriodEnd = *value.S.(*models.DateTime)
 			}
+		case entityhistory.FieldSoc2LapsedAt:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field soc2_lapsed_at", values[i])
+			} else if value.Valid {
+				_m.Soc2LapsedAt = new(models.DateTime)
+				*_m.Soc2LapsedAt = *value.S.(*models.DateTime)
+			}
 		case entityhistory.FieldContractStartDate:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field contract_start_date", values[i])
@@ -821,6 +830,11 @@ func (_m *EntityHistory) String() string {
 		builder.WriteString(fmt.Sprintf("%v", *v))
 	}
 	builder.WriteString(", ")
+	if v := _m.Soc2LapsedAt; v != nil {
+		builder.WriteString("soc2_lapsed_at=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	if v := _m.ContractStartDate; v != nil {
 		builder.WriteString("contract_start_date=")
 		builder.WriteString(fmt.Sprintf("%v", *v))
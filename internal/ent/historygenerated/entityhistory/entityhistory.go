@@ -106,6 +106,8 @@ const (
 	FieldHasSoc2 = "has_soc2"
 	// FieldSoc2PeriodEnd holds the string denoting the soc2_period_end field in the database.
 	FieldSoc2PeriodEnd = "soc2_period_end"
+	// FieldSoc2LapsedAt holds the string denoting the soc2_lapsed_at field in the database.
+	FieldSoc2LapsedAt = "soc2_lapsed_at"
 	// FieldContractStartDate holds the string denoting the contract_start_date field in the database.
 	FieldContractStartDate = "contract_start_date"
 	// FieldContractEndDate holds the string denoting the contract_end_date field in the database.
@@ -208,6 +210,7 @@ var Columns = []string{
 	FieldLinkedAssetIds,
 	FieldHasSoc2,
 	FieldSoc2PeriodEnd,
+	FieldSoc2LapsedAt,
 	FieldContractStartDate,
 	FieldContractEndDate,
 	FieldAutoRenews,
@@ -540,6 +543,11 @@ func BySoc2PeriodEnd(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSoc2PeriodEnd, opts...).ToFunc()
 }
 
+// BySoc2LapsedAt orders the results by the soc2_lapsed_at field.
+func BySoc2LapsedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSoc2LapsedAt, opts...).ToFunc()
+}
+
 // ByContractStartDate orders the results by the contract_start_date field.
 func ByContractStartDate(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContractStartDate, opts...).ToFunc()
@@ -587,6 +587,20 @@ func (_c *EntityHistoryCreate) SetNillableSoc2PeriodEnd(v *models.DateTime) *Ent
 	return _c
 }
 
+// SetSoc2LapsedAt sets the "soc2_lapsed_at" field.
+func (_c *EntityHistoryCreate) SetSoc2LapsedAt(v models.DateTime) *EntityHistoryCreate {
+	_c.mutation.SetSoc2LapsedAt(v)
+	return _c
+}
+
+// SetNillableSoc2LapsedAt sets the "soc2_lapsed_at" field if the given value is not nil.
+func (_c *EntityHistoryCreate) SetNillableSoc2LapsedAt(v *models.DateTime) *EntityHistoryCreate {
+	if v != nil {
+		_c.SetSoc2LapsedAt(*v)
+	}
+	return _c
+}
+
 // SetContractStartDate sets the "contract_start_date" field.
 func (_c *EntityHistoryCreate) SetContractStartDate(v models.DateTime) *EntityHistoryCreate {
 	_c.mutation.SetContractStartDate(v)
@@ -1305,6 +1319,10 @@ func (_c *EntityHistoryCreate) createSpec() (*EntityHistory, *sqlgraph.CreateSpe
 		_spec.SetField(entityhistory.FieldSoc2PeriodEnd, field.TypeTime, value)
 		_node.Soc2PeriodEnd = &value
 	}
+	if value, ok := _c.mutation.Soc2LapsedAt(); ok {
+		_spec.SetField(entityhistory.FieldSoc2LapsedAt, field.TypeTime, value)
+		_node.Soc2LapsedAt = &value
+	}
 	if value, ok := _c.mutation.ContractStartDate(); ok {
 		_spec.SetField(entityhistory.FieldContractStartDate, field.TypeTime, value)
 		_node.ContractStartDate = &value
@@ -740,6 +740,26 @@ func (_u *EntityHistoryUpdate) ClearSoc2PeriodEnd() *EntityHistoryUpdate {
 	return _u
 }
 
+// SetSoc2LapsedAt sets the "soc2_lapsed_at" field.
+func (_u *EntityHistoryUpdate) SetSoc2LapsedAt(v models.DateTime) *EntityHistoryUpdate {
+	_u.mutation.SetSoc2LapsedAt(v)
+	return _u
+}
+
+// SetNillableSoc2LapsedAt sets the "soc2_lapsed_at" field if the given value is not nil.
+func (_u *EntityHistoryUpdate) SetNillableSoc2LapsedAt(v *models.DateTime) *EntityHistoryUpdate {
+	if v != nil {
+		_u.SetSoc2LapsedAt(*v)
+	}
+	return _u
+}
+
+// ClearSoc2LapsedAt clears the value of the "soc2_lapsed_at" field.
+func (_u *EntityHistoryUpdate) ClearSoc2LapsedAt() *EntityHistoryUpdate {
+	_u.mutation.ClearSoc2LapsedAt()
+	return _u
+}
+
 // SetContractStartDate sets the "contract_start_date" field.
 func (_u *EntityHistoryUpdate) SetContractStartDate(v models.DateTime) *EntityHistoryUpdate {
 	_u.mutation.SetContractStartDate(v)
@@ -1612,6 +1632,12 @@ func (_u *EntityHistoryUpdate) sqlSave(ctx context.Context) (_node int, err erro
 	if _u.mutation.Soc2PeriodEndCleared() {
 		_spec.ClearField(entityhistory.FieldSoc2PeriodEnd, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Soc2LapsedAt(); ok {
+		_spec.SetField(entityhistory.FieldSoc2LapsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.Soc2LapsedAtCleared() {
+		_spec.ClearField(entityhistory.FieldSoc2LapsedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ContractStartDate(); ok {
 		_spec.SetField(entityhistory.FieldContractStartDate, field.TypeTime, value)
 	}
@@ -2518,6 +2544,26 @@ func (_u *EntityHistoryUpdateOne) ClearSoc2PeriodEnd() *EntityHistoryUpdateOne {
 	return _u
 }
 
+// SetSoc2LapsedAt sets the "soc2_lapsed_at" field.
+func (_u *EntityHistoryUpdateOne) SetSoc2LapsedAt(v models.DateTime) *EntityHistoryUpdateOne {
+	_u.mutation.SetSoc2LapsedAt(v)
+	return _u
+}
+
+// SetNillableSoc2LapsedAt sets the "soc2_lapsed_at" field if the given value is not nil.
+func (_u *EntityHistoryUpdateOne) SetNillableSoc2LapsedAt(v *models.DateTime) *EntityHistoryUpdateOne {
+	if v != nil {
+		_u.SetSoc2LapsedAt(*v)
+	}
+	return _u
+}
+
+// ClearSoc2LapsedAt clears the value of the "soc2_lapsed_at" field.
+func (_u *EntityHistoryUpdateOne) ClearSoc2LapsedAt() *EntityHistoryUpdateOne {
+	_u.mutation.ClearSoc2LapsedAt()
+	return _u
+}
+
 // SetContractStartDate sets the "contract_start_date" field.
 func (_u *EntityHistoryUpdateOne) SetContractStartDate(v models.DateTime) *EntityHistoryUpdateOne {
 	_u.mutation.SetContractStartDate(v)
@@ -3420,6 +3466,12 @@ func (_u *EntityHistoryUpdateOne) sqlSave(ctx context.Context) (_node *EntityHis
 	if _u.mutation.Soc2PeriodEndCleared() {
 		_spec.ClearField(entityhistory.FieldSoc2PeriodEnd, field.TypeTime)
 	}
+	if value, ok := _u.mutation.Soc2LapsedAt(); ok {
+		_spec.SetField(entityhistory.FieldSoc2LapsedAt, field.TypeTime, value)
+	}
+	if _u.mutation.Soc2LapsedAtCleared() {
+		_spec.ClearField(entityhistory.FieldSoc2LapsedAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.ContractStartDate(); ok {
 		_spec.SetField(entityhistory.FieldContractStartDate, field.TypeTime, value)
 	}
@@ -97,6 +97,8 @@ const (
 	FieldStorageProvider = "storage_provider"
 	// FieldLastAccessedAt holds the string denoting the last_accessed_at field in the database.
 	FieldLastAccessedAt = "last_accessed_at"
+	// FieldScanStatus holds the string denoting the scan_status field in the database.
+	FieldScanStatus = "scan_status"
 	// Table holds the table name of the filehistory in the database.
 	Table = "file_history"
 )
@@ -143,6 +145,7 @@ var Columns = []string{
 	FieldStorageRegion,
 	FieldStorageProvider,
 	FieldLastAccessedAt,
+	FieldScanStatus,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -378,6 +381,11 @@ func ByLastAccessedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLastAccessedAt, opts...).ToFunc()
 }
 
+// ByScanStatus orders the results by the scan_status field.
+func ByScanStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldScanStatus, opts...).ToFunc()
+}
+
 var (
 	// history.OpType must implement graphql.Marshaler.
 	_ graphql.Marshaler = (*history.OpType)(nil)
@@ -513,6 +513,20 @@ func (_c *FileHistoryCreate) SetNillableLastAccessedAt(v *time.Time) *FileHistor
 	return _c
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_c *FileHistoryCreate) SetScanStatus(v string) *FileHistoryCreate {
+	_c.mutation.SetScanStatus(v)
+	return _c
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_c *FileHistoryCreate) SetNillableScanStatus(v *string) *FileHistoryCreate {
+	if v != nil {
+		_c.SetScanStatus(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *FileHistoryCreate) SetID(v string) *FileHistoryCreate {
 	_c.mutation.SetID(v)
@@ -817,6 +831,10 @@ func (_c *FileHistoryCreate) createSpec() (*FileHistory, *sqlgraph.CreateSpec) {
 		_spec.SetField(filehistory.FieldLastAccessedAt, field.TypeTime, value)
 		_node.LastAccessedAt = &value
 	}
+	if value, ok := _c.mutation.ScanStatus(); ok {
+		_spec.SetField(filehistory.FieldScanStatus, field.TypeString, value)
+		_node.ScanStatus = value
+	}
 	return _node, _spec
 }
 
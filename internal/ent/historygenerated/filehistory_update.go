@@ -664,6 +664,26 @@ func (_u *FileHistoryUpdate) ClearLastAccessedAt() *FileHistoryUpdate {
 	return _u
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileHistoryUpdate) SetScanStatus(v string) *FileHistoryUpdate {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileHistoryUpdate) SetNillableScanStatus(v *string) *FileHistoryUpdate {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileHistoryUpdate) ClearScanStatus() *FileHistoryUpdate {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
 // Mutation returns the FileHistoryMutation object of the builder.
 func (_u *FileHistoryUpdate) Mutation() *FileHistoryMutation {
 	return _u.mutation
@@ -938,6 +958,12 @@ func (_u *FileHistoryUpdate) sqlSave(ctx context.Context) (_node int, err error)
 	if _u.mutation.LastAccessedAtCleared() {
 		_spec.ClearField(filehistory.FieldLastAccessedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(filehistory.FieldScanStatus, field.TypeString, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(filehistory.FieldScanStatus, field.TypeString)
+	}
 	_spec.Node.Schema = _u.schemaConfig.FileHistory
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -1592,6 +1618,26 @@ func (_u *FileHistoryUpdateOne) ClearLastAccessedAt() *FileHistoryUpdateOne {
 	return _u
 }
 
+// SetScanStatus sets the "scan_status" field.
+func (_u *FileHistoryUpdateOne) SetScanStatus(v string) *FileHistoryUpdateOne {
+	_u.mutation.SetScanStatus(v)
+	return _u
+}
+
+// SetNillableScanStatus sets the "scan_status" field if the given value is not nil.
+func (_u *FileHistoryUpdateOne) SetNillableScanStatus(v *string) *FileHistoryUpdateOne {
+	if v != nil {
+		_u.SetScanStatus(*v)
+	}
+	return _u
+}
+
+// ClearScanStatus clears the value of the "scan_status" field.
+func (_u *FileHistoryUpdateOne) ClearScanStatus() *FileHistoryUpdateOne {
+	_u.mutation.ClearScanStatus()
+	return _u
+}
+
 // Mutation returns the FileHistoryMutation object of the builder.
 func (_u *FileHistoryUpdateOne) Mutation() *FileHistoryMutation {
 	return _u.mutation
@@ -1896,6 +1942,12 @@ func (_u *FileHistoryUpdateOne) sqlSave(ctx context.Context) (_node *FileHistory
 	if _u.mutation.LastAccessedAtCleared() {
 		_spec.ClearField(filehistory.FieldLastAccessedAt, field.TypeTime)
 	}
+	if value, ok := _u.mutation.ScanStatus(); ok {
+		_spec.SetField(filehistory.FieldScanStatus, field.TypeString, value)
+	}
+	if _u.mutation.ScanStatusCleared() {
+		_spec.ClearField(filehistory.FieldScanStatus, field.TypeString)
+	}
 	_spec.Node.Schema = _u.schemaConfig.FileHistory
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
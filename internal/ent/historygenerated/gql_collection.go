@@ -50,6 +50,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -3506,6 +3507,11 @@ func (_q *EntityHistoryQuery) collectField(ctx context.Context, oneNode bool, op
 				selectedFields = append(selectedFields, entityhistory.FieldSoc2PeriodEnd)
 				fieldSeen[entityhistory.FieldSoc2PeriodEnd] = struct{}{}
 			}
+		case "soc2LapsedAt":
+			if _, ok := fieldSeen[entityhistory.FieldSoc2LapsedAt]; !ok {
+				selectedFields = append(selectedFields, entityhistory.FieldSoc2LapsedAt)
+				fieldSeen[entityhistory.FieldSoc2LapsedAt] = struct{}{}
+			}
 		case "contractStartDate":
 			if _, ok := fieldSeen[entityhistory.FieldContractStartDate]; !ok {
 				selectedFields = append(selectedFields, entityhistory.FieldContractStartDate)
@@ -4273,6 +4279,11 @@ func (_q *FileHistoryQuery) collectField(ctx context.Context, oneNode bool, opCt
 				selectedFields = append(selectedFields, filehistory.FieldLastAccessedAt)
 				fieldSeen[filehistory.FieldLastAccessedAt] = struct{}{}
 			}
+		case "scanStatus":
+			if _, ok := fieldSeen[filehistory.FieldScanStatus]; !ok {
+				selectedFields = append(selectedFields, filehistory.FieldScanStatus)
+				fieldSeen[filehistory.FieldScanStatus] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
@@ -9483,6 +9494,165 @@ func newRemediationHistoryPaginateArgs(rv map[string]any) *remediationhistoryPag
 	return args
 }
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *RetentionRuleHistoryQuery) CollectFields(ctx context.Context, satisfies ...string) (*RetentionRuleHistoryQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *RetentionRuleHistoryQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(retentionrulehistory.Columns))
+		selectedFields = []string{retentionrulehistory.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "historyTime":
+			if _, ok := fieldSeen[retentionrulehistory.FieldHistoryTime]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldHistoryTime)
+				fieldSeen[retentionrulehistory.FieldHistoryTime] = struct{}{}
+			}
+		case "ref":
+			if _, ok := fieldSeen[retentionrulehistory.FieldRef]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldRef)
+				fieldSeen[retentionrulehistory.FieldRef] = struct{}{}
+			}
+		case "operation":
+			if _, ok := fieldSeen[retentionrulehistory.FieldOperation]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldOperation)
+				fieldSeen[retentionrulehistory.FieldOperation] = struct{}{}
+			}
+		case "createdAt":
+			if _, ok := fieldSeen[retentionrulehistory.FieldCreatedAt]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldCreatedAt)
+				fieldSeen[retentionrulehistory.FieldCreatedAt] = struct{}{}
+			}
+		case "updatedAt":
+			if _, ok := fieldSeen[retentionrulehistory.FieldUpdatedAt]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldUpdatedAt)
+				fieldSeen[retentionrulehistory.FieldUpdatedAt] = struct{}{}
+			}
+		case "createdBy":
+			if _, ok := fieldSeen[retentionrulehistory.FieldCreatedBy]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldCreatedBy)
+				fieldSeen[retentionrulehistory.FieldCreatedBy] = struct{}{}
+			}
+		case "updatedBy":
+			if _, ok := fieldSeen[retentionrulehistory.FieldUpdatedBy]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldUpdatedBy)
+				fieldSeen[retentionrulehistory.FieldUpdatedBy] = struct{}{}
+			}
+		case "updatedByImpersonator":
+			if _, ok := fieldSeen[retentionrulehistory.FieldUpdatedByImpersonator]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldUpdatedByImpersonator)
+				fieldSeen[retentionrulehistory.FieldUpdatedByImpersonator] = struct{}{}
+			}
+		case "ownerID":
+			if _, ok := fieldSeen[retentionrulehistory.FieldOwnerID]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldOwnerID)
+				fieldSeen[retentionrulehistory.FieldOwnerID] = struct{}{}
+			}
+		case "name":
+			if _, ok := fieldSeen[retentionrulehistory.FieldName]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldName)
+				fieldSeen[retentionrulehistory.FieldName] = struct{}{}
+			}
+		case "description":
+			if _, ok := fieldSeen[retentionrulehistory.FieldDescription]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldDescription)
+				fieldSeen[retentionrulehistory.FieldDescription] = struct{}{}
+			}
+		case "category":
+			if _, ok := fieldSeen[retentionrulehistory.FieldCategory]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldCategory)
+				fieldSeen[retentionrulehistory.FieldCategory] = struct{}{}
+			}
+		case "objectType":
+			if _, ok := fieldSeen[retentionrulehistory.FieldObjectType]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldObjectType)
+				fieldSeen[retentionrulehistory.FieldObjectType] = struct{}{}
+			}
+		case "retentionDays":
+			if _, ok := fieldSeen[retentionrulehistory.FieldRetentionDays]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldRetentionDays)
+				fieldSeen[retentionrulehistory.FieldRetentionDays] = struct{}{}
+			}
+		case "purge":
+			if _, ok := fieldSeen[retentionrulehistory.FieldPurge]; !ok {
+				selectedFields = append(selectedFields, retentionrulehistory.FieldPurge)
+				fieldSeen[retentionrulehistory.FieldPurge] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type retentionrulehistoryPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []RetentionRuleHistoryPaginateOption
+}
+
+func newRetentionRuleHistoryPaginateArgs(rv map[string]any) *retentionrulehistoryPaginateArgs {
+	args := &retentionrulehistoryPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	if v, ok := rv[orderByField]; ok {
+		switch v := v.(type) {
+		case map[string]any:
+			var (
+				err1, err2 error
+				order      = &RetentionRuleHistoryOrder{Field: &RetentionRuleHistoryOrderField{}, Direction: entgql.OrderDirectionAsc}
+			)
+			if d, ok := v[directionField]; ok {
+				err1 = order.Direction.UnmarshalGQL(d)
+			}
+			if f, ok := v[fieldField]; ok {
+				err2 = order.Field.UnmarshalGQL(f)
+			}
+			if err1 == nil && err2 == nil {
+				args.opts = append(args.opts, WithRetentionRuleHistoryOrder(order))
+			}
+		case *RetentionRuleHistoryOrder:
+			if v != nil {
+				args.opts = append(args.opts, WithRetentionRuleHistoryOrder(v))
+			}
+		}
+	}
+	if v, ok := rv[whereField].(*RetentionRuleHistoryWhereInput); ok {
+		args.opts = append(args.opts, WithRetentionRuleHistoryFilter(v.Filter))
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *ReviewHistoryQuery) CollectFields(ctx context.Context, satisfies ...string) (*ReviewHistoryQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -12301,6 +12471,11 @@ func (_q *TrustCenterFAQHistoryQuery) collectField(ctx context.Context, oneNode
 				selectedFields = append(selectedFields, trustcenterfaqhistory.FieldDisplayOrder)
 				fieldSeen[trustcenterfaqhistory.FieldDisplayOrder] = struct{}{}
 			}
+		case "approved":
+			if _, ok := fieldSeen[trustcenterfaqhistory.FieldApproved]; !ok {
+				selectedFields = append(selectedFields, trustcenterfaqhistory.FieldApproved)
+				fieldSeen[trustcenterfaqhistory.FieldApproved] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
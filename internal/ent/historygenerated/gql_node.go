@@ -52,6 +52,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -291,6 +292,11 @@ var remediationhistoryImplementors = []string{"RemediationHistory", "Node"}
 // IsNode implements the Node interface check for GQLGen.
 func (*RemediationHistory) IsNode() {}
 
+var retentionrulehistoryImplementors = []string{"RetentionRuleHistory", "Node"}
+
+// IsNode implements the Node interface check for GQLGen.
+func (*RetentionRuleHistory) IsNode() {}
+
 var reviewhistoryImplementors = []string{"ReviewHistory", "Node"}
 
 // IsNode implements the Node interface check for GQLGen.
@@ -853,6 +859,15 @@ func (c *Client) noder(ctx context.Context, table string, id string) (Noder, err
 			}
 		}
 		return query.Only(ctx)
+	case retentionrulehistory.Table:
+		query := c.RetentionRuleHistory.Query().
+			Where(retentionrulehistory.ID(id))
+		if fc := graphql.GetFieldContext(ctx); fc != nil {
+			if err := query.collectField(ctx, true, graphql.GetOperationContext(ctx), fc.Field, nil, retentionrulehistoryImplementors...); err != nil {
+				return nil, err
+			}
+		}
+		return query.Only(ctx)
 	case reviewhistory.Table:
 		query := c.ReviewHistory.Query().
 			Where(reviewhistory.ID(id))
@@ -1825,6 +1840,22 @@ func (c *Client) noders(ctx context.Context, table string, ids []string) ([]Node
 				*noder = node
 			}
 		}
+	case retentionrulehistory.Table:
+		query := c.RetentionRuleHistory.Query().
+			Where(retentionrulehistory.IDIn(ids...))
+		query, err := query.CollectFields(ctx, retentionrulehistoryImplementors...)
+		if err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range nodes {
+			for _, noder := range idmap[node.ID] {
+				*noder = node
+			}
+		}
 	case reviewhistory.Table:
 		query := c.ReviewHistory.Query().
 			Where(reviewhistory.IDIn(ids...))
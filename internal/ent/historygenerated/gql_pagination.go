@@ -57,6 +57,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -18360,6 +18361,374 @@ func (_m *RemediationHistory) ToEdge(order *RemediationHistoryOrder) *Remediatio
 	}
 }
 
+// RetentionRuleHistoryEdge is the edge representation of RetentionRuleHistory.
+type RetentionRuleHistoryEdge struct {
+	Node   *RetentionRuleHistory `json:"node"`
+	Cursor Cursor                `json:"cursor"`
+}
+
+// RetentionRuleHistoryConnection is the connection containing edges to RetentionRuleHistory.
+type RetentionRuleHistoryConnection struct {
+	Edges      []*RetentionRuleHistoryEdge `json:"edges"`
+	PageInfo   PageInfo                    `json:"pageInfo"`
+	TotalCount int                         `json:"totalCount"`
+}
+
+func (c *RetentionRuleHistoryConnection) build(nodes []*RetentionRuleHistory, pager *retentionrulehistoryPager, after *Cursor, first *int, before *Cursor, last *int) {
+	c.PageInfo.HasNextPage = before != nil
+	c.PageInfo.HasPreviousPage = after != nil
+	if first != nil && len(nodes) >= *first+1 {
+		c.PageInfo.HasNextPage = true
+		nodes = nodes[:*first]
+	} else if last != nil && len(nodes) >= *last+1 {
+		c.PageInfo.HasPreviousPage = true
+		nodes = nodes[:*last]
+	}
+	var nodeAt func(int) *RetentionRuleHistory
+	if last != nil {
+		n := len(nodes) - 1
+		nodeAt = func(i int) *RetentionRuleHistory {
+			return nodes[n-i]
+		}
+	} else {
+		nodeAt = func(i int) *RetentionRuleHistory {
+			return nodes[i]
+		}
+	}
+	c.Edges = make([]*RetentionRuleHistoryEdge, len(nodes))
+	for i := range nodes {
+		node := nodeAt(i)
+		c.Edges[i] = &RetentionRuleHistoryEdge{
+			Node:   node,
+			Cursor: pager.toCursor(node),
+		}
+	}
+	if l := len(c.Edges); l > 0 {
+		c.PageInfo.StartCursor = &c.Edges[0].Cursor
+		c.PageInfo.EndCursor = &c.Edges[l-1].Cursor
+	}
+	if c.TotalCount == 0 {
+		c.TotalCount = len(nodes)
+	}
+}
+
+// RetentionRuleHistoryPaginateOption enables pagination customization.
+type RetentionRuleHistoryPaginateOption func(*retentionrulehistoryPager) error
+
+// WithRetentionRuleHistoryOrder configures pagination ordering.
+func WithRetentionRuleHistoryOrder(order *RetentionRuleHistoryOrder) RetentionRuleHistoryPaginateOption {
+	if order == nil {
+		order = DefaultRetentionRuleHistoryOrder
+	}
+	o := *order
+	return func(pager *retentionrulehistoryPager) error {
+		if err := o.Direction.Validate(); err != nil {
+			return err
+		}
+		if o.Field == nil {
+			o.Field = DefaultRetentionRuleHistoryOrder.Field
+		}
+		pager.order = &o
+		return nil
+	}
+}
+
+// WithRetentionRuleHistoryFilter configures pagination filter.
+func WithRetentionRuleHistoryFilter(filter func(*RetentionRuleHistoryQuery) (*RetentionRuleHistoryQuery, error)) RetentionRuleHistoryPaginateOption {
+	return func(pager *retentionrulehistoryPager) error {
+		if filter == nil {
+			return errors.New("RetentionRuleHistoryQuery filter cannot be nil")
+		}
+		pager.filter = filter
+		return nil
+	}
+}
+
+type retentionrulehistoryPager struct {
+	reverse bool
+	order   *RetentionRuleHistoryOrder
+	filter  func(*RetentionRuleHistoryQuery) (*RetentionRuleHistoryQuery, error)
+}
+
+func newRetentionRuleHistoryPager(opts []RetentionRuleHistoryPaginateOption, reverse bool) (*retentionrulehistoryPager, error) {
+	pager := &retentionrulehistoryPager{reverse: reverse}
+	for _, opt := range opts {
+		if err := opt(pager); err != nil {
+			return nil, err
+		}
+	}
+	if pager.order == nil {
+		pager.order = DefaultRetentionRuleHistoryOrder
+	}
+	return pager, nil
+}
+
+func (p *retentionrulehistoryPager) applyFilter(query *RetentionRuleHistoryQuery) (*RetentionRuleHistoryQuery, error) {
+	if p.filter != nil {
+		return p.filter(query)
+	}
+	return query, nil
+}
+
+func (p *retentionrulehistoryPager) toCursor(_m *RetentionRuleHistory) Cursor {
+	return p.order.Field.toCursor(_m)
+}
+
+func (p *retentionrulehistoryPager) applyCursors(query *RetentionRuleHistoryQuery, after, before *Cursor) (*RetentionRuleHistoryQuery, error) {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	for _, predicate := range entgql.CursorsPredicate(after, before, DefaultRetentionRuleHistoryOrder.Field.column, p.order.Field.column, direction) {
+		query = query.Where(predicate)
+	}
+	return query, nil
+}
+
+func (p *retentionrulehistoryPager) applyOrder(query *RetentionRuleHistoryQuery) *RetentionRuleHistoryQuery {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	query = query.Order(p.order.Field.toTerm(direction.OrderTermOption()))
+	if p.order.Field != DefaultRetentionRuleHistoryOrder.Field {
+		query = query.Order(DefaultRetentionRuleHistoryOrder.Field.toTerm(direction.OrderTermOption()))
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return query
+}
+
+func (p *retentionrulehistoryPager) orderExpr(query *RetentionRuleHistoryQuery) sql.Querier {
+	direction := p.order.Direction
+	if p.reverse {
+		direction = direction.Reverse()
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(p.order.Field.column)
+	}
+	return sql.ExprFunc(func(b *sql.Builder) {
+		b.Ident(p.order.Field.column).Pad().WriteString(string(direction))
+		if p.order.Field != DefaultRetentionRuleHistoryOrder.Field {
+			b.Comma().Ident(DefaultRetentionRuleHistoryOrder.Field.column).Pad().WriteString(string(direction))
+		}
+	})
+}
+
+// Paginate executes the query and returns a relay based cursor connection to RetentionRuleHistory.
+func (_m *RetentionRuleHistoryQuery) Paginate(
+	ctx context.Context, after *Cursor, first *int,
+	before *Cursor, last *int, opts ...RetentionRuleHistoryPaginateOption,
+) (*RetentionRuleHistoryConnection, error) {
+	if err := validateFirstLast(first, last); err != nil {
+		return nil, err
+	}
+	pager, err := newRetentionRuleHistoryPager(opts, last != nil)
+	if err != nil {
+		return nil, err
+	}
+	if _m, err = pager.applyFilter(_m); err != nil {
+		return nil, err
+	}
+	conn := &RetentionRuleHistoryConnection{Edges: []*RetentionRuleHistoryEdge{}}
+	ignoredEdges := !hasCollectedField(ctx, edgesField)
+	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
+		hasPagination := after != nil || first != nil || before != nil || last != nil
+		if hasPagination || ignoredEdges {
+			c := _m.Clone()
+			c.ctx.Fields = nil
+			if conn.TotalCount, err = c.CountIDs(ctx); err != nil {
+				return nil, err
+			}
+			conn.PageInfo.HasNextPage = first != nil && conn.TotalCount > 0
+			conn.PageInfo.HasPreviousPage = last != nil && conn.TotalCount > 0
+		}
+	}
+	if (first != nil && *first == 0) || (last != nil && *last == 0) {
+		return conn, nil
+	}
+	if _m, err = pager.applyCursors(_m, after, before); err != nil {
+		return nil, err
+	}
+	limit := paginateLimit(first, last)
+	if limit != 0 {
+		_m.Limit(limit)
+	}
+	if field := collectedField(ctx, edgesField, nodeField); field != nil {
+		if err := _m.collectField(ctx, limit == 1, graphql.GetOperationContext(ctx), *field, []string{edgesField, nodeField}); err != nil {
+			return nil, err
+		}
+	}
+	_m = pager.applyOrder(_m)
+	nodes, err := _m.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	conn.build(nodes, pager, after, first, before, last)
+	return conn, nil
+}
+
+var (
+	// RetentionRuleHistoryOrderFieldHistoryTime orders RetentionRuleHistory by history_time.
+	RetentionRuleHistoryOrderFieldHistoryTime = &RetentionRuleHistoryOrderField{
+		Value: func(_m *RetentionRuleHistory) (ent.Value, error) {
+			return _m.HistoryTime, nil
+		},
+		column: retentionrulehistory.FieldHistoryTime,
+		toTerm: retentionrulehistory.ByHistoryTime,
+		toCursor: func(_m *RetentionRuleHistory) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.HistoryTime,
+			}
+		},
+	}
+	// RetentionRuleHistoryOrderFieldCreatedAt orders RetentionRuleHistory by created_at.
+	RetentionRuleHistoryOrderFieldCreatedAt = &RetentionRuleHistoryOrderField{
+		Value: func(_m *RetentionRuleHistory) (ent.Value, error) {
+			return _m.CreatedAt, nil
+		},
+		column: retentionrulehistory.FieldCreatedAt,
+		toTerm: retentionrulehistory.ByCreatedAt,
+		toCursor: func(_m *RetentionRuleHistory) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.CreatedAt,
+			}
+		},
+	}
+	// RetentionRuleHistoryOrderFieldUpdatedAt orders RetentionRuleHistory by updated_at.
+	RetentionRuleHistoryOrderFieldUpdatedAt = &RetentionRuleHistoryOrderField{
+		Value: func(_m *RetentionRuleHistory) (ent.Value, error) {
+			return _m.UpdatedAt, nil
+		},
+		column: retentionrulehistory.FieldUpdatedAt,
+		toTerm: retentionrulehistory.ByUpdatedAt,
+		toCursor: func(_m *RetentionRuleHistory) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.UpdatedAt,
+			}
+		},
+	}
+	// RetentionRuleHistoryOrderFieldName orders RetentionRuleHistory by name.
+	RetentionRuleHistoryOrderFieldName = &RetentionRuleHistoryOrderField{
+		Value: func(_m *RetentionRuleHistory) (ent.Value, error) {
+			return _m.Name, nil
+		},
+		column: retentionrulehistory.FieldName,
+		toTerm: retentionrulehistory.ByName,
+		toCursor: func(_m *RetentionRuleHistory) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.Name,
+			}
+		},
+	}
+	// RetentionRuleHistoryOrderFieldRetentionDays orders RetentionRuleHistory by retention_days.
+	RetentionRuleHistoryOrderFieldRetentionDays = &RetentionRuleHistoryOrderField{
+		Value: func(_m *RetentionRuleHistory) (ent.Value, error) {
+			return _m.RetentionDays, nil
+		},
+		column: retentionrulehistory.FieldRetentionDays,
+		toTerm: retentionrulehistory.ByRetentionDays,
+		toCursor: func(_m *RetentionRuleHistory) Cursor {
+			return Cursor{
+				ID:    _m.ID,
+				Value: _m.RetentionDays,
+			}
+		},
+	}
+)
+
+// String implement fmt.Stringer interface.
+func (f RetentionRuleHistoryOrderField) String() string {
+	var str string
+	switch f.column {
+	case RetentionRuleHistoryOrderFieldHistoryTime.column:
+		str = "history_time"
+	case RetentionRuleHistoryOrderFieldCreatedAt.column:
+		str = "created_at"
+	case RetentionRuleHistoryOrderFieldUpdatedAt.column:
+		str = "updated_at"
+	case RetentionRuleHistoryOrderFieldName.column:
+		str = "name"
+	case RetentionRuleHistoryOrderFieldRetentionDays.column:
+		str = "retention_days"
+	}
+	return str
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (f RetentionRuleHistoryOrderField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(f.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (f *RetentionRuleHistoryOrderField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("RetentionRuleHistoryOrderField %T must be a string", v)
+	}
+	switch str {
+	case "history_time":
+		*f = *RetentionRuleHistoryOrderFieldHistoryTime
+	case "created_at":
+		*f = *RetentionRuleHistoryOrderFieldCreatedAt
+	case "updated_at":
+		*f = *RetentionRuleHistoryOrderFieldUpdatedAt
+	case "name":
+		*f = *RetentionRuleHistoryOrderFieldName
+	case "retention_days":
+		*f = *RetentionRuleHistoryOrderFieldRetentionDays
+	default:
+		return fmt.Errorf("%s is not a valid RetentionRuleHistoryOrderField", str)
+	}
+	return nil
+}
+
+// RetentionRuleHistoryOrderField defines the ordering field of RetentionRuleHistory.
+type RetentionRuleHistoryOrderField struct {
+	// Value extracts the ordering value from the given RetentionRuleHistory.
+	Value    func(*RetentionRuleHistory) (ent.Value, error)
+	column   string // field or computed.
+	toTerm   func(...sql.OrderTermOption) retentionrulehistory.OrderOption
+	toCursor func(*RetentionRuleHistory) Cursor
+}
+
+// RetentionRuleHistoryOrder defines the ordering of RetentionRuleHistory.
+type RetentionRuleHistoryOrder struct {
+	Direction OrderDirection                  `json:"direction"`
+	Field     *RetentionRuleHistoryOrderField `json:"field"`
+}
+
+// DefaultRetentionRuleHistoryOrder is the default ordering of RetentionRuleHistory.
+var DefaultRetentionRuleHistoryOrder = &RetentionRuleHistoryOrder{
+	Direction: entgql.OrderDirectionAsc,
+	Field: &RetentionRuleHistoryOrderField{
+		Value: func(_m *RetentionRuleHistory) (ent.Value, error) {
+			return _m.ID, nil
+		},
+		column: retentionrulehistory.FieldID,
+		toTerm: retentionrulehistory.ByID,
+		toCursor: func(_m *RetentionRuleHistory) Cursor {
+			return Cursor{ID: _m.ID}
+		},
+	},
+}
+
+// ToEdge converts RetentionRuleHistory into RetentionRuleHistoryEdge.
+func (_m *RetentionRuleHistory) ToEdge(order *RetentionRuleHistoryOrder) *RetentionRuleHistoryEdge {
+	if order == nil {
+		order = DefaultRetentionRuleHistoryOrder
+	}
+	return &RetentionRuleHistoryEdge{
+		Node:   _m,
+		Cursor: order.Field.toCursor(_m),
+	}
+}
+
 // ReviewHistoryEdge is the edge representation of ReviewHistory.
 type ReviewHistoryEdge struct {
 	Node   *ReviewHistory `json:"node"`
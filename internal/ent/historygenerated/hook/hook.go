@@ -503,6 +503,18 @@ func (f RemediationHistoryFunc) Mutate(ctx context.Context, m historygenerated.M
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *historygenerated.RemediationHistoryMutation", m)
 }
 
+// The RetentionRuleHistoryFunc type is an adapter to allow the use of ordinary
+// function as RetentionRuleHistory mutator.
+type RetentionRuleHistoryFunc func(context.Context, *historygenerated.RetentionRuleHistoryMutation) (historygenerated.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RetentionRuleHistoryFunc) Mutate(ctx context.Context, m historygenerated.Mutation) (historygenerated.Value, error) {
+	if mv, ok := m.(*historygenerated.RetentionRuleHistoryMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *historygenerated.RetentionRuleHistoryMutation", m)
+}
+
 // The ReviewHistoryFunc type is an adapter to allow the use of ordinary
 // function as ReviewHistory mutator.
 type ReviewHistoryFunc func(context.Context, *historygenerated.ReviewHistoryMutation) (historygenerated.Value, error)
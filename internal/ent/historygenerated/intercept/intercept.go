@@ -52,6 +52,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -1244,6 +1245,33 @@ func (f TraverseRemediationHistory) Traverse(ctx context.Context, q historygener
 	return fmt.Errorf("unexpected query type %T. expect *historygenerated.RemediationHistoryQuery", q)
 }
 
+// The RetentionRuleHistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
+type RetentionRuleHistoryFunc func(context.Context, *historygenerated.RetentionRuleHistoryQuery) (historygenerated.Value, error)
+
+// Query calls f(ctx, q).
+func (f RetentionRuleHistoryFunc) Query(ctx context.Context, q historygenerated.Query) (historygenerated.Value, error) {
+	if q, ok := q.(*historygenerated.RetentionRuleHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return nil, fmt.Errorf("unexpected query type %T. expect *historygenerated.RetentionRuleHistoryQuery", q)
+}
+
+// The TraverseRetentionRuleHistory type is an adapter to allow the use of ordinary function as Traverser.
+type TraverseRetentionRuleHistory func(context.Context, *historygenerated.RetentionRuleHistoryQuery) error
+
+// Intercept is a dummy implementation of Intercept that returns the next Querier in the pipeline.
+func (f TraverseRetentionRuleHistory) Intercept(next historygenerated.Querier) historygenerated.Querier {
+	return next
+}
+
+// Traverse calls f(ctx, q).
+func (f TraverseRetentionRuleHistory) Traverse(ctx context.Context, q historygenerated.Query) error {
+	if q, ok := q.(*historygenerated.RetentionRuleHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return fmt.Errorf("unexpected query type %T. expect *historygenerated.RetentionRuleHistoryQuery", q)
+}
+
 // The ReviewHistoryFunc type is an adapter to allow the use of ordinary function as a Querier.
 type ReviewHistoryFunc func(context.Context, *historygenerated.ReviewHistoryQuery) (historygenerated.Value, error)
 
@@ -2058,6 +2086,8 @@ func NewQuery(q historygenerated.Query) (Query, error) {
 		return &query[*historygenerated.ProgramMembershipHistoryQuery, predicate.ProgramMembershipHistory, programmembershiphistory.OrderOption]{typ: historygenerated.TypeProgramMembershipHistory, tq: q}, nil
 	case *historygenerated.RemediationHistoryQuery:
 		return &query[*historygenerated.RemediationHistoryQuery, predicate.RemediationHistory, remediationhistory.OrderOption]{typ: historygenerated.TypeRemediationHistory, tq: q}, nil
+	case *historygenerated.RetentionRuleHistoryQuery:
+		return &query[*historygenerated.RetentionRuleHistoryQuery, predicate.RetentionRuleHistory, retentionrulehistory.OrderOption]{typ: historygenerated.TypeRetentionRuleHistory, tq: q}, nil
 	case *historygenerated.ReviewHistoryQuery:
 		return &query[*historygenerated.ReviewHistoryQuery, predicate.ReviewHistory, reviewhistory.OrderOption]{typ: historygenerated.TypeReviewHistory, tq: q}, nil
 	case *historygenerated.RiskHistoryQuery:
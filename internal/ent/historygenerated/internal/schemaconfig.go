@@ -50,6 +50,7 @@ type SchemaConfig struct {
 	ProgramHistory                    string // ProgramHistory table.
 	ProgramMembershipHistory          string // ProgramMembershipHistory table.
 	RemediationHistory                string // RemediationHistory table.
+	RetentionRuleHistory              string // RetentionRuleHistory table.
 	ReviewHistory                     string // ReviewHistory table.
 	RiskHistory                       string // RiskHistory table.
 	SLADefinitionHistory              string // SLADefinitionHistory table.
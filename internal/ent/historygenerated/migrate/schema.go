@@ -261,7 +261,7 @@ var (
 		{Name: "workflow_eligible_marker", Type: field.TypeBool, Nullable: true, Default: true},
 		{Name: "name", Type: field.TypeString},
 		{Name: "description", Type: field.TypeString, Nullable: true},
-		{Name: "campaign_type", Type: field.TypeEnum, Enums: []string{"QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE"}, Default: "QUESTIONNAIRE"},
+		{Name: "campaign_type", Type: field.TypeEnum, Enums: []string{"QUESTIONNAIRE", "TRAINING", "POLICY_ATTESTATION", "VENDOR_ASSESSMENT", "CUSTOM", "TRUST_CENTER_UPDATE", "ACCESS_REVIEW"}, Default: "QUESTIONNAIRE"},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"DRAFT", "SCHEDULED", "ACTIVE", "COMPLETED", "CANCELED"}, Default: "DRAFT"},
 		{Name: "is_active", Type: field.TypeBool, Default: false},
 		{Name: "scheduled_at", Type: field.TypeTime, Nullable: true},
@@ -287,6 +287,7 @@ var (
 		{Name: "integration_id", Type: field.TypeString, Nullable: true},
 		{Name: "email_branding_id", Type: field.TypeString, Nullable: true},
 		{Name: "trust_center_id", Type: field.TypeString, Nullable: true},
+		{Name: "access_review", Type: field.TypeJSON, Nullable: true},
 	}
 	// CampaignHistoryTable holds the schema information for the "campaign_history" table.
 	CampaignHistoryTable = &schema.Table{
@@ -734,6 +735,7 @@ var (
 		{Name: "linked_asset_ids", Type: field.TypeJSON, Nullable: true},
 		{Name: "has_soc2", Type: field.TypeBool, Nullable: true, Default: false},
 		{Name: "soc2_period_end", Type: field.TypeTime, Nullable: true},
+		{Name: "soc2_lapsed_at", Type: field.TypeTime, Nullable: true},
 		{Name: "contract_start_date", Type: field.TypeTime, Nullable: true},
 		{Name: "contract_end_date", Type: field.TypeTime, Nullable: true},
 		{Name: "auto_renews", Type: field.TypeBool, Nullable: true, Default: false},
@@ -895,6 +897,7 @@ var (
 		{Name: "storage_region", Type: field.TypeString, Nullable: true},
 		{Name: "storage_provider", Type: field.TypeString, Nullable: true},
 		{Name: "last_accessed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "scan_status", Type: field.TypeString, Nullable: true},
 	}
 	// FileHistoryTable holds the schema information for the "file_history" table.
 	FileHistoryTable = &schema.Table{
@@ -1984,6 +1987,40 @@ var (
 			},
 		},
 	}
+	// RetentionRuleHistoryColumns holds the columns for the "retention_rule_history" table.
+	RetentionRuleHistoryColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeString},
+		{Name: "history_time", Type: field.TypeTime},
+		{Name: "ref", Type: field.TypeString, Nullable: true},
+		{Name: "operation", Type: field.TypeEnum, Enums: []string{"INSERT", "UPDATE", "DELETE"}},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by", Type: field.TypeString, Nullable: true},
+		{Name: "updated_by_impersonator", Type: field.TypeString, Nullable: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "deleted_by", Type: field.TypeString, Nullable: true},
+		{Name: "owner_id", Type: field.TypeString, Nullable: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "object_type", Type: field.TypeString, Nullable: true},
+		{Name: "retention_days", Type: field.TypeInt},
+		{Name: "purge", Type: field.TypeBool, Default: false},
+	}
+	// RetentionRuleHistoryTable holds the schema information for the "retention_rule_history" table.
+	RetentionRuleHistoryTable = &schema.Table{
+		Name:       "retention_rule_history",
+		Columns:    RetentionRuleHistoryColumns,
+		PrimaryKey: []*schema.Column{RetentionRuleHistoryColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "retentionrulehistory_history_time",
+				Unique:  false,
+				Columns: []*schema.Column{RetentionRuleHistoryColumns[1]},
+			},
+		},
+	}
 	// ReviewHistoryColumns holds the columns for the "review_history" table.
 	ReviewHistoryColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeString},
@@ -2577,6 +2614,7 @@ var (
 		{Name: "trust_center_id", Type: field.TypeString, Nullable: true},
 		{Name: "reference_link", Type: field.TypeString, Nullable: true},
 		{Name: "display_order", Type: field.TypeInt, Nullable: true, Default: 0},
+		{Name: "approved", Type: field.TypeBool, Nullable: true, Default: false},
 	}
 	// TrustCenterFaqsHistoryTable holds the schema information for the "trust_center_faqs_history" table.
 	TrustCenterFaqsHistoryTable = &schema.Table{
@@ -3215,6 +3253,7 @@ var (
 		ProgramHistoryTable,
 		ProgramMembershipHistoryTable,
 		RemediationHistoryTable,
+		RetentionRuleHistoryTable,
 		ReviewHistoryTable,
 		RiskHistoryTable,
 		SLADefinitionHistoryTable,
@@ -3369,6 +3408,9 @@ func init() {
 	RemediationHistoryTable.Annotation = &entsql.Annotation{
 		Table: "remediation_history",
 	}
+	RetentionRuleHistoryTable.Annotation = &entsql.Annotation{
+		Table: "retention_rule_history",
+	}
 	ReviewHistoryTable.Annotation = &entsql.Annotation{
 		Table: "review_history",
 	}
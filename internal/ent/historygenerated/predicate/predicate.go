@@ -131,6 +131,9 @@ type ProgramMembershipHistory func(*sql.Selector)
 // RemediationHistory is the predicate function for remediationhistory builders.
 type RemediationHistory func(*sql.Selector)
 
+// RetentionRuleHistory is the predicate function for retentionrulehistory builders.
+type RetentionRuleHistory func(*sql.Selector)
+
 // ReviewHistory is the predicate function for reviewhistory builders.
 type ReviewHistory func(*sql.Selector)
 
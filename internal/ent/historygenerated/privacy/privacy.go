@@ -1097,6 +1097,30 @@ func (f RemediationHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m
 	return Denyf("historygenerated/privacy: unexpected mutation type %T, expect *historygenerated.RemediationHistoryMutation", m)
 }
 
+// The RetentionRuleHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RetentionRuleHistoryQueryRuleFunc func(context.Context, *historygenerated.RetentionRuleHistoryQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RetentionRuleHistoryQueryRuleFunc) EvalQuery(ctx context.Context, q historygenerated.Query) error {
+	if q, ok := q.(*historygenerated.RetentionRuleHistoryQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("historygenerated/privacy: unexpected query type %T, expect *historygenerated.RetentionRuleHistoryQuery", q)
+}
+
+// The RetentionRuleHistoryMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RetentionRuleHistoryMutationRuleFunc func(context.Context, *historygenerated.RetentionRuleHistoryMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RetentionRuleHistoryMutationRuleFunc) EvalMutation(ctx context.Context, m historygenerated.Mutation) error {
+	if m, ok := m.(*historygenerated.RetentionRuleHistoryMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("historygenerated/privacy: unexpected mutation type %T, expect *historygenerated.RetentionRuleHistoryMutation", m)
+}
+
 // The ReviewHistoryQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type ReviewHistoryQueryRuleFunc func(context.Context, *historygenerated.ReviewHistoryQuery) error
@@ -1862,6 +1886,8 @@ func queryFilter(q historygenerated.Query) (Filter, error) {
 		return q.Filter(), nil
 	case *historygenerated.RemediationHistoryQuery:
 		return q.Filter(), nil
+	case *historygenerated.RetentionRuleHistoryQuery:
+		return q.Filter(), nil
 	case *historygenerated.ReviewHistoryQuery:
 		return q.Filter(), nil
 	case *historygenerated.RiskHistoryQuery:
@@ -2005,6 +2031,8 @@ func mutationFilter(m historygenerated.Mutation) (Filter, error) {
 		return m.Filter(), nil
 	case *historygenerated.RemediationHistoryMutation:
 		return m.Filter(), nil
+	case *historygenerated.RetentionRuleHistoryMutation:
+		return m.Filter(), nil
 	case *historygenerated.ReviewHistoryMutation:
 		return m.Filter(), nil
 	case *historygenerated.RiskHistoryMutation:
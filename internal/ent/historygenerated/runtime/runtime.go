@@ -50,6 +50,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/historygenerated/programhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/programmembershiphistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/remediationhistory"
+	"github.com/theopenlane/core/internal/ent/historygenerated/retentionrulehistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/reviewhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/riskhistory"
 	"github.com/theopenlane/core/internal/ent/historygenerated/scheduledjobhistory"
@@ -789,31 +790,31 @@ func init() {
 	// entityhistory.DefaultHasSoc2 holds the default value on creation for the has_soc2 field.
 	entityhistory.DefaultHasSoc2 = entityhistoryDescHasSoc2.Default.(bool)
 	// entityhistoryDescAutoRenews is the schema descriptor for auto_renews field.
-	entityhistoryDescAutoRenews := entityhistoryFields[46].Descriptor()
+	entityhistoryDescAutoRenews := entityhistoryFields[47].Descriptor()
 	// entityhistory.DefaultAutoRenews holds the default value on creation for the auto_renews field.
 	entityhistory.DefaultAutoRenews = entityhistoryDescAutoRenews.Default.(bool)
 	// entityhistoryDescSpendCurrency is the schema descriptor for spend_currency field.
-	entityhistoryDescSpendCurrency := entityhistoryFields[49].Descriptor()
+	entityhistoryDescSpendCurrency := entityhistoryFields[50].Descriptor()
 	// entityhistory.DefaultSpendCurrency holds the default value on creation for the spend_currency field.
 	entityhistory.DefaultSpendCurrency = entityhistoryDescSpendCurrency.Default.(string)
 	// entityhistoryDescSSOEnforced is the schema descriptor for sso_enforced field.
-	entityhistoryDescSSOEnforced := entityhistoryFields[52].Descriptor()
+	entityhistoryDescSSOEnforced := entityhistoryFields[53].Descriptor()
 	// entityhistory.DefaultSSOEnforced holds the default value on creation for the sso_enforced field.
 	entityhistory.DefaultSSOEnforced = entityhistoryDescSSOEnforced.Default.(bool)
 	// entityhistoryDescMfaSupported is the schema descriptor for mfa_supported field.
-	entityhistoryDescMfaSupported := entityhistoryFields[53].Descriptor()
+	entityhistoryDescMfaSupported := entityhistoryFields[54].Descriptor()
 	// entityhistory.DefaultMfaSupported holds the default value on creation for the mfa_supported field.
 	entityhistory.DefaultMfaSupported = entityhistoryDescMfaSupported.Default.(bool)
 	// entityhistoryDescMfaEnforced is the schema descriptor for mfa_enforced field.
-	entityhistoryDescMfaEnforced := entityhistoryFields[54].Descriptor()
+	entityhistoryDescMfaEnforced := entityhistoryFields[55].Descriptor()
 	// entityhistory.DefaultMfaEnforced holds the default value on creation for the mfa_enforced field.
 	entityhistory.DefaultMfaEnforced = entityhistoryDescMfaEnforced.Default.(bool)
 	// entityhistoryDescProvidedServices is the schema descriptor for provided_services field.
-	entityhistoryDescProvidedServices := entityhistoryFields[56].Descriptor()
+	entityhistoryDescProvidedServices := entityhistoryFields[57].Descriptor()
 	// entityhistory.DefaultProvidedServices holds the default value on creation for the provided_services field.
 	entityhistory.DefaultProvidedServices = entityhistoryDescProvidedServices.Default.([]string)
 	// entityhistoryDescLinks is the schema descriptor for links field.
-	entityhistoryDescLinks := entityhistoryFields[57].Descriptor()
+	entityhistoryDescLinks := entityhistoryFields[58].Descriptor()
 	// entityhistory.DefaultLinks holds the default value on creation for the links field.
 	entityhistory.DefaultLinks = entityhistoryDescLinks.Default.([]string)
 	// entityhistoryDescID is the schema descriptor for id field.
@@ -2006,6 +2007,41 @@ func init() {
 	remediationhistoryDescID := remediationhistoryFields[10].Descriptor()
 	// remediationhistory.DefaultID holds the default value on creation for the id field.
 	remediationhistory.DefaultID = remediationhistoryDescID.Default.(func() string)
+	retentionrulehistory.Policy = privacy.NewPolicies(historyschema.RetentionRuleHistory{})
+	retentionrulehistory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := retentionrulehistory.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	retentionrulehistoryInters := historyschema.RetentionRuleHistory{}.Interceptors()
+	retentionrulehistory.Interceptors[0] = retentionrulehistoryInters[0]
+	retentionrulehistoryFields := historyschema.RetentionRuleHistory{}.Fields()
+	_ = retentionrulehistoryFields
+	// retentionrulehistoryDescHistoryTime is the schema descriptor for history_time field.
+	retentionrulehistoryDescHistoryTime := retentionrulehistoryFields[0].Descriptor()
+	// retentionrulehistory.DefaultHistoryTime holds the default value on creation for the history_time field.
+	retentionrulehistory.DefaultHistoryTime = retentionrulehistoryDescHistoryTime.Default.(func() time.Time)
+	// retentionrulehistoryDescCreatedAt is the schema descriptor for created_at field.
+	retentionrulehistoryDescCreatedAt := retentionrulehistoryFields[3].Descriptor()
+	// retentionrulehistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	retentionrulehistory.DefaultCreatedAt = retentionrulehistoryDescCreatedAt.Default.(func() time.Time)
+	// retentionrulehistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	retentionrulehistoryDescUpdatedAt := retentionrulehistoryFields[4].Descriptor()
+	// retentionrulehistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	retentionrulehistory.DefaultUpdatedAt = retentionrulehistoryDescUpdatedAt.Default.(func() time.Time)
+	// retentionrulehistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	retentionrulehistory.UpdateDefaultUpdatedAt = retentionrulehistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// retentionrulehistoryDescPurge is the schema descriptor for purge field.
+	retentionrulehistoryDescPurge := retentionrulehistoryFields[17].Descriptor()
+	// retentionrulehistory.DefaultPurge holds the default value on creation for the purge field.
+	retentionrulehistory.DefaultPurge = retentionrulehistoryDescPurge.Default.(bool)
+	// retentionrulehistoryDescID is the schema descriptor for id field.
+	retentionrulehistoryDescID := retentionrulehistoryFields[10].Descriptor()
+	// retentionrulehistory.DefaultID holds the default value on creation for the id field.
+	retentionrulehistory.DefaultID = retentionrulehistoryDescID.Default.(func() string)
 	reviewhistory.Policy = privacy.NewPolicies(historyschema.ReviewHistory{})
 	reviewhistory.Hooks[0] = func(next ent.Mutator) ent.Mutator {
 		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
@@ -2556,6 +2592,10 @@ func init() {
 	trustcenterfaqhistoryDescDisplayOrder := trustcenterfaqhistoryFields[16].Descriptor()
 	// trustcenterfaqhistory.DefaultDisplayOrder holds the default value on creation for the display_order field.
 	trustcenterfaqhistory.DefaultDisplayOrder = trustcenterfaqhistoryDescDisplayOrder.Default.(int)
+	// trustcenterfaqhistoryDescApproved is the schema descriptor for approved field.
+	trustcenterfaqhistoryDescApproved := trustcenterfaqhistoryFields[17].Descriptor()
+	// trustcenterfaqhistory.DefaultApproved holds the default value on creation for the approved field.
+	trustcenterfaqhistory.DefaultApproved = trustcenterfaqhistoryDescApproved.Default.(bool)
 	// trustcenterfaqhistoryDescID is the schema descriptor for id field.
 	trustcenterfaqhistoryDescID := trustcenterfaqhistoryFields[10].Descriptor()
 	// trustcenterfaqhistory.DefaultID holds the default value on creation for the id field.
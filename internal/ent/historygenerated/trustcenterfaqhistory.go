@@ -52,6 +52,8 @@ type TrustCenterFAQHistory struct {
 	ReferenceLink string `json:"reference_link,omitempty"`
 	// display order of the FAQ
 	DisplayOrder int `json:"display_order,omitempty"`
+	// whether the FAQ answer is approved for reuse, e.g. when answering security questionnaires
+	Approved     bool `json:"approved,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -62,6 +64,8 @@ func (*TrustCenterFAQHistory) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case trustcenterfaqhistory.FieldOperation:
 			values[i] = new(history.OpType)
+		case trustcenterfaqhistory.FieldApproved:
+			values[i] = new(sql.NullBool)
 		case trustcenterfaqhistory.FieldDisplayOrder:
 			values[i] = new(sql.NullInt64)
 		case trustcenterfaqhistory.FieldID, trustcenterfaqhistory.FieldRef, trustcenterfaqhistory.FieldCreatedBy, trustcenterfaqhistory.FieldUpdatedBy, trustcenterfaqhistory.FieldUpdatedByImpersonator, trustcenterfaqhistory.FieldDeletedBy, trustcenterfaqhistory.FieldTrustCenterFaqKindName, trustcenterfaqhistory.FieldTrustCenterFaqKindID, trustcenterfaqhistory.FieldNoteID, trustcenterfaqhistory.FieldTrustCenterID, trustcenterfaqhistory.FieldReferenceLink:
@@ -186,6 +190,12 @@ func (_m *TrustCenterFAQHistory) assignValues(columns []string, values []any) er
 			} else if value.Valid {
 				_m.DisplayOrder = int(value.Int64)
 			}
+		case trustcenterfaqhistory.FieldApproved:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field approved", values[i])
+			} else if value.Valid {
+				_m.Approved = value.Bool
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -271,6 +281,9 @@ func (_m *TrustCenterFAQHistory) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("display_order=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DisplayOrder))
+	builder.WriteString(", ")
+	builder.WriteString("approved=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Approved))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -51,6 +51,8 @@ const (
 	FieldReferenceLink = "reference_link"
 	// FieldDisplayOrder holds the string denoting the display_order field in the database.
 	FieldDisplayOrder = "display_order"
+	// FieldApproved holds the string denoting the approved field in the database.
+	FieldApproved = "approved"
 	// Table holds the table name of the trustcenterfaqhistory in the database.
 	Table = "trust_center_faqs_history"
 )
@@ -74,6 +76,7 @@ var Columns = []string{
 	FieldTrustCenterID,
 	FieldReferenceLink,
 	FieldDisplayOrder,
+	FieldApproved,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -105,6 +108,8 @@ var (
 	UpdateDefaultUpdatedAt func() time.Time
 	// DefaultDisplayOrder holds the default value on creation for the "display_order" field.
 	DefaultDisplayOrder int
+	// DefaultApproved holds the default value on creation for the "approved" field.
+	DefaultApproved bool
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() string
 )
@@ -207,6 +212,11 @@ func ByDisplayOrder(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDisplayOrder, opts...).ToFunc()
 }
 
+// ByApproved orders the results by the approved field.
+func ByApproved(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldApproved, opts...).ToFunc()
+}
+
 var (
 	// history.OpType must implement graphql.Marshaler.
 	_ graphql.Marshaler = (*history.OpType)(nil)
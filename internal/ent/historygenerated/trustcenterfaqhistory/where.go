@@ -142,6 +142,11 @@ func DisplayOrder(v int) predicate.TrustCenterFAQHistory {
 	return predicate.TrustCenterFAQHistory(sql.FieldEQ(FieldDisplayOrder, v))
 }
 
+// Approved applies equality check predicate on the "approved" field. It's identical to ApprovedEQ.
+func Approved(v bool) predicate.TrustCenterFAQHistory {
+	return predicate.TrustCenterFAQHistory(sql.FieldEQ(FieldApproved, v))
+}
+
 // HistoryTimeEQ applies the EQ predicate on the "history_time" field.
 func HistoryTimeEQ(v time.Time) predicate.TrustCenterFAQHistory {
 	return predicate.TrustCenterFAQHistory(sql.FieldEQ(FieldHistoryTime, v))
@@ -1142,6 +1147,26 @@ func DisplayOrderNotNil() predicate.TrustCenterFAQHistory {
 	return predicate.TrustCenterFAQHistory(sql.FieldNotNull(FieldDisplayOrder))
 }
 
+// ApprovedEQ applies the EQ predicate on the "approved" field.
+func ApprovedEQ(v bool) predicate.TrustCenterFAQHistory {
+	return predicate.TrustCenterFAQHistory(sql.FieldEQ(FieldApproved, v))
+}
+
+// ApprovedNEQ applies the NEQ predicate on the "approved" field.
+func ApprovedNEQ(v bool) predicate.TrustCenterFAQHistory {
+	return predicate.TrustCenterFAQHistory(sql.FieldNEQ(FieldApproved, v))
+}
+
+// ApprovedIsNil applies the IsNil predicate on the "approved" field.
+func ApprovedIsNil() predicate.TrustCenterFAQHistory {
+	return predicate.TrustCenterFAQHistory(sql.FieldIsNull(FieldApproved))
+}
+
+// ApprovedNotNil applies the NotNil predicate on the "approved" field.
+func ApprovedNotNil() predicate.TrustCenterFAQHistory {
+	return predicate.TrustCenterFAQHistory(sql.FieldNotNull(FieldApproved))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.TrustCenterFAQHistory) predicate.TrustCenterFAQHistory {
 	return predicate.TrustCenterFAQHistory(sql.AndPredicates(predicates...))
@@ -231,6 +231,20 @@ func (_c *TrustCenterFAQHistoryCreate) SetNillableDisplayOrder(v *int) *TrustCen
 	return _c
 }
 
+// SetApproved sets the "approved" field.
+func (_c *TrustCenterFAQHistoryCreate) SetApproved(v bool) *TrustCenterFAQHistoryCreate {
+	_c.mutation.SetApproved(v)
+	return _c
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (_c *TrustCenterFAQHistoryCreate) SetNillableApproved(v *bool) *TrustCenterFAQHistoryCreate {
+	if v != nil {
+		_c.SetApproved(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *TrustCenterFAQHistoryCreate) SetID(v string) *TrustCenterFAQHistoryCreate {
 	_c.mutation.SetID(v)
@@ -307,6 +321,10 @@ func (_c *TrustCenterFAQHistoryCreate) defaults() error {
 		v := trustcenterfaqhistory.DefaultDisplayOrder
 		_c.mutation.SetDisplayOrder(v)
 	}
+	if _, ok := _c.mutation.Approved(); !ok {
+		v := trustcenterfaqhistory.DefaultApproved
+		_c.mutation.SetApproved(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		if trustcenterfaqhistory.DefaultID == nil {
 			return fmt.Errorf("historygenerated: uninitialized trustcenterfaqhistory.DefaultID (forgotten import historygenerated/runtime?)")
@@ -433,6 +451,10 @@ func (_c *TrustCenterFAQHistoryCreate) createSpec() (*TrustCenterFAQHistory, *sq
 		_spec.SetField(trustcenterfaqhistory.FieldDisplayOrder, field.TypeInt, value)
 		_node.DisplayOrder = value
 	}
+	if value, ok := _c.mutation.Approved(); ok {
+		_spec.SetField(trustcenterfaqhistory.FieldApproved, field.TypeBool, value)
+		_node.Approved = value
+	}
 	return _node, _spec
 }
 
@@ -212,6 +212,26 @@ func (_u *TrustCenterFAQHistoryUpdate) ClearDisplayOrder() *TrustCenterFAQHistor
 	return _u
 }
 
+// SetApproved sets the "approved" field.
+func (_u *TrustCenterFAQHistoryUpdate) SetApproved(v bool) *TrustCenterFAQHistoryUpdate {
+	_u.mutation.SetApproved(v)
+	return _u
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (_u *TrustCenterFAQHistoryUpdate) SetNillableApproved(v *bool) *TrustCenterFAQHistoryUpdate {
+	if v != nil {
+		_u.SetApproved(*v)
+	}
+	return _u
+}
+
+// ClearApproved clears the value of the "approved" field.
+func (_u *TrustCenterFAQHistoryUpdate) ClearApproved() *TrustCenterFAQHistoryUpdate {
+	_u.mutation.ClearApproved()
+	return _u
+}
+
 // Mutation returns the TrustCenterFAQHistoryMutation object of the builder.
 func (_u *TrustCenterFAQHistoryUpdate) Mutation() *TrustCenterFAQHistoryMutation {
 	return _u.mutation
@@ -343,6 +363,12 @@ func (_u *TrustCenterFAQHistoryUpdate) sqlSave(ctx context.Context) (_node int,
 	if _u.mutation.DisplayOrderCleared() {
 		_spec.ClearField(trustcenterfaqhistory.FieldDisplayOrder, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Approved(); ok {
+		_spec.SetField(trustcenterfaqhistory.FieldApproved, field.TypeBool, value)
+	}
+	if _u.mutation.ApprovedCleared() {
+		_spec.ClearField(trustcenterfaqhistory.FieldApproved, field.TypeBool)
+	}
 	_spec.Node.Schema = _u.schemaConfig.TrustCenterFAQHistory
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -546,6 +572,26 @@ func (_u *TrustCenterFAQHistoryUpdateOne) ClearDisplayOrder() *TrustCenterFAQHis
 	return _u
 }
 
+// SetApproved sets the "approved" field.
+func (_u *TrustCenterFAQHistoryUpdateOne) SetApproved(v bool) *TrustCenterFAQHistoryUpdateOne {
+	_u.mutation.SetApproved(v)
+	return _u
+}
+
+// SetNillableApproved sets the "approved" field if the given value is not nil.
+func (_u *TrustCenterFAQHistoryUpdateOne) SetNillableApproved(v *bool) *TrustCenterFAQHistoryUpdateOne {
+	if v != nil {
+		_u.SetApproved(*v)
+	}
+	return _u
+}
+
+// ClearApproved clears the value of the "approved" field.
+func (_u *TrustCenterFAQHistoryUpdateOne) ClearApproved() *TrustCenterFAQHistoryUpdateOne {
+	_u.mutation.ClearApproved()
+	return _u
+}
+
 // Mutation returns the TrustCenterFAQHistoryMutation object of the builder.
 func (_u *TrustCenterFAQHistoryUpdateOne) Mutation() *TrustCenterFAQHistoryMutation {
 	return _u.mutation
@@ -707,6 +753,12 @@ func (_u *TrustCenterFAQHistoryUpdateOne) sqlSave(ctx context.Context) (_node *T
 	if _u.mutation.DisplayOrderCleared() {
 		_spec.ClearField(trustcenterfaqhistory.FieldDisplayOrder, field.TypeInt)
 	}
+	if value, ok := _u.mutation.Approved(); ok {
+		_spec.SetField(trustcenterfaqhistory.FieldApproved, field.TypeBool, value)
+	}
+	if _u.mutation.ApprovedCleared() {
+		_spec.ClearField(trustcenterfaqhistory.FieldApproved, field.TypeBool)
+	}
 	_spec.Node.Schema = _u.schemaConfig.TrustCenterFAQHistory
 	ctx = internal.NewSchemaConfigContext(ctx, _u.schemaConfig)
 	_spec.AddModifiers(_u.modifiers...)
@@ -96,6 +96,8 @@ type Tx struct {
 	ProgramMembershipHistory *ProgramMembershipHistoryClient
 	// RemediationHistory is the client for interacting with the RemediationHistory builders.
 	RemediationHistory *RemediationHistoryClient
+	// RetentionRuleHistory is the client for interacting with the RetentionRuleHistory builders.
+	RetentionRuleHistory *RetentionRuleHistoryClient
 	// ReviewHistory is the client for interacting with the ReviewHistory builders.
 	ReviewHistory *ReviewHistoryClient
 	// RiskHistory is the client for interacting with the RiskHistory builders.
@@ -322,6 +324,7 @@ func (tx *Tx) init() {
 	tx.ProgramHistory = NewProgramHistoryClient(tx.config)
 	tx.ProgramMembershipHistory = NewProgramMembershipHistoryClient(tx.config)
 	tx.RemediationHistory = NewRemediationHistoryClient(tx.config)
+	tx.RetentionRuleHistory = NewRetentionRuleHistoryClient(tx.config)
 	tx.ReviewHistory = NewReviewHistoryClient(tx.config)
 	tx.RiskHistory = NewRiskHistoryClient(tx.config)
 	tx.SLADefinitionHistory = NewSLADefinitionHistoryClient(tx.config)
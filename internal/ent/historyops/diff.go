@@ -0,0 +1,127 @@
+package historyops
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"time"
+)
+
+// auditColumns change on every update and are left out of diffs and restores
+var auditColumns = map[string]bool{
+	"updated_at":              true,
+	"updated_by":              true,
+	"updated_by_impersonator": true,
+}
+
+// FieldDiff is a field whose value differs between two snapshots
+type FieldDiff struct {
+	// Field is the column name of the field
+	Field string
+	// OldValue is the value in the older snapshot
+	OldValue any
+	// NewValue is the value in the newer snapshot
+	NewValue any
+}
+
+// Diff returns the fields that differ between two snapshots of the same object, ordered by field name
+func Diff(from, to *Snapshot) ([]FieldDiff, error) {
+	if from.ObjectType != to.ObjectType || from.ID != to.ID {
+		return nil, ErrEntryMismatch
+	}
+
+	return diffFields(from.Fields, to.Fields), nil
+}
+
+// diffFields compares two sets of field values, treating missing and empty values as equal
+func diffFields(from, to map[string]any) []FieldDiff {
+	fields := map[string]bool{}
+
+	for field := range from {
+		fields[field] = true
+	}
+
+	for field := range to {
+		fields[field] = true
+	}
+
+	names := make([]string, 0, len(fields))
+
+	for field := range fields {
+		if !auditColumns[field] {
+			names = append(names, field)
+		}
+	}
+
+	sort.Strings(names)
+
+	diffs := []FieldDiff{}
+
+	for _, field := range names {
+		if equalValues(from[field], to[field]) {
+			continue
+		}
+
+		diffs = append(diffs, FieldDiff{Field: field, OldValue: from[field], NewValue: to[field]})
+	}
+
+	return diffs
+}
+
+// equalValues compares two json decoded values; empty values are equal to missing ones, which ent omits when
+// serializing objects, and timestamps are compared as instants since Postgres and Go format them differently
+func equalValues(a, b any) bool {
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+
+	as, aok := a.(string)
+	bs, bok := b.(string)
+
+	if aok && bok {
+		if at, err := time.Parse(time.RFC3339Nano, as); err == nil {
+			if bt, err := time.Parse(time.RFC3339Nano, bs); err == nil {
+				return at.Equal(bt)
+			}
+		}
+
+		return as == bs
+	}
+
+	return reflect.DeepEqual(normalize(a), normalize(b))
+}
+
+// isEmpty reports whether a json decoded value is the zero value of its type
+func isEmpty(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// normalize round trips a value through json so values decoded from different sources compare equal
+func normalize(v any) any {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return v
+	}
+
+	var out any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return v
+	}
+
+	return out
+}
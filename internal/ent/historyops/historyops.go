@@ -0,0 +1,386 @@
+// Package historyops rebuilds objects from their history tables. Every history table records the full row of
+// its object on each change, so the state of an object at any point in time is the latest history entry written
+// at or before that time; edges stored as foreign keys are rebuilt the same way from the history of the objects
+// holding the key
+package historyops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+
+	"github.com/theopenlane/core/internal/ent/entityops"
+	"github.com/theopenlane/core/internal/ent/historygenerated"
+	"github.com/theopenlane/core/internal/ent/historygenerated/migrate"
+)
+
+const (
+	// historySuffix is appended to the snake_case schema name to form the name of its history table
+	historySuffix = "_history"
+	// opDelete is the history operation recorded when an object is deleted
+	opDelete = "DELETE"
+	// softDeleteColumn is set on objects removed by the soft-delete mixin
+	softDeleteColumn = "deleted_at"
+)
+
+var (
+	// ErrUnknownType is returned when the object type has no history table
+	ErrUnknownType = errors.New("object type does not keep history")
+	// ErrNoHistory is returned when no history was recorded for the object at or before the requested time
+	ErrNoHistory = errors.New("no history recorded for the object at the requested time")
+	// ErrObjectDeleted is returned when the object was deleted at the requested time
+	ErrObjectDeleted = errors.New("object was deleted at the requested time")
+	// ErrEntryMismatch is returned when history entries of different objects are compared
+	ErrEntryMismatch = errors.New("history entries belong to different objects")
+)
+
+// bookkeepingColumns are the history columns describing the history entry rather than the object
+var bookkeepingColumns = map[string]bool{
+	"id":           true,
+	"history_time": true,
+	"ref":          true,
+	"operation":    true,
+}
+
+// Snapshot is an object as it was recorded in its history table
+type Snapshot struct {
+	// ObjectType is the schema name of the object, e.g. Control
+	ObjectType string
+	// ID is the id of the object
+	ID string
+	// HistoryID is the id of the history entry the snapshot was built from
+	HistoryID string
+	// HistoryTime is the time the history entry was recorded
+	HistoryTime time.Time
+	// Operation is the change recorded by the history entry: INSERT, UPDATE or DELETE
+	Operation string
+	// Fields are the values of the object keyed by column name
+	Fields map[string]any
+	// Edges are the objects related to the object at the time of the snapshot
+	Edges []Edge
+}
+
+// Edge lists the objects related to a snapshot through an edge
+type Edge struct {
+	// Name is the name of the edge on the object
+	Name string
+	// ObjectType is the schema name of the related objects
+	ObjectType string
+	// IDs are the ids of the related objects
+	IDs []string
+}
+
+// historyTable is a schema that keeps history along with the columns of its history table
+type historyTable struct {
+	schema  *entityops.Schema
+	name    string
+	columns map[string]bool
+}
+
+var (
+	historyTablesOnce sync.Once
+	historyTables     map[string]historyTable
+)
+
+// tables returns the history tables keyed by the name of the schema they record
+func tables() map[string]historyTable {
+	historyTablesOnce.Do(func() {
+		byName := make(map[string]*schema.Table, len(migrate.Tables))
+		for _, t := range migrate.Tables {
+			byName[t.Name] = t
+		}
+
+		historyTables = map[string]historyTable{}
+
+		for _, s := range entityops.AllSchemas() {
+			t, ok := byName[s.Snake+historySuffix]
+			if !ok {
+				continue
+			}
+
+			columns := make(map[string]bool, len(t.Columns))
+			for _, c := range t.Columns {
+				columns[c.Name] = true
+			}
+
+			historyTables[s.Name] = historyTable{schema: s, name: t.Name, columns: columns}
+		}
+	})
+
+	return historyTables
+}
+
+// lookup resolves the history table of an object type given by any name variation of its schema
+func lookup(objectType string) (historyTable, error) {
+	s, ok := entityops.LookupSchema(objectType)
+	if !ok {
+		return historyTable{}, fmt.Errorf("%w: %s", ErrUnknownType, objectType)
+	}
+
+	t, ok := tables()[s.Name]
+	if !ok {
+		return historyTable{}, fmt.Errorf("%w: %s", ErrUnknownType, objectType)
+	}
+
+	return t, nil
+}
+
+// Supported reports whether the object type keeps history and can be rebuilt at a point in time
+func Supported(objectType string) bool {
+	_, err := lookup(objectType)
+
+	return err == nil
+}
+
+// ObjectType returns the schema name of the object type, e.g. Control for control
+func ObjectType(objectType string) (string, error) {
+	t, err := lookup(objectType)
+	if err != nil {
+		return "", err
+	}
+
+	return t.schema.Name, nil
+}
+
+// AsOf rebuilds the object and its edges as they were at the given time
+func AsOf(ctx context.Context, client *historygenerated.Client, objectType, id string, at time.Time) (*Snapshot, error) {
+	t, err := lookup(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	row, found, err := queryRow(ctx, client, fmt.Sprintf(
+		`SELECT row_to_json(h) FROM %s h WHERE ref = $1 AND history_time <= $2 ORDER BY history_time DESC, id DESC LIMIT 1`,
+		quote(t.name)), id, at)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrNoHistory
+	}
+
+	snapshot, err := t.snapshot(row)
+	if err != nil {
+		return nil, err
+	}
+
+	if snapshot.deleted() {
+		return nil, ErrObjectDeleted
+	}
+
+	if snapshot.Edges, err = edgesAsOf(ctx, client, t, snapshot, at); err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// Entry returns the object as recorded by a single history entry; edges are not rebuilt
+func Entry(ctx context.Context, client *historygenerated.Client, objectType, historyID string) (*Snapshot, error) {
+	t, err := lookup(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	row, found, err := queryRow(ctx, client, fmt.Sprintf(`SELECT row_to_json(h) FROM %s h WHERE id = $1`, quote(t.name)), historyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrNoHistory
+	}
+
+	return t.snapshot(row)
+}
+
+// snapshot decodes a history row into a snapshot of the object
+func (t historyTable) snapshot(row []byte) (*Snapshot, error) {
+	fields := map[string]any{}
+	if err := json.Unmarshal(row, &fields); err != nil {
+		return nil, fmt.Errorf("decoding %s entry: %w", t.name, err)
+	}
+
+	s := &Snapshot{
+		ObjectType: t.schema.Name,
+		ID:         stringValue(fields["ref"]),
+		HistoryID:  stringValue(fields["id"]),
+		Operation:  stringValue(fields["operation"]),
+		Fields:     make(map[string]any, len(fields)),
+	}
+
+	if recorded := stringValue(fields["history_time"]); recorded != "" {
+		at, err := time.Parse(time.RFC3339Nano, recorded)
+		if err != nil {
+			return nil, fmt.Errorf("decoding %s entry time: %w", t.name, err)
+		}
+
+		s.HistoryTime = at
+	}
+
+	for column, value := range fields {
+		if !bookkeepingColumns[column] {
+			s.Fields[column] = value
+		}
+	}
+
+	return s, nil
+}
+
+// deleted reports whether the snapshot records a deleted object
+func (s *Snapshot) deleted() bool {
+	return s.Operation == opDelete || s.Fields[softDeleteColumn] != nil
+}
+
+// edgesAsOf rebuilds the edges stored as foreign keys: keys held by the object are read from the snapshot, keys
+// held by related objects are read from their history at the same time. Edges stored in join tables are not
+// recorded in history and cannot be rebuilt
+func edgesAsOf(ctx context.Context, client *historygenerated.Client, t historyTable, snapshot *Snapshot, at time.Time) ([]Edge, error) {
+	edges := []Edge{}
+
+	for _, e := range t.schema.Edges {
+		if !e.Unique || e.Field == "" || e.Target == nil {
+			continue
+		}
+
+		if id := stringValue(snapshot.Fields[e.Field]); id != "" {
+			edges = append(edges, Edge{Name: e.Name, ObjectType: e.Target.Name, IDs: []string{id}})
+		}
+	}
+
+	for _, related := range sortedTables() {
+		for _, e := range related.schema.Edges {
+			if !e.Unique || e.Field == "" || e.Target != t.schema || !related.columns[e.Field] {
+				continue
+			}
+
+			ids, err := referencingIDs(ctx, client, related, e.Field, snapshot.ID, at)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(ids) == 0 {
+				continue
+			}
+
+			edges = append(edges, Edge{Name: inverseEdgeName(t.schema, related.schema, e.Field), ObjectType: related.schema.Name, IDs: ids})
+		}
+	}
+
+	return edges, nil
+}
+
+// referencingIDs returns the objects that referenced the id through the column at the given time
+func referencingIDs(ctx context.Context, client *historygenerated.Client, t historyTable, column, id string, at time.Time) ([]string, error) {
+	live := fmt.Sprintf("operation <> '%s'", opDelete)
+	if t.columns[softDeleteColumn] {
+		live += " AND " + quote(softDeleteColumn) + " IS NULL"
+	}
+
+	// the latest entry of every object that ever referenced the id, keeping the ones still referencing it
+	query := fmt.Sprintf(`SELECT ref FROM (
+		SELECT DISTINCT ON (ref) * FROM %[1]s
+		WHERE history_time <= $2 AND ref IN (SELECT ref FROM %[1]s WHERE %[2]s = $1)
+		ORDER BY ref, history_time DESC, id DESC
+	) latest WHERE %[2]s = $1 AND %[3]s ORDER BY ref`, quote(t.name), quote(column), live)
+
+	var rows entsql.Rows
+	if err := client.Driver().Query(ctx, query, []any{id, at}, &rows); err != nil {
+		return nil, fmt.Errorf("querying %s: %w", t.name, err)
+	}
+
+	defer rows.Close()
+
+	var ids []string
+
+	for rows.Next() {
+		var ref string
+		if err := rows.Scan(&ref); err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, ref)
+	}
+
+	return ids, rows.Err()
+}
+
+// inverseEdgeName names an edge held by the related schema from the side of the object; the name of the
+// object's edge is used when it is the only to-many edge to the related schema, otherwise the related
+// schema and its key column identify the edge
+func inverseEdgeName(object, related *entityops.Schema, column string) string {
+	var names []string
+
+	for _, e := range object.Edges {
+		if !e.Unique && !e.Through && e.Target == related {
+			names = append(names, e.Name)
+		}
+	}
+
+	if len(names) == 1 {
+		return names[0]
+	}
+
+	return related.Snake + "." + column
+}
+
+// sortedTables returns the history tables ordered by schema name so rebuilt edges have a stable order
+func sortedTables() []historyTable {
+	all := tables()
+
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	out := make([]historyTable, 0, len(names))
+	for _, name := range names {
+		out = append(out, all[name])
+	}
+
+	return out
+}
+
+// queryRow runs a query returning a single json row
+func queryRow(ctx context.Context, client *historygenerated.Client, query string, args ...any) ([]byte, bool, error) {
+	var rows entsql.Rows
+	if err := client.Driver().Query(ctx, query, args, &rows); err != nil {
+		return nil, false, err
+	}
+
+	defer rows.Close()
+
+	if !rows.Next() {
+		return nil, false, rows.Err()
+	}
+
+	var row []byte
+	if err := rows.Scan(&row); err != nil {
+		return nil, false, err
+	}
+
+	return row, true, rows.Err()
+}
+
+// stringValue returns the value when it is a string
+func stringValue(v any) string {
+	s, _ := v.(string)
+
+	return s
+}
+
+// quote quotes an identifier for use in a query
+func quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
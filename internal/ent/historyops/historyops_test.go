@@ -0,0 +1,157 @@
+package historyops
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/internal/ent/entityops"
+)
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"Control", "control", "internal_policy", "InternalPolicy"} {
+		table, err := lookup(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, table.schema.Snake+historySuffix, table.name)
+		assert.True(t, table.columns["ref"])
+		assert.True(t, table.columns["history_time"])
+	}
+
+	assert.True(t, Supported("Control"))
+	assert.False(t, Supported("NotAType"))
+
+	_, err := lookup("NotAType")
+	assert.ErrorIs(t, err, ErrUnknownType)
+}
+
+func TestSnapshot(t *testing.T) {
+	table, err := lookup("Control")
+	require.NoError(t, err)
+
+	snapshot, err := table.snapshot([]byte(`{"id":"h1","ref":"c1","operation":"UPDATE","history_time":"2025-03-01T10:00:00.5+00:00","title":"Access control","deleted_at":null}`))
+	require.NoError(t, err)
+
+	assert.Equal(t, "Control", snapshot.ObjectType)
+	assert.Equal(t, "c1", snapshot.ID)
+	assert.Equal(t, "h1", snapshot.HistoryID)
+	assert.Equal(t, "UPDATE", snapshot.Operation)
+	assert.True(t, snapshot.HistoryTime.Equal(time.Date(2025, 3, 1, 10, 0, 0, 500000000, time.UTC)))
+	assert.Equal(t, map[string]any{"title": "Access control", "deleted_at": nil}, snapshot.Fields)
+	assert.False(t, snapshot.deleted())
+
+	snapshot.Fields["deleted_at"] = "2025-03-02T10:00:00Z"
+	assert.True(t, snapshot.deleted())
+
+	snapshot.Fields["deleted_at"] = nil
+	snapshot.Operation = opDelete
+	assert.True(t, snapshot.deleted())
+}
+
+func TestDiff(t *testing.T) {
+	from := &Snapshot{ObjectType: "Control", ID: "c1", Fields: map[string]any{
+		"title":       "Access control",
+		"description": "",
+		"aliases":     []any{"AC-1"},
+		"review_due":  "2025-03-01T10:00:00+00:00",
+		"updated_at":  "2025-03-01T10:00:00+00:00",
+		"status":      "DRAFT",
+	}}
+
+	to := &Snapshot{ObjectType: "Control", ID: "c1", Fields: map[string]any{
+		"title":       "Access control",
+		"description": nil,
+		"aliases":     []any{"AC-1", "AC-2"},
+		"review_due":  "2025-03-01T10:00:00Z",
+		"updated_at":  "2025-04-01T10:00:00+00:00",
+		"status":      "APPROVED",
+	}}
+
+	diffs, err := Diff(from, to)
+	require.NoError(t, err)
+
+	assert.Equal(t, []FieldDiff{
+		{Field: "aliases", OldValue: []any{"AC-1"}, NewValue: []any{"AC-1", "AC-2"}},
+		{Field: "status", OldValue: "DRAFT", NewValue: "APPROVED"},
+	}, diffs)
+
+	_, err = Diff(from, &Snapshot{ObjectType: "Control", ID: "c2"})
+	assert.ErrorIs(t, err, ErrEntryMismatch)
+}
+
+func TestEqualValues(t *testing.T) {
+	tests := []struct {
+		name  string
+		a, b  any
+		equal bool
+	}{
+		{name: "missing and empty string", a: nil, b: "", equal: true},
+		{name: "missing and false", a: nil, b: false, equal: true},
+		{name: "missing and empty list", a: nil, b: []any{}, equal: true},
+		{name: "same instant in different zones", a: "2025-03-01T10:00:00+00:00", b: "2025-03-01T12:00:00+02:00", equal: true},
+		{name: "different strings", a: "a", b: "b", equal: false},
+		{name: "nested json", a: map[string]any{"k": []any{1.0}}, b: map[string]any{"k": []any{1.0}}, equal: true},
+		{name: "true and missing", a: true, b: nil, equal: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.equal, equalValues(tc.a, tc.b))
+		})
+	}
+}
+
+func TestRestoreInput(t *testing.T) {
+	s := &entityops.Schema{
+		Fields: []entityops.FieldDescriptor{
+			{Name: "id"},
+			{Name: "owner_id", Clearable: true},
+			{Name: "title"},
+			{Name: "description", Clearable: true},
+			{Name: "status", Clearable: true},
+			{Name: "ref_code"},
+			{Name: "updated_at", Clearable: true},
+			{Name: "category", Clearable: true},
+		},
+	}
+
+	current := map[string]any{
+		"id":          "c1",
+		"owner_id":    "org-new",
+		"title":       "Renamed",
+		"description": "added later",
+		"status":      "APPROVED",
+		"ref_code":    "AC-1",
+		"updated_at":  "2025-04-01T10:00:00Z",
+	}
+
+	recorded := map[string]any{
+		"id":          "c1",
+		"owner_id":    "org-old",
+		"title":       "Access control",
+		"description": nil,
+		"status":      "APPROVED",
+		"ref_code":    nil,
+		"updated_at":  "2025-03-01T10:00:00Z",
+	}
+
+	input, fields := restoreInput(s, current, recorded, nil)
+
+	assert.Equal(t, []string{"title", "description"}, fields)
+	assert.Equal(t, map[string]any{"title": "Access control", "description": nil}, input)
+
+	readOnlyTitle := func(column string) bool { return column != "title" }
+
+	input, fields = restoreInput(s, current, recorded, readOnlyTitle)
+
+	assert.Equal(t, []string{"description"}, fields)
+	assert.Equal(t, map[string]any{"description": nil}, input)
+}
+
+func TestInverseEdgeName(t *testing.T) {
+	assert.Equal(t, "subcontrols", inverseEdgeName(entityops.SchemaControl, entityops.SchemaSubcontrol, "control_id"))
+
+	object := &entityops.Schema{SchemaDescriptor: entityops.SchemaDescriptor{Name: "Control"}}
+	assert.Equal(t, "subcontrol.control_id", inverseEdgeName(object, entityops.SchemaSubcontrol, "control_id"))
+}
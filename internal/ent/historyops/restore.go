@@ -0,0 +1,129 @@
+package historyops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/theopenlane/core/internal/ent/entityops"
+	"github.com/theopenlane/core/internal/ent/generated"
+)
+
+// ErrRestoreUnsupported is returned when the object type has no update path a restore can go through
+var ErrRestoreUnsupported = errors.New("object type cannot be restored from history")
+
+// restoreSkipColumns identify the object or record who created and removed it; they are never written back
+var restoreSkipColumns = map[string]bool{
+	"id":         true,
+	"owner_id":   true,
+	"display_id": true,
+	"created_at": true,
+	"created_by": true,
+	"deleted_at": true,
+	"deleted_by": true,
+}
+
+// FieldFilter reports whether the column may be written back by a restore
+type FieldFilter func(column string) bool
+
+// Restore reverts the object to the snapshot as a new change and returns the fields that were reverted. The
+// update goes through the regular update of the schema, so privacy rules and hooks apply as for any other edit
+// and the restore is itself recorded in history; fields the writable filter rejects, such as read only fields
+// of the update input, keep their current value. Edges stored in join tables are left as they are
+func Restore(ctx context.Context, client *generated.Client, snapshot *Snapshot, writable FieldFilter) ([]string, error) {
+	t, err := lookup(snapshot.ObjectType)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.schema.Update == nil || t.schema.Load == nil {
+		return nil, fmt.Errorf("%w: %s", ErrRestoreUnsupported, t.schema.Name)
+	}
+
+	if snapshot.deleted() {
+		return nil, ErrObjectDeleted
+	}
+
+	current, err := loadFields(ctx, client, t.schema, snapshot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	input, fields := restoreInput(t.schema, current, snapshot.Fields, writable)
+	if len(fields) == 0 {
+		return []string{}, nil
+	}
+
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.schema.Update(ctx, client, snapshot.ID, data); err != nil {
+		return nil, err
+	}
+
+	// fields the update input does not accept are silently ignored, report only the ones that were reverted
+	updated, err := loadFields(ctx, client, t.schema, snapshot.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	restored := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if equalValues(updated[field], snapshot.Fields[field]) {
+			restored = append(restored, field)
+		}
+	}
+
+	return restored, nil
+}
+
+// restoreInput builds the update input setting every field that differs from the snapshot back to its recorded
+// value; fields recorded as null are cleared when the schema allows clearing them and fields the writable filter
+// rejects are left out
+func restoreInput(s *entityops.Schema, current, recorded map[string]any, writable FieldFilter) (map[string]any, []string) {
+	input := map[string]any{}
+	fields := []string{}
+
+	for _, f := range s.Fields {
+		if restoreSkipColumns[f.Name] || auditColumns[f.Name] {
+			continue
+		}
+
+		if writable != nil && !writable(f.Name) {
+			continue
+		}
+
+		value, ok := recorded[f.Name]
+		if !ok || equalValues(current[f.Name], value) {
+			continue
+		}
+
+		if value == nil && !f.Clearable {
+			continue
+		}
+
+		input[f.Name] = value
+		fields = append(fields, f.Name)
+	}
+
+	return input, fields
+}
+
+// loadFields loads the current values of the object keyed by column name
+func loadFields(ctx context.Context, client *generated.Client, s *entityops.Schema, id string) (map[string]any, error) {
+	data, err := s.Load(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := map[string]any{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,27 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+
+	"github.com/theopenlane/core/internal/accessreview"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/hook"
+)
+
+// HookCampaignAccessReview rejects changes to the access review of a campaign made outside of the access review
+// workflow; the decisions recorded on the review are applied and signed off as evidence so they cannot be edited
+func HookCampaignAccessReview() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.CampaignFunc(func(ctx context.Context, m *generated.CampaignMutation) (generated.Value, error) {
+			_, set := m.AccessReview()
+
+			if (set || m.AccessReviewCleared()) && !accessreview.IsManagedUpdate(ctx) {
+				return nil, accessreview.ErrReviewImmutable
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
@@ -0,0 +1,369 @@
+package hooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+	"github.com/samber/lo"
+	"github.com/stoewer/go-strcase"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/iam/fgax"
+
+	fgamodel "github.com/theopenlane/core/fga/model"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
+	"github.com/theopenlane/core/internal/ent/generated/group"
+	"github.com/theopenlane/core/internal/ent/generated/hook"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/ent/generated/program"
+	"github.com/theopenlane/core/internal/ent/privacy/utils"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+const (
+	customRolePermissionsField = "permissions"
+	customRoleProgramIDsField  = "program_ids"
+	customRoleGroupIDsField    = "group_ids"
+	customRoleOwnerField       = "owner_id"
+)
+
+// customRoleScopedRelations are the permissions a role scoped to programs or groups can hold, keyed by the scoped
+// object type, and the relation each grants on every program or group of the scope. Other permissions apply to
+// the organization rather than a single program or group and are rejected on scoped roles; can_delete_program has
+// no relation a role can be granted on a program
+var customRoleScopedRelations = map[string]map[string]string{
+	strcase.SnakeCase(generated.TypeProgram): {
+		fgamodel.CanView + "_program": string(fgax.ViewerRelation),
+		fgamodel.CanEdit + "_program": string(fgax.EditorRelation),
+	},
+	strcase.SnakeCase(generated.TypeGroup): {
+		fgamodel.CanView + "_group":   string(fgax.ViewerRelation),
+		fgamodel.CanEdit + "_group":   string(fgax.EditorRelation),
+		fgamodel.CanDelete + "_group": string(fgax.EditorRelation),
+	},
+}
+
+// customRoleScope is the permissions and scope of a custom role the tuples are written from
+type customRoleScope struct {
+	permissions []string
+	programIDs  []string
+	groupIDs    []string
+}
+
+// customRoleGrant is a relation granted to the assignee userset of a custom role
+type customRoleGrant struct {
+	objectType string
+	objectID   string
+	relation   string
+}
+
+// HookCustomRole writes the tuples granting the permissions of a custom role to its assignees, when the permissions
+// or scope of the role change only the difference is written. Deleting the role removes its grants, the assignee
+// tuples are removed through HookDeletePermissions
+func HookCustomRole() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if isDeleteOp(ctx, m) {
+				return deleteCustomRoleGrants(ctx, m, next)
+			}
+
+			if !m.Op().Is(ent.OpCreate) && !customRoleScopeChanged(m) {
+				return next.Mutate(ctx, m)
+			}
+
+			mut, ok := m.(utils.GenericMutation)
+			if !ok {
+				return nil, ErrInvalidInput
+			}
+
+			// the previous scope is needed to remove the grants that no longer apply, it can only be read for a
+			// single role so bulk updates of the permissions or scope are rejected
+			if m.Op().Is(ent.OpUpdate) {
+				return nil, ErrCustomRoleBulkUpdate
+			}
+
+			var (
+				old   customRoleScope
+				orgID string
+				err   error
+			)
+
+			if m.Op().Is(ent.OpUpdateOne) {
+				old, orgID, err = oldCustomRoleScope(ctx, m)
+			} else {
+				orgID, err = customRoleOrganization(ctx, m)
+			}
+
+			if err != nil {
+				return nil, err
+			}
+
+			updated := newCustomRoleScope(m, old)
+
+			// the grants are written with the hook's authz client, so a program or group of another
+			// organization must be rejected here rather than by the fga model
+			if err := validateCustomRoleScope(ctx, mut.Client(), orgID, old, updated); err != nil {
+				return nil, err
+			}
+
+			if _, err := customRoleGrants(orgID, updated); err != nil {
+				return nil, err
+			}
+
+			retVal, err := next.Mutate(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			roleID, _ := mut.ID()
+			if m.Op().Is(ent.OpCreate) {
+				if roleID, err = GetObjectIDFromEntValue(retVal); err != nil {
+					return nil, err
+				}
+			}
+
+			writes, deletes, err := customRoleTupleChanges(orgID, roleID, old, updated)
+			if err != nil {
+				return nil, err
+			}
+
+			if m.Op().Is(ent.OpCreate) {
+				writes = append(writes, fgax.GetTupleKey(fgax.TupleRequest{
+					SubjectID:   orgID,
+					SubjectType: generated.TypeOrganization,
+					ObjectID:    roleID,
+					ObjectType:  fgamodel.CustomRoleType,
+					Relation:    fgax.ParentContextRelation,
+				}))
+			}
+
+			if len(writes) == 0 && len(deletes) == 0 {
+				return retVal, nil
+			}
+
+			if _, err := utils.AuthzClient(ctx, m).WriteTupleKeys(ctx, writes, deletes); err != nil {
+				logx.FromContext(ctx).Error().Err(err).Str("custom_role_id", roleID).Msg("failed to write custom role tuples")
+
+				return nil, ErrInternalServerError
+			}
+
+			return retVal, nil
+		})
+	}, ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne)
+}
+
+// customRoleScopeChanged reports whether the mutation changes the permissions or scope of the role
+func customRoleScopeChanged(m ent.Mutation) bool {
+	for _, f := range []string{customRolePermissionsField, customRoleProgramIDsField, customRoleGroupIDsField} {
+		if _, ok := m.Field(f); ok || m.FieldCleared(f) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// oldCustomRoleScope returns the permissions, scope and organization of the role before the update
+func oldCustomRoleScope(ctx context.Context, m ent.Mutation) (customRoleScope, string, error) {
+	var scope customRoleScope
+
+	fields := map[string]*[]string{
+		customRolePermissionsField: &scope.permissions,
+		customRoleProgramIDsField:  &scope.programIDs,
+		customRoleGroupIDsField:    &scope.groupIDs,
+	}
+
+	for name, dst := range fields {
+		v, err := m.OldField(ctx, name)
+		if err != nil {
+			return scope, "", err
+		}
+
+		*dst, _ = v.([]string)
+	}
+
+	owner, err := m.OldField(ctx, customRoleOwnerField)
+	if err != nil {
+		return scope, "", err
+	}
+
+	orgID, _ := owner.(string)
+
+	return scope, orgID, nil
+}
+
+// newCustomRoleScope returns the permissions and scope of the role after the mutation
+func newCustomRoleScope(m ent.Mutation, old customRoleScope) customRoleScope {
+	value := func(name string, current []string) []string {
+		if v, ok := m.Field(name); ok {
+			s, _ := v.([]string)
+			return s
+		}
+
+		if m.FieldCleared(name) {
+			return nil
+		}
+
+		return current
+	}
+
+	return customRoleScope{
+		permissions: value(customRolePermissionsField, old.permissions),
+		programIDs:  value(customRoleProgramIDsField, old.programIDs),
+		groupIDs:    value(customRoleGroupIDsField, old.groupIDs),
+	}
+}
+
+// customRoleOrganization returns the organization a new role is created in
+func customRoleOrganization(ctx context.Context, m ent.Mutation) (string, error) {
+	if v, ok := m.Field(customRoleOwnerField); ok {
+		if orgID, _ := v.(string); orgID != "" {
+			return orgID, nil
+		}
+	}
+
+	return auth.GetOrganizationIDFromContext(ctx)
+}
+
+// validateCustomRoleScope ensures the programs and groups added to the scope of a role belong to the organization
+// of the role; ids that were already in the scope are not checked again so a deleted program does not block updates
+func validateCustomRoleScope(ctx context.Context, client *generated.Client, orgID string, old, updated customRoleScope) error {
+	programIDs := lo.Uniq(lo.Without(updated.programIDs, old.programIDs...))
+	groupIDs := lo.Uniq(lo.Without(updated.groupIDs, old.groupIDs...))
+
+	if len(programIDs) == 0 && len(groupIDs) == 0 {
+		return nil
+	}
+
+	if orgID == "" {
+		return fmt.Errorf("%w: custom role organization is required", ErrInvalidInput)
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	if len(programIDs) > 0 {
+		count, err := client.Program.Query().
+			Where(program.IDIn(programIDs...), program.OwnerID(orgID)).
+			Count(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		if count != len(programIDs) {
+			return fmt.Errorf("%w: program", ErrCustomRoleScopeNotInOrganization)
+		}
+	}
+
+	if len(groupIDs) > 0 {
+		count, err := client.Group.Query().
+			Where(group.IDIn(groupIDs...), group.OwnerID(orgID)).
+			Count(allowCtx)
+		if err != nil {
+			return err
+		}
+
+		if count != len(groupIDs) {
+			return fmt.Errorf("%w: group", ErrCustomRoleScopeNotInOrganization)
+		}
+	}
+
+	return nil
+}
+
+// customRoleGrants returns the relations granted to the assignees of a role; an unscoped role is granted the
+// relations of its permissions on the organization, a scoped role is granted the relation of each of its
+// permissions on the programs or groups of its scope the permission applies to
+func customRoleGrants(orgID string, scope customRoleScope) ([]customRoleGrant, error) {
+	grants := []customRoleGrant{}
+
+	if len(scope.programIDs) == 0 && len(scope.groupIDs) == 0 {
+		for _, permission := range scope.permissions {
+			relation, err := fgamodel.CustomRoleRelation(permission)
+			if err != nil {
+				return nil, err
+			}
+
+			grants = append(grants, customRoleGrant{
+				objectType: strcase.SnakeCase(generated.TypeOrganization),
+				objectID:   orgID,
+				relation:   relation,
+			})
+		}
+
+		return lo.Uniq(grants), nil
+	}
+
+	scoped := []struct {
+		objectType string
+		ids        []string
+	}{
+		{objectType: strcase.SnakeCase(generated.TypeProgram), ids: scope.programIDs},
+		{objectType: strcase.SnakeCase(generated.TypeGroup), ids: scope.groupIDs},
+	}
+
+	for _, permission := range scope.permissions {
+		permission = strings.ToLower(strings.TrimSpace(permission))
+		granted := false
+
+		for _, s := range scoped {
+			relation, ok := customRoleScopedRelations[s.objectType][permission]
+			if !ok || len(s.ids) == 0 {
+				continue
+			}
+
+			granted = true
+
+			for _, id := range s.ids {
+				grants = append(grants, customRoleGrant{objectType: s.objectType, objectID: id, relation: relation})
+			}
+		}
+
+		if !granted {
+			return nil, fmt.Errorf("%w: %s", ErrCustomRoleScopedPermission, permission)
+		}
+	}
+
+	return lo.Uniq(grants), nil
+}
+
+// customRoleTupleChanges returns the tuples to write and delete to move the grants of a role from the old
+// permissions and scope to the new ones
+func customRoleTupleChanges(orgID, roleID string, old, updated customRoleScope) ([]fgax.TupleKey, []fgax.TupleKey, error) {
+	if orgID == "" || roleID == "" {
+		return nil, nil, fmt.Errorf("%w: custom role organization and id are required", ErrInvalidInput)
+	}
+
+	oldGrants, err := customRoleGrants(orgID, old)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newGrants, err := customRoleGrants(orgID, updated)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removed, added := lo.Difference(oldGrants, newGrants)
+
+	return customRoleTuples(roleID, added), customRoleTuples(roleID, removed), nil
+}
+
+// customRoleTuples returns the tuples granting the relations to the assignee userset of the role
+func customRoleTuples(roleID string, grants []customRoleGrant) []fgax.TupleKey {
+	tuples := make([]fgax.TupleKey, 0, len(grants))
+
+	for _, g := range grants {
+		tuples = append(tuples, fgax.GetTupleKey(fgax.TupleRequest{
+			SubjectID:       roleID,
+			SubjectType:     fgamodel.CustomRoleType,
+			SubjectRelation: fgamodel.CustomRoleAssigneeRelation,
+			ObjectID:        g.objectID,
+			ObjectType:      g.objectType,
+			Relation:        g.relation,
+		}))
+	}
+
+	return tuples
+}
@@ -0,0 +1,119 @@
+package hooks
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theopenlane/iam/fgax"
+
+	fgamodel "github.com/theopenlane/core/fga/model"
+)
+
+func TestCustomRoleTupleChanges(t *testing.T) {
+	assignee := func(objectType, objectID, relation string) fgax.TupleKey {
+		return fgax.GetTupleKey(fgax.TupleRequest{
+			SubjectID:       "role-1",
+			SubjectType:     fgamodel.CustomRoleType,
+			SubjectRelation: fgamodel.CustomRoleAssigneeRelation,
+			ObjectID:        objectID,
+			ObjectType:      objectType,
+			Relation:        relation,
+		})
+	}
+
+	testCases := []struct {
+		name          string
+		old           customRoleScope
+		updated       customRoleScope
+		expectWrites  []fgax.TupleKey
+		expectDeletes []fgax.TupleKey
+		expectErr     error
+	}{
+		{
+			name:    "new organization role",
+			updated: customRoleScope{permissions: []string{"can_edit_evidence", "can_view_control", "can_manage_policies"}},
+			expectWrites: []fgax.TupleKey{
+				assignee("organization", "org-1", "can_edit_evidence"),
+				assignee("organization", "org-1", "can_view_control"),
+				assignee("organization", "org-1", "policy_manager"),
+			},
+			expectDeletes: []fgax.TupleKey{},
+		},
+		{
+			name:         "permission removed",
+			old:          customRoleScope{permissions: []string{"can_edit_evidence", "can_create_control"}},
+			updated:      customRoleScope{permissions: []string{"can_edit_evidence"}},
+			expectWrites: []fgax.TupleKey{},
+			expectDeletes: []fgax.TupleKey{
+				assignee("organization", "org-1", "control_creator"),
+			},
+		},
+		{
+			name:    "scoped to a program",
+			old:     customRoleScope{permissions: []string{"can_edit_program"}},
+			updated: customRoleScope{permissions: []string{"can_edit_program"}, programIDs: []string{"program-1"}},
+			expectWrites: []fgax.TupleKey{
+				assignee("program", "program-1", "editor"),
+			},
+			expectDeletes: []fgax.TupleKey{
+				assignee("organization", "org-1", "can_edit_program"),
+			},
+		},
+		{
+			name:    "read only role scoped to a group",
+			updated: customRoleScope{permissions: []string{"can_view_group"}, groupIDs: []string{"group-1"}},
+			expectWrites: []fgax.TupleKey{
+				assignee("group", "group-1", "viewer"),
+			},
+			expectDeletes: []fgax.TupleKey{},
+		},
+		{
+			name: "scoped to programs and groups",
+			updated: customRoleScope{
+				permissions: []string{"can_view_program", "can_delete_group"},
+				programIDs:  []string{"program-1"},
+				groupIDs:    []string{"group-1"},
+			},
+			expectWrites: []fgax.TupleKey{
+				assignee("program", "program-1", "viewer"),
+				assignee("group", "group-1", "editor"),
+			},
+			expectDeletes: []fgax.TupleKey{},
+		},
+		{
+			name:      "organization permission on a scoped role",
+			updated:   customRoleScope{permissions: []string{"can_edit_evidence"}, programIDs: []string{"program-1"}},
+			expectErr: ErrCustomRoleScopedPermission,
+		},
+		{
+			name:      "group permission on a role scoped to programs",
+			updated:   customRoleScope{permissions: []string{"can_edit_group"}, programIDs: []string{"program-1"}},
+			expectErr: ErrCustomRoleScopedPermission,
+		},
+		{
+			name:      "program delete on a scoped role",
+			updated:   customRoleScope{permissions: []string{"can_delete_program"}, programIDs: []string{"program-1"}},
+			expectErr: ErrCustomRoleScopedPermission,
+		},
+		{
+			name:      "invalid permission",
+			updated:   customRoleScope{permissions: []string{"owner"}},
+			expectErr: fgamodel.ErrInvalidCustomRolePermission,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			writes, deletes, err := customRoleTupleChanges("org-1", "role-1", tc.old, tc.updated)
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.ElementsMatch(t, tc.expectWrites, writes)
+			assert.ElementsMatch(t, tc.expectDeletes, deletes)
+		})
+	}
+}
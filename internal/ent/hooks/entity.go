@@ -66,6 +66,30 @@ func HookEntityApprovedForUse() ent.Hook {
 	)
 }
 
+// HookEntitySOC2PeriodEnd clears the SOC 2 lapse marker when the reporting period end changes, so a newer
+// report recorded by a user or an ingested assurance report ends the lapse
+func HookEntitySOC2PeriodEnd() ent.Hook {
+	return hook.If(func(next ent.Mutator) ent.Mutator {
+		return hook.EntityFunc(func(ctx context.Context, m *generated.EntityMutation) (generated.Value, error) {
+			if _, ok := m.Soc2LapsedAt(); ok {
+				return next.Mutate(ctx, m)
+			}
+
+			m.ClearSoc2LapsedAt()
+
+			return next.Mutate(ctx, m)
+		})
+	},
+		hook.And(
+			hook.Or(
+				hook.HasFields("soc2_period_end"),
+				hook.HasClearedFields("soc2_period_end"),
+			),
+			hook.HasOp(ent.OpUpdateOne|ent.OpUpdate),
+		),
+	)
+}
+
 // HookEntityLogoFile runs on entity mutations to check for an uploaded logo file
 func HookEntityLogoFile() ent.Hook {
 	return hook.On(func(next ent.Mutator) ent.Mutator {
@@ -259,6 +259,12 @@ var (
 	ErrExpressionNotList = errors.New("entityops: expression did not evaluate to a list")
 	// ErrQuestionnaireTransformInvalid is returned when a questionnaire transform configuration or submission cannot map to its target
 	ErrQuestionnaireTransformInvalid = errors.New("questionnaire transform invalid")
+	// ErrCustomRoleBulkUpdate is returned when the permissions or scope of several custom roles are updated at once
+	ErrCustomRoleBulkUpdate = errors.New("custom role permissions and scope must be updated one role at a time")
+	// ErrCustomRoleScopeNotInOrganization is returned when a custom role is scoped to a program or group outside of its organization
+	ErrCustomRoleScopeNotInOrganization = errors.New("custom role can only be scoped to programs and groups of its organization")
+	// ErrCustomRoleScopedPermission is returned when a custom role scoped to programs or groups holds a permission that does not apply to them
+	ErrCustomRoleScopedPermission = errors.New("permission cannot be granted on the programs and groups the custom role is scoped to")
 )
 
 // IsUniqueConstraintError reports if the error resulted from a DB uniqueness constraint violation.
@@ -20,7 +20,9 @@ import (
 
 // EmitGalaEventHook returns a hook that emits Gala mutation envelopes after mutations.
 // Runtimes are deduplicated once at installation; a mutation fans out to every concern
-// topic each runtime has an interested listener for, one envelope per mutated row
+// topic each runtime has an interested listener for, one envelope per mutated row.
+// Inside a transaction, runtimes with an outbox emit right away so the envelopes are written
+// in the transaction; the other runtimes emit once it commits
 func EmitGalaEventHook(runtimes ...*gala.Gala) ent.Hook {
 	galaRuntimes := lo.Uniq(lo.Compact(runtimes))
 
@@ -58,8 +60,8 @@ func EmitGalaEventHook(runtimes ...*gala.Gala) ent.Hook {
 				return retVal, err
 			}
 
-			emit := func() {
-				if !entityops.InterestedInMutation(galaRuntimes, topicName, op) {
+			emit := func(runtimes []*gala.Gala) {
+				if !entityops.InterestedInMutation(runtimes, topicName, op) {
 					return
 				}
 
@@ -74,7 +76,7 @@ func EmitGalaEventHook(runtimes ...*gala.Gala) ent.Hook {
 						}
 						payload.OldValues = oldValues[entityID]
 
-						entityops.EmitMutation(ctx, galaRuntimes, payload)
+						entityops.EmitMutation(ctx, runtimes, payload)
 					}
 				default:
 					if retVal == nil || reflect.TypeOf(retVal).Kind() == reflect.Int {
@@ -88,7 +90,7 @@ func EmitGalaEventHook(runtimes ...*gala.Gala) ent.Hook {
 						return
 					}
 
-					entityops.EmitMutation(ctx, galaRuntimes, entityops.MutationPayload{
+					entityops.EmitMutation(ctx, runtimes, entityops.MutationPayload{
 						MutationType: topicName,
 						Operation:    op,
 						EntityID:     entityID,
@@ -98,18 +100,28 @@ func EmitGalaEventHook(runtimes ...*gala.Gala) ent.Hook {
 			}
 
 			if tx := transactionFromContext(ctx); tx != nil {
-				tx.OnCommit(func(next entgen.Committer) entgen.Committer {
-					return entgen.CommitFunc(func(ctx context.Context, tx *entgen.Tx) error {
-						err := next.Commit(ctx, tx)
-						if err == nil {
-							defer emit()
-						}
+				outboxRuntimes, commitRuntimes := lo.FilterReject(galaRuntimes, func(runtime *gala.Gala, _ int) bool {
+					return runtime.OutboxEnabled()
+				})
+
+				if len(outboxRuntimes) > 0 {
+					emit(outboxRuntimes)
+				}
 
-						return err
+				if len(commitRuntimes) > 0 {
+					tx.OnCommit(func(next entgen.Committer) entgen.Committer {
+						return entgen.CommitFunc(func(ctx context.Context, tx *entgen.Tx) error {
+							err := next.Commit(ctx, tx)
+							if err == nil {
+								defer emit(commitRuntimes)
+							}
+
+							return err
+						})
 					})
-				})
+				}
 			} else {
-				defer emit()
+				defer emit(galaRuntimes)
 			}
 
 			return retVal, err
@@ -3,13 +3,17 @@ package hooks
 import (
 	"context"
 	"errors"
+	"time"
 
 	"entgo.io/ent"
+	"github.com/samber/lo"
+
 	"github.com/theopenlane/core/common/storagetypes"
 	"github.com/theopenlane/core/internal/ent/generated"
 	"github.com/theopenlane/core/internal/ent/generated/file"
 	"github.com/theopenlane/core/internal/ent/generated/hook"
 	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/retention"
 )
 
 var (
@@ -99,3 +103,75 @@ func HookFileDelete() ent.Hook {
 			})
 	}, ent.OpDelete|ent.OpDeleteOne)
 }
+
+// fileContentFields are the file fields that change when the stored object is overwritten
+var fileContentFields = []string{
+	file.FieldStoragePath,
+	file.FieldStoreKey,
+	file.FieldStorageVolume,
+	file.FieldStorageProvider,
+	file.FieldURI,
+	file.FieldMd5Hash,
+	file.FieldPersistedFileSize,
+	file.FieldFileContents,
+}
+
+// HookFileRetention blocks deletes and overwrites of files that are under retention or legal hold, and
+// prevents the recorded retention state from being changed outside of the retention workflows
+func HookFileRetention() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.FileFunc(
+			func(ctx context.Context, m *generated.FileMutation) (generated.Value, error) {
+				deleteOp := isDeleteOp(ctx, m)
+				overwrite := lo.SomeBy(fileContentFields, func(field string) bool {
+					_, ok := m.Field(field)
+					return ok
+				})
+				metadata, metadataSet := m.Metadata()
+
+				// clearing the metadata drops the retention entries just like replacing it does
+				if m.MetadataCleared() {
+					metadata, metadataSet = nil, true
+				}
+
+				if !deleteOp && !overwrite && !metadataSet {
+					return next.Mutate(ctx, m)
+				}
+
+				ids, err := getMutationIDs(ctx, m)
+				if err != nil {
+					return nil, err
+				}
+
+				if len(ids) == 0 {
+					return next.Mutate(ctx, m)
+				}
+
+				files, err := m.Client().File.Query().Where(file.IDIn(ids...)).
+					Select(file.FieldID, file.FieldMetadata).
+					All(ctx)
+				if err != nil {
+					return nil, err
+				}
+
+				now := time.Now()
+
+				for _, f := range files {
+					// relocations copy verified contents to a new storage location and are not overwrites
+					if deleteOp || (overwrite && !retention.IsRelocation(ctx)) {
+						if err := retention.CheckMutation(f.Metadata, now); err != nil {
+							logx.FromContext(ctx).Info().Err(err).Str("file_id", f.ID).Msg("blocked mutation of retained file")
+
+							return nil, err
+						}
+					}
+
+					if metadataSet && !deleteOp && !retention.IsManagedUpdate(ctx) && retention.Changed(f.Metadata, metadata) {
+						return nil, retention.ErrRetentionMetadataImmutable
+					}
+				}
+
+				return next.Mutate(ctx, m)
+			})
+	}, ent.OpUpdate|ent.OpUpdateOne|ent.OpDelete|ent.OpDeleteOne)
+}
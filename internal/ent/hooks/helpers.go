@@ -22,16 +22,7 @@ func isDeleteOp(ctx context.Context, m ent.Mutation) bool {
 
 // transactionFromContext returns the transaction from the context if it exists
 func transactionFromContext(ctx context.Context) *generated.Tx {
-	// check if the transaction is in the context
-	// this is returned from all graphql requests
-	tx := generated.TxFromContext(ctx)
-	if tx != nil {
-		return tx
-	}
-
-	// check if the transaction is in the context
-	// from the REST middleware
-	return transaction.FromContext(ctx)
+	return transaction.Current(ctx)
 }
 
 // runtimeHooks is a list of post-mutation hooks that are executed after a mutation operation is performed
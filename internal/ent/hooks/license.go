@@ -0,0 +1,58 @@
+package hooks
+
+import (
+	"context"
+	"time"
+
+	"entgo.io/ent"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/hook"
+	"github.com/theopenlane/core/internal/metering"
+)
+
+// LicenseSeats returns the seat limit an offline license sets for an organization
+type LicenseSeats interface {
+	// SeatLimit returns the seat limit of the organization and whether the license limits its seats
+	SeatLimit(orgID string, now time.Time) (int64, bool)
+}
+
+// HookLicenseSeats rejects organization members past the seat limit of the license of a self-hosted
+// deployment; unlike HookQuota it does not depend on the module catalog being enabled
+func HookLicenseSeats(license LicenseSeats) ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.OrgMembershipFunc(func(ctx context.Context, m *generated.OrgMembershipMutation) (generated.Value, error) {
+			if license == nil || skipQuota(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			orgID, ok := m.OrganizationID()
+			if !ok {
+				return next.Mutate(ctx, m)
+			}
+
+			now := time.Now()
+
+			seats, limited := license.SeatLimit(orgID, now)
+			if !limited {
+				return next.Mutate(ctx, m)
+			}
+
+			used, err := metering.Measure(ctx, m.Client(), orgID, metering.MetricSeats, now)
+			if err != nil {
+				return nil, err
+			}
+
+			if used+1 > seats {
+				return nil, &metering.QuotaError{
+					Metric:    metering.MetricSeats,
+					Used:      used,
+					Requested: 1,
+					Limit:     metering.Limit{Soft: seats, Hard: seats},
+				}
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate)
+}
@@ -0,0 +1,363 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/samber/lo"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/ent/entityops"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/control"
+	"github.com/theopenlane/core/internal/ent/generated/finding"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/ent/generated/task"
+	"github.com/theopenlane/core/pkg/assurance"
+	"github.com/theopenlane/core/pkg/gala"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/extract"
+	"github.com/theopenlane/core/pkg/objects/scanner"
+)
+
+const (
+	// assuranceReportSource is the source recorded on findings and tasks created from assurance reports
+	assuranceReportSource = "assurance_report"
+	// assuranceReportMetadataKey is the file metadata key the parsed report summary is stored under
+	assuranceReportMetadataKey = "assurance_report"
+	// assuranceReportFindingCategory is the category of findings created from report exceptions
+	assuranceReportFindingCategory = "Vendor Assurance Exception"
+	// cuecReviewDueDays is the number of days given to review the complementary user entity controls of a report
+	cuecReviewDueDays = 30
+)
+
+// AssuranceReportListeners parses SOC and ISO/IEC 27001 reports attached to a vendor, updating the vendor's
+// SOC 2 fields, raising a finding for each exception noted and adding the complementary user entity controls
+// to the organization's program
+func AssuranceReportListeners() []gala.Registration {
+	return []gala.Registration{
+		entityops.MutationListener{
+			Schema:     entityops.SchemaEntity,
+			Operations: []string{entityops.OpCreate, entityops.OpUpdateOne},
+			Caller:     internalOperationBypassCaller,
+			Handle:     handleAssuranceReports,
+		},
+	}
+}
+
+// handleAssuranceReports ingests the files added to the vendor that are recognized as assurance reports; files
+// that were already ingested are skipped
+func handleAssuranceReports(inv entityops.Invocation, payload entityops.MutationPayload) error {
+	fileIDs := payload.AddedIDs["files"]
+	if len(fileIDs) == 0 {
+		return nil
+	}
+
+	allowCtx := privacy.DecisionContext(inv.Context, privacy.Allow)
+
+	e, ok, err := entityops.LoadEntity(allowCtx, inv.EntityID, inv.Client.Entity.Get)
+	if err != nil || !ok {
+		return err
+	}
+
+	var errs []error
+
+	for _, fileID := range lo.Uniq(fileIDs) {
+		if err := ingestAssuranceReport(allowCtx, inv.Client, e, fileID); err != nil {
+			logx.FromContext(allowCtx).Error().Err(err).Str("entity_id", e.ID).Str("file_id", fileID).Msg("failed to ingest assurance report")
+
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ingestAssuranceReport parses the file and applies the report to the vendor
+func ingestAssuranceReport(ctx context.Context, client *generated.Client, e *generated.Entity, fileID string) error {
+	f, ok, err := entityops.LoadEntity(ctx, fileID, client.File.Get)
+	if err != nil || !ok {
+		return err
+	}
+
+	if _, done := f.Metadata[assuranceReportMetadataKey]; done || !extract.Supported(f.DetectedContentType, f.ProvidedFileExtension) {
+		return nil
+	}
+
+	// files kept in the database skip the quarantine check of the object manager, so it runs before reading
+	if err := scanner.CheckAccess(f.ScanStatus); err != nil {
+		logx.FromContext(ctx).Warn().Err(err).Str("file_id", f.ID).Msg("skipping assurance report that has not passed content scanning")
+
+		return nil
+	}
+
+	data, err := documentContents(ctx, client, f)
+	if err != nil || data == nil {
+		return err
+	}
+
+	text, err := extract.Text(data, f.DetectedContentType, f.ProvidedFileExtension)
+	if err != nil {
+		// a document that cannot be read will not become readable on retry
+		return nil
+	}
+
+	report, err := assurance.Parse(text)
+	if errors.Is(err, assurance.ErrNotAssuranceReport) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := applyAssuranceReportToVendor(ctx, client, e, report); err != nil {
+		return err
+	}
+
+	findingIDs, err := createAssuranceFindings(ctx, client, e, f, report)
+	if err != nil {
+		return err
+	}
+
+	controlIDs, err := upsertCUECControls(ctx, client, e, report)
+	if err != nil {
+		return err
+	}
+
+	if err := openCUECReviewTask(ctx, client, e, f, report, controlIDs, findingIDs); err != nil {
+		return err
+	}
+
+	metadata := lo.Assign(f.Metadata, map[string]any{
+		assuranceReportMetadataKey: assuranceReportSummary(report),
+	})
+
+	return client.File.UpdateOneID(f.ID).SetMetadata(metadata).Exec(ctx)
+}
+
+// applyAssuranceReportToVendor records a SOC 2 report on the vendor; the period end only moves forward so an
+// older report uploaded after a newer one does not replace it, and a report without a favorable opinion does
+// not count as a SOC 2 report on file
+func applyAssuranceReportToVendor(ctx context.Context, client *generated.Client, e *generated.Entity, report *assurance.Report) error {
+	if report.Framework != assurance.FrameworkSOC2 || report.PeriodEnd == nil {
+		return nil
+	}
+
+	if e.Soc2PeriodEnd != nil && !report.PeriodEnd.After(time.Time(*e.Soc2PeriodEnd)) {
+		return nil
+	}
+
+	periodEnd := models.DateTime(*report.PeriodEnd)
+
+	if err := client.Entity.UpdateOneID(e.ID).
+		SetHasSoc2(report.Opinion.Favorable()).
+		SetSoc2PeriodEnd(periodEnd).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	// keep the loaded vendor current for the other files added in the same mutation
+	e.HasSoc2 = report.Opinion.Favorable()
+	e.Soc2PeriodEnd = &periodEnd
+
+	return nil
+}
+
+// createAssuranceFindings raises a finding on the vendor for each exception noted in the report and returns
+// the IDs of the findings for the report, including those raised by an earlier ingestion
+func createAssuranceFindings(ctx context.Context, client *generated.Client, e *generated.Entity, f *generated.File, report *assurance.Report) ([]string, error) {
+	ids := make([]string, 0, len(report.Exceptions))
+
+	for i, exc := range report.Exceptions {
+		externalID := fmt.Sprintf("%s:exception:%d", f.ID, i+1)
+
+		existing, err := client.Finding.Query().
+			Where(
+				finding.OwnerID(e.OwnerID),
+				finding.ExternalID(externalID),
+				finding.ExternalOwnerID(e.ID),
+			).
+			FirstID(ctx)
+
+		switch {
+		case err == nil:
+			ids = append(ids, existing)
+
+			continue
+		case !generated.IsNotFound(err):
+			return nil, err
+		}
+
+		create := client.Finding.Create().
+			SetOwnerID(e.OwnerID).
+			SetExternalID(externalID).
+			SetExternalOwnerID(e.ID).
+			SetSource(assuranceReportSource).
+			SetDisplayName(exceptionTitle(e, report, exc)).
+			SetCategory(assuranceReportFindingCategory).
+			SetDescription(exc.Description).
+			SetOpen(true).
+			SetReportedAt(models.DateTime(time.Now())).
+			SetMetadata(map[string]any{
+				"framework": string(report.Framework),
+				"auditor":   report.Auditor,
+				"control":   exc.Control,
+				"file_id":   f.ID,
+			}).
+			AddEntityIDs(e.ID).
+			AddFileIDs(f.ID)
+
+		if exc.ManagementResponse != "" {
+			create.SetRecommendation(exc.ManagementResponse)
+		}
+
+		created, err := create.Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, created.ID)
+	}
+
+	return ids, nil
+}
+
+// exceptionTitle is the display name of the finding raised for an exception
+func exceptionTitle(e *generated.Entity, report *assurance.Report, exc assurance.Exception) string {
+	title := fmt.Sprintf("%s %s exception", vendorDisplayName(e), report.Framework)
+	if exc.Control != "" {
+		title += " (" + exc.Control + ")"
+	}
+
+	return title
+}
+
+// upsertCUECControls adds a control for each complementary user entity control in the report and returns
+// their IDs; controls are keyed on the vendor and the position of the CUEC in the report so a newer report
+// from the same vendor updates the existing controls
+func upsertCUECControls(ctx context.Context, client *generated.Client, e *generated.Entity, report *assurance.Report) ([]string, error) {
+	ids := make([]string, 0, len(report.CUECs))
+
+	for _, cuec := range report.CUECs {
+		refCode := fmt.Sprintf("%s %s", vendorDisplayName(e), cuec.Ref)
+
+		existing, err := client.Control.Query().
+			Where(
+				control.OwnerID(e.OwnerID),
+				control.RefCode(refCode),
+				control.StandardIDIsNil(),
+				control.DeletedAtIsNil(),
+			).
+			Only(ctx)
+
+		switch {
+		case err == nil:
+			if err := client.Control.UpdateOneID(existing.ID).
+				SetDescription(cuec.Description).
+				SetMappedCategories(cuec.Criteria).
+				Exec(ctx); err != nil {
+				return nil, err
+			}
+
+			ids = append(ids, existing.ID)
+
+			continue
+		case !generated.IsNotFound(err):
+			return nil, err
+		}
+
+		created, err := client.Control.Create().
+			SetOwnerID(e.OwnerID).
+			SetRefCode(refCode).
+			SetTitle(fmt.Sprintf("%s complementary user entity control %s", vendorDisplayName(e), strings.TrimPrefix(cuec.Ref, "CUEC "))).
+			SetDescription(cuec.Description).
+			SetReferenceID(cuec.Ref).
+			SetSource(enums.ControlSourceImport).
+			SetSourceName(vendorDisplayName(e)).
+			SetReferenceFramework(string(report.Framework)).
+			SetMappedCategories(cuec.Criteria).
+			SetStatus(enums.ControlStatusNotImplemented).
+			AddEntityIDs(e.ID).
+			Save(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, created.ID)
+	}
+
+	return ids, nil
+}
+
+// openCUECReviewTask opens a task for the vendor owner to confirm the organization operates the complementary
+// user entity controls and to follow up on the exceptions noted in the report
+func openCUECReviewTask(ctx context.Context, client *generated.Client, e *generated.Entity, f *generated.File, report *assurance.Report, controlIDs, findingIDs []string) error {
+	if len(controlIDs) == 0 && len(findingIDs) == 0 {
+		return nil
+	}
+
+	idempotencyKey := fmt.Sprintf("%s:%s", assuranceReportSource, f.ID)
+
+	exists, err := client.Task.Query().
+		Where(
+			task.OwnerIDEQ(e.OwnerID),
+			task.IdempotencyKeyEQ(idempotencyKey),
+		).
+		Exist(ctx)
+	if err != nil || exists {
+		return err
+	}
+
+	create := client.Task.Create().
+		SetOwnerID(e.OwnerID).
+		SetTitle(fmt.Sprintf("Review the %s report from %s", report.Framework, vendorDisplayName(e))).
+		SetDetails(fmt.Sprintf("The %s report from %s lists %d complementary user entity controls and %d exceptions. Confirm the controls are operated by the organization and assess the impact of the exceptions.",
+			report.Framework, vendorDisplayName(e), len(report.CUECs), len(report.Exceptions))).
+		SetDue(models.DateTime(time.Now().AddDate(0, 0, cuecReviewDueDays))).
+		SetSystemGenerated(true).
+		SetSource(assuranceReportSource).
+		SetSourceKey(f.ID).
+		SetIdempotencyKey(idempotencyKey).
+		SetMetadata(map[string]any{"entity_id": e.ID, "file_id": f.ID}).
+		AddControlIDs(controlIDs...).
+		AddFindingIDs(findingIDs...)
+
+	if e.InternalOwnerUserID != "" {
+		create.SetAssigneeID(e.InternalOwnerUserID)
+	}
+
+	return create.Exec(ctx)
+}
+
+// assuranceReportSummary is the summary of the report stored on the file
+func assuranceReportSummary(report *assurance.Report) map[string]any {
+	summary := map[string]any{
+		"framework":  string(report.Framework),
+		"type":       string(report.Type),
+		"auditor":    report.Auditor,
+		"opinion":    string(report.Opinion),
+		"exceptions": len(report.Exceptions),
+		"cuecs":      len(report.CUECs),
+		"parsed_at":  time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if report.PeriodStart != nil {
+		summary["period_start"] = report.PeriodStart.Format(time.DateOnly)
+	}
+
+	if report.PeriodEnd != nil {
+		summary["period_end"] = report.PeriodEnd.Format(time.DateOnly)
+	}
+
+	return summary
+}
+
+// vendorDisplayName is the name shown for the vendor on records created from its reports
+func vendorDisplayName(e *generated.Entity) string {
+	return lo.CoalesceOrEmpty(e.DisplayName, e.Name)
+}
@@ -0,0 +1,135 @@
+package hooks
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec // used to fingerprint file contents, not for security
+	"encoding/hex"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/internal/ent/entityops"
+	"github.com/theopenlane/core/internal/ent/fulltext"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/file"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/ent/interceptors"
+	"github.com/theopenlane/core/pkg/gala"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/extract"
+	"github.com/theopenlane/core/pkg/objects/scanner"
+)
+
+// DocumentContentListeners extracts the text of uploaded documents and indexes it for full-text search
+// whenever a file is uploaded, replaced or cleared by the content scanner, and removes it when the file is deleted
+func DocumentContentListeners() []gala.Registration {
+	return []gala.Registration{
+		entityops.MutationListener{
+			Schema:     entityops.SchemaFile,
+			Operations: []string{entityops.OpCreate, entityops.OpUpdateOne},
+			Fields: []string{
+				file.FieldStoragePath,
+				file.FieldMd5Hash,
+				file.FieldMetadata,
+			},
+			Caller: internalOperationBypassCaller,
+			Handle: handleDocumentContent,
+		},
+		entityops.MutationListener{
+			Schema:     entityops.SchemaFile,
+			Operations: []string{entityops.OpSoftDelete, entityops.OpDelete, entityops.OpDeleteOne},
+			Caller:     internalOperationBypassCaller,
+			Handle:     handleDocumentRemoved,
+		},
+	}
+}
+
+// handleDocumentRemoved removes the indexed text of a deleted file; the document table only cascades hard
+// deletes, so the text of soft deleted files would otherwise stay behind
+func handleDocumentRemoved(inv entityops.Invocation, _ entityops.MutationPayload) error {
+	return fulltext.RemoveDocument(privacy.DecisionContext(inv.Context, privacy.Allow), inv.Client, inv.EntityID)
+}
+
+// handleDocumentContent downloads the file and indexes its text; files that are quarantined by the content
+// scanner are removed from the index until they pass scanning
+func handleDocumentContent(inv entityops.Invocation, _ entityops.MutationPayload) error {
+	allowCtx := privacy.DecisionContext(inv.Context, privacy.Allow)
+
+	f, ok, err := entityops.LoadEntity(allowCtx, inv.EntityID, inv.Client.File.Get)
+	if err != nil || !ok {
+		return err
+	}
+
+	if (f.StoragePath == "" && len(f.FileContents) == 0) || !extract.Supported(f.DetectedContentType, f.ProvidedFileExtension) {
+		return nil
+	}
+
+	ctx := logx.WithFields(inv.Context, map[string]any{"file_id": f.ID})
+
+	if scanner.ParseStatus(f.ScanStatus).Quarantined() {
+		return fulltext.RemoveDocument(allowCtx, inv.Client, f.ID)
+	}
+
+	indexed, found, err := fulltext.DocumentChecksum(allowCtx, inv.Client, f.ID)
+	if err != nil {
+		return err
+	}
+
+	if found && f.Md5Hash != "" && indexed == f.Md5Hash {
+		return nil
+	}
+
+	data, err := documentContents(allowCtx, inv.Client, f)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to download file for document content indexing")
+
+		return err
+	}
+
+	if data == nil {
+		logx.FromContext(ctx).Warn().Msg("object manager is nil, skipping document content indexing")
+
+		return nil
+	}
+
+	text, err := extract.Text(data, f.DetectedContentType, f.ProvidedFileExtension)
+	if err != nil {
+		// a document that cannot be read will not become readable on retry
+		logx.FromContext(ctx).Info().Err(err).Msg("skipping document content indexing, text could not be extracted")
+
+		return nil
+	}
+
+	checksum := f.Md5Hash
+	if checksum == "" {
+		sum := md5.Sum(data) //nolint:gosec
+		checksum = hex.EncodeToString(sum[:])
+	}
+
+	return fulltext.IndexDocument(allowCtx, inv.Client, fulltext.Document{
+		FileID:   f.ID,
+		Checksum: checksum,
+		Content:  text,
+	})
+}
+
+// documentContents returns the contents of the file, reading files kept in the database directly; nil is
+// returned when no object manager is configured to download the file
+func documentContents(ctx context.Context, client *generated.Client, f *generated.File) ([]byte, error) {
+	if storagetypes.ProviderType(f.StorageProvider) == storagetypes.DatabaseProvider && len(f.FileContents) > 0 {
+		return f.FileContents, nil
+	}
+
+	if client.ObjectManager == nil {
+		return nil, nil
+	}
+
+	downloaded, err := client.ObjectManager.Download(ctx, nil, interceptors.StorageFileFromEnt(f), &objects.DownloadOptions{
+		FileName:    f.ProvidedFileName,
+		ContentType: f.DetectedContentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return downloaded.File, nil
+}
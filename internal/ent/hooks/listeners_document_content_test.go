@@ -0,0 +1,30 @@
+package hooks
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/ent"
+	"gotest.tools/v3/assert"
+
+	"github.com/theopenlane/core/internal/ent/entityops"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/pkg/gala"
+)
+
+func TestRegisterGalaDocumentContentListeners(t *testing.T) {
+	t.Parallel()
+
+	runtime, err := gala.NewGala(context.Background(), gala.Config{DispatchMode: gala.DispatchModeInMemory, WorkerCount: 3})
+	assert.NilError(t, err)
+
+	ids, err := gala.Register(runtime, DocumentContentListeners()...)
+	assert.NilError(t, err)
+	assert.Equal(t, len(ids), 2)
+
+	// soft deletes never reach the cascade on the document table, so the listener has to remove the text
+	topic := entityops.MutationTopicName(entityops.MutationConcernDirect, generated.TypeFile)
+	assert.Check(t, runtime.InterestedIn(topic, entityops.OpSoftDelete))
+	assert.Check(t, runtime.InterestedIn(topic, ent.OpDeleteOne.String()))
+	assert.Check(t, runtime.InterestedIn(topic, ent.OpUpdateOne.String()))
+}
@@ -0,0 +1,88 @@
+package hooks
+
+import (
+	"context"
+
+	"github.com/theopenlane/core/internal/ent/entityops"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/control"
+	"github.com/theopenlane/core/internal/ent/generated/integrationrun"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/ent/generated/task"
+	"github.com/theopenlane/core/internal/ent/generated/workflowinstance"
+	"github.com/theopenlane/core/internal/graphsubscriptions"
+	"github.com/theopenlane/core/pkg/gala"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// objectOwnerLookup returns the organization owning the changed object
+type objectOwnerLookup func(ctx context.Context, client *generated.Client, id string) (string, error)
+
+// ObjectSubscriptionListeners publishes control, task, workflow instance and integration run changes to the
+// graphql subscription manager so subscribers receive them in real time
+func ObjectSubscriptionListeners() []gala.Registration {
+	return []gala.Registration{
+		objectSubscriptionListener(entityops.SchemaControl, graphsubscriptions.TopicControl,
+			func(ctx context.Context, client *generated.Client, id string) (string, error) {
+				return client.Control.Query().Where(control.ID(id)).Select(control.FieldOwnerID).String(ctx)
+			}),
+		objectSubscriptionListener(entityops.SchemaTask, graphsubscriptions.TopicTask,
+			func(ctx context.Context, client *generated.Client, id string) (string, error) {
+				return client.Task.Query().Where(task.ID(id)).Select(task.FieldOwnerID).String(ctx)
+			}),
+		objectSubscriptionListener(entityops.SchemaWorkflowInstance, graphsubscriptions.TopicWorkflowInstance,
+			func(ctx context.Context, client *generated.Client, id string) (string, error) {
+				return client.WorkflowInstance.Query().Where(workflowinstance.ID(id)).Select(workflowinstance.FieldOwnerID).String(ctx)
+			}),
+		objectSubscriptionListener(entityops.SchemaIntegrationRun, graphsubscriptions.TopicIntegrationRun,
+			func(ctx context.Context, client *generated.Client, id string) (string, error) {
+				return client.IntegrationRun.Query().Where(integrationrun.ID(id)).Select(integrationrun.FieldOwnerID).String(ctx)
+			}),
+	}
+}
+
+// objectSubscriptionListener builds the listener publishing the changes of a single schema. Deletes are not
+// published: the subscriptions send the changed object itself, which no longer exists, and soft deletes arrive as
+// updates of an object subscribers can no longer load, so they are skipped when the event is received
+func objectSubscriptionListener(schema *entityops.Schema, topic string, owner objectOwnerLookup) entityops.MutationListener {
+	return entityops.MutationListener{
+		Schema:     schema,
+		Operations: []string{entityops.OpCreate, entityops.OpUpdate, entityops.OpUpdateOne},
+		Caller:     internalOperationBypassCaller,
+		Handle: func(inv entityops.Invocation, payload entityops.MutationPayload) error {
+			return publishObjectChange(inv, payload, topic, owner)
+		},
+	}
+}
+
+// publishObjectChange publishes the change to the subscribers of the owning organization; the event only
+// names the object, subscribers reload it with their own permissions
+func publishObjectChange(inv entityops.Invocation, payload entityops.MutationPayload, topic string, owner objectOwnerLookup) error {
+	manager := graphsubscriptions.GetGlobalManager()
+	if manager == nil {
+		return nil
+	}
+
+	orgID, err := owner(privacy.DecisionContext(inv.Context, privacy.Allow), inv.Client, inv.EntityID)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return nil
+		}
+
+		logx.FromContext(inv.Context).Error().Err(err).Str("topic", topic).Str("id", inv.EntityID).Msg("failed to load owner of changed object for subscriptions")
+
+		return err
+	}
+
+	// system owned objects have no organization to route to
+	if orgID == "" {
+		return nil
+	}
+
+	return manager.PublishObject(graphsubscriptions.ObjectEvent{
+		Topic:     topic,
+		ID:        inv.EntityID,
+		OrgID:     orgID,
+		Operation: payload.Operation,
+	})
+}
@@ -0,0 +1,181 @@
+package hooks
+
+import (
+	"context"
+
+	"entgo.io/ent"
+	"github.com/theopenlane/iam/auth"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/file"
+	"github.com/theopenlane/core/internal/ent/generated/hook"
+	"github.com/theopenlane/core/internal/ent/privacy/utils"
+	"github.com/theopenlane/core/internal/metering"
+)
+
+// HookQuota rejects mutations that would take the usage of an organization past the hard limit of its plan
+// and logs mutations that pass the soft limit; it is registered on the client because the metered schemas
+// are spread across the graph
+func HookQuota() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			client := mutationClient(m)
+			if client == nil || !utils.ModulesEnabled(client) || skipQuota(ctx) {
+				return next.Mutate(ctx, m)
+			}
+
+			metric, orgID, delta, err := quotaUsage(ctx, m)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := metering.Enforce(ctx, client, orgID, metric, delta); err != nil {
+				return nil, err
+			}
+
+			return next.Mutate(ctx, m)
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// mutationClient returns the client of the metered mutations
+func mutationClient(m ent.Mutation) *generated.Client {
+	switch m := m.(type) {
+	case *generated.FileMutation:
+		return m.Client()
+	case *generated.OrgMembershipMutation:
+		return m.Client()
+	case *generated.IntegrationRunMutation:
+		return m.Client()
+	case *generated.ProgramMutation:
+		return m.Client()
+	default:
+		return nil
+	}
+}
+
+// skipQuota reports whether the request is made by the system rather than on behalf of an organization,
+// such as seeding a new organization or restoring an export
+func skipQuota(ctx context.Context) bool {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil {
+		return true
+	}
+
+	return caller.Has(auth.CapSystemAdmin) || caller.Has(auth.CapInternalOperation)
+}
+
+// quotaUsage returns the metric the mutation adds to, the organization it belongs to and how much it adds;
+// a zero delta means the mutation does not add to any metric
+func quotaUsage(ctx context.Context, m ent.Mutation) (metering.Metric, string, int64, error) {
+	callerOrg := ""
+	if caller, ok := auth.CallerFromContext(ctx); ok && caller != nil {
+		callerOrg = caller.OrganizationID
+	}
+
+	switch m := m.(type) {
+	case *generated.FileMutation:
+		return fileQuotaUsage(ctx, m, callerOrg)
+	case *generated.OrgMembershipMutation:
+		if !m.Op().Is(ent.OpCreate) {
+			return "", "", 0, nil
+		}
+
+		orgID, _ := m.OrganizationID()
+
+		return metering.MetricSeats, orgID, 1, nil
+	case *generated.IntegrationRunMutation:
+		if !m.Op().Is(ent.OpCreate) {
+			return "", "", 0, nil
+		}
+
+		orgID, ok := m.OwnerID()
+		if !ok {
+			orgID = callerOrg
+		}
+
+		return metering.MetricIntegrationRuns, orgID, 1, nil
+	case *generated.ProgramMutation:
+		return programQuotaUsage(ctx, m, callerOrg)
+	default:
+		return "", "", 0, nil
+	}
+}
+
+// fileQuotaUsage measures files by the size persisted in storage, which is set once the upload completes,
+// rather than the size provided by the client; updates add the growth of the persisted size
+func fileQuotaUsage(ctx context.Context, m *generated.FileMutation, callerOrg string) (metering.Metric, string, int64, error) {
+	size, ok := m.PersistedFileSize()
+	if !ok {
+		return "", "", 0, nil
+	}
+
+	orgID := callerOrg
+	if ids := m.OrganizationIDs(); len(ids) > 0 {
+		orgID = ids[0]
+	}
+
+	if m.Op().Is(ent.OpCreate) {
+		return metering.MetricStorageBytes, orgID, size, nil
+	}
+
+	previous, err := m.OldPersistedFileSize(ctx)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	if id, ok := m.ID(); ok {
+		if fileOrg, err := m.Client().File.Query().Where(file.ID(id)).QueryOrganization().FirstID(ctx); err == nil {
+			orgID = fileOrg
+		}
+	}
+
+	return metering.MetricStorageBytes, orgID, size - previous, nil
+}
+
+// programQuotaUsage counts programs that are created active or reopened after being completed or archived
+func programQuotaUsage(ctx context.Context, m *generated.ProgramMutation, callerOrg string) (metering.Metric, string, int64, error) {
+	status, statusSet := m.Status()
+	if statusSet && inactiveProgramStatus(status) {
+		return "", "", 0, nil
+	}
+
+	if m.Op().Is(ent.OpCreate) {
+		orgID, ok := m.OwnerID()
+		if !ok {
+			orgID = callerOrg
+		}
+
+		return metering.MetricActivePrograms, orgID, 1, nil
+	}
+
+	if !statusSet {
+		return "", "", 0, nil
+	}
+
+	previous, err := m.OldStatus(ctx)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	if !inactiveProgramStatus(previous) {
+		return "", "", 0, nil
+	}
+
+	orgID, err := m.OldOwnerID(ctx)
+	if err != nil {
+		return "", "", 0, err
+	}
+
+	if orgID == "" {
+		orgID = callerOrg
+	}
+
+	return metering.MetricActivePrograms, orgID, 1, nil
+}
+
+// inactiveProgramStatus reports whether programs in the status do not count towards the active programs quota
+func inactiveProgramStatus(status enums.ProgramStatus) bool {
+	return status == enums.ProgramStatusCompleted || status == enums.ProgramStatusArchived
+}
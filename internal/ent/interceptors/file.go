@@ -20,6 +20,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/generated/orgmembership"
 	"github.com/theopenlane/core/internal/ent/generated/privacy"
 	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
 	dbprovider "github.com/theopenlane/core/pkg/objects/storage/providers/database"
 	"github.com/theopenlane/core/pkg/objects/storage/proxy"
@@ -155,6 +156,7 @@ func StorageFileFromEnt(file *generated.File) *storagetypes.File {
 	storageFile := &storagetypes.File{
 		ID:           file.ID,
 		OriginalName: file.ProvidedFileName,
+		ScanStatus:   file.ScanStatus,
 		FileMetadata: storagetypes.FileMetadata{
 			Key:          file.StoragePath,
 			Bucket:       file.StorageVolume,
@@ -210,6 +212,11 @@ func setBase64(ctx context.Context, file *generated.File, q *generated.FileQuery
 		return nil
 	}
 
+	// database stored contents never pass through the object service, so the quarantine check runs here
+	if err := scanner.CheckAccess(file.ScanStatus); err != nil {
+		return err
+	}
+
 	if storagetypes.ProviderType(file.StorageProvider) == storagetypes.DatabaseProvider && len(file.FileContents) > 0 {
 		file.Base64 = base64.StdEncoding.EncodeToString(file.FileContents)
 		return nil
@@ -16,6 +16,7 @@ import (
 	"github.com/theopenlane/core/common/storagetypes"
 	"github.com/theopenlane/core/internal/ent/generated"
 	"github.com/theopenlane/core/internal/objects"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
 	"github.com/theopenlane/eddy"
 )
@@ -50,7 +51,7 @@ func (p *downloadProvider) GetScheme() *string {
 	return nil
 }
 
-func (p *downloadProvider) ListBuckets() ([]string, error) {
+func (p *downloadProvider) ListBuckets(context.Context) ([]string, error) {
 	return nil, nil
 }
 
@@ -154,6 +155,35 @@ func TestInterceptorPresignedURL_Base64DatabaseProvider(t *testing.T) {
 	assert.Equal(t, 0, provider.downloadCalls)
 }
 
+func TestInterceptorPresignedURL_Base64DatabaseProviderQuarantined(t *testing.T) {
+	provider := &downloadProvider{downloaded: []byte("downloaded-data")}
+	objService := newObjectManager(t, provider)
+	file := &generated.File{
+		ID:              "file-1",
+		StorageProvider: string(storagetypes.DatabaseProvider),
+		FileContents:    []byte("infected-contents"),
+		ScanStatus:      scanner.StatusInfected.String(),
+	}
+
+	query := &generated.FileQuery{}
+	query.ObjectManager = objService
+
+	intercepted := InterceptorPresignedURL().Intercept(generated.QuerierFunc(func(ctx context.Context, q generated.Query) (generated.Value, error) {
+		return []*generated.File{file}, nil
+	}))
+
+	ctx := graphqlContextWithSelection("base64")
+	result, err := intercepted.Query(ctx, query)
+	require.NoError(t, err)
+
+	files, ok := result.([]*generated.File)
+	require.True(t, ok)
+	require.Len(t, files, 1)
+
+	assert.Empty(t, files[0].Base64)
+	assert.ErrorIs(t, setBase64(ctx, file, query), scanner.ErrFileQuarantined)
+}
+
 func newObjectManager(t *testing.T, provider storage.Provider) *objects.Service {
 	t.Helper()
 
@@ -18,6 +18,7 @@ import (
 	"github.com/theopenlane/core/common/enums"
 	"github.com/theopenlane/core/common/models"
 	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/hooks"
 	"github.com/theopenlane/core/internal/ent/privacy/policy"
 )
 
@@ -248,6 +249,12 @@ func (Campaign) Fields() []ent.Field {
 		field.String("trust_center_id").
 			Comment("the trust center this campaign sends updates for, if any").
 			Optional(),
+		field.JSON("access_review", map[string]any{}).
+			Comment("the snapshot and decisions of the access review run by the campaign; only written by the access review workflow").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipAll),
+			),
 	}
 }
 
@@ -343,6 +350,13 @@ func (Campaign) Indexes() []ent.Index {
 	}
 }
 
+// Hooks of the Campaign
+func (Campaign) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookCampaignAccessReview(),
+	}
+}
+
 // Modules this schema has access to
 func (Campaign) Modules() []models.OrgModule {
 	return []models.OrgModule{
@@ -0,0 +1,147 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/gertd/go-pluralize"
+
+	"github.com/theopenlane/entx"
+	"github.com/theopenlane/entx/history"
+
+	"github.com/theopenlane/core/common/models"
+	fgamodel "github.com/theopenlane/core/fga/model"
+	"github.com/theopenlane/core/internal/ent/hooks"
+	"github.com/theopenlane/core/internal/ent/privacy/policy"
+)
+
+// CustomRole holds the schema definition for the CustomRole entity
+type CustomRole struct {
+	SchemaFuncs
+
+	ent.Schema
+}
+
+// SchemaCustomRole is the name of the schema in snake case
+const SchemaCustomRole = "custom_role"
+
+// Name is the name of the schema in snake case
+func (CustomRole) Name() string {
+	return SchemaCustomRole
+}
+
+// GetType returns the type of the schema
+func (CustomRole) GetType() any {
+	return CustomRole.Type
+}
+
+// PluralName returns the plural name of the schema
+func (CustomRole) PluralName() string {
+	return pluralize.NewClient().Plural(SchemaCustomRole)
+}
+
+// Fields of the CustomRole
+func (CustomRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("the name of the custom role").
+			Annotations(entgql.OrderField("name")).
+			SchemaType(map[string]string{
+				dialect.Postgres: "citext",
+			}).
+			NotEmpty(),
+		field.String("description").
+			Comment("the description of the custom role").
+			Optional(),
+		field.Strings("permissions").
+			Comment("the permission bundles (e.g. can_manage_policies) and object permissions (e.g. can_create_evidence) granted by the role").
+			Validate(validateCustomRolePermissions),
+		field.Strings("program_ids").
+			Comment("programs the role is scoped to; when the role is scoped its permissions are limited to can_view_program, can_edit_program, can_view_group, can_edit_group and can_delete_group, granted on the programs and groups of the scope instead of the organization").
+			Optional(),
+		field.Strings("group_ids").
+			Comment("groups the role is scoped to").
+			Optional(),
+	}
+}
+
+// validateCustomRolePermissions ensures each permission can be granted by a custom role
+func validateCustomRolePermissions(permissions []string) error {
+	if len(permissions) == 0 {
+		return ErrCustomRolePermissionsRequired
+	}
+
+	for _, permission := range permissions {
+		if _, err := fgamodel.CustomRoleRelation(permission); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Mixin of the CustomRole
+func (c CustomRole) Mixin() []ent.Mixin {
+	return mixinConfig{
+		excludeTags: true,
+		additionalMixins: []ent.Mixin{
+			newOrgOwnedMixin(c),
+		},
+	}.getMixins(c)
+}
+
+// Edges of the CustomRole
+func (CustomRole) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the CustomRole
+func (CustomRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", ownerFieldName).
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Annotations of the CustomRole
+func (CustomRole) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// custom roles are managed by organization admins, they are not granted through crud permissions
+		entx.FGACrudSkip(entx.SkipAll),
+		history.Annotations{
+			Exclude: true,
+		},
+	}
+}
+
+// Hooks of the CustomRole
+func (CustomRole) Hooks() []ent.Hook {
+	return []ent.Hook{
+		hooks.HookCustomRole(),
+	}
+}
+
+// Interceptors of the CustomRole
+func (CustomRole) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{}
+}
+
+// Modules this schema has access to
+func (CustomRole) Modules() []models.OrgModule {
+	return []models.OrgModule{
+		models.CatalogBaseModule,
+	}
+}
+
+// Policy of the CustomRole
+func (CustomRole) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithMutationRules(
+			policy.CheckOrgWriteAccess(),
+		),
+	)
+}
@@ -130,6 +130,14 @@ func (Entity) Fields() []ent.Field {
 			Annotations(
 				entgql.OrderField("soc2_period_end"),
 			),
+		field.Time("soc2_lapsed_at").
+			Comment("when the SOC 2 reporting period on file was found to have lapsed; cleared when the reporting period end changes").
+			GoType(models.DateTime{}).
+			Optional().
+			Nillable().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 		field.Time("contract_start_date").
 			Comment("start date for the entity contract").
 			GoType(models.DateTime{}).
@@ -430,6 +438,7 @@ func (Entity) Hooks() []ent.Hook {
 		hooks.HookEntityCreate(),
 		hooks.HookEntityFiles(),
 		hooks.HookEntityApprovedForUse(),
+		hooks.HookEntitySOC2PeriodEnd(),
 		hooks.HookEntityLogoFile(),
 	}
 }
@@ -16,4 +16,7 @@ var (
 
 	// ErrInternalServerError is returned when an error occurs that should not be exposed to the user and is not the user's fault, such as an error writing to the database or authz system
 	ErrInternalServerError = errors.New("internal server error")
+
+	// ErrCustomRolePermissionsRequired is returned when a custom role is created without any permissions
+	ErrCustomRolePermissionsRequired = errors.New("custom role requires at least one permission")
 )
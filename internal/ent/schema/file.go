@@ -108,6 +108,12 @@ func (File) Fields() []ent.Field {
 				entgql.OrderField("last_accessed_at"),
 			).
 			Nillable(),
+		field.String("scan_status").
+			Comment("the content scan status of the file; files that have not passed scanning are quarantined and cannot be downloaded").
+			Optional().
+			Annotations(
+				entgql.Skip(entgql.SkipMutationCreateInput, entgql.SkipMutationUpdateInput),
+			),
 	}
 }
 
@@ -215,5 +221,6 @@ func (f File) Policy() ent.Policy {
 func (File) Hooks() []ent.Hook {
 	return []ent.Hook{
 		hooks.HookFileDelete(),
+		hooks.HookFileRetention(),
 	}
 }
@@ -72,6 +72,8 @@ func newGroupPermissionsEdgesMixin(opts ...groupEdgesOptions) GroupPermissionsEd
 		opt(&g)
 	}
 
+	registerGroupPermissionSpecs(g.EdgeInfo)
+
 	return g
 }
 
@@ -80,6 +80,9 @@ type ObjectOwnedMixin struct {
 	// SkipParentContextTuple skips the parent context tuple creation for schemas that have owner_id but permissions
 	// are not based on the parent organization, and instead based on other parents
 	SkipParentContextTuple bool
+	// SkipTupleCheck excludes the schema from the tuple consistency checks, set when the default tuple hooks
+	// are replaced and the tuples of the object can no longer be computed from its fields
+	SkipTupleCheck bool
 }
 
 type HookFunc func(o ObjectOwnedMixin) ent.Hook
@@ -114,6 +117,8 @@ func newObjectOwnedMixin[V any](schema any, opts ...objectOwnedOption) ObjectOwn
 	// setup the correct interceptor
 	getObjectInterceptor[V](&o)
 
+	registerTupleSpec(o)
+
 	return o
 }
 
@@ -127,6 +132,8 @@ func withSkipTokenTypesObjects(tokens ...token.PrivacyToken) objectOwnedOption {
 // withHookFuncs allows to set custom hook functions
 func withHookFuncs(hookFuncs ...HookFunc) objectOwnedOption {
 	return func(o *ObjectOwnedMixin) {
+		o.SkipTupleCheck = true
+
 		if hookFuncs == nil {
 			o.HookFuncs = []HookFunc{}
 
@@ -218,6 +225,8 @@ func withOrganizationOwnerFieldOnly() objectOwnedOption {
 func withOrganizationOwnerServiceOnly() objectOwnedOption {
 	return func(o *ObjectOwnedMixin) {
 		o.IncludeOrganizationOwner = true
+		// the service only create hook does not write the parent_context tuple
+		o.SkipParentContextTuple = true
 
 		// Replace the default tuple update hook with a service-only version
 		// that skips creating user parent tuples (since users are not allowed as parent types)
@@ -614,6 +614,16 @@ func (o Organization) Edges() []ent.Edge {
 			edgeSchema:         VendorRiskScore{},
 			cascadeDeleteOwner: true,
 		}),
+		edgeToWithPagination(&edgeDefinition{
+			fromSchema:         o,
+			edgeSchema:         RetentionRule{},
+			cascadeDeleteOwner: true,
+		}),
+		edgeToWithPagination(&edgeDefinition{
+			fromSchema:         o,
+			edgeSchema:         CustomRole{},
+			cascadeDeleteOwner: true,
+		}),
 	}
 }
 
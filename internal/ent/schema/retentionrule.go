@@ -0,0 +1,124 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/gertd/go-pluralize"
+
+	"github.com/theopenlane/entx"
+
+	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/ent/privacy/policy"
+)
+
+// RetentionRule holds the schema definition for the RetentionRule entity
+type RetentionRule struct {
+	SchemaFuncs
+
+	ent.Schema
+}
+
+// SchemaRetentionRule is the name of the schema in snake case
+const SchemaRetentionRule = "retention_rule"
+
+// Name is the name of the schema in snake case
+func (RetentionRule) Name() string {
+	return SchemaRetentionRule
+}
+
+// GetType returns the type of the schema
+func (RetentionRule) GetType() any {
+	return RetentionRule.Type
+}
+
+// PluralName returns the plural name of the schema
+func (RetentionRule) PluralName() string {
+	return pluralize.NewClient().Plural(SchemaRetentionRule)
+}
+
+// Fields of the RetentionRule
+func (RetentionRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("the name of the retention rule, recorded in the metadata of the files it is applied to").
+			Annotations(entgql.OrderField("name")).
+			NotEmpty(),
+		field.String("description").
+			Comment("the description of the retention rule").
+			Optional(),
+		field.String("category").
+			Comment("the file category the rule applies to, e.g. evidence; empty matches any category").
+			Optional(),
+		field.String("object_type").
+			Comment("the type of object the file is attached to, e.g. evidence or procedure; empty matches any type").
+			Optional(),
+		field.Int("retention_days").
+			Comment("the number of days matching files are retained after upload").
+			Annotations(entgql.OrderField("retention_days")).
+			Positive(),
+		field.Bool("purge").
+			Comment("delete matching files once the retention period ends").
+			Default(false),
+	}
+}
+
+// Mixin of the RetentionRule
+func (r RetentionRule) Mixin() []ent.Mixin {
+	return mixinConfig{
+		excludeTags: true,
+		additionalMixins: []ent.Mixin{
+			newOrgOwnedMixin(r),
+		},
+	}.getMixins(r)
+}
+
+// Edges of the RetentionRule
+func (RetentionRule) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+// Indexes of the RetentionRule
+func (RetentionRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name", ownerFieldName).
+			Unique().Annotations(entsql.IndexWhere("deleted_at is NULL")),
+	}
+}
+
+// Annotations of the RetentionRule
+func (RetentionRule) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		// retention rules are managed by organization admins, they are not granted through crud permissions
+		entx.FGACrudSkip(entx.SkipAll),
+	}
+}
+
+// Hooks of the RetentionRule
+func (RetentionRule) Hooks() []ent.Hook {
+	return []ent.Hook{}
+}
+
+// Interceptors of the RetentionRule
+func (RetentionRule) Interceptors() []ent.Interceptor {
+	return []ent.Interceptor{}
+}
+
+// Modules this schema has access to
+func (RetentionRule) Modules() []models.OrgModule {
+	return []models.OrgModule{
+		models.CatalogBaseModule,
+	}
+}
+
+// Policy of the RetentionRule
+func (RetentionRule) Policy() ent.Policy {
+	return policy.NewPolicy(
+		policy.WithMutationRules(
+			policy.CheckOrgWriteAccess(),
+		),
+	)
+}
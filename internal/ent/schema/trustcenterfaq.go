@@ -68,6 +68,10 @@ func (TrustCenterFAQ) Fields() []ent.Field {
 			Default(0).
 			Annotations(entgql.OrderField("DISPLAY_ORDER")).
 			Optional(),
+		field.Bool("approved").
+			Comment("whether the FAQ answer is approved for reuse, e.g. when answering security questionnaires").
+			Default(false).
+			Optional(),
 	}
 }
 
@@ -0,0 +1,113 @@
+package schema
+
+import (
+	"slices"
+	"strings"
+	"sync"
+)
+
+// TupleSpec describes the parent tuples the object owned mixin hooks write for a schema, so the tuples
+// expected in fga can be computed back from the database
+type TupleSpec struct {
+	// Schema is the snake_case name of the schema, which is also its fga object type, e.g. internal_policy
+	Schema string
+	// Table is the name of the table holding the schema, e.g. internal_policies
+	Table string
+	// ParentContext is true when an organization:<owner_id> parent_context tuple is written for every object
+	ParentContext bool
+	// ParentFields are the fields holding the parents of the object, each written as a <parent>:<id> parent tuple
+	ParentFields []string
+}
+
+// GroupPermissionSpec describes the group permission edges of a schema; every group added to the edges is
+// written as a group:<id>#member editor, viewer or blocked tuple on the object
+type GroupPermissionSpec struct {
+	// Schema is the snake_case name of the schema, which is also its fga object type
+	Schema string
+	// ViewPermissions is true when the schema has the viewers edge in addition to editors and blocked groups
+	ViewPermissions bool
+}
+
+var (
+	tupleSpecsMu         sync.RWMutex
+	tupleSpecs           = map[string]TupleSpec{}
+	groupPermissionSpecs = map[string]GroupPermissionSpec{}
+)
+
+// registerTupleSpec records the tuples written by the object owned mixin; mixins are built each time the
+// schema is loaded so repeated registrations of the same schema are merged
+func registerTupleSpec(o ObjectOwnedMixin) {
+	if o.SkipTupleCheck || o.SchemaName == "" {
+		return
+	}
+
+	spec := TupleSpec{
+		Schema:        o.SchemaName,
+		Table:         o.Ref,
+		ParentContext: o.IncludeOrganizationOwner && !o.SkipParentContextTuple,
+	}
+
+	for _, f := range o.FieldNames {
+		if f == ownerFieldName || !strings.HasSuffix(f, "_id") {
+			continue
+		}
+
+		spec.ParentFields = append(spec.ParentFields, f)
+	}
+
+	tupleSpecsMu.Lock()
+	defer tupleSpecsMu.Unlock()
+
+	if existing, ok := tupleSpecs[spec.Schema]; ok {
+		spec.ParentContext = spec.ParentContext || existing.ParentContext
+		spec.ParentFields = append(spec.ParentFields, existing.ParentFields...)
+	}
+
+	slices.Sort(spec.ParentFields)
+	spec.ParentFields = slices.Compact(spec.ParentFields)
+
+	tupleSpecs[spec.Schema] = spec
+}
+
+// registerGroupPermissionSpecs records the schemas with group permission edges
+func registerGroupPermissionSpecs(edges []EdgeInfo) {
+	tupleSpecsMu.Lock()
+	defer tupleSpecsMu.Unlock()
+
+	for _, e := range edges {
+		name := toSchemaFuncs(e.Schema).Name()
+
+		groupPermissionSpecs[name] = GroupPermissionSpec{Schema: name, ViewPermissions: e.ViewPermissions}
+	}
+}
+
+// TupleSpecs returns the parent tuple specs of every loaded schema, sorted by schema name
+func TupleSpecs() []TupleSpec {
+	tupleSpecsMu.RLock()
+	defer tupleSpecsMu.RUnlock()
+
+	specs := make([]TupleSpec, 0, len(tupleSpecs))
+	for _, s := range tupleSpecs {
+		s.ParentFields = slices.Clone(s.ParentFields)
+		specs = append(specs, s)
+	}
+
+	slices.SortFunc(specs, func(a, b TupleSpec) int { return strings.Compare(a.Schema, b.Schema) })
+
+	return specs
+}
+
+// GroupPermissionSpecs returns the group permission specs of every loaded schema, sorted by schema name
+func GroupPermissionSpecs() []GroupPermissionSpec {
+	tupleSpecsMu.RLock()
+	defer tupleSpecsMu.RUnlock()
+
+	specs := make([]GroupPermissionSpec, 0, len(groupPermissionSpecs))
+	for _, s := range groupPermissionSpecs {
+		specs = append(specs, s)
+	}
+
+	slices.SortFunc(specs, func(a, b GroupPermissionSpec) int { return strings.Compare(a.Schema, b.Schema) })
+
+	return specs
+}
@@ -0,0 +1,139 @@
+package sensitivegen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"text/template"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"golang.org/x/tools/imports"
+)
+
+// credentialSetType is the type of the credential fields hush stores alongside secret values; they hold secrets
+// without being marked sensitive so they stay readable by the integrations using them
+const credentialSetType = "models.CredentialSet"
+
+// Generator emits the columns holding secrets, which the tenant export leaves out.
+type Generator struct {
+	SchemaPath string
+	OutputPath string
+	Package    string
+}
+
+// New creates a new sensitive column generator.
+func New(schemaPath, outputPath string) *Generator {
+	return &Generator{
+		SchemaPath: schemaPath,
+		OutputPath: outputPath,
+		Package:    "tenantexport",
+	}
+}
+
+// Hook allows the generator to run in the post-generation hook pipeline.
+func (g *Generator) Hook() gen.Hook {
+	return func(next gen.Generator) gen.Generator {
+		return gen.GenerateFunc(func(graph *gen.Graph) error {
+			if err := next.Generate(graph); err != nil {
+				return err
+			}
+
+			return g.generateFromGraph(graph)
+		})
+	}
+}
+
+const generatedFileTemplate = `// Code generated by sensitivegen. DO NOT EDIT.
+
+package {{.Package}}
+
+// sensitiveColumns maps tables, and the history tables recorded for them, to the columns of sensitive fields and
+// fields holding credentials.
+var sensitiveColumns = map[string][]string{
+{{- range .Entries }}
+	"{{ .Table }}": { {{- range $i, $c := .Columns }}{{ if $i }}, {{ end }}"{{ $c }}"{{ end -}} },
+{{- end }}
+}
+`
+
+type entry struct {
+	Table   string
+	Columns []string
+}
+
+// Generate reads the schema fields and writes the generated lookup file.
+func (g *Generator) Generate(flags ...string) error {
+	graph, err := entc.LoadGraph(g.SchemaPath, &gen.Config{
+		BuildFlags: flags,
+	})
+	if err != nil {
+		return fmt.Errorf("loading graph: %w", err)
+	}
+
+	return g.generateFromGraph(graph)
+}
+
+func (g *Generator) generateFromGraph(graph *gen.Graph) error {
+	entries := make([]entry, 0)
+
+	for _, node := range graph.Nodes {
+		var columns []string
+
+		for _, f := range node.Fields {
+			if f.Sensitive() || (f.Type != nil && f.Type.Ident == credentialSetType) {
+				columns = append(columns, f.StorageKey())
+			}
+		}
+
+		if len(columns) == 0 {
+			continue
+		}
+
+		slices.Sort(columns)
+
+		entries = append(entries,
+			entry{Table: node.Table(), Columns: columns},
+			entry{Table: gen.Funcs["snake"].(func(string) string)(node.Name) + "_history", Columns: columns},
+		)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Table < entries[j].Table
+	})
+
+	tmpl, err := template.New("generated").Parse(generatedFileTemplate)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Create(g.OutputPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct {
+		Package string
+		Entries []entry
+	}{
+		Package: g.Package,
+		Entries: entries,
+	}); err != nil {
+		return err
+	}
+
+	formatted, err := imports.Process(g.OutputPath, buf.Bytes(), nil)
+	if err != nil {
+		return fmt.Errorf("%w: failed to format file", err)
+	}
+
+	if _, err := file.Write(formatted); err != nil {
+		return err
+	}
+
+	return nil
+}
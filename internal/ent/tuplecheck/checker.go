@@ -0,0 +1,359 @@
+package tuplecheck
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/schema"
+)
+
+const (
+	// DefaultBatchSize is the number of tuples written or deleted per repair request
+	DefaultBatchSize = 50
+	// maxBatchSize is the maximum number of tuple writes and deletes OpenFGA accepts in one request
+	maxBatchSize = 100
+)
+
+// Checker compares the tuples expected from the records of an organization with the tuples stored in OpenFGA
+type Checker struct {
+	source     Source
+	store      Store
+	specs      []schema.TupleSpec
+	groupSpecs []schema.GroupPermissionSpec
+	schemas    map[string]bool
+}
+
+// Option configures the checker
+type Option func(*Checker)
+
+// WithSchemas limits the check to the given schemas, e.g. control or internal_policy
+func WithSchemas(names ...string) Option {
+	return func(c *Checker) {
+		for _, n := range names {
+			if n = strings.TrimSpace(n); n != "" {
+				c.schemas[n] = true
+			}
+		}
+	}
+}
+
+// WithSpecs replaces the specs registered by the schema mixins
+func WithSpecs(specs []schema.TupleSpec, groupSpecs []schema.GroupPermissionSpec) Option {
+	return func(c *Checker) {
+		c.specs = specs
+		c.groupSpecs = groupSpecs
+	}
+}
+
+// New returns a checker reading records from the source and tuples from the store
+func New(source Source, store Store, opts ...Option) (*Checker, error) {
+	if source == nil {
+		return nil, ErrSourceRequired
+	}
+
+	if store == nil {
+		return nil, ErrStoreRequired
+	}
+
+	c := &Checker{
+		source:     source,
+		store:      store,
+		specs:      schema.TupleSpecs(),
+		groupSpecs: schema.GroupPermissionSpecs(),
+		schemas:    map[string]bool{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// Report lists the drift found between the records of an organization and OpenFGA
+type Report struct {
+	// OrganizationID is the organization that was checked
+	OrganizationID string `json:"organizationID"`
+	// Schemas is the number of schemas checked
+	Schemas int `json:"schemas"`
+	// Objects is the number of objects whose tuples were compared
+	Objects int `json:"objects"`
+	// Missing are the tuples expected from the records but not found in OpenFGA
+	Missing []Tuple `json:"missing"`
+	// Orphaned are the tuples found in OpenFGA for records or edges that no longer exist
+	Orphaned []Tuple `json:"orphaned"`
+}
+
+// Consistent reports whether no drift was found
+func (r *Report) Consistent() bool {
+	return len(r.Missing) == 0 && len(r.Orphaned) == 0
+}
+
+// target is everything checked for one schema
+type target struct {
+	spec   schema.TupleSpec
+	groups *schema.GroupPermissionSpec
+}
+
+// managed reports whether the tuple is one the mixins of the schema write, only those are compared; tuples
+// written elsewhere, e.g. the creator of an object, can't be computed from the records
+func (t target) managed(tuple Tuple) bool {
+	subjectType, _, _ := strings.Cut(tuple.Subject, ":")
+
+	switch tuple.Relation {
+	case fgax.ParentContextRelation:
+		return t.spec.ParentContext && subjectType == generated.TypeOrganization
+	case fgax.ParentRelation:
+		return slices.Contains(t.parentTypes(), subjectType)
+	case fgax.EditorRelation.String(), fgax.BlockedRelation.String():
+		return t.groups != nil && isGroupMembers(tuple.Subject)
+	case fgax.ViewerRelation.String():
+		return t.groups != nil && t.groups.ViewPermissions && isGroupMembers(tuple.Subject)
+	}
+
+	return false
+}
+
+// parentTypes returns the subject types of the parent tuples of the schema
+func (t target) parentTypes() []string {
+	types := make([]string, 0, len(t.spec.ParentFields))
+	for _, f := range t.spec.ParentFields {
+		types = append(types, parentType(f))
+	}
+
+	return types
+}
+
+// parentType returns the fga type of a parent field, e.g. program for program_id
+func parentType(field string) string {
+	return strings.TrimSuffix(field, "_id")
+}
+
+// isGroupMembers reports whether the subject is the members of a group, e.g. group:01HXYZ#member
+func isGroupMembers(subject string) bool {
+	return strings.HasPrefix(subject, generated.TypeGroup+":") && strings.HasSuffix(subject, "#"+fgax.MemberRelation)
+}
+
+// targets merges the parent and group permission specs into one target per schema
+func (c *Checker) targets() []target {
+	bySchema := map[string]*target{}
+
+	for _, s := range c.specs {
+		bySchema[s.Schema] = &target{spec: s}
+	}
+
+	for _, g := range c.groupSpecs {
+		t, ok := bySchema[g.Schema]
+		if !ok {
+			t = &target{spec: schema.TupleSpec{Schema: g.Schema}}
+			bySchema[g.Schema] = t
+		}
+
+		t.groups = &g
+	}
+
+	targets := make([]target, 0, len(bySchema))
+
+	for name, t := range bySchema {
+		if len(c.schemas) > 0 && !c.schemas[name] {
+			continue
+		}
+
+		targets = append(targets, *t)
+	}
+
+	slices.SortFunc(targets, func(a, b target) int { return cmp.Compare(a.spec.Schema, b.spec.Schema) })
+
+	return targets
+}
+
+// Check compares the tuples of every checked schema for the organization
+func (c *Checker) Check(ctx context.Context, orgID string) (*Report, error) {
+	if orgID == "" {
+		return nil, ErrOrganizationRequired
+	}
+
+	report := &Report{OrganizationID: orgID, Missing: []Tuple{}, Orphaned: []Tuple{}}
+
+	for _, t := range c.targets() {
+		if err := c.checkTarget(ctx, orgID, t, report); err != nil {
+			return nil, err
+		}
+	}
+
+	sortTuples(report.Missing)
+	sortTuples(report.Orphaned)
+
+	return report, nil
+}
+
+// checkTarget compares the tuples of one schema and adds the drift to the report
+func (c *Checker) checkTarget(ctx context.Context, orgID string, t target, report *Report) error {
+	if !t.spec.ParentContext && len(t.spec.ParentFields) == 0 && t.groups == nil {
+		return nil
+	}
+
+	objects, scoped, err := c.source.Objects(ctx, orgID, t.spec)
+	if err != nil {
+		return err
+	}
+
+	// records not scoped to an organization can't be checked for one
+	if !scoped {
+		return nil
+	}
+
+	expected := map[string]map[Tuple]bool{}
+
+	for _, obj := range objects {
+		object := t.spec.Schema + ":" + obj.ID
+		tuples := map[Tuple]bool{}
+
+		if t.spec.ParentContext {
+			tuples[Tuple{Subject: generated.TypeOrganization + ":" + orgID, Relation: fgax.ParentContextRelation, Object: object}] = true
+		}
+
+		for field, id := range obj.Parents {
+			tuples[Tuple{Subject: parentType(field) + ":" + id, Relation: fgax.ParentRelation, Object: object}] = true
+		}
+
+		expected[object] = tuples
+	}
+
+	if t.groups != nil {
+		grants, err := c.source.GroupGrants(ctx, orgID, *t.groups)
+		if err != nil {
+			return err
+		}
+
+		for _, g := range grants {
+			object := t.spec.Schema + ":" + g.ObjectID
+			if _, ok := expected[object]; !ok {
+				expected[object] = map[Tuple]bool{}
+			}
+
+			expected[object][Tuple{
+				Subject:  generated.TypeGroup + ":" + g.GroupID + "#" + fgax.MemberRelation,
+				Relation: g.Relation.String(),
+				Object:   object,
+			}] = true
+		}
+	}
+
+	// objects that were deleted from the database but still have the organization tuple in OpenFGA
+	if t.spec.ParentContext {
+		stored, err := c.store.ReadSubject(ctx, generated.TypeOrganization+":"+orgID, fgax.ParentContextRelation, t.spec.Schema)
+		if err != nil {
+			return err
+		}
+
+		for _, s := range stored {
+			if _, ok := expected[s.Object]; !ok {
+				expected[s.Object] = nil
+			}
+		}
+	}
+
+	report.Schemas++
+
+	for _, object := range sortedKeys(expected) {
+		stored, err := c.store.ReadObject(ctx, object)
+		if err != nil {
+			return err
+		}
+
+		want := expected[object]
+		found := map[Tuple]bool{}
+
+		for _, s := range stored {
+			if !t.managed(s) {
+				continue
+			}
+
+			found[s] = true
+
+			if !want[s] {
+				report.Orphaned = append(report.Orphaned, s)
+			}
+		}
+
+		for w := range want {
+			if !found[w] {
+				report.Missing = append(report.Missing, w)
+			}
+		}
+
+		report.Objects++
+	}
+
+	return nil
+}
+
+// RepairResult summarizes a repair of the drift in a report
+type RepairResult struct {
+	// Written is the number of missing tuples written
+	Written int `json:"written"`
+	// Deleted is the number of orphaned tuples deleted
+	Deleted int `json:"deleted"`
+	// Batches is the number of write requests sent, or that would be sent on a dry run
+	Batches int `json:"batches"`
+	// DryRun is true when nothing was written
+	DryRun bool `json:"dryRun"`
+}
+
+// Repair writes the missing tuples of the report and deletes the orphaned ones in batches of at most batchSize
+// tuples; on a dry run the batches are only counted
+func (c *Checker) Repair(ctx context.Context, report *Report, batchSize int, dryRun bool) (*RepairResult, error) {
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	batchSize = min(batchSize, maxBatchSize)
+
+	result := &RepairResult{DryRun: dryRun}
+
+	writes, deletes := report.Missing, report.Orphaned
+
+	for len(writes) > 0 || len(deletes) > 0 {
+		w := writes[:min(batchSize, len(writes))]
+		d := deletes[:min(batchSize-len(w), len(deletes))]
+
+		if !dryRun {
+			if err := c.store.Write(ctx, w, d); err != nil {
+				return result, err
+			}
+		}
+
+		writes, deletes = writes[len(w):], deletes[len(d):]
+
+		result.Written += len(w)
+		result.Deleted += len(d)
+		result.Batches++
+	}
+
+	return result, nil
+}
+
+// sortTuples orders tuples by object, relation and subject
+func sortTuples(tuples []Tuple) {
+	slices.SortFunc(tuples, func(a, b Tuple) int {
+		return cmp.Or(cmp.Compare(a.Object, b.Object), cmp.Compare(a.Relation, b.Relation), cmp.Compare(a.Subject, b.Subject))
+	})
+}
+
+// sortedKeys returns the keys of the map in order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
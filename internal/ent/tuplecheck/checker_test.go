@@ -0,0 +1,213 @@
+package tuplecheck
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/ent/schema"
+)
+
+// fakeSource returns fixed records per schema
+type fakeSource struct {
+	objects map[string][]Object
+	grants  map[string][]GroupGrant
+}
+
+func (f *fakeSource) Objects(_ context.Context, _ string, spec schema.TupleSpec) ([]Object, bool, error) {
+	objects, ok := f.objects[spec.Schema]
+
+	return objects, ok, nil
+}
+
+func (f *fakeSource) GroupGrants(_ context.Context, _ string, spec schema.GroupPermissionSpec) ([]GroupGrant, error) {
+	return f.grants[spec.Schema], nil
+}
+
+// fakeStore keeps tuples in memory and records the write requests
+type fakeStore struct {
+	tuples  []Tuple
+	batches [][2][]Tuple
+}
+
+func (f *fakeStore) ReadObject(_ context.Context, object string) ([]Tuple, error) {
+	var out []Tuple
+
+	for _, t := range f.tuples {
+		if t.Object == object {
+			out = append(out, t)
+		}
+	}
+
+	return out, nil
+}
+
+func (f *fakeStore) ReadSubject(_ context.Context, subject, relation, objectType string) ([]Tuple, error) {
+	var out []Tuple
+
+	for _, t := range f.tuples {
+		if t.Subject == subject && t.Relation == relation && strings.HasPrefix(t.Object, objectType+":") {
+			out = append(out, t)
+		}
+	}
+
+	return out, nil
+}
+
+func (f *fakeStore) Write(_ context.Context, writes, deletes []Tuple) error {
+	f.batches = append(f.batches, [2][]Tuple{writes, deletes})
+
+	return nil
+}
+
+func testSpecs() ([]schema.TupleSpec, []schema.GroupPermissionSpec) {
+	return []schema.TupleSpec{
+		{Schema: "control", Table: "controls", ParentContext: true},
+		{Schema: "subcontrol", Table: "subcontrols", ParentContext: true, ParentFields: []string{"control_id"}},
+		{Schema: "global_thing", Table: "global_things", ParentContext: true},
+	}, []schema.GroupPermissionSpec{
+		{Schema: "control", ViewPermissions: false},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	source := &fakeSource{
+		objects: map[string][]Object{
+			"control":    {{ID: "c1"}, {ID: "c2"}},
+			"subcontrol": {{ID: "s1", Parents: map[string]string{"control_id": "c2"}}},
+		},
+		grants: map[string][]GroupGrant{
+			"control": {{ObjectID: "c1", GroupID: "g1", Relation: fgax.EditorRelation}},
+		},
+	}
+
+	store := &fakeStore{tuples: []Tuple{
+		// consistent
+		{Subject: "organization:o1", Relation: "parent_context", Object: "control:c1"},
+		{Subject: "group:g1#member", Relation: "editor", Object: "control:c1"},
+		// not managed by the mixins, ignored
+		{Subject: "user:u1", Relation: "editor", Object: "control:c1"},
+		// group removed from the editors edge
+		{Subject: "group:g2#member", Relation: "editor", Object: "control:c2"},
+		// subcontrol moved from c1 to c2
+		{Subject: "organization:o1", Relation: "parent_context", Object: "subcontrol:s1"},
+		{Subject: "control:c1", Relation: "parent", Object: "subcontrol:s1"},
+		// control deleted from the database
+		{Subject: "organization:o1", Relation: "parent_context", Object: "control:c3"},
+		{Subject: "group:g1#member", Relation: "blocked", Object: "control:c3"},
+		// schema not scoped to an organization, skipped
+		{Subject: "organization:o1", Relation: "parent_context", Object: "global_thing:x1"},
+	}}
+
+	specs, groupSpecs := testSpecs()
+
+	checker, err := New(source, store, WithSpecs(specs, groupSpecs))
+	require.NoError(t, err)
+
+	report, err := checker.Check(context.Background(), "o1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.Schemas)
+	assert.Equal(t, 4, report.Objects)
+	assert.False(t, report.Consistent())
+
+	assert.Equal(t, []Tuple{
+		{Subject: "organization:o1", Relation: "parent_context", Object: "control:c2"},
+		{Subject: "control:c2", Relation: "parent", Object: "subcontrol:s1"},
+	}, report.Missing)
+
+	assert.Equal(t, []Tuple{
+		{Subject: "group:g2#member", Relation: "editor", Object: "control:c2"},
+		{Subject: "group:g1#member", Relation: "blocked", Object: "control:c3"},
+		{Subject: "organization:o1", Relation: "parent_context", Object: "control:c3"},
+		{Subject: "control:c1", Relation: "parent", Object: "subcontrol:s1"},
+	}, report.Orphaned)
+
+	_, err = checker.Check(context.Background(), "")
+	assert.ErrorIs(t, err, ErrOrganizationRequired)
+}
+
+func TestCheckWithSchemas(t *testing.T) {
+	source := &fakeSource{objects: map[string][]Object{"control": {{ID: "c1"}}, "subcontrol": {{ID: "s1"}}}}
+	specs, groupSpecs := testSpecs()
+
+	checker, err := New(source, &fakeStore{}, WithSpecs(specs, groupSpecs), WithSchemas("subcontrol"))
+	require.NoError(t, err)
+
+	report, err := checker.Check(context.Background(), "o1")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.Schemas)
+	assert.Equal(t, []Tuple{{Subject: "organization:o1", Relation: "parent_context", Object: "subcontrol:s1"}}, report.Missing)
+}
+
+func TestRepair(t *testing.T) {
+	report := &Report{
+		Missing: []Tuple{
+			{Subject: "organization:o1", Relation: "parent_context", Object: "control:c1"},
+			{Subject: "organization:o1", Relation: "parent_context", Object: "control:c2"},
+			{Subject: "organization:o1", Relation: "parent_context", Object: "control:c3"},
+		},
+		Orphaned: []Tuple{
+			{Subject: "organization:o1", Relation: "parent_context", Object: "control:c4"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		dryRun      bool
+		batchSize   int
+		expectCalls int
+		expect      RepairResult
+	}{
+		{
+			name:        "dry run",
+			dryRun:      true,
+			batchSize:   2,
+			expectCalls: 0,
+			expect:      RepairResult{Written: 3, Deleted: 1, Batches: 2, DryRun: true},
+		},
+		{
+			name:        "batches",
+			batchSize:   2,
+			expectCalls: 2,
+			expect:      RepairResult{Written: 3, Deleted: 1, Batches: 2},
+		},
+		{
+			name:        "default batch size",
+			expectCalls: 1,
+			expect:      RepairResult{Written: 3, Deleted: 1, Batches: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &fakeStore{}
+
+			checker, err := New(&fakeSource{}, store, WithSpecs(testSpecs()))
+			require.NoError(t, err)
+
+			result, err := checker.Repair(context.Background(), report, tc.batchSize, tc.dryRun)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expect, *result)
+			assert.Len(t, store.batches, tc.expectCalls)
+
+			for _, b := range store.batches {
+				assert.LessOrEqual(t, len(b[0])+len(b[1]), max(tc.batchSize, DefaultBatchSize))
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(nil, &fakeStore{})
+	assert.ErrorIs(t, err, ErrSourceRequired)
+
+	_, err = New(&fakeSource{}, nil)
+	assert.ErrorIs(t, err, ErrStoreRequired)
+}
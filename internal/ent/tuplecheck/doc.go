@@ -0,0 +1,6 @@
+// Package tuplecheck finds drift between the records of an organization and the authorization tuples stored in
+// OpenFGA. The tuples every record is expected to have are computed from the object owned and group permission
+// mixins of its schema: the organization parent_context tuple, the parent tuples of its parent fields and the
+// editor, viewer and blocked tuples of the groups on its permission edges. Tuples missing from OpenFGA and tuples
+// left behind for records or edges that no longer exist are reported, and can be repaired in batches
+package tuplecheck
@@ -0,0 +1,14 @@
+package tuplecheck
+
+import "errors"
+
+var (
+	// ErrOrganizationRequired is returned when a check is started without an organization id
+	ErrOrganizationRequired = errors.New("organization id is required to check tuples")
+	// ErrSourceRequired is returned when the checker is created without a record source
+	ErrSourceRequired = errors.New("record source is required to check tuples")
+	// ErrStoreRequired is returned when the checker is created without a tuple store
+	ErrStoreRequired = errors.New("tuple store is required to check tuples")
+	// ErrInvalidTuple is returned when a tuple cannot be converted to an fga tuple key
+	ErrInvalidTuple = errors.New("invalid tuple")
+)
@@ -0,0 +1,270 @@
+package tuplecheck
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	entschema "entgo.io/ent/dialect/sql/schema"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/ent/generated/migrate"
+	"github.com/theopenlane/core/internal/ent/schema"
+)
+
+const (
+	// ownerColumn scopes the records of a table to an organization
+	ownerColumn = "owner_id"
+	// softDeleteColumn is set on records removed by the soft-delete mixin
+	softDeleteColumn = "deleted_at"
+	// groupColumn is the column holding the group of a permission edge join table
+	groupColumn = "group_id"
+	// groupsTable holds the groups, used to skip grants of deleted groups
+	groupsTable = "groups"
+)
+
+// permissionEdges maps the suffix of the group permission join tables to the relation they grant
+var permissionEdges = []struct {
+	suffix   string
+	relation fgax.Relation
+	view     bool
+}{
+	{suffix: "_editors", relation: fgax.EditorRelation},
+	{suffix: "_blocked_groups", relation: fgax.BlockedRelation},
+	{suffix: "_viewers", relation: fgax.ViewerRelation, view: true},
+}
+
+// Object is a live record of an organization along with the ids of its parents
+type Object struct {
+	// ID is the id of the record
+	ID string
+	// Parents are the parent ids keyed by parent field, e.g. program_id; unset parents are omitted
+	Parents map[string]string
+}
+
+// GroupGrant is a group added to a permission edge of a record
+type GroupGrant struct {
+	// ObjectID is the id of the record
+	ObjectID string
+	// GroupID is the id of the group
+	GroupID string
+	// Relation is the relation the edge grants to the members of the group
+	Relation fgax.Relation
+}
+
+// Source reads the records the expected tuples are computed from
+type Source interface {
+	// Objects returns the live records of the organization for the schema, along with false when the table of
+	// the schema is unknown or not scoped to an organization
+	Objects(ctx context.Context, orgID string, spec schema.TupleSpec) ([]Object, bool, error)
+	// GroupGrants returns the groups added to the permission edges of the live records of the organization
+	GroupGrants(ctx context.Context, orgID string, spec schema.GroupPermissionSpec) ([]GroupGrant, error)
+}
+
+// sqlSource reads records straight from the database tables of the ent schemas
+type sqlSource struct {
+	drv dialect.ExecQuerier
+}
+
+// NewSQLSource returns a Source reading records through the database driver, e.g. the Driver() of the ent client
+func NewSQLSource(drv dialect.ExecQuerier) Source {
+	return &sqlSource{drv: drv}
+}
+
+var (
+	tablesOnce   sync.Once
+	tablesByName map[string]*entschema.Table
+)
+
+// table returns the table with the given name from the ent migration schema
+func table(name string) (*entschema.Table, bool) {
+	tablesOnce.Do(func() {
+		tablesByName = make(map[string]*entschema.Table, len(migrate.Tables))
+		for _, t := range migrate.Tables {
+			tablesByName[t.Name] = t
+		}
+	})
+
+	t, ok := tablesByName[name]
+
+	return t, ok
+}
+
+// hasColumn reports whether the table has the column
+func hasColumn(t *entschema.Table, name string) bool {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// objectTable resolves the table of the schema, falling back to the table referenced by its editors join
+// table for schemas only known through their group permission edges
+func objectTable(spec schema.TupleSpec) (*entschema.Table, bool) {
+	if spec.Table != "" {
+		return table(spec.Table)
+	}
+
+	join, ok := table(spec.Schema + permissionEdges[0].suffix)
+	if !ok || len(join.ForeignKeys) == 0 {
+		return nil, false
+	}
+
+	for _, fk := range join.ForeignKeys {
+		if fk.RefTable != nil && fk.RefTable.Name != groupsTable {
+			return fk.RefTable, true
+		}
+	}
+
+	return nil, false
+}
+
+// liveFilter returns the condition skipping soft deleted records of the table under the alias
+func liveFilter(t *entschema.Table, alias string) string {
+	if !hasColumn(t, softDeleteColumn) {
+		return ""
+	}
+
+	return fmt.Sprintf(" AND %s.%s IS NULL", alias, quote(softDeleteColumn))
+}
+
+// Objects satisfies the Source interface
+func (s *sqlSource) Objects(ctx context.Context, orgID string, spec schema.TupleSpec) ([]Object, bool, error) {
+	t, ok := objectTable(spec)
+	if !ok || !hasColumn(t, ownerColumn) {
+		return nil, false, nil
+	}
+
+	// parent fields written through edges rather than columns of the table are not checked
+	parents := make([]string, 0, len(spec.ParentFields))
+	columns := []string{"o.id"}
+
+	for _, f := range spec.ParentFields {
+		if hasColumn(t, f) {
+			parents = append(parents, f)
+			columns = append(columns, "o."+quote(f))
+		}
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s o WHERE o.%s = $1%s ORDER BY o.id",
+		strings.Join(columns, ", "), quote(t.Name), quote(ownerColumn), liveFilter(t, "o"))
+
+	var rows entsql.Rows
+	if err := s.drv.Query(ctx, query, []any{orgID}, &rows); err != nil {
+		return nil, false, fmt.Errorf("querying %s: %w", t.Name, err)
+	}
+
+	defer rows.Close()
+
+	var objects []Object
+
+	for rows.Next() {
+		values := make([]sql.NullString, len(columns))
+		dest := make([]any, len(columns))
+
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, false, fmt.Errorf("scanning %s: %w", t.Name, err)
+		}
+
+		obj := Object{ID: values[0].String, Parents: map[string]string{}}
+
+		for i, f := range parents {
+			if v := values[i+1]; v.Valid && v.String != "" {
+				obj.Parents[f] = v.String
+			}
+		}
+
+		objects = append(objects, obj)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, false, err
+	}
+
+	return objects, true, nil
+}
+
+// GroupGrants satisfies the Source interface
+func (s *sqlSource) GroupGrants(ctx context.Context, orgID string, spec schema.GroupPermissionSpec) ([]GroupGrant, error) {
+	var grants []GroupGrant
+
+	for _, edge := range permissionEdges {
+		if edge.view && !spec.ViewPermissions {
+			continue
+		}
+
+		join, ok := table(spec.Schema + edge.suffix)
+		if !ok {
+			continue
+		}
+
+		var (
+			objectColumn string
+			objTable     *entschema.Table
+		)
+
+		for _, fk := range join.ForeignKeys {
+			if fk.RefTable != nil && fk.RefTable.Name != groupsTable && len(fk.Columns) == 1 {
+				objectColumn, objTable = fk.Columns[0].Name, fk.RefTable
+			}
+		}
+
+		if objTable == nil || !hasColumn(objTable, ownerColumn) {
+			continue
+		}
+
+		groupFilter := ""
+		if groups, ok := table(groupsTable); ok {
+			groupFilter = liveFilter(groups, "g")
+		}
+
+		query := fmt.Sprintf(`SELECT j.%[1]s, j.%[2]s FROM %[3]s j
+			JOIN %[4]s o ON o.id = j.%[1]s
+			JOIN %[5]s g ON g.id = j.%[2]s
+			WHERE o.%[6]s = $1%[7]s%[8]s ORDER BY j.%[1]s, j.%[2]s`,
+			quote(objectColumn), quote(groupColumn), quote(join.Name), quote(objTable.Name), quote(groupsTable),
+			quote(ownerColumn), liveFilter(objTable, "o"), groupFilter)
+
+		var rows entsql.Rows
+		if err := s.drv.Query(ctx, query, []any{orgID}, &rows); err != nil {
+			return nil, fmt.Errorf("querying %s: %w", join.Name, err)
+		}
+
+		for rows.Next() {
+			grant := GroupGrant{Relation: edge.relation}
+			if err := rows.Scan(&grant.ObjectID, &grant.GroupID); err != nil {
+				rows.Close()
+
+				return nil, fmt.Errorf("scanning %s: %w", join.Name, err)
+			}
+
+			grants = append(grants, grant)
+		}
+
+		if err := rows.Err(); err != nil {
+			rows.Close()
+
+			return nil, err
+		}
+
+		rows.Close()
+	}
+
+	return grants, nil
+}
+
+// quote quotes an identifier for use in a query
+func quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
@@ -0,0 +1,145 @@
+package tuplecheck
+
+import (
+	"context"
+	"fmt"
+
+	ofgaclient "github.com/openfga/go-sdk/client"
+	"github.com/theopenlane/iam/fgax"
+)
+
+// readPageSize is the number of tuples requested per read call, the maximum allowed by OpenFGA
+const readPageSize int32 = 100
+
+// Tuple is a relationship tuple in its OpenFGA string form
+type Tuple struct {
+	// Subject is the user of the tuple, e.g. organization:01HXYZ or group:01HXYZ#member
+	Subject string `json:"subject"`
+	// Relation is the relation of the tuple, e.g. parent_context
+	Relation string `json:"relation"`
+	// Object is the object of the tuple, e.g. control:01HABC
+	Object string `json:"object"`
+}
+
+// String returns the tuple as subject relation object
+func (t Tuple) String() string {
+	return fmt.Sprintf("%s %s %s", t.Subject, t.Relation, t.Object)
+}
+
+// TupleKey converts the tuple to an fgax tuple key
+func (t Tuple) TupleKey() (fgax.TupleKey, error) {
+	subject, err := fgax.ParseEntity(t.Subject)
+	if err != nil {
+		return fgax.TupleKey{}, fmt.Errorf("%w: %s: %w", ErrInvalidTuple, t, err)
+	}
+
+	object, err := fgax.ParseEntity(t.Object)
+	if err != nil {
+		return fgax.TupleKey{}, fmt.Errorf("%w: %s: %w", ErrInvalidTuple, t, err)
+	}
+
+	return fgax.TupleKey{
+		Subject:  subject,
+		Object:   object,
+		Relation: fgax.Relation(t.Relation),
+	}, nil
+}
+
+// Store is the subset of the fga api used to check and repair tuples
+type Store interface {
+	// ReadObject returns every tuple stored on the object, e.g. control:01HABC
+	ReadObject(ctx context.Context, object string) ([]Tuple, error)
+	// ReadSubject returns the tuples of the subject with the relation on any object of the object type
+	ReadSubject(ctx context.Context, subject, relation, objectType string) ([]Tuple, error)
+	// Write writes and deletes the tuples in a single request
+	Write(ctx context.Context, writes, deletes []Tuple) error
+}
+
+// fgaStore reads and writes tuples through the fgax client
+type fgaStore struct {
+	client *fgax.Client
+}
+
+// NewFGAStore returns a Store backed by the fgax client
+func NewFGAStore(c *fgax.Client) Store {
+	return &fgaStore{client: c}
+}
+
+// ReadObject satisfies the Store interface
+func (s *fgaStore) ReadObject(ctx context.Context, object string) ([]Tuple, error) {
+	return s.read(ctx, ofgaclient.ClientReadRequest{Object: &object})
+}
+
+// ReadSubject satisfies the Store interface; the object is given as the type only, which OpenFGA allows when
+// the user is set
+func (s *fgaStore) ReadSubject(ctx context.Context, subject, relation, objectType string) ([]Tuple, error) {
+	object := objectType + ":"
+
+	return s.read(ctx, ofgaclient.ClientReadRequest{User: &subject, Relation: &relation, Object: &object})
+}
+
+// read pages through the tuples matching the request
+func (s *fgaStore) read(ctx context.Context, req ofgaclient.ClientReadRequest) ([]Tuple, error) {
+	var (
+		tuples []Tuple
+		token  string
+	)
+
+	pageSize := readPageSize
+
+	for {
+		opts := ofgaclient.ClientReadOptions{PageSize: &pageSize}
+		if token != "" {
+			opts.ContinuationToken = &token
+		}
+
+		resp, err := s.client.Ofga.Read(ctx).Body(req).Options(opts).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range resp.GetTuples() {
+			key := t.GetKey()
+
+			tuples = append(tuples, Tuple{Subject: key.GetUser(), Relation: key.GetRelation(), Object: key.GetObject()})
+		}
+
+		token = resp.GetContinuationToken()
+		if token == "" {
+			return tuples, nil
+		}
+	}
+}
+
+// Write satisfies the Store interface
+func (s *fgaStore) Write(ctx context.Context, writes, deletes []Tuple) error {
+	writeKeys, err := tupleKeys(writes)
+	if err != nil {
+		return err
+	}
+
+	deleteKeys, err := tupleKeys(deletes)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.client.WriteTupleKeys(ctx, writeKeys, deleteKeys)
+
+	return err
+}
+
+// tupleKeys converts the tuples to fgax tuple keys
+func tupleKeys(tuples []Tuple) ([]fgax.TupleKey, error) {
+	keys := make([]fgax.TupleKey, 0, len(tuples))
+
+	for _, t := range tuples {
+		key, err := t.TupleKey()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
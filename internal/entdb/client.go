@@ -97,8 +97,28 @@ func WithModules() Option {
 	}
 }
 
+// WithQuotas adds the hook enforcing the usage limits of the module catalog to the ent client
+func WithQuotas() Option {
+	return func(c *ent.Client) {
+		c.Use(hooks.HookQuota())
+	}
+}
+
+// WithLicense adds the hook enforcing the seat limit of an offline license to the ent client
+func WithLicense(license hooks.LicenseSeats) Option {
+	return func(c *ent.Client) {
+		c.Use(hooks.HookLicenseSeats(license))
+	}
+}
+
 // New returns a ent client with a primary and secondary, if configured, write database
 func New(ctx context.Context, c entx.Config, jobOpts []riverqueue.Option, clientOpts []Option, opts ...ent.Option) (*ent.Client, error) {
+	return NewWithReplicas(ctx, c, nil, jobOpts, clientOpts, opts...)
+}
+
+// NewWithReplicas returns a ent client like New that serves read-only queries of contexts marked with
+// WithReplicaRead from the read replicas; a nil replica set sends every query to the primary
+func NewWithReplicas(ctx context.Context, c entx.Config, replicas *ReplicaSet, jobOpts []riverqueue.Option, clientOpts []Option, opts ...ent.Option) (*ent.Client, error) {
 	client := &client{
 		config: &c,
 	}
@@ -119,7 +139,7 @@ func New(ctx context.Context, c entx.Config, jobOpts []riverqueue.Option, client
 
 	var drvSecondary dialect.Driver
 
-	drvPrimary = entConfig.GetPrimaryDB()
+	drvPrimary = replicaDriverFor(entConfig.GetPrimaryDB(), replicas)
 	client.pc = client.createEntDBClient(entConfig.GetPrimaryDB())
 
 	// run migrations on primary driver
@@ -218,6 +238,12 @@ func New(ctx context.Context, c entx.Config, jobOpts []riverqueue.Option, client
 
 // NewHistory returns a enthistory client with a primary database
 func NewHistory(c entx.Config, opts ...historygenerated.Option) (*historygenerated.Client, error) {
+	return NewHistoryWithReplicas(c, nil, opts...)
+}
+
+// NewHistoryWithReplicas returns a enthistory client like NewHistory that serves read-only queries of contexts
+// marked with WithReplicaRead from the read replicas
+func NewHistoryWithReplicas(c entx.Config, replicas *ReplicaSet, opts ...historygenerated.Option) (*historygenerated.Client, error) {
 	if !c.EnableHistory {
 		log.Info().Msg("history is not enabled, not creating history client")
 
@@ -230,7 +256,7 @@ func NewHistory(c entx.Config, opts ...historygenerated.Option) (*historygenerat
 	}
 
 	// setup driver for the client
-	drvPrimary := entConfig.GetPrimaryDB()
+	drvPrimary := replicaDriverFor(entConfig.GetPrimaryDB(), replicas)
 
 	opts = append(opts, historygenerated.Driver(drvPrimary))
 
@@ -257,7 +283,7 @@ func entcacheDriver(driver dialect.Driver, cacheTTL time.Duration) *entcache.Dri
 func (c *client) runMigrations(ctx context.Context) error {
 	switch c.config.MigrationProvider {
 	case "goose":
-		if err := c.runGooseMigrations(); err != nil {
+		if err := c.runGooseMigrations(ctx); err != nil {
 			return err
 		}
 
@@ -268,7 +294,7 @@ func (c *client) runMigrations(ctx context.Context) error {
 }
 
 // runGooseMigrations runs the goose migrations
-func (c *client) runGooseMigrations() error {
+func (c *client) runGooseMigrations(ctx context.Context) error {
 	driver, err := entx.CheckEntDialect(c.config.DriverName)
 	if err != nil {
 		return err
@@ -289,8 +315,6 @@ func (c *client) runGooseMigrations() error {
 		return err
 	}
 
-	migrationsDir := "migrations-goose-postgres"
-
 	// enable extensions
 	if err := EnablePostgresExtensions(drv); err != nil {
 		log.Error().Err(err).Msg("failed enabling citext extension")
@@ -298,7 +322,7 @@ func (c *client) runGooseMigrations() error {
 		return err
 	}
 
-	if err := goose.Up(drv, migrationsDir); err != nil {
+	if err := goose.Up(drv, gooseMigrationsDir); err != nil {
 		log.Error().Err(err).Msg("failed running goose migrations")
 
 		return err
@@ -318,6 +342,12 @@ func (c *client) runAtlasMigrations(ctx context.Context) error {
 
 			return err
 		}
+
+		if err := runUnmanagedMigrations(ctx, SQLDB(c.pc)); err != nil {
+			log.Error().Err(err).Msg("failed running unmanaged migrations")
+
+			return err
+		}
 	}
 
 	if c.hc != nil {
@@ -9,4 +9,6 @@ var (
 	ErrDriverLackingBeginTx = errors.New("driver does not support BeginTx")
 	// ErrShuttingDown is returned when operations are attempted during shutdown
 	ErrShuttingDown = errors.New("database shutting down")
+	// ErrPendingMigrations is returned when the database schema is behind the migrations of the binary
+	ErrPendingMigrations = errors.New("database has pending migrations")
 )
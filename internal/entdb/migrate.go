@@ -0,0 +1,200 @@
+package entdb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pressly/goose/v3"
+	"github.com/theopenlane/entx"
+
+	migratedb "github.com/theopenlane/core/db"
+)
+
+// gooseMigrationsDir is the directory of the versioned goose migrations in the embedded filesystem
+const gooseMigrationsDir = "migrations-goose-postgres"
+
+// unmanagedVersionTable is the goose version table tracking the unmanaged migrations applied after atlas
+// auto-migration
+const unmanagedVersionTable = "goose_unmanaged_db_version"
+
+// unmanagedMigrations are the goose migrations creating tables that are not part of the ent schema; atlas
+// auto-migration only creates the ent schema, so they are applied after it
+var unmanagedMigrations = []string{
+	"20261019120000_resumable_uploads.sql",
+	"20261019150000_gala_dead_letters.sql",
+	"20261019151000_gala_outbox.sql",
+	"20261019160000_file_search_documents.sql",
+	"20261019161000_fulltext_search_vectors.sql",
+}
+
+// Migrate runs the migrations of the configured migration provider against the primary database, the same
+// way they run on startup when RunMigrations is enabled
+func Migrate(ctx context.Context, c entx.Config) error {
+	entConfig, err := entx.NewDBConfig(c)
+	if err != nil {
+		return err
+	}
+
+	cl := &client{config: &c}
+	cl.pc = cl.createEntDBClient(entConfig.GetPrimaryDB())
+
+	defer cl.pc.Close()
+
+	return cl.runMigrations(ctx)
+}
+
+// PendingMigrations returns what Migrate would apply to the primary database: the goose migrations newer than
+// the database version, or the statements atlas auto-migration would run followed by the unmanaged migrations
+// not applied yet; an empty result means the schema is up to date
+func PendingMigrations(ctx context.Context, c entx.Config) ([]string, error) {
+	if c.MigrationProvider == "goose" {
+		return pendingGooseMigrations(c)
+	}
+
+	entConfig, err := entx.NewDBConfig(c)
+	if err != nil {
+		return nil, err
+	}
+
+	cl := &client{config: &c}
+	cl.pc = cl.createEntDBClient(entConfig.GetPrimaryDB())
+
+	defer cl.pc.Close()
+
+	var buf bytes.Buffer
+
+	if err := cl.pc.Schema.WriteTo(ctx, &buf); err != nil {
+		return nil, err
+	}
+
+	unmanaged, err := pendingUnmanagedMigrations(ctx, SQLDB(cl.pc))
+	if err != nil {
+		return nil, err
+	}
+
+	return append(migrationStatements(&buf), unmanaged...), nil
+}
+
+// pendingGooseMigrations returns the files of the goose migrations newer than the database version
+func pendingGooseMigrations(c entx.Config) ([]string, error) {
+	driver, err := entx.CheckEntDialect(c.DriverName)
+	if err != nil {
+		return nil, err
+	}
+
+	drv, err := sql.Open(c.DriverName, c.PrimaryDBSource)
+	if err != nil {
+		return nil, err
+	}
+	defer drv.Close()
+
+	goose.SetLogger(newGooseLogger(c.Debug))
+	goose.SetBaseFS(migratedb.GooseMigrationsPG)
+
+	if err := goose.SetDialect(driver); err != nil {
+		return nil, err
+	}
+
+	current, err := goose.GetDBVersion(drv)
+	if err != nil {
+		return nil, err
+	}
+
+	migrations, err := goose.CollectMigrations(gooseMigrationsDir, current, goose.MaxVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]string, 0, len(migrations))
+	for _, m := range migrations {
+		pending = append(pending, filepath.Base(m.Source))
+	}
+
+	return pending, nil
+}
+
+// migrationStatements returns the statements written by the ent schema writer without the transaction
+// statements wrapping them
+func migrationStatements(buf *bytes.Buffer) []string {
+	var stmts []string
+
+	for line := range strings.Lines(buf.String()) {
+		stmt := strings.TrimSpace(line)
+
+		switch stmt {
+		case "", "BEGIN;", "COMMIT;":
+			continue
+		}
+
+		stmts = append(stmts, stmt)
+	}
+
+	return stmts
+}
+
+// runUnmanagedMigrations applies the unmanaged goose migrations that have not been applied to the database yet
+func runUnmanagedMigrations(ctx context.Context, db *sql.DB) error {
+	provider, err := newUnmanagedProvider(db)
+	if err != nil {
+		return err
+	}
+
+	_, err = provider.Up(ctx)
+
+	return err
+}
+
+// pendingUnmanagedMigrations returns the files of the unmanaged goose migrations not applied to the database
+func pendingUnmanagedMigrations(ctx context.Context, db *sql.DB) ([]string, error) {
+	provider, err := newUnmanagedProvider(db)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses, err := provider.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []string
+
+	for _, status := range statuses {
+		if status.State == goose.StatePending {
+			pending = append(pending, filepath.Base(status.Source.Path))
+		}
+	}
+
+	return pending, nil
+}
+
+// newUnmanagedProvider returns a goose provider limited to the unmanaged migrations, versioned in their own table
+func newUnmanagedProvider(db *sql.DB) (*goose.Provider, error) {
+	migrations, err := fs.Sub(migratedb.GooseMigrationsPG, gooseMigrationsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := fs.ReadDir(migrations, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	var exclude []string
+
+	for _, e := range entries {
+		if !slices.Contains(unmanagedMigrations, e.Name()) {
+			exclude = append(exclude, e.Name())
+		}
+	}
+
+	return goose.NewProvider(goose.DialectPostgres, db, migrations,
+		goose.WithTableName(unmanagedVersionTable),
+		goose.WithExcludeNames(exclude),
+		goose.WithAllowOutofOrder(true),
+	)
+}
@@ -0,0 +1,57 @@
+package entdb
+
+import (
+	"bytes"
+	"io/fs"
+	"path"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	migratedb "github.com/theopenlane/core/db"
+	"github.com/theopenlane/core/internal/ent/generated/migrate"
+	historymigrate "github.com/theopenlane/core/internal/ent/historygenerated/migrate"
+)
+
+func TestMigrationStatements(t *testing.T) {
+	buf := bytes.NewBufferString("BEGIN;\nALTER TABLE \"controls\" ADD COLUMN \"ref_code\" character varying NULL;\n\nCREATE INDEX \"control_ref_code\" ON \"controls\" (\"ref_code\");\nCOMMIT;\n")
+
+	require.Equal(t, []string{
+		`ALTER TABLE "controls" ADD COLUMN "ref_code" character varying NULL;`,
+		`CREATE INDEX "control_ref_code" ON "controls" ("ref_code");`,
+	}, migrationStatements(buf))
+
+	require.Empty(t, migrationStatements(bytes.NewBufferString("BEGIN;\nCOMMIT;\n")))
+}
+
+func TestUnmanagedMigrationsExist(t *testing.T) {
+	for _, name := range unmanagedMigrations {
+		_, err := fs.Stat(migratedb.GooseMigrationsPG, path.Join(gooseMigrationsDir, name))
+		require.NoError(t, err, "unmanaged migration %s does not exist", name)
+	}
+}
+
+func TestNonEntMigrationsAreUnmanaged(t *testing.T) {
+	entTables := map[string]bool{}
+
+	for _, table := range append(migrate.Tables, historymigrate.Tables...) {
+		entTables[table.Name] = true
+	}
+
+	createTable := regexp.MustCompile(`CREATE TABLE (?:IF NOT EXISTS )?"?([a-z0-9_]+)"?`)
+
+	entries, err := fs.ReadDir(migratedb.GooseMigrationsPG, gooseMigrationsDir)
+	require.NoError(t, err)
+
+	for _, e := range entries {
+		contents, err := fs.ReadFile(migratedb.GooseMigrationsPG, path.Join(gooseMigrationsDir, e.Name()))
+		require.NoError(t, err)
+
+		for _, match := range createTable.FindAllStringSubmatch(string(contents), -1) {
+			if !entTables[match[1]] {
+				require.Contains(t, unmanagedMigrations, e.Name(), "migration %s creates table %s outside the ent schema but is not an unmanaged migration", e.Name(), match[1])
+			}
+		}
+	}
+}
@@ -0,0 +1,526 @@
+package entdb
+
+import (
+	"context"
+	stdsql "database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/rs/zerolog/log"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/utils/contextx"
+)
+
+const (
+	// currentLSNQuery returns the write position of a primary, or the replay position of a replica
+	currentLSNQuery = `SELECT (CASE WHEN pg_is_in_recovery() THEN pg_last_wal_replay_lsn() ELSE pg_current_wal_lsn() END)::text`
+	// lsnHalves is the number of parts of the textual form of a log sequence number
+	lsnHalves = 2
+	// lsnHighBits is the shift applied to the high half of a log sequence number
+	lsnHighBits = 32
+)
+
+// ErrInvalidLSN is returned when a log sequence number cannot be parsed
+var ErrInvalidLSN = errors.New("invalid log sequence number")
+
+// ReplicaConfig configures the read replicas that serve read-only queries
+type ReplicaConfig struct {
+	// Enabled routes read-only queries to the replicas
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// Sources are the connection strings of the read replicas
+	Sources []string `json:"sources" koanf:"sources" sensitive:"true"`
+	// PollInterval is how often the replay position of each replica is checked
+	PollInterval time.Duration `json:"pollinterval" koanf:"pollinterval" default:"1s"`
+	// PinTTL is the longest a write keeps its caller on the primary when the replicas do not catch up
+	PinTTL time.Duration `json:"pinttl" koanf:"pinttl" default:"1m"`
+}
+
+// LSN is a Postgres log sequence number, a position in the write-ahead log
+type LSN uint64
+
+// ParseLSN parses the textual form of a log sequence number, e.g. 16/B374D848
+func ParseLSN(s string) (LSN, error) {
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if len(parts) != lsnHalves {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLSN, s)
+	}
+
+	hi, err := strconv.ParseUint(parts[0], 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLSN, s)
+	}
+
+	lo, err := strconv.ParseUint(parts[1], 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidLSN, s)
+	}
+
+	return LSN(hi<<lsnHighBits | lo), nil
+}
+
+// String returns the textual form of the log sequence number
+func (l LSN) String() string {
+	return fmt.Sprintf("%X/%X", uint64(l)>>lsnHighBits, uint64(l)&(1<<lsnHighBits-1))
+}
+
+// replicaRead marks a context whose queries may be served by a replica; resolvers of the same operation share
+// it concurrently
+type replicaRead struct {
+	mu     sync.Mutex
+	loaded bool
+	lsn    LSN
+	pinned bool
+}
+
+// replicaReadContextKey holds the replica read marker of a context
+var replicaReadContextKey = contextx.NewKey[*replicaRead]()
+
+// WithReplicaRead allows the read-only queries run with the context to be served by a read replica. Writes and
+// transactions always go to the primary, and callers who recently wrote stay on the primary until a replica has
+// replayed their last write
+func WithReplicaRead(ctx context.Context) context.Context {
+	if _, ok := replicaReadContextKey.Get(ctx); ok {
+		return ctx
+	}
+
+	return replicaReadContextKey.Set(ctx, &replicaRead{})
+}
+
+// replicaWrites collects the writes made with a context so the primary position is recorded once for all of them
+// rather than after every commit
+type replicaWrites struct {
+	mu sync.Mutex
+	// pending is the driver that wrote since the position was last recorded
+	pending *replicaDriver
+	// flushed is set once the context is done writing; later commits record their position right away
+	flushed bool
+}
+
+// replicaWritesContextKey holds the write collector of a context
+var replicaWritesContextKey = contextx.NewKey[*replicaWrites]()
+
+// WithReplicaWrites collects the writes made with the context, so the primary position is recorded once by
+// FlushReplicaWrites instead of after every commit. Reads made with the context after a write record the position
+// first, so they still see the write
+func WithReplicaWrites(ctx context.Context) context.Context {
+	if _, ok := replicaWritesContextKey.Get(ctx); ok {
+		return ctx
+	}
+
+	return replicaWritesContextKey.Set(ctx, &replicaWrites{})
+}
+
+// FlushReplicaWrites records the primary position for the writes collected by WithReplicaWrites; commits made with
+// the context afterwards, e.g. by a transaction committed once the response is written, are recorded right away
+func FlushReplicaWrites(ctx context.Context) {
+	if writes, ok := replicaWritesContextKey.Get(ctx); ok {
+		writes.flush(ctx, true)
+	}
+}
+
+// flush records the position of the collected writes; done stops collecting further writes
+func (w *replicaWrites) flush(ctx context.Context, done bool) {
+	w.mu.Lock()
+	pending := w.pending
+	w.pending = nil
+	w.flushed = w.flushed || done
+	w.mu.Unlock()
+
+	if pending != nil {
+		pending.markWrite(ctx)
+	}
+}
+
+// ReplicaSet holds the read replicas along with their last known replay positions; it is shared by the clients
+// routing reads to the replicas and closed by its owner once they are closed
+type ReplicaSet struct {
+	config   ReplicaConfig
+	sessions SessionStore
+	replicas []*replica
+	next     atomic.Uint64
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// replica is a single read replica
+type replica struct {
+	source  int
+	driver  dialect.Driver
+	db      *stdsql.DB
+	lsn     atomic.Uint64
+	healthy atomic.Bool
+}
+
+// NewReplicaSet opens the configured read replicas and starts polling their replay positions; it returns nil when
+// replicas are not enabled
+func NewReplicaSet(ctx context.Context, c ReplicaConfig, driverName string, maxConns, maxIdleConns int, sessions SessionStore) (*ReplicaSet, error) {
+	if !c.Enabled || len(c.Sources) == 0 {
+		return nil, nil
+	}
+
+	if sessions == nil {
+		sessions = NewMemorySessionStore()
+	}
+
+	rs := &ReplicaSet{
+		config:   c,
+		sessions: sessions,
+		stop:     make(chan struct{}),
+	}
+
+	for i, source := range c.Sources {
+		drv, err := entsql.Open(driverName, source)
+		if err != nil {
+			rs.Close()
+
+			return nil, fmt.Errorf("opening read replica %d: %w", i, err)
+		}
+
+		if maxConns > 0 {
+			drv.DB().SetMaxOpenConns(maxConns)
+		}
+
+		if maxIdleConns > 0 {
+			drv.DB().SetMaxIdleConns(maxIdleConns)
+		}
+
+		rs.replicas = append(rs.replicas, &replica{source: i, driver: drv, db: drv.DB()})
+	}
+
+	rs.poll(ctx)
+
+	go rs.run(ctx) //nolint:gosec
+
+	return rs, nil
+}
+
+// Close stops polling and closes the replica connections
+func (rs *ReplicaSet) Close() error {
+	if rs == nil {
+		return nil
+	}
+
+	rs.stopOnce.Do(func() { close(rs.stop) })
+
+	var errs []error
+
+	for _, r := range rs.replicas {
+		if err := r.driver.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// run polls the replay positions of the replicas until the set is closed
+func (rs *ReplicaSet) run(ctx context.Context) {
+	interval := rs.config.PollInterval
+	if interval <= 0 {
+		interval = time.Second
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-rs.stop:
+			return
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			rs.poll(ctx)
+		}
+	}
+}
+
+// poll refreshes the replay position of every replica; replicas that cannot be reached are not used until they
+// answer again
+func (rs *ReplicaSet) poll(ctx context.Context) {
+	for _, r := range rs.replicas {
+		lsn, err := currentLSN(ctx, r.db)
+		if err != nil {
+			if r.healthy.Swap(false) {
+				log.Warn().Err(err).Int("replica", r.source).Msg("read replica unavailable, routing its reads to the primary")
+			}
+
+			continue
+		}
+
+		r.lsn.Store(uint64(lsn))
+
+		if !r.healthy.Swap(true) {
+			log.Info().Int("replica", r.source).Str("lsn", lsn.String()).Msg("read replica available")
+		}
+	}
+}
+
+// pick returns a healthy replica that has replayed the position, spreading reads across the replicas
+func (rs *ReplicaSet) pick(lsn LSN) dialect.Driver {
+	n := uint64(len(rs.replicas))
+	start := rs.next.Add(1)
+
+	for i := range n {
+		r := rs.replicas[(start+i)%n]
+		if r.healthy.Load() && LSN(r.lsn.Load()) >= lsn {
+			return r.driver
+		}
+	}
+
+	return nil
+}
+
+// currentLSN returns the current write or replay position of the database
+func currentLSN(ctx context.Context, db *stdsql.DB) (LSN, error) {
+	var value stdsql.NullString
+	if err := db.QueryRowContext(ctx, currentLSNQuery).Scan(&value); err != nil {
+		return 0, err
+	}
+
+	if !value.Valid {
+		return 0, fmt.Errorf("%w: replay position unknown", ErrInvalidLSN)
+	}
+
+	return ParseLSN(value.String)
+}
+
+// replicaDriver routes read-only queries of contexts marked with WithReplicaRead to a replica and everything else
+// to the primary; writes made on behalf of a caller record the primary position so the caller's reads stay on the
+// primary until a replica has caught up
+type replicaDriver struct {
+	dialect.Driver
+	replicas *ReplicaSet
+	// primaryLSN reads the write position of the primary after a write
+	primaryLSN func(context.Context) (LSN, error)
+}
+
+// replicaDriverFor wraps the primary driver with replica routing; the primary is returned as is when no replicas
+// are configured
+func replicaDriverFor(primary dialect.Driver, replicas *ReplicaSet) dialect.Driver {
+	if replicas == nil || len(replicas.replicas) == 0 {
+		return primary
+	}
+
+	primaryDB := extractSQLDB(primary)
+
+	return &replicaDriver{
+		Driver:   primary,
+		replicas: replicas,
+		primaryLSN: func(ctx context.Context) (LSN, error) {
+			return currentLSN(ctx, primaryDB)
+		},
+	}
+}
+
+// Query runs read-only queries on a replica when the context allows it
+func (d *replicaDriver) Query(ctx context.Context, query string, args, v any) error {
+	if drv := d.reader(ctx, query); drv != nil {
+		return drv.Query(ctx, query, args, v)
+	}
+
+	if err := d.Driver.Query(ctx, query, args, v); err != nil {
+		return err
+	}
+
+	// inserts and updates returning rows are run as queries
+	if !isReadOnlyQuery(query) {
+		d.recordWrite(ctx)
+	}
+
+	return nil
+}
+
+// Exec runs the statement on the primary and records the write for the caller
+func (d *replicaDriver) Exec(ctx context.Context, query string, args, v any) error {
+	if err := d.Driver.Exec(ctx, query, args, v); err != nil {
+		return err
+	}
+
+	d.recordWrite(ctx)
+
+	return nil
+}
+
+// Tx starts a transaction on the primary
+func (d *replicaDriver) Tx(ctx context.Context) (dialect.Tx, error) {
+	tx, err := d.Driver.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replicaTx{Tx: tx, ctx: ctx, driver: d}, nil
+}
+
+// BeginTx starts a transaction with options on the primary
+func (d *replicaDriver) BeginTx(ctx context.Context, opts *stdsql.TxOptions) (dialect.Tx, error) {
+	drv, ok := d.Driver.(interface {
+		BeginTx(context.Context, *stdsql.TxOptions) (dialect.Tx, error)
+	})
+	if !ok {
+		return nil, ErrDriverLackingBeginTx
+	}
+
+	tx, err := drv.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replicaTx{Tx: tx, ctx: ctx, driver: d}, nil
+}
+
+// reader returns the replica to run the query on, or nil when it must run on the primary
+func (d *replicaDriver) reader(ctx context.Context, query string) dialect.Driver {
+	read, ok := replicaReadContextKey.Get(ctx)
+	if !ok || !isReadOnlyQuery(query) {
+		return nil
+	}
+
+	// writes collected from the context are recorded first, so its reads see them
+	if writes, ok := replicaWritesContextKey.Get(ctx); ok {
+		writes.flush(ctx, false)
+	}
+
+	read.mu.Lock()
+	defer read.mu.Unlock()
+
+	// the caller's last write is looked up once per context rather than for every query
+	if !read.loaded {
+		read.loaded = true
+		read.lsn, read.pinned = d.lastWrite(ctx)
+	}
+
+	if read.pinned {
+		return d.replicas.pick(read.lsn)
+	}
+
+	return d.replicas.pick(0)
+}
+
+// lastWrite returns the position of the last write of the caller of the context; when it cannot be read the
+// caller is pinned to the primary
+func (d *replicaDriver) lastWrite(ctx context.Context) (LSN, bool) {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return 0, false
+	}
+
+	lsn, found, err := d.replicas.sessions.LastWrite(ctx, caller.SubjectID)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to read replica session, using the primary")
+
+		return LSN(^uint64(0)), true
+	}
+
+	return lsn, found
+}
+
+// recordWrite records a committed write for the caller of the context; writes made with a context collecting
+// them are recorded once when it is flushed
+func (d *replicaDriver) recordWrite(ctx context.Context) {
+	if writes, ok := replicaWritesContextKey.Get(ctx); ok {
+		writes.mu.Lock()
+		collect := !writes.flushed
+		if collect {
+			writes.pending = d
+		}
+		writes.mu.Unlock()
+
+		if collect {
+			return
+		}
+	}
+
+	d.markWrite(ctx)
+}
+
+// markWrite records the current primary position for the caller of the context; it costs a round trip to the
+// primary and one to the session store, which is why writes are recorded per commit, or once per request for
+// contexts collecting them, rather than per statement
+func (d *replicaDriver) markWrite(ctx context.Context) {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return
+	}
+
+	lsn, err := d.primaryLSN(ctx)
+	if err != nil {
+		log.Warn().Err(err).Msg("unable to read primary write position")
+
+		return
+	}
+
+	if err := d.replicas.sessions.MarkWrite(ctx, caller.SubjectID, lsn, d.replicas.config.PinTTL); err != nil {
+		log.Warn().Err(err).Msg("unable to record replica session")
+	}
+
+	// the context may be reused for reads after the write, e.g. a mutation returning the updated object
+	if read, ok := replicaReadContextKey.Get(ctx); ok {
+		read.mu.Lock()
+		read.loaded = true
+		read.lsn = max(read.lsn, lsn)
+		read.pinned = true
+		read.mu.Unlock()
+	}
+}
+
+// replicaTx records the write for the caller once the transaction commits
+type replicaTx struct {
+	dialect.Tx
+	ctx    context.Context //nolint:containedctx
+	driver *replicaDriver
+	wrote  bool
+}
+
+// Exec runs the statement in the transaction
+func (tx *replicaTx) Exec(ctx context.Context, query string, args, v any) error {
+	tx.wrote = true
+
+	return tx.Tx.Exec(ctx, query, args, v)
+}
+
+// Query runs the query in the transaction
+func (tx *replicaTx) Query(ctx context.Context, query string, args, v any) error {
+	if !isReadOnlyQuery(query) {
+		tx.wrote = true
+	}
+
+	return tx.Tx.Query(ctx, query, args, v)
+}
+
+// Commit commits the transaction and records the write for the caller
+func (tx *replicaTx) Commit() error {
+	if err := tx.Tx.Commit(); err != nil {
+		return err
+	}
+
+	if tx.wrote {
+		tx.driver.recordWrite(tx.ctx)
+	}
+
+	return nil
+}
+
+// isReadOnlyQuery reports whether the statement only reads; statements locking rows and common table expressions,
+// which may contain writes, are treated as writes
+func isReadOnlyQuery(query string) bool {
+	q := strings.ToUpper(strings.TrimSpace(query))
+	if !strings.HasPrefix(q, "SELECT") {
+		return false
+	}
+
+	for _, lock := range []string{" FOR UPDATE", " FOR NO KEY UPDATE", " FOR SHARE", " FOR KEY SHARE"} {
+		if strings.Contains(q, lock) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,127 @@
+package entdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// replicaSessionKeyPrefix namespaces the write positions recorded in redis
+const replicaSessionKeyPrefix = "entdb:replica:lastwrite:"
+
+// SessionStore records the position of the last write of each caller so their reads stay on the primary until a
+// replica has replayed it
+type SessionStore interface {
+	// MarkWrite records the write position for the subject, keeping it for at most ttl
+	MarkWrite(ctx context.Context, subjectID string, lsn LSN, ttl time.Duration) error
+	// LastWrite returns the write position recorded for the subject
+	LastWrite(ctx context.Context, subjectID string) (LSN, bool, error)
+}
+
+// memorySessionStore keeps write positions in memory; it only pins callers served by the same instance
+type memorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]memorySession
+}
+
+type memorySession struct {
+	lsn     LSN
+	expires time.Time
+}
+
+// NewMemorySessionStore returns a session store that keeps write positions in memory
+func NewMemorySessionStore() SessionStore {
+	return &memorySessionStore{sessions: map[string]memorySession{}}
+}
+
+// MarkWrite records the write position for the subject
+func (s *memorySessionStore) MarkWrite(_ context.Context, subjectID string, lsn LSN, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+
+	// drop expired sessions so the map does not grow with every caller that ever wrote
+	for id, session := range s.sessions {
+		if now.After(session.expires) {
+			delete(s.sessions, id)
+		}
+	}
+
+	if current, ok := s.sessions[subjectID]; ok && current.lsn > lsn {
+		lsn = current.lsn
+	}
+
+	s.sessions[subjectID] = memorySession{lsn: lsn, expires: now.Add(ttl)}
+
+	return nil
+}
+
+// LastWrite returns the write position recorded for the subject
+func (s *memorySessionStore) LastWrite(_ context.Context, subjectID string) (LSN, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	session, ok := s.sessions[subjectID]
+	if !ok || time.Now().After(session.expires) {
+		return 0, false, nil
+	}
+
+	return session.lsn, true, nil
+}
+
+// redisSessionStore keeps write positions in redis so callers are pinned across every instance
+type redisSessionStore struct {
+	client *redis.Client
+}
+
+// NewRedisSessionStore returns a session store that keeps write positions in redis
+func NewRedisSessionStore(client *redis.Client) SessionStore {
+	return &redisSessionStore{client: client}
+}
+
+// markWriteScript keeps the highest write position of the subject and refreshes its expiry; positions are stored
+// as fixed width hex so they compare as strings without losing precision in lua numbers
+var markWriteScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current and current > ARGV[1] then
+  redis.call('PEXPIRE', KEYS[1], ARGV[2])
+  return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`)
+
+// MarkWrite records the write position for the subject
+func (s *redisSessionStore) MarkWrite(ctx context.Context, subjectID string, lsn LSN, ttl time.Duration) error {
+	return markWriteScript.Run(ctx, s.client, []string{replicaSessionKeyPrefix + subjectID}, encodeLSN(lsn), ttl.Milliseconds()).Err()
+}
+
+// LastWrite returns the write position recorded for the subject
+func (s *redisSessionStore) LastWrite(ctx context.Context, subjectID string) (LSN, bool, error) {
+	value, err := s.client.Get(ctx, replicaSessionKeyPrefix+subjectID).Result()
+	if errors.Is(err, redis.Nil) {
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, err
+	}
+
+	lsn, err := strconv.ParseUint(value, 16, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: %q", ErrInvalidLSN, value)
+	}
+
+	return LSN(lsn), true, nil
+}
+
+// encodeLSN formats the position as fixed width hex
+func encodeLSN(lsn LSN) string {
+	return fmt.Sprintf("%016X", uint64(lsn))
+}
@@ -0,0 +1,234 @@
+package entdb
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theopenlane/iam/auth"
+)
+
+// recordingDriver counts the statements it receives
+type recordingDriver struct {
+	dialect.Driver
+	queries int
+	execs   int
+}
+
+func (d *recordingDriver) Query(_ context.Context, _ string, _, _ any) error {
+	d.queries++
+
+	return nil
+}
+
+func (d *recordingDriver) Exec(_ context.Context, _ string, _, _ any) error {
+	d.execs++
+
+	return nil
+}
+
+func TestParseLSN(t *testing.T) {
+	lsn, err := ParseLSN("16/B374D848")
+	require.NoError(t, err)
+	assert.Equal(t, LSN(0x16B374D848), lsn)
+	assert.Equal(t, "16/B374D848", lsn.String())
+
+	lsn, err = ParseLSN("0/0")
+	require.NoError(t, err)
+	assert.Equal(t, LSN(0), lsn)
+
+	for _, invalid := range []string{"", "16", "16/", "zz/10", "1/2/3"} {
+		_, err := ParseLSN(invalid)
+		assert.ErrorIs(t, err, ErrInvalidLSN, invalid)
+	}
+}
+
+func TestEncodeLSNOrdering(t *testing.T) {
+	// encoded positions are compared as strings by the redis session store
+	assert.Less(t, encodeLSN(0x9), encodeLSN(0xA))
+	assert.Less(t, encodeLSN(0xFFFFFFFF), encodeLSN(0x100000000))
+	assert.Len(t, encodeLSN(1), 16)
+}
+
+func TestIsReadOnlyQuery(t *testing.T) {
+	tests := []struct {
+		query    string
+		readOnly bool
+	}{
+		{query: `SELECT "controls"."id" FROM "controls"`, readOnly: true},
+		{query: "  select 1", readOnly: true},
+		{query: `SELECT "id" FROM "controls" WHERE "id" = $1 FOR UPDATE`, readOnly: false},
+		{query: `SELECT "id" FROM "controls" FOR SHARE`, readOnly: false},
+		{query: `INSERT INTO "controls" ("id") VALUES ($1) RETURNING "id"`, readOnly: false},
+		{query: `UPDATE "controls" SET "title" = $1 RETURNING "id"`, readOnly: false},
+		{query: `WITH moved AS (DELETE FROM "controls" RETURNING *) SELECT * FROM moved`, readOnly: false},
+	}
+
+	for _, tc := range tests {
+		assert.Equal(t, tc.readOnly, isReadOnlyQuery(tc.query), tc.query)
+	}
+}
+
+func TestReplicaDriverRouting(t *testing.T) {
+	const selectQuery = `SELECT "id" FROM "controls"`
+
+	newDriver := func(replicaLSN LSN, healthy bool, sessions SessionStore) (*replicaDriver, *recordingDriver, *recordingDriver) {
+		primary := &recordingDriver{}
+		replicaDrv := &recordingDriver{}
+
+		r := &replica{driver: replicaDrv}
+		r.lsn.Store(uint64(replicaLSN))
+		r.healthy.Store(healthy)
+
+		rs := &ReplicaSet{
+			config:   ReplicaConfig{Enabled: true, PinTTL: time.Minute},
+			sessions: sessions,
+			replicas: []*replica{r},
+		}
+
+		return &replicaDriver{Driver: primary, replicas: rs}, primary, replicaDrv
+	}
+
+	callerCtx := func(subjectID string) context.Context {
+		return auth.WithCaller(context.Background(), &auth.Caller{SubjectID: subjectID})
+	}
+
+	t.Run("unmarked context reads from the primary", func(t *testing.T) {
+		d, primary, replicaDrv := newDriver(100, true, NewMemorySessionStore())
+
+		require.NoError(t, d.Query(callerCtx("user"), selectQuery, []any{}, nil))
+		assert.Equal(t, 1, primary.queries)
+		assert.Equal(t, 0, replicaDrv.queries)
+	})
+
+	t.Run("marked context reads from the replica", func(t *testing.T) {
+		d, primary, replicaDrv := newDriver(100, true, NewMemorySessionStore())
+
+		require.NoError(t, d.Query(WithReplicaRead(callerCtx("user")), selectQuery, []any{}, nil))
+		assert.Equal(t, 0, primary.queries)
+		assert.Equal(t, 1, replicaDrv.queries)
+	})
+
+	t.Run("writes stay on the primary", func(t *testing.T) {
+		d, primary, replicaDrv := newDriver(100, true, NewMemorySessionStore())
+		ctx := WithReplicaRead(context.Background())
+
+		require.NoError(t, d.Query(ctx, `INSERT INTO "controls" ("id") VALUES ($1) RETURNING "id"`, []any{"c1"}, nil))
+		require.NoError(t, d.Exec(ctx, `DELETE FROM "controls"`, []any{}, nil))
+		assert.Equal(t, 1, primary.queries)
+		assert.Equal(t, 1, primary.execs)
+		assert.Equal(t, 0, replicaDrv.queries+replicaDrv.execs)
+	})
+
+	t.Run("recent writer is pinned until the replica catches up", func(t *testing.T) {
+		sessions := NewMemorySessionStore()
+		require.NoError(t, sessions.MarkWrite(context.Background(), "writer", 200, time.Minute))
+
+		d, primary, replicaDrv := newDriver(100, true, sessions)
+
+		require.NoError(t, d.Query(WithReplicaRead(callerCtx("writer")), selectQuery, []any{}, nil))
+		assert.Equal(t, 1, primary.queries)
+
+		// other callers are not pinned by the write
+		require.NoError(t, d.Query(WithReplicaRead(callerCtx("reader")), selectQuery, []any{}, nil))
+		assert.Equal(t, 1, replicaDrv.queries)
+
+		// once the replica replays the write the writer reads from it again
+		d.replicas.replicas[0].lsn.Store(200)
+
+		require.NoError(t, d.Query(WithReplicaRead(callerCtx("writer")), selectQuery, []any{}, nil))
+		assert.Equal(t, 1, primary.queries)
+		assert.Equal(t, 2, replicaDrv.queries)
+	})
+
+	t.Run("unhealthy replica is skipped", func(t *testing.T) {
+		d, primary, replicaDrv := newDriver(100, false, NewMemorySessionStore())
+
+		require.NoError(t, d.Query(WithReplicaRead(callerCtx("user")), selectQuery, []any{}, nil))
+		assert.Equal(t, 1, primary.queries)
+		assert.Equal(t, 0, replicaDrv.queries)
+	})
+}
+
+func TestReplicaWritesRecordedOnce(t *testing.T) {
+	const insertQuery = `INSERT INTO "controls" ("id") VALUES ($1) RETURNING "id"`
+
+	lookups := 0
+	sessions := NewMemorySessionStore()
+
+	r := &replica{driver: &recordingDriver{}}
+	r.lsn.Store(100)
+	r.healthy.Store(true)
+
+	d := &replicaDriver{
+		Driver: &recordingDriver{},
+		replicas: &ReplicaSet{
+			config:   ReplicaConfig{Enabled: true, PinTTL: time.Minute},
+			sessions: sessions,
+			replicas: []*replica{r},
+		},
+		primaryLSN: func(context.Context) (LSN, error) {
+			lookups++
+
+			return LSN(200 + lookups), nil
+		},
+	}
+
+	ctx := WithReplicaWrites(auth.WithCaller(context.Background(), &auth.Caller{SubjectID: "writer"}))
+
+	for range 3 {
+		require.NoError(t, d.Exec(ctx, `UPDATE "controls" SET "title" = $1`, []any{"t"}, nil))
+		require.NoError(t, d.Query(ctx, insertQuery, []any{"c1"}, nil))
+	}
+
+	assert.Zero(t, lookups, "writes are collected until the context is flushed")
+
+	FlushReplicaWrites(ctx)
+	FlushReplicaWrites(ctx)
+	assert.Equal(t, 1, lookups)
+
+	lsn, found, err := sessions.LastWrite(context.Background(), "writer")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, LSN(201), lsn)
+
+	// a commit after the flush, e.g. of a transaction ending after the response, is recorded right away
+	require.NoError(t, d.Exec(ctx, `DELETE FROM "controls"`, []any{}, nil))
+	assert.Equal(t, 2, lookups)
+
+	t.Run("reads record collected writes first", func(t *testing.T) {
+		lookups = 0
+		readCtx := WithReplicaWrites(WithReplicaRead(auth.WithCaller(context.Background(), &auth.Caller{SubjectID: "reader"})))
+
+		require.NoError(t, d.Exec(readCtx, `UPDATE "controls" SET "title" = $1`, []any{"t"}, nil))
+		require.NoError(t, d.Query(readCtx, `SELECT "id" FROM "controls"`, []any{}, nil))
+		assert.Equal(t, 1, lookups)
+		assert.Zero(t, r.driver.(*recordingDriver).queries, "the read waits for the replica to replay the write")
+	})
+}
+
+func TestMemorySessionStore(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemorySessionStore()
+
+	_, found, err := store.LastWrite(ctx, "user")
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	require.NoError(t, store.MarkWrite(ctx, "user", 200, time.Minute))
+	require.NoError(t, store.MarkWrite(ctx, "user", 100, time.Minute))
+
+	lsn, found, err := store.LastWrite(ctx, "user")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, LSN(200), lsn, "an older write does not move the position back")
+
+	require.NoError(t, store.MarkWrite(ctx, "expired", 300, -time.Second))
+
+	_, found, err = store.LastWrite(ctx, "expired")
+	require.NoError(t, err)
+	assert.False(t, found)
+}
@@ -90,6 +90,8 @@ func extractSQLDB(d dialect.Driver) *stdsql.DB {
 		return extractSQLDB(drv.Driver)
 	case *blockingDriver:
 		return extractSQLDB(drv.Driver)
+	case *replicaDriver:
+		return extractSQLDB(drv.Driver)
 	default:
 		panic(fmt.Sprintf("entdb: unknown driver type %T", d))
 	}
@@ -16,6 +16,7 @@ var FeatureOfType = map[string][]models.OrgModule{
 	"ControlImplementation":      {models.CatalogComplianceModule},
 	"ControlObjective":           {models.CatalogComplianceModule, models.CatalogPolicyManagementAddon},
 	"CustomDomain":               {models.CatalogTrustCenterModule},
+	"CustomRole":                 {models.CatalogBaseModule},
 	"CustomTypeEnum":             {models.CatalogBaseModule},
 	"Discussion":                 {models.CatalogBaseModule},
 	"DNSVerification":            {models.CatalogTrustCenterModule},
@@ -63,6 +64,7 @@ var FeatureOfType = map[string][]models.OrgModule{
 	"Program":                    {models.CatalogComplianceModule, models.CatalogEntityManagementModule},
 	"ProgramMembership":          {models.CatalogComplianceModule},
 	"Remediation":                {models.CatalogVulnerabilityManagementModule, models.CatalogRiskManagementAddon, models.CatalogComplianceModule},
+	"RetentionRule":              {models.CatalogBaseModule},
 	"Review":                     {models.CatalogComplianceModule, models.CatalogVulnerabilityManagementModule},
 	"Risk":                       {models.CatalogComplianceModule, models.CatalogRiskManagementAddon},
 	"Scan":                       {models.CatalogVulnerabilityManagementModule, models.CatalogComplianceModule},
@@ -0,0 +1,32 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// CreateAccessReview is the resolver for the createAccessReview field.
+func (r *mutationResolver) CreateAccessReview(ctx context.Context, input model.CreateAccessReviewInput) (*model.AccessReviewPayload, error) {
+	return r.createAccessReview(ctx, input)
+}
+
+// DecideAccessReviewEntry is the resolver for the decideAccessReviewEntry field.
+func (r *mutationResolver) DecideAccessReviewEntry(ctx context.Context, campaignID string, entryID string, decision model.AccessReviewDecision, comment *string) (*model.AccessReviewPayload, error) {
+	return r.decideAccessReviewEntry(ctx, campaignID, entryID, decision, comment)
+}
+
+// CompleteAccessReview is the resolver for the completeAccessReview field.
+func (r *mutationResolver) CompleteAccessReview(ctx context.Context, campaignID string) (*model.AccessReviewPayload, error) {
+	return r.completeAccessReview(ctx, campaignID)
+}
+
+// AccessReview is the resolver for the accessReview field.
+func (r *queryResolver) AccessReview(ctx context.Context, campaignID string) (*model.AccessReview, error) {
+	return r.accessReview(ctx, campaignID)
+}
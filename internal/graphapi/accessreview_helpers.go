@@ -0,0 +1,213 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stoewer/go-strcase"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/accessreview"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// createAccessReview snapshots the access of the organization of the caller into a new access review campaign;
+// the snapshot includes the memberships and tokens of every user so editing the organization is required
+func (r *mutationResolver) createAccessReview(ctx context.Context, input model.CreateAccessReviewInput) (*model.AccessReviewPayload, error) {
+	action := common.Action{Action: common.ActionCreate, Object: "accessreview"}
+
+	orgID, err := auth.GetOrganizationIDFromContext(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, action)
+	}
+
+	if err := r.checkAccessReviewAccess(ctx, generated.TypeOrganization, orgID, fgax.CanEdit); err != nil {
+		return nil, err
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	camp, review, err := accessreview.Create(allowCtx, withTransactionalMutation(ctx), accessreview.CreateInput{
+		OrganizationID: orgID,
+		Name:           input.Name,
+		Description:    lo.FromPtr(input.Description),
+		ReviewerIDs:    input.ReviewerIDs,
+		ControlIDs:     input.ControlIDs,
+		DueDate:        input.DueDate,
+	}, time.Now())
+	if err != nil {
+		return nil, accessReviewError(ctx, err, action)
+	}
+
+	return &model.AccessReviewPayload{AccessReview: accessReviewModel(camp.ID, review)}, nil
+}
+
+// decideAccessReviewEntry records the decision of the caller on an entry; only the assigned reviewers can decide,
+// which is checked against the review itself
+func (r *mutationResolver) decideAccessReviewEntry(ctx context.Context, campaignID, entryID string, decision model.AccessReviewDecision, comment *string) (*model.AccessReviewPayload, error) {
+	action := common.Action{Action: common.ActionUpdate, Object: "accessreview"}
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return nil, newPermissionDeniedError()
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	review, err := accessreview.Decide(allowCtx, withTransactionalMutation(ctx), campaignID, caller.SubjectID, entryID,
+		accessreview.Decision(decision), lo.FromPtr(comment), time.Now())
+	if err != nil {
+		return nil, accessReviewError(ctx, err, action)
+	}
+
+	return &model.AccessReviewPayload{AccessReview: accessReviewModel(campaignID, review)}, nil
+}
+
+// completeAccessReview signs off the review as the caller, applies the revocations and saves the evidence
+func (r *mutationResolver) completeAccessReview(ctx context.Context, campaignID string) (*model.AccessReviewPayload, error) {
+	action := common.Action{Action: common.ActionUpdate, Object: "accessreview"}
+
+	if err := r.ensureCampaignEditAccess(ctx, campaignID); err != nil {
+		return nil, err
+	}
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return nil, newPermissionDeniedError()
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	review, err := accessreview.Complete(allowCtx, withTransactionalMutation(ctx), campaignID, caller.SubjectID, time.Now())
+	if err != nil {
+		return nil, accessReviewError(ctx, err, action)
+	}
+
+	return &model.AccessReviewPayload{AccessReview: accessReviewModel(campaignID, review)}, nil
+}
+
+// accessReview returns the review of the campaign to its reviewers and to users who can view the campaign
+func (r *queryResolver) accessReview(ctx context.Context, campaignID string) (*model.AccessReview, error) {
+	action := common.Action{Action: common.ActionGet, Object: "accessreview"}
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return nil, newPermissionDeniedError()
+	}
+
+	_, review, err := accessreview.Load(privacy.DecisionContext(ctx, privacy.Allow), withTransactionalMutation(ctx), campaignID)
+	if err != nil {
+		return nil, accessReviewError(ctx, err, action)
+	}
+
+	if !review.IsReviewer(caller.SubjectID) {
+		if err := r.checkAccessReviewAccess(ctx, generated.TypeCampaign, campaignID, fgax.CanView); err != nil {
+			return nil, err
+		}
+	}
+
+	return accessReviewModel(campaignID, review), nil
+}
+
+// checkAccessReviewAccess checks the caller has the relation on the object
+func (r *Resolver) checkAccessReviewAccess(ctx context.Context, objectType, objectID, relation string) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return newPermissionDeniedError()
+	}
+
+	if caller.Has(auth.CapSystemAdmin) {
+		return nil
+	}
+
+	allow, err := r.db.Authz.CheckAccess(ctx, fgax.AccessCheck{
+		ObjectType:  fgax.Kind(strcase.SnakeCase(objectType)),
+		ObjectID:    objectID,
+		Relation:    relation,
+		SubjectID:   caller.SubjectID,
+		SubjectType: caller.SubjectType(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !allow {
+		return newPermissionDeniedError()
+	}
+
+	return nil
+}
+
+// accessReviewError maps access review errors to errors returned to the user
+func accessReviewError(ctx context.Context, err error, a common.Action) error {
+	switch {
+	case errors.Is(err, accessreview.ErrReviewerNotAssigned):
+		return newPermissionDeniedError()
+	case errors.Is(err, accessreview.ErrReviewersRequired),
+		errors.Is(err, accessreview.ErrReviewerNotMember),
+		errors.Is(err, accessreview.ErrNotAccessReview),
+		errors.Is(err, accessreview.ErrReviewMissing),
+		errors.Is(err, accessreview.ErrReviewCompleted),
+		errors.Is(err, accessreview.ErrEntryNotFound),
+		errors.Is(err, accessreview.ErrInvalidDecision),
+		errors.Is(err, accessreview.ErrSelfReview),
+		errors.Is(err, accessreview.ErrPendingEntries):
+		return common.NewValidationError(err.Error())
+	default:
+		return parseRequestError(ctx, err, a)
+	}
+}
+
+// accessReviewModel converts the review to its graphql model
+func accessReviewModel(campaignID string, review *accessreview.Review) *model.AccessReview {
+	summary := review.Summary()
+
+	out := &model.AccessReview{
+		CampaignID:  campaignID,
+		SnapshotAt:  review.SnapshotAt,
+		ReviewerIDs: lo.CoalesceSliceOrEmpty(review.ReviewerIDs),
+		ControlIDs:  lo.CoalesceSliceOrEmpty(review.ControlIDs),
+		Entries:     make([]*model.AccessReviewEntry, 0, len(review.Entries)),
+		Summary: &model.AccessReviewSummary{
+			Total:     summary.Total,
+			Pending:   summary.Pending,
+			Certified: summary.Certified,
+			Revoked:   summary.Revoked,
+			Applied:   summary.Applied,
+			Failed:    summary.Failed,
+		},
+		CompletedBy: lo.EmptyableToPtr(review.CompletedBy),
+		CompletedAt: review.CompletedAt,
+		EvidenceID:  lo.EmptyableToPtr(review.EvidenceID),
+		Digest:      lo.EmptyableToPtr(review.Digest),
+	}
+
+	for _, e := range review.Entries {
+		out.Entries = append(out.Entries, &model.AccessReviewEntry{
+			ID:           e.ID,
+			Kind:         string(e.Kind),
+			SubjectID:    e.SubjectID,
+			SubjectName:  e.SubjectName,
+			ResourceID:   e.ResourceID,
+			ResourceName: e.ResourceName,
+			Role:         lo.EmptyableToPtr(e.Role),
+			Scopes:       e.Scopes,
+			ExpiresAt:    e.ExpiresAt,
+			LastUsedAt:   e.LastUsedAt,
+			Decision:     model.AccessReviewDecision(e.Decision),
+			DecidedBy:    lo.EmptyableToPtr(e.DecidedBy),
+			DecidedAt:    e.DecidedAt,
+			Comment:      lo.EmptyableToPtr(e.Comment),
+			AppliedAt:    e.AppliedAt,
+			ApplyError:   lo.EmptyableToPtr(e.ApplyError),
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,71 @@
+package graphapi_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/theopenlane/utils/ulids"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/theopenlane/core/internal/accessreview"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+)
+
+func TestAccessReviewConcurrentDecisions(t *testing.T) {
+	ctx := setContext(sharedTestUser1.UserCtx, suite.client.db)
+
+	tokenIDs := map[string]bool{}
+
+	for range 5 {
+		token := (&APITokenBuilder{client: suite.client}).MustNew(ctx, t)
+		tokenIDs[token.ID] = true
+	}
+
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	camp, review, err := accessreview.Create(allowCtx, suite.client.db, accessreview.CreateInput{
+		OrganizationID: sharedTestUser1.OrganizationID,
+		Name:           "access review " + ulids.New().String(),
+		ReviewerIDs:    []string{sharedTestUser1.ID},
+	}, time.Now())
+	assert.NilError(t, err)
+
+	var entryIDs []string
+
+	for _, e := range review.Entries {
+		if e.Kind == accessreview.KindAPIToken && tokenIDs[e.ID] {
+			entryIDs = append(entryIDs, e.ID)
+		}
+	}
+
+	assert.Assert(t, is.Len(entryIDs, len(tokenIDs)))
+
+	// each decision runs in its own transaction against the same campaign, none of them may overwrite another
+	var wg sync.WaitGroup
+
+	errs := make([]error, len(entryIDs))
+
+	for i, id := range entryIDs {
+		wg.Go(func() {
+			_, errs[i] = accessreview.Decide(allowCtx, suite.client.db, camp.ID, sharedTestUser1.ID, id,
+				accessreview.DecisionCertify, "", time.Now())
+		})
+	}
+
+	wg.Wait()
+
+	for _, err := range errs {
+		assert.NilError(t, err)
+	}
+
+	_, saved, err := accessreview.Load(allowCtx, suite.client.db, camp.ID)
+	assert.NilError(t, err)
+
+	for _, e := range saved.Entries {
+		if tokenIDs[e.ID] {
+			assert.Check(t, is.Equal(accessreview.DecisionCertify, e.Decision), "entry %s", e.ID)
+		}
+	}
+}
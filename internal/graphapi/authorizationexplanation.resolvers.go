@@ -0,0 +1,17 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// AuthorizationExplanation is the resolver for the authorizationExplanation field.
+func (r *queryResolver) AuthorizationExplanation(ctx context.Context, subjectType string, subjectID string, objectType string, objectID string, relation string) (*model.AuthorizationExplanation, error) {
+	return r.authorizationExplanation(ctx, subjectType, subjectID, objectType, objectID, relation)
+}
@@ -0,0 +1,79 @@
+package graphapi
+
+import (
+	"context"
+
+	"github.com/samber/lo"
+	"github.com/theopenlane/iam/auth"
+
+	"github.com/theopenlane/core/internal/authzexplain"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// authorizationExplanation explains why the subject can or cannot access the object, only system admins can see
+// the tuples of any organization so the query is restricted to them
+func (r *queryResolver) authorizationExplanation(ctx context.Context, subjectType, subjectID, objectType, objectID, relation string) (*model.AuthorizationExplanation, error) {
+	action := common.Action{Action: common.ActionGet, Object: "authorizationexplanation"}
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || !caller.Has(auth.CapSystemAdmin) {
+		return nil, newPermissionDeniedError()
+	}
+
+	explainer, err := authzexplain.New(authzexplain.NewFGAClient(&r.db.Authz))
+	if err != nil {
+		return nil, parseRequestError(ctx, err, action)
+	}
+
+	exp, err := explainer.Explain(ctx, authzexplain.Request{
+		SubjectType: subjectType,
+		SubjectID:   subjectID,
+		ObjectType:  objectType,
+		ObjectID:    objectID,
+		Relation:    relation,
+	})
+	if err != nil {
+		return nil, parseRequestError(ctx, err, action)
+	}
+
+	out := &model.AuthorizationExplanation{
+		Subject:          exp.Subject,
+		Object:           exp.Object,
+		Relation:         exp.Relation,
+		Allowed:          exp.Allowed,
+		Path:             make([]*model.AuthorizationPathStep, 0, len(exp.Path)),
+		GrantedRelations: exp.GrantedRelations,
+		Truncated:        exp.Truncated,
+	}
+
+	if out.GrantedRelations == nil {
+		out.GrantedRelations = []string{}
+	}
+
+	for _, step := range exp.Path {
+		out.Path = append(out.Path, authorizationPathStep(step))
+	}
+
+	if exp.MissingLink != nil {
+		out.MissingLink = authorizationPathStep(*exp.MissingLink)
+	}
+
+	return out, nil
+}
+
+// authorizationPathStep converts a step of a resolution path to its graphql model
+func authorizationPathStep(step authzexplain.Step) *model.AuthorizationPathStep {
+	out := &model.AuthorizationPathStep{
+		Object:      step.Object,
+		Relation:    step.Relation,
+		Source:      step.Source.String(),
+		Description: step.Description,
+	}
+
+	if step.Via != "" {
+		out.Via = lo.ToPtr(step.Via)
+	}
+
+	return out
+}
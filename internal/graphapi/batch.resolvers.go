@@ -0,0 +1,17 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// BatchMutation is the resolver for the batchMutation field.
+func (r *mutationResolver) BatchMutation(ctx context.Context, input model.BatchMutationInput) (*model.BatchMutationPayload, error) {
+	return r.batchMutation(ctx, input)
+}
@@ -0,0 +1,485 @@
+package graphapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/samber/lo"
+	"github.com/theopenlane/iam/auth"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/directives"
+	gqlgenerated "github.com/theopenlane/core/internal/graphapi/generated"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/utils/rout"
+)
+
+const (
+	// maxBatchOperations is the most operations accepted in a single batch mutation
+	maxBatchOperations = 100
+	// batchRefPrefix marks a value that refers to the ID of an earlier operation in the batch
+	batchRefPrefix = "$ref:"
+)
+
+var (
+	// ErrBatchUnknownRef is returned when a value refers to a label not set by an earlier operation
+	ErrBatchUnknownRef = errors.New("reference does not match an earlier operation in the batch")
+	// ErrBatchDuplicateRef is returned when more than one operation uses the same label
+	ErrBatchDuplicateRef = errors.New("reference label is used by more than one operation")
+)
+
+// batchSchema is the parsed GraphQL schema, used to look up the directives on the fields of batch inputs
+var batchSchema = sync.OnceValue(func() *ast.Schema {
+	return gqlgenerated.NewExecutableSchema(gqlgenerated.Config{}).Schema()
+})
+
+// batchHandler runs batch operations for a single object type
+type batchHandler struct {
+	create func(ctx context.Context, r *mutationResolver, input map[string]any) (string, error)
+	update func(ctx context.Context, r *mutationResolver, id string, input map[string]any) error
+	delete func(ctx context.Context, r *mutationResolver, id string) error
+}
+
+// batchEntity builds a batch handler from the create, update and delete resolvers of an object type so batch
+// operations get the same org handling, validation and cleanup as the single object mutations
+func batchEntity[C, U, CP, UP, DP any](
+	create func(*mutationResolver, context.Context, C) (CP, error),
+	createdID func(CP) string,
+	update func(*mutationResolver, context.Context, string, U) (UP, error),
+	del func(*mutationResolver, context.Context, string) (DP, error),
+) batchHandler {
+	return batchHandler{
+		create: func(ctx context.Context, r *mutationResolver, values map[string]any) (string, error) {
+			if err := checkBatchReadOnlyFields(ctx, batchInputName[C](), "", values); err != nil {
+				return "", err
+			}
+
+			input, err := decodeBatchInput[C](values)
+			if err != nil {
+				return "", err
+			}
+
+			res, err := create(r, ctx, input)
+			if err != nil {
+				return "", err
+			}
+
+			return createdID(res), nil
+		},
+		update: func(ctx context.Context, r *mutationResolver, id string, values map[string]any) error {
+			if err := checkBatchReadOnlyFields(ctx, batchInputName[U](), id, values); err != nil {
+				return err
+			}
+
+			input, err := decodeBatchInput[U](values)
+			if err != nil {
+				return err
+			}
+
+			_, err = update(r, ctx, id, input)
+
+			return err
+		},
+		delete: func(ctx context.Context, r *mutationResolver, id string) error {
+			_, err := del(r, ctx, id)
+
+			return err
+		},
+	}
+}
+
+// batchHandlers are the object types supported by the batch mutation, keyed by the lower case type name
+var batchHandlers = map[string]batchHandler{
+	"actionplan": batchEntity((*mutationResolver).CreateActionPlan,
+		func(p *model.ActionPlanCreatePayload) string { return p.ActionPlan.ID },
+		(*mutationResolver).UpdateActionPlan, (*mutationResolver).DeleteActionPlan),
+	"asset": batchEntity((*mutationResolver).CreateAsset,
+		func(p *model.AssetCreatePayload) string { return p.Asset.ID },
+		(*mutationResolver).UpdateAsset, (*mutationResolver).DeleteAsset),
+	"contact": batchEntity((*mutationResolver).CreateContact,
+		func(p *model.ContactCreatePayload) string { return p.Contact.ID },
+		(*mutationResolver).UpdateContact, (*mutationResolver).DeleteContact),
+	"control": batchEntity((*mutationResolver).CreateControl,
+		func(p *model.ControlCreatePayload) string { return p.Control.ID },
+		(*mutationResolver).UpdateControl, (*mutationResolver).DeleteControl),
+	"controlimplementation": batchEntity((*mutationResolver).CreateControlImplementation,
+		func(p *model.ControlImplementationCreatePayload) string { return p.ControlImplementation.ID },
+		(*mutationResolver).UpdateControlImplementation, (*mutationResolver).DeleteControlImplementation),
+	"controlobjective": batchEntity((*mutationResolver).CreateControlObjective,
+		func(p *model.ControlObjectiveCreatePayload) string { return p.ControlObjective.ID },
+		(*mutationResolver).UpdateControlObjective, (*mutationResolver).DeleteControlObjective),
+	"evidence": batchEntity(
+		func(r *mutationResolver, ctx context.Context, input generated.CreateEvidenceInput) (*model.EvidenceCreatePayload, error) {
+			return r.CreateEvidence(ctx, input, nil, nil)
+		},
+		func(p *model.EvidenceCreatePayload) string { return p.Evidence.ID },
+		func(r *mutationResolver, ctx context.Context, id string, input generated.UpdateEvidenceInput) (*model.EvidenceUpdatePayload, error) {
+			return r.UpdateEvidence(ctx, id, input, nil, nil)
+		},
+		(*mutationResolver).DeleteEvidence),
+	"finding": batchEntity((*mutationResolver).CreateFinding,
+		func(p *model.FindingCreatePayload) string { return p.Finding.ID },
+		(*mutationResolver).UpdateFinding, (*mutationResolver).DeleteFinding),
+	"internalpolicy": batchEntity((*mutationResolver).CreateInternalPolicy,
+		func(p *model.InternalPolicyCreatePayload) string { return p.InternalPolicy.ID },
+		func(r *mutationResolver, ctx context.Context, id string, input generated.UpdateInternalPolicyInput) (*model.InternalPolicyUpdatePayload, error) {
+			return r.UpdateInternalPolicy(ctx, id, input, nil, nil)
+		},
+		(*mutationResolver).DeleteInternalPolicy),
+	"mappedcontrol": batchEntity((*mutationResolver).CreateMappedControl,
+		func(p *model.MappedControlCreatePayload) string { return p.MappedControl.ID },
+		(*mutationResolver).UpdateMappedControl, (*mutationResolver).DeleteMappedControl),
+	"narrative": batchEntity((*mutationResolver).CreateNarrative,
+		func(p *model.NarrativeCreatePayload) string { return p.Narrative.ID },
+		(*mutationResolver).UpdateNarrative, (*mutationResolver).DeleteNarrative),
+	"procedure": batchEntity((*mutationResolver).CreateProcedure,
+		func(p *model.ProcedureCreatePayload) string { return p.Procedure.ID },
+		func(r *mutationResolver, ctx context.Context, id string, input generated.UpdateProcedureInput) (*model.ProcedureUpdatePayload, error) {
+			return r.UpdateProcedure(ctx, id, input, nil, nil)
+		},
+		(*mutationResolver).DeleteProcedure),
+	"program": batchEntity((*mutationResolver).CreateProgram,
+		func(p *model.ProgramCreatePayload) string { return p.Program.ID },
+		(*mutationResolver).UpdateProgram, (*mutationResolver).DeleteProgram),
+	"remediation": batchEntity((*mutationResolver).CreateRemediation,
+		func(p *model.RemediationCreatePayload) string { return p.Remediation.ID },
+		(*mutationResolver).UpdateRemediation, (*mutationResolver).DeleteRemediation),
+	"risk": batchEntity((*mutationResolver).CreateRisk,
+		func(p *model.RiskCreatePayload) string { return p.Risk.ID },
+		(*mutationResolver).UpdateRisk, (*mutationResolver).DeleteRisk),
+	"subcontrol": batchEntity((*mutationResolver).CreateSubcontrol,
+		func(p *model.SubcontrolCreatePayload) string { return p.Subcontrol.ID },
+		(*mutationResolver).UpdateSubcontrol, (*mutationResolver).DeleteSubcontrol),
+	"task": batchEntity((*mutationResolver).CreateTask,
+		func(p *model.TaskCreatePayload) string { return p.Task.ID },
+		(*mutationResolver).UpdateTask, (*mutationResolver).DeleteTask),
+	"vulnerability": batchEntity((*mutationResolver).CreateVulnerability,
+		func(p *model.VulnerabilityCreatePayload) string { return p.Vulnerability.ID },
+		(*mutationResolver).UpdateVulnerability, (*mutationResolver).DeleteVulnerability),
+}
+
+// batchMutation runs the operations in order; every operation uses the transaction of the mutation
+// so an error in any operation rolls back the changes made by the others
+func (r *mutationResolver) batchMutation(ctx context.Context, input model.BatchMutationInput) (*model.BatchMutationPayload, error) {
+	if len(input.Operations) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("operations")
+	}
+
+	if len(input.Operations) > maxBatchOperations {
+		return nil, common.NewValidationErrorWithFields(
+			fmt.Sprintf("a batch can contain at most %d operations", maxBatchOperations), "operations")
+	}
+
+	if err := validateBatchRefs(input.Operations); err != nil {
+		return nil, err
+	}
+
+	refs := map[string]string{}
+	results := make([]*model.BatchOperationResult, 0, len(input.Operations))
+
+	for i, op := range input.Operations {
+		id, err := r.runBatchOperation(ctx, op, refs)
+		if err != nil {
+			return nil, batchOperationError(ctx, i, err)
+		}
+
+		if ref := lo.FromPtr(op.Ref); ref != "" {
+			refs[ref] = id
+		}
+
+		results = append(results, &model.BatchOperationResult{
+			Index:      i,
+			Ref:        op.Ref,
+			Operation:  op.Operation,
+			ObjectType: op.ObjectType,
+			ID:         id,
+		})
+	}
+
+	return &model.BatchMutationPayload{
+		Results: results,
+	}, nil
+}
+
+// runBatchOperation resolves the references of a single operation and runs it, returning the ID of the object
+func (r *mutationResolver) runBatchOperation(ctx context.Context, op *model.BatchOperationInput, refs map[string]string) (string, error) {
+	handler, ok := batchHandlers[normalizeBatchObjectType(op.ObjectType)]
+	if !ok {
+		return "", common.NewValidationErrorWithFields(
+			fmt.Sprintf("object type %q is not supported in batch mutations", op.ObjectType), "objectType")
+	}
+
+	resolved, err := resolveBatchRefs(op.Input, refs)
+	if err != nil {
+		return "", err
+	}
+
+	values, _ := resolved.(map[string]any)
+
+	if op.Operation == model.BatchOperationTypeCreate {
+		if op.ID != nil {
+			return "", common.NewValidationErrorWithFields("id cannot be set on a create operation", "id")
+		}
+
+		return handler.create(ctx, r, values)
+	}
+
+	id, err := resolveBatchRefs(lo.FromPtr(op.ID), refs)
+	if err != nil {
+		return "", err
+	}
+
+	objectID, _ := id.(string)
+	if objectID == "" {
+		return "", rout.NewMissingRequiredFieldError("id")
+	}
+
+	switch op.Operation {
+	case model.BatchOperationTypeUpdate:
+		return objectID, handler.update(ctx, r, objectID, values)
+	case model.BatchOperationTypeDelete:
+		return objectID, handler.delete(ctx, r, objectID)
+	default:
+		return "", common.NewValidationErrorWithFields(
+			fmt.Sprintf("operation %q is not supported", op.Operation), "operation")
+	}
+}
+
+// validateBatchRefs checks reference labels are unique within the batch before any operation runs
+func validateBatchRefs(ops []*model.BatchOperationInput) error {
+	seen := map[string]struct{}{}
+
+	for _, op := range ops {
+		ref := lo.FromPtr(op.Ref)
+		if ref == "" {
+			continue
+		}
+
+		if _, ok := seen[ref]; ok {
+			return common.NewValidationErrorWithFields(fmt.Sprintf("%s: %s", ErrBatchDuplicateRef.Error(), ref), "ref")
+		}
+
+		seen[ref] = struct{}{}
+	}
+
+	return nil
+}
+
+// resolveBatchRefs replaces "$ref:<label>" strings anywhere in the value with the ID produced by the
+// operation with that label; only operations that already ran can be referenced
+func resolveBatchRefs(value any, refs map[string]string) (any, error) {
+	switch v := value.(type) {
+	case string:
+		label, ok := strings.CutPrefix(v, batchRefPrefix)
+		if !ok {
+			return v, nil
+		}
+
+		id, ok := refs[label]
+		if !ok {
+			return nil, common.NewValidationError(fmt.Sprintf("%s: %s", ErrBatchUnknownRef.Error(), label))
+		}
+
+		return id, nil
+	case map[string]any:
+		out := make(map[string]any, len(v))
+
+		for key, item := range v {
+			resolved, err := resolveBatchRefs(item, refs)
+			if err != nil {
+				return nil, err
+			}
+
+			out[key] = resolved
+		}
+
+		return out, nil
+	case []any:
+		out := make([]any, len(v))
+
+		for i, item := range v {
+			resolved, err := resolveBatchRefs(item, refs)
+			if err != nil {
+				return nil, err
+			}
+
+			out[i] = resolved
+		}
+
+		return out, nil
+	default:
+		return v, nil
+	}
+}
+
+// decodeBatchInput decodes the input values of an operation into the create or update input of the object
+// type; keys use the GraphQL field names of the input which match the Go field names ignoring case
+func decodeBatchInput[T any](values map[string]any) (T, error) {
+	var input T
+
+	fields := map[string]string{}
+
+	t := reflect.TypeOf(input)
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		key := f.Name
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name != "" && name != "-" {
+			key = name
+		}
+
+		fields[strings.ToLower(f.Name)] = key
+	}
+
+	converted := make(map[string]any, len(values))
+
+	for name, value := range values {
+		key, ok := fields[strings.ToLower(name)]
+		if !ok {
+			return input, common.NewValidationErrorWithFields(fmt.Sprintf("unknown input field %q", name), name)
+		}
+
+		converted[key] = value
+	}
+
+	data, err := json.Marshal(converted)
+	if err != nil {
+		return input, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(&input); err != nil {
+		return input, common.NewValidationErrorWithFields(fmt.Sprintf("invalid input: %s", err.Error()), "input")
+	}
+
+	return input, nil
+}
+
+// batchInputName returns the GraphQL name of a create or update input, which matches the Go type name
+func batchInputName[T any]() string {
+	var input T
+
+	return reflect.TypeOf(input).Name()
+}
+
+// checkBatchReadOnlyFields applies the @readOnly and @externalReadOnly directives of the input type to the values
+// of a batch operation; the directives only run when GraphQL decodes a mutation argument, so values decoded from
+// the batch input map are checked here instead. An id is only passed for updates, matching the directive which
+// skips create operations for external read only fields
+func checkBatchReadOnlyFields(ctx context.Context, inputType, id string, values map[string]any) error {
+	if auth.IsSystemAdminFromContext(ctx) {
+		return nil
+	}
+
+	def := batchSchema().Types[inputType]
+	if def == nil {
+		return nil
+	}
+
+	for name, value := range values {
+		if value == nil {
+			continue
+		}
+
+		field := lo.FindOrElse(def.Fields, nil, func(f *ast.FieldDefinition) bool {
+			return strings.EqualFold(f.Name, name)
+		})
+		if field == nil {
+			continue
+		}
+
+		readOnly, err := readOnlyInputField(ctx, field, id)
+		if err != nil {
+			return err
+		}
+
+		if readOnly {
+			return fmt.Errorf("%w: %s", directives.ErrReadOnlyField, field.Name)
+		}
+	}
+
+	return nil
+}
+
+// readOnlyInputField reports whether the input field is @readOnly, or @externalReadOnly on an object created by
+// the restricted source; external read only fields are only checked when the id of an existing object is given
+func readOnlyInputField(ctx context.Context, field *ast.FieldDefinition, id string) (bool, error) {
+	if field.Directives.ForName(directives.ReadOnly) != nil {
+		return true, nil
+	}
+
+	external := field.Directives.ForName(directives.ExternalReadOnly)
+	if external == nil || id == "" {
+		return false, nil
+	}
+
+	return batchExternalSourceRestricted(ctx, external, id)
+}
+
+// batchExternalSourceRestricted reports whether the control or subcontrol being updated was created by the source
+// named in the @externalReadOnly directive, in which case its externally populated fields cannot be changed
+func batchExternalSourceRestricted(ctx context.Context, directive *ast.Directive, id string) (bool, error) {
+	arg := directive.Arguments.ForName("source")
+	if arg == nil || arg.Value == nil {
+		return false, nil
+	}
+
+	restricted := enums.ToControlSource(arg.Value.Raw)
+	if restricted == nil {
+		return false, nil
+	}
+
+	client := withTransactionalMutation(ctx)
+
+	control, err := client.Control.Get(ctx, id)
+	if err == nil {
+		return control.Source == *restricted, nil
+	}
+
+	if !generated.IsNotFound(err) {
+		return false, err
+	}
+
+	subcontrol, err := client.Subcontrol.Get(ctx, id)
+	if err != nil {
+		if generated.IsNotFound(err) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return subcontrol.Source == *restricted, nil
+}
+
+// normalizeBatchObjectType accepts the object type as the GraphQL type name or the snake case schema name
+func normalizeBatchObjectType(objectType string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(objectType), "_", ""))
+}
+
+// batchOperationError adds the index of the failed operation to the error returned to the client while
+// keeping the original error so its code is still presented
+func batchOperationError(ctx context.Context, index int, err error) error {
+	return &gqlerror.Error{
+		Err:        err,
+		Message:    fmt.Sprintf("batch operation %d failed: %s", index, err.Error()),
+		Path:       graphql.GetPath(ctx),
+		Extensions: map[string]any{"operationIndex": index},
+	}
+}
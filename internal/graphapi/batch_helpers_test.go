@@ -0,0 +1,125 @@
+package graphapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphapi/directives"
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+func TestResolveBatchRefs(t *testing.T) {
+	refs := map[string]string{"risk": "01RISK", "control": "01CONTROL"}
+
+	resolved, err := resolveBatchRefs(map[string]any{
+		"name":       "$ref is only replaced as a prefix",
+		"riskIDs":    []any{"$ref:risk", "01OTHER"},
+		"controlID":  "$ref:control",
+		"nested":     map[string]any{"id": "$ref:risk"},
+		"priority":   1,
+		"unassigned": nil,
+	}, refs)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]any{
+		"name":       "$ref is only replaced as a prefix",
+		"riskIDs":    []any{"01RISK", "01OTHER"},
+		"controlID":  "01CONTROL",
+		"nested":     map[string]any{"id": "01RISK"},
+		"priority":   1,
+		"unassigned": nil,
+	}, resolved)
+
+	_, err = resolveBatchRefs([]any{"$ref:task"}, refs)
+	assert.ErrorContains(t, err, "task")
+}
+
+func TestValidateBatchRefs(t *testing.T) {
+	ops := []*model.BatchOperationInput{
+		{Ref: lo.ToPtr("risk")},
+		{},
+		{Ref: lo.ToPtr("control")},
+	}
+
+	require.NoError(t, validateBatchRefs(ops))
+
+	ops = append(ops, &model.BatchOperationInput{Ref: lo.ToPtr("risk")})
+	assert.ErrorContains(t, validateBatchRefs(ops), "risk")
+}
+
+func TestDecodeBatchInput(t *testing.T) {
+	input, err := decodeBatchInput[generated.CreateRiskInput](map[string]any{
+		"name":       "vendor outage",
+		"ownerID":    "01ORG",
+		"controlIDs": []any{"01CONTROL"},
+		"tags":       []any{"vendor"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "vendor outage", input.Name)
+	assert.Equal(t, "01ORG", lo.FromPtr(input.OwnerID))
+	assert.Equal(t, []string{"01CONTROL"}, input.ControlIDs)
+	assert.Equal(t, []string{"vendor"}, input.Tags)
+
+	update, err := decodeBatchInput[generated.UpdateRiskInput](map[string]any{
+		"addControlIDs": []any{"01CONTROL"},
+		"clearTags":     true,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"01CONTROL"}, update.AddControlIDs)
+	assert.True(t, update.ClearTags)
+
+	_, err = decodeBatchInput[generated.CreateRiskInput](map[string]any{"notAField": "value"})
+	assert.ErrorContains(t, err, "notAField")
+
+	_, err = decodeBatchInput[generated.CreateRiskInput](map[string]any{"name": 10})
+	assert.Error(t, err)
+}
+
+func TestNormalizeBatchObjectType(t *testing.T) {
+	assert.Equal(t, "actionplan", normalizeBatchObjectType("ActionPlan"))
+	assert.Equal(t, "actionplan", normalizeBatchObjectType("action_plan"))
+	assert.Equal(t, "risk", normalizeBatchObjectType(" Risk "))
+
+	for _, objectType := range []string{"Risk", "Control", "Task", "InternalPolicy", "Evidence"} {
+		_, ok := batchHandlers[normalizeBatchObjectType(objectType)]
+		assert.True(t, ok, objectType)
+	}
+}
+
+func TestCheckBatchReadOnlyFields(t *testing.T) {
+	ctx := context.Background()
+
+	assert.Equal(t, "CreateFindingInput", batchInputName[generated.CreateFindingInput]())
+
+	err := checkBatchReadOnlyFields(ctx, batchInputName[generated.CreateFindingInput](), "", map[string]any{
+		"displayName":   "finding",
+		"internalNotes": "only system admins can set this",
+	})
+	assert.ErrorIs(t, err, directives.ErrReadOnlyField)
+	assert.ErrorContains(t, err, "internalNotes")
+
+	// field names are matched ignoring case, as when the input is decoded
+	err = checkBatchReadOnlyFields(ctx, batchInputName[generated.UpdateFindingInput](), "01FINDING", map[string]any{
+		"systeminternalid": "abc",
+	})
+	assert.ErrorIs(t, err, directives.ErrReadOnlyField)
+
+	// unset read only fields and other fields are allowed
+	require.NoError(t, checkBatchReadOnlyFields(ctx, batchInputName[generated.CreateFindingInput](), "", map[string]any{
+		"displayName":   "finding",
+		"internalNotes": nil,
+	}))
+
+	// external read only fields are only checked on update, as with the directive
+	require.NoError(t, checkBatchReadOnlyFields(ctx, batchInputName[generated.CreateControlInput](), "", map[string]any{
+		"refCode":     "AC-1",
+		"description": "set on create",
+	}))
+}
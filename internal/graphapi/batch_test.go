@@ -0,0 +1,95 @@
+package graphapi_test
+
+import (
+	"testing"
+
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/theopenlane/utils/ulids"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/risk"
+	"github.com/theopenlane/core/internal/graphapi/testclient"
+)
+
+func TestMutationBatch(t *testing.T) {
+	resp, err := suite.client.api.BatchMutation(sharedTestUser1.UserCtx, testclient.BatchMutationInput{
+		Operations: []*testclient.BatchOperationInput{
+			{
+				Ref:        lo.ToPtr("risk"),
+				Operation:  testclient.BatchOperationTypeCreate,
+				ObjectType: "Risk",
+				Input:      map[string]any{"name": "batch risk"},
+			},
+			{
+				Operation:  testclient.BatchOperationTypeUpdate,
+				ObjectType: "Risk",
+				ID:         lo.ToPtr("$ref:risk"),
+				Input:      map[string]any{"details": "updated in the same batch"},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(resp.BatchMutation.Results, 2))
+
+	riskID := resp.BatchMutation.Results[0].ID
+	assert.Check(t, is.Equal(riskID, resp.BatchMutation.Results[1].ID))
+
+	created, err := suite.client.db.Risk.Get(sharedTestUser1.UserCtx, riskID)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal("updated in the same batch", created.Details))
+
+	(&Cleanup[*generated.RiskDeleteOne]{client: suite.client.db.Risk, ID: riskID}).MustDelete(sharedTestUser1.UserCtx, t)
+}
+
+func TestMutationBatchRollback(t *testing.T) {
+	name := "batch risk rolled back " + ulids.New().String()
+
+	_, err := suite.client.api.BatchMutation(sharedTestUser1.UserCtx, testclient.BatchMutationInput{
+		Operations: []*testclient.BatchOperationInput{
+			{
+				Operation:  testclient.BatchOperationTypeCreate,
+				ObjectType: "Risk",
+				Input:      map[string]any{"name": name},
+			},
+			{
+				Operation:  testclient.BatchOperationTypeUpdate,
+				ObjectType: "Risk",
+				ID:         lo.ToPtr(ulids.New().String()),
+				Input:      map[string]any{"details": "object does not exist"},
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "batch operation 1 failed")
+
+	// the risk created by the first operation is rolled back with the failed update
+	count, err := suite.client.db.Risk.Query().Where(risk.Name(name)).Count(sharedTestUser1.UserCtx)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal(0, count))
+}
+
+func TestMutationBatchReadOnlyFields(t *testing.T) {
+	name := "batch risk with read only sibling " + ulids.New().String()
+
+	_, err := suite.client.api.BatchMutation(sharedTestUser1.UserCtx, testclient.BatchMutationInput{
+		Operations: []*testclient.BatchOperationInput{
+			{
+				Operation:  testclient.BatchOperationTypeCreate,
+				ObjectType: "Risk",
+				Input:      map[string]any{"name": name},
+			},
+			{
+				Operation:  testclient.BatchOperationTypeCreate,
+				ObjectType: "Finding",
+				Input:      map[string]any{"internalNotes": "only system admins can set this"},
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "read only field")
+
+	count, err := suite.client.db.Risk.Query().Where(risk.Name(name)).Count(sharedTestUser1.UserCtx)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal(0, count))
+}
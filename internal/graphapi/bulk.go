@@ -2110,6 +2110,225 @@ func (r *mutationResolver) bulkUpdateCSVCustomDomain(ctx context.Context, inputs
 	}, nil
 }
 
+// bulkCreateCustomRole uses the CreateBulk function to create multiple CustomRole entities
+func (r *mutationResolver) bulkCreateCustomRole(ctx context.Context, input []*generated.CreateCustomRoleInput) (*model.CustomRoleBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.CustomRoleCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.CustomRole.Create().SetInput(*data)
+	}
+
+	res, err := c.CustomRole.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionCreate, Object: "customrole"})
+	}
+
+	// return response
+	return &model.CustomRoleBulkCreatePayload{
+		CustomRoles: res,
+	}, nil
+}
+
+// bulkUpdateCustomRole updates multiple CustomRole entities
+func (r *mutationResolver) bulkUpdateCustomRole(ctx context.Context, ids []string, input generated.UpdateCustomRoleInput) (*model.CustomRoleBulkUpdatePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	originalIDs := append([]string(nil), ids...)
+	ids = r.filterAuthorizedIDs(ctx, ids, "custom_role", fgax.CanEdit)
+	if len(ids) == 0 {
+		err := gqlerrors.BulkActionIncomplete
+
+		return &model.CustomRoleBulkUpdatePayload{
+			CustomRoles:   []*generated.CustomRole{},
+			UpdatedIDs:    []string{},
+			NotUpdatedIDs: originalIDs,
+			Error:         &err,
+		}, nil
+	}
+
+	c := withTransactionalMutation(ctx)
+	results := make([]*generated.CustomRole, 0, len(ids))
+	updatedIDs := make([]string, 0, len(ids))
+
+	// update each customrole individually to ensure proper validation
+	for _, id := range ids {
+		if id == "" {
+			logx.FromContext(ctx).Error().Msg("empty id in bulk update for customrole")
+			continue
+		}
+
+		// get the existing entity first
+		existing, err := c.CustomRole.Get(ctx, id)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("customrole_id", id).Msg("failed to get customrole in bulk update operation")
+			continue
+		}
+
+		// setup update request
+		updatedEntity, err := existing.Update().SetInput(input).Save(ctx)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("customrole_id", id).Msg("failed to update customrole in bulk operation")
+			continue
+		}
+
+		results = append(results, updatedEntity)
+		updatedIDs = append(updatedIDs, id)
+	}
+
+	updated := make(map[string]struct{}, len(updatedIDs))
+	for _, id := range updatedIDs {
+		updated[id] = struct{}{}
+	}
+
+	notUpdatedIDs := make([]string, 0, len(originalIDs))
+	for _, id := range originalIDs {
+		if _, ok := updated[id]; !ok {
+			notUpdatedIDs = append(notUpdatedIDs, id)
+		}
+	}
+
+	var err *string
+	if len(notUpdatedIDs) > 0 {
+		bulkActionIncomplete := gqlerrors.BulkActionIncomplete
+		err = &bulkActionIncomplete
+	}
+
+	return &model.CustomRoleBulkUpdatePayload{
+		CustomRoles:   results,
+		UpdatedIDs:    updatedIDs,
+		NotUpdatedIDs: notUpdatedIDs,
+		Error:         err,
+	}, nil
+}
+
+// bulkUpdateCSVCustomRole updates multiple CustomRole entities from CSV data with per-row values
+func (r *mutationResolver) bulkUpdateCSVCustomRole(ctx context.Context, inputs []*csvgenerated.CustomRoleCSVUpdateInput) (*model.CustomRoleBulkUpdatePayload, error) {
+	if len(inputs) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	c := withTransactionalMutation(ctx)
+	results := make([]*generated.CustomRole, 0, len(inputs))
+	updatedIDs := make([]string, 0, len(inputs))
+
+	// update each customrole individually with its own input values
+	for _, input := range inputs {
+		if input == nil || input.ID == "" {
+			logx.FromContext(ctx).Error().Msg("empty id in CSV bulk update for customrole")
+			continue
+		}
+
+		// get the existing entity first
+		existing, err := c.CustomRole.Get(ctx, input.ID)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("customrole_id", input.ID).Msg("failed to get customrole in CSV bulk update operation")
+			continue
+		}
+
+		// setup update request with this row's input values
+		updatedEntity, err := existing.Update().SetInput(input.Input).Save(ctx)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("customrole_id", input.ID).Msg("failed to update customrole in CSV bulk operation")
+			return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "customrole"})
+		}
+
+		results = append(results, updatedEntity)
+		updatedIDs = append(updatedIDs, input.ID)
+	}
+
+	return &model.CustomRoleBulkUpdatePayload{
+		CustomRoles: results,
+		UpdatedIDs:  updatedIDs,
+	}, nil
+}
+
+// bulkDeleteCustomRole deletes multiple CustomRole entities by their IDs
+func (r *mutationResolver) bulkDeleteCustomRole(ctx context.Context, ids []string) (*model.CustomRoleBulkDeletePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	originalIDs := append([]string(nil), ids...)
+	ids = r.filterAuthorizedIDs(ctx, ids, "custom_role", fgax.CanDelete)
+	if len(ids) == 0 {
+		err := gqlerrors.BulkActionIncomplete
+
+		return &model.CustomRoleBulkDeletePayload{
+			DeletedIDs:    []string{},
+			NotDeletedIDs: originalIDs,
+			Error:         &err,
+		}, nil
+	}
+
+	deletedIDs := make([]string, 0, len(ids))
+	errors := make([]error, 0, len(ids))
+
+	var mu sync.Mutex
+
+	funcs := make([]func(), 0, len(ids))
+	for _, id := range ids {
+		funcs = append(funcs, func() {
+			// use r.db in context so interceptors use the connection pool instead of the shared transaction
+			poolCtx := generated.NewContext(ctx, r.db)
+
+			// delete each customrole individually to ensure proper cleanup
+			if err := r.db.CustomRole.DeleteOneID(id).Exec(poolCtx); err != nil {
+				logx.FromContext(poolCtx).Error().Err(err).Str("customrole_id", id).Msg("failed to delete customrole in bulk operation")
+				mu.Lock()
+				errors = append(errors, err)
+				mu.Unlock()
+				return
+			}
+
+			if err := generated.CustomRoleEdgeCleanup(poolCtx, id); err != nil {
+				logx.FromContext(poolCtx).Error().Err(err).Str("customrole_id", id).Msg("failed to cleanup customrole edges in bulk operation")
+				mu.Lock()
+				errors = append(errors, err)
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			deletedIDs = append(deletedIDs, id)
+			mu.Unlock()
+		})
+	}
+
+	if err := r.withPool().SubmitMultipleAndWait(funcs); err != nil {
+		return nil, err
+	}
+
+	if len(errors) > 0 {
+		logx.FromContext(ctx).Error().Int("deleted_items", len(deletedIDs)).Int("errors", len(errors)).Msg("some customrole deletions failed")
+	}
+
+	deleted := make(map[string]struct{}, len(deletedIDs))
+	for _, id := range deletedIDs {
+		deleted[id] = struct{}{}
+	}
+
+	notDeletedIDs := make([]string, 0, len(originalIDs))
+	for _, id := range originalIDs {
+		if _, ok := deleted[id]; !ok {
+			notDeletedIDs = append(notDeletedIDs, id)
+		}
+	}
+
+	var err *string
+	if len(notDeletedIDs) > 0 {
+		bulkActionIncomplete := gqlerrors.BulkActionIncomplete
+		err = &bulkActionIncomplete
+	}
+
+	return &model.CustomRoleBulkDeletePayload{
+		DeletedIDs:    deletedIDs,
+		NotDeletedIDs: notDeletedIDs,
+		Error:         err,
+	}, nil
+}
+
 // bulkCreateCustomTypeEnum uses the CreateBulk function to create multiple CustomTypeEnum entities
 func (r *mutationResolver) bulkCreateCustomTypeEnum(ctx context.Context, input []*generated.CreateCustomTypeEnumInput) (*model.CustomTypeEnumBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
@@ -8345,6 +8564,225 @@ func (r *mutationResolver) bulkDeleteRemediation(ctx context.Context, ids []stri
 	}, nil
 }
 
+// bulkCreateRetentionRule uses the CreateBulk function to create multiple RetentionRule entities
+func (r *mutationResolver) bulkCreateRetentionRule(ctx context.Context, input []*generated.CreateRetentionRuleInput) (*model.RetentionRuleBulkCreatePayload, error) {
+	c := withTransactionalMutation(ctx)
+	builders := make([]*generated.RetentionRuleCreate, len(input))
+	for i, data := range input {
+		builders[i] = c.RetentionRule.Create().SetInput(*data)
+	}
+
+	res, err := c.RetentionRule.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionCreate, Object: "retentionrule"})
+	}
+
+	// return response
+	return &model.RetentionRuleBulkCreatePayload{
+		RetentionRules: res,
+	}, nil
+}
+
+// bulkUpdateRetentionRule updates multiple RetentionRule entities
+func (r *mutationResolver) bulkUpdateRetentionRule(ctx context.Context, ids []string, input generated.UpdateRetentionRuleInput) (*model.RetentionRuleBulkUpdatePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	originalIDs := append([]string(nil), ids...)
+	ids = r.filterAuthorizedIDs(ctx, ids, "retention_rule", fgax.CanEdit)
+	if len(ids) == 0 {
+		err := gqlerrors.BulkActionIncomplete
+
+		return &model.RetentionRuleBulkUpdatePayload{
+			RetentionRules: []*generated.RetentionRule{},
+			UpdatedIDs:     []string{},
+			NotUpdatedIDs:  originalIDs,
+			Error:          &err,
+		}, nil
+	}
+
+	c := withTransactionalMutation(ctx)
+	results := make([]*generated.RetentionRule, 0, len(ids))
+	updatedIDs := make([]string, 0, len(ids))
+
+	// update each retentionrule individually to ensure proper validation
+	for _, id := range ids {
+		if id == "" {
+			logx.FromContext(ctx).Error().Msg("empty id in bulk update for retentionrule")
+			continue
+		}
+
+		// get the existing entity first
+		existing, err := c.RetentionRule.Get(ctx, id)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("retentionrule_id", id).Msg("failed to get retentionrule in bulk update operation")
+			continue
+		}
+
+		// setup update request
+		updatedEntity, err := existing.Update().SetInput(input).Save(ctx)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("retentionrule_id", id).Msg("failed to update retentionrule in bulk operation")
+			continue
+		}
+
+		results = append(results, updatedEntity)
+		updatedIDs = append(updatedIDs, id)
+	}
+
+	updated := make(map[string]struct{}, len(updatedIDs))
+	for _, id := range updatedIDs {
+		updated[id] = struct{}{}
+	}
+
+	notUpdatedIDs := make([]string, 0, len(originalIDs))
+	for _, id := range originalIDs {
+		if _, ok := updated[id]; !ok {
+			notUpdatedIDs = append(notUpdatedIDs, id)
+		}
+	}
+
+	var err *string
+	if len(notUpdatedIDs) > 0 {
+		bulkActionIncomplete := gqlerrors.BulkActionIncomplete
+		err = &bulkActionIncomplete
+	}
+
+	return &model.RetentionRuleBulkUpdatePayload{
+		RetentionRules: results,
+		UpdatedIDs:     updatedIDs,
+		NotUpdatedIDs:  notUpdatedIDs,
+		Error:          err,
+	}, nil
+}
+
+// bulkUpdateCSVRetentionRule updates multiple RetentionRule entities from CSV data with per-row values
+func (r *mutationResolver) bulkUpdateCSVRetentionRule(ctx context.Context, inputs []*csvgenerated.RetentionRuleCSVUpdateInput) (*model.RetentionRuleBulkUpdatePayload, error) {
+	if len(inputs) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	c := withTransactionalMutation(ctx)
+	results := make([]*generated.RetentionRule, 0, len(inputs))
+	updatedIDs := make([]string, 0, len(inputs))
+
+	// update each retentionrule individually with its own input values
+	for _, input := range inputs {
+		if input == nil || input.ID == "" {
+			logx.FromContext(ctx).Error().Msg("empty id in CSV bulk update for retentionrule")
+			continue
+		}
+
+		// get the existing entity first
+		existing, err := c.RetentionRule.Get(ctx, input.ID)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("retentionrule_id", input.ID).Msg("failed to get retentionrule in CSV bulk update operation")
+			continue
+		}
+
+		// setup update request with this row's input values
+		updatedEntity, err := existing.Update().SetInput(input.Input).Save(ctx)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("retentionrule_id", input.ID).Msg("failed to update retentionrule in CSV bulk operation")
+			return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "retentionrule"})
+		}
+
+		results = append(results, updatedEntity)
+		updatedIDs = append(updatedIDs, input.ID)
+	}
+
+	return &model.RetentionRuleBulkUpdatePayload{
+		RetentionRules: results,
+		UpdatedIDs:     updatedIDs,
+	}, nil
+}
+
+// bulkDeleteRetentionRule deletes multiple RetentionRule entities by their IDs
+func (r *mutationResolver) bulkDeleteRetentionRule(ctx context.Context, ids []string) (*model.RetentionRuleBulkDeletePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	originalIDs := append([]string(nil), ids...)
+	ids = r.filterAuthorizedIDs(ctx, ids, "retention_rule", fgax.CanDelete)
+	if len(ids) == 0 {
+		err := gqlerrors.BulkActionIncomplete
+
+		return &model.RetentionRuleBulkDeletePayload{
+			DeletedIDs:    []string{},
+			NotDeletedIDs: originalIDs,
+			Error:         &err,
+		}, nil
+	}
+
+	deletedIDs := make([]string, 0, len(ids))
+	errors := make([]error, 0, len(ids))
+
+	var mu sync.Mutex
+
+	funcs := make([]func(), 0, len(ids))
+	for _, id := range ids {
+		funcs = append(funcs, func() {
+			// use r.db in context so interceptors use the connection pool instead of the shared transaction
+			poolCtx := generated.NewContext(ctx, r.db)
+
+			// delete each retentionrule individually to ensure proper cleanup
+			if err := r.db.RetentionRule.DeleteOneID(id).Exec(poolCtx); err != nil {
+				logx.FromContext(poolCtx).Error().Err(err).Str("retentionrule_id", id).Msg("failed to delete retentionrule in bulk operation")
+				mu.Lock()
+				errors = append(errors, err)
+				mu.Unlock()
+				return
+			}
+
+			if err := generated.RetentionRuleEdgeCleanup(poolCtx, id); err != nil {
+				logx.FromContext(poolCtx).Error().Err(err).Str("retentionrule_id", id).Msg("failed to cleanup retentionrule edges in bulk operation")
+				mu.Lock()
+				errors = append(errors, err)
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			deletedIDs = append(deletedIDs, id)
+			mu.Unlock()
+		})
+	}
+
+	if err := r.withPool().SubmitMultipleAndWait(funcs); err != nil {
+		return nil, err
+	}
+
+	if len(errors) > 0 {
+		logx.FromContext(ctx).Error().Int("deleted_items", len(deletedIDs)).Int("errors", len(errors)).Msg("some retentionrule deletions failed")
+	}
+
+	deleted := make(map[string]struct{}, len(deletedIDs))
+	for _, id := range deletedIDs {
+		deleted[id] = struct{}{}
+	}
+
+	notDeletedIDs := make([]string, 0, len(originalIDs))
+	for _, id := range originalIDs {
+		if _, ok := deleted[id]; !ok {
+			notDeletedIDs = append(notDeletedIDs, id)
+		}
+	}
+
+	var err *string
+	if len(notDeletedIDs) > 0 {
+		bulkActionIncomplete := gqlerrors.BulkActionIncomplete
+		err = &bulkActionIncomplete
+	}
+
+	return &model.RetentionRuleBulkDeletePayload{
+		DeletedIDs:    deletedIDs,
+		NotDeletedIDs: notDeletedIDs,
+		Error:         err,
+	}, nil
+}
+
 // bulkCreateReview uses the CreateBulk function to create multiple Review entities
 func (r *mutationResolver) bulkCreateReview(ctx context.Context, input []*generated.CreateReviewInput) (*model.ReviewBulkCreatePayload, error) {
 	c := withTransactionalMutation(ctx)
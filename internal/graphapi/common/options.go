@@ -6,6 +6,7 @@ import (
 	"ariga.io/entcache"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/theopenlane/core/internal/entdb"
 	"github.com/theopenlane/core/internal/objects"
 	"github.com/theopenlane/gqlgen-plugins/graphutils"
 	"github.com/vektah/gqlparser/v2/ast"
@@ -28,6 +29,21 @@ func WithContextLevelCache(h *handler.Server) {
 	})
 }
 
+// WithReplicaReads routes the database reads of query operations to the read replicas when they are configured;
+// mutations and subscriptions stay on the primary and record the primary position once their response is ready
+func WithReplicaReads(h *handler.Server) {
+	h.AroundResponses(func(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+		if op := graphql.GetOperationContext(ctx).Operation; op != nil && op.Operation == ast.Query {
+			ctx = entdb.WithReplicaRead(ctx)
+		}
+
+		ctx = entdb.WithReplicaWrites(ctx)
+		defer entdb.FlushReplicaWrites(ctx)
+
+		return next(ctx)
+	})
+}
+
 // WithResultLimit adds a max result limit to the handler in order to set limits on
 // all nested edges in the graphql request
 func WithResultLimit(h *handler.Server, limit *int) {
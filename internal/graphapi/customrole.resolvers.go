@@ -0,0 +1,191 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/theopenlane/core/internal/ent/csvgenerated"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/customrole"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/utils/rout"
+)
+
+// CreateCustomRole is the resolver for the createCustomRole field.
+func (r *mutationResolver) CreateCustomRole(ctx context.Context, input generated.CreateCustomRoleInput) (*model.CustomRoleCreatePayload, error) {
+	// set the organization in the auth context if its not done for us
+	ctx, err := common.SetOrganizationInAuthContext(ctx, input.OwnerID)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		return nil, rout.NewMissingRequiredFieldError("owner_id")
+	}
+
+	res, err := withTransactionalMutation(ctx).CustomRole.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionCreate, Object: "customrole"})
+	}
+
+	return &model.CustomRoleCreatePayload{
+		CustomRole: res,
+	}, nil
+}
+
+// CreateBulkCustomRole is the resolver for the createBulkCustomRole field.
+func (r *mutationResolver) CreateBulkCustomRole(ctx context.Context, input []*generated.CreateCustomRoleInput) (*model.CustomRoleBulkCreatePayload, error) {
+	if len(input) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	// set the organization in the auth context if its not done for us
+	// this will choose the first input OwnerID when using a personal access token
+	ctx, err := common.SetOrganizationInAuthContextBulkRequest(ctx, input)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		return nil, rout.NewMissingRequiredFieldError("owner_id")
+	}
+
+	return r.bulkCreateCustomRole(ctx, input)
+}
+
+// CreateBulkCSVCustomRole is the resolver for the createBulkCSVCustomRole field.
+func (r *mutationResolver) CreateBulkCSVCustomRole(ctx context.Context, input graphql.Upload) (*model.CustomRoleBulkCreatePayload, error) {
+	data, err := common.UnmarshalBulkData[csvgenerated.CustomRoleCSVInput](input)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to unmarshal bulk data")
+
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionCreate, Object: "customrole"})
+	}
+
+	if len(data) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	// set the organization in the auth context if its not done for us
+	// this will choose the first input OwnerID when using a personal access token
+	ctx, err = common.SetOrganizationInAuthContextBulkRequest(ctx, data)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		if _, ownerErr := common.GetBulkUploadOwnerInput(data); ownerErr != nil {
+			return nil, ownerErr
+		}
+
+		return nil, rout.ErrPermissionDenied
+	}
+
+	if err := resolveCSVReferencesForSchema(ctx, "CustomRole", data); err != nil {
+		return nil, err
+	}
+
+	inputs := make([]*generated.CreateCustomRoleInput, 0, len(data))
+	for i := range data {
+		inputs = append(inputs, &data[i].Input)
+	}
+
+	return r.bulkCreateCustomRole(ctx, inputs)
+}
+
+// UpdateBulkCustomRole is the resolver for the updateBulkCustomRole field.
+func (r *mutationResolver) UpdateBulkCustomRole(ctx context.Context, ids []string, input generated.UpdateCustomRoleInput) (*model.CustomRoleBulkUpdatePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	return r.bulkUpdateCustomRole(ctx, ids, input)
+}
+
+// UpdateBulkCSVCustomRole is the resolver for the updateBulkCSVCustomRole field.
+func (r *mutationResolver) UpdateBulkCSVCustomRole(ctx context.Context, input graphql.Upload) (*model.CustomRoleBulkUpdatePayload, error) {
+	data, err := common.UnmarshalBulkData[csvgenerated.CustomRoleCSVUpdateInput](input)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to unmarshal bulk data")
+
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "customrole"})
+	}
+
+	if len(data) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	if err := resolveCSVReferencesForSchema(ctx, "CustomRole", data); err != nil {
+		return nil, err
+	}
+
+	return r.bulkUpdateCSVCustomRole(ctx, data)
+}
+
+// UpdateCustomRole is the resolver for the updateCustomRole field.
+func (r *mutationResolver) UpdateCustomRole(ctx context.Context, id string, input generated.UpdateCustomRoleInput) (*model.CustomRoleUpdatePayload, error) {
+	res, err := withTransactionalMutation(ctx).CustomRole.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "customrole"})
+	}
+
+	// set the organization in the auth context if its not done for us
+	ctx, err = common.SetOrganizationInAuthContext(ctx, &res.OwnerID)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		return nil, rout.ErrPermissionDenied
+	}
+
+	// setup update request
+	req := res.Update().SetInput(input)
+
+	res, err = req.Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "customrole"})
+	}
+
+	return &model.CustomRoleUpdatePayload{
+		CustomRole: res,
+	}, nil
+}
+
+// DeleteCustomRole is the resolver for the deleteCustomRole field.
+func (r *mutationResolver) DeleteCustomRole(ctx context.Context, id string) (*model.CustomRoleDeletePayload, error) {
+	if err := withTransactionalMutation(ctx).CustomRole.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionDelete, Object: "customrole"})
+	}
+
+	if err := generated.CustomRoleEdgeCleanup(ctx, id); err != nil {
+		return nil, common.NewCascadeDeleteError(ctx, err)
+	}
+
+	return &model.CustomRoleDeletePayload{
+		DeletedID: id,
+	}, nil
+}
+
+// DeleteBulkCustomRole is the resolver for the deleteBulkCustomRole field.
+func (r *mutationResolver) DeleteBulkCustomRole(ctx context.Context, ids []string) (*model.CustomRoleBulkDeletePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	return r.bulkDeleteCustomRole(ctx, ids)
+}
+
+// CustomRole is the resolver for the customRole field.
+func (r *queryResolver) CustomRole(ctx context.Context, id string) (*generated.CustomRole, error) {
+	query, err := withTransactionalMutation(ctx).CustomRole.Query().Where(customrole.ID(id)).CollectFields(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "customrole"})
+	}
+
+	res, err := query.Only(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "customrole"})
+	}
+
+	return res, nil
+}
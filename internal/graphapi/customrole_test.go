@@ -0,0 +1,226 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphapi/testclient"
+)
+
+func TestQueryCustomRole(t *testing.T) {
+	role := (&CustomRoleBuilder{client: suite.client}).MustNew(sharedTestUser1.UserCtx, t)
+
+	testCases := []struct {
+		name     string
+		queryID  string
+		client   *testclient.TestClient
+		ctx      context.Context
+		errorMsg string
+	}{
+		{
+			name:    "happy path",
+			queryID: role.ID,
+			client:  suite.client.api,
+			ctx:     sharedTestUser1.UserCtx,
+		},
+		{
+			name:     "custom role not found, using user of another organization",
+			queryID:  role.ID,
+			client:   suite.client.api,
+			ctx:      sharedTestUser2.UserCtx,
+			errorMsg: notFoundErrorMsg,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Get "+tc.name, func(t *testing.T) {
+			resp, err := tc.client.GetCustomRoleByID(tc.ctx, tc.queryID)
+
+			if tc.errorMsg != "" {
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, resp != nil)
+
+			assert.Check(t, is.Equal(role.ID, resp.CustomRole.ID))
+			assert.Check(t, is.Equal(role.Name, resp.CustomRole.Name))
+			assert.Check(t, is.DeepEqual(role.Permissions, resp.CustomRole.Permissions))
+		})
+	}
+
+	(&Cleanup[*generated.CustomRoleDeleteOne]{client: suite.client.db.CustomRole, ID: role.ID}).MustDelete(sharedTestUser1.UserCtx, t)
+}
+
+func TestMutationCreateCustomRole(t *testing.T) {
+	program := (&ProgramBuilder{client: suite.client}).MustNew(sharedTestUser1.UserCtx, t)
+	group := (&GroupBuilder{client: suite.client}).MustNew(sharedTestUser1.UserCtx, t)
+
+	otherProgram := (&ProgramBuilder{client: suite.client}).MustNew(sharedTestUser2.UserCtx, t)
+	otherGroup := (&GroupBuilder{client: suite.client}).MustNew(sharedTestUser2.UserCtx, t)
+
+	testCases := []struct {
+		name        string
+		request     testclient.CreateCustomRoleInput
+		client      *testclient.TestClient
+		ctx         context.Context
+		expectedErr string
+	}{
+		{
+			name: "happy path",
+			request: testclient.CreateCustomRoleInput{
+				Name:        "policy-managers",
+				Permissions: []string{"can_manage_policies"},
+			},
+			client: suite.client.api,
+			ctx:    sharedTestUser1.UserCtx,
+		},
+		{
+			name: "happy path, scoped to program and group",
+			request: testclient.CreateCustomRoleInput{
+				Name:        "program-evidence-editors",
+				Permissions: []string{"can_edit_evidence"},
+				ProgramIds:  []string{program.ID},
+				GroupIds:    []string{group.ID},
+			},
+			client: suite.client.api,
+			ctx:    sharedTestUser1.UserCtx,
+		},
+		{
+			name: "not authorized, read only user",
+			request: testclient.CreateCustomRoleInput{
+				Name:        "read-only",
+				Permissions: []string{"can_manage_policies"},
+			},
+			client:      suite.client.api,
+			ctx:         sharedViewOnlyUser.UserCtx,
+			expectedErr: notAuthorizedErrorMsg,
+		},
+		{
+			name: "invalid permission",
+			request: testclient.CreateCustomRoleInput{
+				Name:        "invalid-permission",
+				Permissions: []string{"can_do_everything"},
+			},
+			client:      suite.client.api,
+			ctx:         sharedTestUser1.UserCtx,
+			expectedErr: "permission",
+		},
+		{
+			name: "program of another organization",
+			request: testclient.CreateCustomRoleInput{
+				Name:        "cross-org-program",
+				Permissions: []string{"can_edit_evidence"},
+				ProgramIds:  []string{otherProgram.ID},
+			},
+			client:      suite.client.api,
+			ctx:         sharedTestUser1.UserCtx,
+			expectedErr: "custom role can only be scoped to programs and groups of its organization",
+		},
+		{
+			name: "group of another organization",
+			request: testclient.CreateCustomRoleInput{
+				Name:        "cross-org-group",
+				Permissions: []string{"can_edit_evidence"},
+				ProgramIds:  []string{program.ID},
+				GroupIds:    []string{otherGroup.ID},
+			},
+			client:      suite.client.api,
+			ctx:         sharedTestUser1.UserCtx,
+			expectedErr: "custom role can only be scoped to programs and groups of its organization",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			resp, err := tc.client.CreateCustomRole(tc.ctx, tc.request)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, resp != nil)
+
+			created := resp.CreateCustomRole.CustomRole
+			assert.Check(t, is.Equal(tc.request.Name, created.Name))
+			assert.Check(t, is.DeepEqual(tc.request.Permissions, created.Permissions))
+			assert.Check(t, is.Equal(sharedTestUser1.OrganizationID, *created.OwnerID))
+
+			(&Cleanup[*generated.CustomRoleDeleteOne]{client: suite.client.db.CustomRole, ID: created.ID}).MustDelete(tc.ctx, t)
+		})
+	}
+
+	(&Cleanup[*generated.ProgramDeleteOne]{client: suite.client.db.Program, ID: program.ID}).MustDelete(sharedTestUser1.UserCtx, t)
+	(&Cleanup[*generated.GroupDeleteOne]{client: suite.client.db.Group, ID: group.ID}).MustDelete(sharedTestUser1.UserCtx, t)
+	(&Cleanup[*generated.ProgramDeleteOne]{client: suite.client.db.Program, ID: otherProgram.ID}).MustDelete(sharedTestUser2.UserCtx, t)
+	(&Cleanup[*generated.GroupDeleteOne]{client: suite.client.db.Group, ID: otherGroup.ID}).MustDelete(sharedTestUser2.UserCtx, t)
+}
+
+func TestMutationUpdateCustomRole(t *testing.T) {
+	program := (&ProgramBuilder{client: suite.client}).MustNew(sharedTestUser1.UserCtx, t)
+	otherProgram := (&ProgramBuilder{client: suite.client}).MustNew(sharedTestUser2.UserCtx, t)
+
+	role := (&CustomRoleBuilder{client: suite.client, Permissions: []string{"can_edit_evidence"}}).MustNew(sharedTestUser1.UserCtx, t)
+
+	testCases := []struct {
+		name        string
+		request     testclient.UpdateCustomRoleInput
+		client      *testclient.TestClient
+		ctx         context.Context
+		expectedErr string
+	}{
+		{
+			name: "happy path, scope to program",
+			request: testclient.UpdateCustomRoleInput{
+				AppendProgramIds: []string{program.ID},
+			},
+			client: suite.client.api,
+			ctx:    sharedTestUser1.UserCtx,
+		},
+		{
+			name: "program of another organization",
+			request: testclient.UpdateCustomRoleInput{
+				AppendProgramIds: []string{otherProgram.ID},
+			},
+			client:      suite.client.api,
+			ctx:         sharedTestUser1.UserCtx,
+			expectedErr: "custom role can only be scoped to programs and groups of its organization",
+		},
+		{
+			name: "not found, another organization",
+			request: testclient.UpdateCustomRoleInput{
+				AppendProgramIds: []string{otherProgram.ID},
+			},
+			client:      suite.client.api,
+			ctx:         sharedTestUser2.UserCtx,
+			expectedErr: notFoundErrorMsg,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Update "+tc.name, func(t *testing.T) {
+			resp, err := tc.client.UpdateCustomRole(tc.ctx, role.ID, tc.request)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, resp != nil)
+			assert.Check(t, is.DeepEqual([]string{program.ID}, resp.UpdateCustomRole.CustomRole.ProgramIds))
+		})
+	}
+
+	(&Cleanup[*generated.CustomRoleDeleteOne]{client: suite.client.db.CustomRole, ID: role.ID}).MustDelete(sharedTestUser1.UserCtx, t)
+	(&Cleanup[*generated.ProgramDeleteOne]{client: suite.client.db.Program, ID: program.ID}).MustDelete(sharedTestUser1.UserCtx, t)
+	(&Cleanup[*generated.ProgramDeleteOne]{client: suite.client.db.Program, ID: otherProgram.ID}).MustDelete(sharedTestUser2.UserCtx, t)
+}
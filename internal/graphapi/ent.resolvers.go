@@ -488,6 +488,40 @@ func (r *queryResolver) CustomDomains(ctx context.Context, after *entgql.Cursor[
 	return res, err
 }
 
+// CustomRoles is the resolver for the customRoles field.
+func (r *queryResolver) CustomRoles(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy []*generated.CustomRoleOrder, where *generated.CustomRoleWhereInput) (*generated.CustomRoleConnection, error) {
+	// set page limit if nothing was set
+	first, last = graphutils.SetFirstLastDefaults(first, last, r.maxResultLimit)
+
+	if orderBy == nil {
+		orderBy = []*generated.CustomRoleOrder{
+			{
+				Field:     generated.CustomRoleOrderFieldCreatedAt,
+				Direction: entgql.OrderDirectionDesc,
+			},
+		}
+	}
+
+	query, err := withTransactionalMutation(ctx).CustomRole.Query().CollectFields(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "customrole"})
+	}
+
+	res, err := query.Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithCustomRoleOrder(orderBy),
+		generated.WithCustomRoleFilter(where.Filter))
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "customrole"})
+	}
+
+	return res, err
+}
+
 // CustomTypeEnums is the resolver for the customTypeEnums field.
 func (r *queryResolver) CustomTypeEnums(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy []*generated.CustomTypeEnumOrder, where *generated.CustomTypeEnumWhereInput) (*generated.CustomTypeEnumConnection, error) {
 	// set page limit if nothing was set
@@ -2052,6 +2086,40 @@ func (r *queryResolver) Remediations(ctx context.Context, after *entgql.Cursor[s
 	return res, err
 }
 
+// RetentionRules is the resolver for the retentionRules field.
+func (r *queryResolver) RetentionRules(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy []*generated.RetentionRuleOrder, where *generated.RetentionRuleWhereInput) (*generated.RetentionRuleConnection, error) {
+	// set page limit if nothing was set
+	first, last = graphutils.SetFirstLastDefaults(first, last, r.maxResultLimit)
+
+	if orderBy == nil {
+		orderBy = []*generated.RetentionRuleOrder{
+			{
+				Field:     generated.RetentionRuleOrderFieldCreatedAt,
+				Direction: entgql.OrderDirectionDesc,
+			},
+		}
+	}
+
+	query, err := withTransactionalMutation(ctx).RetentionRule.Query().CollectFields(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "retentionrule"})
+	}
+
+	res, err := query.Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		generated.WithRetentionRuleOrder(orderBy),
+		generated.WithRetentionRuleFilter(where.Filter))
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "retentionrule"})
+	}
+
+	return res, err
+}
+
 // Reviews is the resolver for the reviews field.
 func (r *queryResolver) Reviews(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy []*generated.ReviewOrder, where *generated.ReviewWhereInput) (*generated.ReviewConnection, error) {
 	// set page limit if nothing was set
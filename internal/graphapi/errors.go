@@ -11,6 +11,7 @@ import (
 	"github.com/theopenlane/core/internal/ent/privacy/rule"
 	"github.com/theopenlane/core/internal/graphapi/common"
 	"github.com/theopenlane/core/internal/graphapi/gqlerrors"
+	"github.com/theopenlane/core/internal/metering"
 	"github.com/theopenlane/core/pkg/logx"
 	"github.com/theopenlane/utils/rout"
 )
@@ -30,7 +31,12 @@ func parseRequestError(ctx context.Context, err error, a common.Action) error {
 	// log the error for debugging, these can be user errors so we want to log at info level instead of error level to avoid alert fatigue, but we still want to log the error for debugging purposes
 	logx.FromContext(ctx).Info().Err(err).Str("action", a.Action).Str("object", a.Object).Msg("error processing request")
 
+	var quotaErr *metering.QuotaError
+
 	switch {
+	case errors.As(err, &quotaErr):
+		// the request would take the organization past the limits of its plan
+		return quotaErr
 	case errors.Is(err, rule.ErrRequiredScopeNotSet):
 		// The access token lacks the required scopes for this request.
 		return common.NewErrorWithCode(common.ErrMissingRequireScopes, gqlerrors.InsufficientScopes)
@@ -0,0 +1,27 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// ReplayGalaDeadLetter is the resolver for the replayGalaDeadLetter field.
+func (r *mutationResolver) ReplayGalaDeadLetter(ctx context.Context, id string, listener *string) (*model.GalaDeadLetterReplayPayload, error) {
+	return r.replayGalaDeadLetter(ctx, id, listener)
+}
+
+// DiscardGalaDeadLetters is the resolver for the discardGalaDeadLetters field.
+func (r *mutationResolver) DiscardGalaDeadLetters(ctx context.Context, input model.DiscardGalaDeadLettersInput) (*model.GalaDeadLetterDiscardPayload, error) {
+	return r.discardGalaDeadLetters(ctx, input)
+}
+
+// GalaDeadLetters is the resolver for the galaDeadLetters field.
+func (r *queryResolver) GalaDeadLetters(ctx context.Context, topic *string, listener *string, first *int) ([]*model.GalaDeadLetter, error) {
+	return r.galaDeadLetters(ctx, topic, listener, first)
+}
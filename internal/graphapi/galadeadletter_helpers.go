@@ -0,0 +1,150 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/theopenlane/iam/auth"
+
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/core/pkg/gala"
+	"github.com/theopenlane/core/pkg/jsonx"
+)
+
+// galaDeadLettersDefaultFirst is the number of dead letters returned when the query does not set first
+const galaDeadLettersDefaultFirst = 100
+
+// galaDeadLetters lists the dead letters of the event runtime, most recent first
+func (r *queryResolver) galaDeadLetters(ctx context.Context, topic, listener *string, first *int) ([]*model.GalaDeadLetter, error) {
+	action := common.Action{Action: common.ActionGet, Object: "galadeadletter"}
+
+	g, err := r.deadLetterGala(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	letters, err := g.ListDeadLetters(ctx, gala.DeadLetterFilter{
+		Topic:    gala.TopicName(lo.FromPtr(topic)),
+		Listener: lo.FromPtr(listener),
+		Limit:    lo.FromPtrOr(first, galaDeadLettersDefaultFirst),
+	})
+	if err != nil {
+		return nil, galaDeadLetterError(ctx, err, action)
+	}
+
+	return lo.Map(letters, func(letter gala.DeadLetter, _ int) *model.GalaDeadLetter {
+		return galaDeadLetterModel(letter)
+	}), nil
+}
+
+// replayGalaDeadLetter dispatches a dead-lettered event again, optionally to a single listener definition
+func (r *mutationResolver) replayGalaDeadLetter(ctx context.Context, id string, listener *string) (*model.GalaDeadLetterReplayPayload, error) {
+	action := common.Action{Action: common.ActionUpdate, Object: "galadeadletter"}
+
+	g, err := r.deadLetterGala(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	letter, err := g.ReplayDeadLetter(ctx, id, lo.FromPtr(listener))
+	if err != nil {
+		return nil, galaDeadLetterError(ctx, err, action)
+	}
+
+	return &model.GalaDeadLetterReplayPayload{DeadLetter: galaDeadLetterModel(letter)}, nil
+}
+
+// discardGalaDeadLetters removes the dead letters selected by the input without dispatching them
+func (r *mutationResolver) discardGalaDeadLetters(ctx context.Context, input model.DiscardGalaDeadLettersInput) (*model.GalaDeadLetterDiscardPayload, error) {
+	action := common.Action{Action: common.ActionDelete, Object: "galadeadletter"}
+
+	g, err := r.deadLetterGala(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	removed, err := g.DiscardDeadLetters(ctx, gala.DeadLetterFilter{
+		Topic:    gala.TopicName(lo.FromPtr(input.Topic)),
+		Listener: lo.FromPtr(input.Listener),
+		IDs:      input.Ids,
+		All:      lo.FromPtr(input.All),
+	})
+	if err != nil {
+		return nil, galaDeadLetterError(ctx, err, action)
+	}
+
+	return &model.GalaDeadLetterDiscardPayload{DiscardedCount: removed}, nil
+}
+
+// deadLetterGala returns the event runtime once the caller is confirmed to be a system admin; dead letters hold
+// events of every organization so they are not exposed to anyone else
+func (r *Resolver) deadLetterGala(ctx context.Context) (*gala.Gala, error) {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || !caller.Has(auth.CapSystemAdmin) {
+		return nil, newPermissionDeniedError()
+	}
+
+	if r.integrationsRuntime == nil {
+		return nil, common.NewValidationError(gala.ErrDeadLettersDisabled.Error())
+	}
+
+	return r.integrationsRuntime.Gala(), nil
+}
+
+// galaDeadLetterError maps dead letter errors to errors returned to the user
+func galaDeadLetterError(ctx context.Context, err error, a common.Action) error {
+	switch {
+	case errors.Is(err, gala.ErrDeadLetterNotFound):
+		return common.NewNotFoundError("galadeadletter")
+	case errors.Is(err, gala.ErrDeadLettersDisabled),
+		errors.Is(err, gala.ErrDeadLetterListenerUnknown),
+		errors.Is(err, gala.ErrDeadLetterFilterRequired):
+		return common.NewValidationError(err.Error())
+	default:
+		return parseRequestError(ctx, err, a)
+	}
+}
+
+// galaDeadLetterModel converts the dead letter to its graphql model; payloads that are not valid JSON are omitted
+func galaDeadLetterModel(letter gala.DeadLetter) *model.GalaDeadLetter {
+	envelope := letter.Envelope
+
+	out := &model.GalaDeadLetter{
+		ID:         letter.ID,
+		EventID:    string(letter.EventID),
+		Topic:      string(letter.Topic),
+		Listener:   lo.EmptyableToPtr(letter.Listener),
+		Kind:       lo.EmptyableToPtr(letter.Kind),
+		Queue:      letter.Queue,
+		JobID:      letter.JobID,
+		Attempts:   letter.Attempts,
+		OccurredAt: envelope.OccurredAt,
+		FailedAt:   letter.FailedAt,
+		Errors: lo.Map(letter.Errors, func(attemptErr gala.DeadLetterError, _ int) *model.GalaDeadLetterError {
+			return &model.GalaDeadLetterError{Attempt: attemptErr.Attempt, At: attemptErr.At, Error: attemptErr.Error}
+		}),
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal(envelope.Payload, &payload); err == nil {
+		out.Payload = payload
+	}
+
+	if len(envelope.ContextSnapshot.Values) > 0 {
+		out.ContextSnapshot = make(map[string]any, len(envelope.ContextSnapshot.Values))
+		for key, raw := range envelope.ContextSnapshot.Values {
+			out.ContextSnapshot[key] = jsonx.DecodeAnyOrNil(raw)
+		}
+	}
+
+	if len(envelope.Headers.Properties) > 0 {
+		out.Properties = lo.MapValues(envelope.Headers.Properties, func(value string, _ string) any {
+			return value
+		})
+	}
+
+	return out
+}
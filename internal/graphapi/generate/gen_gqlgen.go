@@ -6,6 +6,7 @@ import (
 	"github.com/99designs/gqlgen/api"
 	"github.com/99designs/gqlgen/codegen/config"
 	"github.com/rs/zerolog/log"
+	"github.com/theopenlane/core/internal/ent/fulltextgen"
 	"github.com/theopenlane/core/internal/ent/schema"
 	"github.com/theopenlane/core/internal/genhelpers"
 	"github.com/theopenlane/gqlgen-plugins/bulkgen"
@@ -101,6 +102,7 @@ func gqlGenerate() {
 			searchgen.WithIncludeAdminSearch(false),
 			searchgen.WithGraphQLImport(graphqlImport),
 		)), // add the search plugin
+		api.AddPlugin(fulltextgen.NewSearchPlugin()), // search the searchable fields through their full-text index
 	); err != nil {
 		log.Fatal().Err(err).Msg("failed to generate gqlgen server")
 	}
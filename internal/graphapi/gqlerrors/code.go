@@ -26,4 +26,7 @@ const (
 	BulkActionIncomplete = "BULK_ACTION_INCOMPLETELY_APPLIED"
 	// InsufficientScopes is the error code returned when an API Request using an API token does not include the require scope(s) for the request
 	InsufficientScopes = "INSUFFICIENT_SCOPES"
+	// QuotaExceededErrorCode is the error code for when a request would take the usage of an organization past
+	// the limits of its plan
+	QuotaExceededErrorCode = "QUOTA_EXCEEDED"
 )
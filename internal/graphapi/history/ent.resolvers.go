@@ -1349,6 +1349,38 @@ func (r *queryResolver) RemediationHistories(ctx context.Context, after *entgql.
 	return res, err
 }
 
+// RetentionRuleHistories is the resolver for the retentionRuleHistories field.
+func (r *queryResolver) RetentionRuleHistories(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.RetentionRuleHistoryOrder, where *historygenerated.RetentionRuleHistoryWhereInput) (*historygenerated.RetentionRuleHistoryConnection, error) {
+	// set page limit if nothing was set
+	first, last = graphutils.SetFirstLastDefaults(first, last, r.maxResultLimit)
+
+	if orderBy == nil {
+		orderBy = &historygenerated.RetentionRuleHistoryOrder{
+			Field:     historygenerated.RetentionRuleHistoryOrderFieldCreatedAt,
+			Direction: entgql.OrderDirectionDesc,
+		}
+	}
+
+	query, err := withTransactionalMutation(ctx).RetentionRuleHistory.Query().CollectFields(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "discussionhistory"})
+	}
+
+	res, err := query.Paginate(
+		ctx,
+		after,
+		first,
+		before,
+		last,
+		historygenerated.WithRetentionRuleHistoryOrder(orderBy),
+		historygenerated.WithRetentionRuleHistoryFilter(where.Filter))
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "discussionhistory"})
+	}
+
+	return res, err
+}
+
 // ReviewHistories is the resolver for the reviewHistories field.
 func (r *queryResolver) ReviewHistories(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.ReviewHistoryOrder, where *historygenerated.ReviewHistoryWhereInput) (*historygenerated.ReviewHistoryConnection, error) {
 	// set page limit if nothing was set
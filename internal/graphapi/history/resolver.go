@@ -120,6 +120,9 @@ func (r *Resolver) Handler() *Handler {
 	// add context level caching
 	common.WithContextLevelCache(srv)
 
+	// serve query operations from the read replicas
+	common.WithReplicaReads(srv)
+
 	// add extensions if enabled
 	if r.extensionsEnabled {
 		common.AddAllExtensions(srv)
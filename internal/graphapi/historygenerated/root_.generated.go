@@ -706,6 +706,7 @@ type ComplexityRoot struct {
 		SSOEnforced                           func(childComplexity int) int
 		ScopeID                               func(childComplexity int) int
 		ScopeName                             func(childComplexity int) int
+		Soc2LapsedAt                          func(childComplexity int) int
 		Soc2PeriodEnd                         func(childComplexity int) int
 		SpendCurrency                         func(childComplexity int) int
 		Status                                func(childComplexity int) int
@@ -1839,6 +1840,7 @@ type ComplexityRoot struct {
 		ProgramHistories                    func(childComplexity int, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.ProgramHistoryOrder, where *historygenerated.ProgramHistoryWhereInput) int
 		ProgramMembershipHistories          func(childComplexity int, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.ProgramMembershipHistoryOrder, where *historygenerated.ProgramMembershipHistoryWhereInput) int
 		RemediationHistories                func(childComplexity int, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.RemediationHistoryOrder, where *historygenerated.RemediationHistoryWhereInput) int
+		RetentionRuleHistories              func(childComplexity int, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.RetentionRuleHistoryOrder, where *historygenerated.RetentionRuleHistoryWhereInput) int
 		ReviewHistories                     func(childComplexity int, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.ReviewHistoryOrder, where *historygenerated.ReviewHistoryWhereInput) int
 		RiskHistories                       func(childComplexity int, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.RiskHistoryOrder, where *historygenerated.RiskHistoryWhereInput) int
 		SLADefinitionHistories              func(childComplexity int, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *historygenerated.SLADefinitionHistoryOrder, where *historygenerated.SLADefinitionHistoryWhereInput) int
@@ -1922,6 +1924,36 @@ type ComplexityRoot struct {
 		Node   func(childComplexity int) int
 	}
 
+	RetentionRuleHistory struct {
+		Category              func(childComplexity int) int
+		CreatedAt             func(childComplexity int) int
+		CreatedBy             func(childComplexity int) int
+		Description           func(childComplexity int) int
+		HistoryTime           func(childComplexity int) int
+		ID                    func(childComplexity int) int
+		Name                  func(childComplexity int) int
+		ObjectType            func(childComplexity int) int
+		Operation             func(childComplexity int) int
+		OwnerID               func(childComplexity int) int
+		Purge                 func(childComplexity int) int
+		Ref                   func(childComplexity int) int
+		RetentionDays         func(childComplexity int) int
+		UpdatedAt             func(childComplexity int) int
+		UpdatedBy             func(childComplexity int) int
+		UpdatedByImpersonator func(childComplexity int) int
+	}
+
+	RetentionRuleHistoryConnection struct {
+		Edges      func(childComplexity int) int
+		PageInfo   func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
+	RetentionRuleHistoryEdge struct {
+		Cursor func(childComplexity int) int
+		Node   func(childComplexity int) int
+	}
+
 	ReviewHistory struct {
 		Approved              func(childComplexity int) int
 		ApprovedAt            func(childComplexity int) int
@@ -2445,6 +2477,7 @@ type ComplexityRoot struct {
 	}
 
 	TrustCenterFAQHistory struct {
+		Approved               func(childComplexity int) int
 		CreatedAt              func(childComplexity int) int
 		CreatedBy              func(childComplexity int) int
 		DisplayOrder           func(childComplexity int) int
@@ -6298,6 +6331,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.EntityHistory.RiskScoreCoverage(childComplexity), true
+	case "EntityHistory.soc2LapsedAt":
+		if e.ComplexityRoot.EntityHistory.Soc2LapsedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EntityHistory.Soc2LapsedAt(childComplexity), true
+
 	case "EntityHistory.ssoEnforced":
 		if e.ComplexityRoot.EntityHistory.SSOEnforced == nil {
 			break
@@ -11987,6 +12027,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.RemediationHistories(childComplexity, args["after"].(*entgql.Cursor[string]), args["first"].(*int), args["before"].(*entgql.Cursor[string]), args["last"].(*int), args["orderBy"].(*historygenerated.RemediationHistoryOrder), args["where"].(*historygenerated.RemediationHistoryWhereInput)), true
+	case "Query.retentionRuleHistories":
+		if e.ComplexityRoot.Query.RetentionRuleHistories == nil {
+			break
+		}
+
+		args, err := ec.field_Query_retentionRuleHistories_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.RetentionRuleHistories(childComplexity, args["after"].(*entgql.Cursor[string]), args["first"].(*int), args["before"].(*entgql.Cursor[string]), args["last"].(*int), args["orderBy"].(*historygenerated.RetentionRuleHistoryOrder), args["where"].(*historygenerated.RetentionRuleHistoryWhereInput)), true
 	case "Query.reviewHistories":
 		if e.ComplexityRoot.Query.ReviewHistories == nil {
 			break
@@ -12558,6 +12609,135 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.RemediationHistoryEdge.Node(childComplexity), true
 
+	case "RetentionRuleHistory.category":
+		if e.ComplexityRoot.RetentionRuleHistory.Category == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.Category(childComplexity), true
+	case "RetentionRuleHistory.createdAt":
+		if e.ComplexityRoot.RetentionRuleHistory.CreatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.CreatedAt(childComplexity), true
+	case "RetentionRuleHistory.createdBy":
+		if e.ComplexityRoot.RetentionRuleHistory.CreatedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.CreatedBy(childComplexity), true
+	case "RetentionRuleHistory.description":
+		if e.ComplexityRoot.RetentionRuleHistory.Description == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.Description(childComplexity), true
+	case "RetentionRuleHistory.historyTime":
+		if e.ComplexityRoot.RetentionRuleHistory.HistoryTime == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.HistoryTime(childComplexity), true
+	case "RetentionRuleHistory.id":
+		if e.ComplexityRoot.RetentionRuleHistory.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.ID(childComplexity), true
+	case "RetentionRuleHistory.name":
+		if e.ComplexityRoot.RetentionRuleHistory.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.Name(childComplexity), true
+	case "RetentionRuleHistory.objectType":
+		if e.ComplexityRoot.RetentionRuleHistory.ObjectType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.ObjectType(childComplexity), true
+	case "RetentionRuleHistory.operation":
+		if e.ComplexityRoot.RetentionRuleHistory.Operation == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.Operation(childComplexity), true
+	case "RetentionRuleHistory.ownerID":
+		if e.ComplexityRoot.RetentionRuleHistory.OwnerID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.OwnerID(childComplexity), true
+	case "RetentionRuleHistory.purge":
+		if e.ComplexityRoot.RetentionRuleHistory.Purge == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.Purge(childComplexity), true
+	case "RetentionRuleHistory.ref":
+		if e.ComplexityRoot.RetentionRuleHistory.Ref == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.Ref(childComplexity), true
+	case "RetentionRuleHistory.retentionDays":
+		if e.ComplexityRoot.RetentionRuleHistory.RetentionDays == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.RetentionDays(childComplexity), true
+	case "RetentionRuleHistory.updatedAt":
+		if e.ComplexityRoot.RetentionRuleHistory.UpdatedAt == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.UpdatedAt(childComplexity), true
+	case "RetentionRuleHistory.updatedBy":
+		if e.ComplexityRoot.RetentionRuleHistory.UpdatedBy == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.UpdatedBy(childComplexity), true
+	case "RetentionRuleHistory.updatedByImpersonator":
+		if e.ComplexityRoot.RetentionRuleHistory.UpdatedByImpersonator == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistory.UpdatedByImpersonator(childComplexity), true
+
+	case "RetentionRuleHistoryConnection.edges":
+		if e.ComplexityRoot.RetentionRuleHistoryConnection.Edges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistoryConnection.Edges(childComplexity), true
+	case "RetentionRuleHistoryConnection.pageInfo":
+		if e.ComplexityRoot.RetentionRuleHistoryConnection.PageInfo == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistoryConnection.PageInfo(childComplexity), true
+	case "RetentionRuleHistoryConnection.totalCount":
+		if e.ComplexityRoot.RetentionRuleHistoryConnection.TotalCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistoryConnection.TotalCount(childComplexity), true
+
+	case "RetentionRuleHistoryEdge.cursor":
+		if e.ComplexityRoot.RetentionRuleHistoryEdge.Cursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistoryEdge.Cursor(childComplexity), true
+	case "RetentionRuleHistoryEdge.node":
+		if e.ComplexityRoot.RetentionRuleHistoryEdge.Node == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RetentionRuleHistoryEdge.Node(childComplexity), true
+
 	case "ReviewHistory.approved":
 		if e.ComplexityRoot.ReviewHistory.Approved == nil {
 			break
@@ -15027,6 +15207,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.TrustCenterEntityHistoryEdge.Node(childComplexity), true
 
+	case "TrustCenterFAQHistory.approved":
+		if e.ComplexityRoot.TrustCenterFAQHistory.Approved == nil {
+			break
+		}
+
+		return e.ComplexityRoot.TrustCenterFAQHistory.Approved(childComplexity), true
+
 	case "TrustCenterFAQHistory.createdAt":
 		if e.ComplexityRoot.TrustCenterFAQHistory.CreatedAt == nil {
 			break
@@ -17709,6 +17896,8 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputProgramMembershipHistoryWhereInput,
 		ec.unmarshalInputRemediationHistoryOrder,
 		ec.unmarshalInputRemediationHistoryWhereInput,
+		ec.unmarshalInputRetentionRuleHistoryOrder,
+		ec.unmarshalInputRetentionRuleHistoryWhereInput,
 		ec.unmarshalInputReviewHistoryOrder,
 		ec.unmarshalInputReviewHistoryWhereInput,
 		ec.unmarshalInputRiskHistoryOrder,
@@ -21092,6 +21281,7 @@ enum CampaignHistoryCampaignType @goModel(model: "github.com/theopenlane/core/co
   VENDOR_ASSESSMENT
   CUSTOM
   TRUST_CENTER_UPDATE
+  ACCESS_REVIEW
 }
 """
 A connection to a list of items.
@@ -26375,6 +26565,10 @@ type EntityHistory implements Node {
   """
   soc2PeriodEnd: DateTime
   """
+  when the SOC 2 reporting period on file was found to have lapsed; cleared when the reporting period end changes
+  """
+  soc2LapsedAt: DateTime
+  """
   start date for the entity contract
   """
   contractStartDate: DateTime
@@ -27211,6 +27405,19 @@ input EntityHistoryWhereInput {
   soc2PeriodEndIsNil: Boolean
   soc2PeriodEndNotNil: Boolean
   """
+  soc2_lapsed_at field predicates
+  """
+  soc2LapsedAt: DateTime
+  soc2LapsedAtNEQ: DateTime
+  soc2LapsedAtIn: [DateTime!]
+  soc2LapsedAtNotIn: [DateTime!]
+  soc2LapsedAtGT: DateTime
+  soc2LapsedAtGTE: DateTime
+  soc2LapsedAtLT: DateTime
+  soc2LapsedAtLTE: DateTime
+  soc2LapsedAtIsNil: Boolean
+  soc2LapsedAtNotNil: Boolean
+  """
   contract_start_date field predicates
   """
   contractStartDate: DateTime
@@ -42549,6 +42756,37 @@ type Query {
     """
     where: RemediationHistoryWhereInput
   ): RemediationHistoryConnection!
+  retentionRuleHistories(
+    """
+    Returns the elements in the list that come after the specified cursor.
+    """
+    after: Cursor
+
+    """
+    Returns the first _n_ elements from the list.
+    """
+    first: Int
+
+    """
+    Returns the elements in the list that come before the specified cursor.
+    """
+    before: Cursor
+
+    """
+    Returns the last _n_ elements from the list.
+    """
+    last: Int
+
+    """
+    Ordering options for RetentionRuleHistories returned from the connection.
+    """
+    orderBy: RetentionRuleHistoryOrder
+
+    """
+    Filtering options for RetentionRuleHistories returned from the connection.
+    """
+    where: RetentionRuleHistoryWhereInput
+  ): RetentionRuleHistoryConnection!
   reviewHistories(
     """
     Returns the elements in the list that come after the specified cursor.
@@ -44211,6 +44449,351 @@ input RemediationHistoryWhereInput {
   externalURIEqualFold: String
   externalURIContainsFold: String
 }
+type RetentionRuleHistory implements Node {
+  id: ID!
+  historyTime: Time!
+  ref: String
+  operation: RetentionRuleHistoryOpType!
+  createdAt: Time
+  updatedAt: Time
+  createdBy: String
+  updatedBy: String
+  """
+  the real user acting through an impersonation session when the record was last mutated, if any
+  """
+  updatedByImpersonator: String
+  """
+  the organization id that owns the object
+  """
+  ownerID: String
+  """
+  the name of the retention rule, recorded in the metadata of the files it is applied to
+  """
+  name: String!
+  """
+  the description of the retention rule
+  """
+  description: String
+  """
+  the file category the rule applies to, e.g. evidence; empty matches any category
+  """
+  category: String
+  """
+  the type of object the file is attached to, e.g. evidence or procedure; empty matches any type
+  """
+  objectType: String
+  """
+  the number of days matching files are retained after upload
+  """
+  retentionDays: Int!
+  """
+  delete matching files once the retention period ends
+  """
+  purge: Boolean!
+}
+"""
+A connection to a list of items.
+"""
+type RetentionRuleHistoryConnection {
+  """
+  A list of edges.
+  """
+  edges: [RetentionRuleHistoryEdge]
+  """
+  Information to aid in pagination.
+  """
+  pageInfo: PageInfo!
+  """
+  Identifies the total count of items in the connection.
+  """
+  totalCount: Int!
+}
+"""
+An edge in a connection.
+"""
+type RetentionRuleHistoryEdge {
+  """
+  The item at the end of the edge.
+  """
+  node: RetentionRuleHistory
+  """
+  A cursor for use in pagination.
+  """
+  cursor: Cursor!
+}
+"""
+RetentionRuleHistoryOpType is enum for the field operation
+"""
+enum RetentionRuleHistoryOpType @goModel(model: "github.com/theopenlane/entx/history.OpType") {
+  INSERT
+  UPDATE
+  DELETE
+}
+"""
+Ordering options for RetentionRuleHistory connections
+"""
+input RetentionRuleHistoryOrder {
+  """
+  The ordering direction.
+  """
+  direction: OrderDirection! = ASC
+  """
+  The field by which to order RetentionRuleHistories.
+  """
+  field: RetentionRuleHistoryOrderField!
+}
+"""
+Properties by which RetentionRuleHistory connections can be ordered.
+"""
+enum RetentionRuleHistoryOrderField {
+  history_time
+  created_at
+  updated_at
+  name
+  retention_days
+}
+"""
+RetentionRuleHistoryWhereInput is used for filtering RetentionRuleHistory objects.
+Input was generated by ent.
+"""
+input RetentionRuleHistoryWhereInput {
+  not: RetentionRuleHistoryWhereInput
+  and: [RetentionRuleHistoryWhereInput!]
+  or: [RetentionRuleHistoryWhereInput!]
+  """
+  id field predicates
+  """
+  id: ID
+  idNEQ: ID
+  idIn: [ID!]
+  idNotIn: [ID!]
+  idGT: ID
+  idGTE: ID
+  idLT: ID
+  idLTE: ID
+  idEqualFold: ID
+  idContainsFold: ID
+  """
+  history_time field predicates
+  """
+  historyTime: Time
+  historyTimeNEQ: Time
+  historyTimeIn: [Time!]
+  historyTimeNotIn: [Time!]
+  historyTimeGT: Time
+  historyTimeGTE: Time
+  historyTimeLT: Time
+  historyTimeLTE: Time
+  """
+  ref field predicates
+  """
+  ref: String
+  refNEQ: String
+  refIn: [String!]
+  refNotIn: [String!]
+  refGT: String
+  refGTE: String
+  refLT: String
+  refLTE: String
+  refContains: String
+  refHasPrefix: String
+  refHasSuffix: String
+  refIsNil: Boolean
+  refNotNil: Boolean
+  refEqualFold: String
+  refContainsFold: String
+  """
+  operation field predicates
+  """
+  operation: RetentionRuleHistoryOpType
+  operationNEQ: RetentionRuleHistoryOpType
+  operationIn: [RetentionRuleHistoryOpType!]
+  operationNotIn: [RetentionRuleHistoryOpType!]
+  """
+  created_at field predicates
+  """
+  createdAt: Time
+  createdAtNEQ: Time
+  createdAtIn: [Time!]
+  createdAtNotIn: [Time!]
+  createdAtGT: Time
+  createdAtGTE: Time
+  createdAtLT: Time
+  createdAtLTE: Time
+  createdAtIsNil: Boolean
+  createdAtNotNil: Boolean
+  """
+  updated_at field predicates
+  """
+  updatedAt: Time
+  updatedAtNEQ: Time
+  updatedAtIn: [Time!]
+  updatedAtNotIn: [Time!]
+  updatedAtGT: Time
+  updatedAtGTE: Time
+  updatedAtLT: Time
+  updatedAtLTE: Time
+  updatedAtIsNil: Boolean
+  updatedAtNotNil: Boolean
+  """
+  created_by field predicates
+  """
+  createdBy: String
+  createdByNEQ: String
+  createdByIn: [String!]
+  createdByNotIn: [String!]
+  createdByGT: String
+  createdByGTE: String
+  createdByLT: String
+  createdByLTE: String
+  createdByContains: String
+  createdByHasPrefix: String
+  createdByHasSuffix: String
+  createdByIsNil: Boolean
+  createdByNotNil: Boolean
+  createdByEqualFold: String
+  createdByContainsFold: String
+  """
+  updated_by field predicates
+  """
+  updatedBy: String
+  updatedByNEQ: String
+  updatedByIn: [String!]
+  updatedByNotIn: [String!]
+  updatedByGT: String
+  updatedByGTE: String
+  updatedByLT: String
+  updatedByLTE: String
+  updatedByContains: String
+  updatedByHasPrefix: String
+  updatedByHasSuffix: String
+  updatedByIsNil: Boolean
+  updatedByNotNil: Boolean
+  updatedByEqualFold: String
+  updatedByContainsFold: String
+  """
+  updated_by_impersonator field predicates
+  """
+  updatedByImpersonator: String
+  updatedByImpersonatorNEQ: String
+  updatedByImpersonatorIn: [String!]
+  updatedByImpersonatorNotIn: [String!]
+  updatedByImpersonatorGT: String
+  updatedByImpersonatorGTE: String
+  updatedByImpersonatorLT: String
+  updatedByImpersonatorLTE: String
+  updatedByImpersonatorContains: String
+  updatedByImpersonatorHasPrefix: String
+  updatedByImpersonatorHasSuffix: String
+  updatedByImpersonatorIsNil: Boolean
+  updatedByImpersonatorNotNil: Boolean
+  updatedByImpersonatorEqualFold: String
+  updatedByImpersonatorContainsFold: String
+  """
+  owner_id field predicates
+  """
+  ownerID: String
+  ownerIDNEQ: String
+  ownerIDIn: [String!]
+  ownerIDNotIn: [String!]
+  ownerIDGT: String
+  ownerIDGTE: String
+  ownerIDLT: String
+  ownerIDLTE: String
+  ownerIDContains: String
+  ownerIDHasPrefix: String
+  ownerIDHasSuffix: String
+  ownerIDIsNil: Boolean
+  ownerIDNotNil: Boolean
+  ownerIDEqualFold: String
+  ownerIDContainsFold: String
+  """
+  name field predicates
+  """
+  name: String
+  nameNEQ: String
+  nameIn: [String!]
+  nameNotIn: [String!]
+  nameGT: String
+  nameGTE: String
+  nameLT: String
+  nameLTE: String
+  nameContains: String
+  nameHasPrefix: String
+  nameHasSuffix: String
+  nameEqualFold: String
+  nameContainsFold: String
+  """
+  description field predicates
+  """
+  description: String
+  descriptionNEQ: String
+  descriptionIn: [String!]
+  descriptionNotIn: [String!]
+  descriptionGT: String
+  descriptionGTE: String
+  descriptionLT: String
+  descriptionLTE: String
+  descriptionContains: String
+  descriptionHasPrefix: String
+  descriptionHasSuffix: String
+  descriptionIsNil: Boolean
+  descriptionNotNil: Boolean
+  descriptionEqualFold: String
+  descriptionContainsFold: String
+  """
+  category field predicates
+  """
+  category: String
+  categoryNEQ: String
+  categoryIn: [String!]
+  categoryNotIn: [String!]
+  categoryGT: String
+  categoryGTE: String
+  categoryLT: String
+  categoryLTE: String
+  categoryContains: String
+  categoryHasPrefix: String
+  categoryHasSuffix: String
+  categoryIsNil: Boolean
+  categoryNotNil: Boolean
+  categoryEqualFold: String
+  categoryContainsFold: String
+  """
+  object_type field predicates
+  """
+  objectType: String
+  objectTypeNEQ: String
+  objectTypeIn: [String!]
+  objectTypeNotIn: [String!]
+  objectTypeGT: String
+  objectTypeGTE: String
+  objectTypeLT: String
+  objectTypeLTE: String
+  objectTypeContains: String
+  objectTypeHasPrefix: String
+  objectTypeHasSuffix: String
+  objectTypeIsNil: Boolean
+  objectTypeNotNil: Boolean
+  objectTypeEqualFold: String
+  objectTypeContainsFold: String
+  """
+  retention_days field predicates
+  """
+  retentionDays: Int
+  retentionDaysNEQ: Int
+  retentionDaysIn: [Int!]
+  retentionDaysNotIn: [Int!]
+  retentionDaysGT: Int
+  retentionDaysGTE: Int
+  retentionDaysLT: Int
+  retentionDaysLTE: Int
+  """
+  purge field predicates
+  """
+  purge: Boolean
+  purgeNEQ: Boolean
+}
 type ReviewHistory implements Node {
   id: ID!
   historyTime: Time!
@@ -50939,6 +51522,10 @@ type TrustCenterFAQHistory implements Node {
   display order of the FAQ
   """
   displayOrder: Int
+  """
+  whether the FAQ answer is approved for reuse, e.g. when answering security questionnaires
+  """
+  approved: Boolean
 }
 """
 A connection to a list of items.
@@ -51238,6 +51825,13 @@ input TrustCenterFAQHistoryWhereInput {
   displayOrderLTE: Int
   displayOrderIsNil: Boolean
   displayOrderNotNil: Boolean
+  """
+  approved field predicates
+  """
+  approved: Boolean
+  approvedNEQ: Boolean
+  approvedIsNil: Boolean
+  approvedNotNil: Boolean
 }
 type TrustCenterHistory implements Node {
   id: ID!
@@ -59300,6 +59894,8 @@ func (ec *executionContext) childFields_EntityHistory(ctx context.Context, field
 		return ec.fieldContext_EntityHistory_hasSoc2(ctx, field)
 	case "soc2PeriodEnd":
 		return ec.fieldContext_EntityHistory_soc2PeriodEnd(ctx, field)
+	case "soc2LapsedAt":
+		return ec.fieldContext_EntityHistory_soc2LapsedAt(ctx, field)
 	case "contractStartDate":
 		return ec.fieldContext_EntityHistory_contractStartDate(ctx, field)
 	case "contractEndDate":
@@ -61612,6 +62208,66 @@ func (ec *executionContext) childFields_RemediationHistoryEdge(ctx context.Conte
 	return nil, fmt.Errorf("no field named %q was found under type RemediationHistoryEdge", field.Name)
 }
 
+func (ec *executionContext) childFields_RetentionRuleHistory(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "id":
+		return ec.fieldContext_RetentionRuleHistory_id(ctx, field)
+	case "historyTime":
+		return ec.fieldContext_RetentionRuleHistory_historyTime(ctx, field)
+	case "ref":
+		return ec.fieldContext_RetentionRuleHistory_ref(ctx, field)
+	case "operation":
+		return ec.fieldContext_RetentionRuleHistory_operation(ctx, field)
+	case "createdAt":
+		return ec.fieldContext_RetentionRuleHistory_createdAt(ctx, field)
+	case "updatedAt":
+		return ec.fieldContext_RetentionRuleHistory_updatedAt(ctx, field)
+	case "createdBy":
+		return ec.fieldContext_RetentionRuleHistory_createdBy(ctx, field)
+	case "updatedBy":
+		return ec.fieldContext_RetentionRuleHistory_updatedBy(ctx, field)
+	case "updatedByImpersonator":
+		return ec.fieldContext_RetentionRuleHistory_updatedByImpersonator(ctx, field)
+	case "ownerID":
+		return ec.fieldContext_RetentionRuleHistory_ownerID(ctx, field)
+	case "name":
+		return ec.fieldContext_RetentionRuleHistory_name(ctx, field)
+	case "description":
+		return ec.fieldContext_RetentionRuleHistory_description(ctx, field)
+	case "category":
+		return ec.fieldContext_RetentionRuleHistory_category(ctx, field)
+	case "objectType":
+		return ec.fieldContext_RetentionRuleHistory_objectType(ctx, field)
+	case "retentionDays":
+		return ec.fieldContext_RetentionRuleHistory_retentionDays(ctx, field)
+	case "purge":
+		return ec.fieldContext_RetentionRuleHistory_purge(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RetentionRuleHistory", field.Name)
+}
+
+func (ec *executionContext) childFields_RetentionRuleHistoryConnection(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "edges":
+		return ec.fieldContext_RetentionRuleHistoryConnection_edges(ctx, field)
+	case "pageInfo":
+		return ec.fieldContext_RetentionRuleHistoryConnection_pageInfo(ctx, field)
+	case "totalCount":
+		return ec.fieldContext_RetentionRuleHistoryConnection_totalCount(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RetentionRuleHistoryConnection", field.Name)
+}
+
+func (ec *executionContext) childFields_RetentionRuleHistoryEdge(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+	switch field.Name {
+	case "node":
+		return ec.fieldContext_RetentionRuleHistoryEdge_node(ctx, field)
+	case "cursor":
+		return ec.fieldContext_RetentionRuleHistoryEdge_cursor(ctx, field)
+	}
+	return nil, fmt.Errorf("no field named %q was found under type RetentionRuleHistoryEdge", field.Name)
+}
+
 func (ec *executionContext) childFields_ReviewHistory(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 	switch field.Name {
 	case "id":
@@ -62688,6 +63344,8 @@ func (ec *executionContext) childFields_TrustCenterFAQHistory(ctx context.Contex
 		return ec.fieldContext_TrustCenterFAQHistory_referenceLink(ctx, field)
 	case "displayOrder":
 		return ec.fieldContext_TrustCenterFAQHistory_displayOrder(ctx, field)
+	case "approved":
+		return ec.fieldContext_TrustCenterFAQHistory_approved(ctx, field)
 	}
 	return nil, fmt.Errorf("no field named %q was found under type TrustCenterFAQHistory", field.Name)
 }
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 
 	"entgo.io/contrib/entgql"
 	"github.com/theopenlane/core/common/enums"
@@ -60,6 +61,88 @@ type APITokenUpdatePayload struct {
 	APIToken *generated.APIToken `json:"apiToken"`
 }
 
+// AccessReview is the snapshot and decisions of an access review campaign
+type AccessReview struct {
+	// ID of the access review campaign
+	CampaignID string `json:"campaignID"`
+	// when the access of the organization was captured
+	SnapshotAt time.Time `json:"snapshotAt"`
+	// users assigned to review the entries
+	ReviewerIDs []string `json:"reviewerIDs"`
+	// controls the signed-off review is saved as evidence for
+	ControlIDs []string `json:"controlIDs"`
+	// memberships and tokens under review
+	Entries []*AccessReviewEntry `json:"entries"`
+	// counts of the entries by outcome
+	Summary *AccessReviewSummary `json:"summary"`
+	// user who signed off the review
+	CompletedBy *string `json:"completedBy,omitempty"`
+	// when the review was signed off
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	// evidence the signed-off review was saved as
+	EvidenceID *string `json:"evidenceID,omitempty"`
+	// sha256 of the decisions at sign-off, recorded on the evidence
+	Digest *string `json:"digest,omitempty"`
+}
+
+// AccessReviewEntry is a membership or token captured by an access review
+type AccessReviewEntry struct {
+	// ID of the membership or token
+	ID string `json:"id"`
+	// kind of access, one of ORG_MEMBERSHIP, GROUP_MEMBERSHIP, PROGRAM_MEMBERSHIP, API_TOKEN or PERSONAL_ACCESS_TOKEN
+	Kind string `json:"kind"`
+	// user holding the access, or the token itself for API tokens
+	SubjectID string `json:"subjectID"`
+	// readable name of the subject
+	SubjectName string `json:"subjectName"`
+	// organization, group or program the access is granted on
+	ResourceID string `json:"resourceID"`
+	// readable name of the resource
+	ResourceName string `json:"resourceName"`
+	// membership role, empty for tokens
+	Role *string `json:"role,omitempty"`
+	// scopes of a token
+	Scopes []string `json:"scopes,omitempty"`
+	// when a token expires
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	// when a token was last used
+	LastUsedAt *time.Time `json:"lastUsedAt,omitempty"`
+	// outcome of the review of the entry
+	Decision AccessReviewDecision `json:"decision"`
+	// reviewer who made the decision
+	DecidedBy *string `json:"decidedBy,omitempty"`
+	// when the decision was made
+	DecidedAt *time.Time `json:"decidedAt,omitempty"`
+	// justification given by the reviewer
+	Comment *string `json:"comment,omitempty"`
+	// when a revocation was applied
+	AppliedAt *time.Time `json:"appliedAt,omitempty"`
+	// why a revocation could not be applied
+	ApplyError *string `json:"applyError,omitempty"`
+}
+
+// Return response for access review mutations
+type AccessReviewPayload struct {
+	// access review after the mutation
+	AccessReview *AccessReview `json:"accessReview"`
+}
+
+// AccessReviewSummary counts the entries of an access review by outcome
+type AccessReviewSummary struct {
+	// number of entries
+	Total int `json:"total"`
+	// entries not reviewed yet
+	Pending int `json:"pending"`
+	// entries kept
+	Certified int `json:"certified"`
+	// entries marked for revocation
+	Revoked int `json:"revoked"`
+	// revocations applied
+	Applied int `json:"applied"`
+	// revocations that could not be applied
+	Failed int `json:"failed"`
+}
+
 // Return response for markNotificationsAsRead mutation
 type ActionNotificationsReadPayload struct {
 	// Updated notification IDs
@@ -210,6 +293,81 @@ type AssetUpdatePayload struct {
 	Asset *generated.Asset `json:"asset"`
 }
 
+// AuthorizationExplanation is why a subject can or cannot access an object
+type AuthorizationExplanation struct {
+	// subject in fga format, e.g. user:01HXYZ
+	Subject string `json:"subject"`
+	// object in fga format, e.g. program:01HXYZ
+	Object string `json:"object"`
+	// explained relation
+	Relation string `json:"relation"`
+	// whether the subject has the relation on the object
+	Allowed bool `json:"allowed"`
+	// resolution path from the object down to the tuple naming the subject, empty when access is denied
+	Path []*AuthorizationPathStep `json:"path"`
+	// closest link that would grant the relation when access is denied
+	MissingLink *AuthorizationPathStep `json:"missingLink,omitempty"`
+	// the can_view, can_edit and can_delete relations and the explained relation the subject has on the object
+	GrantedRelations []string `json:"grantedRelations"`
+	// set when the userset tree was too deep or wide to be fully walked
+	Truncated bool `json:"truncated"`
+}
+
+// AuthorizationPathStep is a single link of a resolution path
+type AuthorizationPathStep struct {
+	// object of the step in fga format, e.g. group:01HXYZ
+	Object string `json:"object"`
+	// relation on the object, e.g. member
+	Relation string `json:"relation"`
+	// how the step grants the relation, one of DIRECT, WILDCARD, ORG_ROLE, CUSTOM_ROLE, GROUP_MEMBERSHIP, PROGRAM_MEMBERSHIP, PARENT_INHERITANCE or COMPUTED
+	Source string `json:"source"`
+	// userset or tupleset the path continues through, e.g. group:01HXYZ#member
+	Via *string `json:"via,omitempty"`
+	// readable summary of the step
+	Description string `json:"description"`
+}
+
+// BatchMutationInput is the list of operations to run in a batch
+type BatchMutationInput struct {
+	// operations to run, in order
+	Operations []*BatchOperationInput `json:"operations"`
+}
+
+// BatchMutationPayload is the result of a batch mutation
+type BatchMutationPayload struct {
+	// results of the operations, in input order
+	Results []*BatchOperationResult `json:"results"`
+}
+
+// BatchOperationInput is a single create, update or delete within a batch
+type BatchOperationInput struct {
+	// label for the operation; later operations can use the ID of the object with "$ref:<ref>"
+	Ref *string `json:"ref,omitempty"`
+	// change to make
+	Operation BatchOperationType `json:"operation"`
+	// type of the object, e.g. Risk
+	ObjectType string `json:"objectType"`
+	// ID of the object to update or delete
+	ID *string `json:"id,omitempty"`
+	// fields of the create or update input for the object type, using the same names as the
+	// create and update mutations of the type
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// BatchOperationResult is the outcome of a single operation within a batch
+type BatchOperationResult struct {
+	// position of the operation in the input list
+	Index int `json:"index"`
+	// label given to the operation, if any
+	Ref *string `json:"ref,omitempty"`
+	// change made
+	Operation BatchOperationType `json:"operation"`
+	// type of the object
+	ObjectType string `json:"objectType"`
+	// ID of the object created, updated or deleted
+	ID string `json:"id"`
+}
+
 // Return response for approveNDARequests or denyNDARequests mutation
 type BulkUpdateStatusPayload struct {
 	// Updated nda request IDs
@@ -389,6 +547,16 @@ type CloneControlUploadInput struct {
 	ControlInput *generated.CreateControlInput `json:"controlInput,omitempty"`
 }
 
+// CompletedQuestionnairePayload is the questionnaire with the answers written into it
+type CompletedQuestionnairePayload struct {
+	// name of the completed file
+	Filename string `json:"filename"`
+	// media type of the completed file
+	ContentType string `json:"contentType"`
+	// base64 encoded contents of the completed file
+	Base64 string `json:"base64"`
+}
+
 // Return response for createBulkContact mutation
 type ContactBulkCreatePayload struct {
 	// Created contacts
@@ -784,6 +952,20 @@ type ControlUpdatePayload struct {
 	Control *generated.Control `json:"control"`
 }
 
+// Input for createAccessReview mutation
+type CreateAccessReviewInput struct {
+	// name of the campaign
+	Name string `json:"name"`
+	// description of the campaign
+	Description *string `json:"description,omitempty"`
+	// members of the organization assigned to review the entries
+	ReviewerIDs []string `json:"reviewerIDs"`
+	// controls the signed-off review is saved as evidence for, defaults to the access-control controls of the organization
+	ControlIDs []string `json:"controlIDs,omitempty"`
+	// when the review is due
+	DueDate *time.Time `json:"dueDate,omitempty"`
+}
+
 // Input for creating a questionnaire template from an assessment
 type CreateAssessmentTemplateInput struct {
 	// ID of the assessment to turn into a template
@@ -938,6 +1120,52 @@ type CustomDomainValidatePayload struct {
 	CustomDomain *generated.CustomDomain `json:"customDomain"`
 }
 
+// Return response for createBulkCustomRole mutation
+type CustomRoleBulkCreatePayload struct {
+	// Created customRoles
+	CustomRoles []*generated.CustomRole `json:"customRoles,omitempty"`
+}
+
+// Return response for deleteBulkCustomRole mutation
+type CustomRoleBulkDeletePayload struct {
+	// Deleted customRole IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// IDs that were not deleted
+	NotDeletedIDs []string `json:"notDeletedIDs"`
+	// Error message when the bulk delete did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// Return response for updateBulkCustomRole mutation
+type CustomRoleBulkUpdatePayload struct {
+	// Updated customRoles
+	CustomRoles []*generated.CustomRole `json:"customRoles,omitempty"`
+	// IDs of the updated customRoles
+	UpdatedIDs []string `json:"updatedIDs,omitempty"`
+	// IDs that were not updated
+	NotUpdatedIDs []string `json:"notUpdatedIDs"`
+	// Error message when the bulk update did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// Return response for createCustomRole mutation
+type CustomRoleCreatePayload struct {
+	// Created customRole
+	CustomRole *generated.CustomRole `json:"customRole"`
+}
+
+// Return response for deleteCustomRole mutation
+type CustomRoleDeletePayload struct {
+	// Deleted customRole ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for updateCustomRole mutation
+type CustomRoleUpdatePayload struct {
+	// Updated customRole
+	CustomRole *generated.CustomRole `json:"customRole"`
+}
+
 // Return response for createBulkCustomTypeEnum mutation
 type CustomTypeEnumBulkCreatePayload struct {
 	// Created customTypeEnums
@@ -1104,6 +1332,18 @@ type DirectorySyncRunUpdatePayload struct {
 	DirectorySyncRun *generated.DirectorySyncRun `json:"directorySyncRun"`
 }
 
+// Input for discardGalaDeadLetters mutation, the set fields are combined
+type DiscardGalaDeadLettersInput struct {
+	// discard the dead letters of the topic
+	Topic *string `json:"topic,omitempty"`
+	// discard the dead letters of the listener definition
+	Listener *string `json:"listener,omitempty"`
+	// discard the given dead letters
+	Ids []string `json:"ids,omitempty"`
+	// discard every dead letter, required when no other field is set
+	All *bool `json:"all,omitempty"`
+}
+
 // Return response for createBulkDiscussion mutation
 type DiscussionBulkCreatePayload struct {
 	// Created discussions
@@ -1575,6 +1815,60 @@ type FindingUpdatePayload struct {
 	Finding *generated.Finding `json:"finding"`
 }
 
+// GalaDeadLetter is a gala event whose durable dispatch exhausted its retries
+type GalaDeadLetter struct {
+	// ID of the dead letter
+	ID string `json:"id"`
+	// ID of the dead-lettered event
+	EventID string `json:"eventID"`
+	// topic the event was emitted on
+	Topic string `json:"topic"`
+	// listener definition whose failure exhausted the retries, empty when the event failed before reaching a listener
+	Listener *string `json:"listener,omitempty"`
+	// job kind the event was dispatched under
+	Kind *string `json:"kind,omitempty"`
+	// queue the job ran on
+	Queue string `json:"queue"`
+	// ID of the discarded job
+	JobID int64 `json:"jobID"`
+	// number of attempts made
+	Attempts int `json:"attempts"`
+	// payload of the event
+	Payload map[string]any `json:"payload,omitempty"`
+	// context snapshot restored for the listeners, keyed by context codec
+	ContextSnapshot map[string]any `json:"contextSnapshot,omitempty"`
+	// header properties of the event
+	Properties map[string]any `json:"properties,omitempty"`
+	// error of every attempt, oldest first
+	Errors []*GalaDeadLetterError `json:"errors"`
+	// when the event was emitted
+	OccurredAt time.Time `json:"occurredAt"`
+	// when the final attempt failed
+	FailedAt time.Time `json:"failedAt"`
+}
+
+// Return response for discardGalaDeadLetters mutation
+type GalaDeadLetterDiscardPayload struct {
+	// number of dead letters discarded
+	DiscardedCount int `json:"discardedCount"`
+}
+
+// GalaDeadLetterError is the error of one attempt of a dead-lettered event
+type GalaDeadLetterError struct {
+	// attempt number, starting at one
+	Attempt int `json:"attempt"`
+	// when the attempt failed
+	At time.Time `json:"at"`
+	// error message of the attempt
+	Error string `json:"error"`
+}
+
+// Return response for replayGalaDeadLetter mutation
+type GalaDeadLetterReplayPayload struct {
+	// replayed dead letter
+	DeadLetter *GalaDeadLetter `json:"deadLetter"`
+}
+
 // Return response for createBulkGroup mutation
 type GroupBulkCreatePayload struct {
 	// Created groups
@@ -1817,6 +2111,32 @@ type GroupUpdatePayload struct {
 	Group *generated.Group `json:"group"`
 }
 
+// HistoryDiffPayload contains the field-level diffs between two history entries of an object
+type HistoryDiffPayload struct {
+	// type of the object
+	ObjectType string `json:"objectType"`
+	// ID of the object
+	ID string `json:"id"`
+	// time the older entry was recorded
+	FromHistoryTime time.Time `json:"fromHistoryTime"`
+	// time the newer entry was recorded
+	ToHistoryTime time.Time `json:"toHistoryTime"`
+	// fields that differ between the entries
+	Diffs []*HistoryFieldDiff `json:"diffs"`
+}
+
+// HistoryFieldDiff describes a single field that differs between two history entries
+type HistoryFieldDiff struct {
+	// Field name (snake_case)
+	Field string `json:"field"`
+	// Value in the older entry
+	OldValue any `json:"oldValue,omitempty"`
+	// Value in the newer entry
+	NewValue any `json:"newValue,omitempty"`
+	// Unified diff text when applicable
+	Diff *string `json:"diff,omitempty"`
+}
+
 // Return response for createBulkHush mutation
 type HushBulkCreatePayload struct {
 	// Created hushs
@@ -2010,6 +2330,28 @@ type IntegrationDeletePayload struct {
 	DeletedID string `json:"deletedID"`
 }
 
+// IntegrationRunProgress is the state of an integration run as it executes
+type IntegrationRunProgress struct {
+	// ID of the integration run
+	ID string `json:"id"`
+	// ID of the integration the run belongs to
+	IntegrationID string `json:"integrationID"`
+	// name of the operation being run
+	OperationName string `json:"operationName"`
+	// status of the run
+	Status string `json:"status"`
+	// time the run started
+	StartedAt *time.Time `json:"startedAt,omitempty"`
+	// time the run finished, empty while the run is in progress
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+	// duration of the run in milliseconds, set once the run finishes
+	DurationMs *int `json:"durationMs,omitempty"`
+	// summary of the run
+	Summary *string `json:"summary,omitempty"`
+	// error message when the run failed
+	Error *string `json:"error,omitempty"`
+}
+
 // Return response for createBulkInternalPolicy mutation
 type InternalPolicyBulkCreatePayload struct {
 	// Created internalPolicys
@@ -2478,6 +2820,34 @@ type NotificationUpdatePayload struct {
 	Notification *generated.Notification `json:"notification"`
 }
 
+// ObjectSnapshot is an object as it was recorded in its history at a point in time
+type ObjectSnapshot struct {
+	// type of the object
+	ObjectType string `json:"objectType"`
+	// ID of the object
+	ID string `json:"id"`
+	// ID of the history entry the snapshot was built from
+	HistoryID string `json:"historyID"`
+	// time the history entry was recorded
+	HistoryTime time.Time `json:"historyTime"`
+	// change recorded by the history entry: INSERT, UPDATE or DELETE
+	Operation string `json:"operation"`
+	// values of the object keyed by field name (snake_case)
+	Fields map[string]any `json:"fields"`
+	// objects related to the object at the time of the snapshot that the caller can view
+	Edges []*ObjectSnapshotEdge `json:"edges"`
+}
+
+// ObjectSnapshotEdge lists the objects related to a snapshot through an edge
+type ObjectSnapshotEdge struct {
+	// name of the edge
+	Name string `json:"name"`
+	// type of the related objects
+	ObjectType string `json:"objectType"`
+	// IDs of the related objects
+	IDs []string `json:"ids"`
+}
+
 // Return response for createOnboarding mutation
 type OnboardingCreatePayload struct {
 	// Created onboarding
@@ -2615,6 +2985,32 @@ type OrganizationUpdatePayload struct {
 	Organization *generated.Organization `json:"organization"`
 }
 
+// OrganizationUsage is the usage of an organization against the limits of its plan
+type OrganizationUsage struct {
+	// ID of the organization
+	OrganizationID string `json:"organizationID"`
+	// start of the billing period metered usage such as integration runs is counted from
+	PeriodStart time.Time `json:"periodStart"`
+	// usage of each metric
+	Metrics []*OrganizationUsageMetric `json:"metrics"`
+}
+
+// OrganizationUsageMetric is the usage of a single metric and the limits of the plan
+type OrganizationUsageMetric struct {
+	// name of the metric, one of storage_bytes, seats, integration_runs or active_programs
+	Metric string `json:"metric"`
+	// current usage of the metric
+	Used int `json:"used"`
+	// limit of the plan, usage past it is allowed but should be reviewed; empty when the metric is unlimited
+	SoftLimit *int `json:"softLimit,omitempty"`
+	// limit past which creates are rejected; empty when the metric is unlimited
+	HardLimit *int `json:"hardLimit,omitempty"`
+	// whether the plan does not limit the metric
+	Unlimited bool `json:"unlimited"`
+	// how usage compares to the limits, one of OK, OVER_SOFT_LIMIT or AT_HARD_LIMIT
+	Status string `json:"status"`
+}
+
 // Return response for createBulkPersonalAccessToken mutation
 type PersonalAccessTokenBulkCreatePayload struct {
 	// Created personalAccessTokens
@@ -2815,6 +3211,56 @@ type ProgramUpdatePayload struct {
 	Program *generated.Program `json:"program"`
 }
 
+// QuestionnaireAnswerInput is an answer to write into a questionnaire
+type QuestionnaireAnswerInput struct {
+	// sheet the question is on, defaults to the first sheet
+	Sheet *string `json:"sheet,omitempty"`
+	// row number of the question, as returned by suggestQuestionnaireAnswers
+	Row int `json:"row"`
+	// answer text
+	Answer string `json:"answer"`
+}
+
+// QuestionnaireAnswerSource is the object a suggested answer was taken from
+type QuestionnaireAnswerSource struct {
+	// type of the object, e.g. TrustCenterFAQ
+	ObjectType string `json:"objectType"`
+	// ID of the object
+	ID string `json:"id"`
+	// title of the object
+	Title *string `json:"title,omitempty"`
+}
+
+// QuestionnaireAnswerSuggestion is an answer suggested for a question
+type QuestionnaireAnswerSuggestion struct {
+	// suggested answer
+	Answer string `json:"answer"`
+	// similarity of the question to the source of the answer, between 0 and 1
+	Confidence float64 `json:"confidence"`
+	// objects the answer was found in
+	Sources []*QuestionnaireAnswerSource `json:"sources"`
+}
+
+// QuestionnaireQuestion is a question read from a questionnaire with its suggested answers
+type QuestionnaireQuestion struct {
+	// sheet the question is on
+	Sheet string `json:"sheet"`
+	// row number of the question
+	Row int `json:"row"`
+	// question text
+	Question string `json:"question"`
+	// answer already in the file, if any
+	ExistingAnswer *string `json:"existingAnswer,omitempty"`
+	// suggested answers, most confident first
+	Suggestions []*QuestionnaireAnswerSuggestion `json:"suggestions"`
+}
+
+// QuestionnaireSuggestionsPayload is the result of suggesting answers for a questionnaire
+type QuestionnaireSuggestionsPayload struct {
+	// questions found in the file, in file order
+	Questions []*QuestionnaireQuestion `json:"questions"`
+}
+
 // Input for adminReassignWorkflowAssignment mutation
 type ReassignWorkflowAssignmentInput struct {
 	// ID of the workflow assignment to reassign
@@ -2923,6 +3369,62 @@ type ResolveVulnerabilityInput struct {
 	ReviewState *string `json:"reviewState,omitempty"`
 }
 
+// RestoreFromHistoryPayload is the result of reverting an object to a history entry
+type RestoreFromHistoryPayload struct {
+	// type of the object
+	ObjectType string `json:"objectType"`
+	// ID of the restored object
+	ID string `json:"id"`
+	// fields set back to the values recorded by the history entry
+	RestoredFields []string `json:"restoredFields"`
+}
+
+// Return response for createBulkRetentionRule mutation
+type RetentionRuleBulkCreatePayload struct {
+	// Created retentionRules
+	RetentionRules []*generated.RetentionRule `json:"retentionRules,omitempty"`
+}
+
+// Return response for deleteBulkRetentionRule mutation
+type RetentionRuleBulkDeletePayload struct {
+	// Deleted retentionRule IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// IDs that were not deleted
+	NotDeletedIDs []string `json:"notDeletedIDs"`
+	// Error message when the bulk delete did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// Return response for updateBulkRetentionRule mutation
+type RetentionRuleBulkUpdatePayload struct {
+	// Updated retentionRules
+	RetentionRules []*generated.RetentionRule `json:"retentionRules,omitempty"`
+	// IDs of the updated retentionRules
+	UpdatedIDs []string `json:"updatedIDs,omitempty"`
+	// IDs that were not updated
+	NotUpdatedIDs []string `json:"notUpdatedIDs"`
+	// Error message when the bulk update did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// Return response for createRetentionRule mutation
+type RetentionRuleCreatePayload struct {
+	// Created retentionRule
+	RetentionRule *generated.RetentionRule `json:"retentionRule"`
+}
+
+// Return response for deleteRetentionRule mutation
+type RetentionRuleDeletePayload struct {
+	// Deleted retentionRule ID
+	DeletedID string `json:"deletedID"`
+}
+
+// Return response for updateRetentionRule mutation
+type RetentionRuleUpdatePayload struct {
+	// Updated retentionRule
+	RetentionRule *generated.RetentionRule `json:"retentionRule"`
+}
+
 // Return response for createBulkReview mutation
 type ReviewBulkCreatePayload struct {
 	// Created reviews
@@ -3826,6 +4328,30 @@ type TrustCenterSettingUpdatePayload struct {
 	TrustCenterSetting *generated.TrustCenterSetting `json:"trustCenterSetting"`
 }
 
+// TrustCenterSiteExcludedDocument is a trust center document left out of an export
+type TrustCenterSiteExcludedDocument struct {
+	// title of the document
+	Title string `json:"title"`
+	// why the document was left out
+	Reason string `json:"reason"`
+}
+
+// TrustCenterSiteExportPayload is a signed static site export of a trust center
+type TrustCenterSiteExportPayload struct {
+	// name of the archive
+	Filename string `json:"filename"`
+	// media type of the archive
+	ContentType string `json:"contentType"`
+	// base64 encoded contents of the archive
+	Base64 string `json:"base64"`
+	// ID of the key that signed the manifest, published at /.well-known/trust-center-export-keys.json
+	KeyID string `json:"keyID"`
+	// number of documents included in the export
+	DocumentCount int `json:"documentCount"`
+	// documents left out of the export
+	ExcludedDocuments []*TrustCenterSiteExcludedDocument `json:"excludedDocuments"`
+}
+
 // Return response for createBulkTrustCenterSubprocessor mutation
 type TrustCenterSubprocessorBulkCreatePayload struct {
 	// Created trustCenterSubprocessors
@@ -4305,6 +4831,122 @@ type WorkflowProposalWithdrawPayload struct {
 	WorkflowProposal *generated.WorkflowProposal `json:"workflowProposal"`
 }
 
+// AccessReviewDecision is the outcome of the review of an access review entry
+type AccessReviewDecision string
+
+const (
+	AccessReviewDecisionPending AccessReviewDecision = "PENDING"
+	AccessReviewDecisionCertify AccessReviewDecision = "CERTIFY"
+	AccessReviewDecisionRevoke  AccessReviewDecision = "REVOKE"
+)
+
+var AllAccessReviewDecision = []AccessReviewDecision{
+	AccessReviewDecisionPending,
+	AccessReviewDecisionCertify,
+	AccessReviewDecisionRevoke,
+}
+
+func (e AccessReviewDecision) IsValid() bool {
+	switch e {
+	case AccessReviewDecisionPending, AccessReviewDecisionCertify, AccessReviewDecisionRevoke:
+		return true
+	}
+	return false
+}
+
+func (e AccessReviewDecision) String() string {
+	return string(e)
+}
+
+func (e *AccessReviewDecision) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AccessReviewDecision(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AccessReviewDecision", str)
+	}
+	return nil
+}
+
+func (e AccessReviewDecision) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *AccessReviewDecision) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e AccessReviewDecision) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// BatchOperationType is the kind of change made by a batch operation
+type BatchOperationType string
+
+const (
+	BatchOperationTypeCreate BatchOperationType = "CREATE"
+	BatchOperationTypeUpdate BatchOperationType = "UPDATE"
+	BatchOperationTypeDelete BatchOperationType = "DELETE"
+)
+
+var AllBatchOperationType = []BatchOperationType{
+	BatchOperationTypeCreate,
+	BatchOperationTypeUpdate,
+	BatchOperationTypeDelete,
+}
+
+func (e BatchOperationType) IsValid() bool {
+	switch e {
+	case BatchOperationTypeCreate, BatchOperationTypeUpdate, BatchOperationTypeDelete:
+		return true
+	}
+	return false
+}
+
+func (e BatchOperationType) String() string {
+	return string(e)
+}
+
+func (e *BatchOperationType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BatchOperationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BatchOperationType", str)
+	}
+	return nil
+}
+
+func (e BatchOperationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BatchOperationType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BatchOperationType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Properties by which ControlCategory connections can be ordered.
 type ControlCategoryOrderField string
 
@@ -437,6 +437,26 @@ type TagDefinitionBuilder struct {
 	Color string
 }
 
+type RetentionRuleBuilder struct {
+	client *client
+
+	// Fields
+	Name          string
+	Category      string
+	ObjectType    string
+	RetentionDays int
+}
+
+type CustomRoleBuilder struct {
+	client *client
+
+	// Fields
+	Name        string
+	Permissions []string
+	ProgramIDs  []string
+	GroupIDs    []string
+}
+
 type CustomTypeEnumBuilder struct {
 	client *client
 
@@ -2636,6 +2656,64 @@ func (td *TagDefinitionBuilder) MustNew(ctx context.Context, t *testing.T) *ent.
 	return tagDefinition
 }
 
+func (rr *RetentionRuleBuilder) MustNew(ctx context.Context, t *testing.T) *ent.RetentionRule {
+	ctx = setContext(ctx, rr.client.db)
+
+	if rr.Name == "" {
+		rr.Name = gofakeit.HipsterWord() + "-" + ulids.New().String()
+	}
+
+	if rr.RetentionDays == 0 {
+		rr.RetentionDays = 365
+	}
+
+	mutation := rr.client.db.RetentionRule.Create().
+		SetName(rr.Name).
+		SetRetentionDays(rr.RetentionDays)
+
+	if rr.Category != "" {
+		mutation.SetCategory(rr.Category)
+	}
+
+	if rr.ObjectType != "" {
+		mutation.SetObjectType(rr.ObjectType)
+	}
+
+	rule, err := mutation.Save(ctx)
+	requireNoError(t, err)
+
+	return rule
+}
+
+func (cr *CustomRoleBuilder) MustNew(ctx context.Context, t *testing.T) *ent.CustomRole {
+	ctx = setContext(ctx, cr.client.db)
+
+	if cr.Name == "" {
+		cr.Name = gofakeit.HipsterWord() + "-" + ulids.New().String()
+	}
+
+	if len(cr.Permissions) == 0 {
+		cr.Permissions = []string{"can_manage_policies"}
+	}
+
+	mutation := cr.client.db.CustomRole.Create().
+		SetName(cr.Name).
+		SetPermissions(cr.Permissions)
+
+	if len(cr.ProgramIDs) > 0 {
+		mutation.SetProgramIds(cr.ProgramIDs)
+	}
+
+	if len(cr.GroupIDs) > 0 {
+		mutation.SetGroupIds(cr.GroupIDs)
+	}
+
+	role, err := mutation.Save(ctx)
+	requireNoError(t, err)
+
+	return role
+}
+
 func (td *CustomTypeEnumBuilder) MustNew(ctx context.Context, t *testing.T) *ent.CustomTypeEnum {
 	ctx = setContext(ctx, td.client.db)
 
@@ -0,0 +1,28 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+	"time"
+
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// RestoreFromHistory is the resolver for the restoreFromHistory field.
+func (r *mutationResolver) RestoreFromHistory(ctx context.Context, objectType string, historyID string) (*model.RestoreFromHistoryPayload, error) {
+	return r.restoreFromHistory(ctx, objectType, historyID)
+}
+
+// ObjectAsOf is the resolver for the objectAsOf field.
+func (r *queryResolver) ObjectAsOf(ctx context.Context, objectType string, id string, asOf time.Time) (*model.ObjectSnapshot, error) {
+	return r.objectAsOf(ctx, objectType, id, asOf)
+}
+
+// HistoryDiff is the resolver for the historyDiff field.
+func (r *queryResolver) HistoryDiff(ctx context.Context, objectType string, fromHistoryID string, toHistoryID string) (*model.HistoryDiffPayload, error) {
+	return r.historyDiff(ctx, objectType, fromHistoryID, toHistoryID)
+}
@@ -0,0 +1,273 @@
+package graphapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/stoewer/go-strcase"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/historyops"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// objectAsOf rebuilds the object from its history at the given time
+func (r *Resolver) objectAsOf(ctx context.Context, objectType, id string, asOf time.Time) (*model.ObjectSnapshot, error) {
+	action := common.Action{Action: common.ActionGet, Object: "object history"}
+
+	name, err := r.objectHistoryType(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.checkObjectHistoryAccess(ctx, name, id, fgax.CanViewAuditLog); err != nil {
+		return nil, err
+	}
+
+	snapshot, err := historyops.AsOf(ctx, r.db.HistoryClient, name, id, asOf)
+	if err != nil {
+		return nil, objectHistoryError(ctx, err, action)
+	}
+
+	out := &model.ObjectSnapshot{
+		ObjectType:  snapshot.ObjectType,
+		ID:          snapshot.ID,
+		HistoryID:   snapshot.HistoryID,
+		HistoryTime: snapshot.HistoryTime,
+		Operation:   snapshot.Operation,
+		Fields:      snapshot.Fields,
+		Edges:       make([]*model.ObjectSnapshotEdge, 0, len(snapshot.Edges)),
+	}
+
+	for _, e := range snapshot.Edges {
+		ids, err := r.viewableHistoryEdgeIDs(ctx, e.ObjectType, e.IDs)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(ids) == 0 {
+			continue
+		}
+
+		out.Edges = append(out.Edges, &model.ObjectSnapshotEdge{
+			Name:       e.Name,
+			ObjectType: e.ObjectType,
+			IDs:        ids,
+		})
+	}
+
+	return out, nil
+}
+
+// viewableHistoryEdgeIDs returns the related ids of a snapshot edge the caller can view; edges are read from
+// the history tables without privacy rules, so objects without their own access checks are left out rather than
+// shown to callers who could not query them
+func (r *Resolver) viewableHistoryEdgeIDs(ctx context.Context, objectType string, ids []string) ([]string, error) {
+	kind := strcase.SnakeCase(objectType)
+	if len(ids) == 0 || !generated.IsSelfAccessType(kind) {
+		return nil, nil
+	}
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return nil, newPermissionDeniedError()
+	}
+
+	checks := make([]fgax.AccessCheck, 0, len(ids))
+	for _, id := range ids {
+		checks = append(checks, fgax.AccessCheck{
+			ObjectType:  fgax.Kind(kind),
+			ObjectID:    id,
+			Relation:    fgax.CanView,
+			SubjectID:   caller.SubjectID,
+			SubjectType: caller.SubjectType(),
+		})
+	}
+
+	return r.db.Authz.BatchCheckObjectAccess(ctx, checks)
+}
+
+// historyDiff compares two history entries of the same object
+func (r *Resolver) historyDiff(ctx context.Context, objectType, fromHistoryID, toHistoryID string) (*model.HistoryDiffPayload, error) {
+	action := common.Action{Action: common.ActionGet, Object: "object history"}
+
+	name, err := r.objectHistoryType(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := historyops.Entry(ctx, r.db.HistoryClient, name, fromHistoryID)
+	if err != nil {
+		return nil, objectHistoryError(ctx, err, action)
+	}
+
+	// access is checked before the second entry is read, so nothing about an object the caller cannot view is
+	// revealed by the entries compared with it
+	if err := r.checkObjectHistoryAccess(ctx, name, from.ID, fgax.CanViewAuditLog); err != nil {
+		return nil, err
+	}
+
+	to, err := historyops.Entry(ctx, r.db.HistoryClient, name, toHistoryID)
+	if err != nil {
+		return nil, objectHistoryError(ctx, err, action)
+	}
+
+	if to.ID != from.ID {
+		return nil, objectHistoryError(ctx, historyops.ErrEntryMismatch, action)
+	}
+
+	diffs, err := historyops.Diff(from, to)
+	if err != nil {
+		return nil, objectHistoryError(ctx, err, action)
+	}
+
+	out := &model.HistoryDiffPayload{
+		ObjectType:      name,
+		ID:              from.ID,
+		FromHistoryTime: from.HistoryTime,
+		ToHistoryTime:   to.HistoryTime,
+		Diffs:           make([]*model.HistoryFieldDiff, 0, len(diffs)),
+	}
+
+	for _, d := range diffs {
+		fieldDiff := &model.HistoryFieldDiff{
+			Field:    d.Field,
+			OldValue: d.OldValue,
+			NewValue: d.NewValue,
+		}
+
+		if text := workflowProposalDiff(d.OldValue, d.NewValue); text != "" {
+			fieldDiff.Diff = &text
+		}
+
+		out.Diffs = append(out.Diffs, fieldDiff)
+	}
+
+	return out, nil
+}
+
+// restoreFromHistory reverts the object recorded by the history entry to the recorded values
+func (r *Resolver) restoreFromHistory(ctx context.Context, objectType, historyID string) (*model.RestoreFromHistoryPayload, error) {
+	action := common.Action{Action: common.ActionUpdate, Object: "object history"}
+
+	name, err := r.objectHistoryType(objectType)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot, err := historyops.Entry(ctx, r.db.HistoryClient, name, historyID)
+	if err != nil {
+		return nil, objectHistoryError(ctx, err, action)
+	}
+
+	if err := r.checkObjectHistoryAccess(ctx, name, snapshot.ID, fgax.CanEdit); err != nil {
+		return nil, err
+	}
+
+	writable, err := restoreWritableFields(ctx, name, snapshot.ID)
+	if err != nil {
+		return nil, objectHistoryError(ctx, err, action)
+	}
+
+	restored, err := historyops.Restore(ctx, withTransactionalMutation(ctx), snapshot, writable)
+	if err != nil {
+		return nil, objectHistoryError(ctx, err, action)
+	}
+
+	return &model.RestoreFromHistoryPayload{
+		ObjectType:     name,
+		ID:             snapshot.ID,
+		RestoredFields: restored,
+	}, nil
+}
+
+// restoreWritableFields returns the filter of the columns a restore may write back to the object; the fields of
+// the update input the caller cannot set are left out, the same way they are rejected for batch updates
+func restoreWritableFields(ctx context.Context, objectType, id string) (historyops.FieldFilter, error) {
+	if auth.IsSystemAdminFromContext(ctx) {
+		return nil, nil
+	}
+
+	def := batchSchema().Types["Update"+objectType+"Input"]
+	if def == nil {
+		return nil, nil
+	}
+
+	readOnly := map[string]bool{}
+
+	for _, field := range def.Fields {
+		restricted, err := readOnlyInputField(ctx, field, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if restricted {
+			readOnly[strings.ToLower(field.Name)] = true
+		}
+	}
+
+	// input fields are the camel case column names, with initialisms such as ID upper cased
+	return func(column string) bool {
+		return !readOnly[strings.ReplaceAll(column, "_", "")]
+	}, nil
+}
+
+// objectHistoryType resolves the schema name of an object type that keeps history
+func (r *Resolver) objectHistoryType(objectType string) (string, error) {
+	if r.db.HistoryClient == nil {
+		return "", fmt.Errorf("history client not configured") //nolint:err113
+	}
+
+	name, err := historyops.ObjectType(objectType)
+	if err != nil {
+		return "", common.NewValidationErrorWithFields(err.Error(), "objectType")
+	}
+
+	return name, nil
+}
+
+// checkObjectHistoryAccess checks the caller has the relation on the object; history is read directly from the
+// history tables so the check takes the place of the privacy rules applied to regular queries
+func (r *Resolver) checkObjectHistoryAccess(ctx context.Context, objectType, id, relation string) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return newPermissionDeniedError()
+	}
+
+	allow, err := r.db.Authz.CheckAccess(ctx, fgax.AccessCheck{
+		ObjectType:  fgax.Kind(strcase.SnakeCase(objectType)),
+		ObjectID:    id,
+		Relation:    relation,
+		SubjectID:   caller.SubjectID,
+		SubjectType: caller.SubjectType(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !allow {
+		return newPermissionDeniedError()
+	}
+
+	return nil
+}
+
+// objectHistoryError maps history errors to errors returned to the user
+func objectHistoryError(ctx context.Context, err error, a common.Action) error {
+	switch {
+	case errors.Is(err, historyops.ErrNoHistory):
+		return common.NewNotFoundError(a.Object)
+	case errors.Is(err, historyops.ErrObjectDeleted),
+		errors.Is(err, historyops.ErrEntryMismatch),
+		errors.Is(err, historyops.ErrRestoreUnsupported):
+		return common.NewValidationError(err.Error())
+	default:
+		return parseRequestError(ctx, err, a)
+	}
+}
@@ -0,0 +1,85 @@
+package graphapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stoewer/go-strcase"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/core/internal/metering"
+)
+
+// organizationUsage measures the usage of the organization of the caller against the limits of its plan
+func (r *queryResolver) organizationUsage(ctx context.Context) (*model.OrganizationUsage, error) {
+	action := common.Action{Action: common.ActionGet, Object: "organizationusage"}
+
+	orgID, err := auth.GetOrganizationIDFromContext(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, action)
+	}
+
+	if err := r.checkOrganizationUsageAccess(ctx, orgID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	usage, err := metering.Snapshot(ctx, withTransactionalMutation(ctx), orgID, now)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, action)
+	}
+
+	metrics := make([]*model.OrganizationUsageMetric, 0, len(usage))
+	for _, u := range usage {
+		m := &model.OrganizationUsageMetric{
+			Metric:    u.Metric.String(),
+			Used:      int(u.Used),
+			Unlimited: u.Limit.Unlimited,
+			Status:    string(u.Status),
+		}
+
+		if !u.Limit.Unlimited {
+			m.SoftLimit = lo.ToPtr(int(u.Limit.Soft))
+			m.HardLimit = lo.ToPtr(int(u.Limit.Hard))
+		}
+
+		metrics = append(metrics, m)
+	}
+
+	return &model.OrganizationUsage{
+		OrganizationID: orgID,
+		PeriodStart:    metering.PeriodStart(now),
+		Metrics:        metrics,
+	}, nil
+}
+
+// checkOrganizationUsageAccess checks the caller can view the organization
+func (r *queryResolver) checkOrganizationUsageAccess(ctx context.Context, orgID string) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return newPermissionDeniedError()
+	}
+
+	allow, err := r.db.Authz.CheckAccess(ctx, fgax.AccessCheck{
+		ObjectType:  fgax.Kind(strcase.SnakeCase(generated.TypeOrganization)),
+		ObjectID:    orgID,
+		Relation:    fgax.CanView,
+		SubjectID:   caller.SubjectID,
+		SubjectType: caller.SubjectType(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !allow {
+		return newPermissionDeniedError()
+	}
+
+	return nil
+}
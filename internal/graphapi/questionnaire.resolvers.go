@@ -0,0 +1,24 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// SuggestQuestionnaireAnswers is the resolver for the suggestQuestionnaireAnswers field.
+func (r *mutationResolver) SuggestQuestionnaireAnswers(ctx context.Context, questionnaire graphql.Upload, limit *int) (*model.QuestionnaireSuggestionsPayload, error) {
+	return r.suggestQuestionnaireAnswers(ctx, questionnaire, limit)
+}
+
+// CompleteQuestionnaire is the resolver for the completeQuestionnaire field.
+func (r *mutationResolver) CompleteQuestionnaire(ctx context.Context, questionnaire graphql.Upload, answers []*model.QuestionnaireAnswerInput) (*model.CompletedQuestionnairePayload, error) {
+	return r.completeQuestionnaire(ctx, questionnaire, answers)
+}
@@ -0,0 +1,328 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/samber/lo"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/assessmentresponse"
+	"github.com/theopenlane/core/internal/ent/generated/internalpolicy"
+	"github.com/theopenlane/core/internal/ent/generated/trustcenterfaq"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/core/pkg/questionnaire"
+)
+
+const (
+	// maxQuestionnaireResponses is the number of most recently completed assessment responses added to the answer library
+	maxQuestionnaireResponses = 200
+	// maxPolicyAnswerLength is the number of characters of a policy used as the answer when it has no summary
+	maxPolicyAnswerLength = 1000
+)
+
+// suggestQuestionnaireAnswers reads the questions of the uploaded questionnaire and suggests answers for each
+// from the organization's answer library
+func (r *mutationResolver) suggestQuestionnaireAnswers(ctx context.Context, upload graphql.Upload, limit *int) (*model.QuestionnaireSuggestionsPayload, error) {
+	doc, err := parseQuestionnaire(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	questions, err := doc.Questions()
+	if err != nil {
+		return nil, questionnaireError(err)
+	}
+
+	lib, err := questionnaireLibrary(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "questionnaire answers"})
+	}
+
+	out := &model.QuestionnaireSuggestionsPayload{
+		Questions: make([]*model.QuestionnaireQuestion, 0, len(questions)),
+	}
+
+	for _, q := range questions {
+		question := &model.QuestionnaireQuestion{
+			Sheet:          q.Sheet,
+			Row:            q.Row,
+			Question:       q.Text,
+			ExistingAnswer: lo.EmptyableToPtr(q.Answer),
+			Suggestions:    []*model.QuestionnaireAnswerSuggestion{},
+		}
+
+		for _, s := range lib.Suggest(q.Text, lo.FromPtr(limit)) {
+			suggestion := &model.QuestionnaireAnswerSuggestion{
+				Answer:     s.Answer,
+				Confidence: s.Confidence,
+				Sources:    make([]*model.QuestionnaireAnswerSource, 0, len(s.Sources)),
+			}
+
+			for _, src := range s.Sources {
+				suggestion.Sources = append(suggestion.Sources, &model.QuestionnaireAnswerSource{
+					ObjectType: src.ObjectType,
+					ID:         src.ID,
+					Title:      lo.EmptyableToPtr(src.Title),
+				})
+			}
+
+			question.Suggestions = append(question.Suggestions, suggestion)
+		}
+
+		out.Questions = append(out.Questions, question)
+	}
+
+	return out, nil
+}
+
+// completeQuestionnaire writes the answers into the uploaded questionnaire and returns the completed file
+func (r *mutationResolver) completeQuestionnaire(ctx context.Context, upload graphql.Upload, answers []*model.QuestionnaireAnswerInput) (*model.CompletedQuestionnairePayload, error) {
+	doc, err := parseQuestionnaire(upload)
+	if err != nil {
+		return nil, err
+	}
+
+	fill := make([]questionnaire.Answer, 0, len(answers))
+
+	for _, a := range answers {
+		if a.Row < 1 {
+			return nil, common.NewValidationErrorWithFields("row must be greater than zero", "row")
+		}
+
+		fill = append(fill, questionnaire.Answer{
+			Sheet: lo.FromPtr(a.Sheet),
+			Row:   a.Row,
+			Text:  a.Answer,
+		})
+	}
+
+	data, err := doc.Fill(fill)
+	if err != nil {
+		return nil, questionnaireError(err)
+	}
+
+	return &model.CompletedQuestionnairePayload{
+		Filename:    upload.Filename,
+		ContentType: doc.ContentType(),
+		Base64:      base64.StdEncoding.EncodeToString(data),
+	}, nil
+}
+
+// parseQuestionnaire reads and parses the uploaded questionnaire
+func parseQuestionnaire(upload graphql.Upload) (*questionnaire.Document, error) {
+	if upload.File == nil {
+		return nil, common.NewValidationErrorWithFields("questionnaire file is required", "questionnaire")
+	}
+
+	data, err := io.ReadAll(upload.File)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := questionnaire.Parse(upload.Filename, data)
+	if err != nil {
+		return nil, questionnaireError(err)
+	}
+
+	return doc, nil
+}
+
+// questionnaireError maps questionnaire errors to errors returned to the user
+func questionnaireError(err error) error {
+	switch {
+	case errors.Is(err, questionnaire.ErrUnsupportedFormat),
+		errors.Is(err, questionnaire.ErrInvalidDocument),
+		errors.Is(err, questionnaire.ErrNoQuestions):
+		return common.NewValidationErrorWithFields(err.Error(), "questionnaire")
+	case errors.Is(err, questionnaire.ErrUnknownSheet):
+		return common.NewValidationErrorWithFields(err.Error(), "sheet")
+	default:
+		return err
+	}
+}
+
+// questionnaireLibrary builds the answer library from the approved trust center FAQs, published policies and
+// completed assessment responses the user can see; FAQs that have not been approved for reuse are left out
+func questionnaireLibrary(ctx context.Context) (*questionnaire.Library, error) {
+	client := withTransactionalMutation(ctx)
+
+	var entries []questionnaire.Entry
+
+	faqs, err := client.TrustCenterFAQ.Query().
+		Where(trustcenterfaq.Approved(true)).
+		WithNote().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, faq := range faqs {
+		n := faq.Edges.Note
+		if n == nil || lo.FromPtr(n.Title) == "" || strings.TrimSpace(n.Text) == "" {
+			continue
+		}
+
+		entries = append(entries, questionnaire.Entry{
+			Question: *n.Title,
+			Answer:   sanitizeContent(n.Text),
+			Source:   questionnaire.Source{ObjectType: generated.TypeTrustCenterFAQ, ID: faq.ID, Title: *n.Title},
+		})
+	}
+
+	policies, err := client.InternalPolicy.Query().
+		Where(internalpolicy.StatusEQ(enums.DocumentPublished)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range policies {
+		details := sanitizeContent(p.Details)
+
+		entries = append(entries, questionnaire.Entry{
+			Question: p.Name + "\n" + details,
+			Answer:   lo.CoalesceOrEmpty(strings.TrimSpace(p.Summary), truncateRunes(details, maxPolicyAnswerLength)),
+			Source:   questionnaire.Source{ObjectType: generated.TypeInternalPolicy, ID: p.ID, Title: p.Name},
+		})
+	}
+
+	responses, err := client.AssessmentResponse.Query().
+		Where(
+			assessmentresponse.StatusEQ(enums.AssessmentResponseStatusCompleted),
+			assessmentresponse.IsTest(false),
+			assessmentresponse.IsDraft(false),
+		).
+		WithDocument().
+		WithAssessment().
+		Order(generated.Desc(assessmentresponse.FieldCompletedAt)).
+		Limit(maxQuestionnaireResponses).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resp := range responses {
+		if resp.Edges.Document == nil || resp.Edges.Assessment == nil {
+			continue
+		}
+
+		source := questionnaire.Source{
+			ObjectType: generated.TypeAssessmentResponse,
+			ID:         resp.ID,
+			Title:      lo.CoalesceOrEmpty(resp.DisplayName, resp.Edges.Assessment.Name),
+		}
+
+		titles := surveyQuestionTitles(resp.Edges.Assessment.Jsonconfig)
+		names := lo.Keys(titles)
+		sort.Strings(names)
+
+		for _, name := range names {
+			entries = append(entries, questionnaire.Entry{
+				Question: titles[name],
+				Answer:   surveyAnswerText(resp.Edges.Document.Data[name]),
+				Source:   source,
+			})
+		}
+	}
+
+	return questionnaire.NewLibrary(entries), nil
+}
+
+// surveyQuestionTitles returns the titles of the questions in a survey configuration keyed by question name;
+// localized titles use the default locale
+func surveyQuestionTitles(config map[string]any) map[string]string {
+	titles := map[string]string{}
+
+	var walk func(v any)
+
+	walk = func(v any) {
+		switch t := v.(type) {
+		case map[string]any:
+			name, _ := t["name"].(string)
+			if title := surveyText(t["title"]); name != "" && title != "" {
+				titles[name] = title
+			}
+
+			for _, child := range t {
+				walk(child)
+			}
+		case []any:
+			for _, child := range t {
+				walk(child)
+			}
+		}
+	}
+
+	walk(config)
+
+	return titles
+}
+
+// surveyText returns a survey string, which is either plain or localized
+func surveyText(v any) string {
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case map[string]any:
+		s, _ := t["default"].(string)
+
+		return strings.TrimSpace(s)
+	default:
+		return ""
+	}
+}
+
+// surveyAnswerText formats a survey answer as text; lists are joined and booleans become Yes or No
+func surveyAnswerText(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(t)
+	case bool:
+		if t {
+			return "Yes"
+		}
+
+		return "No"
+	case []any:
+		parts := make([]string, 0, len(t))
+
+		for _, item := range t {
+			if s := surveyAnswerText(item); s != "" {
+				parts = append(parts, s)
+			}
+		}
+
+		return strings.Join(parts, ", ")
+	case map[string]any:
+		// composite answers such as matrices are not useful as a suggested answer
+		return ""
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+// truncateRunes shortens s to at most n runes, cutting at the last word boundary
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+
+	cut := string(r[:n])
+	if i := strings.LastIndex(cut, " "); i > 0 {
+		cut = cut[:i]
+	}
+
+	return cut + "…"
+}
@@ -0,0 +1,46 @@
+package graphapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSurveyQuestionTitles(t *testing.T) {
+	config := map[string]any{
+		"title": "Vendor security review",
+		"pages": []any{
+			map[string]any{
+				"name": "page1",
+				"elements": []any{
+					map[string]any{"type": "boolean", "name": "mfa", "title": "Is MFA enforced?"},
+					map[string]any{"type": "text", "name": "encryption", "title": map[string]any{"default": "How is data encrypted?", "de": "Wie werden Daten verschlüsselt?"}},
+					map[string]any{"type": "panel", "name": "panel1", "elements": []any{
+						map[string]any{"type": "comment", "name": "ir", "title": " Describe your incident response process "},
+						map[string]any{"type": "html", "name": "intro"},
+					}},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"mfa":        "Is MFA enforced?",
+		"encryption": "How is data encrypted?",
+		"ir":         "Describe your incident response process",
+	}, surveyQuestionTitles(config))
+}
+
+func TestSurveyAnswerText(t *testing.T) {
+	assert.Equal(t, "Yes", surveyAnswerText(true))
+	assert.Equal(t, "No", surveyAnswerText(false))
+	assert.Equal(t, "AES-256, TLS 1.2", surveyAnswerText([]any{"AES-256", "", "TLS 1.2"}))
+	assert.Equal(t, "3", surveyAnswerText(float64(3)))
+	assert.Empty(t, surveyAnswerText(nil))
+	assert.Empty(t, surveyAnswerText(map[string]any{"row1": "col1"}))
+}
+
+func TestTruncateRunes(t *testing.T) {
+	assert.Equal(t, "short", truncateRunes("short", 10))
+	assert.Equal(t, "données…", truncateRunes("données chiffrées", 10))
+}
@@ -0,0 +1,75 @@
+package graphapi_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphapi/testclient"
+)
+
+func TestMutationSuggestQuestionnaireAnswersApprovedFAQsOnly(t *testing.T) {
+	t.Parallel()
+	tcOrg := createFreshOrgWithTrustCenter(t)
+	ctx := tcOrg.owner.UserCtx
+
+	approvedNote := (&NoteBuilder{client: suite.client}).MustNew(ctx, t)
+	draftNote := (&NoteBuilder{client: suite.client}).MustNew(ctx, t)
+
+	allowCtx := setContext(ctx, suite.client.db)
+
+	suite.client.db.Note.UpdateOneID(approvedNote.ID).
+		SetTitle("Do you encrypt customer data at rest?").
+		SetText("Yes, all customer data is encrypted at rest with AES-256").
+		ExecX(allowCtx)
+
+	suite.client.db.Note.UpdateOneID(draftNote.ID).
+		SetTitle("Do you encrypt customer data at rest with customer managed keys?").
+		SetText("Draft: not reviewed yet").
+		ExecX(allowCtx)
+
+	approved, err := suite.client.api.CreateTrustCenterFaq(ctx, testclient.CreateTrustCenterFAQInput{
+		NoteID:        approvedNote.ID,
+		TrustCenterID: &tcOrg.trustCenter.ID,
+		Approved:      lo.ToPtr(true),
+	})
+	assert.NilError(t, err)
+
+	// a FAQ that has not been approved is not used for answers, even when it is published on the trust center
+	draft, err := suite.client.api.CreateTrustCenterFaq(ctx, testclient.CreateTrustCenterFAQInput{
+		NoteID:        draftNote.ID,
+		TrustCenterID: &tcOrg.trustCenter.ID,
+	})
+	assert.NilError(t, err)
+
+	csv := "Question,Answer\nDo you encrypt customer data at rest?,\n"
+
+	resp, err := suite.client.api.SuggestQuestionnaireAnswers(ctx, graphql.Upload{
+		File:        strings.NewReader(csv),
+		Filename:    "vendor.csv",
+		Size:        int64(len(csv)),
+		ContentType: "text/csv",
+	}, lo.ToPtr(int64(5)))
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(resp.SuggestQuestionnaireAnswers.Questions, 1))
+
+	var sourceIDs []string
+
+	for _, suggestion := range resp.SuggestQuestionnaireAnswers.Questions[0].Suggestions {
+		for _, source := range suggestion.Sources {
+			if source.ObjectType == generated.TypeTrustCenterFAQ {
+				sourceIDs = append(sourceIDs, source.ID)
+			}
+		}
+	}
+
+	assert.Check(t, is.Contains(sourceIDs, approved.CreateTrustCenterFaq.TrustCenterFaq.ID))
+	assert.Check(t, !lo.Contains(sourceIDs, draft.CreateTrustCenterFaq.TrustCenterFaq.ID))
+
+	cleanupOrganizationDataWithContext(ctx, t)
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/theopenlane/core/internal/workflows"
 	"github.com/theopenlane/core/pkg/gala"
 	mwauth "github.com/theopenlane/core/pkg/middleware/auth"
+	"github.com/theopenlane/core/pkg/signedbundle"
 )
 
 // This file will not be regenerated automatically.
@@ -60,6 +61,8 @@ type trustCenterSettings struct {
 	trustCenterCnameTarget string
 	// defaultTrustCenterDomain is the default domain for trust center
 	defaultTrustCenterDomain string
+	// trustCenterExportSigner signs the manifests of trust center site exports
+	trustCenterExportSigner *signedbundle.Signer
 }
 
 // subscriptionSettings holds the settings for subscriptions
@@ -158,6 +161,9 @@ func (r *Resolver) Handler() *Handler {
 	// add context level caching
 	common.WithContextLevelCache(srv)
 
+	// serve query operations from the read replicas
+	common.WithReplicaReads(srv)
+
 	// add extensions if enabled
 	if r.extensionsEnabled {
 		common.AddAllExtensions(srv)
@@ -12,6 +12,7 @@ import (
 	"github.com/theopenlane/core/pkg/gala"
 	"github.com/theopenlane/core/pkg/mapx"
 	mwauth "github.com/theopenlane/core/pkg/middleware/auth"
+	"github.com/theopenlane/core/pkg/signedbundle"
 )
 
 // WithTrustCenterCnameTarget sets the trust center cname target for the resolver
@@ -28,6 +29,13 @@ func (r Resolver) WithTrustCenterDefaultDomain(domain string) *Resolver {
 	return &r
 }
 
+// WithTrustCenterExportSigner sets the signer used for trust center site exports
+func (r Resolver) WithTrustCenterExportSigner(signer *signedbundle.Signer) *Resolver {
+	r.trustCenterExportSigner = signer
+
+	return &r
+}
+
 // WithExtensions enables or disables graph extensions
 func (r Resolver) WithExtensions(enabled bool) *Resolver {
 	r.extensionsEnabled = enabled
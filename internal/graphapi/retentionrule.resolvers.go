@@ -0,0 +1,191 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/theopenlane/core/internal/ent/csvgenerated"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/utils/rout"
+)
+
+// CreateRetentionRule is the resolver for the createRetentionRule field.
+func (r *mutationResolver) CreateRetentionRule(ctx context.Context, input generated.CreateRetentionRuleInput) (*model.RetentionRuleCreatePayload, error) {
+	// set the organization in the auth context if its not done for us
+	ctx, err := common.SetOrganizationInAuthContext(ctx, input.OwnerID)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		return nil, rout.NewMissingRequiredFieldError("owner_id")
+	}
+
+	res, err := withTransactionalMutation(ctx).RetentionRule.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionCreate, Object: "retentionrule"})
+	}
+
+	return &model.RetentionRuleCreatePayload{
+		RetentionRule: res,
+	}, nil
+}
+
+// CreateBulkRetentionRule is the resolver for the createBulkRetentionRule field.
+func (r *mutationResolver) CreateBulkRetentionRule(ctx context.Context, input []*generated.CreateRetentionRuleInput) (*model.RetentionRuleBulkCreatePayload, error) {
+	if len(input) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	// set the organization in the auth context if its not done for us
+	// this will choose the first input OwnerID when using a personal access token
+	ctx, err := common.SetOrganizationInAuthContextBulkRequest(ctx, input)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		return nil, rout.NewMissingRequiredFieldError("owner_id")
+	}
+
+	return r.bulkCreateRetentionRule(ctx, input)
+}
+
+// CreateBulkCSVRetentionRule is the resolver for the createBulkCSVRetentionRule field.
+func (r *mutationResolver) CreateBulkCSVRetentionRule(ctx context.Context, input graphql.Upload) (*model.RetentionRuleBulkCreatePayload, error) {
+	data, err := common.UnmarshalBulkData[csvgenerated.RetentionRuleCSVInput](input)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to unmarshal bulk data")
+
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionCreate, Object: "retentionrule"})
+	}
+
+	if len(data) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	// set the organization in the auth context if its not done for us
+	// this will choose the first input OwnerID when using a personal access token
+	ctx, err = common.SetOrganizationInAuthContextBulkRequest(ctx, data)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		if _, ownerErr := common.GetBulkUploadOwnerInput(data); ownerErr != nil {
+			return nil, ownerErr
+		}
+
+		return nil, rout.ErrPermissionDenied
+	}
+
+	if err := resolveCSVReferencesForSchema(ctx, "RetentionRule", data); err != nil {
+		return nil, err
+	}
+
+	inputs := make([]*generated.CreateRetentionRuleInput, 0, len(data))
+	for i := range data {
+		inputs = append(inputs, &data[i].Input)
+	}
+
+	return r.bulkCreateRetentionRule(ctx, inputs)
+}
+
+// UpdateBulkRetentionRule is the resolver for the updateBulkRetentionRule field.
+func (r *mutationResolver) UpdateBulkRetentionRule(ctx context.Context, ids []string, input generated.UpdateRetentionRuleInput) (*model.RetentionRuleBulkUpdatePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	return r.bulkUpdateRetentionRule(ctx, ids, input)
+}
+
+// UpdateBulkCSVRetentionRule is the resolver for the updateBulkCSVRetentionRule field.
+func (r *mutationResolver) UpdateBulkCSVRetentionRule(ctx context.Context, input graphql.Upload) (*model.RetentionRuleBulkUpdatePayload, error) {
+	data, err := common.UnmarshalBulkData[csvgenerated.RetentionRuleCSVUpdateInput](input)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to unmarshal bulk data")
+
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "retentionrule"})
+	}
+
+	if len(data) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("input")
+	}
+
+	if err := resolveCSVReferencesForSchema(ctx, "RetentionRule", data); err != nil {
+		return nil, err
+	}
+
+	return r.bulkUpdateCSVRetentionRule(ctx, data)
+}
+
+// UpdateRetentionRule is the resolver for the updateRetentionRule field.
+func (r *mutationResolver) UpdateRetentionRule(ctx context.Context, id string, input generated.UpdateRetentionRuleInput) (*model.RetentionRuleUpdatePayload, error) {
+	res, err := withTransactionalMutation(ctx).RetentionRule.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "retentionrule"})
+	}
+
+	// set the organization in the auth context if its not done for us
+	ctx, err = common.SetOrganizationInAuthContext(ctx, &res.OwnerID)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed to set organization in auth context")
+
+		return nil, rout.ErrPermissionDenied
+	}
+
+	// setup update request
+	req := res.Update().SetInput(input)
+
+	res, err = req.Save(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionUpdate, Object: "retentionrule"})
+	}
+
+	return &model.RetentionRuleUpdatePayload{
+		RetentionRule: res,
+	}, nil
+}
+
+// DeleteRetentionRule is the resolver for the deleteRetentionRule field.
+func (r *mutationResolver) DeleteRetentionRule(ctx context.Context, id string) (*model.RetentionRuleDeletePayload, error) {
+	if err := withTransactionalMutation(ctx).RetentionRule.DeleteOneID(id).Exec(ctx); err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionDelete, Object: "retentionrule"})
+	}
+
+	if err := generated.RetentionRuleEdgeCleanup(ctx, id); err != nil {
+		return nil, common.NewCascadeDeleteError(ctx, err)
+	}
+
+	return &model.RetentionRuleDeletePayload{
+		DeletedID: id,
+	}, nil
+}
+
+// DeleteBulkRetentionRule is the resolver for the deleteBulkRetentionRule field.
+func (r *mutationResolver) DeleteBulkRetentionRule(ctx context.Context, ids []string) (*model.RetentionRuleBulkDeletePayload, error) {
+	if len(ids) == 0 {
+		return nil, rout.NewMissingRequiredFieldError("ids")
+	}
+
+	return r.bulkDeleteRetentionRule(ctx, ids)
+}
+
+// RetentionRule is the resolver for the retentionRule field.
+func (r *queryResolver) RetentionRule(ctx context.Context, id string) (*generated.RetentionRule, error) {
+	query, err := withTransactionalMutation(ctx).RetentionRule.Query().Where(retentionrule.ID(id)).CollectFields(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "retentionrule"})
+	}
+
+	res, err := query.Only(ctx)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "retentionrule"})
+	}
+
+	return res, nil
+}
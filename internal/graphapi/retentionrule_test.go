@@ -0,0 +1,193 @@
+package graphapi_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphapi/testclient"
+)
+
+func TestQueryRetentionRule(t *testing.T) {
+	rule := (&RetentionRuleBuilder{client: suite.client, Category: "evidence"}).MustNew(sharedTestUser1.UserCtx, t)
+
+	testCases := []struct {
+		name     string
+		queryID  string
+		client   *testclient.TestClient
+		ctx      context.Context
+		errorMsg string
+	}{
+		{
+			name:    "happy path",
+			queryID: rule.ID,
+			client:  suite.client.api,
+			ctx:     sharedTestUser1.UserCtx,
+		},
+		{
+			name:    "happy path, read only user",
+			queryID: rule.ID,
+			client:  suite.client.api,
+			ctx:     sharedViewOnlyUser.UserCtx,
+		},
+		{
+			name:     "retention rule not found, using user of another organization",
+			queryID:  rule.ID,
+			client:   suite.client.api,
+			ctx:      sharedTestUser2.UserCtx,
+			errorMsg: notFoundErrorMsg,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Get "+tc.name, func(t *testing.T) {
+			resp, err := tc.client.GetRetentionRuleByID(tc.ctx, tc.queryID)
+
+			if tc.errorMsg != "" {
+				assert.ErrorContains(t, err, tc.errorMsg)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, resp != nil)
+
+			assert.Check(t, is.Equal(rule.ID, resp.RetentionRule.ID))
+			assert.Check(t, is.Equal(rule.Name, resp.RetentionRule.Name))
+			assert.Check(t, is.Equal(int64(rule.RetentionDays), resp.RetentionRule.RetentionDays))
+		})
+	}
+
+	(&Cleanup[*generated.RetentionRuleDeleteOne]{client: suite.client.db.RetentionRule, ID: rule.ID}).MustDelete(sharedTestUser1.UserCtx, t)
+}
+
+func TestMutationCreateRetentionRule(t *testing.T) {
+	testCases := []struct {
+		name        string
+		request     testclient.CreateRetentionRuleInput
+		client      *testclient.TestClient
+		ctx         context.Context
+		expectedErr string
+	}{
+		{
+			name: "happy path",
+			request: testclient.CreateRetentionRuleInput{
+				Name:          "evidence-seven-years",
+				Category:      lo.ToPtr("evidence"),
+				ObjectType:    lo.ToPtr("control"),
+				RetentionDays: 2555,
+				Purge:         lo.ToPtr(true),
+			},
+			client: suite.client.api,
+			ctx:    sharedTestUser1.UserCtx,
+		},
+		{
+			name: "happy path, admin user",
+			request: testclient.CreateRetentionRuleInput{
+				Name:          "policy-one-year",
+				Category:      lo.ToPtr("policy"),
+				RetentionDays: 365,
+				OwnerID:       lo.ToPtr(sharedTestUser1.OrganizationID),
+			},
+			client: suite.client.api,
+			ctx:    sharedAdminUser.UserCtx,
+		},
+		{
+			name: "not authorized, read only user",
+			request: testclient.CreateRetentionRuleInput{
+				Name:          "read-only",
+				RetentionDays: 30,
+			},
+			client:      suite.client.api,
+			ctx:         sharedViewOnlyUser.UserCtx,
+			expectedErr: notAuthorizedErrorMsg,
+		},
+		{
+			name: "retention days must be positive",
+			request: testclient.CreateRetentionRuleInput{
+				Name:          "no-retention",
+				RetentionDays: 0,
+			},
+			client:      suite.client.api,
+			ctx:         sharedTestUser1.UserCtx,
+			expectedErr: "retention_days",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Create "+tc.name, func(t *testing.T) {
+			resp, err := tc.client.CreateRetentionRule(tc.ctx, tc.request)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, resp != nil)
+
+			created := resp.CreateRetentionRule.RetentionRule
+			assert.Check(t, is.Equal(tc.request.Name, created.Name))
+			assert.Check(t, is.Equal(tc.request.RetentionDays, created.RetentionDays))
+			assert.Check(t, is.Equal(sharedTestUser1.OrganizationID, *created.OwnerID))
+			assert.Check(t, is.Equal(lo.FromPtr(tc.request.Purge), created.Purge))
+
+			(&Cleanup[*generated.RetentionRuleDeleteOne]{client: suite.client.db.RetentionRule, ID: created.ID}).MustDelete(tc.ctx, t)
+		})
+	}
+}
+
+func TestMutationDeleteRetentionRule(t *testing.T) {
+	rule := (&RetentionRuleBuilder{client: suite.client}).MustNew(sharedTestUser1.UserCtx, t)
+	rule2 := (&RetentionRuleBuilder{client: suite.client}).MustNew(sharedTestUser1.UserCtx, t)
+
+	testCases := []struct {
+		name        string
+		id          string
+		client      *testclient.TestClient
+		ctx         context.Context
+		expectedErr string
+	}{
+		{
+			name:        "not authorized, read only user",
+			id:          rule.ID,
+			client:      suite.client.api,
+			ctx:         sharedViewOnlyUser.UserCtx,
+			expectedErr: notAuthorizedErrorMsg,
+		},
+		{
+			name:        "not found, another organization",
+			id:          rule2.ID,
+			client:      suite.client.api,
+			ctx:         sharedTestUser2.UserCtx,
+			expectedErr: notFoundErrorMsg,
+		},
+		{
+			name:   "happy path",
+			id:     rule.ID,
+			client: suite.client.api,
+			ctx:    sharedTestUser1.UserCtx,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run("Delete "+tc.name, func(t *testing.T) {
+			resp, err := tc.client.DeleteRetentionRule(tc.ctx, tc.id)
+			if tc.expectedErr != "" {
+				assert.ErrorContains(t, err, tc.expectedErr)
+
+				return
+			}
+
+			assert.NilError(t, err)
+			assert.Assert(t, resp != nil)
+			assert.Check(t, is.Equal(tc.id, resp.DeleteRetentionRule.DeletedID))
+		})
+	}
+
+	(&Cleanup[*generated.RetentionRuleDeleteOne]{client: suite.client.db.RetentionRule, ID: rule2.ID}).MustDelete(sharedTestUser1.UserCtx, t)
+}
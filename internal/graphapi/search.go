@@ -9,6 +9,7 @@ import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/theopenlane/core/internal/ent/fulltext"
 	"github.com/theopenlane/core/internal/ent/generated"
 	"github.com/theopenlane/core/internal/ent/generated/actionplan"
 	"github.com/theopenlane/core/internal/ent/generated/assessment"
@@ -68,13 +69,8 @@ func searchActionPlans(ctx context.Context, query string, after *entgql.Cursor[s
 	request := withTransactionalMutation(ctx).ActionPlan.Query().
 		Where(
 			actionplan.Or(
-				actionplan.DetailsContainsFold(query), // search by Details
-				actionplan.ID(query),                  // search equal to ID
-				actionplan.NameContainsFold(query),    // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				actionplan.ID(query),  // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -163,11 +159,7 @@ func searchAssessments(ctx context.Context, query string, after *entgql.Cursor[s
 						sqljson.StringContains(assessment.FieldJsonconfig, query, sqljson.Path("$id")), // search by Jsonconfig at $id
 					)
 				},
-				assessment.NameContainsFold(query), // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -210,9 +202,8 @@ func searchAssessmentResponses(ctx context.Context, query string, after *entgql.
 	request := withTransactionalMutation(ctx).AssessmentResponse.Query().
 		Where(
 			assessmentresponse.Or(
-				assessmentresponse.DisplayNameContainsFold(query), // search by DisplayName
-				assessmentresponse.EmailContainsFold(query),       // search by Email
-				assessmentresponse.ID(query),                      // search equal to ID
+				assessmentresponse.ID(query), // search equal to ID
+				fulltext.Match(query),        // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -248,14 +239,8 @@ func searchAssets(ctx context.Context, query string, after *entgql.Cursor[string
 	request := withTransactionalMutation(ctx).Asset.Query().
 		Where(
 			asset.Or(
-				asset.DisplayNameContainsFold(query),   // search by DisplayName
-				asset.ID(query),                        // search equal to ID
-				asset.InternalOwnerContainsFold(query), // search by InternalOwner
-				asset.NameContainsFold(query),          // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				asset.ID(query),       // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -321,14 +306,9 @@ func searchCampaigns(ctx context.Context, query string, after *entgql.Cursor[str
 	request := withTransactionalMutation(ctx).Campaign.Query().
 		Where(
 			campaign.Or(
-				campaign.DisplayID(query),                 // search equal to DisplayID
-				campaign.ID(query),                        // search equal to ID
-				campaign.InternalOwnerContainsFold(query), // search by InternalOwner
-				campaign.NameContainsFold(query),          // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				campaign.DisplayID(query), // search equal to DisplayID
+				campaign.ID(query),        // search equal to ID
+				fulltext.Match(query),     // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -376,8 +356,8 @@ func searchCampaignTargets(ctx context.Context, query string, after *entgql.Curs
 	request := withTransactionalMutation(ctx).CampaignTarget.Query().
 		Where(
 			campaigntarget.Or(
-				campaigntarget.EmailContainsFold(query), // search by Email
-				campaigntarget.ID(query),                // search equal to ID
+				campaigntarget.ID(query), // search equal to ID
+				fulltext.Match(query),    // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -414,13 +394,8 @@ func searchContacts(ctx context.Context, query string, after *entgql.Cursor[stri
 	request := withTransactionalMutation(ctx).Contact.Query().
 		Where(
 			contact.Or(
-				contact.EmailContainsFold(query),    // search by Email
-				contact.FullNameContainsFold(query), // search by FullName
-				contact.ID(query),                   // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				contact.ID(query),     // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -458,26 +433,9 @@ func searchControls(ctx context.Context, query string, after *entgql.Cursor[stri
 	request := withTransactionalMutation(ctx).Control.Query().
 		Where(
 			control.Or(
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(aliases)::text LIKE $1", likeQuery)) // search by Aliases
-				},
-				control.CategoryContainsFold(query),    // search by Category
-				control.DescriptionContainsFold(query), // search by Description
-				control.DisplayID(query),               // search equal to DisplayID
-				control.ID(query),                      // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(mapped_categories)::text LIKE $6", likeQuery)) // search by MappedCategories
-				},
-				control.PublicRepresentationContainsFold(query), // search by PublicRepresentation
-				control.RefCodeContainsFold(query),              // search by RefCode
-				control.SubcategoryContainsFold(query),          // search by Subcategory
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $10", likeQuery)) // search by Tags
-				},
-				control.TitleContainsFold(query), // search by Title
+				control.DisplayID(query), // search equal to DisplayID
+				control.ID(query),        // search equal to ID
+				fulltext.Match(query),    // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -578,15 +536,9 @@ func searchControlObjectives(ctx context.Context, query string, after *entgql.Cu
 	request := withTransactionalMutation(ctx).ControlObjective.Query().
 		Where(
 			controlobjective.Or(
-				controlobjective.CategoryContainsFold(query),    // search by Category
-				controlobjective.DisplayID(query),               // search equal to DisplayID
-				controlobjective.ID(query),                      // search equal to ID
-				controlobjective.NameContainsFold(query),        // search by Name
-				controlobjective.SubcategoryContainsFold(query), // search by Subcategory
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $6", likeQuery)) // search by Tags
-				},
+				controlobjective.DisplayID(query), // search equal to DisplayID
+				controlobjective.ID(query),        // search equal to ID
+				fulltext.Match(query),             // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -629,9 +581,8 @@ func searchCustomTypeEnums(ctx context.Context, query string, after *entgql.Curs
 	request := withTransactionalMutation(ctx).CustomTypeEnum.Query().
 		Where(
 			customtypeenum.Or(
-				customtypeenum.ID(query),                     // search equal to ID
-				customtypeenum.NameContainsFold(query),       // search by Name
-				customtypeenum.ObjectTypeContainsFold(query), // search by ObjectType
+				customtypeenum.ID(query), // search equal to ID
+				fulltext.Match(query),    // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -665,9 +616,8 @@ func searchEmailTemplates(ctx context.Context, query string, after *entgql.Curso
 	request := withTransactionalMutation(ctx).EmailTemplate.Query().
 		Where(
 			emailtemplate.Or(
-				emailtemplate.ID(query),               // search equal to ID
-				emailtemplate.KeyContainsFold(query),  // search by Key
-				emailtemplate.NameContainsFold(query), // search by Name
+				emailtemplate.ID(query), // search equal to ID
+				fulltext.Match(query),   // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -704,19 +654,8 @@ func searchEntities(ctx context.Context, query string, after *entgql.Cursor[stri
 	request := withTransactionalMutation(ctx).Entity.Query().
 		Where(
 			entity.Or(
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(aliases)::text LIKE $1", likeQuery)) // search by Aliases
-				},
-				entity.DescriptionContainsFold(query),   // search by Description
-				entity.DisplayNameContainsFold(query),   // search by DisplayName
-				entity.ID(query),                        // search equal to ID
-				entity.InternalOwnerContainsFold(query), // search by InternalOwner
-				entity.NameContainsFold(query),          // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $7", likeQuery)) // search by Tags
-				},
+				entity.ID(query),      // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -800,13 +739,9 @@ func searchEvidences(ctx context.Context, query string, after *entgql.Cursor[str
 	request := withTransactionalMutation(ctx).Evidence.Query().
 		Where(
 			evidence.Or(
-				evidence.DisplayID(query),        // search equal to DisplayID
-				evidence.ID(query),               // search equal to ID
-				evidence.NameContainsFold(query), // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				evidence.DisplayID(query), // search equal to DisplayID
+				evidence.ID(query),        // search equal to ID
+				fulltext.Match(query),     // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -847,15 +782,9 @@ func searchFindings(ctx context.Context, query string, after *entgql.Cursor[stri
 	request := withTransactionalMutation(ctx).Finding.Query().
 		Where(
 			finding.Or(
-				finding.DisplayID(query),                   // search equal to DisplayID
-				finding.ExternalIDContainsFold(query),      // search by ExternalID
-				finding.ExternalOwnerIDContainsFold(query), // search by ExternalOwnerID
-				finding.ID(query),                          // search equal to ID
-				finding.SeverityContainsFold(query),        // search by Severity
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $6", likeQuery)) // search by Tags
-				},
+				finding.DisplayID(query), // search equal to DisplayID
+				finding.ID(query),        // search equal to ID
+				fulltext.Match(query),    // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -944,14 +873,9 @@ func searchGroups(ctx context.Context, query string, after *entgql.Cursor[string
 	request := withTransactionalMutation(ctx).Group.Query().
 		Where(
 			group.Or(
-				group.DisplayID(query),               // search equal to DisplayID
-				group.DisplayNameContainsFold(query), // search by DisplayName
-				group.ID(query),                      // search equal to ID
-				group.NameContainsFold(query),        // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				group.DisplayID(query), // search equal to DisplayID
+				group.ID(query),        // search equal to ID
+				fulltext.Match(query),  // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -994,15 +918,9 @@ func searchIdentityHolders(ctx context.Context, query string, after *entgql.Curs
 	request := withTransactionalMutation(ctx).IdentityHolder.Query().
 		Where(
 			identityholder.Or(
-				identityholder.DisplayID(query),                 // search equal to DisplayID
-				identityholder.EmailContainsFold(query),         // search by Email
-				identityholder.FullNameContainsFold(query),      // search by FullName
-				identityholder.ID(query),                        // search equal to ID
-				identityholder.InternalOwnerContainsFold(query), // search by InternalOwner
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $6", likeQuery)) // search by Tags
-				},
+				identityholder.DisplayID(query), // search equal to DisplayID
+				identityholder.ID(query),        // search equal to ID
+				fulltext.Match(query),           // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1061,15 +979,8 @@ func searchIntegrations(ctx context.Context, query string, after *entgql.Cursor[
 	request := withTransactionalMutation(ctx).Integration.Query().
 		Where(
 			integration.Or(
-				integration.DefinitionIDContainsFold(query),   // search by DefinitionID
-				integration.DefinitionSlugContainsFold(query), // search by DefinitionSlug
-				integration.ID(query),                         // search equal to ID
-				integration.KindContainsFold(query),           // search by Kind
-				integration.NameContainsFold(query),           // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $6", likeQuery)) // search by Tags
-				},
+				integration.ID(query), // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1113,14 +1024,9 @@ func searchInternalPolicies(ctx context.Context, query string, after *entgql.Cur
 	request := withTransactionalMutation(ctx).InternalPolicy.Query().
 		Where(
 			internalpolicy.Or(
-				internalpolicy.DetailsContainsFold(query), // search by Details
-				internalpolicy.DisplayID(query),           // search equal to DisplayID
-				internalpolicy.ID(query),                  // search equal to ID
-				internalpolicy.NameContainsFold(query),    // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				internalpolicy.DisplayID(query), // search equal to DisplayID
+				internalpolicy.ID(query),        // search equal to ID
+				fulltext.Match(query),           // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1197,8 +1103,8 @@ func searchInvites(ctx context.Context, query string, after *entgql.Cursor[strin
 	request := withTransactionalMutation(ctx).Invite.Query().
 		Where(
 			invite.Or(
-				invite.ID(query),                    // search equal to ID
-				invite.RecipientContainsFold(query), // search by Recipient
+				invite.ID(query),      // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1226,13 +1132,9 @@ func searchJobRunners(ctx context.Context, query string, after *entgql.Cursor[st
 	request := withTransactionalMutation(ctx).JobRunner.Query().
 		Where(
 			jobrunner.Or(
-				jobrunner.DisplayID(query),        // search equal to DisplayID
-				jobrunner.ID(query),               // search equal to ID
-				jobrunner.NameContainsFold(query), // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				jobrunner.DisplayID(query), // search equal to DisplayID
+				jobrunner.ID(query),        // search equal to ID
+				fulltext.Match(query),      // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1269,14 +1171,9 @@ func searchJobTemplates(ctx context.Context, query string, after *entgql.Cursor[
 	request := withTransactionalMutation(ctx).JobTemplate.Query().
 		Where(
 			jobtemplate.Or(
-				jobtemplate.DescriptionContainsFold(query), // search by Description
-				jobtemplate.DisplayID(query),               // search equal to DisplayID
-				jobtemplate.ID(query),                      // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
-				jobtemplate.TitleContainsFold(query), // search by Title
+				jobtemplate.DisplayID(query), // search equal to DisplayID
+				jobtemplate.ID(query),        // search equal to ID
+				fulltext.Match(query),        // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1311,14 +1208,9 @@ func searchNarratives(ctx context.Context, query string, after *entgql.Cursor[st
 	request := withTransactionalMutation(ctx).Narrative.Query().
 		Where(
 			narrative.Or(
-				narrative.DescriptionContainsFold(query), // search by Description
-				narrative.DisplayID(query),               // search equal to DisplayID
-				narrative.ID(query),                      // search equal to ID
-				narrative.NameContainsFold(query),        // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				narrative.DisplayID(query), // search equal to DisplayID
+				narrative.ID(query),        // search equal to ID
+				fulltext.Match(query),      // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1354,9 +1246,8 @@ func searchNotificationTemplates(ctx context.Context, query string, after *entgq
 	request := withTransactionalMutation(ctx).NotificationTemplate.Query().
 		Where(
 			notificationtemplate.Or(
-				notificationtemplate.ID(query),               // search equal to ID
-				notificationtemplate.KeyContainsFold(query),  // search by Key
-				notificationtemplate.NameContainsFold(query), // search by Name
+				notificationtemplate.ID(query), // search equal to ID
+				fulltext.Match(query),          // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1396,13 +1287,8 @@ func searchOrganizations(ctx context.Context, query string, after *entgql.Cursor
 	request := withTransactionalMutation(ctx).Organization.Query().
 		Where(
 			organization.Or(
-				organization.DisplayNameContainsFold(query), // search by DisplayName
-				organization.ID(query),                      // search equal to ID
-				organization.NameContainsFold(query),        // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				organization.ID(query), // search equal to ID
+				fulltext.Match(query),  // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1436,14 +1322,9 @@ func searchPlatforms(ctx context.Context, query string, after *entgql.Cursor[str
 	request := withTransactionalMutation(ctx).Platform.Query().
 		Where(
 			platform.Or(
-				platform.DisplayID(query),                 // search equal to DisplayID
-				platform.ID(query),                        // search equal to ID
-				platform.InternalOwnerContainsFold(query), // search by InternalOwner
-				platform.NameContainsFold(query),          // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				platform.DisplayID(query), // search equal to DisplayID
+				platform.ID(query),        // search equal to ID
+				fulltext.Match(query),     // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1516,14 +1397,9 @@ func searchProcedures(ctx context.Context, query string, after *entgql.Cursor[st
 	request := withTransactionalMutation(ctx).Procedure.Query().
 		Where(
 			procedure.Or(
-				procedure.DetailsContainsFold(query), // search by Details
-				procedure.DisplayID(query),           // search equal to DisplayID
-				procedure.ID(query),                  // search equal to ID
-				procedure.NameContainsFold(query),    // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				procedure.DisplayID(query), // search equal to DisplayID
+				procedure.ID(query),        // search equal to ID
+				fulltext.Match(query),      // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1599,14 +1475,9 @@ func searchPrograms(ctx context.Context, query string, after *entgql.Cursor[stri
 	request := withTransactionalMutation(ctx).Program.Query().
 		Where(
 			program.Or(
-				program.DescriptionContainsFold(query), // search by Description
-				program.DisplayID(query),               // search equal to DisplayID
-				program.ID(query),                      // search equal to ID
-				program.NameContainsFold(query),        // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
+				program.DisplayID(query), // search equal to DisplayID
+				program.ID(query),        // search equal to ID
+				fulltext.Match(query),    // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1647,15 +1518,9 @@ func searchRemediations(ctx context.Context, query string, after *entgql.Cursor[
 	request := withTransactionalMutation(ctx).Remediation.Query().
 		Where(
 			remediation.Or(
-				remediation.DisplayID(query),                   // search equal to DisplayID
-				remediation.ExternalIDContainsFold(query),      // search by ExternalID
-				remediation.ExternalOwnerIDContainsFold(query), // search by ExternalOwnerID
-				remediation.ID(query),                          // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $5", likeQuery)) // search by Tags
-				},
-				remediation.TitleContainsFold(query), // search by Title
+				remediation.DisplayID(query), // search equal to DisplayID
+				remediation.ID(query),        // search equal to ID
+				fulltext.Match(query),        // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1711,14 +1576,8 @@ func searchReviews(ctx context.Context, query string, after *entgql.Cursor[strin
 	request := withTransactionalMutation(ctx).Review.Query().
 		Where(
 			review.Or(
-				review.ExternalIDContainsFold(query),      // search by ExternalID
-				review.ExternalOwnerIDContainsFold(query), // search by ExternalOwnerID
-				review.ID(query),                          // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
-				review.TitleContainsFold(query), // search by Title
+				review.ID(query),      // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1774,13 +1633,9 @@ func searchRisks(ctx context.Context, query string, after *entgql.Cursor[string]
 	request := withTransactionalMutation(ctx).Risk.Query().
 		Where(
 			risk.Or(
-				risk.DisplayID(query),        // search equal to DisplayID
-				risk.ID(query),               // search equal to ID
-				risk.NameContainsFold(query), // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				risk.DisplayID(query), // search equal to DisplayID
+				risk.ID(query),        // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1840,12 +1695,8 @@ func searchScans(ctx context.Context, query string, after *entgql.Cursor[string]
 	request := withTransactionalMutation(ctx).Scan.Query().
 		Where(
 			scan.Or(
-				scan.ID(query), // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $2", likeQuery)) // search by Tags
-				},
-				scan.TargetContainsFold(query), // search by Target
+				scan.ID(query),        // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1900,19 +1751,8 @@ func searchStandards(ctx context.Context, query string, after *entgql.Cursor[str
 	request := withTransactionalMutation(ctx).Standard.Query().
 		Where(
 			standard.Or(
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(domains)::text LIKE $1", likeQuery)) // search by Domains
-				},
-				standard.FrameworkContainsFold(query),     // search by Framework
-				standard.GoverningBodyContainsFold(query), // search by GoverningBody
-				standard.ID(query),                        // search equal to ID
-				standard.NameContainsFold(query),          // search by Name
-				standard.ShortNameContainsFold(query),     // search by ShortName
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $7", likeQuery)) // search by Tags
-				},
+				standard.ID(query),    // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -1959,26 +1799,9 @@ func searchSubcontrols(ctx context.Context, query string, after *entgql.Cursor[s
 	request := withTransactionalMutation(ctx).Subcontrol.Query().
 		Where(
 			subcontrol.Or(
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(aliases)::text LIKE $1", likeQuery)) // search by Aliases
-				},
-				subcontrol.CategoryContainsFold(query),    // search by Category
-				subcontrol.DescriptionContainsFold(query), // search by Description
-				subcontrol.DisplayID(query),               // search equal to DisplayID
-				subcontrol.ID(query),                      // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(mapped_categories)::text LIKE $6", likeQuery)) // search by MappedCategories
-				},
-				subcontrol.PublicRepresentationContainsFold(query), // search by PublicRepresentation
-				subcontrol.RefCodeContainsFold(query),              // search by RefCode
-				subcontrol.SubcategoryContainsFold(query),          // search by Subcategory
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $10", likeQuery)) // search by Tags
-				},
-				subcontrol.TitleContainsFold(query), // search by Title
+				subcontrol.DisplayID(query), // search equal to DisplayID
+				subcontrol.ID(query),        // search equal to ID
+				fulltext.Match(query),       // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2075,12 +1898,8 @@ func searchSubprocessors(ctx context.Context, query string, after *entgql.Cursor
 	request := withTransactionalMutation(ctx).Subprocessor.Query().
 		Where(
 			subprocessor.Or(
-				subprocessor.ID(query),               // search equal to ID
-				subprocessor.NameContainsFold(query), // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $3", likeQuery)) // search by Tags
-				},
+				subprocessor.ID(query), // search equal to ID
+				fulltext.Match(query),  // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2116,12 +1935,8 @@ func searchSubscribers(ctx context.Context, query string, after *entgql.Cursor[s
 	request := withTransactionalMutation(ctx).Subscriber.Query().
 		Where(
 			subscriber.Or(
-				subscriber.EmailContainsFold(query), // search by Email
-				subscriber.ID(query),                // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $3", likeQuery)) // search by Tags
-				},
+				subscriber.ID(query),  // search equal to ID
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2156,13 +1971,9 @@ func searchSystemDetails(ctx context.Context, query string, after *entgql.Cursor
 	request := withTransactionalMutation(ctx).SystemDetail.Query().
 		Where(
 			systemdetail.Or(
-				systemdetail.DisplayID(query),              // search equal to DisplayID
-				systemdetail.ID(query),                     // search equal to ID
-				systemdetail.SystemNameContainsFold(query), // search by SystemName
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				systemdetail.DisplayID(query), // search equal to DisplayID
+				systemdetail.ID(query),        // search equal to ID
+				fulltext.Match(query),         // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2205,13 +2016,8 @@ func searchTagDefinitions(ctx context.Context, query string, after *entgql.Curso
 	request := withTransactionalMutation(ctx).TagDefinition.Query().
 		Where(
 			tagdefinition.Or(
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(aliases)::text LIKE $1", likeQuery)) // search by Aliases
-				},
-				tagdefinition.ID(query),               // search equal to ID
-				tagdefinition.NameContainsFold(query), // search by Name
-				tagdefinition.SlugContainsFold(query), // search by Slug
+				tagdefinition.ID(query), // search equal to ID
+				fulltext.Match(query),   // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2249,11 +2055,7 @@ func searchTasks(ctx context.Context, query string, after *entgql.Cursor[string]
 			task.Or(
 				task.DisplayID(query), // search equal to DisplayID
 				task.ID(query),        // search equal to ID
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $3", likeQuery)) // search by Tags
-				},
-				task.TitleContainsFold(query), // search by Title
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2317,11 +2119,7 @@ func searchTemplates(ctx context.Context, query string, after *entgql.Cursor[str
 						sqljson.StringContains(template.FieldJsonconfig, query, sqljson.Path("$id")), // search by Jsonconfig at $id
 					)
 				},
-				template.NameContainsFold(query), // search by Name
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $4", likeQuery)) // search by Tags
-				},
+				fulltext.Match(query), // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2373,9 +2171,8 @@ func searchTrustCenterEntities(ctx context.Context, query string, after *entgql.
 	request := withTransactionalMutation(ctx).TrustCenterEntity.Query().
 		Where(
 			trustcenterentity.Or(
-				trustcenterentity.ID(query),               // search equal to ID
-				trustcenterentity.NameContainsFold(query), // search by Name
-				trustcenterentity.URLContainsFold(query),  // search by URL
+				trustcenterentity.ID(query), // search equal to ID
+				fulltext.Match(query),       // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -2404,18 +2201,9 @@ func searchVulnerabilities(ctx context.Context, query string, after *entgql.Curs
 	request := withTransactionalMutation(ctx).Vulnerability.Query().
 		Where(
 			vulnerability.Or(
-				vulnerability.CveIDContainsFold(query),           // search by CveID
-				vulnerability.DismissedReasonContainsFold(query), // search by DismissedReason
-				vulnerability.DisplayID(query),                   // search equal to DisplayID
-				vulnerability.ExternalIDContainsFold(query),      // search by ExternalID
-				vulnerability.ExternalOwnerIDContainsFold(query), // search by ExternalOwnerID
-				vulnerability.ID(query),                          // search equal to ID
-				vulnerability.PackageNameContainsFold(query),     // search by PackageName
-				vulnerability.SeverityContainsFold(query),        // search by Severity
-				func(s *sql.Selector) {
-					likeQuery := "%" + query + "%"
-					s.Where(sql.ExprP("(tags)::text LIKE $9", likeQuery)) // search by Tags
-				},
+				vulnerability.DisplayID(query), // search equal to DisplayID
+				vulnerability.ID(query),        // search equal to ID
+				fulltext.Match(query),          // search by the full-text index of the searchable fields
 			),
 		)
 
@@ -3,7 +3,6 @@ package graphapi
 import (
 	"bytes"
 	"context"
-	"reflect"
 	"strings"
 	"sync"
 
@@ -14,14 +13,12 @@ import (
 	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
 
 	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/ent/fulltext"
 	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/pkg/logx"
 	"github.com/theopenlane/core/pkg/mapx"
 )
 
-const (
-	maxSnippetLength = 100
-)
-
 var snippetMarkdown = goldmark.New(
 	goldmark.WithExtensions(extension.Table),
 	goldmark.WithParserOptions(goldmarkparser.WithAutoHeadingID()),
@@ -43,96 +40,35 @@ func newContextTracker(query string) *searchCtxTracker {
 	}
 }
 
-func (t *searchCtxTracker) addMatch(entityID, entityType string, fieldMatches []string, entity any) {
+// addSnippets records the fields of the entity that matched the query along with their snippets
+func (t *searchCtxTracker) addSnippets(entityID, entityType string, snippets []fulltext.Snippet) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
 	entry := mapx.GetOrInit(t.contexts, entityID, func() *models.SearchContext {
 		return &models.SearchContext{
-			EntityID:      entityID,
-			EntityType:    entityType,
-			MatchedFields: fieldMatches,
+			EntityID:   entityID,
+			EntityType: entityType,
 		}
 	})
-	entry.Snippets = append(entry.Snippets, t.extractSnippets(entity, fieldMatches)...)
-}
-
-func (t *searchCtxTracker) extractSnippets(entity any, matchedFields []string) []*models.SearchSnippet {
-	snippets := make([]*models.SearchSnippet, 0)
-
-	if entity == nil {
-		return snippets
-	}
-
-	val := reflect.ValueOf(entity)
-	if val.Kind() == reflect.Pointer {
-		if val.IsNil() {
-			return snippets
-		}
-
-		val = val.Elem()
-	}
-
-	if val.Kind() != reflect.Struct {
-		return snippets
-	}
-
-	queryLower := strings.ToLower(t.query)
-
-	for _, fieldName := range matchedFields {
 
-		field := val.FieldByNameFunc(func(n string) bool {
-			return strings.EqualFold(n, fieldName)
-		})
+	for _, snippet := range snippets {
+		entry.MatchedFields = append(entry.MatchedFields, snippet.Field)
 
-		if !field.IsValid() {
-			continue
-		}
-
-		text := sanitizeContent(retrieveFieldValue(field))
+		text := sanitizeContent(snippet.Text)
 		if text == "" {
 			continue
 		}
 
-		snippet := t.createSnippet(fieldName, text, queryLower)
-		if snippet == nil {
-			continue
-		}
-
-		snippets = append(snippets, snippet)
-	}
-
-	return snippets
-}
-
-func retrieveFieldValue(field reflect.Value) string {
-	switch field.Kind() {
-	case reflect.String:
-		return field.String()
-
-	case reflect.Slice:
-		// tags, mapped categories and aliases are []string
-		if field.Type().Elem().Kind() != reflect.String {
-			return ""
-		}
-
-		val := make([]string, 0, field.Len())
-		for i := 0; i < field.Len(); i++ {
-			val = append(val, field.Index(i).String())
-		}
-
-		return strings.Join(val, ", ")
-
-	case reflect.Pointer:
-		// handle nullable string values
-		if !field.IsNil() && field.Elem().Kind() == reflect.String {
-			return field.Elem().String()
-		}
+		entry.Snippets = append(entry.Snippets, &models.SearchSnippet{
+			Field: snippet.Field,
+			Text:  text,
+		})
 	}
-
-	return ""
 }
 
+// sanitizeContent converts markdown and html content to plain text, which also removes the
+// highlight tags added by ts_headline
 func sanitizeContent(text string) string {
 	text = strings.TrimSpace(text)
 	if text == "" {
@@ -149,47 +85,6 @@ func sanitizeContent(text string) string {
 	return strings.Join(strings.Fields(sanitized), " ")
 }
 
-// createSnippet creates a snippet with highlighted match to improve the surrounding context
-// needed
-func (t *searchCtxTracker) createSnippet(fieldName, text, queryLower string) *models.SearchSnippet {
-	textLower := strings.ToLower(text)
-	idx := strings.Index(textLower, queryLower)
-
-	if idx == -1 {
-		if len(text) > maxSnippetLength {
-			return &models.SearchSnippet{
-				Field: fieldName,
-				Text:  text[:maxSnippetLength] + "...", // if too long, add ...
-			}
-		}
-
-		return &models.SearchSnippet{
-			Field: fieldName,
-			Text:  text,
-		}
-	}
-
-	contextSize := 50
-	start := max(0, idx-contextSize)
-
-	end := min(len(text), idx+len(t.query)+contextSize)
-
-	snippet := text[start:end]
-
-	if start > 0 {
-		snippet = "..." + snippet
-	}
-
-	if end < len(text) {
-		snippet += "..."
-	}
-
-	return &models.SearchSnippet{
-		Field: fieldName,
-		Text:  snippet,
-	}
-}
-
 func (t *searchCtxTracker) getContexts() []*models.SearchContext {
 	t.mu.Lock()
 	defer t.mu.Unlock()
@@ -201,336 +96,364 @@ func (t *searchCtxTracker) getContexts() []*models.SearchContext {
 	return contexts
 }
 
-type fieldMatchChecker struct {
-	query string
-}
-
-// check checks which of the given fields match the query for the entity
-func (c *fieldMatchChecker) check(entity any, fieldNames []string) []string {
-	matches := make([]string, 0)
-
-	if entity == nil {
-		return matches
-	}
-
-	val := reflect.ValueOf(entity)
-	if val.Kind() == reflect.Pointer {
-		if val.IsNil() {
-			return matches
-		}
-		val = val.Elem()
+// highlightSearchContext looks up the fields of the search results that matched the query, with snippets built
+// by ts_headline from the full-text index; results that only matched on an id have no search context
+func highlightSearchContext(ctx context.Context, query string, results any, tracker *searchCtxTracker) {
+	if results == nil || tracker == nil {
+		return
 	}
 
-	if val.Kind() != reflect.Struct {
-		return matches
+	entityType, ids := searchResultIDs(results)
+	if len(ids) == 0 {
+		return
 	}
 
-	queryLower := strings.ToLower(c.query)
-
-	for _, fieldName := range fieldNames {
-		field := val.FieldByNameFunc(func(n string) bool {
-			return strings.EqualFold(n, fieldName)
-		})
+	snippets, err := fulltext.Headlines(ctx, withTransactionalMutation(ctx), entityType, query, ids)
+	if err != nil {
+		logx.FromContext(ctx).Warn().Err(err).Str("entity_type", entityType).Msg("unable to build search context")
 
-		if !field.IsValid() {
-			continue
-		}
-
-		matched := false
-		text := sanitizeContent(retrieveFieldValue(field))
-		if text != "" {
-			matched = strings.Contains(strings.ToLower(text), queryLower)
-		}
-
-		if matched {
-			matches = append(matches, fieldName)
-		}
+		return
 	}
 
-	// If the ID matches exactly, add it
-	idField := val.FieldByName("ID")
-	if idField.IsValid() && idField.Kind() == reflect.String {
-		if idField.String() == c.query {
-			matches = append(matches, "ID")
+	for _, id := range ids {
+		if matched := snippets[id]; len(matched) > 0 {
+			tracker.addSnippets(id, entityType, matched)
 		}
 	}
-
-	return matches
 }
 
-// highlightSearchContext processes search results using type switches for better type safety
-// This is a non-magical alternative to processSearchResults that explicitly handles each type
-func highlightSearchContext(_ context.Context, query string, results any, tracker *searchCtxTracker) {
-	if results == nil || tracker == nil {
-		return
-	}
-
-	checker := fieldMatchChecker{query: query}
-
+// searchResultIDs returns the entity type and ids of the nodes in a search result connection
+func searchResultIDs(results any) (entityType string, ids []string) {
 	switch conn := results.(type) {
 	case *generated.ActionPlanConnection:
+		entityType = generated.TypeActionPlan
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
+			}
+		}
+	case *generated.AssessmentConnection:
+		entityType = generated.TypeAssessment
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Details", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "ActionPlan", matchedFields, node)
+		}
+	case *generated.AssessmentResponseConnection:
+		entityType = generated.TypeAssessmentResponse
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.AssetConnection:
+		entityType = generated.TypeAsset
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
+			}
+		}
+	case *generated.CampaignConnection:
+		entityType = generated.TypeCampaign
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Asset", matchedFields, node)
+		}
+	case *generated.CampaignTargetConnection:
+		entityType = generated.TypeCampaignTarget
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.ContactConnection:
+		entityType = generated.TypeContact
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Email", "FullName", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Contact", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.ControlConnection:
+		entityType = generated.TypeControl
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
+			}
+		}
+	case *generated.ControlObjectiveConnection:
+		entityType = generated.TypeControlObjective
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Aliases", "Category", "Description", "DisplayID", "MappedCategories", "RefCode", "Subcategory", "Tags", "Title"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Control", matchedFields, node)
+		}
+	case *generated.CustomTypeEnumConnection:
+		entityType = generated.TypeCustomTypeEnum
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
-	case *generated.EntityConnection:
+	case *generated.EmailTemplateConnection:
+		entityType = generated.TypeEmailTemplate
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Description", "DisplayName", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Entity", matchedFields, node)
+		}
+	case *generated.EntityConnection:
+		entityType = generated.TypeEntity
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.EvidenceConnection:
+		entityType = generated.TypeEvidence
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"DisplayID", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Evidence", matchedFields, node)
+		}
+	case *generated.FindingConnection:
+		entityType = generated.TypeFinding
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.GroupConnection:
+		entityType = generated.TypeGroup
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"DisplayID", "DisplayName", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Group", matchedFields, node)
+		}
+	case *generated.IdentityHolderConnection:
+		entityType = generated.TypeIdentityHolder
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
-	case *generated.InternalPolicyConnection:
+	case *generated.IntegrationConnection:
+		entityType = generated.TypeIntegration
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Details", "DisplayID", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "InternalPolicy", matchedFields, node)
+		}
+	case *generated.InternalPolicyConnection:
+		entityType = generated.TypeInternalPolicy
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.InviteConnection:
+		entityType = generated.TypeInvite
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Recipient"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Invite", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.JobRunnerConnection:
+		entityType = generated.TypeJobRunner
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"DisplayID", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "JobRunner", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.JobTemplateConnection:
+		entityType = generated.TypeJobTemplate
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Description", "DisplayID", "Tags", "Title"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "JobTemplate", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.NarrativeConnection:
+		entityType = generated.TypeNarrative
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Description", "DisplayID", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Narrative", matchedFields, node)
+		}
+	case *generated.NotificationTemplateConnection:
+		entityType = generated.TypeNotificationTemplate
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.OrganizationConnection:
+		entityType = generated.TypeOrganization
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"DisplayName", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Organization", matchedFields, node)
+		}
+	case *generated.PlatformConnection:
+		entityType = generated.TypePlatform
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.ProcedureConnection:
+		entityType = generated.TypeProcedure
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Details", "DisplayID", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Procedure", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.ProgramConnection:
+		entityType = generated.TypeProgram
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
+			}
+		}
+	case *generated.RemediationConnection:
+		entityType = generated.TypeRemediation
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Description", "DisplayID", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Program", matchedFields, node)
+		}
+	case *generated.ReviewConnection:
+		entityType = generated.TypeReview
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.RiskConnection:
+		entityType = generated.TypeRisk
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"DisplayID", "Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Risk", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.ScanConnection:
+		entityType = generated.TypeScan
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Tags", "Target"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Scan", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.StandardConnection:
+		entityType = generated.TypeStandard
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Domains", "Framework", "GoverningBody", "Name", "ShortName", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Standard", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.SubcontrolConnection:
+		entityType = generated.TypeSubcontrol
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Aliases", "Category", "Description", "DisplayID", "MappedCategories", "RefCode", "Subcategory", "Tags", "Title"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Subcontrol", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.SubprocessorConnection:
+		entityType = generated.TypeSubprocessor
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Subprocessor", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.SubscriberConnection:
+		entityType = generated.TypeSubscriber
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Email", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Subscriber", matchedFields, node)
+		}
+	case *generated.SystemDetailConnection:
+		entityType = generated.TypeSystemDetail
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
-	case *generated.TaskConnection:
+	case *generated.TagDefinitionConnection:
+		entityType = generated.TypeTagDefinition
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"DisplayID", "Tags", "Title"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Task", matchedFields, node)
+		}
+	case *generated.TaskConnection:
+		entityType = generated.TypeTask
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	case *generated.TemplateConnection:
+		entityType = generated.TypeTemplate
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
-			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"Name", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "Template", matchedFields, node)
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
-	case *generated.UserConnection:
+	case *generated.TrustCenterEntityConnection:
+		entityType = generated.TypeTrustCenterEntity
+
 		for _, edge := range conn.Edges {
-			if edge == nil || edge.Node == nil {
-				continue
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
-			node := edge.Node
-			matchedFields := checker.check(node, []string{"DisplayID", "Tags"})
-			if len(matchedFields) > 0 {
-				tracker.addMatch(node.ID, "User", matchedFields, node)
+		}
+	case *generated.VulnerabilityConnection:
+		entityType = generated.TypeVulnerability
+
+		for _, edge := range conn.Edges {
+			if edge != nil && edge.Node != nil {
+				ids = append(ids, edge.Node.ID)
 			}
 		}
 	}
+
+	return entityType, ids
 }
@@ -1,25 +1,23 @@
 package graphapi
 
 import (
-	"slices"
 	"strings"
 	"testing"
 
 	"gotest.tools/v3/assert"
 	is "gotest.tools/v3/assert/cmp"
 
+	"github.com/theopenlane/core/internal/ent/fulltext"
 	"github.com/theopenlane/core/internal/ent/generated"
 )
 
 func TestSearchContextTracker(t *testing.T) {
-	query := "test"
-	tracker := newContextTracker(query)
+	tracker := newContextTracker("test")
 
 	// test adding a match
-	tracker.addMatch("entity-123", "Control", []string{"Title", "Description"}, &generated.Control{
-		ID:          "entity-123",
-		Title:       "Test Control",
-		Description: "This is a test control for testing purposes",
+	tracker.addSnippets("entity-123", "Control", []fulltext.Snippet{
+		{Field: "Title", Text: "<b>Test</b> Control"},
+		{Field: "Description", Text: "This is a <b>test</b> control for <b>testing</b> purposes"},
 	})
 
 	contexts := tracker.getContexts()
@@ -29,133 +27,74 @@ func TestSearchContextTracker(t *testing.T) {
 	assert.Check(t, is.Equal("Control", contexts[0].EntityType))
 	assert.Check(t, is.Contains(contexts[0].MatchedFields, "Title"))
 	assert.Check(t, is.Contains(contexts[0].MatchedFields, "Description"))
-	assert.Check(t, len(contexts[0].Snippets) >= 1)
+	assert.Assert(t, is.Len(contexts[0].Snippets, 2))
+	assert.Check(t, is.Equal("Test Control", contexts[0].Snippets[0].Text))
+	assert.Check(t, is.Equal("This is a test control for testing purposes", contexts[0].Snippets[1].Text))
 }
 
-func TestFieldMatchChecker(t *testing.T) {
-	checker := fieldMatchChecker{"policy"}
-
-	control := &generated.Control{
-		ID:          "ctrl-123",
-		Title:       "Security Policy Control",
-		Description: "Ensures the security policy is followed",
+func TestSearchResultIDs(t *testing.T) {
+	conn := &generated.ControlConnection{
+		Edges: []*generated.ControlEdge{
+			{Node: &generated.Control{ID: "ctrl-1"}},
+			nil,
+			{Node: nil},
+			{Node: &generated.Control{ID: "ctrl-2"}},
+		},
 	}
 
-	// check which fields match
-	matches := checker.check(control, []string{"Title", "Description", "RefCode"})
+	entityType, ids := searchResultIDs(conn)
+	assert.Check(t, is.Equal(generated.TypeControl, entityType))
+	assert.Check(t, is.DeepEqual([]string{"ctrl-1", "ctrl-2"}, ids))
 
-	// both Title and Description contains "policy"
-	assert.Check(t, is.Contains(matches, "Title"))
-	assert.Check(t, is.Contains(matches, "Description"))
-
-	// but RefCode doesn't contain "policy"
-	assert.Check(t, !slices.Contains(matches, "RefCode"))
+	entityType, ids = searchResultIDs("not a connection")
+	assert.Check(t, is.Equal("", entityType))
+	assert.Check(t, is.Len(ids, 0))
 }
 
-func TestCreateSnippet(t *testing.T) {
-	tracker := newContextTracker("security")
-
-	// test snippet creation with match in middle
-	snippet := tracker.createSnippet("Description", "This is a very important security control that protects the system", "security")
-	assert.Assert(t, snippet != nil)
-	assert.Check(t, is.Equal("Description", snippet.Field))
-
-	// snippet should contain the matched text and context
-	assert.Check(t, is.Contains(snippet.Text, "security"))
-	assert.Check(t, is.Contains(snippet.Text, "important"))
-
-	// test snippet with match at beginning
-	snippet2 := tracker.createSnippet("Title", "Security Policy", "security")
-	assert.Assert(t, snippet2 != nil)
-	assert.Check(t, is.Contains(snippet2.Text, "Security"))
-}
-
-func TestExtractSnippets(t *testing.T) {
-	tracker := newContextTracker("test")
-
-	entity := &generated.ActionPlan{
-		ID:      "plan-123",
-		Name:    "Test Plan",
-		Details: "This is a test plan for testing the search functionality",
+func TestSearchResultTypesAreIndexed(t *testing.T) {
+	for _, conn := range []any{
+		&generated.ActionPlanConnection{},
+		&generated.ControlConnection{},
+		&generated.SubcontrolConnection{},
+		&generated.VulnerabilityConnection{},
+	} {
+		entityType, _ := searchResultIDs(conn)
+
+		_, ok := fulltext.Lookup(entityType)
+		assert.Check(t, ok, "%s is not indexed for full-text search", entityType)
 	}
-
-	snippets := tracker.extractSnippets(entity, []string{"Name", "Details"})
-	assert.Assert(t, snippets != nil)
-
-	// should have snippets for both Name and Details
-	var nameSnippetExists, detailSnippetExists bool
-
-	for _, snippet := range snippets {
-		if snippet.Field == "Name" {
-			nameSnippetExists = true
-			assert.Check(t, is.Contains(snippet.Text, "Test"))
-		}
-		if snippet.Field == "Details" {
-			detailSnippetExists = true
-			assert.Check(t, is.Contains(snippet.Text, "test"))
-		}
-	}
-
-	assert.Check(t, is.Equal(true, nameSnippetExists), "Should have snippet for Name field")
-	assert.Check(t, is.Equal(true, detailSnippetExists), "Should have snippet for Details field")
 }
 
 func TestSearchContextContentSanitization(t *testing.T) {
 	tt := []struct {
-		name         string
-		query        string
-		details      string
-		wantSnippet  string
-		wantMatch    bool
-		wantSanitize string
+		name     string
+		headline string
+		want     string
 	}{
 		{
-			name:  "html attributes ignored",
-			query: "slate",
-			details: `<span data-slate-node="text">` +
-				`<span data-slate-string="true">Visible objective</span></span>`,
-			wantMatch: false,
+			name: "html stripped",
+			headline: `<span class="slate-bold"><span data-slate-string="true"><b>objectives</b></span></span>` +
+				`<span data-slate-node="text"> and responsibilities</span>`,
+			want: "objectives and responsibilities",
 		},
 		{
-			name:  "html stripped",
-			query: "objectives",
-			details: `<span class="slate-bold"><span data-slate-string="true">objectives</span></span>` +
-				`<span data-slate-node="text"> and responsibilities</span>`,
-			wantSnippet: "objectives and responsibilities",
-			wantMatch:   true,
+			name:     "markdown stripped",
+			headline: "## Recovery <b>Objectives</b>\n\n- [Objective owner](https://example.com)\n- **monitoring**",
+			want:     "Recovery Objectives Objective owner monitoring",
 		},
 		{
-			name:         "markdown stripped",
-			details:      "## Recovery Objectives\n\n- [Objective owner](https://example.com)\n- **monitoring**",
-			wantSanitize: "Recovery Objectives Objective owner monitoring",
+			name:     "empty",
+			headline: "   ",
+			want:     "",
 		},
 	}
 
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
-			if tc.wantSanitize != "" {
-				assert.Check(t, is.Equal(tc.wantSanitize, sanitizeContent(tc.details)))
-				return
-			}
-
-			entity := &generated.ActionPlan{
-				ID:      "plan-123",
-				Details: tc.details,
-			}
-
-			checker := fieldMatchChecker{query: tc.query}
-			matches := checker.check(entity, []string{"Details"})
-			assert.Check(t, is.Equal(tc.wantMatch, slices.Contains(matches, "Details")))
-
-			if tc.wantSnippet == "" {
-				return
-			}
-
-			snippets := newContextTracker(tc.query).extractSnippets(entity, []string{"Details"})
-			assert.Assert(t, is.Len(snippets, 1))
-			assert.Check(t, is.Equal(tc.wantSnippet, snippets[0].Text))
-			assert.Check(t, !strings.Contains(snippets[0].Text, "<span"))
-			assert.Check(t, !strings.Contains(snippets[0].Text, "data-slate"))
+			got := sanitizeContent(tc.headline)
+			assert.Check(t, is.Equal(tc.want, got))
+			assert.Check(t, !strings.Contains(got, "<"))
+			assert.Check(t, !strings.Contains(got, "data-slate"))
 		})
 	}
 }
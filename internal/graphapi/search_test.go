@@ -82,6 +82,31 @@ func TestGlobalSearch(t *testing.T) {
 			expectedContacts: 10,
 			expectedPrograms: 0, // no access to the programs by the view only user
 		},
+		{
+			name:             "happy path, prefix of each word",
+			client:           suite.client.api,
+			ctx:              testSearchUser.UserCtx,
+			query:            "a1cd2 prog",
+			expectResults:    true,
+			expectedContacts: 0,
+			expectedPrograms: 3,
+		},
+		{
+			name:             "happy path, phrase",
+			client:           suite.client.api,
+			ctx:              testSearchUser.UserCtx,
+			query:            `"a1cd2d contact"`,
+			expectResults:    true,
+			expectedContacts: 10,
+			expectedPrograms: 0,
+		},
+		{
+			name:          "no results, phrase out of order",
+			client:        suite.client.api,
+			ctx:           testSearchUser.UserCtx,
+			query:         `"contact a1cd2d"`,
+			expectResults: false,
+		},
 		{
 			name:          "no results",
 			client:        suite.client.api,
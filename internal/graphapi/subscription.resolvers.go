@@ -9,7 +9,12 @@ import (
 	"context"
 
 	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/control"
+	"github.com/theopenlane/core/internal/ent/generated/program"
+	"github.com/theopenlane/core/internal/graphapi/common"
 	gqlgenerated "github.com/theopenlane/core/internal/graphapi/generated"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/core/internal/graphsubscriptions"
 )
 
 // NotificationCreated is the resolver for the notificationCreated field.
@@ -17,6 +22,45 @@ func (r *subscriptionResolver) NotificationCreated(ctx context.Context) (<-chan
 	return r.handleNotificationSubscription(ctx)
 }
 
+// ControlUpdated is the resolver for the controlUpdated field.
+func (r *subscriptionResolver) ControlUpdated(ctx context.Context, programID *string) (<-chan *generated.Control, error) {
+	return subscribeToObjects(ctx, r, graphsubscriptions.TopicControl, nil, nil, func(ctx context.Context, id string) (*generated.Control, error) {
+		query := r.db.Control.Query().Where(control.ID(id))
+		if programID != nil {
+			query = query.Where(control.HasProgramsWith(program.ID(*programID)))
+		}
+
+		return query.Only(ctx)
+	})
+}
+
+// TaskChanged is the resolver for the taskChanged field.
+func (r *subscriptionResolver) TaskChanged(ctx context.Context) (<-chan *generated.Task, error) {
+	return subscribeToObjects(ctx, r, graphsubscriptions.TopicTask, nil, nil, r.db.Task.Get)
+}
+
+// WorkflowInstanceUpdated is the resolver for the workflowInstanceUpdated field.
+func (r *subscriptionResolver) WorkflowInstanceUpdated(ctx context.Context, id string) (<-chan *generated.WorkflowInstance, error) {
+	instance, err := r.db.WorkflowInstance.Get(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "workflowinstance"})
+	}
+
+	return subscribeToObjects(ctx, r, graphsubscriptions.TopicWorkflowInstance, []*generated.WorkflowInstance{instance},
+		func(event graphsubscriptions.ObjectEvent) bool { return event.ID == id }, r.db.WorkflowInstance.Get)
+}
+
+// IntegrationRunProgress is the resolver for the integrationRunProgress field.
+func (r *subscriptionResolver) IntegrationRunProgress(ctx context.Context, id string) (<-chan *model.IntegrationRunProgress, error) {
+	progress, err := r.integrationRunProgress(ctx, id)
+	if err != nil {
+		return nil, parseRequestError(ctx, err, common.Action{Action: common.ActionGet, Object: "integrationrun"})
+	}
+
+	return subscribeToObjects(ctx, r, graphsubscriptions.TopicIntegrationRun, []*model.IntegrationRunProgress{progress},
+		func(event graphsubscriptions.ObjectEvent) bool { return event.ID == id }, r.integrationRunProgress)
+}
+
 // Subscription returns gqlgenerated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() gqlgenerated.SubscriptionResolver { return &subscriptionResolver{r} }
 
@@ -3,12 +3,15 @@ package graphapi
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/theopenlane/core/internal/ent/generated"
 	"github.com/theopenlane/core/internal/ent/generated/notification"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
 	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
 	"github.com/theopenlane/core/internal/graphsubscriptions"
 	"github.com/theopenlane/core/pkg/logx"
 	"github.com/theopenlane/iam/auth"
@@ -143,3 +146,112 @@ func (r *subscriptionResolver) handleNotificationSubscription(ctx context.Contex
 
 	return notifChan, nil
 }
+
+// subscribeToObjects subscribes the caller to changes of objects of the topic within their active organization.
+// Events only name the changed object, so each one is reloaded with the subscriber's context; objects the
+// subscriber cannot see, that no longer match the subscription filters or that were deleted, are skipped. The
+// initial objects are sent before any change
+func subscribeToObjects[T any](ctx context.Context, r *subscriptionResolver, topic string, initial []T, match func(graphsubscriptions.ObjectEvent) bool,
+	load func(ctx context.Context, id string) (T, error)) (<-chan T, error) {
+	if r.subscriptionManager == nil {
+		logx.FromContext(ctx).Info().Msg("subscription manager is not initialized")
+		return nil, common.ErrInternalServerError
+	}
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.IsAnonymous() {
+		return nil, fmt.Errorf("%s subscriptions are not available to anonymous callers: %w", topic, auth.ErrNoAuthUser)
+	}
+
+	orgID, ok := caller.ActiveOrg()
+	if !ok || orgID == "" {
+		return nil, fmt.Errorf("%s subscriptions require an active organization: %w", topic, auth.ErrNoAuthUser)
+	}
+
+	logx.FromContext(ctx).Debug().Str("org_id", orgID).Str("topic", topic).Bool("redis_enabled", r.subscriptionManager.HasRedis()).Msg("object subscription: subscribing")
+
+	events := make(chan graphsubscriptions.ObjectEvent, graphsubscriptions.ObjectChannelBufferSize)
+	r.subscriptionManager.SubscribeObjects(orgID, topic, events)
+
+	objects := make(chan T, graphsubscriptions.ObjectChannelBufferSize)
+
+	go func() {
+		defer close(objects)
+		defer r.subscriptionManager.UnsubscribeObjects(orgID, topic, events)
+
+		for _, obj := range initial {
+			select {
+			case objects <- obj:
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				if match != nil && !match(event) {
+					continue
+				}
+
+				obj, err := load(ctx, event.ID)
+				if err != nil {
+					// the subscriber is not allowed to see the object or it no longer matches the filters
+					if !generated.IsNotFound(err) && !errors.Is(err, privacy.Deny) {
+						logx.FromContext(ctx).Error().Err(err).Str("topic", topic).Str("id", event.ID).Msg("failed to load changed object for subscription")
+					}
+
+					continue
+				}
+
+				select {
+				case objects <- obj:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return objects, nil
+}
+
+// integrationRunProgress returns the progress of the integration run visible to the caller
+func (r *subscriptionResolver) integrationRunProgress(ctx context.Context, id string) (*model.IntegrationRunProgress, error) {
+	run, err := r.db.IntegrationRun.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &model.IntegrationRunProgress{
+		ID:            run.ID,
+		IntegrationID: run.IntegrationID,
+		OperationName: run.OperationName,
+		Status:        run.Status.String(),
+		FinishedAt:    run.FinishedAt,
+	}
+
+	if !run.StartedAt.IsZero() {
+		progress.StartedAt = &run.StartedAt
+	}
+
+	if run.FinishedAt != nil {
+		progress.DurationMs = &run.DurationMs
+	}
+
+	if run.Summary != "" {
+		progress.Summary = &run.Summary
+	}
+
+	if run.Error != "" {
+		progress.Error = &run.Error
+	}
+
+	return progress, nil
+}
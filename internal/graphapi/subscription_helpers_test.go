@@ -0,0 +1,53 @@
+package graphapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theopenlane/iam/auth"
+
+	"github.com/theopenlane/core/internal/ent/entityops"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphsubscriptions"
+)
+
+func TestSubscribeToObjectsSkipsDeletedObjects(t *testing.T) {
+	manager := graphsubscriptions.NewManager()
+	r := &subscriptionResolver{&Resolver{subscriptionManager: manager}}
+
+	ctx, cancel := context.WithCancel(auth.WithCaller(context.Background(), &auth.Caller{SubjectID: "01USER", OrganizationID: "01ORG"}))
+	defer cancel()
+
+	existing := map[string]*generated.Task{"01KEPT": {ID: "01KEPT"}}
+
+	tasks, err := subscribeToObjects(ctx, r, graphsubscriptions.TopicTask, nil, nil, func(_ context.Context, id string) (*generated.Task, error) {
+		task, ok := existing[id]
+		if !ok {
+			return nil, &generated.NotFoundError{}
+		}
+
+		return task, nil
+	})
+	require.NoError(t, err)
+
+	// a soft deleted task is announced as an update the subscriber can no longer load, and hard deletes are not
+	// published at all, so deletes never reach the subscriber
+	require.NoError(t, manager.PublishObject(graphsubscriptions.ObjectEvent{Topic: graphsubscriptions.TopicTask, ID: "01GONE", OrgID: "01ORG", Operation: entityops.OpUpdateOne}))
+	require.NoError(t, manager.PublishObject(graphsubscriptions.ObjectEvent{Topic: graphsubscriptions.TopicTask, ID: "01KEPT", OrgID: "01ORG", Operation: entityops.OpUpdateOne}))
+
+	select {
+	case task := <-tasks:
+		assert.Equal(t, "01KEPT", task.ID)
+	case <-time.After(time.Second):
+		t.Fatal("expected the task that still exists to be sent")
+	}
+
+	select {
+	case task := <-tasks:
+		t.Fatalf("unexpected task %s sent", task.ID)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
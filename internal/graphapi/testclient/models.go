@@ -2823,6 +2823,47 @@ type AssetWhereInput struct {
 	CategoriesHas *string `json:"categoriesHas,omitempty"`
 }
 
+// BatchMutationInput is the list of operations to run in a batch
+type BatchMutationInput struct {
+	// operations to run, in order
+	Operations []*BatchOperationInput `json:"operations"`
+}
+
+// BatchMutationPayload is the result of a batch mutation
+type BatchMutationPayload struct {
+	// results of the operations, in input order
+	Results []*BatchOperationResult `json:"results"`
+}
+
+// BatchOperationInput is a single create, update or delete within a batch
+type BatchOperationInput struct {
+	// label for the operation; later operations can use the ID of the object with "$ref:<ref>"
+	Ref *string `json:"ref,omitempty"`
+	// change to make
+	Operation BatchOperationType `json:"operation"`
+	// type of the object, e.g. Risk
+	ObjectType string `json:"objectType"`
+	// ID of the object to update or delete
+	ID *string `json:"id,omitempty"`
+	// fields of the create or update input for the object type, using the same names as the
+	// create and update mutations of the type
+	Input map[string]any `json:"input,omitempty"`
+}
+
+// BatchOperationResult is the outcome of a single operation within a batch
+type BatchOperationResult struct {
+	// position of the operation in the input list
+	Index int `json:"index"`
+	// label given to the operation, if any
+	Ref *string `json:"ref,omitempty"`
+	// change made
+	Operation BatchOperationType `json:"operation"`
+	// type of the object
+	ObjectType string `json:"objectType"`
+	// ID of the object created, updated or deleted
+	ID string `json:"id"`
+}
+
 // Return response for approveNDARequests or denyNDARequests mutation
 type BulkUpdateStatusPayload struct {
 	// Updated nda request IDs
@@ -4327,6 +4368,16 @@ type Contact struct {
 
 func (Contact) IsNode() {}
 
+// CompletedQuestionnairePayload is the questionnaire with the answers written into it
+type CompletedQuestionnairePayload struct {
+	// name of the completed file
+	Filename string `json:"filename"`
+	// media type of the completed file
+	ContentType string `json:"contentType"`
+	// base64 encoded contents of the completed file
+	Base64 string `json:"base64"`
+}
+
 // Return response for createBulkContact mutation
 type ContactBulkCreatePayload struct {
 	// Created contacts
@@ -7226,6 +7277,22 @@ type CreateCustomDomainInput struct {
 	DNSVerificationID *string                 `json:"dnsVerificationID,omitempty"`
 }
 
+// CreateCustomRoleInput is used for create CustomRole object.
+// Input was generated by ent.
+type CreateCustomRoleInput struct {
+	// the name of the custom role
+	Name string `json:"name"`
+	// the description of the custom role
+	Description *string `json:"description,omitempty"`
+	// the permission bundles (e.g. can_manage_policies) and object permissions (e.g. can_create_evidence) granted by the role
+	Permissions []string `json:"permissions"`
+	// programs the role is scoped to; when the role is scoped its permissions are limited to can_view_program, can_edit_program, can_view_group, can_edit_group and can_delete_group, granted on the programs and groups of the scope instead of the organization
+	ProgramIds []string `json:"programIds,omitempty"`
+	// groups the role is scoped to
+	GroupIds []string `json:"groupIds,omitempty"`
+	OwnerID  *string  `json:"ownerID,omitempty"`
+}
+
 // CreateCustomTypeEnumInput is used for create CustomTypeEnum object.
 // Input was generated by ent.
 type CreateCustomTypeEnumInput struct {
@@ -8904,6 +8971,8 @@ type CreateOrganizationInput struct {
 	DiscussionIDs                        []string                        `json:"discussionIDs,omitempty"`
 	VendorScoringConfigIDs               []string                        `json:"vendorScoringConfigIDs,omitempty"`
 	VendorRiskScoreIDs                   []string                        `json:"vendorRiskScoreIDs,omitempty"`
+	RetentionRuleIDs                     []string                        `json:"retentionRuleIDs,omitempty"`
+	CustomRoleIDs                        []string                        `json:"customRoleIDs,omitempty"`
 	CreateOrgSettings                    *CreateOrganizationSettingInput `json:"createOrgSettings,omitempty"`
 }
 
@@ -9343,6 +9412,24 @@ type CreateRemediationInput struct {
 	WorkflowObjectRefIDs []string       `json:"workflowObjectRefIDs,omitempty"`
 }
 
+// CreateRetentionRuleInput is used for create RetentionRule object.
+// Input was generated by ent.
+type CreateRetentionRuleInput struct {
+	// the name of the retention rule, recorded in the metadata of the files it is applied to
+	Name string `json:"name"`
+	// the description of the retention rule
+	Description *string `json:"description,omitempty"`
+	// the file category the rule applies to, e.g. evidence; empty matches any category
+	Category *string `json:"category,omitempty"`
+	// the type of object the file is attached to, e.g. evidence or procedure; empty matches any type
+	ObjectType *string `json:"objectType,omitempty"`
+	// the number of days matching files are retained after upload
+	RetentionDays int64 `json:"retentionDays"`
+	// delete matching files once the retention period ends
+	Purge   *bool   `json:"purge,omitempty"`
+	OwnerID *string `json:"ownerID,omitempty"`
+}
+
 // CreateReviewInput is used for create Review object.
 // Input was generated by ent.
 type CreateReviewInput struct {
@@ -10020,7 +10107,9 @@ type CreateTrustCenterFAQInput struct {
 	// optional reference link for the FAQ
 	ReferenceLink *string `json:"referenceLink,omitempty"`
 	// display order of the FAQ
-	DisplayOrder         *int64           `json:"displayOrder,omitempty"`
+	DisplayOrder *int64 `json:"displayOrder,omitempty"`
+	// whether the FAQ answer is approved for reuse, e.g. when answering security questionnaires
+	Approved             *bool            `json:"approved,omitempty"`
 	TrustCenterFaqKindID *string          `json:"trustCenterFaqKindID,omitempty"`
 	BlockedGroupIDs      []string         `json:"blockedGroupIDs,omitempty"`
 	EditorIDs            []string         `json:"editorIDs,omitempty"`
@@ -10862,6 +10951,241 @@ type CustomDomainWhereInput struct {
 	TagsHas *string `json:"tagsHas,omitempty"`
 }
 
+type CustomRole struct {
+	ID        string     `json:"id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the real user acting through an impersonation session when the record was last mutated, if any
+	UpdatedByImpersonator *string `json:"updatedByImpersonator,omitempty"`
+	// the organization id that owns the object
+	OwnerID *string `json:"ownerID,omitempty"`
+	// the name of the custom role
+	Name string `json:"name"`
+	// the description of the custom role
+	Description *string `json:"description,omitempty"`
+	// the permission bundles (e.g. can_manage_policies) and object permissions (e.g. can_create_evidence) granted by the role
+	Permissions []string `json:"permissions"`
+	// programs the role is scoped to; when the role is scoped its permissions are limited to can_view_program, can_edit_program, can_view_group, can_edit_group and can_delete_group, granted on the programs and groups of the scope instead of the organization
+	ProgramIds []string `json:"programIds,omitempty"`
+	// groups the role is scoped to
+	GroupIds []string      `json:"groupIds,omitempty"`
+	Owner    *Organization `json:"owner,omitempty"`
+}
+
+func (CustomRole) IsNode() {}
+
+// Return response for createBulkCustomRole mutation
+type CustomRoleBulkCreatePayload struct {
+	// Created customRoles
+	CustomRoles []*CustomRole `json:"customRoles,omitempty"`
+}
+
+// Return response for deleteBulkCustomRole mutation
+type CustomRoleBulkDeletePayload struct {
+	// Deleted customRole IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// IDs that were not deleted
+	NotDeletedIDs []string `json:"notDeletedIDs"`
+	// Error message when the bulk delete did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// Return response for updateBulkCustomRole mutation
+type CustomRoleBulkUpdatePayload struct {
+	// Updated customRoles
+	CustomRoles []*CustomRole `json:"customRoles,omitempty"`
+	// IDs of the updated customRoles
+	UpdatedIDs []string `json:"updatedIDs,omitempty"`
+	// IDs that were not updated
+	NotUpdatedIDs []string `json:"notUpdatedIDs"`
+	// Error message when the bulk update did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// A connection to a list of items.
+type CustomRoleConnection struct {
+	// A list of edges.
+	Edges []*CustomRoleEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createCustomRole mutation
+type CustomRoleCreatePayload struct {
+	// Created customRole
+	CustomRole *CustomRole `json:"customRole"`
+}
+
+// Return response for deleteCustomRole mutation
+type CustomRoleDeletePayload struct {
+	// Deleted customRole ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type CustomRoleEdge struct {
+	// The item at the end of the edge.
+	Node *CustomRole `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for CustomRole connections
+type CustomRoleOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order CustomRoles.
+	Field CustomRoleOrderField `json:"field"`
+}
+
+// Return response for updateCustomRole mutation
+type CustomRoleUpdatePayload struct {
+	// Updated customRole
+	CustomRole *CustomRole `json:"customRole"`
+}
+
+// CustomRoleWhereInput is used for filtering CustomRole objects.
+// Input was generated by ent.
+type CustomRoleWhereInput struct {
+	Not *CustomRoleWhereInput   `json:"not,omitempty"`
+	And []*CustomRoleWhereInput `json:"and,omitempty"`
+	Or  []*CustomRoleWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// updated_by_impersonator field predicates
+	UpdatedByImpersonator             *string  `json:"updatedByImpersonator,omitempty"`
+	UpdatedByImpersonatorNeq          *string  `json:"updatedByImpersonatorNEQ,omitempty"`
+	UpdatedByImpersonatorIn           []string `json:"updatedByImpersonatorIn,omitempty"`
+	UpdatedByImpersonatorNotIn        []string `json:"updatedByImpersonatorNotIn,omitempty"`
+	UpdatedByImpersonatorGt           *string  `json:"updatedByImpersonatorGT,omitempty"`
+	UpdatedByImpersonatorGte          *string  `json:"updatedByImpersonatorGTE,omitempty"`
+	UpdatedByImpersonatorLt           *string  `json:"updatedByImpersonatorLT,omitempty"`
+	UpdatedByImpersonatorLte          *string  `json:"updatedByImpersonatorLTE,omitempty"`
+	UpdatedByImpersonatorContains     *string  `json:"updatedByImpersonatorContains,omitempty"`
+	UpdatedByImpersonatorHasPrefix    *string  `json:"updatedByImpersonatorHasPrefix,omitempty"`
+	UpdatedByImpersonatorHasSuffix    *string  `json:"updatedByImpersonatorHasSuffix,omitempty"`
+	UpdatedByImpersonatorIsNil        *bool    `json:"updatedByImpersonatorIsNil,omitempty"`
+	UpdatedByImpersonatorNotNil       *bool    `json:"updatedByImpersonatorNotNil,omitempty"`
+	UpdatedByImpersonatorEqualFold    *string  `json:"updatedByImpersonatorEqualFold,omitempty"`
+	UpdatedByImpersonatorContainsFold *string  `json:"updatedByImpersonatorContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIdneq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIdgt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIdgte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIdlt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIdlte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        *bool    `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// description field predicates
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
 type CustomTypeEnum struct {
 	ID        string     `json:"id"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -14956,6 +15280,8 @@ type Entity struct {
 	HasSoc2 *bool `json:"hasSoc2,omitempty"`
 	// SOC 2 reporting period end date
 	Soc2PeriodEnd *models.DateTime `json:"soc2PeriodEnd,omitempty"`
+	// when the SOC 2 reporting period on file was found to have lapsed; cleared when the reporting period end changes
+	Soc2LapsedAt *models.DateTime `json:"soc2LapsedAt,omitempty"`
 	// start date for the entity contract
 	ContractStartDate *models.DateTime `json:"contractStartDate,omitempty"`
 	// end date for the entity contract
@@ -15884,6 +16210,17 @@ type EntityWhereInput struct {
 	Soc2PeriodEndLte    *models.DateTime   `json:"soc2PeriodEndLTE,omitempty"`
 	Soc2PeriodEndIsNil  *bool              `json:"soc2PeriodEndIsNil,omitempty"`
 	Soc2PeriodEndNotNil *bool              `json:"soc2PeriodEndNotNil,omitempty"`
+	// soc2_lapsed_at field predicates
+	Soc2LapsedAt       *models.DateTime   `json:"soc2LapsedAt,omitempty"`
+	Soc2LapsedAtNeq    *models.DateTime   `json:"soc2LapsedAtNEQ,omitempty"`
+	Soc2LapsedAtIn     []*models.DateTime `json:"soc2LapsedAtIn,omitempty"`
+	Soc2LapsedAtNotIn  []*models.DateTime `json:"soc2LapsedAtNotIn,omitempty"`
+	Soc2LapsedAtGt     *models.DateTime   `json:"soc2LapsedAtGT,omitempty"`
+	Soc2LapsedAtGte    *models.DateTime   `json:"soc2LapsedAtGTE,omitempty"`
+	Soc2LapsedAtLt     *models.DateTime   `json:"soc2LapsedAtLT,omitempty"`
+	Soc2LapsedAtLte    *models.DateTime   `json:"soc2LapsedAtLTE,omitempty"`
+	Soc2LapsedAtIsNil  *bool              `json:"soc2LapsedAtIsNil,omitempty"`
+	Soc2LapsedAtNotNil *bool              `json:"soc2LapsedAtNotNil,omitempty"`
 	// contract_start_date field predicates
 	ContractStartDate       *models.DateTime   `json:"contractStartDate,omitempty"`
 	ContractStartDateNeq    *models.DateTime   `json:"contractStartDateNEQ,omitempty"`
@@ -17389,8 +17726,10 @@ type File struct {
 	// the region the file is stored in, if applicable
 	StorageRegion *string `json:"storageRegion,omitempty"`
 	// the storage provider the file is stored in, if applicable
-	StorageProvider        *string                      `json:"storageProvider,omitempty"`
-	LastAccessedAt         *time.Time                   `json:"lastAccessedAt,omitempty"`
+	StorageProvider *string    `json:"storageProvider,omitempty"`
+	LastAccessedAt  *time.Time `json:"lastAccessedAt,omitempty"`
+	// the content scan status of the file; files that have not passed scanning are quarantined and cannot be downloaded
+	ScanStatus             *string                      `json:"scanStatus,omitempty"`
 	Environment            *CustomTypeEnum              `json:"environment,omitempty"`
 	Scope                  *CustomTypeEnum              `json:"scope,omitempty"`
 	Category               *CustomTypeEnum              `json:"category,omitempty"`
@@ -17928,6 +18267,22 @@ type FileWhereInput struct {
 	LastAccessedAtLte    *time.Time   `json:"lastAccessedAtLTE,omitempty"`
 	LastAccessedAtIsNil  *bool        `json:"lastAccessedAtIsNil,omitempty"`
 	LastAccessedAtNotNil *bool        `json:"lastAccessedAtNotNil,omitempty"`
+	// scan_status field predicates
+	ScanStatus             *string  `json:"scanStatus,omitempty"`
+	ScanStatusNeq          *string  `json:"scanStatusNEQ,omitempty"`
+	ScanStatusIn           []string `json:"scanStatusIn,omitempty"`
+	ScanStatusNotIn        []string `json:"scanStatusNotIn,omitempty"`
+	ScanStatusGt           *string  `json:"scanStatusGT,omitempty"`
+	ScanStatusGte          *string  `json:"scanStatusGTE,omitempty"`
+	ScanStatusLt           *string  `json:"scanStatusLT,omitempty"`
+	ScanStatusLte          *string  `json:"scanStatusLTE,omitempty"`
+	ScanStatusContains     *string  `json:"scanStatusContains,omitempty"`
+	ScanStatusHasPrefix    *string  `json:"scanStatusHasPrefix,omitempty"`
+	ScanStatusHasSuffix    *string  `json:"scanStatusHasSuffix,omitempty"`
+	ScanStatusIsNil        *bool    `json:"scanStatusIsNil,omitempty"`
+	ScanStatusNotNil       *bool    `json:"scanStatusNotNil,omitempty"`
+	ScanStatusEqualFold    *string  `json:"scanStatusEqualFold,omitempty"`
+	ScanStatusContainsFold *string  `json:"scanStatusContainsFold,omitempty"`
 	// environment edge predicates
 	HasEnvironment     *bool                       `json:"hasEnvironment,omitempty"`
 	HasEnvironmentWith []*CustomTypeEnumWhereInput `json:"hasEnvironmentWith,omitempty"`
@@ -27574,6 +27929,8 @@ type Organization struct {
 	Discussions                        *DiscussionConnection                 `json:"discussions"`
 	VendorScoringConfigs               *VendorScoringConfigConnection        `json:"vendorScoringConfigs"`
 	VendorRiskScores                   *VendorRiskScoreConnection            `json:"vendorRiskScores"`
+	RetentionRules                     *RetentionRuleConnection              `json:"retentionRules"`
+	CustomRoles                        *CustomRoleConnection                 `json:"customRoles"`
 	Members                            *OrgMembershipConnection              `json:"members"`
 }
 
@@ -28836,6 +29193,12 @@ type OrganizationWhereInput struct {
 	// vendor_risk_scores edge predicates
 	HasVendorRiskScores     *bool                        `json:"hasVendorRiskScores,omitempty"`
 	HasVendorRiskScoresWith []*VendorRiskScoreWhereInput `json:"hasVendorRiskScoresWith,omitempty"`
+	// retention_rules edge predicates
+	HasRetentionRules     *bool                      `json:"hasRetentionRules,omitempty"`
+	HasRetentionRulesWith []*RetentionRuleWhereInput `json:"hasRetentionRulesWith,omitempty"`
+	// custom_roles edge predicates
+	HasCustomRoles     *bool                   `json:"hasCustomRoles,omitempty"`
+	HasCustomRolesWith []*CustomRoleWhereInput `json:"hasCustomRolesWith,omitempty"`
 	// members edge predicates
 	HasMembers     *bool                      `json:"hasMembers,omitempty"`
 	HasMembersWith []*OrgMembershipWhereInput `json:"hasMembersWith,omitempty"`
@@ -31737,6 +32100,56 @@ type ProgramWhereInput struct {
 type Query struct {
 }
 
+// QuestionnaireAnswerInput is an answer to write into a questionnaire
+type QuestionnaireAnswerInput struct {
+	// sheet the question is on, defaults to the first sheet
+	Sheet *string `json:"sheet,omitempty"`
+	// row number of the question, as returned by suggestQuestionnaireAnswers
+	Row int `json:"row"`
+	// answer text
+	Answer string `json:"answer"`
+}
+
+// QuestionnaireAnswerSource is the object a suggested answer was taken from
+type QuestionnaireAnswerSource struct {
+	// type of the object, e.g. TrustCenterFAQ
+	ObjectType string `json:"objectType"`
+	// ID of the object
+	ID string `json:"id"`
+	// title of the object
+	Title *string `json:"title,omitempty"`
+}
+
+// QuestionnaireAnswerSuggestion is an answer suggested for a question
+type QuestionnaireAnswerSuggestion struct {
+	// suggested answer
+	Answer string `json:"answer"`
+	// similarity of the question to the source of the answer, between 0 and 1
+	Confidence float64 `json:"confidence"`
+	// objects the answer was found in
+	Sources []*QuestionnaireAnswerSource `json:"sources"`
+}
+
+// QuestionnaireQuestion is a question read from a questionnaire with its suggested answers
+type QuestionnaireQuestion struct {
+	// sheet the question is on
+	Sheet string `json:"sheet"`
+	// row number of the question
+	Row int `json:"row"`
+	// question text
+	Question string `json:"question"`
+	// answer already in the file, if any
+	ExistingAnswer *string `json:"existingAnswer,omitempty"`
+	// suggested answers, most confident first
+	Suggestions []*QuestionnaireAnswerSuggestion `json:"suggestions"`
+}
+
+// QuestionnaireSuggestionsPayload is the result of suggesting answers for a questionnaire
+type QuestionnaireSuggestionsPayload struct {
+	// questions found in the file, in file order
+	Questions []*QuestionnaireQuestion `json:"questions"`
+}
+
 // Input for adminReassignWorkflowAssignment mutation
 type ReassignWorkflowAssignmentInput struct {
 	// ID of the workflow assignment to reassign
@@ -32544,6 +32957,287 @@ type ResolveVulnerabilityInput struct {
 	ReviewState *string `json:"reviewState,omitempty"`
 }
 
+type RetentionRule struct {
+	ID        string     `json:"id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+	CreatedBy *string    `json:"createdBy,omitempty"`
+	UpdatedBy *string    `json:"updatedBy,omitempty"`
+	// the real user acting through an impersonation session when the record was last mutated, if any
+	UpdatedByImpersonator *string `json:"updatedByImpersonator,omitempty"`
+	// the organization id that owns the object
+	OwnerID *string `json:"ownerID,omitempty"`
+	// the name of the retention rule, recorded in the metadata of the files it is applied to
+	Name string `json:"name"`
+	// the description of the retention rule
+	Description *string `json:"description,omitempty"`
+	// the file category the rule applies to, e.g. evidence; empty matches any category
+	Category *string `json:"category,omitempty"`
+	// the type of object the file is attached to, e.g. evidence or procedure; empty matches any type
+	ObjectType *string `json:"objectType,omitempty"`
+	// the number of days matching files are retained after upload
+	RetentionDays int64 `json:"retentionDays"`
+	// delete matching files once the retention period ends
+	Purge bool          `json:"purge"`
+	Owner *Organization `json:"owner,omitempty"`
+}
+
+func (RetentionRule) IsNode() {}
+
+// Return response for createBulkRetentionRule mutation
+type RetentionRuleBulkCreatePayload struct {
+	// Created retentionRules
+	RetentionRules []*RetentionRule `json:"retentionRules,omitempty"`
+}
+
+// Return response for deleteBulkRetentionRule mutation
+type RetentionRuleBulkDeletePayload struct {
+	// Deleted retentionRule IDs
+	DeletedIDs []string `json:"deletedIDs"`
+	// IDs that were not deleted
+	NotDeletedIDs []string `json:"notDeletedIDs"`
+	// Error message when the bulk delete did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// Return response for updateBulkRetentionRule mutation
+type RetentionRuleBulkUpdatePayload struct {
+	// Updated retentionRules
+	RetentionRules []*RetentionRule `json:"retentionRules,omitempty"`
+	// IDs of the updated retentionRules
+	UpdatedIDs []string `json:"updatedIDs,omitempty"`
+	// IDs that were not updated
+	NotUpdatedIDs []string `json:"notUpdatedIDs"`
+	// Error message when the bulk update did not apply to every requested ID
+	Error *string `json:"error,omitempty"`
+}
+
+// A connection to a list of items.
+type RetentionRuleConnection struct {
+	// A list of edges.
+	Edges []*RetentionRuleEdge `json:"edges,omitempty"`
+	// Information to aid in pagination.
+	PageInfo *PageInfo `json:"pageInfo"`
+	// Identifies the total count of items in the connection.
+	TotalCount int64 `json:"totalCount"`
+}
+
+// Return response for createRetentionRule mutation
+type RetentionRuleCreatePayload struct {
+	// Created retentionRule
+	RetentionRule *RetentionRule `json:"retentionRule"`
+}
+
+// Return response for deleteRetentionRule mutation
+type RetentionRuleDeletePayload struct {
+	// Deleted retentionRule ID
+	DeletedID string `json:"deletedID"`
+}
+
+// An edge in a connection.
+type RetentionRuleEdge struct {
+	// The item at the end of the edge.
+	Node *RetentionRule `json:"node,omitempty"`
+	// A cursor for use in pagination.
+	Cursor string `json:"cursor"`
+}
+
+// Ordering options for RetentionRule connections
+type RetentionRuleOrder struct {
+	// The ordering direction.
+	Direction OrderDirection `json:"direction"`
+	// The field by which to order RetentionRules.
+	Field RetentionRuleOrderField `json:"field"`
+}
+
+// Return response for updateRetentionRule mutation
+type RetentionRuleUpdatePayload struct {
+	// Updated retentionRule
+	RetentionRule *RetentionRule `json:"retentionRule"`
+}
+
+// RetentionRuleWhereInput is used for filtering RetentionRule objects.
+// Input was generated by ent.
+type RetentionRuleWhereInput struct {
+	Not *RetentionRuleWhereInput   `json:"not,omitempty"`
+	And []*RetentionRuleWhereInput `json:"and,omitempty"`
+	Or  []*RetentionRuleWhereInput `json:"or,omitempty"`
+	// id field predicates
+	ID             *string  `json:"id,omitempty"`
+	IDNeq          *string  `json:"idNEQ,omitempty"`
+	IDIn           []string `json:"idIn,omitempty"`
+	IDNotIn        []string `json:"idNotIn,omitempty"`
+	IDGt           *string  `json:"idGT,omitempty"`
+	IDGte          *string  `json:"idGTE,omitempty"`
+	IDLt           *string  `json:"idLT,omitempty"`
+	IDLte          *string  `json:"idLTE,omitempty"`
+	IDEqualFold    *string  `json:"idEqualFold,omitempty"`
+	IDContainsFold *string  `json:"idContainsFold,omitempty"`
+	// created_at field predicates
+	CreatedAt       *time.Time   `json:"createdAt,omitempty"`
+	CreatedAtNeq    *time.Time   `json:"createdAtNEQ,omitempty"`
+	CreatedAtIn     []*time.Time `json:"createdAtIn,omitempty"`
+	CreatedAtNotIn  []*time.Time `json:"createdAtNotIn,omitempty"`
+	CreatedAtGt     *time.Time   `json:"createdAtGT,omitempty"`
+	CreatedAtGte    *time.Time   `json:"createdAtGTE,omitempty"`
+	CreatedAtLt     *time.Time   `json:"createdAtLT,omitempty"`
+	CreatedAtLte    *time.Time   `json:"createdAtLTE,omitempty"`
+	CreatedAtIsNil  *bool        `json:"createdAtIsNil,omitempty"`
+	CreatedAtNotNil *bool        `json:"createdAtNotNil,omitempty"`
+	// updated_at field predicates
+	UpdatedAt       *time.Time   `json:"updatedAt,omitempty"`
+	UpdatedAtNeq    *time.Time   `json:"updatedAtNEQ,omitempty"`
+	UpdatedAtIn     []*time.Time `json:"updatedAtIn,omitempty"`
+	UpdatedAtNotIn  []*time.Time `json:"updatedAtNotIn,omitempty"`
+	UpdatedAtGt     *time.Time   `json:"updatedAtGT,omitempty"`
+	UpdatedAtGte    *time.Time   `json:"updatedAtGTE,omitempty"`
+	UpdatedAtLt     *time.Time   `json:"updatedAtLT,omitempty"`
+	UpdatedAtLte    *time.Time   `json:"updatedAtLTE,omitempty"`
+	UpdatedAtIsNil  *bool        `json:"updatedAtIsNil,omitempty"`
+	UpdatedAtNotNil *bool        `json:"updatedAtNotNil,omitempty"`
+	// created_by field predicates
+	CreatedBy             *string  `json:"createdBy,omitempty"`
+	CreatedByNeq          *string  `json:"createdByNEQ,omitempty"`
+	CreatedByIn           []string `json:"createdByIn,omitempty"`
+	CreatedByNotIn        []string `json:"createdByNotIn,omitempty"`
+	CreatedByGt           *string  `json:"createdByGT,omitempty"`
+	CreatedByGte          *string  `json:"createdByGTE,omitempty"`
+	CreatedByLt           *string  `json:"createdByLT,omitempty"`
+	CreatedByLte          *string  `json:"createdByLTE,omitempty"`
+	CreatedByContains     *string  `json:"createdByContains,omitempty"`
+	CreatedByHasPrefix    *string  `json:"createdByHasPrefix,omitempty"`
+	CreatedByHasSuffix    *string  `json:"createdByHasSuffix,omitempty"`
+	CreatedByIsNil        *bool    `json:"createdByIsNil,omitempty"`
+	CreatedByNotNil       *bool    `json:"createdByNotNil,omitempty"`
+	CreatedByEqualFold    *string  `json:"createdByEqualFold,omitempty"`
+	CreatedByContainsFold *string  `json:"createdByContainsFold,omitempty"`
+	// updated_by field predicates
+	UpdatedBy             *string  `json:"updatedBy,omitempty"`
+	UpdatedByNeq          *string  `json:"updatedByNEQ,omitempty"`
+	UpdatedByIn           []string `json:"updatedByIn,omitempty"`
+	UpdatedByNotIn        []string `json:"updatedByNotIn,omitempty"`
+	UpdatedByGt           *string  `json:"updatedByGT,omitempty"`
+	UpdatedByGte          *string  `json:"updatedByGTE,omitempty"`
+	UpdatedByLt           *string  `json:"updatedByLT,omitempty"`
+	UpdatedByLte          *string  `json:"updatedByLTE,omitempty"`
+	UpdatedByContains     *string  `json:"updatedByContains,omitempty"`
+	UpdatedByHasPrefix    *string  `json:"updatedByHasPrefix,omitempty"`
+	UpdatedByHasSuffix    *string  `json:"updatedByHasSuffix,omitempty"`
+	UpdatedByIsNil        *bool    `json:"updatedByIsNil,omitempty"`
+	UpdatedByNotNil       *bool    `json:"updatedByNotNil,omitempty"`
+	UpdatedByEqualFold    *string  `json:"updatedByEqualFold,omitempty"`
+	UpdatedByContainsFold *string  `json:"updatedByContainsFold,omitempty"`
+	// updated_by_impersonator field predicates
+	UpdatedByImpersonator             *string  `json:"updatedByImpersonator,omitempty"`
+	UpdatedByImpersonatorNeq          *string  `json:"updatedByImpersonatorNEQ,omitempty"`
+	UpdatedByImpersonatorIn           []string `json:"updatedByImpersonatorIn,omitempty"`
+	UpdatedByImpersonatorNotIn        []string `json:"updatedByImpersonatorNotIn,omitempty"`
+	UpdatedByImpersonatorGt           *string  `json:"updatedByImpersonatorGT,omitempty"`
+	UpdatedByImpersonatorGte          *string  `json:"updatedByImpersonatorGTE,omitempty"`
+	UpdatedByImpersonatorLt           *string  `json:"updatedByImpersonatorLT,omitempty"`
+	UpdatedByImpersonatorLte          *string  `json:"updatedByImpersonatorLTE,omitempty"`
+	UpdatedByImpersonatorContains     *string  `json:"updatedByImpersonatorContains,omitempty"`
+	UpdatedByImpersonatorHasPrefix    *string  `json:"updatedByImpersonatorHasPrefix,omitempty"`
+	UpdatedByImpersonatorHasSuffix    *string  `json:"updatedByImpersonatorHasSuffix,omitempty"`
+	UpdatedByImpersonatorIsNil        *bool    `json:"updatedByImpersonatorIsNil,omitempty"`
+	UpdatedByImpersonatorNotNil       *bool    `json:"updatedByImpersonatorNotNil,omitempty"`
+	UpdatedByImpersonatorEqualFold    *string  `json:"updatedByImpersonatorEqualFold,omitempty"`
+	UpdatedByImpersonatorContainsFold *string  `json:"updatedByImpersonatorContainsFold,omitempty"`
+	// owner_id field predicates
+	OwnerID             *string  `json:"ownerID,omitempty"`
+	OwnerIdneq          *string  `json:"ownerIDNEQ,omitempty"`
+	OwnerIDIn           []string `json:"ownerIDIn,omitempty"`
+	OwnerIDNotIn        []string `json:"ownerIDNotIn,omitempty"`
+	OwnerIdgt           *string  `json:"ownerIDGT,omitempty"`
+	OwnerIdgte          *string  `json:"ownerIDGTE,omitempty"`
+	OwnerIdlt           *string  `json:"ownerIDLT,omitempty"`
+	OwnerIdlte          *string  `json:"ownerIDLTE,omitempty"`
+	OwnerIDContains     *string  `json:"ownerIDContains,omitempty"`
+	OwnerIDHasPrefix    *string  `json:"ownerIDHasPrefix,omitempty"`
+	OwnerIDHasSuffix    *string  `json:"ownerIDHasSuffix,omitempty"`
+	OwnerIDIsNil        *bool    `json:"ownerIDIsNil,omitempty"`
+	OwnerIDNotNil       *bool    `json:"ownerIDNotNil,omitempty"`
+	OwnerIDEqualFold    *string  `json:"ownerIDEqualFold,omitempty"`
+	OwnerIDContainsFold *string  `json:"ownerIDContainsFold,omitempty"`
+	// name field predicates
+	Name             *string  `json:"name,omitempty"`
+	NameNeq          *string  `json:"nameNEQ,omitempty"`
+	NameIn           []string `json:"nameIn,omitempty"`
+	NameNotIn        []string `json:"nameNotIn,omitempty"`
+	NameGt           *string  `json:"nameGT,omitempty"`
+	NameGte          *string  `json:"nameGTE,omitempty"`
+	NameLt           *string  `json:"nameLT,omitempty"`
+	NameLte          *string  `json:"nameLTE,omitempty"`
+	NameContains     *string  `json:"nameContains,omitempty"`
+	NameHasPrefix    *string  `json:"nameHasPrefix,omitempty"`
+	NameHasSuffix    *string  `json:"nameHasSuffix,omitempty"`
+	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
+	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
+	// description field predicates
+	Description             *string  `json:"description,omitempty"`
+	DescriptionNeq          *string  `json:"descriptionNEQ,omitempty"`
+	DescriptionIn           []string `json:"descriptionIn,omitempty"`
+	DescriptionNotIn        []string `json:"descriptionNotIn,omitempty"`
+	DescriptionGt           *string  `json:"descriptionGT,omitempty"`
+	DescriptionGte          *string  `json:"descriptionGTE,omitempty"`
+	DescriptionLt           *string  `json:"descriptionLT,omitempty"`
+	DescriptionLte          *string  `json:"descriptionLTE,omitempty"`
+	DescriptionContains     *string  `json:"descriptionContains,omitempty"`
+	DescriptionHasPrefix    *string  `json:"descriptionHasPrefix,omitempty"`
+	DescriptionHasSuffix    *string  `json:"descriptionHasSuffix,omitempty"`
+	DescriptionIsNil        *bool    `json:"descriptionIsNil,omitempty"`
+	DescriptionNotNil       *bool    `json:"descriptionNotNil,omitempty"`
+	DescriptionEqualFold    *string  `json:"descriptionEqualFold,omitempty"`
+	DescriptionContainsFold *string  `json:"descriptionContainsFold,omitempty"`
+	// category field predicates
+	Category             *string  `json:"category,omitempty"`
+	CategoryNeq          *string  `json:"categoryNEQ,omitempty"`
+	CategoryIn           []string `json:"categoryIn,omitempty"`
+	CategoryNotIn        []string `json:"categoryNotIn,omitempty"`
+	CategoryGt           *string  `json:"categoryGT,omitempty"`
+	CategoryGte          *string  `json:"categoryGTE,omitempty"`
+	CategoryLt           *string  `json:"categoryLT,omitempty"`
+	CategoryLte          *string  `json:"categoryLTE,omitempty"`
+	CategoryContains     *string  `json:"categoryContains,omitempty"`
+	CategoryHasPrefix    *string  `json:"categoryHasPrefix,omitempty"`
+	CategoryHasSuffix    *string  `json:"categoryHasSuffix,omitempty"`
+	CategoryIsNil        *bool    `json:"categoryIsNil,omitempty"`
+	CategoryNotNil       *bool    `json:"categoryNotNil,omitempty"`
+	CategoryEqualFold    *string  `json:"categoryEqualFold,omitempty"`
+	CategoryContainsFold *string  `json:"categoryContainsFold,omitempty"`
+	// object_type field predicates
+	ObjectType             *string  `json:"objectType,omitempty"`
+	ObjectTypeNeq          *string  `json:"objectTypeNEQ,omitempty"`
+	ObjectTypeIn           []string `json:"objectTypeIn,omitempty"`
+	ObjectTypeNotIn        []string `json:"objectTypeNotIn,omitempty"`
+	ObjectTypeGt           *string  `json:"objectTypeGT,omitempty"`
+	ObjectTypeGte          *string  `json:"objectTypeGTE,omitempty"`
+	ObjectTypeLt           *string  `json:"objectTypeLT,omitempty"`
+	ObjectTypeLte          *string  `json:"objectTypeLTE,omitempty"`
+	ObjectTypeContains     *string  `json:"objectTypeContains,omitempty"`
+	ObjectTypeHasPrefix    *string  `json:"objectTypeHasPrefix,omitempty"`
+	ObjectTypeHasSuffix    *string  `json:"objectTypeHasSuffix,omitempty"`
+	ObjectTypeIsNil        *bool    `json:"objectTypeIsNil,omitempty"`
+	ObjectTypeNotNil       *bool    `json:"objectTypeNotNil,omitempty"`
+	ObjectTypeEqualFold    *string  `json:"objectTypeEqualFold,omitempty"`
+	ObjectTypeContainsFold *string  `json:"objectTypeContainsFold,omitempty"`
+	// retention_days field predicates
+	RetentionDays      *int64  `json:"retentionDays,omitempty"`
+	RetentionDaysNeq   *int64  `json:"retentionDaysNEQ,omitempty"`
+	RetentionDaysIn    []int64 `json:"retentionDaysIn,omitempty"`
+	RetentionDaysNotIn []int64 `json:"retentionDaysNotIn,omitempty"`
+	RetentionDaysGt    *int64  `json:"retentionDaysGT,omitempty"`
+	RetentionDaysGte   *int64  `json:"retentionDaysGTE,omitempty"`
+	RetentionDaysLt    *int64  `json:"retentionDaysLT,omitempty"`
+	RetentionDaysLte   *int64  `json:"retentionDaysLTE,omitempty"`
+	// purge field predicates
+	Purge    *bool `json:"purge,omitempty"`
+	PurgeNeq *bool `json:"purgeNEQ,omitempty"`
+	// owner edge predicates
+	HasOwner     *bool                     `json:"hasOwner,omitempty"`
+	HasOwnerWith []*OrganizationWhereInput `json:"hasOwnerWith,omitempty"`
+}
+
 type Review struct {
 	ID        string     `json:"id"`
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
@@ -40225,7 +40919,9 @@ type TrustCenterFaq struct {
 	// optional reference link for the FAQ
 	ReferenceLink *string `json:"referenceLink,omitempty"`
 	// display order of the FAQ
-	DisplayOrder       *int64           `json:"displayOrder,omitempty"`
+	DisplayOrder *int64 `json:"displayOrder,omitempty"`
+	// whether the FAQ answer is approved for reuse, e.g. when answering security questionnaires
+	Approved           *bool            `json:"approved,omitempty"`
 	TrustCenterFaqKind *CustomTypeEnum  `json:"trustCenterFaqKind,omitempty"`
 	BlockedGroups      *GroupConnection `json:"blockedGroups"`
 	Editors            *GroupConnection `json:"editors"`
@@ -40483,6 +41179,11 @@ type TrustCenterFAQWhereInput struct {
 	DisplayOrderLte    *int64  `json:"displayOrderLTE,omitempty"`
 	DisplayOrderIsNil  *bool   `json:"displayOrderIsNil,omitempty"`
 	DisplayOrderNotNil *bool   `json:"displayOrderNotNil,omitempty"`
+	// approved field predicates
+	Approved       *bool `json:"approved,omitempty"`
+	ApprovedNeq    *bool `json:"approvedNEQ,omitempty"`
+	ApprovedIsNil  *bool `json:"approvedIsNil,omitempty"`
+	ApprovedNotNil *bool `json:"approvedNotNil,omitempty"`
 	// trust_center_faq_kind edge predicates
 	HasTrustCenterFaqKind     *bool                       `json:"hasTrustCenterFaqKind,omitempty"`
 	HasTrustCenterFaqKindWith []*CustomTypeEnumWhereInput `json:"hasTrustCenterFaqKindWith,omitempty"`
@@ -43451,6 +44152,29 @@ type UpdateCustomDomainInput struct {
 	ClearDNSVerification *bool   `json:"clearDNSVerification,omitempty"`
 }
 
+// UpdateCustomRoleInput is used for update CustomRole object.
+// Input was generated by ent.
+type UpdateCustomRoleInput struct {
+	// the name of the custom role
+	Name *string `json:"name,omitempty"`
+	// the description of the custom role
+	Description      *string `json:"description,omitempty"`
+	ClearDescription *bool   `json:"clearDescription,omitempty"`
+	// the permission bundles (e.g. can_manage_policies) and object permissions (e.g. can_create_evidence) granted by the role
+	Permissions       []string `json:"permissions,omitempty"`
+	AppendPermissions []string `json:"appendPermissions,omitempty"`
+	// programs the role is scoped to; when the role is scoped its permissions are limited to can_view_program, can_edit_program, can_view_group, can_edit_group and can_delete_group, granted on the programs and groups of the scope instead of the organization
+	ProgramIds       []string `json:"programIds,omitempty"`
+	AppendProgramIds []string `json:"appendProgramIds,omitempty"`
+	ClearProgramIds  *bool    `json:"clearProgramIds,omitempty"`
+	// groups the role is scoped to
+	GroupIds       []string `json:"groupIds,omitempty"`
+	AppendGroupIds []string `json:"appendGroupIds,omitempty"`
+	ClearGroupIds  *bool    `json:"clearGroupIds,omitempty"`
+	OwnerID        *string  `json:"ownerID,omitempty"`
+	ClearOwner     *bool    `json:"clearOwner,omitempty"`
+}
+
 // UpdateCustomTypeEnumInput is used for update CustomTypeEnum object.
 // Input was generated by ent.
 type UpdateCustomTypeEnumInput struct {
@@ -46303,6 +47027,12 @@ type UpdateOrganizationInput struct {
 	AddVendorRiskScoreIDs                      []string                        `json:"addVendorRiskScoreIDs,omitempty"`
 	RemoveVendorRiskScoreIDs                   []string                        `json:"removeVendorRiskScoreIDs,omitempty"`
 	ClearVendorRiskScores                      *bool                           `json:"clearVendorRiskScores,omitempty"`
+	AddRetentionRuleIDs                        []string                        `json:"addRetentionRuleIDs,omitempty"`
+	RemoveRetentionRuleIDs                     []string                        `json:"removeRetentionRuleIDs,omitempty"`
+	ClearRetentionRules                        *bool                           `json:"clearRetentionRules,omitempty"`
+	AddCustomRoleIDs                           []string                        `json:"addCustomRoleIDs,omitempty"`
+	RemoveCustomRoleIDs                        []string                        `json:"removeCustomRoleIDs,omitempty"`
+	ClearCustomRoles                           *bool                           `json:"clearCustomRoles,omitempty"`
 	AddOrgMembers                              []*CreateOrgMembershipInput     `json:"addOrgMembers,omitempty"`
 	RemoveOrgMembers                           []string                        `json:"removeOrgMembers,omitempty"`
 	UpdateOrgSettings                          *UpdateOrganizationSettingInput `json:"updateOrgSettings,omitempty"`
@@ -47069,6 +47799,28 @@ type UpdateRemediationInput struct {
 	ClearWorkflowObjectRefs    *bool          `json:"clearWorkflowObjectRefs,omitempty"`
 }
 
+// UpdateRetentionRuleInput is used for update RetentionRule object.
+// Input was generated by ent.
+type UpdateRetentionRuleInput struct {
+	// the name of the retention rule, recorded in the metadata of the files it is applied to
+	Name *string `json:"name,omitempty"`
+	// the description of the retention rule
+	Description      *string `json:"description,omitempty"`
+	ClearDescription *bool   `json:"clearDescription,omitempty"`
+	// the file category the rule applies to, e.g. evidence; empty matches any category
+	Category      *string `json:"category,omitempty"`
+	ClearCategory *bool   `json:"clearCategory,omitempty"`
+	// the type of object the file is attached to, e.g. evidence or procedure; empty matches any type
+	ObjectType      *string `json:"objectType,omitempty"`
+	ClearObjectType *bool   `json:"clearObjectType,omitempty"`
+	// the number of days matching files are retained after upload
+	RetentionDays *int64 `json:"retentionDays,omitempty"`
+	// delete matching files once the retention period ends
+	Purge      *bool   `json:"purge,omitempty"`
+	OwnerID    *string `json:"ownerID,omitempty"`
+	ClearOwner *bool   `json:"clearOwner,omitempty"`
+}
+
 // UpdateReviewInput is used for update Review object.
 // Input was generated by ent.
 type UpdateReviewInput struct {
@@ -48216,8 +48968,11 @@ type UpdateTrustCenterFAQInput struct {
 	ReferenceLink      *string `json:"referenceLink,omitempty"`
 	ClearReferenceLink *bool   `json:"clearReferenceLink,omitempty"`
 	// display order of the FAQ
-	DisplayOrder            *int64           `json:"displayOrder,omitempty"`
-	ClearDisplayOrder       *bool            `json:"clearDisplayOrder,omitempty"`
+	DisplayOrder      *int64 `json:"displayOrder,omitempty"`
+	ClearDisplayOrder *bool  `json:"clearDisplayOrder,omitempty"`
+	// whether the FAQ answer is approved for reuse, e.g. when answering security questionnaires
+	Approved                *bool            `json:"approved,omitempty"`
+	ClearApproved           *bool            `json:"clearApproved,omitempty"`
 	TrustCenterFaqKindID    *string          `json:"trustCenterFaqKindID,omitempty"`
 	ClearTrustCenterFaqKind *bool            `json:"clearTrustCenterFaqKind,omitempty"`
 	AddBlockedGroupIDs      []string         `json:"addBlockedGroupIDs,omitempty"`
@@ -54745,6 +55500,64 @@ func (e AssetOrderField) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// BatchOperationType is the kind of change made by a batch operation
+type BatchOperationType string
+
+const (
+	BatchOperationTypeCreate BatchOperationType = "CREATE"
+	BatchOperationTypeUpdate BatchOperationType = "UPDATE"
+	BatchOperationTypeDelete BatchOperationType = "DELETE"
+)
+
+var AllBatchOperationType = []BatchOperationType{
+	BatchOperationTypeCreate,
+	BatchOperationTypeUpdate,
+	BatchOperationTypeDelete,
+}
+
+func (e BatchOperationType) IsValid() bool {
+	switch e {
+	case BatchOperationTypeCreate, BatchOperationTypeUpdate, BatchOperationTypeDelete:
+		return true
+	}
+	return false
+}
+
+func (e BatchOperationType) String() string {
+	return string(e)
+}
+
+func (e *BatchOperationType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BatchOperationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BatchOperationType", str)
+	}
+	return nil
+}
+
+func (e BatchOperationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *BatchOperationType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e BatchOperationType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Properties by which Campaign connections can be ordered.
 type CampaignOrderField string
 
@@ -55428,6 +56241,64 @@ func (e CustomDomainOrderField) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Properties by which CustomRole connections can be ordered.
+type CustomRoleOrderField string
+
+const (
+	CustomRoleOrderFieldCreatedAt CustomRoleOrderField = "created_at"
+	CustomRoleOrderFieldUpdatedAt CustomRoleOrderField = "updated_at"
+	CustomRoleOrderFieldName      CustomRoleOrderField = "name"
+)
+
+var AllCustomRoleOrderField = []CustomRoleOrderField{
+	CustomRoleOrderFieldCreatedAt,
+	CustomRoleOrderFieldUpdatedAt,
+	CustomRoleOrderFieldName,
+}
+
+func (e CustomRoleOrderField) IsValid() bool {
+	switch e {
+	case CustomRoleOrderFieldCreatedAt, CustomRoleOrderFieldUpdatedAt, CustomRoleOrderFieldName:
+		return true
+	}
+	return false
+}
+
+func (e CustomRoleOrderField) String() string {
+	return string(e)
+}
+
+func (e *CustomRoleOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CustomRoleOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CustomRoleOrderField", str)
+	}
+	return nil
+}
+
+func (e CustomRoleOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *CustomRoleOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e CustomRoleOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Properties by which CustomTypeEnum connections can be ordered.
 type CustomTypeEnumOrderField string
 
@@ -58550,6 +59421,66 @@ func (e RemediationOrderField) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+// Properties by which RetentionRule connections can be ordered.
+type RetentionRuleOrderField string
+
+const (
+	RetentionRuleOrderFieldCreatedAt     RetentionRuleOrderField = "created_at"
+	RetentionRuleOrderFieldUpdatedAt     RetentionRuleOrderField = "updated_at"
+	RetentionRuleOrderFieldName          RetentionRuleOrderField = "name"
+	RetentionRuleOrderFieldRetentionDays RetentionRuleOrderField = "retention_days"
+)
+
+var AllRetentionRuleOrderField = []RetentionRuleOrderField{
+	RetentionRuleOrderFieldCreatedAt,
+	RetentionRuleOrderFieldUpdatedAt,
+	RetentionRuleOrderFieldName,
+	RetentionRuleOrderFieldRetentionDays,
+}
+
+func (e RetentionRuleOrderField) IsValid() bool {
+	switch e {
+	case RetentionRuleOrderFieldCreatedAt, RetentionRuleOrderFieldUpdatedAt, RetentionRuleOrderFieldName, RetentionRuleOrderFieldRetentionDays:
+		return true
+	}
+	return false
+}
+
+func (e RetentionRuleOrderField) String() string {
+	return string(e)
+}
+
+func (e *RetentionRuleOrderField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RetentionRuleOrderField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RetentionRuleOrderField", str)
+	}
+	return nil
+}
+
+func (e RetentionRuleOrderField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RetentionRuleOrderField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RetentionRuleOrderField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 // Properties by which Review connections can be ordered.
 type ReviewOrderField string
 
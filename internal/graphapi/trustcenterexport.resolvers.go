@@ -0,0 +1,17 @@
+package graphapi
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen
+
+import (
+	"context"
+
+	"github.com/theopenlane/core/internal/graphapi/model"
+)
+
+// ExportTrustCenterSite is the resolver for the exportTrustCenterSite field.
+func (r *mutationResolver) ExportTrustCenterSite(ctx context.Context, trustCenterID string, includeProtectedDocuments *bool) (*model.TrustCenterSiteExportPayload, error) {
+	return r.exportTrustCenterSite(ctx, trustCenterID, includeProtectedDocuments)
+}
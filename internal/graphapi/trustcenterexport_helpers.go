@@ -0,0 +1,89 @@
+package graphapi
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"github.com/samber/lo"
+	"github.com/stoewer/go-strcase"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/graphapi/common"
+	"github.com/theopenlane/core/internal/graphapi/model"
+	"github.com/theopenlane/core/internal/trustcenterexport"
+)
+
+// exportTrustCenterSite renders the trust center as a static site and returns the signed archive
+func (r *mutationResolver) exportTrustCenterSite(ctx context.Context, trustCenterID string, includeProtected *bool) (*model.TrustCenterSiteExportPayload, error) {
+	action := common.Action{Action: common.ActionGet, Object: "trustcenter"}
+
+	// exports include documents that are otherwise only shared after an NDA, so editing the trust center is
+	// required rather than viewing it
+	if err := r.checkTrustCenterExportAccess(ctx, trustCenterID); err != nil {
+		return nil, err
+	}
+
+	bundle, err := trustcenterexport.Export(ctx, withTransactionalMutation(ctx), trustCenterID, trustcenterexport.Options{
+		IncludeProtected: lo.FromPtr(includeProtected),
+	}, r.trustCenterExportSigner)
+	if err != nil {
+		return nil, trustCenterExportError(ctx, err, action)
+	}
+
+	excluded := make([]*model.TrustCenterSiteExcludedDocument, 0, len(bundle.Site.Excluded))
+	for _, e := range bundle.Site.Excluded {
+		excluded = append(excluded, &model.TrustCenterSiteExcludedDocument{
+			Title:  e.Title,
+			Reason: e.Reason,
+		})
+	}
+
+	return &model.TrustCenterSiteExportPayload{
+		Filename:          bundle.Filename,
+		ContentType:       trustcenterexport.ContentType,
+		Base64:            base64.StdEncoding.EncodeToString(bundle.Data),
+		KeyID:             bundle.Manifest.KeyID,
+		DocumentCount:     len(bundle.Site.Documents),
+		ExcludedDocuments: excluded,
+	}, nil
+}
+
+// checkTrustCenterExportAccess checks the caller can edit the trust center
+func (r *mutationResolver) checkTrustCenterExportAccess(ctx context.Context, trustCenterID string) error {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil || caller.SubjectID == "" {
+		return newPermissionDeniedError()
+	}
+
+	allow, err := r.db.Authz.CheckAccess(ctx, fgax.AccessCheck{
+		ObjectType:  fgax.Kind(strcase.SnakeCase(generated.TypeTrustCenter)),
+		ObjectID:    trustCenterID,
+		Relation:    fgax.CanEdit,
+		SubjectID:   caller.SubjectID,
+		SubjectType: caller.SubjectType(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !allow {
+		return newPermissionDeniedError()
+	}
+
+	return nil
+}
+
+// trustCenterExportError maps export errors to errors returned to the user
+func trustCenterExportError(ctx context.Context, err error, a common.Action) error {
+	switch {
+	case errors.Is(err, trustcenterexport.ErrExportTooLarge):
+		return common.NewValidationError(err.Error())
+	case errors.Is(err, trustcenterexport.ErrSignerRequired):
+		return err
+	default:
+		return parseRequestError(ctx, err, a)
+	}
+}
@@ -0,0 +1,216 @@
+package graphapi_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+	is "gotest.tools/v3/assert/cmp"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/campaign"
+	"github.com/theopenlane/core/internal/ent/generated/task"
+	"github.com/theopenlane/core/internal/integrations/definitions/email"
+	systemdef "github.com/theopenlane/core/internal/integrations/definitions/system"
+	"github.com/theopenlane/core/internal/integrations/types"
+)
+
+// vendorReviewDispatcher records the campaigns dispatched by the vendor review sweep and fails the dispatch
+// while err is set
+type vendorReviewDispatcher struct {
+	err         error
+	campaignIDs []string
+}
+
+func (d *vendorReviewDispatcher) dispatch(_ context.Context, req types.DispatchRequest) (types.DispatchResult, error) {
+	if d.err != nil {
+		return types.DispatchResult{}, d.err
+	}
+
+	var input email.CampaignDispatchInput
+	if err := json.Unmarshal(req.Config, &input); err != nil {
+		return types.DispatchResult{}, err
+	}
+
+	d.campaignIDs = append(d.campaignIDs, input.CampaignID)
+
+	return types.DispatchResult{}, nil
+}
+
+// runVendorReviewSweep runs one sweep with a cap high enough that vendors created by other tests do not
+// crowd out the vendor under test
+func runVendorReviewSweep(t *testing.T, d *vendorReviewDispatcher) {
+	t.Helper()
+
+	_, err := systemdef.VendorReviewSweep{
+		RenewalLeadDays:  30,
+		SOC2GraceDays:    90,
+		MaxActionsPerRun: 10000,
+	}.Run(context.Background(), types.OperationRequest{
+		DB:       suite.client.db,
+		Dispatch: d.dispatch,
+	})
+	assert.NilError(t, err)
+}
+
+// createDueVendor creates a vendor whose review was due yesterday along with an earlier assessment campaign
+// for the sweep to repeat
+func createDueVendor(ctx context.Context, t *testing.T) (*generated.Entity, *generated.Campaign) {
+	t.Helper()
+
+	vendor := (&EntityBuilder{client: suite.client}).MustNew(ctx, t)
+	dueAt := time.Now().UTC().AddDate(0, 0, -1).Truncate(time.Second)
+
+	vendor = suite.client.db.Entity.UpdateOneID(vendor.ID).
+		SetReviewFrequency(enums.FrequencyYearly).
+		SetNextReviewAt(models.DateTime(dueAt)).
+		SaveX(ctx)
+
+	previous := suite.client.db.Campaign.Create().
+		SetName("vendor assessment " + vendor.ID).
+		SetOwnerID(vendor.OwnerID).
+		SetEntityID(vendor.ID).
+		SetCampaignType(enums.CampaignTypeVendorAssessment).
+		SetRecurrenceFrequency(enums.FrequencyNone).
+		SaveX(ctx)
+
+	suite.client.db.CampaignTarget.Create().
+		SetOwnerID(vendor.OwnerID).
+		SetCampaignID(previous.ID).
+		SetEmail("security@vendor.example").
+		ExecX(ctx)
+
+	return vendor, previous
+}
+
+func TestVendorReviewSweepLaunchesDueReview(t *testing.T) {
+	ctx := setContext(sharedTestUser1.UserCtx, suite.client.db)
+
+	vendor, previous := createDueVendor(ctx, t)
+	dueAt := time.Time(*vendor.NextReviewAt)
+
+	d := &vendorReviewDispatcher{}
+	runVendorReviewSweep(t, d)
+
+	launched, err := suite.client.db.Campaign.Query().
+		Where(campaign.EntityIDEQ(vendor.ID), campaign.IDNEQ(previous.ID)).
+		All(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(launched, 1))
+	assert.Check(t, is.DeepEqual([]string{launched[0].ID}, d.campaignIDs))
+	assert.Check(t, is.Equal(previous.ID, launched[0].Metadata["repeated_campaign_id"]))
+	assert.Check(t, is.Equal(1, launched[0].RecipientCount))
+
+	// the next review is scheduled one review period after the one that was due
+	updated, err := suite.client.db.Entity.Get(ctx, vendor.ID)
+	assert.NilError(t, err)
+	assert.Assert(t, updated.NextReviewAt != nil)
+	assert.Check(t, time.Time(*updated.NextReviewAt).Equal(dueAt.AddDate(1, 0, 0)))
+}
+
+func TestVendorReviewSweepOpensRenewalTask(t *testing.T) {
+	ctx := setContext(sharedTestUser1.UserCtx, suite.client.db)
+
+	vendor := (&EntityBuilder{client: suite.client}).MustNew(ctx, t)
+	endDate := time.Now().UTC().AddDate(0, 0, 45).Truncate(24 * time.Hour)
+
+	suite.client.db.Entity.UpdateOneID(vendor.ID).
+		SetContractEndDate(models.DateTime(endDate)).
+		SetTerminationNoticeDays(30).
+		SetAutoRenews(true).
+		ExecX(ctx)
+
+	runVendorReviewSweep(t, &vendorReviewDispatcher{})
+	runVendorReviewSweep(t, &vendorReviewDispatcher{})
+
+	// the notice deadline is within the lead time, so one task is opened and a later sweep does not open another
+	tasks, err := suite.client.db.Task.Query().
+		Where(task.IdempotencyKeyEQ("vendor_review:renewal:" + vendor.ID + ":" + endDate.Format("2006-01-02"))).
+		All(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(tasks, 1))
+	assert.Check(t, time.Time(*tasks[0].Due).Equal(endDate.AddDate(0, 0, -30)))
+}
+
+func TestVendorReviewSweepDispatchFailure(t *testing.T) {
+	ctx := setContext(sharedTestUser1.UserCtx, suite.client.db)
+
+	vendor, previous := createDueVendor(ctx, t)
+	dueAt := time.Time(*vendor.NextReviewAt)
+
+	d := &vendorReviewDispatcher{err: errors.New("queue unavailable")}
+
+	// a failed dispatch leaves the review due and a retry does not create a second campaign
+	runVendorReviewSweep(t, d)
+	runVendorReviewSweep(t, d)
+
+	launched, err := suite.client.db.Campaign.Query().
+		Where(campaign.EntityIDEQ(vendor.ID), campaign.IDNEQ(previous.ID)).
+		All(ctx)
+	assert.NilError(t, err)
+	assert.Assert(t, is.Len(launched, 1))
+
+	updated, err := suite.client.db.Entity.Get(ctx, vendor.ID)
+	assert.NilError(t, err)
+	assert.Check(t, time.Time(*updated.NextReviewAt).Equal(dueAt))
+
+	// once the dispatch succeeds the campaign created by the first sweep is the one sent
+	d.err = nil
+	runVendorReviewSweep(t, d)
+
+	count, err := suite.client.db.Campaign.Query().
+		Where(campaign.EntityIDEQ(vendor.ID), campaign.IDNEQ(previous.ID)).
+		Count(ctx)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal(1, count))
+	assert.Check(t, is.DeepEqual([]string{launched[0].ID}, d.campaignIDs))
+
+	updated, err = suite.client.db.Entity.Get(ctx, vendor.ID)
+	assert.NilError(t, err)
+	assert.Check(t, time.Time(*updated.NextReviewAt).Equal(dueAt.AddDate(1, 0, 0)))
+}
+
+func TestVendorReviewSweepSOC2Lapse(t *testing.T) {
+	ctx := setContext(sharedTestUser1.UserCtx, suite.client.db)
+
+	vendor := (&EntityBuilder{client: suite.client}).MustNew(ctx, t)
+	periodEnd := time.Now().UTC().AddDate(0, 0, -120).Truncate(24 * time.Hour)
+
+	suite.client.db.Entity.UpdateOneID(vendor.ID).
+		SetHasSoc2(true).
+		SetSoc2PeriodEnd(models.DateTime(periodEnd)).
+		ExecX(ctx)
+
+	runVendorReviewSweep(t, &vendorReviewDispatcher{})
+
+	// the lapse is recorded without changing the SOC 2 flag set on the vendor
+	updated, err := suite.client.db.Entity.Get(ctx, vendor.ID)
+	assert.NilError(t, err)
+	assert.Check(t, updated.HasSoc2)
+	assert.Assert(t, updated.Soc2LapsedAt != nil)
+
+	lapsedAt := *updated.Soc2LapsedAt
+
+	runVendorReviewSweep(t, &vendorReviewDispatcher{})
+
+	tasks, err := suite.client.db.Task.Query().
+		Where(task.IdempotencyKeyEQ("vendor_review:soc2:" + vendor.ID + ":" + periodEnd.Format("2006-01-02"))).
+		Count(ctx)
+	assert.NilError(t, err)
+	assert.Check(t, is.Equal(1, tasks))
+
+	updated, err = suite.client.db.Entity.Get(ctx, vendor.ID)
+	assert.NilError(t, err)
+	assert.Check(t, is.DeepEqual(lapsedAt, *updated.Soc2LapsedAt))
+
+	// recording a newer reporting period ends the lapse
+	updated = suite.client.db.Entity.UpdateOneID(vendor.ID).
+		SetSoc2PeriodEnd(models.DateTime(time.Now().UTC().AddDate(0, 0, -10))).
+		SaveX(ctx)
+	assert.Check(t, updated.Soc2LapsedAt == nil)
+}
@@ -34,6 +34,8 @@ type subscriberKey struct {
 type Manager struct {
 	mu          sync.RWMutex
 	subscribers map[subscriberKey][]chan Notification // map of session to list of notification channels
+	// objectSubscribers holds the object change subscriptions of each org, keyed by org and topic
+	objectSubscribers map[objectKey][]chan ObjectEvent
 
 	// redisClient, when set via WithRedis, distributes published notifications to other processes over Redis
 	redisClient *redis.Client
@@ -53,8 +55,9 @@ type redisEnvelope struct {
 // NewManager creates a new subscription manager
 func NewManager() *Manager {
 	m := &Manager{
-		subscribers: make(map[subscriberKey][]chan Notification),
-		instanceID:  uuid.NewString(),
+		subscribers:       make(map[subscriberKey][]chan Notification),
+		objectSubscribers: make(map[objectKey][]chan ObjectEvent),
+		instanceID:        uuid.NewString(),
 	}
 
 	// Set as global manager
@@ -74,9 +77,10 @@ func (sm *Manager) WithRedis(client *redis.Client) *Manager {
 	return sm
 }
 
-// subscribeRedis listens for notifications published by other processes and forwards them to local subscribers
+// subscribeRedis listens for notifications and object changes published by other processes and forwards them
+// to local subscribers
 func (sm *Manager) subscribeRedis(ctx context.Context) {
-	pubsub := sm.redisClient.Subscribe(ctx, redisChannelName)
+	pubsub := sm.redisClient.Subscribe(ctx, redisChannelName, redisObjectChannelName)
 	defer pubsub.Close()
 
 	if _, err := pubsub.Receive(ctx); err != nil {
@@ -96,6 +100,11 @@ func (sm *Manager) subscribeRedis(ctx context.Context) {
 				return
 			}
 
+			if msg.Channel == redisObjectChannelName {
+				sm.receiveObjectEvent(msg.Payload)
+				continue
+			}
+
 			var env redisEnvelope
 			if err := json.Unmarshal([]byte(msg.Payload), &env); err != nil {
 				log.Error().Err(err).Msg("graphsubscriptions: failed to unmarshal redis notification envelope")
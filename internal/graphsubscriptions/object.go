@@ -0,0 +1,140 @@
+package graphsubscriptions
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ObjectChannelBufferSize is the buffer size for object change subscription channels
+const ObjectChannelBufferSize = 10
+
+// redisObjectChannelName is the pub/sub channel used to distribute object changes across processes
+const redisObjectChannelName = "graphsubscriptions:objects"
+
+const (
+	// TopicControl is the topic of control changes
+	TopicControl = "Control"
+	// TopicTask is the topic of task changes
+	TopicTask = "Task"
+	// TopicWorkflowInstance is the topic of workflow instance changes
+	TopicWorkflowInstance = "WorkflowInstance"
+	// TopicIntegrationRun is the topic of integration run progress
+	TopicIntegrationRun = "IntegrationRun"
+)
+
+// ObjectEvent announces a change to an object that subscribers can follow. The event only carries the
+// identity of the object; subscribers reload the object with their own context so the permissions of
+// each subscriber are applied to what they receive
+type ObjectEvent struct {
+	// Topic is the object type that changed
+	Topic string `json:"topic"`
+	// ID is the id of the changed object
+	ID string `json:"id"`
+	// OrgID is the organization owning the object
+	OrgID string `json:"org_id"`
+	// Operation is the mutation operation that changed the object
+	Operation string `json:"operation"`
+}
+
+// objectKey identifies the object change subscriptions of a topic within an org
+type objectKey struct {
+	orgID string
+	topic string
+}
+
+// objectEnvelope is the payload published to the Redis object channel
+type objectEnvelope struct {
+	OriginID string      `json:"origin_id"`
+	Event    ObjectEvent `json:"event"`
+}
+
+// SubscribeObjects adds a subscriber for changes to objects of the topic owned by the org
+func (sm *Manager) SubscribeObjects(orgID, topic string, ch chan ObjectEvent) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	key := objectKey{orgID: orgID, topic: topic}
+
+	sm.objectSubscribers[key] = append(sm.objectSubscribers[key], ch)
+	log.Debug().Str("instance_id", sm.instanceID).Str("org_id", orgID).Str("topic", topic).Int("subscriber_count", len(sm.objectSubscribers[key])).Msg("graphsubscriptions: subscribed to object changes")
+}
+
+// UnsubscribeObjects removes an object change subscriber and closes its channel
+func (sm *Manager) UnsubscribeObjects(orgID, topic string, ch chan ObjectEvent) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	key := objectKey{orgID: orgID, topic: topic}
+
+	channels := sm.objectSubscribers[key]
+
+	if i := slices.Index(channels, ch); i >= 0 {
+		sm.objectSubscribers[key] = slices.Delete(channels, i, i+1)
+		close(ch)
+	}
+
+	if len(sm.objectSubscribers[key]) == 0 {
+		delete(sm.objectSubscribers, key)
+	}
+}
+
+// PublishObject sends an object change to the subscribers of its org and topic in this process, and, when
+// Redis is configured, to subscribers of other processes as well
+func (sm *Manager) PublishObject(event ObjectEvent) error {
+	if event.OrgID == "" || event.Topic == "" || event.ID == "" {
+		log.Debug().Str("topic", event.Topic).Str("id", event.ID).Msg("graphsubscriptions: object change has no org, topic or id, nothing to route to")
+		return nil
+	}
+
+	sm.dispatchObjectLocal(event)
+
+	if sm.redisClient == nil {
+		return nil
+	}
+
+	envelope, err := json.Marshal(objectEnvelope{OriginID: sm.instanceID, Event: event})
+	if err != nil {
+		log.Error().Err(err).Str("topic", event.Topic).Str("id", event.ID).Msg("graphsubscriptions: failed to marshal object change envelope")
+		return nil
+	}
+
+	if _, err := sm.redisClient.Publish(context.Background(), redisObjectChannelName, envelope).Result(); err != nil {
+		log.Error().Err(err).Str("topic", event.Topic).Str("id", event.ID).Msg("graphsubscriptions: failed to publish object change to redis")
+	}
+
+	return nil
+}
+
+// receiveObjectEvent forwards an object change published by another process to local subscribers
+func (sm *Manager) receiveObjectEvent(payload string) {
+	var env objectEnvelope
+	if err := json.Unmarshal([]byte(payload), &env); err != nil {
+		log.Error().Err(err).Msg("graphsubscriptions: failed to unmarshal redis object change envelope")
+		return
+	}
+
+	// changes published by this process were already delivered locally
+	if env.OriginID == sm.instanceID {
+		return
+	}
+
+	sm.dispatchObjectLocal(env.Event)
+}
+
+// dispatchObjectLocal sends an object change to the subscribers of its org and topic within this process,
+// dropping it for subscribers whose buffer is full
+func (sm *Manager) dispatchObjectLocal(event ObjectEvent) {
+	sm.mu.RLock()
+	defer sm.mu.RUnlock()
+
+	for i, ch := range sm.objectSubscribers[objectKey{orgID: event.OrgID, topic: event.Topic}] {
+		select {
+		case ch <- event:
+		default:
+			log.Info().Int("subscriber_index", i).Str("topic", event.Topic).Msg("channel full, unable to send object change to subscriber")
+		}
+	}
+}
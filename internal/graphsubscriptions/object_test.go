@@ -0,0 +1,116 @@
+package graphsubscriptions
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// receivesObject reports whether the channel delivers a change to the object within a short wait
+func receivesObject(t *testing.T, ch chan ObjectEvent, id string) bool {
+	t.Helper()
+
+	select {
+	case event := <-ch:
+		return event.ID == id
+	case <-time.After(100 * time.Millisecond):
+		return false
+	}
+}
+
+func TestPublishObject(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager()
+
+	controls := make(chan ObjectEvent, ObjectChannelBufferSize)
+	tasks := make(chan ObjectEvent, ObjectChannelBufferSize)
+	otherOrg := make(chan ObjectEvent, ObjectChannelBufferSize)
+
+	manager.SubscribeObjects("org-a", TopicControl, controls)
+	manager.SubscribeObjects("org-a", TopicTask, tasks)
+	manager.SubscribeObjects("org-b", TopicControl, otherOrg)
+
+	require.NoError(t, manager.PublishObject(ObjectEvent{Topic: TopicControl, ID: "control-1", OrgID: "org-a", Operation: "OpUpdateOne"}))
+
+	assert.True(t, receivesObject(t, controls, "control-1"), "subscriber to the topic in the org should receive it")
+	assert.False(t, receivesObject(t, tasks, "control-1"), "subscriber to another topic should not receive it")
+	assert.False(t, receivesObject(t, otherOrg, "control-1"), "subscriber in another org should not receive it")
+}
+
+func TestPublishObjectNoRoutingTarget(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager()
+
+	ch := make(chan ObjectEvent, ObjectChannelBufferSize)
+	manager.SubscribeObjects("", TopicTask, ch)
+
+	require.NoError(t, manager.PublishObject(ObjectEvent{Topic: TopicTask, ID: "task-1"}))
+
+	assert.False(t, receivesObject(t, ch, "task-1"), "changes without an org are not delivered")
+}
+
+func TestUnsubscribeObjects(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager()
+
+	ch := make(chan ObjectEvent, ObjectChannelBufferSize)
+	manager.SubscribeObjects("org-a", TopicWorkflowInstance, ch)
+	manager.UnsubscribeObjects("org-a", TopicWorkflowInstance, ch)
+
+	manager.mu.RLock()
+	assert.Empty(t, manager.objectSubscribers)
+	manager.mu.RUnlock()
+
+	_, ok := <-ch
+	assert.False(t, ok, "channel should be closed")
+
+	// unsubscribing again is a no-op
+	manager.UnsubscribeObjects("org-a", TopicWorkflowInstance, ch)
+}
+
+func TestPublishObjectToFullChannel(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager()
+
+	ch := make(chan ObjectEvent, 1)
+	manager.SubscribeObjects("org-a", TopicIntegrationRun, ch)
+
+	require.NoError(t, manager.PublishObject(ObjectEvent{Topic: TopicIntegrationRun, ID: "run-1", OrgID: "org-a"}))
+	require.NoError(t, manager.PublishObject(ObjectEvent{Topic: TopicIntegrationRun, ID: "run-2", OrgID: "org-a"}))
+
+	assert.True(t, receivesObject(t, ch, "run-1"))
+	assert.False(t, receivesObject(t, ch, "run-2"), "changes are dropped rather than blocking the publisher")
+}
+
+func TestReceiveObjectEvent(t *testing.T) {
+	t.Parallel()
+
+	manager := NewManager()
+
+	ch := make(chan ObjectEvent, ObjectChannelBufferSize)
+	manager.SubscribeObjects("org-a", TopicTask, ch)
+
+	event := ObjectEvent{Topic: TopicTask, ID: "task-remote", OrgID: "org-a"}
+
+	own, err := json.Marshal(objectEnvelope{OriginID: manager.instanceID, Event: event})
+	require.NoError(t, err)
+
+	manager.receiveObjectEvent(string(own))
+	assert.False(t, receivesObject(t, ch, "task-remote"), "changes published by this process are already delivered")
+
+	remote, err := json.Marshal(objectEnvelope{OriginID: "another-instance", Event: event})
+	require.NoError(t, err)
+
+	manager.receiveObjectEvent(string(remote))
+	assert.True(t, receivesObject(t, ch, "task-remote"), "changes from other processes are delivered")
+
+	manager.receiveObjectEvent("not json")
+	assert.False(t, receivesObject(t, ch, "task-remote"))
+}
@@ -16,6 +16,7 @@ import (
 	ent "github.com/theopenlane/core/internal/ent/generated"
 	"github.com/theopenlane/core/internal/ent/privacy/token"
 	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
 	dbprovider "github.com/theopenlane/core/pkg/objects/storage/providers/database"
 	"github.com/theopenlane/iam/auth"
@@ -123,6 +124,12 @@ func (h *Handler) FileDownloadHandler(ctx echo.Context) error {
 
 	download, err := h.ObjectStore.Download(requestCtx, nil, storFile, &storage.DownloadOptions{})
 	if err != nil {
+		if errors.Is(err, scanner.ErrFileQuarantined) {
+			logx.FromContext(requestCtx).Debug().Str("file_id", storFile.ID).Msg("file is quarantined pending content scan")
+
+			return h.Forbidden(ctx, scanner.ErrFileQuarantined)
+		}
+
 		if errors.Is(err, dbprovider.ErrFileNotFound) || ent.IsNotFound(err) {
 			logx.FromContext(requestCtx).Debug().Err(err).Msg("file not found in storage")
 
@@ -194,6 +201,8 @@ func buildStorageFile(fileEntity *ent.File) *storagetypes.File {
 				KnownProvider: storagetypes.ProviderType(fileEntity.StorageProvider),
 			},
 		},
+		Metadata:   fileEntity.Metadata,
+		ScanStatus: fileEntity.ScanStatus,
 	}
 
 	return storFile
@@ -0,0 +1,194 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"time"
+
+	echo "github.com/theopenlane/echox"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/iam/fgax"
+	"github.com/theopenlane/utils/rout"
+
+	models "github.com/theopenlane/core/common/openapi"
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/middleware/transaction"
+	"github.com/theopenlane/core/pkg/objects/retention"
+)
+
+const (
+	// eventTypeLegalHoldPlaced is the event type recorded when a legal hold is placed on a file
+	eventTypeLegalHoldPlaced = "file.legal_hold_placed"
+	// eventTypeLegalHoldReleased is the event type recorded when a legal hold is released from a file
+	eventTypeLegalHoldReleased = "file.legal_hold_released"
+)
+
+// ErrFileNotOwnedByOrganization is returned when a legal hold is requested for a file without an owning organization
+var ErrFileNotOwnedByOrganization = errors.New("legal holds can only be placed on organization owned files")
+
+// FileLegalHoldPlace places a legal hold on a file, blocking deletes and overwrites until it is released
+func (h *Handler) FileLegalHoldPlace(ctx echo.Context) error {
+	return h.fileLegalHold(ctx, true)
+}
+
+// FileLegalHoldRelease releases the legal hold on a file; any remaining retention period still applies
+func (h *Handler) FileLegalHoldRelease(ctx echo.Context) error {
+	return h.fileLegalHold(ctx, false)
+}
+
+// fileLegalHold updates the legal hold recorded against a file, mirrors it to the storage provider when
+// object lock is supported, and records an event for the audit trail; a failure after the database update
+// reverts the change in both places
+func (h *Handler) fileLegalHold(ctx echo.Context, place bool) error {
+	in, err := BindAndValidate[models.FileLegalHoldRequest](ctx)
+	if err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	reqCtx := ctx.Request().Context()
+
+	caller, ok := auth.CallerFromContext(reqCtx)
+	if !ok || caller == nil {
+		return h.Unauthorized(ctx, auth.ErrNoAuthUser)
+	}
+
+	fileEntity, err := transaction.FromContext(reqCtx).File.Get(reqCtx, in.ID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return h.NotFound(ctx, ErrNotFound)
+		}
+
+		logx.FromContext(reqCtx).Error().Err(err).Str("file_id", in.ID).Msg("error retrieving file for legal hold")
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	allowCtx := privacy.DecisionContext(reqCtx, privacy.Allow)
+
+	orgIDs, err := fileEntity.QueryOrganization().IDs(allowCtx)
+	if err != nil {
+		logx.FromContext(reqCtx).Error().Err(err).Str("file_id", in.ID).Msg("error retrieving file organization for legal hold")
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if len(orgIDs) == 0 {
+		return h.BadRequest(ctx, ErrFileNotOwnedByOrganization)
+	}
+
+	// legal holds are restricted to organization administrators rather than anyone who can edit the file
+	allowed, err := h.DBClient.Authz.CheckAccess(reqCtx, fgax.AccessCheck{
+		SubjectType: caller.SubjectType(),
+		SubjectID:   caller.SubjectID,
+		Relation:    fgax.CanEdit,
+		ObjectID:    orgIDs[0],
+		ObjectType:  fgax.Kind(ent.TypeOrganization),
+	})
+	if err != nil {
+		logx.FromContext(reqCtx).Error().Err(err).Str("file_id", in.ID).Msg("error checking legal hold access")
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	if !allowed {
+		return h.Forbidden(ctx, ErrUnauthorized)
+	}
+
+	metadata := maps.Clone(fileEntity.Metadata)
+	eventType := eventTypeLegalHoldReleased
+
+	if place {
+		metadata, err = retention.PlaceLegalHold(metadata, in.Reason, caller.SubjectID, time.Now())
+		if err != nil {
+			return h.BadRequest(ctx, err)
+		}
+
+		eventType = eventTypeLegalHoldPlaced
+	} else {
+		metadata = retention.ReleaseLegalHold(metadata)
+	}
+
+	// the hold is recorded in the request transaction before it is mirrored to the storage provider so a
+	// storage failure never leaves the provider holding a file the database does not
+	managedCtx := retention.WithManagedUpdate(allowCtx)
+
+	if err := transaction.FromContext(reqCtx).File.UpdateOneID(fileEntity.ID).
+		SetMetadata(metadata).
+		Exec(managedCtx); err != nil {
+		logx.FromContext(reqCtx).Error().Err(err).Str("file_id", in.ID).Msg("error recording legal hold")
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	storageHold := h.ObjectStore != nil && fileEntity.StoragePath != ""
+
+	if storageHold {
+		if err := h.ObjectStore.SetLegalHold(reqCtx, buildStorageFile(fileEntity), place); err != nil {
+			logx.FromContext(reqCtx).Error().Err(err).Str("file_id", in.ID).Msg("error updating legal hold in object storage")
+
+			h.revertLegalHold(managedCtx, fileEntity, place, false)
+
+			return h.InternalServerError(ctx, ErrProcessingRequest)
+		}
+	}
+
+	state := retention.StateFromMetadata(metadata)
+
+	if err := h.recordLegalHoldEvent(allowCtx, eventType, fileEntity.ID, orgIDs[0], caller.SubjectID, state); err != nil {
+		h.revertLegalHold(managedCtx, fileEntity, place, storageHold)
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	out := &models.FileLegalHoldResponse{
+		Reply:     rout.Reply{Success: true},
+		FileID:    fileEntity.ID,
+		LegalHold: state.LegalHold,
+		Reason:    state.LegalHoldReason,
+	}
+
+	if !state.RetainUntil.IsZero() {
+		out.RetainUntil = &state.RetainUntil
+	}
+
+	return h.Success(ctx, out)
+}
+
+// revertLegalHold restores the metadata the file had before the legal hold change and, when the change was
+// already applied by the storage provider, reverses it there; the error responses of the handler do not roll
+// back the request transaction so the previous state is written back explicitly
+func (h *Handler) revertLegalHold(ctx context.Context, fileEntity *ent.File, place, storageHold bool) {
+	if storageHold {
+		if err := h.ObjectStore.SetLegalHold(ctx, buildStorageFile(fileEntity), !place); err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("file_id", fileEntity.ID).Msg("error reverting legal hold in object storage")
+		}
+	}
+
+	if err := transaction.FromContext(ctx).File.UpdateOneID(fileEntity.ID).
+		SetMetadata(fileEntity.Metadata).
+		Exec(ctx); err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("file_id", fileEntity.ID).Msg("error reverting legal hold")
+	}
+}
+
+// recordLegalHoldEvent records the legal hold change as an event so it is retained in the audit trail
+func (h *Handler) recordLegalHoldEvent(ctx context.Context, eventType, fileID, orgID, subjectID string, state retention.State) error {
+	metadata := map[string]any{
+		"file_id":    fileID,
+		"subject_id": subjectID,
+	}
+
+	maps.Copy(metadata, state.Metadata())
+
+	_, err := h.createEvent(ctx, ent.CreateEventInput{
+		EventType:       eventType,
+		Metadata:        metadata,
+		OrganizationIDs: []string{orgID},
+		FileIDs:         []string{fileID},
+	})
+
+	return err
+}
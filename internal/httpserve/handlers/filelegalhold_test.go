@@ -0,0 +1,158 @@
+package handlers_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/theopenlane/httpsling"
+
+	models "github.com/theopenlane/core/common/openapi"
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/objects/upload"
+	pkgobjects "github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+func (suite *HandlerTestSuite) TestFileLegalHold() {
+	t := suite.T()
+
+	retentionStore := suite.createDatabaseStoreWithPresignConfig(storage.ProviderConfig{
+		Enabled: true,
+		Providers: storage.Providers{
+			Database: storage.ProviderConfigs{Enabled: true},
+		},
+		Retention: retention.Config{
+			Enabled: true,
+		},
+	}, testTokenIssuer)
+
+	originalStore := suite.objectStore
+	originalHandlerStore := suite.h.ObjectStore
+
+	suite.objectStore = retentionStore
+	suite.h.ObjectStore = retentionStore
+
+	t.Cleanup(func() {
+		suite.objectStore = originalStore
+		suite.h.ObjectStore = originalHandlerStore
+	})
+
+	suite.registerTestHandler(http.MethodPut, "files/:id/legal-hold", suite.h.FileLegalHoldPlace)
+	suite.registerTestHandler(http.MethodDelete, "files/:id/legal-hold", suite.h.FileLegalHoldRelease)
+
+	// retention rules are defined by the organization that owns the file; the rule of another organization
+	// matches the file more specifically but must not be applied
+	_, err := suite.db.RetentionRule.Create().
+		SetName("organization-files").
+		SetRetentionDays(1).
+		Save(testUser1.UserCtx)
+	require.NoError(t, err)
+
+	_, err = suite.db.RetentionRule.Create().
+		SetName("other-organization-files").
+		SetObjectType("organization").
+		SetRetentionDays(365).
+		Save(testUser2.UserCtx)
+	require.NoError(t, err)
+
+	uploadCtx := privacy.DecisionContext(testUser1.UserCtx, privacy.Allow)
+	uploadCtx = ent.NewContext(uploadCtx, suite.db)
+
+	_, uploadedFiles, err := upload.HandleUploads(uploadCtx, suite.objectStore, []pkgobjects.File{
+		{
+			RawFile:              bytes.NewReader([]byte("retained evidence")),
+			OriginalName:         "evidence.txt",
+			FieldName:            "uploadFile",
+			CorrelatedObjectID:   testUser1.OrganizationID,
+			CorrelatedObjectType: "organization",
+			FileMetadata: pkgobjects.FileMetadata{
+				ContentType: "text/plain",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, uploadedFiles, 1)
+
+	fileID := uploadedFiles[0].ID
+
+	fileRecord, err := suite.db.File.Get(uploadCtx, fileID)
+	require.NoError(t, err)
+
+	state := retention.StateFromMetadata(fileRecord.Metadata)
+	assert.Equal(t, "organization-files", state.Rule)
+	assert.True(t, state.Retained(time.Now()))
+
+	t.Run("retained file cannot be deleted", func(t *testing.T) {
+		err := suite.db.File.DeleteOneID(fileID).Exec(uploadCtx)
+		assert.ErrorIs(t, err, retention.ErrRetentionActive)
+	})
+
+	t.Run("retention metadata cannot be edited", func(t *testing.T) {
+		err := suite.db.File.UpdateOneID(fileID).SetMetadata(map[string]any{}).Exec(uploadCtx)
+		assert.ErrorIs(t, err, retention.ErrRetentionMetadataImmutable)
+	})
+
+	t.Run("retention metadata cannot be cleared", func(t *testing.T) {
+		err := suite.db.File.UpdateOneID(fileID).ClearMetadata().Exec(uploadCtx)
+		assert.ErrorIs(t, err, retention.ErrRetentionMetadataImmutable)
+	})
+
+	legalHold := func(method string, body models.FileLegalHoldRequest) (*httptest.ResponseRecorder, *models.FileLegalHoldResponse) {
+		payload, err := json.Marshal(body)
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(method, "/files/"+fileID+"/legal-hold", strings.NewReader(string(payload)))
+		req.Header.Set(httpsling.HeaderContentType, httpsling.ContentTypeJSONUTF8)
+
+		recorder := httptest.NewRecorder()
+
+		suite.e.ServeHTTP(recorder, req.WithContext(testUser1.UserCtx))
+
+		var out *models.FileLegalHoldResponse
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+		return recorder, out
+	}
+
+	t.Run("placing a legal hold requires a reason", func(t *testing.T) {
+		recorder, out := legalHold(http.MethodPut, models.FileLegalHoldRequest{})
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+		assert.False(t, out.Success)
+	})
+
+	t.Run("place legal hold", func(t *testing.T) {
+		recorder, out := legalHold(http.MethodPut, models.FileLegalHoldRequest{Reason: "pending litigation"})
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.True(t, out.Success)
+		assert.True(t, out.LegalHold)
+		assert.Equal(t, "pending litigation", out.Reason)
+		assert.NotNil(t, out.RetainUntil)
+
+		err := suite.db.File.DeleteOneID(fileID).Exec(uploadCtx)
+		assert.ErrorIs(t, err, retention.ErrLegalHoldActive)
+	})
+
+	t.Run("release legal hold", func(t *testing.T) {
+		recorder, out := legalHold(http.MethodDelete, models.FileLegalHoldRequest{})
+
+		require.Equal(t, http.StatusOK, recorder.Code)
+		assert.True(t, out.Success)
+		assert.False(t, out.LegalHold)
+
+		fileRecord, err := suite.db.File.Get(uploadCtx, fileID)
+		require.NoError(t, err)
+
+		assert.False(t, retention.StateFromMetadata(fileRecord.Metadata).LegalHold)
+	})
+}
@@ -23,7 +23,9 @@ import (
 	"github.com/theopenlane/core/pkg/entitlements"
 	"github.com/theopenlane/core/pkg/logx"
 	"github.com/theopenlane/core/pkg/metrics"
+	"github.com/theopenlane/core/pkg/objects/tus"
 	"github.com/theopenlane/core/pkg/shortlinks"
+	"github.com/theopenlane/core/pkg/signedbundle"
 	"github.com/theopenlane/core/pkg/summarizer"
 )
 
@@ -69,8 +71,12 @@ type Handler struct {
 	Summarizer *summarizer.Client
 	// DefaultTrustCenterDomain is the default domain to use for the trust center if no custom domain is set
 	DefaultTrustCenterDomain string
+	// TrustCenterExportSigner signs the manifests of trust center site exports
+	TrustCenterExportSigner *signedbundle.Signer
 	// ObjectStore handles file storage operations
 	ObjectStore *objects.Service
+	// ResumableUploads serves the tus resumable upload endpoints, nil when disabled
+	ResumableUploads *tus.Handler
 	// IntegrationsRuntime holds the integration runtime components.
 	IntegrationsRuntime *integrationsruntime.Runtime
 	// IntegrationsConfig contains environment-backed operator configuration for built-in integrations.
@@ -0,0 +1,224 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/stoewer/go-strcase"
+	echo "github.com/theopenlane/echox"
+	"github.com/theopenlane/iam/auth"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/internal/objects"
+	"github.com/theopenlane/core/internal/objects/store"
+	"github.com/theopenlane/core/internal/objects/upload"
+	"github.com/theopenlane/core/pkg/logx"
+	pkgobjects "github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/storage"
+	"github.com/theopenlane/core/pkg/objects/tus"
+)
+
+const (
+	// resumableUploadFieldName is the upload key recorded on files received through the resumable endpoints
+	resumableUploadFieldName = "uploadFile"
+	// resumableMetadataFilename is the Upload-Metadata key carrying the original file name
+	resumableMetadataFilename = "filename"
+	// resumableMetadataParentID is the Upload-Metadata key carrying the parent object id
+	resumableMetadataParentID = "parent_id"
+	// resumableMetadataParentType is the Upload-Metadata key carrying the parent object type
+	resumableMetadataParentType = "parent_type"
+)
+
+var (
+	// ErrResumableUploadsDisabled is returned when the resumable upload endpoints are not configured
+	ErrResumableUploadsDisabled = errors.New("resumable uploads are not enabled")
+	// ErrResumableUploadMissingParent is returned when a resumable upload does not declare its parent object
+	ErrResumableUploadMissingParent = errors.New("upload metadata must include parent_id and parent_type")
+	// ErrResumableUploadMissingFilename is returned when a resumable upload does not declare its file name
+	ErrResumableUploadMissingFilename = errors.New("upload metadata must include filename")
+	// ErrResumableUploadFailed is returned when a completed upload could not be persisted
+	ErrResumableUploadFailed = errors.New("failed to persist resumable upload")
+)
+
+// NewResumableUploadHandler builds the tus handler used for resumable uploads, keeping upload state in the database,
+// staging chunks through the configured object storage and handing completed uploads to the object store
+func (h *Handler) NewResumableUploadHandler(cfg storage.ResumableConfig) (*tus.Handler, error) {
+	if h.ObjectStore == nil || h.DBClient == nil {
+		return nil, ErrObjectStoreUnavailable
+	}
+
+	return tus.NewHandler(tus.Config{
+		Store:      objects.NewResumableStore(h.DBClient.Driver(), h.ObjectStore),
+		MaxSize:    cfg.MaxSizeMB * 1024 * 1024, //nolint:mnd
+		Expiration: cfg.Expiration,
+		Caller:     resumableUploadCaller,
+		Validate:   h.validateResumableUpload,
+		Complete:   h.completeResumableUpload,
+	}), nil
+}
+
+// ResumableUploadOptions advertises the supported tus protocol version and extensions
+func (h *Handler) ResumableUploadOptions(ctx echo.Context) error {
+	if h.ResumableUploads == nil {
+		return h.NotFound(ctx, ErrResumableUploadsDisabled)
+	}
+
+	h.ResumableUploads.Options(ctx.Response(), ctx.Request())
+
+	return nil
+}
+
+// ResumableUploadCreate starts a new resumable upload
+func (h *Handler) ResumableUploadCreate(ctx echo.Context) error {
+	if h.ResumableUploads == nil {
+		return h.NotFound(ctx, ErrResumableUploadsDisabled)
+	}
+
+	h.ResumableUploads.Create(ctx.Response(), ctx.Request())
+
+	return nil
+}
+
+// ResumableUploadHead returns the current offset of a resumable upload
+func (h *Handler) ResumableUploadHead(ctx echo.Context) error {
+	if h.ResumableUploads == nil {
+		return h.NotFound(ctx, ErrResumableUploadsDisabled)
+	}
+
+	h.ResumableUploads.Head(ctx.Response(), ctx.Request(), ctx.PathParam("id"))
+
+	return nil
+}
+
+// ResumableUploadPatch appends a chunk to a resumable upload, persisting the file once all bytes are received
+func (h *Handler) ResumableUploadPatch(ctx echo.Context) error {
+	if h.ResumableUploads == nil {
+		return h.NotFound(ctx, ErrResumableUploadsDisabled)
+	}
+
+	h.ResumableUploads.Patch(ctx.Response(), ctx.Request(), ctx.PathParam("id"))
+
+	return nil
+}
+
+// ResumableUploadDelete terminates a resumable upload and discards the staged bytes
+func (h *Handler) ResumableUploadDelete(ctx echo.Context) error {
+	if h.ResumableUploads == nil {
+		return h.NotFound(ctx, ErrResumableUploadsDisabled)
+	}
+
+	h.ResumableUploads.Delete(ctx.Response(), ctx.Request(), ctx.PathParam("id"))
+
+	return nil
+}
+
+// resumableUploadCaller maps the authenticated caller to the owner recorded on a resumable upload
+func resumableUploadCaller(ctx context.Context) (tus.Caller, error) {
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil {
+		return tus.Caller{}, auth.ErrNoAuthUser
+	}
+
+	orgID, _ := caller.ActiveOrg()
+
+	return tus.Caller{
+		SubjectID:      caller.SubjectID,
+		OrganizationID: orgID,
+	}, nil
+}
+
+// validateResumableUpload ensures the upload declares a parent object the caller can edit
+func (h *Handler) validateResumableUpload(ctx context.Context, info tus.Info) error {
+	if info.Metadata[resumableMetadataFilename] == "" {
+		return errors.Join(tus.ErrInvalidMetadata, ErrResumableUploadMissingFilename)
+	}
+
+	parentID := info.Metadata[resumableMetadataParentID]
+	parentType := info.Metadata[resumableMetadataParentType]
+
+	if parentID == "" || parentType == "" {
+		return errors.Join(tus.ErrInvalidMetadata, ErrResumableUploadMissingParent)
+	}
+
+	caller, ok := auth.CallerFromContext(ctx)
+	if !ok || caller == nil {
+		return tus.ErrUploadForbidden
+	}
+
+	req := fgax.AccessCheck{
+		SubjectType: caller.SubjectType(),
+		SubjectID:   caller.SubjectID,
+		Relation:    fgax.CanEdit,
+		ObjectID:    parentID,
+		ObjectType:  fgax.Kind(strcase.SnakeCase(parentType)),
+	}
+
+	allow, err := h.DBClient.Authz.CheckAccess(ctx, req)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Interface("access_request", req).Msg("error checking resumable upload parent access")
+
+		return err
+	}
+
+	if !allow {
+		return tus.ErrUploadForbidden
+	}
+
+	return nil
+}
+
+// completeResumableUpload hands a fully received upload to the object store and returns the new file id
+func (h *Handler) completeResumableUpload(ctx context.Context, info tus.Info, file io.ReadSeeker) (string, error) {
+	if h.ObjectStore == nil {
+		return "", ErrObjectStoreUnavailable
+	}
+
+	// the declared file type is client input, so the content type is detected from the assembled bytes
+	contentType, err := storage.DetectContentType(file)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("upload_id", info.ID).Msg("failed to detect resumable upload content type")
+
+		return "", err
+	}
+
+	parent := pkgobjects.ParentObject{
+		ID:   info.Metadata[resumableMetadataParentID],
+		Type: info.Metadata[resumableMetadataParentType],
+	}
+
+	in := pkgobjects.File{
+		RawFile:              file,
+		OriginalName:         info.Metadata[resumableMetadataFilename],
+		FieldName:            resumableUploadFieldName,
+		CorrelatedObjectID:   parent.ID,
+		CorrelatedObjectType: parent.Type,
+		Parent:               parent,
+		FileMetadata: pkgobjects.FileMetadata{
+			Key:         resumableUploadFieldName,
+			ContentType: contentType,
+			Size:        info.Size,
+		},
+	}
+
+	uploadCtx, uploaded, err := upload.HandleUploads(ctx, h.ObjectStore, []pkgobjects.File{in})
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("upload_id", info.ID).Msg("failed to persist resumable upload")
+
+		return "", err
+	}
+
+	if len(uploaded) == 0 {
+		return "", ErrResumableUploadFailed
+	}
+
+	if _, err := store.AddFilePermissions(uploadCtx); err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("upload_id", info.ID).Msg("could not add fga permissions for resumable upload")
+
+		upload.HandleRollback(uploadCtx, h.ObjectStore, uploaded)
+
+		return "", err
+	}
+
+	return uploaded[0].ID, nil
+}
@@ -124,7 +124,25 @@ func (h *Handler) handleRoleMutation(ctx echo.Context, isDeleteOp bool) error {
 		return h.BadRequest(ctx, ErrInvalidInput)
 	}
 
-	tuples := convertOrgRolesToTuples(orgID, in.Role, in.UserIDs, in.GroupIDs)
+	var tuples []fgax.TupleKey
+
+	switch {
+	case in.CustomRoleID != "":
+		ok, err := h.isOrganizationCustomRole(reqCtx, orgID, in.CustomRoleID)
+		if err != nil {
+			logx.FromContext(reqCtx).Error().Err(err).Str("organization_id", orgID).Str("custom_role_id", in.CustomRoleID).Msg("error checking custom role")
+			return h.InternalServerError(ctx, ErrProcessingRequest)
+		}
+
+		if !ok {
+			return h.BadRequest(ctx, ErrInvalidInput)
+		}
+
+		tuples = convertCustomRoleToTuples(in.CustomRoleID, in.UserIDs, in.GroupIDs)
+	default:
+		tuples = convertOrgRolesToTuples(orgID, in.Role, in.UserIDs, in.GroupIDs)
+	}
+
 	writes := tuples
 	var deletes []fgax.TupleKey
 	if isDeleteOp {
@@ -133,7 +151,7 @@ func (h *Handler) handleRoleMutation(ctx echo.Context, isDeleteOp bool) error {
 	}
 
 	if _, err := h.DBClient.Authz.WriteTupleKeys(reqCtx, writes, deletes); err != nil {
-		logx.FromContext(reqCtx).Error().Err(err).Str("organization_id", orgID).Str("role", in.Role).Msg("error updating organization roles")
+		logx.FromContext(reqCtx).Error().Err(err).Str("organization_id", orgID).Str("role", in.Role).Str("custom_role_id", in.CustomRoleID).Msg("error updating organization roles")
 		return h.InternalServerError(ctx, ErrProcessingRequest)
 	}
 
@@ -141,6 +159,18 @@ func (h *Handler) handleRoleMutation(ctx echo.Context, isDeleteOp bool) error {
 		Reply:          rout.Reply{Success: true},
 		OrganizationID: orgID,
 		Role:           in.Role,
+		CustomRoleID:   in.CustomRoleID,
+	})
+}
+
+// isOrganizationCustomRole checks the custom role was defined by the organization
+func (h *Handler) isOrganizationCustomRole(ctx context.Context, orgID, customRoleID string) (bool, error) {
+	return h.DBClient.Authz.CheckAccess(ctx, fgax.AccessCheck{
+		SubjectID:   orgID,
+		SubjectType: generated.TypeOrganization,
+		ObjectID:    customRoleID,
+		ObjectType:  fgax.Kind(fgamodel.CustomRoleType),
+		Relation:    fgax.ParentContextRelation,
 	})
 }
 
@@ -202,3 +232,36 @@ func convertOrgRolesToTuples(orgID, role string, userIDs, groupIDs []string) []f
 
 	return tuples
 }
+
+func convertCustomRoleToTuples(customRoleID string, userIDs, groupIDs []string) []fgax.TupleKey {
+	tuples := make([]fgax.TupleKey, 0, len(userIDs)+len(groupIDs))
+	object := fgax.Entity{
+		Kind:       fgax.Kind(fgamodel.CustomRoleType),
+		Identifier: customRoleID,
+	}
+
+	for _, userID := range userIDs {
+		tuples = append(tuples, fgax.TupleKey{
+			Subject: fgax.Entity{
+				Kind:       fgax.Kind(generated.TypeUser),
+				Identifier: userID,
+			},
+			Object:   object,
+			Relation: fgax.Relation(fgamodel.CustomRoleAssigneeRelation),
+		})
+	}
+
+	for _, groupID := range groupIDs {
+		tuples = append(tuples, fgax.TupleKey{
+			Subject: fgax.Entity{
+				Kind:       fgax.Kind(generated.TypeGroup),
+				Identifier: groupID,
+				Relation:   fgax.Relation(fgax.MemberRelation),
+			},
+			Object:   object,
+			Relation: fgax.Relation(fgamodel.CustomRoleAssigneeRelation),
+		})
+	}
+
+	return tuples
+}
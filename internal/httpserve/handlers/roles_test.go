@@ -13,9 +13,11 @@ import (
 	"github.com/theopenlane/httpsling"
 	"github.com/theopenlane/iam/auth"
 	"github.com/theopenlane/iam/fgax"
+	"github.com/theopenlane/utils/ulids"
 
 	"github.com/theopenlane/core/common/enums"
 	models "github.com/theopenlane/core/common/openapi"
+	fgamodel "github.com/theopenlane/core/fga/model"
 	"github.com/theopenlane/core/internal/ent/generated"
 	"github.com/theopenlane/core/internal/ent/generated/privacy"
 )
@@ -82,6 +84,18 @@ func (suite *HandlerTestSuite) TestOrganizationRolesAssignmentHandler() {
 	memberCtx = privacy.DecisionContext(memberCtx, privacy.Allow)
 	memberCtx = generated.NewContext(memberCtx, suite.db)
 
+	customRoleID := ulids.New().String()
+	_, err = suite.db.Authz.WriteTupleKeys(ctx, []fgax.TupleKey{
+		fgax.GetTupleKey(fgax.TupleRequest{
+			SubjectID:   testUser1.OrganizationID,
+			SubjectType: generated.TypeOrganization,
+			ObjectID:    customRoleID,
+			ObjectType:  fgamodel.CustomRoleType,
+			Relation:    fgax.ParentContextRelation,
+		}),
+	}, nil)
+	require.NoError(t, err)
+
 	cases := []struct {
 		name       string
 		method     string
@@ -150,6 +164,54 @@ func (suite *HandlerTestSuite) TestOrganizationRolesAssignmentHandler() {
 			statusCode: http.StatusOK,
 			success:    true,
 		},
+		{
+			name:   "assign custom role to a user and group",
+			method: http.MethodPost,
+			request: models.OrganizationRolesRequest{
+				OrganizationID: testUser1.OrganizationID,
+				CustomRoleID:   customRoleID,
+				UserIDs:        []string{testUser2.ID},
+				GroupIDs:       []string{group.ID},
+			},
+			ctx:        ownerCtx,
+			statusCode: http.StatusOK,
+			success:    true,
+		},
+		{
+			name:   "delete custom role from a user",
+			method: http.MethodDelete,
+			request: models.OrganizationRolesRequest{
+				OrganizationID: testUser1.OrganizationID,
+				CustomRoleID:   customRoleID,
+				UserIDs:        []string{testUser2.ID},
+			},
+			ctx:        ownerCtx,
+			statusCode: http.StatusOK,
+			success:    true,
+		},
+		{
+			name:   "custom role of another organization",
+			method: http.MethodPost,
+			request: models.OrganizationRolesRequest{
+				OrganizationID: testUser1.OrganizationID,
+				CustomRoleID:   ulids.New().String(),
+				UserIDs:        []string{testUser2.ID},
+			},
+			ctx:        ownerCtx,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			name:   "role and custom role are exclusive",
+			method: http.MethodPost,
+			request: models.OrganizationRolesRequest{
+				OrganizationID: testUser1.OrganizationID,
+				Role:           "policy_manager",
+				CustomRoleID:   customRoleID,
+				UserIDs:        []string{testUser2.ID},
+			},
+			ctx:        ownerCtx,
+			statusCode: http.StatusBadRequest,
+		},
 		{
 			name:   "invalid role",
 			method: http.MethodPost,
@@ -233,6 +295,7 @@ func (suite *HandlerTestSuite) TestOrganizationRolesAssignmentHandler() {
 
 			assert.Equal(t, tc.request.OrganizationID, out.OrganizationID)
 			assert.Equal(t, tc.request.Role, out.Role)
+			assert.Equal(t, tc.request.CustomRoleID, out.CustomRoleID)
 		})
 	}
 }
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"errors"
+
+	echo "github.com/theopenlane/echox"
+	"github.com/theopenlane/utils/rout"
+
+	models "github.com/theopenlane/core/common/openapi"
+	"github.com/theopenlane/core/internal/ent/fulltext"
+	"github.com/theopenlane/core/internal/entdb"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// Search runs a ranked full-text search across every searchable object type and the contents of their uploaded
+// documents and returns the matches merged by relevance; only objects the caller can read are returned
+func (h *Handler) Search(ctx echo.Context) error {
+	in, err := BindAndValidate[models.SearchRequest](ctx)
+	if err != nil {
+		return h.InvalidInput(ctx, err)
+	}
+
+	// search only reads, so it is served by the read replicas when they are configured
+	reqCtx := entdb.WithReplicaRead(ctx.Request().Context())
+
+	results, err := fulltext.Search(reqCtx, h.DBClient, in.Query, fulltext.Options{
+		Types: in.Types,
+		Limit: in.Limit,
+	})
+	if err != nil {
+		if errors.Is(err, fulltext.ErrUnknownType) {
+			return h.BadRequest(ctx, err)
+		}
+
+		logx.FromContext(reqCtx).Error().Err(err).Msg("error running search")
+
+		return h.InternalServerError(ctx, ErrProcessingRequest)
+	}
+
+	out := &models.SearchResponse{
+		Reply:   rout.Reply{Success: true},
+		Results: make([]models.SearchResult, 0, len(results)),
+	}
+
+	for _, r := range results {
+		out.Results = append(out.Results, models.SearchResult{Type: r.Type, ID: r.ID, Rank: r.Rank, FileID: r.FileID})
+	}
+
+	return h.Success(ctx, out)
+}
@@ -0,0 +1,210 @@
+package handlers_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	models "github.com/theopenlane/core/common/openapi"
+	"github.com/theopenlane/core/internal/ent/fulltext"
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+)
+
+func (suite *HandlerTestSuite) TestSearch() {
+	t := suite.T()
+
+	suite.registerTestHandler(http.MethodGet, "search", suite.h.Search)
+
+	allowCtx := ent.NewContext(privacy.DecisionContext(testUser1.UserCtx, privacy.Allow), suite.db)
+
+	exact, err := suite.db.Contact.Create().
+		SetFullName("Zephyrine Quorvex").
+		SetOwnerID(testUser1.OrganizationID).
+		Save(allowCtx)
+	require.NoError(t, err)
+
+	partial, err := suite.db.Contact.Create().
+		SetFullName("Zephyrine Mallow").
+		SetOwnerID(testUser1.OrganizationID).
+		Save(allowCtx)
+	require.NoError(t, err)
+
+	search := func(ctx context.Context, params url.Values) (*httptest.ResponseRecorder, *models.SearchResponse) {
+		req := httptest.NewRequest(http.MethodGet, "/search?"+params.Encode(), nil)
+
+		recorder := httptest.NewRecorder()
+
+		suite.e.ServeHTTP(recorder, req.WithContext(ctx))
+
+		var out *models.SearchResponse
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+		return recorder, out
+	}
+
+	resultIDs := func(out *models.SearchResponse) []string {
+		ids := make([]string, 0, len(out.Results))
+		for _, r := range out.Results {
+			ids = append(ids, r.ID)
+		}
+
+		return ids
+	}
+
+	testCases := []struct {
+		name       string
+		ctx        context.Context
+		params     url.Values
+		wantStatus int
+		wantIDs    []string
+	}{
+		{
+			name:       "every word matches as a prefix",
+			ctx:        testUser1.UserCtx,
+			params:     url.Values{"q": {"zephyr quorv"}},
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{exact.ID},
+		},
+		{
+			name:       "matches across results",
+			ctx:        testUser1.UserCtx,
+			params:     url.Values{"q": {"zephyrine"}, "types": {"Contact"}},
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{exact.ID, partial.ID},
+		},
+		{
+			name:       "phrase must match in order",
+			ctx:        testUser1.UserCtx,
+			params:     url.Values{"q": {`"quorvex zephyrine"`}},
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{},
+		},
+		{
+			name:       "other organizations are not searched",
+			ctx:        testUser2.UserCtx,
+			params:     url.Values{"q": {"zephyrine"}},
+			wantStatus: http.StatusOK,
+			wantIDs:    []string{},
+		},
+		{
+			name:       "query too short",
+			ctx:        testUser1.UserCtx,
+			params:     url.Values{"q": {"ze"}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown type",
+			ctx:        testUser1.UserCtx,
+			params:     url.Values{"q": {"zephyrine"}, "types": {"Spaceship"}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder, out := search(tc.ctx, tc.params)
+			require.Equal(t, tc.wantStatus, recorder.Code)
+
+			if tc.wantStatus != http.StatusOK {
+				assert.False(t, out.Success)
+				return
+			}
+
+			assert.True(t, out.Success)
+			assert.ElementsMatch(t, tc.wantIDs, resultIDs(out))
+
+			for _, r := range out.Results {
+				assert.Equal(t, "Contact", r.Type)
+				assert.Positive(t, r.Rank)
+			}
+		})
+	}
+}
+
+func (suite *HandlerTestSuite) TestSearchDocuments() {
+	t := suite.T()
+
+	suite.registerTestHandler(http.MethodGet, "search", suite.h.Search)
+
+	allowCtx := ent.NewContext(privacy.DecisionContext(testUser1.UserCtx, privacy.Allow), suite.db)
+
+	report, err := suite.db.File.Create().
+		SetProvidedFileName("pentest-report.pdf").
+		SetProvidedFileExtension("pdf").
+		SetDetectedContentType("application/pdf").
+		AddOrganizationIDs(testUser1.OrganizationID).
+		Save(allowCtx)
+	require.NoError(t, err)
+
+	evidence, err := suite.db.Evidence.Create().
+		SetName("Annual assessment").
+		SetOwnerID(testUser1.OrganizationID).
+		AddFileIDs(report.ID).
+		Save(allowCtx)
+	require.NoError(t, err)
+
+	require.NoError(t, fulltext.IndexDocument(allowCtx, suite.db, fulltext.Document{
+		FileID:   report.ID,
+		Checksum: "d41d8cd98f00b204e9800998ecf8427e",
+		Content:  "Findings of the quarterly xylophagous penetration test",
+	}))
+
+	search := func(ctx context.Context, params url.Values) *models.SearchResponse {
+		req := httptest.NewRequest(http.MethodGet, "/search?"+params.Encode(), nil)
+
+		recorder := httptest.NewRecorder()
+
+		suite.e.ServeHTTP(recorder, req.WithContext(ctx))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var out *models.SearchResponse
+		require.NoError(t, json.NewDecoder(recorder.Body).Decode(&out))
+
+		return out
+	}
+
+	// the document matches are returned as the objects the file is attached to
+	out := search(testUser1.UserCtx, url.Values{"q": {"xylophag penetration"}})
+	require.Len(t, out.Results, 1)
+	assert.Equal(t, "Evidence", out.Results[0].Type)
+	assert.Equal(t, evidence.ID, out.Results[0].ID)
+	assert.Equal(t, report.ID, out.Results[0].FileID)
+	assert.Positive(t, out.Results[0].Rank)
+
+	out = search(testUser1.UserCtx, url.Values{"q": {"xylophagous"}, "types": {"Procedure"}})
+	assert.Empty(t, out.Results)
+
+	out = search(testUser2.UserCtx, url.Values{"q": {"xylophagous"}})
+	assert.Empty(t, out.Results)
+
+	// reindexing replaces the text of the earlier version
+	require.NoError(t, fulltext.IndexDocument(allowCtx, suite.db, fulltext.Document{
+		FileID:  report.ID,
+		Content: "Replaced report",
+	}))
+
+	out = search(testUser1.UserCtx, url.Values{"q": {"xylophagous"}})
+	assert.Empty(t, out.Results)
+
+	out = search(testUser1.UserCtx, url.Values{"q": {"replaced report"}})
+	require.Len(t, out.Results, 1)
+
+	// the text of a soft deleted file is no longer searched, even before the listener removes it from the index
+	require.NoError(t, suite.db.File.DeleteOneID(report.ID).Exec(allowCtx))
+
+	out = search(testUser1.UserCtx, url.Values{"q": {"replaced report"}})
+	assert.Empty(t, out.Results)
+
+	require.NoError(t, fulltext.RemoveDocument(allowCtx, suite.db, report.ID))
+
+	_, found, err := fulltext.DocumentChecksum(allowCtx, suite.db, report.ID)
+	require.NoError(t, err)
+	assert.False(t, found)
+}
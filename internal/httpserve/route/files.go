@@ -67,3 +67,109 @@ func registerFileDownloadHandler(router *Router) error {
 
 	return router.AddV1HandlerRoute(config)
 }
+
+// registerFileLegalHoldHandlers registers the routes to place and release legal holds on files
+func registerFileLegalHoldHandlers(router *Router) error {
+	configs := []Config{
+		{
+			Path:        "/files/:id/legal-hold",
+			Method:      http.MethodPut,
+			Name:        "File Legal Hold Place",
+			Description: handlers.AuthEndpointDesc("Place", "a legal hold on a file, blocking deletes and overwrites"),
+			Tags:        []string{"files"},
+			OperationID: "FileLegalHoldPlace",
+			Security:    handlers.AuthenticatedSecurity,
+			Middlewares: *authenticatedEndpoint,
+			Handler:     router.Handler.FileLegalHoldPlace,
+		},
+		{
+			Path:        "/files/:id/legal-hold",
+			Method:      http.MethodDelete,
+			Name:        "File Legal Hold Release",
+			Description: handlers.AuthEndpointDesc("Release", "the legal hold on a file"),
+			Tags:        []string{"files"},
+			OperationID: "FileLegalHoldRelease",
+			Security:    handlers.AuthenticatedSecurity,
+			Middlewares: *authenticatedEndpoint,
+			Handler:     router.Handler.FileLegalHoldRelease,
+		},
+	}
+
+	for _, config := range configs {
+		if err := router.AddV1HandlerRoute(config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// registerResumableUploadHandlers registers the tus resumable upload routes
+// it is *ONLY* registered when resumable uploads are enabled in the object storage config
+func registerResumableUploadHandlers(router *Router) error {
+	configs := []Config{
+		{
+			Path:        "/uploads",
+			Method:      http.MethodOptions,
+			Name:        "Resumable Upload Options",
+			Description: "Advertise the supported tus protocol version, extensions and maximum upload size",
+			Tags:        []string{"files"},
+			OperationID: "ResumableUploadOptions",
+			Security:    handlers.AuthenticatedSecurity,
+			Middlewares: *authenticatedEndpoint,
+			Handler:     router.Handler.ResumableUploadOptions,
+		},
+		{
+			Path:        "/uploads",
+			Method:      http.MethodPost,
+			Name:        "Resumable Upload Create",
+			Description: handlers.AuthEndpointDesc("Create", "resumable uploads for large files"),
+			Tags:        []string{"files"},
+			OperationID: "ResumableUploadCreate",
+			Security:    handlers.AuthenticatedSecurity,
+			Middlewares: *authenticatedEndpoint,
+			Handler:     router.Handler.ResumableUploadCreate,
+		},
+		{
+			Path:        "/uploads/:id",
+			Method:      http.MethodHead,
+			Name:        "Resumable Upload Offset",
+			Description: handlers.AuthEndpointDesc("Retrieve", "the current offset of a resumable upload"),
+			Tags:        []string{"files"},
+			OperationID: "ResumableUploadHead",
+			Security:    handlers.AuthenticatedSecurity,
+			Middlewares: *authenticatedEndpoint,
+			Handler:     router.Handler.ResumableUploadHead,
+		},
+		{
+			Path:        "/uploads/:id",
+			Method:      http.MethodPatch,
+			Name:        "Resumable Upload Chunk",
+			Description: handlers.AuthEndpointDesc("Append", "a chunk to a resumable upload"),
+			Tags:        []string{"files"},
+			OperationID: "ResumableUploadPatch",
+			Security:    handlers.AuthenticatedSecurity,
+			Middlewares: *authenticatedEndpoint,
+			Handler:     router.Handler.ResumableUploadPatch,
+		},
+		{
+			Path:        "/uploads/:id",
+			Method:      http.MethodDelete,
+			Name:        "Resumable Upload Terminate",
+			Description: handlers.AuthEndpointDesc("Terminate", "a resumable upload"),
+			Tags:        []string{"files"},
+			OperationID: "ResumableUploadDelete",
+			Security:    handlers.AuthenticatedSecurity,
+			Middlewares: *authenticatedEndpoint,
+			Handler:     router.Handler.ResumableUploadDelete,
+		},
+	}
+
+	for _, config := range configs {
+		if err := router.AddV1HandlerRoute(config); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -426,6 +426,7 @@ func RegisterRoutes(router *Router) error {
 		registerRefreshHandler,
 		registerLogoutHandler,
 		registerJwksWellKnownHandler,
+		registerTrustCenterExportKeysHandler,
 		registerOpenIDConfigurationHandler,
 		registerInviteHandler,
 		registerGithubLoginHandler,
@@ -478,6 +479,8 @@ func RegisterRoutes(router *Router) error {
 		registerSupportCallbackHandler,
 		registerProductCatalogHandler,
 		registerFileDownloadHandler,
+		registerFileLegalHoldHandlers,
+		registerSearchHandler,
 		registerIntegrationWebhookHandler,
 		registerSCIMRoutes,
 		registerEmailTestSendHandler,
@@ -506,6 +509,10 @@ func RegisterRoutes(router *Router) error {
 		routeHandlers = append(routeHandlers, registerUploadsHandler)
 	}
 
+	if router.Handler != nil && router.Handler.ResumableUploads != nil {
+		routeHandlers = append(routeHandlers, registerResumableUploadHandlers)
+	}
+
 	for _, route := range routeHandlers {
 		if err := route.(func(*Router) error)(router); err != nil {
 			return err
@@ -0,0 +1,24 @@
+package route
+
+import (
+	"net/http"
+
+	"github.com/theopenlane/core/internal/httpserve/handlers"
+)
+
+// registerSearchHandler registers the ranked full-text search route
+func registerSearchHandler(router *Router) error {
+	config := Config{
+		Path:        "/search",
+		Method:      http.MethodGet,
+		Name:        "Search",
+		Description: handlers.AuthEndpointDesc("Search", "objects across all searchable types, ranked by relevance"),
+		Tags:        []string{"search"},
+		OperationID: "Search",
+		Security:    handlers.AuthenticatedSecurity,
+		Middlewares: *authenticatedEndpoint,
+		Handler:     router.Handler.Search,
+	}
+
+	return router.AddV1HandlerRoute(config)
+}
@@ -60,6 +60,33 @@ func registerJwksWellKnownHandler(router *Router) (err error) {
 	return router.AddUnversionedHandlerRoute(config)
 }
 
+// registerTrustCenterExportKeysHandler publishes the keys that sign trust center site exports so recipients of
+// an offline snapshot can verify it
+func registerTrustCenterExportKeysHandler(router *Router) (err error) {
+	config := Config{
+		Path:        "/.well-known/trust-center-export-keys.json",
+		Method:      http.MethodGet,
+		Name:        "TrustCenterExportKeys",
+		Description: "JSON Web Key Set for verifying trust center site export manifests",
+		Tags:        []string{"well-known", "trustcenter"},
+		OperationID: "TrustCenterExportKeys",
+		Security:    handlers.PublicSecurity,
+		Middlewares: *publicEndpoint,
+		RateLimit:   publicStaticRateLimit,
+		Handler: func(ctx echo.Context) error {
+			keys := []map[string]string{}
+
+			if signer := router.Handler.TrustCenterExportSigner; signer != nil {
+				keys = append(keys, signer.JWK())
+			}
+
+			return ctx.JSON(http.StatusOK, map[string]any{"keys": keys})
+		},
+	}
+
+	return router.AddUnversionedHandlerRoute(config)
+}
+
 // registerOpenIDConfigurationHandler supplies the OIDC discovery document
 func registerOpenIDConfigurationHandler(router *Router) (err error) {
 	config := Config{
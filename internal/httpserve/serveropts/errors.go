@@ -7,3 +7,6 @@ var ErrNoSigningKeys = errors.New("no signing keys found in key directory")
 
 // ErrBackfillRequiresGala is returned when startup backfills are enabled without the gala runtime
 var ErrBackfillRequiresGala = errors.New("backfill enabled without gala runtime: enable workflows.gala or disable backfill")
+
+// ErrKeyLifetimeTooShort is returned when a rotated signing key would expire before the tokens it signs
+var ErrKeyLifetimeTooShort = errors.New("signing key lifetime is too short")
@@ -2,6 +2,7 @@ package serveropts
 
 import (
 	"context"
+	"io"
 
 	"github.com/rs/zerolog/log"
 	"github.com/samber/lo"
@@ -12,6 +13,7 @@ import (
 	"github.com/theopenlane/core/internal/workflows/engine"
 	"github.com/theopenlane/core/pkg/gala"
 	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/middleware/transaction"
 )
 
 // NewGalaRuntimes creates the main and notification gala runtimes from configuration.
@@ -31,6 +33,42 @@ func NewGalaRuntimes(ctx context.Context, so *ServerOptions) (*gala.Gala, *gala.
 		galaQueueName = gala.DefaultQueueName
 	}
 
+	var (
+		deadLetters gala.DeadLetterStore
+		outbox      gala.OutboxStore
+		outboxTx    gala.OutboxTxFunc
+	)
+
+	closeStores := func() {
+		for _, store := range []any{deadLetters, outbox} {
+			if closer, ok := store.(io.Closer); ok {
+				_ = closer.Close()
+			}
+		}
+	}
+
+	if galaCfg.DeadLetters {
+		store, err := gala.NewPostgresDeadLetterStore(ctx, so.Config.Settings.DB.PrimaryDBSource)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		deadLetters = store
+	}
+
+	if galaCfg.Outbox {
+		// the outbox lives in the application database so envelopes are written in the mutation transactions
+		store, err := gala.NewPostgresOutboxStore(ctx, so.Config.Settings.DB.PrimaryDBSource)
+		if err != nil {
+			closeStores()
+
+			return nil, nil, err
+		}
+
+		outbox = store
+		outboxTx = galaOutboxTx
+	}
+
 	galaApp, err := gala.NewGala(ctx, gala.Config{
 		ConnectionURI:    so.Config.Settings.JobQueue.ConnectionURI,
 		QueueName:        galaQueueName,
@@ -38,8 +76,13 @@ func NewGalaRuntimes(ctx context.Context, so *ServerOptions) (*gala.Gala, *gala.
 		MaxRetries:       galaCfg.MaxRetries,
 		TopicRenames:     jobTopicRenames(so),
 		OperationRenames: jobOperationRenames(),
+		DeadLetterStore:  deadLetters,
+		Outbox:           outbox,
+		OutboxTx:         outboxTx,
 	})
 	if err != nil {
+		closeStores()
+
 		return nil, nil, err
 	}
 
@@ -58,6 +101,17 @@ func NewGalaRuntimes(ctx context.Context, so *ServerOptions) (*gala.Gala, *gala.
 	return galaApp, notificationGala, nil
 }
 
+// galaOutboxTx returns the driver of the ent transaction of the context, so outbox writes join the transaction
+// of the graph request or REST handler emitting them
+func galaOutboxTx(ctx context.Context) gala.OutboxExecer {
+	tx := transaction.Current(ctx)
+	if tx == nil {
+		return nil
+	}
+
+	return tx.Client().Driver()
+}
+
 // ConfigureGala wires the gala runtimes to the database client and registers all listeners;
 // it must be called after the database client is created
 func ConfigureGala(galaApp, notificationGala *gala.Gala, dbClient *ent.Client) error {
@@ -100,12 +154,15 @@ func ConfigureGala(galaApp, notificationGala *gala.Gala, dbClient *ent.Client) e
 		hooks.VendorScoringListeners(),
 		hooks.IdentityResolutionListeners(),
 		hooks.DocumentAssociationListeners(),
+		hooks.DocumentContentListeners(),
+		hooks.AssuranceReportListeners(),
 		hooks.QuestionnaireTransformListeners(),
 		hooks.CampaignRecurringListeners(),
 		hooks.SubscriberLinkListeners(),
 		hooks.NDAAttestationListeners(),
 		hooks.DomainScanListeners(),
 		hooks.IntegrationCleanupListeners(),
+		hooks.ObjectSubscriptionListeners(),
 	})
 
 	if _, err := gala.Register(galaApp, registrations...); err != nil {
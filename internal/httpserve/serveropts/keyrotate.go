@@ -0,0 +1,184 @@
+package serveropts
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/theopenlane/iam/tokens"
+)
+
+const (
+	// DefaultKeyLifetime is the validity of the certificate written for a rotated signing key
+	DefaultKeyLifetime = 90 * 24 * time.Hour
+
+	// keyCommonName is the subject of the certificates written for rotated signing keys
+	keyCommonName = "openlane token signing key"
+)
+
+// KeyDirKey describes a signing key in the key directory
+type KeyDirKey struct {
+	// KID is the key id tokens signed with the key carry
+	KID string `json:"kid"`
+	// Path is the path of the key file
+	Path string `json:"path"`
+	// NotBefore is the start of the certificate validity, zero for keys without a certificate
+	NotBefore time.Time `json:"notBefore,omitzero"`
+	// NotAfter is the end of the certificate validity, zero for keys without a certificate
+	NotAfter time.Time `json:"notAfter,omitzero"`
+	// Signing reports whether the issuer signs new tokens with the key
+	Signing bool `json:"signing"`
+}
+
+// ListKeyDir returns the keys in the key directory, marking the one the issuer signs with given the token config
+func ListKeyDir(dir string, conf tokens.Config) ([]KeyDirKey, error) {
+	entries, err := scanKeyDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	kid, _ := chooseSigningKID(entries, signingMargin(conf))
+
+	keys := make([]KeyDirKey, 0, len(entries))
+	for _, entry := range entries {
+		keys = append(keys, KeyDirKey{
+			KID:       entry.kid,
+			Path:      entry.path,
+			NotBefore: entry.notBefore,
+			NotAfter:  entry.notAfter,
+			Signing:   entry.kid == kid,
+		})
+	}
+
+	slices.SortFunc(keys, func(a, b KeyDirKey) int {
+		return a.NotAfter.Compare(b.NotAfter)
+	})
+
+	return keys, nil
+}
+
+// RotateKeyDir writes a new ed25519 signing key with a self-signed certificate valid for lifetime to the key
+// directory. The key watcher of each replica picks it up and hands signing over once the certificate is old
+// enough for the new key to have reached validator caches; the previous keys keep verifying until they are pruned
+func RotateKeyDir(dir string, conf tokens.Config, lifetime time.Duration) (KeyDirKey, error) {
+	if lifetime <= signingMargin(conf)+minCertAge {
+		return KeyDirKey{}, fmt.Errorf("%w: %s is not longer than the %s tokens stay valid", ErrKeyLifetimeTooShort, lifetime, signingMargin(conf)+minCertAge)
+	}
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return KeyDirKey{}, err
+	}
+
+	kid, err := thumbprintKID(publicKey)
+	if err != nil {
+		return KeyDirKey{}, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128)) //nolint:mnd
+	if err != nil {
+		return KeyDirKey{}, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: keyCommonName},
+		NotBefore:    now,
+		NotAfter:     now.Add(lifetime),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, publicKey, privateKey)
+	if err != nil {
+		return KeyDirKey{}, err
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return KeyDirKey{}, err
+	}
+
+	// the certificate goes first so the key is never scanned without its validity window
+	if err := writeKeyFile(dir, kid+certExtension, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})); err != nil {
+		return KeyDirKey{}, err
+	}
+
+	path := filepath.Join(dir, kid+pemExtension)
+
+	if err := writeKeyFile(dir, kid+pemExtension, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})); err != nil {
+		return KeyDirKey{}, err
+	}
+
+	return KeyDirKey{KID: kid, Path: path, NotBefore: tmpl.NotBefore, NotAfter: tmpl.NotAfter}, nil
+}
+
+// PruneKeyDir removes the keys whose certificate expired before now along with their certificates and returns
+// their kids; every token such a key signed has expired with it. Keys without a certificate are kept, and
+// nothing is removed when no key would be left
+func PruneKeyDir(dir string, now time.Time) ([]string, error) {
+	entries, err := scanKeyDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []keyDirEntry
+
+	for _, entry := range entries {
+		if entry.hasCert && entry.notAfter.Before(now) {
+			expired = append(expired, entry)
+		}
+	}
+
+	if len(expired) == len(entries) {
+		return nil, ErrNoSigningKeys
+	}
+
+	removed := make([]string, 0, len(expired))
+
+	for _, entry := range expired {
+		if err := os.Remove(entry.path); err != nil {
+			return removed, err
+		}
+
+		if err := os.Remove(strings.TrimSuffix(entry.path, pemExtension) + certExtension); err != nil && !os.IsNotExist(err) {
+			return removed, err
+		}
+
+		removed = append(removed, entry.kid)
+	}
+
+	return removed, nil
+}
+
+// writeKeyFile writes the file to the directory through a rename so the key watcher never reads it partially written
+func writeKeyFile(dir, name string, data []byte) error {
+	f, err := os.CreateTemp(dir, "."+name+"-*")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+
+		return err
+	}
+
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), filepath.Join(dir, name))
+}
@@ -0,0 +1,97 @@
+package serveropts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotateKeyDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Now()
+
+	currentKID := writeKeyWithCert(t, dir, "current", now.Add(-30*day), 90*day)
+
+	key, err := RotateKeyDir(dir, baseConfig(), DefaultKeyLifetime)
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, key.KID+pemExtension), key.Path)
+	require.FileExists(t, filepath.Join(dir, key.KID+certExtension))
+
+	keys, err := ListKeyDir(dir, baseConfig())
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	// the current key keeps signing until the new certificate has had time to propagate
+	require.Equal(t, currentKID, keys[0].KID)
+	require.True(t, keys[0].Signing)
+	require.Equal(t, key.KID, keys[1].KID)
+	require.False(t, keys[1].Signing)
+
+	// once it has, the new key signs since it has the most headroom
+	conf, err := tokenConfigFromKeyDir(baseConfig(), dir)
+	require.NoError(t, err)
+	require.Contains(t, conf.Keys, key.KID)
+
+	entries, err := scanKeyDir(dir)
+	require.NoError(t, err)
+
+	for i := range entries {
+		entries[i].notBefore = entries[i].notBefore.Add(-2 * minCertAge)
+	}
+
+	kid, healthy := chooseSigningKID(entries, signingMargin(baseConfig()))
+	require.Equal(t, key.KID, kid)
+	require.True(t, healthy)
+}
+
+func TestRotateKeyDirLifetimeTooShort(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	_, err := RotateKeyDir(dir, baseConfig(), 7*day)
+	require.ErrorIs(t, err, ErrKeyLifetimeTooShort)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestPruneKeyDir(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Now()
+
+	expiredKID := writeKeyWithCert(t, dir, "expired", now.Add(-100*day), 90*day)
+	liveKID := writeKeyWithCert(t, dir, "live", now.Add(-30*day), 90*day)
+	bareKID, _ := writeKey(t, dir, "bare")
+
+	removed, err := PruneKeyDir(dir, now)
+	require.NoError(t, err)
+	require.Equal(t, []string{expiredKID}, removed)
+	require.NoFileExists(t, filepath.Join(dir, "expired"+pemExtension))
+	require.NoFileExists(t, filepath.Join(dir, "expired"+certExtension))
+
+	keys, err := ListKeyDir(dir, baseConfig())
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{liveKID, bareKID}, []string{keys[0].KID, keys[1].KID})
+}
+
+func TestPruneKeyDirKeepsLastKey(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	now := time.Now()
+
+	writeKeyWithCert(t, dir, "expired", now.Add(-100*day), 90*day)
+
+	_, err := PruneKeyDir(dir, now)
+	require.ErrorIs(t, err, ErrNoSigningKeys)
+	require.FileExists(t, filepath.Join(dir, "expired"+pemExtension))
+}
@@ -0,0 +1,41 @@
+package serveropts
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/theopenlane/iam/fgax"
+
+	"github.com/theopenlane/core/pkg/entitlements"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// NewLicenseProvider verifies the offline license file of a self-hosted deployment and grants its modules; the
+// licenseexpiry system sweep reloads it and revokes the modules once it expires. nil is returned when no license is configured
+func NewLicenseProvider(ctx context.Context, cfg entitlements.LicenseConfig, rc *redis.Client, fgaClient *fgax.Client) (*entitlements.LicenseProvider, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	checker := entitlements.NewTupleChecker(
+		entitlements.WithRedisClient(rc),
+		entitlements.WithFGAClient(entitlements.NewFGAFeatureClient(fgaClient)),
+	)
+
+	provider, err := entitlements.NewLicenseProvider(cfg, checker)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := provider.Apply(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	l := provider.License()
+	logx.FromContext(ctx).Info().Str("license_id", l.ID).Str("organization_id", l.OrganizationID).
+		Time("expires_at", l.ExpiresAt).Str("status", string(status)).Msg("license loaded")
+
+	return provider, nil
+}
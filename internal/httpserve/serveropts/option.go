@@ -51,6 +51,7 @@ import (
 	"github.com/theopenlane/core/pkg/middleware/secure"
 	"github.com/theopenlane/core/pkg/objects/storage"
 	"github.com/theopenlane/core/pkg/shortlinks"
+	"github.com/theopenlane/core/pkg/signedbundle"
 	"github.com/theopenlane/core/pkg/summarizer"
 )
 
@@ -290,6 +291,7 @@ func WithGraphRoute(srv *server.Server, c *ent.Client) ServerOption {
 			WithWorkflowsConfig(s.Config.Settings.Workflows).
 			WithTrustCenterCnameTarget(s.Config.Settings.Server.TrustCenterCnameTarget).
 			WithTrustCenterDefaultDomain(s.Config.Settings.Server.DefaultTrustCenterDomain).
+			WithTrustCenterExportSigner(s.Config.Handler.TrustCenterExportSigner).
 			WithSubscriptions(s.Config.Settings.Server.EnableGraphSubscriptions, subscriptionRedisClient).
 			WithAllowedOrigins(s.Config.Settings.Server.CORS.AllowOrigins).
 			WithAuthOptions(getAuthOptions(s)...).
@@ -356,6 +358,34 @@ func WithDefaultTrustCenterDomain() ServerOption {
 	})
 }
 
+// WithTrustCenterExportSigner loads the key used to sign trust center site exports; signed exports are
+// disabled when no key file is configured
+func WithTrustCenterExportSigner() ServerOption {
+	return newApplyFunc(func(s *ServerOptions) {
+		keyFile := s.Config.Settings.Server.TrustCenterExportKeyFile
+
+		if keyFile == "" {
+			// a generated key would change on every restart and differ between replicas, so exports signed with it
+			// could not be verified against the published key set
+			log.Warn().Msg("no trust center export signing key configured, trust center site exports are disabled")
+
+			return
+		}
+
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			log.Panic().Err(err).Msg("unable to read trust center export signing key")
+		}
+
+		key, err := signedbundle.ParsePrivateKey(data)
+		if err != nil {
+			log.Panic().Err(err).Msg("unable to parse trust center export signing key")
+		}
+
+		s.Config.Handler.TrustCenterExportSigner = signedbundle.NewSigner(key)
+	})
+}
+
 // WithSessionManager sets up the default session manager with a 10 minute ttl
 // with persistence to redis
 func WithSessionManager(rc *redis.Client) ServerOption {
@@ -544,6 +574,10 @@ func WithObjectStorage() ServerOption {
 		cfg := s.Config.Settings.ObjectStorage
 		authTokenCfg := s.Config.Settings.Auth.Token
 
+		if err := cfg.Retention.Validate(); err != nil {
+			log.Fatal().Err(err).Msg("invalid object storage retention configuration")
+		}
+
 		// Create StorageService with resolver and cp using runtime config
 		storageService := resolver.NewServiceFromConfig(cfg,
 			resolver.WithPresignConfig(func() *tokens.TokenManager {
@@ -570,6 +604,25 @@ func WithObjectStorage() ServerOption {
 	})
 }
 
+// WithResumableUploads sets up the resumable upload endpoints; upload state is kept in the database, so it must
+// be added once the database client is on the handler. Expired uploads are removed by the scheduled resumable
+// upload cleanup sweep
+func WithResumableUploads() ServerOption {
+	return newApplyFunc(func(s *ServerOptions) {
+		cfg := s.Config.Settings.ObjectStorage.Resumable
+		if !cfg.Enabled {
+			return
+		}
+
+		resumable, err := s.Config.Handler.NewResumableUploadHandler(cfg)
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to initialize resumable uploads")
+		}
+
+		s.Config.Handler.ResumableUploads = resumable
+	})
+}
+
 // WithEntitlements sets up the entitlements client for the server which currently only supports stripe
 func WithEntitlements() ServerOption {
 	return newApplyFunc(func(s *ServerOptions) {
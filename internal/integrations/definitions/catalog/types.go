@@ -49,4 +49,18 @@ type Config struct {
 	PaymentReminder system.PaymentReminderConfig `json:"paymentreminder" koanf:"paymentreminder"`
 	// OrganizationDelete configures the scheduled organization deletion sweep
 	OrganizationDelete system.OrganizationDeleteConfig `json:"organizationdelete" koanf:"organizationdelete"`
+	// RetentionPurge configures the scheduled file retention purge sweep
+	RetentionPurge system.RetentionPurgeConfig `json:"retentionpurge" koanf:"retentionpurge"`
+	// VendorReview configures the scheduled vendor contract renewal and review sweep
+	VendorReview system.VendorReviewConfig `json:"vendorreview" koanf:"vendorreview"`
+	// UsageReport configures the scheduled sweep reporting metered usage to Stripe
+	UsageReport system.UsageReportConfig `json:"usagereport" koanf:"usagereport"`
+	// TupleConsistency configures the scheduled sweep checking authorization tuples against the database
+	TupleConsistency system.TupleConsistencyConfig `json:"tupleconsistency" koanf:"tupleconsistency"`
+	// ResumableUploadCleanup configures the scheduled sweep removing expired resumable uploads
+	ResumableUploadCleanup system.ResumableUploadCleanupConfig `json:"resumableuploadcleanup" koanf:"resumableuploadcleanup"`
+	// LicenseExpiry configures the scheduled sweep reloading the offline license file and revoking expired modules
+	LicenseExpiry system.LicenseExpiryConfig `json:"licenseexpiry" koanf:"licenseexpiry"`
+	// FileRescan configures the scheduled sweep rescanning quarantined files whose content scan did not complete
+	FileRescan system.FileRescanConfig `json:"filerescan" koanf:"filerescan"`
 }
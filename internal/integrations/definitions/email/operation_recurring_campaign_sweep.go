@@ -86,9 +86,42 @@ func dueCampaignPredicates(now time.Time) []predicate.Campaign {
 
 // dispatchRecurringCampaign dispatches a single recurring campaign and advances its scheduling fields
 func dispatchRecurringCampaign(ctx context.Context, req types.OperationRequest, camp *ent.Campaign, now time.Time) error {
+	if err := DispatchCampaign(ctx, req, camp, true); err != nil {
+		return err
+	}
+
+	nextRun := camp.RecurrenceFrequency.NextOccurrence(now, camp.RecurrenceInterval, camp.RecurrenceTimezone)
+	nowDT := models.DateTime(now)
+	exhausted := camp.RecurrenceEndAt != nil && !camp.RecurrenceEndAt.IsZero() && !nextRun.Before(time.Time(*camp.RecurrenceEndAt))
+
+	update := req.DB.Campaign.UpdateOneID(camp.ID).
+		SetLastRunAt(nowDT)
+
+	switch {
+	case exhausted:
+		update.ClearNextRunAt().
+			SetStatus(enums.CampaignStatusCompleted).
+			SetIsActive(false).
+			SetCompletedAt(nowDT)
+	default:
+		update.SetNextRunAt(models.DateTime(nextRun))
+	}
+
+	if err := update.Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("campaign_id", camp.ID).Msg("failed updating recurring campaign schedule")
+
+		return err
+	}
+
+	return nil
+}
+
+// DispatchCampaign dispatches the email operation for the campaign type through the owner's campaign email
+// integration, falling back to the runtime provider when the owner has none
+func DispatchCampaign(ctx context.Context, req types.OperationRequest, camp *ent.Campaign, resend bool) error {
 	input := CampaignDispatchInput{
 		CampaignID: camp.ID,
-		Resend:     true,
+		Resend:     resend,
 	}
 
 	var (
@@ -121,7 +154,7 @@ func dispatchRecurringCampaign(ctx context.Context, req types.OperationRequest,
 		return err
 	}
 
-	if _, err := req.Dispatch(ctx, types.DispatchRequest{
+	_, err = req.Dispatch(ctx, types.DispatchRequest{
 		IntegrationID: integrationID,
 		DefinitionID:  DefinitionID.ID(),
 		OwnerID:       camp.OwnerID,
@@ -129,32 +162,7 @@ func dispatchRecurringCampaign(ctx context.Context, req types.OperationRequest,
 		Config:        config,
 		RunType:       enums.IntegrationRunTypeScheduled,
 		Runtime:       integrationID == "",
-	}); err != nil {
-		return err
-	}
-
-	nextRun := camp.RecurrenceFrequency.NextOccurrence(now, camp.RecurrenceInterval, camp.RecurrenceTimezone)
-	nowDT := models.DateTime(now)
-	exhausted := camp.RecurrenceEndAt != nil && !camp.RecurrenceEndAt.IsZero() && !nextRun.Before(time.Time(*camp.RecurrenceEndAt))
-
-	update := req.DB.Campaign.UpdateOneID(camp.ID).
-		SetLastRunAt(nowDT)
-
-	switch {
-	case exhausted:
-		update.ClearNextRunAt().
-			SetStatus(enums.CampaignStatusCompleted).
-			SetIsActive(false).
-			SetCompletedAt(nowDT)
-	default:
-		update.SetNextRunAt(models.DateTime(nextRun))
-	}
-
-	if err := update.Exec(privacy.DecisionContext(ctx, privacy.Allow)); err != nil {
-		logx.FromContext(ctx).Error().Err(err).Str("campaign_id", camp.ID).Msg("failed updating recurring campaign schedule")
-
-		return err
-	}
+	})
 
-	return nil
+	return err
 }
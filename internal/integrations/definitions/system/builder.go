@@ -10,14 +10,14 @@ import (
 
 // Builder returns the system definition hosting the scheduled runtime sweeps; it exposes
 // no credentials, clients, or connections and is never visible in catalog surfaces
-func Builder(paymentReminder PaymentReminderConfig, organizationDelete OrganizationDeleteConfig) registry.Builder {
+func Builder(paymentReminder PaymentReminderConfig, organizationDelete OrganizationDeleteConfig, retentionPurge RetentionPurgeConfig, vendorReview VendorReviewConfig, usageReport UsageReportConfig, tupleConsistency TupleConsistencyConfig, resumableUploadCleanup ResumableUploadCleanupConfig, licenseExpiry LicenseExpiryConfig, fileRescan FileRescanConfig) registry.Builder {
 	return registry.Builder(func() (types.Definition, error) {
 		return types.Definition{
 			DefinitionSpec: types.DefinitionSpec{
 				ID:          DefinitionID.ID(),
 				Family:      "Openlane",
 				DisplayName: "Openlane System",
-				Description: "Internal scheduled sweeps for organization lifecycle.",
+				Description: "Internal scheduled sweeps for organization lifecycle, file retention, vendor reviews, usage reporting, authorization tuple consistency, resumable upload cleanup, license expiry and file rescans.",
 				Category:    "system",
 				Active:      true,
 				Visible:     false,
@@ -47,6 +47,90 @@ func Builder(paymentReminder PaymentReminderConfig, organizationDelete Organizat
 					DisabledForAll:      !organizationDelete.Enabled,
 					SkipDefaultLookback: true,
 				},
+				{
+					Name:                RetentionPurgeOp.Name(),
+					Description:         "Purge files whose retention period has ended and that are not under legal hold",
+					Topic:               DefinitionID.OperationTopic(RetentionPurgeOp.Name()),
+					ConfigSchema:        retentionPurgeSweepSchema,
+					Policy:              types.ExecutionPolicy{Scheduled: true, SkipRunRecord: true},
+					Schedule:            &gala.Schedule{MinInterval: RetentionPurgeMinInterval, MaxInterval: RetentionPurgeMaxInterval},
+					Handle:              retentionPurge.Sweep().Handle(),
+					CustomerSelectable:  lo.ToPtr(false),
+					DisabledForAll:      !retentionPurge.Enabled,
+					SkipDefaultLookback: true,
+				},
+				{
+					Name:                VendorReviewOp.Name(),
+					Description:         "Open vendor contract renewal tasks, flag lapsed SOC 2 reports and launch due vendor assessments",
+					Topic:               DefinitionID.OperationTopic(VendorReviewOp.Name()),
+					ConfigSchema:        vendorReviewSweepSchema,
+					Policy:              types.ExecutionPolicy{Scheduled: true, SkipRunRecord: true},
+					Schedule:            &gala.Schedule{MinInterval: VendorReviewMinInterval, MaxInterval: VendorReviewMaxInterval},
+					Handle:              vendorReview.Sweep().Handle(),
+					CustomerSelectable:  lo.ToPtr(false),
+					DisabledForAll:      !vendorReview.Enabled,
+					SkipDefaultLookback: true,
+				},
+				{
+					Name:                UsageReportOp.Name(),
+					Description:         "Report the metered usage of subscribed organizations to Stripe",
+					Topic:               DefinitionID.OperationTopic(UsageReportOp.Name()),
+					ConfigSchema:        usageReportSweepSchema,
+					Policy:              types.ExecutionPolicy{Scheduled: true, SkipRunRecord: true},
+					Schedule:            &gala.Schedule{MinInterval: UsageReportMinInterval, MaxInterval: UsageReportMaxInterval},
+					Handle:              usageReport.Sweep().Handle(),
+					CustomerSelectable:  lo.ToPtr(false),
+					DisabledForAll:      !usageReport.Enabled,
+					SkipDefaultLookback: true,
+				},
+				{
+					Name:                TupleConsistencyOp.Name(),
+					Description:         "Compare the authorization tuples of organizations with their records and repair missing and orphaned tuples",
+					Topic:               DefinitionID.OperationTopic(TupleConsistencyOp.Name()),
+					ConfigSchema:        tupleConsistencySweepSchema,
+					Policy:              types.ExecutionPolicy{Scheduled: true, SkipRunRecord: true},
+					Schedule:            &gala.Schedule{MinInterval: TupleConsistencyMinInterval, MaxInterval: TupleConsistencyMaxInterval},
+					Handle:              tupleConsistency.Sweep().Handle(),
+					CustomerSelectable:  lo.ToPtr(false),
+					DisabledForAll:      !tupleConsistency.Enabled,
+					SkipDefaultLookback: true,
+				},
+				{
+					Name:                ResumableUploadCleanupOp.Name(),
+					Description:         "Remove resumable uploads that expired before completing, together with their staged chunks",
+					Topic:               DefinitionID.OperationTopic(ResumableUploadCleanupOp.Name()),
+					ConfigSchema:        resumableUploadCleanupSweepSchema,
+					Policy:              types.ExecutionPolicy{Scheduled: true, SkipRunRecord: true},
+					Schedule:            &gala.Schedule{MinInterval: ResumableUploadCleanupMinInterval, MaxInterval: ResumableUploadCleanupMaxInterval},
+					Handle:              resumableUploadCleanup.Sweep().Handle(),
+					CustomerSelectable:  lo.ToPtr(false),
+					DisabledForAll:      !resumableUploadCleanup.Enabled,
+					SkipDefaultLookback: true,
+				},
+				{
+					Name:                LicenseExpiryOp.Name(),
+					Description:         "Reload the offline license file and revoke its modules once it expires",
+					Topic:               DefinitionID.OperationTopic(LicenseExpiryOp.Name()),
+					ConfigSchema:        licenseExpirySweepSchema,
+					Policy:              types.ExecutionPolicy{Scheduled: true, SkipRunRecord: true},
+					Schedule:            &gala.Schedule{MinInterval: LicenseExpiryMinInterval, MaxInterval: LicenseExpiryMaxInterval},
+					Handle:              licenseExpiry.Sweep().Handle(),
+					CustomerSelectable:  lo.ToPtr(false),
+					DisabledForAll:      !licenseExpiry.Enabled,
+					SkipDefaultLookback: true,
+				},
+				{
+					Name:                FileRescanOp.Name(),
+					Description:         "Rescan quarantined files whose content scan is pending or failed and release the ones that pass",
+					Topic:               DefinitionID.OperationTopic(FileRescanOp.Name()),
+					ConfigSchema:        fileRescanSweepSchema,
+					Policy:              types.ExecutionPolicy{Scheduled: true, SkipRunRecord: true},
+					Schedule:            &gala.Schedule{MinInterval: FileRescanMinInterval, MaxInterval: FileRescanMaxInterval},
+					Handle:              fileRescan.Sweep().Handle(),
+					CustomerSelectable:  lo.ToPtr(false),
+					DisabledForAll:      !fileRescan.Enabled,
+					SkipDefaultLookback: true,
+				},
 			},
 		}, nil
 	})
@@ -0,0 +1,155 @@
+package system
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"maps"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/file"
+	"github.com/theopenlane/core/internal/integrations/providerkit"
+	"github.com/theopenlane/core/internal/integrations/types"
+	"github.com/theopenlane/core/internal/objects"
+	"github.com/theopenlane/core/pkg/jsonx"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/scanner"
+)
+
+// Handle adapts the file rescan sweep to the generic operation registration boundary;
+// the receiver carries the operator defaults and request config overlays a copy
+func (r FileRescanSweep) Handle() types.OperationHandler {
+	return func(ctx context.Context, req types.OperationRequest) (json.RawMessage, error) {
+		sweep := r
+
+		if err := jsonx.UnmarshalIfPresent(req.Config, &sweep); err != nil {
+			return nil, ErrOperationConfigInvalid
+		}
+
+		processed, err := sweep.Run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providerkit.EncodeResult(types.ScheduledCycleResult{Processed: processed}, ErrResultEncode)
+	}
+}
+
+// Run executes one file rescan sweep and returns the number of rescanned files
+func (r FileRescanSweep) Run(ctx context.Context, req types.OperationRequest) (int, error) {
+	db := req.DB
+	logger := logx.FromContext(ctx)
+
+	if db.ObjectManager == nil || !db.ObjectManager.ScanningEnabled() {
+		logger.Debug().Msg("content scanning is not configured, skipping file rescan")
+		return 0, nil
+	}
+
+	if r.MaxFilesPerRun <= 0 {
+		r.MaxFilesPerRun = DefaultFileRescanMaxPerRun
+	}
+
+	systemCtx := systemSweepContext(ctx)
+
+	// infected files keep their verdict; only scans that never completed are retried, least recently
+	// attempted first so a file that keeps failing does not starve the rest
+	files, err := db.File.Query().
+		Where(file.ScanStatusIn(scanner.StatusPending.String(), scanner.StatusError.String())).
+		Order(file.ByUpdatedAt()).
+		Limit(r.MaxFilesPerRun).
+		All(systemCtx)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed querying quarantined files for rescan")
+		return 0, err
+	}
+
+	released := 0
+
+	for _, f := range files {
+		fileLogger := logger.With().Str("file_id", f.ID).Logger()
+
+		result, err := rescanFile(systemCtx, db, f)
+		if err != nil {
+			fileLogger.Warn().Err(err).Msg("failed to rescan quarantined file, file remains quarantined")
+
+			result.Status = scanner.StatusError
+		}
+
+		if err := recordRescan(systemCtx, db, f, result); err != nil {
+			fileLogger.Error().Err(err).Msg("failed to record file rescan result")
+			return released, err
+		}
+
+		if result.Status == scanner.StatusClean {
+			released++
+		}
+
+		fileLogger.Info().Str("scan_status", result.Status.String()).Msg("rescanned quarantined file")
+	}
+
+	logger.Info().
+		Int("count", len(files)).
+		Int("released", released).
+		Msg("file rescan summary")
+
+	return len(files), nil
+}
+
+// rescanFile runs the content scanner against the stored contents of the file
+func rescanFile(ctx context.Context, db *ent.Client, f *ent.File) (scanner.Result, error) {
+	if storagetypes.ProviderType(f.StorageProvider) == storagetypes.DatabaseProvider && len(f.FileContents) > 0 {
+		return db.ObjectManager.Scan(ctx, bytes.NewReader(f.FileContents), scanner.Target{
+			Name:        f.ProvidedFileName,
+			ContentType: f.DetectedContentType,
+			Size:        int64(len(f.FileContents)),
+		})
+	}
+
+	orgIDs, err := f.QueryOrganization().IDs(ctx)
+	if err != nil {
+		return scanner.Result{}, err
+	}
+
+	location := objects.ObjectLocation{
+		Provider: storagetypes.ProviderType(f.StorageProvider),
+		Bucket:   f.StorageVolume,
+		Key:      f.StoragePath,
+		Region:   f.StorageRegion,
+		URI:      f.URI,
+	}
+
+	storageFile := location.File(&storagetypes.File{
+		ID:           f.ID,
+		OriginalName: f.ProvidedFileName,
+		FileMetadata: storagetypes.FileMetadata{ContentType: f.DetectedContentType},
+		Metadata:     f.Metadata,
+	})
+
+	if len(orgIDs) > 0 {
+		storageFile.ProviderHints.OrganizationID = orgIDs[0]
+	}
+
+	return db.ObjectManager.Rescan(ctx, storageFile)
+}
+
+// recordRescan stores the rescan verdict on the file; retained files accept the update because the
+// scan result is managed by the system rather than the user
+func recordRescan(ctx context.Context, db *ent.Client, f *ent.File, result scanner.Result) error {
+	md := maps.Clone(f.Metadata)
+	if md == nil {
+		md = map[string]any{}
+	}
+
+	delete(md, scanner.MetadataKeyThreats)
+
+	if !result.ScannedAt.IsZero() {
+		maps.Copy(md, result.Metadata())
+	}
+
+	return db.File.UpdateOneID(f.ID).
+		SetScanStatus(result.Status.String()).
+		SetMetadata(md).
+		Exec(retention.WithManagedUpdate(ctx))
+}
@@ -0,0 +1,69 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/theopenlane/core/internal/integrations/providerkit"
+	"github.com/theopenlane/core/internal/integrations/types"
+	"github.com/theopenlane/core/pkg/jsonx"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// Handle adapts the license expiry sweep to the generic operation registration boundary;
+// the receiver carries the operator defaults and request config overlays a copy
+func (l LicenseExpirySweep) Handle() types.OperationHandler {
+	return func(ctx context.Context, req types.OperationRequest) (json.RawMessage, error) {
+		sweep := l
+
+		if err := jsonx.UnmarshalIfPresent(req.Config, &sweep); err != nil {
+			return nil, ErrOperationConfigInvalid
+		}
+
+		processed, err := sweep.Run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providerkit.EncodeResult(types.ScheduledCycleResult{Processed: processed}, ErrResultEncode)
+	}
+}
+
+// Run executes one license expiry sweep and returns the number of modules the license grants after it is applied.
+// The sweep runs on a single replica, so other replicas keep the license they loaded at startup until they restart;
+// its expiry is still evaluated on every seat check
+func (l LicenseExpirySweep) Run(ctx context.Context, req types.OperationRequest) (int, error) {
+	logger := logx.FromContext(ctx)
+
+	provider := req.DB.LicenseManager
+	if provider == nil {
+		logger.Debug().Msg("no license is configured, skipping license expiry sweep")
+
+		return 0, nil
+	}
+
+	systemCtx := systemSweepContext(ctx)
+	now := time.Now()
+
+	if !l.SkipReload {
+		// a file that fails to verify keeps the loaded license, which still expires on schedule
+		_ = provider.Reload(systemCtx)
+	}
+
+	status, err := provider.Apply(systemCtx, now)
+	if err != nil {
+		logger.Error().Err(err).Str("status", string(status)).Msg("failed to apply license")
+
+		return 0, err
+	}
+
+	granted := 0
+	if status.Grants() {
+		granted = len(provider.License().Modules)
+	}
+
+	logger.Info().Str("status", string(status)).Int("count", granted).Msg("license expiry summary")
+
+	return granted, nil
+}
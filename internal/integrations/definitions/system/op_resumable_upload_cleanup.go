@@ -0,0 +1,62 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/theopenlane/core/internal/integrations/providerkit"
+	"github.com/theopenlane/core/internal/integrations/types"
+	"github.com/theopenlane/core/internal/objects"
+	"github.com/theopenlane/core/pkg/jsonx"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/tus"
+)
+
+// Handle adapts the resumable upload cleanup sweep to the generic operation registration boundary;
+// the receiver carries the operator defaults and request config overlays a copy
+func (r ResumableUploadCleanupSweep) Handle() types.OperationHandler {
+	return func(ctx context.Context, req types.OperationRequest) (json.RawMessage, error) {
+		sweep := r
+
+		if err := jsonx.UnmarshalIfPresent(req.Config, &sweep); err != nil {
+			return nil, ErrOperationConfigInvalid
+		}
+
+		processed, err := sweep.Run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providerkit.EncodeResult(types.ScheduledCycleResult{Processed: processed}, ErrResultEncode)
+	}
+}
+
+// Run executes one resumable upload cleanup sweep and returns the number of removed uploads
+func (r ResumableUploadCleanupSweep) Run(ctx context.Context, req types.OperationRequest) (int, error) {
+	db := req.DB
+	logger := logx.FromContext(ctx)
+
+	if db.ObjectManager == nil {
+		logger.Debug().Msg("object storage is not configured, skipping resumable upload cleanup")
+		return 0, nil
+	}
+
+	if r.MaxRemovalsPerRun <= 0 {
+		r.MaxRemovalsPerRun = DefaultResumableUploadCleanupMaxPerRun
+	}
+
+	store := objects.NewResumableStore(db.Driver(), db.ObjectManager)
+
+	// staged chunks are removed before the upload state, so an upload whose chunks could not all be removed
+	// is picked up again by the next sweep
+	removed, err := tus.CleanupExpired(systemSweepContext(ctx), store, time.Now(), r.MaxRemovalsPerRun)
+	if err != nil {
+		logger.Error().Err(err).Int("removed", removed).Msg("failed removing expired resumable uploads")
+		return removed, err
+	}
+
+	logger.Info().Int("count", removed).Msg("resumable upload cleanup summary")
+
+	return removed, nil
+}
@@ -0,0 +1,147 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/file"
+	"github.com/theopenlane/core/internal/integrations/providerkit"
+	"github.com/theopenlane/core/internal/integrations/types"
+	"github.com/theopenlane/core/internal/workflows"
+	"github.com/theopenlane/core/pkg/jsonx"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/retention"
+)
+
+// eventTypeRetentionPurged is the event type recorded for each file purged at the end of its retention period
+const eventTypeRetentionPurged = "file.retention_purged"
+
+// Handle adapts the retention purge sweep to the generic operation registration boundary;
+// the receiver carries the operator defaults and request config overlays a copy
+func (r RetentionPurgeSweep) Handle() types.OperationHandler {
+	return func(ctx context.Context, req types.OperationRequest) (json.RawMessage, error) {
+		sweep := r
+
+		if err := jsonx.UnmarshalIfPresent(req.Config, &sweep); err != nil {
+			return nil, ErrOperationConfigInvalid
+		}
+
+		processed, err := sweep.Run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providerkit.EncodeResult(types.ScheduledCycleResult{Processed: processed}, ErrResultEncode)
+	}
+}
+
+// Run executes one retention purge sweep and returns the number of purged files
+func (r RetentionPurgeSweep) Run(ctx context.Context, req types.OperationRequest) (int, error) {
+	db := req.DB
+	logger := logx.FromContext(ctx)
+
+	if r.MaxPurgesPerRun <= 0 {
+		r.MaxPurgesPerRun = DefaultRetentionPurgeMaxPerRun
+	}
+
+	systemCtx := systemSweepContext(ctx)
+	now := time.Now()
+
+	// files flagged for purge whose retention period has ended and that are not under legal hold are
+	// loaded oldest first; retention_until is stored as an RFC 3339 UTC timestamp so it compares as text
+	files, err := db.File.Query().
+		Where(func(sel *sql.Selector) {
+			sel.Where(sql.And(
+				sqljson.ValueEQ(file.FieldMetadata, true, sqljson.Path(retention.MetadataKeyPurge)),
+				sqljson.ValueLTE(file.FieldMetadata, now.UTC().Format(time.RFC3339), sqljson.Path(retention.MetadataKeyRetainUntil)),
+				sql.Or(
+					sql.Not(sqljson.HasKey(file.FieldMetadata, sqljson.Path(retention.MetadataKeyLegalHold))),
+					sql.Not(sqljson.ValueEQ(file.FieldMetadata, true, sqljson.Path(retention.MetadataKeyLegalHold))),
+				),
+			))
+		}).
+		Order(file.ByCreatedAt()).
+		Limit(r.MaxPurgesPerRun).
+		All(systemCtx)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed querying files due for retention purge")
+		return 0, err
+	}
+
+	purged := 0
+
+	for _, f := range files {
+		state := retention.StateFromMetadata(f.Metadata)
+		if !state.PurgeDue(now) {
+			continue
+		}
+
+		fileLogger := logger.With().
+			Str("file_id", f.ID).
+			Str("retention_rule", state.Rule).
+			Logger()
+
+		if r.DryRun {
+			fileLogger.Info().Msg("dry run: file would be purged at the end of its retention period")
+
+			purged++
+
+			continue
+		}
+
+		if err := purgeRetainedFile(systemCtx, db, f, state); err != nil {
+			fileLogger.Error().Err(err).Msg("failed to purge file at the end of its retention period")
+			return purged, err
+		}
+
+		purged++
+
+		fileLogger.Info().Msg("purged file at the end of its retention period")
+	}
+
+	logger.Info().
+		Int("count", purged).
+		Bool("dry_run", r.DryRun).
+		Msg("retention purge summary")
+
+	return purged, nil
+}
+
+// purgeRetainedFile records the purge in the audit trail and deletes the file in one transaction, so a
+// purge is never recorded for a file that remains or a file removed without its record; the file delete
+// hook removes the stored object from the storage provider
+func purgeRetainedFile(ctx context.Context, db *ent.Client, f *ent.File, state retention.State) error {
+	orgIDs, err := f.QueryOrganization().IDs(ctx)
+	if err != nil {
+		return err
+	}
+
+	metadata := map[string]any{
+		"file_id": f.ID,
+		"name":    f.ProvidedFileName,
+		"reason":  "retention period ended",
+	}
+
+	for k, v := range state.Metadata() {
+		metadata[k] = v
+	}
+
+	_, err = workflows.WithTx(ctx, db, nil, func(tx *ent.Tx) (struct{}, error) {
+		if _, err := tx.Event.Create().SetInput(ent.CreateEventInput{
+			EventType:       eventTypeRetentionPurged,
+			Metadata:        metadata,
+			OrganizationIDs: orgIDs,
+		}).Save(ctx); err != nil {
+			return struct{}{}, err
+		}
+
+		return struct{}{}, tx.File.DeleteOneID(f.ID).Exec(ctx)
+	})
+
+	return err
+}
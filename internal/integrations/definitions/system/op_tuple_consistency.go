@@ -0,0 +1,105 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/theopenlane/core/internal/consts"
+	"github.com/theopenlane/core/internal/ent/generated/organization"
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+	"github.com/theopenlane/core/internal/integrations/providerkit"
+	"github.com/theopenlane/core/internal/integrations/types"
+	"github.com/theopenlane/core/pkg/jsonx"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// Handle adapts the tuple consistency sweep to the generic operation registration boundary;
+// the receiver carries the operator defaults and request config overlays a copy
+func (t TupleConsistencySweep) Handle() types.OperationHandler {
+	return func(ctx context.Context, req types.OperationRequest) (json.RawMessage, error) {
+		sweep := t
+
+		if err := jsonx.UnmarshalIfPresent(req.Config, &sweep); err != nil {
+			return nil, ErrOperationConfigInvalid
+		}
+
+		processed, err := sweep.Run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providerkit.EncodeResult(types.ScheduledCycleResult{Processed: processed}, ErrResultEncode)
+	}
+}
+
+// Run executes one tuple consistency sweep and returns the number of organizations checked; drift is
+// logged per organization and repaired unless the sweep is a dry run
+func (t TupleConsistencySweep) Run(ctx context.Context, req types.OperationRequest) (int, error) {
+	db := req.DB
+	logger := logx.FromContext(ctx)
+
+	if t.MaxOrganizationsPerRun <= 0 {
+		t.MaxOrganizationsPerRun = DefaultTupleConsistencyMaxPerRun
+	}
+
+	systemCtx := systemSweepContext(ctx)
+
+	// organizations least recently changed are checked first, drift left by failed hooks tends to sit there
+	orgs, err := db.Organization.Query().
+		Where(organization.IDNEQ(consts.SystemAdminOrgID)).
+		Order(organization.ByUpdatedAt()).
+		Limit(t.MaxOrganizationsPerRun).
+		IDs(systemCtx)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed querying organizations for tuple consistency check")
+		return 0, err
+	}
+
+	checker, err := tuplecheck.New(tuplecheck.NewSQLSource(db.Driver()), tuplecheck.NewFGAStore(&db.Authz), tuplecheck.WithSchemas(t.Schemas...))
+	if err != nil {
+		return 0, err
+	}
+
+	checked, missing, orphaned := 0, 0, 0
+
+	for _, orgID := range orgs {
+		orgLogger := logger.With().
+			Str("organization_id", orgID).
+			Logger()
+
+		report, err := checker.Check(systemCtx, orgID)
+		if err != nil {
+			orgLogger.Error().Err(err).Msg("failed to check organization tuples")
+			return checked, err
+		}
+
+		checked++
+
+		if report.Consistent() {
+			continue
+		}
+
+		missing += len(report.Missing)
+		orphaned += len(report.Orphaned)
+
+		orgLogger.Warn().
+			Int("missing", len(report.Missing)).
+			Int("orphaned", len(report.Orphaned)).
+			Bool("dry_run", t.DryRun).
+			Msg("organization tuples are out of sync")
+
+		if _, err := checker.Repair(systemCtx, report, t.BatchSize, t.DryRun); err != nil {
+			orgLogger.Error().Err(err).Msg("failed to repair organization tuples")
+			return checked, err
+		}
+	}
+
+	logger.Info().
+		Int("count", checked).
+		Int("missing", missing).
+		Int("orphaned", orphaned).
+		Bool("dry_run", t.DryRun).
+		Msg("tuple consistency summary")
+
+	return checked, nil
+}
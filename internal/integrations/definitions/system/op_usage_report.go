@@ -0,0 +1,135 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/theopenlane/core/internal/consts"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/organization"
+	"github.com/theopenlane/core/internal/integrations/providerkit"
+	"github.com/theopenlane/core/internal/integrations/types"
+	"github.com/theopenlane/core/internal/metering"
+	"github.com/theopenlane/core/pkg/entitlements"
+	"github.com/theopenlane/core/pkg/jsonx"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// usageReportIdentifierDateFormat is the date format of meter event identifiers, so repeated sweeps on the
+// same day are deduplicated by Stripe
+const usageReportIdentifierDateFormat = "2006-01-02"
+
+// Handle adapts the usage report sweep to the generic operation registration boundary;
+// the receiver carries the operator defaults and request config overlays a copy
+func (u UsageReportSweep) Handle() types.OperationHandler {
+	return func(ctx context.Context, req types.OperationRequest) (json.RawMessage, error) {
+		sweep := u
+
+		if err := jsonx.UnmarshalIfPresent(req.Config, &sweep); err != nil {
+			return nil, ErrOperationConfigInvalid
+		}
+
+		processed, err := sweep.Run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providerkit.EncodeResult(types.ScheduledCycleResult{Processed: processed}, ErrResultEncode)
+	}
+}
+
+// Run executes one usage report sweep and returns the number of organizations reported; every metric is
+// reported as the current measurement, so the Stripe meters are expected to aggregate with the last value
+// for gauges such as storage and seats
+func (u UsageReportSweep) Run(ctx context.Context, req types.OperationRequest) (int, error) {
+	db := req.DB
+	logger := logx.FromContext(ctx)
+
+	if db.EntitlementManager == nil || !db.EntitlementManager.Config.IsEnabled() {
+		logger.Debug().Msg("entitlements are not enabled, skipping usage report")
+
+		return 0, nil
+	}
+
+	if u.EventNamePrefix == "" {
+		u.EventNamePrefix = DefaultUsageReportEventNamePrefix
+	}
+
+	systemCtx := systemSweepContext(ctx)
+	now := time.Now().UTC()
+
+	orgs, err := db.Organization.Query().
+		Where(
+			organization.IDNEQ(consts.SystemAdminOrgID),
+			organization.PersonalOrg(false),
+			organization.StripeCustomerIDNotNil(),
+			organization.HasOrgSubscriptionsWith(activeOrTrialingSubscriptionPredicates()...),
+		).
+		Order(organization.ByCreatedAt()).
+		All(systemCtx)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed querying subscribed organizations for usage report")
+		return 0, err
+	}
+
+	reported, failed := 0, 0
+
+	for _, org := range orgs {
+		orgLogger := logger.With().
+			Str("organization_id", org.ID).
+			Logger()
+
+		// a failing organization is retried on the next sweep rather than holding back the remaining organizations
+		if err := u.reportOrganization(systemCtx, db, org.ID, *org.StripeCustomerID, now); err != nil {
+			orgLogger.Error().Err(err).Msg("failed to report organization usage")
+
+			failed++
+
+			continue
+		}
+
+		reported++
+	}
+
+	logger.Info().
+		Int("count", reported).
+		Int("failed", failed).
+		Bool("dry_run", u.DryRun).
+		Msg("usage report summary")
+
+	return reported, nil
+}
+
+// reportOrganization measures every metric of the organization and reports it to the meters of its customer
+func (u UsageReportSweep) reportOrganization(ctx context.Context, db *generated.Client, orgID, customerID string, now time.Time) error {
+	logger := logx.FromContext(ctx)
+
+	for _, m := range metering.Metrics {
+		used, err := metering.Measure(ctx, db, orgID, m, now)
+		if err != nil {
+			return fmt.Errorf("measuring %s: %w", m, err)
+		}
+
+		event := entitlements.MeterEvent{
+			EventName:  u.EventNamePrefix + m.String(),
+			CustomerID: customerID,
+			Value:      used,
+			Identifier: fmt.Sprintf("%s-%s-%s", orgID, m, now.Format(usageReportIdentifierDateFormat)),
+			Timestamp:  now,
+		}
+
+		if u.DryRun {
+			logger.Info().Str("organization_id", orgID).Str("event_name", event.EventName).Int64("value", used).Msg("dry run: usage would be reported")
+
+			continue
+		}
+
+		if _, err := db.EntitlementManager.ReportUsage(ctx, event); err != nil {
+			return fmt.Errorf("reporting %s: %w", event.EventName, err)
+		}
+	}
+
+	return nil
+}
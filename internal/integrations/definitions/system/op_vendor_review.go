@@ -0,0 +1,581 @@
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/samber/lo"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/common/models"
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/campaign"
+	"github.com/theopenlane/core/internal/ent/generated/campaigntarget"
+	"github.com/theopenlane/core/internal/ent/generated/entity"
+	"github.com/theopenlane/core/internal/ent/generated/predicate"
+	"github.com/theopenlane/core/internal/ent/generated/task"
+	emaildef "github.com/theopenlane/core/internal/integrations/definitions/email"
+	"github.com/theopenlane/core/internal/integrations/providerkit"
+	"github.com/theopenlane/core/internal/integrations/types"
+	"github.com/theopenlane/core/internal/workflows"
+	"github.com/theopenlane/core/pkg/jsonx"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+const (
+	// vendorReviewTaskSource is the source recorded on tasks opened by the vendor review sweep
+	vendorReviewTaskSource = "vendor_review"
+	// vendorTaskRenewal is the task key for contract renewal decisions
+	vendorTaskRenewal = "renewal"
+	// vendorTaskSOC2 is the task key for lapsed SOC 2 reports
+	vendorTaskSOC2 = "soc2"
+	// vendorTaskReview is the task key for reviews that could not be launched as a campaign
+	vendorTaskReview = "review"
+	// vendorDateLayout formats dates in task keys and task details
+	vendorDateLayout = "2006-01-02"
+	// vendorReviewKeyMetadata is the campaign metadata key holding the key of the review it was launched for
+	vendorReviewKeyMetadata = "vendor_review_key"
+)
+
+// Handle adapts the vendor review sweep to the generic operation registration boundary;
+// the receiver carries the operator defaults and request config overlays a copy
+func (v VendorReviewSweep) Handle() types.OperationHandler {
+	return func(ctx context.Context, req types.OperationRequest) (json.RawMessage, error) {
+		sweep := v
+
+		if err := jsonx.UnmarshalIfPresent(req.Config, &sweep); err != nil {
+			return nil, ErrOperationConfigInvalid
+		}
+
+		processed, err := sweep.Run(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return providerkit.EncodeResult(types.ScheduledCycleResult{Processed: processed}, ErrResultEncode)
+	}
+}
+
+// Run executes one vendor review sweep: it opens renewal decision tasks ahead of termination notice
+// deadlines, flags vendors whose SOC 2 period has lapsed and launches vendor assessment campaigns that
+// are due; it returns the number of vendors acted on
+func (v VendorReviewSweep) Run(ctx context.Context, req types.OperationRequest) (int, error) {
+	logger := logx.FromContext(ctx)
+
+	if v.RenewalLeadDays <= 0 {
+		v.RenewalLeadDays = DefaultVendorRenewalLeadDays
+	}
+
+	if v.MaxActionsPerRun <= 0 {
+		v.MaxActionsPerRun = DefaultVendorReviewMaxPerRun
+	}
+
+	systemCtx := systemSweepContext(ctx)
+	now := time.Now().UTC()
+
+	renewals, err := v.openRenewalTasks(systemCtx, req.DB, now, v.MaxActionsPerRun)
+	if err != nil {
+		return renewals, err
+	}
+
+	lapsed, err := v.flagLapsedSOC2(systemCtx, req.DB, now, v.MaxActionsPerRun-renewals)
+	if err != nil {
+		return renewals + lapsed, err
+	}
+
+	reviews, err := v.launchDueReviews(systemCtx, req, now, v.MaxActionsPerRun-renewals-lapsed)
+	if err != nil {
+		return renewals + lapsed + reviews, err
+	}
+
+	logger.Info().
+		Int("renewal_tasks", renewals).
+		Int("soc2_lapsed", lapsed).
+		Int("reviews", reviews).
+		Bool("dry_run", v.DryRun).
+		Msg("vendor review summary")
+
+	return renewals + lapsed + reviews, nil
+}
+
+// activeVendorPredicate excludes entities that are not, or no longer, a vendor relationship
+func activeVendorPredicate() predicate.Entity {
+	return entity.StatusNotIn(enums.EntityStatusDraft, enums.EntityStatusRejected, enums.EntityStatusTerminated)
+}
+
+// openRenewalTasks opens a renewal decision task for each contract whose termination notice deadline falls
+// within the lead time; the deadline is the contract end date less the termination notice days
+func (v VendorReviewSweep) openRenewalTasks(ctx context.Context, db *ent.Client, now time.Time, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	horizon := now.AddDate(0, 0, v.RenewalLeadDays)
+
+	entities, err := db.Entity.Query().
+		Where(
+			activeVendorPredicate(),
+			entity.ContractEndDateNotNil(),
+			entity.ContractEndDateGT(models.DateTime(now)),
+			func(s *sql.Selector) {
+				s.Where(sql.P(func(b *sql.Builder) {
+					b.WriteString(s.C(entity.FieldContractEndDate)).
+						WriteString(" - make_interval(days => COALESCE(").
+						WriteString(s.C(entity.FieldTerminationNoticeDays)).
+						WriteString(", 0)) <= ").
+						Arg(horizon)
+				}))
+			},
+		).
+		Order(entity.ByContractEndDate()).
+		All(ctx)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed querying vendors with upcoming contract renewals")
+		return 0, err
+	}
+
+	opened := 0
+
+	for _, e := range entities {
+		if opened >= limit {
+			break
+		}
+
+		endDate := time.Time(*e.ContractEndDate)
+		deadline := endDate.AddDate(0, 0, -e.TerminationNoticeDays)
+
+		created, err := v.openVendorTask(ctx, db, e, vendorTask{
+			kind:    vendorTaskRenewal,
+			date:    endDate,
+			title:   fmt.Sprintf("Decide on contract renewal for %s", vendorName(e)),
+			details: renewalTaskDetails(e, endDate, deadline),
+			due:     deadline,
+		})
+		if err != nil {
+			return opened, err
+		}
+
+		if created {
+			opened++
+		}
+	}
+
+	return opened, nil
+}
+
+// renewalTaskDetails describes the renewal decision to make before the termination notice deadline
+func renewalTaskDetails(e *ent.Entity, endDate, deadline time.Time) string {
+	if e.AutoRenews {
+		return fmt.Sprintf("The contract with %s renews automatically on %s. Notice of termination must be given by %s (%d days before the end date). Decide whether to renew or give notice.",
+			vendorName(e), endDate.Format(vendorDateLayout), deadline.Format(vendorDateLayout), e.TerminationNoticeDays)
+	}
+
+	return fmt.Sprintf("The contract with %s ends on %s and does not renew automatically. Decide whether to renew by %s.",
+		vendorName(e), endDate.Format(vendorDateLayout), deadline.Format(vendorDateLayout))
+}
+
+// flagLapsedSOC2 records the lapse on vendors whose reporting period ended more than the grace period ago and
+// opens a task to request an updated report; has_soc2 is left as the user set it, the lapse is kept in
+// soc2_lapsed_at until the reporting period end changes
+func (v VendorReviewSweep) flagLapsedSOC2(ctx context.Context, db *ent.Client, now time.Time, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	lapsedBefore := now.AddDate(0, 0, -v.SOC2GraceDays)
+
+	entities, err := db.Entity.Query().
+		Where(
+			activeVendorPredicate(),
+			entity.HasSoc2(true),
+			entity.Soc2PeriodEndNotNil(),
+			entity.Soc2PeriodEndLT(models.DateTime(lapsedBefore)),
+			entity.Soc2LapsedAtIsNil(),
+		).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed querying vendors with lapsed soc 2 reports")
+		return 0, err
+	}
+
+	flagged := 0
+
+	for _, e := range entities {
+		periodEnd := time.Time(*e.Soc2PeriodEnd)
+
+		entityLogger := logx.FromContext(ctx).With().
+			Str("entity_id", e.ID).
+			Str("owner_id", e.OwnerID).
+			Time("soc2_period_end", periodEnd).
+			Logger()
+
+		if v.DryRun {
+			entityLogger.Info().Msg("dry run: vendor soc 2 report would be flagged as lapsed")
+
+			flagged++
+
+			continue
+		}
+
+		if _, err := v.openVendorTask(ctx, db, e, vendorTask{
+			kind:  vendorTaskSOC2,
+			date:  periodEnd,
+			title: fmt.Sprintf("Request an updated SOC 2 report from %s", vendorName(e)),
+			details: fmt.Sprintf("The SOC 2 reporting period for %s ended on %s and no newer report is on file. Request a current report or a bridge letter and update the vendor record.",
+				vendorName(e), periodEnd.Format(vendorDateLayout)),
+			due: now.AddDate(0, 0, v.RenewalLeadDays),
+		}); err != nil {
+			return flagged, err
+		}
+
+		// the task is opened first so a lapse that fails to be recorded is picked up again without a second task
+		if err := db.Entity.UpdateOneID(e.ID).SetSoc2LapsedAt(models.DateTime(now)).Exec(ctx); err != nil {
+			entityLogger.Error().Err(err).Msg("failed to flag lapsed soc 2 report")
+			return flagged, err
+		}
+
+		flagged++
+
+		entityLogger.Info().Msg("flagged lapsed vendor soc 2 report")
+	}
+
+	return flagged, nil
+}
+
+// launchDueReviews launches a vendor assessment campaign for each vendor whose review is due and advances
+// the next review date by the review frequency; vendors without an earlier assessment campaign to repeat
+// get a review task instead
+func (v VendorReviewSweep) launchDueReviews(ctx context.Context, req types.OperationRequest, now time.Time, limit int) (int, error) {
+	if limit <= 0 {
+		return 0, nil
+	}
+
+	db := req.DB
+
+	entities, err := db.Entity.Query().
+		Where(
+			activeVendorPredicate(),
+			entity.NextReviewAtNotNil(),
+			entity.NextReviewAtLTE(models.DateTime(now)),
+		).
+		Order(entity.ByNextReviewAt()).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Msg("failed querying vendors due for review")
+		return 0, err
+	}
+
+	launched := 0
+
+	for _, e := range entities {
+		dueAt := time.Time(*e.NextReviewAt)
+
+		entityLogger := logx.FromContext(ctx).With().
+			Str("entity_id", e.ID).
+			Str("owner_id", e.OwnerID).
+			Time("next_review_at", dueAt).
+			Logger()
+
+		if v.DryRun {
+			entityLogger.Info().Msg("dry run: vendor review would be launched")
+
+			launched++
+
+			continue
+		}
+
+		if err := v.launchReview(ctx, req, e, now); err != nil {
+			// the review date is left unchanged so the next sweep dispatches the same campaign again
+			entityLogger.Error().Err(err).Msg("failed to launch vendor review")
+
+			continue
+		}
+
+		update := db.Entity.UpdateOneID(e.ID)
+
+		if next, ok := nextVendorReview(e.ReviewFrequency, dueAt, now); ok {
+			update.SetNextReviewAt(models.DateTime(next))
+		} else {
+			update.ClearNextReviewAt()
+		}
+
+		if err := update.Exec(ctx); err != nil {
+			entityLogger.Error().Err(err).Msg("failed to advance vendor review date")
+			return launched, err
+		}
+
+		launched++
+
+		entityLogger.Info().Msg("launched vendor review")
+	}
+
+	return launched, nil
+}
+
+// launchReview dispatches the campaign launched for the review, repeating the most recent assessment campaign
+// sent for the vendor when the review has none yet, or opens a review task when there is no campaign to repeat;
+// the campaign is keyed by vendor and review date, so a sweep retrying a failed dispatch sends the same campaign
+// rather than creating another
+func (v VendorReviewSweep) launchReview(ctx context.Context, req types.OperationRequest, e *ent.Entity, now time.Time) error {
+	dueAt := time.Time(*e.NextReviewAt)
+	key := vendorReviewKey(vendorTaskReview, e, dueAt)
+
+	camp, err := req.DB.Campaign.Query().
+		Where(
+			campaign.EntityIDEQ(e.ID),
+			campaign.OwnerIDEQ(e.OwnerID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueEQ(campaign.FieldMetadata, key, sqljson.Path(vendorReviewKeyMetadata)))
+			},
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return err
+	}
+
+	if camp == nil {
+		previous, err := req.DB.Campaign.Query().
+			Where(
+				campaign.EntityIDEQ(e.ID),
+				campaign.OwnerIDEQ(e.OwnerID),
+				campaign.CampaignTypeIn(enums.CampaignTypeVendorAssessment, enums.CampaignTypeQuestionnaire),
+			).
+			Order(campaign.ByCreatedAt(sql.OrderDesc())).
+			First(ctx)
+		if err != nil && !ent.IsNotFound(err) {
+			return err
+		}
+
+		if previous == nil {
+			_, err := v.openVendorTask(ctx, req.DB, e, vendorTask{
+				kind:  vendorTaskReview,
+				date:  dueAt,
+				title: fmt.Sprintf("Review vendor %s", vendorName(e)),
+				details: fmt.Sprintf("%s was due for review on %s. No earlier vendor assessment campaign was found to repeat, so send an assessment and record the review.",
+					vendorName(e), dueAt.Format(vendorDateLayout)),
+				due: now.AddDate(0, 0, v.RenewalLeadDays),
+			})
+
+			return err
+		}
+
+		camp, err = workflows.WithTx(ctx, req.DB, nil, func(tx *ent.Tx) (*ent.Campaign, error) {
+			return repeatVendorCampaign(ctx, tx.Client(), e, previous, key, now)
+		})
+		if err != nil {
+			return err
+		}
+
+		if camp == nil {
+			return nil
+		}
+	}
+
+	return emaildef.DispatchCampaign(ctx, req, camp, false)
+}
+
+// repeatVendorCampaign creates an active copy of the previous campaign with the same recipients, recording the
+// review key in its metadata; it returns nil when the previous campaign had no recipients to send to
+func repeatVendorCampaign(ctx context.Context, db *ent.Client, e *ent.Entity, previous *ent.Campaign, key string, now time.Time) (*ent.Campaign, error) {
+	targets, err := db.CampaignTarget.Query().
+		Where(campaigntarget.CampaignIDEQ(previous.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(targets) == 0 {
+		logx.FromContext(ctx).Warn().Str("entity_id", e.ID).Str("campaign_id", previous.ID).Msg("previous vendor assessment campaign has no recipients, skipping launch")
+
+		return nil, nil
+	}
+
+	create := db.Campaign.Create().
+		SetOwnerID(e.OwnerID).
+		SetName(fmt.Sprintf("%s vendor review %s", vendorName(e), now.Format(vendorDateLayout))).
+		SetCampaignType(previous.CampaignType).
+		SetStatus(enums.CampaignStatusActive).
+		SetIsActive(true).
+		SetLaunchedAt(models.DateTime(now)).
+		SetEntityID(e.ID).
+		SetRecipientCount(len(targets)).
+		SetMetadata(lo.Assign(previous.Metadata, map[string]any{
+			"source":                vendorReviewTaskSource,
+			"repeated_campaign_id":  previous.ID,
+			vendorReviewKeyMetadata: key,
+		}))
+
+	if previous.Description != "" {
+		create.SetDescription(previous.Description)
+	}
+
+	if previous.TemplateID != "" {
+		create.SetTemplateID(previous.TemplateID)
+	}
+
+	if previous.AssessmentID != "" {
+		create.SetAssessmentID(previous.AssessmentID)
+	}
+
+	if previous.EmailTemplateID != "" {
+		create.SetEmailTemplateID(previous.EmailTemplateID)
+	}
+
+	if previous.EmailBrandingID != "" {
+		create.SetEmailBrandingID(previous.EmailBrandingID)
+	}
+
+	if previous.IntegrationID != "" {
+		create.SetIntegrationID(previous.IntegrationID)
+	}
+
+	// keep the same response window as the previous campaign
+	if previous.DueDate != nil && previous.LaunchedAt != nil {
+		window := time.Time(*previous.DueDate).Sub(time.Time(*previous.LaunchedAt))
+		if window > 0 {
+			create.SetDueDate(models.DateTime(now.Add(window)))
+		}
+	}
+
+	camp, err := create.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builders := make([]*ent.CampaignTargetCreate, 0, len(targets))
+
+	for _, t := range targets {
+		b := db.CampaignTarget.Create().
+			SetOwnerID(e.OwnerID).
+			SetCampaignID(camp.ID).
+			SetEmail(t.Email)
+
+		if t.FullName != "" {
+			b.SetFullName(t.FullName)
+		}
+
+		if t.ContactID != "" {
+			b.SetContactID(t.ContactID)
+		}
+
+		if t.UserID != "" {
+			b.SetUserID(t.UserID)
+		}
+
+		if t.GroupID != "" {
+			b.SetGroupID(t.GroupID)
+		}
+
+		builders = append(builders, b)
+	}
+
+	if err := db.CampaignTarget.CreateBulk(builders...).Exec(ctx); err != nil {
+		return nil, err
+	}
+
+	return camp, nil
+}
+
+// nextVendorReview advances the review date by the review frequency until it is after now; frequencies
+// without calendar semantics have no next review
+func nextVendorReview(frequency enums.Frequency, dueAt, now time.Time) (time.Time, bool) {
+	next := dueAt
+
+	for !next.After(now) {
+		advanced := frequency.NextOccurrence(next, 1, "")
+		if !advanced.After(next) {
+			return time.Time{}, false
+		}
+
+		next = advanced
+	}
+
+	return next, true
+}
+
+// vendorTask is a task opened by the vendor review sweep
+type vendorTask struct {
+	// kind is the task key, one of the vendorTask constants
+	kind string
+	// date is the date the task is about, used with the kind to open the task only once
+	date time.Time
+	// title of the task
+	title string
+	// details of the task
+	details string
+	// due date of the task
+	due time.Time
+}
+
+// openVendorTask opens the task unless one was already opened for the same vendor, kind and date; it
+// returns whether a task was opened
+func (v VendorReviewSweep) openVendorTask(ctx context.Context, db *ent.Client, e *ent.Entity, t vendorTask) (bool, error) {
+	idempotencyKey := vendorReviewKey(t.kind, e, t.date)
+
+	entityLogger := logx.FromContext(ctx).With().
+		Str("entity_id", e.ID).
+		Str("owner_id", e.OwnerID).
+		Str("task_kind", t.kind).
+		Logger()
+
+	exists, err := db.Task.Query().
+		Where(
+			task.OwnerIDEQ(e.OwnerID),
+			task.IdempotencyKeyEQ(idempotencyKey),
+		).
+		Exist(ctx)
+	if err != nil {
+		entityLogger.Error().Err(err).Msg("failed checking for existing vendor review task")
+		return false, err
+	}
+
+	if exists {
+		return false, nil
+	}
+
+	if v.DryRun {
+		entityLogger.Info().Msg("dry run: vendor review task would be opened")
+
+		return true, nil
+	}
+
+	create := db.Task.Create().
+		SetOwnerID(e.OwnerID).
+		SetTitle(t.title).
+		SetDetails(t.details).
+		SetDue(models.DateTime(t.due)).
+		SetSystemGenerated(true).
+		SetSource(vendorReviewTaskSource).
+		SetSourceKey(t.kind).
+		SetIdempotencyKey(idempotencyKey).
+		SetMetadata(map[string]any{"entity_id": e.ID})
+
+	if e.InternalOwnerUserID != "" {
+		create.SetAssigneeID(e.InternalOwnerUserID)
+	}
+
+	if err := create.Exec(ctx); err != nil {
+		entityLogger.Error().Err(err).Msg("failed to open vendor review task")
+		return false, err
+	}
+
+	entityLogger.Info().Msg("opened vendor review task")
+
+	return true, nil
+}
+
+// vendorReviewKey identifies one action of the sweep for a vendor by its kind and the date it is about
+func vendorReviewKey(kind string, e *ent.Entity, date time.Time) string {
+	return fmt.Sprintf("%s:%s:%s:%s", vendorReviewTaskSource, kind, e.ID, date.Format(vendorDateLayout))
+}
+
+// vendorName is the name shown for the vendor in tasks and campaigns
+func vendorName(e *ent.Entity) string {
+	return lo.CoalesceOrEmpty(e.DisplayName, e.Name)
+}
@@ -27,6 +27,49 @@ const (
 	OrganizationDeleteMinInterval = 24 * time.Hour
 	// OrganizationDeleteMaxInterval is the maximum polling interval for organization deletion sweeps
 	OrganizationDeleteMaxInterval = 24 * time.Hour
+	// DefaultRetentionPurgeMaxPerRun is the default maximum number of files purged per sweep
+	DefaultRetentionPurgeMaxPerRun = 100
+	// RetentionPurgeMinInterval is the minimum polling interval for retention purge sweeps
+	RetentionPurgeMinInterval = 6 * time.Hour
+	// RetentionPurgeMaxInterval is the maximum polling interval for retention purge sweeps
+	RetentionPurgeMaxInterval = 24 * time.Hour
+	// DefaultVendorRenewalLeadDays is the default number of days before the termination notice deadline
+	// that a renewal decision task is opened
+	DefaultVendorRenewalLeadDays = 30
+	// DefaultVendorReviewMaxPerRun is the default maximum number of vendors acted on per sweep
+	DefaultVendorReviewMaxPerRun = 100
+	// VendorReviewMinInterval is the minimum polling interval for vendor review sweeps
+	VendorReviewMinInterval = 12 * time.Hour
+	// VendorReviewMaxInterval is the maximum polling interval for vendor review sweeps
+	VendorReviewMaxInterval = 24 * time.Hour
+	// DefaultUsageReportEventNamePrefix is the default prefix of the Stripe meter event names usage is reported to
+	DefaultUsageReportEventNamePrefix = "openlane_"
+	// UsageReportMinInterval is the minimum polling interval for usage report sweeps
+	UsageReportMinInterval = 12 * time.Hour
+	// UsageReportMaxInterval is the maximum polling interval for usage report sweeps
+	UsageReportMaxInterval = 24 * time.Hour
+	// DefaultTupleConsistencyMaxPerRun is the default maximum number of organizations checked per sweep
+	DefaultTupleConsistencyMaxPerRun = 50
+	// TupleConsistencyMinInterval is the minimum polling interval for tuple consistency sweeps
+	TupleConsistencyMinInterval = 24 * time.Hour
+	// TupleConsistencyMaxInterval is the maximum polling interval for tuple consistency sweeps
+	TupleConsistencyMaxInterval = 7 * 24 * time.Hour
+	// DefaultResumableUploadCleanupMaxPerRun is the default maximum number of expired resumable uploads removed per sweep
+	DefaultResumableUploadCleanupMaxPerRun = 500
+	// ResumableUploadCleanupMinInterval is the minimum polling interval for resumable upload cleanup sweeps
+	ResumableUploadCleanupMinInterval = time.Hour
+	// ResumableUploadCleanupMaxInterval is the maximum polling interval for resumable upload cleanup sweeps
+	ResumableUploadCleanupMaxInterval = 6 * time.Hour
+	// LicenseExpiryMinInterval is the minimum polling interval for license expiry sweeps
+	LicenseExpiryMinInterval = 30 * time.Minute
+	// LicenseExpiryMaxInterval is the maximum polling interval for license expiry sweeps
+	LicenseExpiryMaxInterval = time.Hour
+	// DefaultFileRescanMaxPerRun is the default maximum number of quarantined files rescanned per sweep
+	DefaultFileRescanMaxPerRun = 100
+	// FileRescanMinInterval is the minimum polling interval for file rescan sweeps
+	FileRescanMinInterval = 15 * time.Minute
+	// FileRescanMaxInterval is the maximum polling interval for file rescan sweeps
+	FileRescanMaxInterval = time.Hour
 )
 
 // PaymentReminderConfig contains the operator configuration for the payment reminder sweep
@@ -51,6 +94,67 @@ type OrganizationDeleteConfig struct {
 	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"description=Whether the organization deletion listener is enabled"`
 }
 
+// RetentionPurgeConfig contains the operator configuration for the retention purge sweep
+type RetentionPurgeConfig struct {
+	// MaxPurgesPerRun caps how many files are purged per sweep
+	MaxPurgesPerRun int `json:"maxpurgesperrun" koanf:"maxpurgesperrun" jsonschema:"default=100,description=Maximum files to purge per run"`
+	// Enabled controls whether the retention purge sweep is seeded at startup
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"default=false,description=Whether the retention purge listener is enabled"`
+	// DryRun logs files that would be purged without deleting them
+	DryRun bool `json:"dryrun" koanf:"dryrun" jsonschema:"default=true,description=If true only log file IDs that would be purged"`
+}
+
+// VendorReviewConfig contains the operator configuration for the vendor contract renewal and review sweep
+type VendorReviewConfig struct {
+	// RenewalLeadDays is the number of days before the termination notice deadline that a renewal decision task is opened
+	RenewalLeadDays int `json:"renewalleaddays" koanf:"renewalleaddays" jsonschema:"default=30,description=Days before the termination notice deadline to open a renewal decision task"`
+	// SOC2GraceDays is the number of days after the SOC 2 reporting period ends before the report is considered lapsed
+	SOC2GraceDays int `json:"soc2gracedays" koanf:"soc2gracedays" jsonschema:"default=0,description=Days after the SOC 2 period end before the report is flagged as lapsed"`
+	// MaxActionsPerRun caps how many vendors are acted on per sweep
+	MaxActionsPerRun int `json:"maxactionsperrun" koanf:"maxactionsperrun" jsonschema:"default=100,description=Maximum vendors to act on per run"`
+	// Enabled controls whether the vendor review sweep is seeded at startup
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"default=false,description=Whether the vendor review listener is enabled"`
+	// DryRun logs vendors that would be acted on without opening tasks or launching campaigns
+	DryRun bool `json:"dryrun" koanf:"dryrun" jsonschema:"default=true,description=If true only log vendor IDs that would be acted on"`
+}
+
+// UsageReportConfig contains the operator configuration for the sweep reporting metered usage to Stripe
+type UsageReportConfig struct {
+	// EventNamePrefix is prepended to the metric name to build the Stripe meter event name
+	EventNamePrefix string `json:"eventnameprefix" koanf:"eventnameprefix" jsonschema:"default=openlane_,description=Prefix of the Stripe meter event names usage is reported to"`
+	// Enabled controls whether the usage report sweep is seeded at startup
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"default=false,description=Whether the usage report listener is enabled"`
+	// DryRun logs the usage that would be reported without sending it to Stripe
+	DryRun bool `json:"dryrun" koanf:"dryrun" jsonschema:"default=true,description=If true only log the usage that would be reported"`
+}
+
+// TupleConsistencyConfig contains the operator configuration for the sweep checking authorization tuples against the database
+type TupleConsistencyConfig struct {
+	// MaxOrganizationsPerRun caps how many organizations are checked per sweep
+	MaxOrganizationsPerRun int `json:"maxorganizationsperrun" koanf:"maxorganizationsperrun" jsonschema:"default=50,description=Maximum organizations to check per run"`
+	// BatchSize is the number of tuples written or deleted per repair request
+	BatchSize int `json:"batchsize" koanf:"batchsize" jsonschema:"default=50,description=Tuples written or deleted per repair request"`
+	// Enabled controls whether the tuple consistency sweep is seeded at startup
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"default=false,description=Whether the tuple consistency listener is enabled"`
+	// DryRun logs the drift that is found without repairing it
+	DryRun bool `json:"dryrun" koanf:"dryrun" jsonschema:"default=true,description=If true only log missing and orphaned tuples"`
+}
+
+// ResumableUploadCleanupConfig contains the operator configuration for the sweep removing expired resumable uploads
+type ResumableUploadCleanupConfig struct {
+	// MaxRemovalsPerRun caps how many expired uploads are removed per sweep
+	MaxRemovalsPerRun int `json:"maxremovalsperrun" koanf:"maxremovalsperrun" jsonschema:"default=500,description=Maximum expired resumable uploads to remove per run"`
+	// Enabled controls whether the resumable upload cleanup sweep is seeded at startup
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"default=false,description=Whether the resumable upload cleanup listener is enabled"`
+}
+
+// LicenseExpiryConfig contains the operator configuration for the sweep reloading the offline license file and
+// revoking its modules once it expires
+type LicenseExpiryConfig struct {
+	// Enabled controls whether the license expiry sweep is seeded at startup
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"default=false,description=Whether the license expiry listener is enabled"`
+}
+
 // Sweep maps the operator configuration to its sweep defaults
 func (c PaymentReminderConfig) Sweep() PaymentReminderSweep {
 	return PaymentReminderSweep{
@@ -65,6 +169,58 @@ func (c OrganizationDeleteConfig) Sweep() OrganizationDeleteSweep {
 	return OrganizationDeleteSweep{MaxDeletesPerRun: c.MaxDeletesPerRun}
 }
 
+// Sweep maps the operator configuration to its sweep defaults
+func (c RetentionPurgeConfig) Sweep() RetentionPurgeSweep {
+	return RetentionPurgeSweep{MaxPurgesPerRun: c.MaxPurgesPerRun, DryRun: c.DryRun}
+}
+
+// Sweep maps the operator configuration to its sweep defaults
+func (c VendorReviewConfig) Sweep() VendorReviewSweep {
+	return VendorReviewSweep{
+		RenewalLeadDays:  c.RenewalLeadDays,
+		SOC2GraceDays:    c.SOC2GraceDays,
+		MaxActionsPerRun: c.MaxActionsPerRun,
+		DryRun:           c.DryRun,
+	}
+}
+
+// Sweep maps the operator configuration to its sweep defaults
+func (c UsageReportConfig) Sweep() UsageReportSweep {
+	return UsageReportSweep{EventNamePrefix: c.EventNamePrefix, DryRun: c.DryRun}
+}
+
+// Sweep maps the operator configuration to its sweep defaults
+func (c TupleConsistencyConfig) Sweep() TupleConsistencySweep {
+	return TupleConsistencySweep{
+		MaxOrganizationsPerRun: c.MaxOrganizationsPerRun,
+		BatchSize:              c.BatchSize,
+		DryRun:                 c.DryRun,
+	}
+}
+
+// Sweep maps the operator configuration to its sweep defaults
+func (c ResumableUploadCleanupConfig) Sweep() ResumableUploadCleanupSweep {
+	return ResumableUploadCleanupSweep{MaxRemovalsPerRun: c.MaxRemovalsPerRun}
+}
+
+// FileRescanConfig contains the operator configuration for the sweep rescanning files whose content scan did not complete
+type FileRescanConfig struct {
+	// MaxFilesPerRun caps how many quarantined files are rescanned per sweep
+	MaxFilesPerRun int `json:"maxfilesperrun" koanf:"maxfilesperrun" jsonschema:"default=100,description=Maximum pending or errored files to rescan per run"`
+	// Enabled controls whether the file rescan sweep is seeded at startup
+	Enabled bool `json:"enabled" koanf:"enabled" jsonschema:"default=false,description=Whether the file rescan listener is enabled"`
+}
+
+// Sweep maps the operator configuration to its sweep defaults
+func (c LicenseExpiryConfig) Sweep() LicenseExpirySweep {
+	return LicenseExpirySweep{}
+}
+
+// Sweep maps the operator configuration to its sweep defaults
+func (c FileRescanConfig) Sweep() FileRescanSweep {
+	return FileRescanSweep{MaxFilesPerRun: c.MaxFilesPerRun}
+}
+
 // PaymentReminderSweep configures one payment reminder sweep cycle
 type PaymentReminderSweep struct {
 	// PaymentMethodInterval is the number of days after cancellation before an org is marked for deletion
@@ -81,7 +237,72 @@ type OrganizationDeleteSweep struct {
 	MaxDeletesPerRun int `json:"maxDeletesPerRun,omitempty"`
 }
 
+// RetentionPurgeSweep configures one retention purge sweep cycle
+type RetentionPurgeSweep struct {
+	// MaxPurgesPerRun caps how many files are purged during the cycle
+	MaxPurgesPerRun int `json:"maxPurgesPerRun,omitempty"`
+	// DryRun logs files that would be purged without deleting them
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// VendorReviewSweep configures one vendor review sweep cycle
+type VendorReviewSweep struct {
+	// RenewalLeadDays is the number of days before the termination notice deadline that a renewal decision task is opened
+	RenewalLeadDays int `json:"renewalLeadDays,omitempty"`
+	// SOC2GraceDays is the number of days after the SOC 2 reporting period ends before the report is considered lapsed
+	SOC2GraceDays int `json:"soc2GraceDays,omitempty"`
+	// MaxActionsPerRun caps how many vendors are acted on during the cycle
+	MaxActionsPerRun int `json:"maxActionsPerRun,omitempty"`
+	// DryRun logs vendors that would be acted on without opening tasks or launching campaigns
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// UsageReportSweep configures one usage report sweep cycle
+type UsageReportSweep struct {
+	// EventNamePrefix is prepended to the metric name to build the Stripe meter event name
+	EventNamePrefix string `json:"eventNamePrefix,omitempty"`
+	// DryRun logs the usage that would be reported without sending it to Stripe
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// TupleConsistencySweep configures one tuple consistency sweep cycle
+type TupleConsistencySweep struct {
+	// MaxOrganizationsPerRun caps how many organizations are checked during the cycle
+	MaxOrganizationsPerRun int `json:"maxOrganizationsPerRun,omitempty"`
+	// BatchSize is the number of tuples written or deleted per repair request
+	BatchSize int `json:"batchSize,omitempty"`
+	// Schemas limits the check to the given schemas, e.g. control; all schemas are checked when empty
+	Schemas []string `json:"schemas,omitempty"`
+	// DryRun logs the drift that is found without repairing it
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// ResumableUploadCleanupSweep configures one resumable upload cleanup sweep cycle
+type ResumableUploadCleanupSweep struct {
+	// MaxRemovalsPerRun caps how many expired uploads are removed during the cycle
+	MaxRemovalsPerRun int `json:"maxRemovalsPerRun,omitempty"`
+}
+
+// LicenseExpirySweep configures one license expiry sweep cycle
+type LicenseExpirySweep struct {
+	// SkipReload applies the loaded license without reading the license file again
+	SkipReload bool `json:"skipReload,omitempty"`
+}
+
+// FileRescanSweep configures one file rescan sweep cycle
+type FileRescanSweep struct {
+	// MaxFilesPerRun caps how many quarantined files are rescanned during the cycle
+	MaxFilesPerRun int `json:"maxFilesPerRun,omitempty"`
+}
+
 var (
-	paymentReminderSweepSchema, PaymentReminderOp       = providerkit.OperationSchema[PaymentReminderSweep]()    //nolint:revive
-	organizationDeleteSweepSchema, OrganizationDeleteOp = providerkit.OperationSchema[OrganizationDeleteSweep]() //nolint:revive
+	paymentReminderSweepSchema, PaymentReminderOp               = providerkit.OperationSchema[PaymentReminderSweep]()        //nolint:revive
+	organizationDeleteSweepSchema, OrganizationDeleteOp         = providerkit.OperationSchema[OrganizationDeleteSweep]()     //nolint:revive
+	retentionPurgeSweepSchema, RetentionPurgeOp                 = providerkit.OperationSchema[RetentionPurgeSweep]()         //nolint:revive
+	vendorReviewSweepSchema, VendorReviewOp                     = providerkit.OperationSchema[VendorReviewSweep]()           //nolint:revive
+	usageReportSweepSchema, UsageReportOp                       = providerkit.OperationSchema[UsageReportSweep]()            //nolint:revive
+	tupleConsistencySweepSchema, TupleConsistencyOp             = providerkit.OperationSchema[TupleConsistencySweep]()       //nolint:revive
+	resumableUploadCleanupSweepSchema, ResumableUploadCleanupOp = providerkit.OperationSchema[ResumableUploadCleanupSweep]() //nolint:revive
+	licenseExpirySweepSchema, LicenseExpiryOp                   = providerkit.OperationSchema[LicenseExpirySweep]()          //nolint:revive
+	fileRescanSweepSchema, FileRescanOp                         = providerkit.OperationSchema[FileRescanSweep]()             //nolint:revive
 )
@@ -0,0 +1,5 @@
+// Package metering measures the usage of an organization against the limits declared in the module catalog.
+// Each module and addon may declare usage limits; the limits of the modules an organization has are added up
+// to find its soft limit, and usage may grow past it by the overage allowance before creates are rejected.
+// Metrics without a declared limit are unlimited
+package metering
@@ -0,0 +1,52 @@
+package metering
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/graphapi/gqlerrors"
+)
+
+// ErrQuotaExceeded is returned when a create would take usage past the hard limit of the organization
+var ErrQuotaExceeded = errors.New("plan quota exceeded")
+
+var _ gqlerrors.CustomErrorType = (*QuotaError)(nil)
+
+// QuotaError describes the quota a create would exceed
+type QuotaError struct {
+	// Metric is the metric over its limit
+	Metric Metric
+	// Used is the current usage
+	Used int64
+	// Requested is the usage the create would add
+	Requested int64
+	// Limit is the limit of the metric
+	Limit Limit
+}
+
+// Error satisfies the error interface
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("%s: %s usage of %d plus %d exceeds the limit of %d", ErrQuotaExceeded, e.Metric, e.Used, e.Requested, e.Limit.Hard)
+}
+
+// Unwrap returns ErrQuotaExceeded
+func (e *QuotaError) Unwrap() error {
+	return ErrQuotaExceeded
+}
+
+// Code satisfies the gqlerrors.CustomErrorType interface
+func (e *QuotaError) Code() string {
+	return gqlerrors.QuotaExceededErrorCode
+}
+
+// Message satisfies the gqlerrors.CustomErrorType interface
+func (e *QuotaError) Message() string {
+	return fmt.Sprintf("your plan allows %d %s and this request would bring usage to %d, upgrade your plan or remove unused items to continue",
+		e.Limit.Soft, e.Metric, e.Used+e.Requested)
+}
+
+// Module satisfies the gqlerrors.CustomErrorType interface
+func (e *QuotaError) Module() models.OrgModule {
+	return ""
+}
@@ -0,0 +1,173 @@
+package metering
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/file"
+	"github.com/theopenlane/core/internal/ent/generated/integrationrun"
+	"github.com/theopenlane/core/internal/ent/generated/organization"
+	"github.com/theopenlane/core/internal/ent/generated/orgmembership"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/ent/generated/program"
+	"github.com/theopenlane/core/internal/ent/privacy/rule"
+	"github.com/theopenlane/core/pkg/catalog/gencatalog"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// Usage is the measured usage of a metric and its limit
+type Usage struct {
+	// Metric is the measured metric
+	Metric Metric
+	// Used is the current usage
+	Used int64
+	// Limit is the limit of the metric
+	Limit Limit
+	// Status describes how usage compares to the limit
+	Status Status
+}
+
+// PeriodStart returns the start of the calendar month metered usage is counted from
+func PeriodStart(now time.Time) time.Time {
+	now = now.UTC()
+
+	return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Measure returns the current usage of a metric for the organization
+func Measure(ctx context.Context, client *generated.Client, orgID string, metric Metric, now time.Time) (int64, error) {
+	// usage is counted across the whole organization regardless of what the caller can see
+	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+
+	switch metric {
+	case MetricStorageBytes:
+		var v []struct {
+			Sum sql.NullInt64 `json:"sum"`
+		}
+
+		if err := client.File.Query().
+			Where(file.HasOrganizationWith(organization.ID(orgID))).
+			Aggregate(generated.As(generated.Sum(file.FieldPersistedFileSize), "sum")).
+			Scan(allowCtx, &v); err != nil {
+			return 0, err
+		}
+
+		if len(v) == 0 {
+			return 0, nil
+		}
+
+		return v[0].Sum.Int64, nil
+	case MetricSeats:
+		n, err := client.OrgMembership.Query().
+			Where(orgmembership.OrganizationID(orgID)).
+			Count(allowCtx)
+
+		return int64(n), err
+	case MetricIntegrationRuns:
+		n, err := client.IntegrationRun.Query().
+			Where(
+				integrationrun.OwnerID(orgID),
+				integrationrun.CreatedAtGTE(PeriodStart(now)),
+			).
+			Count(allowCtx)
+
+		return int64(n), err
+	case MetricActivePrograms:
+		n, err := client.Program.Query().
+			Where(
+				program.OwnerID(orgID),
+				program.StatusNotIn(enums.ProgramStatusCompleted, enums.ProgramStatusArchived),
+			).
+			Count(allowCtx)
+
+		return int64(n), err
+	default:
+		return 0, nil
+	}
+}
+
+// OrganizationLimits returns the limits of the organization from the modules it has
+func OrganizationLimits(ctx context.Context, client *generated.Client, orgID string) (map[Metric]Limit, error) {
+	features, err := rule.GetFeaturesForSpecificOrganization(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	return Limits(catalog(client), features), nil
+}
+
+// Snapshot measures every metric of the organization
+func Snapshot(ctx context.Context, client *generated.Client, orgID string, now time.Time) ([]Usage, error) {
+	limits, err := OrganizationLimits(ctx, client, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Usage, 0, len(Metrics))
+
+	for _, m := range Metrics {
+		used, err := Measure(ctx, client, orgID, m, now)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, Usage{
+			Metric: m,
+			Used:   used,
+			Limit:  limits[m],
+			Status: limits[m].Status(used),
+		})
+	}
+
+	return out, nil
+}
+
+// Enforce returns a QuotaError when adding delta to the usage of the metric would pass the hard limit of the
+// organization, and logs a warning when it passes the soft limit
+func Enforce(ctx context.Context, client *generated.Client, orgID string, metric Metric, delta int64) error {
+	if orgID == "" || delta <= 0 {
+		return nil
+	}
+
+	limits, err := OrganizationLimits(ctx, client, orgID)
+	if err != nil {
+		return err
+	}
+
+	limit := limits[metric]
+	if limit.Unlimited {
+		return nil
+	}
+
+	used, err := Measure(ctx, client, orgID, metric, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !limit.Allows(used, delta) {
+		return &QuotaError{
+			Metric:    metric,
+			Used:      used,
+			Requested: delta,
+			Limit:     limit,
+		}
+	}
+
+	if used+delta > limit.Soft {
+		logx.FromContext(ctx).Warn().Str("organization_id", orgID).Str("metric", metric.String()).
+			Int64("usage", used+delta).Int64("soft_limit", limit.Soft).Msg("organization usage is over its plan limit")
+	}
+
+	return nil
+}
+
+// catalog returns the module catalog the client checks modules against
+func catalog(client *generated.Client) models.Catalog {
+	useSandbox := client.EntConfig != nil && client.EntConfig.Modules.UseSandbox
+
+	return gencatalog.GetDefaultCatalog(useSandbox)
+}
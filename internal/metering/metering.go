@@ -0,0 +1,141 @@
+package metering
+
+import (
+	"strconv"
+
+	"github.com/theopenlane/core/common/models"
+)
+
+// Metric is a measured usage dimension of an organization
+type Metric string
+
+const (
+	// MetricStorageBytes is the combined size of the files of the organization in bytes
+	MetricStorageBytes Metric = "storage_bytes"
+	// MetricSeats is the number of members of the organization
+	MetricSeats Metric = "seats"
+	// MetricIntegrationRuns is the number of integration runs in the current calendar month
+	MetricIntegrationRuns Metric = "integration_runs"
+	// MetricActivePrograms is the number of programs that are not completed or archived
+	MetricActivePrograms Metric = "active_programs"
+)
+
+// Metrics is every metric in the order they are reported
+var Metrics = []Metric{MetricStorageBytes, MetricSeats, MetricIntegrationRuns, MetricActivePrograms}
+
+// String returns the metric name
+func (m Metric) String() string {
+	return string(m)
+}
+
+// Status describes how usage compares to its limits
+type Status string
+
+const (
+	// StatusOK is usage within the soft limit
+	StatusOK Status = "OK"
+	// StatusOverSoftLimit is usage past the soft limit that is still within the overage allowance
+	StatusOverSoftLimit Status = "OVER_SOFT_LIMIT"
+	// StatusAtHardLimit is usage that has reached the hard limit, creates that add to it are rejected
+	StatusAtHardLimit Status = "AT_HARD_LIMIT"
+)
+
+// bytesPerGB is the number of bytes in a gigabyte of storage
+const bytesPerGB int64 = 1 << 30
+
+// incrementGBKey is the price metadata key of addons that add storage
+const incrementGBKey = "increment_gb"
+
+// percent is the divisor of the overage allowance
+const percent = 100
+
+// Limit is the quota of a metric
+type Limit struct {
+	// Soft is the declared limit, usage past it is allowed and logged
+	Soft int64
+	// Hard is the soft limit plus the overage allowance, usage past it is rejected
+	Hard int64
+	// Unlimited is set when no module of the organization declares a limit for the metric
+	Unlimited bool
+}
+
+// Allows reports whether delta can be added to used without passing the hard limit
+func (l Limit) Allows(used, delta int64) bool {
+	return l.Unlimited || used+delta <= l.Hard
+}
+
+// Status returns how used compares to the limit
+func (l Limit) Status(used int64) Status {
+	switch {
+	case l.Unlimited:
+		return StatusOK
+	case used >= l.Hard:
+		return StatusAtHardLimit
+	case used > l.Soft:
+		return StatusOverSoftLimit
+	default:
+		return StatusOK
+	}
+}
+
+// Limits returns the limit of every metric for an organization with the given modules and addons
+func Limits(cat models.Catalog, features []string) map[Metric]Limit {
+	soft := map[Metric]int64{}
+
+	var allowance int64
+
+	for _, name := range features {
+		f, ok := cat.Modules[name]
+		if !ok {
+			f, ok = cat.Addons[name]
+		}
+
+		if !ok {
+			continue
+		}
+
+		soft[MetricStorageBytes] += storageIncrementGB(f) * bytesPerGB
+
+		if f.Usage == nil {
+			continue
+		}
+
+		soft[MetricStorageBytes] += f.Usage.EvidenceStorageGB * bytesPerGB
+		soft[MetricSeats] += f.Usage.Seats
+		soft[MetricIntegrationRuns] += f.Usage.IntegrationRunsPerMonth
+		soft[MetricActivePrograms] += f.Usage.ActivePrograms
+		allowance = max(allowance, f.Usage.OverageAllowancePercent)
+	}
+
+	limits := make(map[Metric]Limit, len(Metrics))
+
+	for _, m := range Metrics {
+		s := soft[m]
+		if s <= 0 {
+			limits[m] = Limit{Unlimited: true}
+
+			continue
+		}
+
+		limits[m] = Limit{
+			Soft: s,
+			Hard: s + s*allowance/percent,
+		}
+	}
+
+	return limits
+}
+
+// storageIncrementGB returns the storage an addon adds, declared on its prices
+func storageIncrementGB(f models.Feature) int64 {
+	for _, p := range f.Billing.Prices {
+		if v, ok := p.Metadata[incrementGBKey]; ok {
+			gb, err := strconv.ParseInt(v, 10, 64)
+			if err == nil && gb > 0 {
+				return gb
+			}
+		}
+	}
+
+	return 0
+}
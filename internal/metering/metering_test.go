@@ -0,0 +1,92 @@
+package metering
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/theopenlane/core/common/models"
+)
+
+func testCatalog() models.Catalog {
+	return models.Catalog{
+		Modules: models.FeatureSet{
+			"base_module": {},
+			"compliance_module": {
+				Usage: &models.Usage{
+					EvidenceStorageGB:       10,
+					Seats:                   20,
+					ActivePrograms:          5,
+					OverageAllowancePercent: 10,
+				},
+			},
+			"vendor_module": {
+				Usage: &models.Usage{
+					Seats:                   5,
+					OverageAllowancePercent: 20,
+				},
+			},
+		},
+		Addons: models.FeatureSet{
+			"extra_storage": {
+				Billing: models.Billing{
+					Prices: []models.ItemPrice{{Metadata: map[string]string{"increment_gb": "100"}}},
+				},
+			},
+		},
+	}
+}
+
+func TestLimits(t *testing.T) {
+	t.Run("no modules", func(t *testing.T) {
+		limits := Limits(testCatalog(), nil)
+
+		for _, m := range Metrics {
+			assert.True(t, limits[m].Unlimited, m)
+		}
+	})
+
+	t.Run("single module", func(t *testing.T) {
+		limits := Limits(testCatalog(), []string{"base_module", "compliance_module", "unknown_module"})
+
+		assert.Equal(t, Limit{Soft: 10 * bytesPerGB, Hard: 11 * bytesPerGB}, limits[MetricStorageBytes])
+		assert.Equal(t, Limit{Soft: 20, Hard: 22}, limits[MetricSeats])
+		assert.Equal(t, Limit{Soft: 5, Hard: 5}, limits[MetricActivePrograms])
+		assert.True(t, limits[MetricIntegrationRuns].Unlimited)
+	})
+
+	t.Run("modules and addons add up", func(t *testing.T) {
+		limits := Limits(testCatalog(), []string{"compliance_module", "vendor_module", "extra_storage"})
+
+		assert.Equal(t, Limit{Soft: 110 * bytesPerGB, Hard: 132 * bytesPerGB}, limits[MetricStorageBytes])
+		assert.Equal(t, Limit{Soft: 25, Hard: 30}, limits[MetricSeats])
+	})
+}
+
+func TestLimitStatus(t *testing.T) {
+	limit := Limit{Soft: 10, Hard: 12}
+
+	assert.Equal(t, StatusOK, limit.Status(10))
+	assert.Equal(t, StatusOverSoftLimit, limit.Status(11))
+	assert.Equal(t, StatusAtHardLimit, limit.Status(12))
+	assert.Equal(t, StatusOK, Limit{Unlimited: true}.Status(1000))
+
+	assert.True(t, limit.Allows(10, 2))
+	assert.False(t, limit.Allows(11, 2))
+	assert.True(t, Limit{Unlimited: true}.Allows(1000, 1000))
+}
+
+func TestQuotaError(t *testing.T) {
+	err := &QuotaError{Metric: MetricSeats, Used: 22, Requested: 1, Limit: Limit{Soft: 20, Hard: 22}}
+
+	assert.True(t, errors.Is(err, ErrQuotaExceeded))
+	assert.Equal(t, "QUOTA_EXCEEDED", err.Code())
+	assert.Contains(t, err.Message(), "your plan allows 20 seats")
+}
+
+func TestPeriodStart(t *testing.T) {
+	assert.Equal(t, time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC),
+		PeriodStart(time.Date(2026, time.March, 17, 23, 0, 0, 0, time.FixedZone("x", -3600))))
+}
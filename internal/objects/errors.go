@@ -27,4 +27,6 @@ var (
 	ErrNoIntegrationWithSecrets = errors.New("no active integration with secrets found")
 	// ErrMissingFileID is returned when file ID is required but missing
 	ErrMissingFileID = errors.New("file id required for presigned URL")
+	// ErrChecksumMismatch is returned when a copied object does not match the checksum of the original
+	ErrChecksumMismatch = errors.New("object checksum mismatch")
 )
@@ -0,0 +1,326 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/theopenlane/iam/auth"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/file"
+	"github.com/theopenlane/core/internal/ent/generated/organization"
+	"github.com/theopenlane/core/internal/ent/generated/predicate"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/objects"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/retention"
+)
+
+// DefaultBatchSize is the number of files loaded per query when no batch size is configured
+const DefaultBatchSize = 50
+
+var (
+	// ErrMissingOrganizationID is returned when no organization is provided for the migration
+	ErrMissingOrganizationID = errors.New("organization id is required")
+	// ErrMissingProvider is returned when the source or target provider is not provided
+	ErrMissingProvider = errors.New("source and target providers are required")
+	// ErrSameProvider is returned when the source and target providers are the same
+	ErrSameProvider = errors.New("source and target providers must differ")
+	// ErrFileChanged is returned when a file record changed while its object was being copied
+	ErrFileChanged = errors.New("file record changed during migration")
+)
+
+// Options configures a storage migration for a single organization
+type Options struct {
+	// OrganizationID is the organization whose files are migrated
+	OrganizationID string
+	// From is the provider the files are currently stored in
+	From storagetypes.ProviderType
+	// To is the provider the files are copied to
+	To storagetypes.ProviderType
+	// BatchSize is the number of files loaded per query
+	BatchSize int
+	// DryRun reports the files that would be migrated without copying them
+	DryRun bool
+}
+
+// Validate ensures the migration options are complete
+func (o Options) Validate() error {
+	switch {
+	case o.OrganizationID == "":
+		return ErrMissingOrganizationID
+	case o.From == "" || o.To == "":
+		return ErrMissingProvider
+	case o.From == o.To:
+		return ErrSameProvider
+	default:
+		return nil
+	}
+}
+
+// Progress reports the state of a migration run
+type Progress struct {
+	// Total is the number of files left to migrate when the run started
+	Total int
+	// Migrated is the number of files copied and switched to the target provider
+	Migrated int
+	// Failed is the number of files that could not be migrated; they are retried on the next run
+	Failed int
+	// Cleaned is the number of source objects removed by a cleanup run
+	Cleaned int
+}
+
+// Remaining returns the number of files not yet handled during the run
+func (p Progress) Remaining() int {
+	return p.Total - p.Migrated - p.Failed
+}
+
+// ProgressFunc is called after each file is handled
+type ProgressFunc func(Progress)
+
+// Migrator copies organization files between storage providers
+type Migrator struct {
+	db    *ent.Client
+	store *objects.Service
+}
+
+// New returns a migrator using the database client and storage service
+func New(db *ent.Client, store *objects.Service) *Migrator {
+	return &Migrator{db: db, store: store}
+}
+
+// Run copies every file of the organization still stored in the source provider to the target provider.
+// Each file is switched to the target only once its copy is verified, recording the previous location so
+// downloads can fall back to it; files already migrated no longer match the source provider, so an
+// interrupted run resumes where it left off when started again
+func (m *Migrator) Run(ctx context.Context, opts Options, report ProgressFunc) (Progress, error) {
+	if err := opts.Validate(); err != nil {
+		return Progress{}, err
+	}
+
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+
+	ctx = m.migrationContext(ctx, opts.OrganizationID)
+	logger := logx.FromContext(ctx).With().
+		Str("organization_id", opts.OrganizationID).
+		Str("from", string(opts.From)).
+		Str("to", string(opts.To)).
+		Logger()
+
+	where := []predicate.File{
+		file.HasOrganizationWith(organization.ID(opts.OrganizationID)),
+		file.StorageProvider(string(opts.From)),
+		file.StoragePathNEQ(""),
+	}
+
+	total, err := m.db.File.Query().Where(where...).Count(ctx)
+	if err != nil {
+		return Progress{}, err
+	}
+
+	progress := Progress{Total: total}
+	cursor := ""
+
+	for {
+		files, err := m.db.File.Query().
+			Where(append(where, file.IDGT(cursor))...).
+			Order(file.ByID()).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return progress, err
+		}
+
+		if len(files) == 0 {
+			break
+		}
+
+		for _, f := range files {
+			cursor = f.ID
+
+			if opts.DryRun {
+				logger.Info().Str("file_id", f.ID).Str("storage_path", f.StoragePath).Msg("dry run: file would be migrated")
+
+				progress.Migrated++
+			} else if err := m.migrateFile(ctx, f, opts); err != nil {
+				logger.Error().Err(err).Str("file_id", f.ID).Msg("failed to migrate file")
+
+				progress.Failed++
+			} else {
+				progress.Migrated++
+			}
+
+			if report != nil {
+				report(progress)
+			}
+		}
+	}
+
+	logger.Info().
+		Int("total", progress.Total).
+		Int("migrated", progress.Migrated).
+		Int("failed", progress.Failed).
+		Bool("dry_run", opts.DryRun).
+		Msg("storage migration summary")
+
+	return progress, nil
+}
+
+// migrateFile copies a single file to the target provider and switches its record to the copy
+func (m *Migrator) migrateFile(ctx context.Context, f *ent.File, opts Options) error {
+	source := objects.ObjectLocation{
+		Provider: storagetypes.ProviderType(f.StorageProvider),
+		Bucket:   f.StorageVolume,
+		Key:      f.StoragePath,
+		Region:   f.StorageRegion,
+		URI:      f.URI,
+	}
+
+	sourceFile := source.File(&storagetypes.File{
+		ID:           f.ID,
+		OriginalName: f.ProvidedFileName,
+		FileMetadata: storagetypes.FileMetadata{ContentType: f.DetectedContentType},
+		Metadata:     f.Metadata,
+	})
+	sourceFile.ProviderHints.OrganizationID = opts.OrganizationID
+
+	// retained files keep their object lock at the target so the copy is protected like the original
+	lock := retention.StateFromMetadata(f.Metadata).ObjectLock()
+
+	// the copy writes file contents when the target is the database provider, which is a relocation of the same bytes
+	target, err := m.store.Copy(retention.WithRelocation(ctx), sourceFile, opts.To, f.Md5Hash, lock)
+	if err != nil {
+		return fmt.Errorf("copy object: %w", err)
+	}
+
+	// the update only applies while the file still points at the source, so concurrent changes are not lost
+	updated, err := m.db.File.Update().
+		Where(
+			file.ID(f.ID),
+			file.StorageProvider(string(opts.From)),
+			file.StoragePath(f.StoragePath),
+		).
+		SetStorageProvider(string(target.Provider)).
+		SetStorageVolume(target.Bucket).
+		SetStoragePath(target.Key).
+		SetStorageRegion(target.Region).
+		SetURI(target.URI).
+		SetMetadata(objects.WithMigratedFrom(f.Metadata, source)).
+		Save(retention.WithRelocation(ctx))
+	if err != nil {
+		return fmt.Errorf("update file record: %w", err)
+	}
+
+	if updated == 0 {
+		return ErrFileChanged
+	}
+
+	return nil
+}
+
+// Cleanup removes the source objects of files the organization has finished migrating to the target provider
+// and clears the recorded fallback location; files still under retention or legal hold keep their source object
+func (m *Migrator) Cleanup(ctx context.Context, opts Options, report ProgressFunc) (Progress, error) {
+	if err := opts.Validate(); err != nil {
+		return Progress{}, err
+	}
+
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+
+	ctx = m.migrationContext(ctx, opts.OrganizationID)
+	logger := logx.FromContext(ctx).With().Str("organization_id", opts.OrganizationID).Logger()
+
+	where := []predicate.File{
+		file.HasOrganizationWith(organization.ID(opts.OrganizationID)),
+		file.StorageProvider(string(opts.To)),
+		func(sel *sql.Selector) {
+			sel.Where(sqljson.HasKey(file.FieldMetadata, sqljson.Path(objects.MetadataKeyMigratedFrom)))
+		},
+	}
+
+	progress := Progress{}
+	cursor := ""
+
+	for {
+		files, err := m.db.File.Query().
+			Where(append(where, file.IDGT(cursor))...).
+			Order(file.ByID()).
+			Limit(opts.BatchSize).
+			All(ctx)
+		if err != nil {
+			return progress, err
+		}
+
+		if len(files) == 0 {
+			break
+		}
+
+		for _, f := range files {
+			cursor = f.ID
+
+			source, ok := objects.MigratedFrom(f.Metadata)
+			if !ok || source.Provider != opts.From {
+				continue
+			}
+
+			progress.Total++
+
+			if opts.DryRun {
+				logger.Info().Str("file_id", f.ID).Str("storage_path", source.Key).Msg("dry run: source object would be removed")
+
+				progress.Cleaned++
+
+				continue
+			}
+
+			sourceFile := source.File(&storagetypes.File{ID: f.ID, Metadata: f.Metadata})
+			sourceFile.ProviderHints.OrganizationID = opts.OrganizationID
+
+			if err := m.store.Delete(ctx, sourceFile, &storagetypes.DeleteFileOptions{}); err != nil {
+				logger.Warn().Err(err).Str("file_id", f.ID).Msg("source object kept after migration")
+
+				progress.Failed++
+
+				if report != nil {
+					report(progress)
+				}
+
+				continue
+			}
+
+			if err := m.db.File.UpdateOneID(f.ID).
+				SetMetadata(objects.ClearMigratedFrom(f.Metadata)).
+				Exec(ctx); err != nil {
+				return progress, err
+			}
+
+			progress.Cleaned++
+
+			if report != nil {
+				report(progress)
+			}
+		}
+	}
+
+	return progress, nil
+}
+
+// migrationContext returns a context allowed to read and update every file in the organization; the client is
+// attached for providers that store objects in the database
+func (m *Migrator) migrationContext(ctx context.Context, orgID string) context.Context {
+	ctx = ent.NewContext(privacy.DecisionContext(ctx, privacy.Allow), m.db)
+
+	return auth.WithCaller(ctx, &auth.Caller{
+		OrganizationID:  orgID,
+		OrganizationIDs: []string{orgID},
+		Capabilities:    auth.CapBypassOrgFilter | auth.CapBypassFGA | auth.CapInternalOperation,
+	})
+}
@@ -0,0 +1,53 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/theopenlane/core/common/storagetypes"
+)
+
+func TestOptionsValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		opts Options
+		err  error
+	}{
+		{
+			name: "valid",
+			opts: Options{OrganizationID: "org", From: storagetypes.DatabaseProvider, To: storagetypes.S3Provider},
+		},
+		{
+			name: "missing organization",
+			opts: Options{From: storagetypes.DatabaseProvider, To: storagetypes.S3Provider},
+			err:  ErrMissingOrganizationID,
+		},
+		{
+			name: "missing target",
+			opts: Options{OrganizationID: "org", From: storagetypes.DatabaseProvider},
+			err:  ErrMissingProvider,
+		},
+		{
+			name: "same provider",
+			opts: Options{OrganizationID: "org", From: storagetypes.S3Provider, To: storagetypes.S3Provider},
+			err:  ErrSameProvider,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.Validate()
+			if tc.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestProgressRemaining(t *testing.T) {
+	assert.Equal(t, 3, Progress{Total: 10, Migrated: 5, Failed: 2}.Remaining())
+}
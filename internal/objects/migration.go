@@ -0,0 +1,251 @@
+package objects
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"io"
+	"maps"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+// MetadataKeyMigratedFrom is the file metadata key holding the storage location a file was migrated from;
+// downloads fall back to it while the migration is in progress
+const MetadataKeyMigratedFrom = "storage_migrated_from"
+
+// ObjectLocation identifies where an object is stored
+type ObjectLocation struct {
+	// Provider is the storage provider holding the object
+	Provider storagetypes.ProviderType `json:"provider"`
+	// Bucket is the bucket or volume holding the object
+	Bucket string `json:"bucket,omitempty"`
+	// Key is the object key within the bucket
+	Key string `json:"key"`
+	// Region is the region of the bucket, if any
+	Region string `json:"region,omitempty"`
+	// URI is the full URI of the object
+	URI string `json:"uri,omitempty"`
+}
+
+// File returns a storage file addressing the object at the location
+func (l ObjectLocation) File(file *storagetypes.File) *storagetypes.File {
+	located := &storagetypes.File{
+		ProviderType: l.Provider,
+		FileMetadata: storagetypes.FileMetadata{
+			Key:          l.Key,
+			Bucket:       l.Bucket,
+			Region:       l.Region,
+			FullURI:      l.URI,
+			ProviderType: l.Provider,
+			ProviderHints: &storagetypes.ProviderHints{
+				KnownProvider: l.Provider,
+			},
+		},
+	}
+
+	if file != nil {
+		located.ID = file.ID
+		located.OriginalName = file.OriginalName
+		located.ContentType = file.ContentType
+		located.Metadata = file.Metadata
+		located.ScanStatus = file.ScanStatus
+	}
+
+	return located
+}
+
+// MigratedFrom returns the storage location recorded as the migration source in file metadata
+func MigratedFrom(md map[string]any) (ObjectLocation, bool) {
+	raw, ok := md[MetadataKeyMigratedFrom].(map[string]any)
+	if !ok {
+		return ObjectLocation{}, false
+	}
+
+	str := func(key string) string {
+		s, _ := raw[key].(string)
+		return s
+	}
+
+	loc := ObjectLocation{
+		Provider: storagetypes.ProviderType(str("provider")),
+		Bucket:   str("bucket"),
+		Key:      str("key"),
+		Region:   str("region"),
+		URI:      str("uri"),
+	}
+
+	if loc.Provider == "" || loc.Key == "" {
+		return ObjectLocation{}, false
+	}
+
+	return loc, true
+}
+
+// WithMigratedFrom returns a copy of the metadata recording the location as the migration source
+func WithMigratedFrom(md map[string]any, loc ObjectLocation) map[string]any {
+	out := maps.Clone(md)
+	if out == nil {
+		out = map[string]any{}
+	}
+
+	out[MetadataKeyMigratedFrom] = map[string]any{
+		"provider": string(loc.Provider),
+		"bucket":   loc.Bucket,
+		"key":      loc.Key,
+		"region":   loc.Region,
+		"uri":      loc.URI,
+	}
+
+	return out
+}
+
+// ClearMigratedFrom returns a copy of the metadata without the migration source
+func ClearMigratedFrom(md map[string]any) map[string]any {
+	out := maps.Clone(md)
+	delete(out, MetadataKeyMigratedFrom)
+
+	return out
+}
+
+// Copy copies the object backing the file to the target provider and returns the new location; the object is
+// streamed from the source, hashed as it is uploaded and read back from the target to verify the copy
+func (s *Service) Copy(ctx context.Context, file *storagetypes.File, target storagetypes.ProviderType, expectedMD5 string, lock *storagetypes.ObjectLockOptions) (ObjectLocation, error) {
+	source, err := s.resolveDownloadProvider(ctx, file)
+	if err != nil {
+		return ObjectLocation{}, err
+	}
+
+	if source == nil {
+		return ObjectLocation{}, ErrProviderResolutionFailed
+	}
+
+	// the file id lets providers that key objects by file record, such as the database provider, locate the record
+	hints := &storagetypes.ProviderHints{
+		KnownProvider: target,
+		Metadata:      map[string]string{"file_id": file.ID},
+	}
+	if file.ProviderHints != nil {
+		hints.OrganizationID = file.ProviderHints.OrganizationID
+	}
+
+	opts := &storage.UploadOptions{
+		FileName:    file.Key,
+		ContentType: file.ContentType,
+		ObjectLock:  lock,
+		FileMetadata: storagetypes.FileMetadata{
+			Key:           file.Key,
+			ProviderHints: hints,
+		},
+	}
+
+	destination, err := s.resolveUploadProvider(ctx, opts)
+	if err != nil {
+		return ObjectLocation{}, err
+	}
+
+	reader, err := s.openObject(ctx, source, file)
+	if err != nil {
+		return ObjectLocation{}, err
+	}
+	defer reader.Close()
+
+	hash := md5.New() //nolint:gosec
+
+	uploaded, err := s.objectService.Upload(ctx, destination, io.TeeReader(reader, hash), opts)
+	if err != nil {
+		return ObjectLocation{}, err
+	}
+
+	loc := ObjectLocation{
+		Provider: uploaded.ProviderType,
+		Bucket:   uploaded.Bucket,
+		Key:      uploaded.Key,
+		Region:   uploaded.Region,
+		URI:      uploaded.FullURI,
+	}
+
+	copied := loc.File(file)
+	sum := hex.EncodeToString(hash.Sum(nil))
+
+	if expectedMD5 != "" && sum != expectedMD5 {
+		logx.FromContext(ctx).Error().Str("file_id", file.ID).Str("provider", string(source.ProviderType())).Msg("source object does not match the recorded checksum")
+
+		// an object lock can prevent the removal, in which case the copy is left to expire with its retention
+		if err := s.objectService.Delete(ctx, destination, copied, &storagetypes.DeleteFileOptions{}); err != nil {
+			logx.FromContext(ctx).Warn().Err(err).Str("file_id", file.ID).Str("provider", string(target)).Msg("failed to remove copied object after checksum mismatch")
+		}
+
+		return ObjectLocation{}, ErrChecksumMismatch
+	}
+
+	verified, err := s.objectChecksum(ctx, destination, copied)
+	if err != nil {
+		return ObjectLocation{}, err
+	}
+
+	if verified != sum {
+		logx.FromContext(ctx).Error().Str("file_id", file.ID).Str("provider", string(target)).Msg("copied object does not match the source checksum")
+
+		return ObjectLocation{}, ErrChecksumMismatch
+	}
+
+	return loc, nil
+}
+
+// openObject returns a reader over the stored object, streaming it when the provider supports it and falling back
+// to a buffered download otherwise
+func (s *Service) openObject(ctx context.Context, provider storagetypes.Provider, file *storagetypes.File) (io.ReadCloser, error) {
+	if streamer, ok := provider.(storagetypes.StreamingProvider); ok {
+		return streamer.Open(ctx, file)
+	}
+
+	downloaded, err := s.objectService.Download(ctx, provider, file, &storage.DownloadOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(downloaded.File)), nil
+}
+
+// objectChecksum streams the stored object through md5 and returns the hex encoded digest
+func (s *Service) objectChecksum(ctx context.Context, provider storagetypes.Provider, file *storagetypes.File) (string, error) {
+	reader, err := s.openObject(ctx, provider, file)
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	hash := md5.New() //nolint:gosec
+	if _, err := io.Copy(hash, reader); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// downloadMigrated retries a failed download against the location the file was migrated from
+func (s *Service) downloadMigrated(ctx context.Context, file *storagetypes.File, opts *storage.DownloadOptions, cause error) (*storage.DownloadedMetadata, error) {
+	if file == nil {
+		return nil, cause
+	}
+
+	loc, ok := MigratedFrom(file.Metadata)
+	if !ok {
+		return nil, cause
+	}
+
+	previous := loc.File(file)
+
+	provider, err := s.resolveDownloadProvider(ctx, previous)
+	if err != nil || provider == nil {
+		return nil, cause
+	}
+
+	logx.FromContext(ctx).Warn().Err(cause).Str("file_id", file.ID).Str("provider", string(loc.Provider)).Msg("download failed, reading file from its pre-migration location")
+
+	return s.objectService.Download(ctx, provider, previous, opts)
+}
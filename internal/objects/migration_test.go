@@ -0,0 +1,102 @@
+package objects
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+func TestMigratedFromRoundTrip(t *testing.T) {
+	loc := ObjectLocation{
+		Provider: storagetypes.DiskProvider,
+		Bucket:   "/tmp/uploads",
+		Key:      "evidence.pdf",
+		URI:      "file:///tmp/uploads/evidence.pdf",
+	}
+
+	md := map[string]any{"scan_status": "CLEAN"}
+
+	migrated := WithMigratedFrom(md, loc)
+	assert.NotContains(t, md, MetadataKeyMigratedFrom, "the original metadata is not modified")
+
+	got, ok := MigratedFrom(migrated)
+	require.True(t, ok)
+	assert.Equal(t, loc, got)
+	assert.Equal(t, "CLEAN", migrated["scan_status"])
+
+	cleared := ClearMigratedFrom(migrated)
+	_, ok = MigratedFrom(cleared)
+	assert.False(t, ok)
+	assert.Equal(t, "CLEAN", cleared["scan_status"])
+}
+
+func TestMigratedFromMissing(t *testing.T) {
+	_, ok := MigratedFrom(nil)
+	assert.False(t, ok)
+
+	_, ok = MigratedFrom(map[string]any{MetadataKeyMigratedFrom: map[string]any{"provider": "disk"}})
+	assert.False(t, ok)
+}
+
+func TestObjectLocationFile(t *testing.T) {
+	source := &storagetypes.File{
+		ID:           "file-1",
+		OriginalName: "evidence.pdf",
+		FileMetadata: storagetypes.FileMetadata{ContentType: "application/pdf"},
+		Metadata:     map[string]any{"scan_status": "CLEAN"},
+	}
+
+	located := ObjectLocation{Provider: storagetypes.S3Provider, Bucket: "evidence-eu", Key: "evidence.pdf"}.File(source)
+
+	assert.Equal(t, "file-1", located.ID)
+	assert.Equal(t, storagetypes.S3Provider, located.ProviderType)
+	assert.Equal(t, "evidence-eu", located.Bucket)
+	assert.Equal(t, "application/pdf", located.ContentType)
+	require.NotNil(t, located.ProviderHints)
+	assert.Equal(t, storagetypes.S3Provider, located.ProviderHints.KnownProvider)
+}
+
+// bufferedProvider serves a fixed object through Download only
+type bufferedProvider struct {
+	fakeProvider
+	data []byte
+}
+
+func (b *bufferedProvider) Download(context.Context, *storagetypes.File, *storagetypes.DownloadFileOptions) (*storagetypes.DownloadedFileMetadata, error) {
+	return &storagetypes.DownloadedFileMetadata{File: b.data, Size: int64(len(b.data))}, nil
+}
+
+// streamingProvider serves a fixed object through Open and fails buffered downloads
+type streamingProvider struct {
+	fakeProvider
+	data string
+}
+
+func (p *streamingProvider) Download(context.Context, *storagetypes.File, *storagetypes.DownloadFileOptions) (*storagetypes.DownloadedFileMetadata, error) {
+	return nil, errors.New("buffered download")
+}
+
+func (p *streamingProvider) Open(context.Context, *storagetypes.File) (io.ReadCloser, error) {
+	return io.NopCloser(strings.NewReader(p.data)), nil
+}
+
+func TestObjectChecksum(t *testing.T) {
+	svc := &Service{objectService: storage.NewObjectService()}
+	file := &storagetypes.File{ID: "file-1"}
+
+	sum, err := svc.objectChecksum(context.Background(), &bufferedProvider{data: []byte("hello")}, file)
+	require.NoError(t, err)
+	assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", sum)
+
+	sum, err = svc.objectChecksum(context.Background(), &streamingProvider{data: "hello"}, file)
+	require.NoError(t, err)
+	assert.Equal(t, "5d41402abc4b2a76b9719d911017c592", sum)
+}
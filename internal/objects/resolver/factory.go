@@ -3,9 +3,13 @@ package resolver
 import (
 	"github.com/theopenlane/core/internal/objects"
 	"github.com/theopenlane/core/internal/objects/validators"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
+	azblobprovider "github.com/theopenlane/core/pkg/objects/storage/providers/azblob"
 	dbprovider "github.com/theopenlane/core/pkg/objects/storage/providers/database"
 	"github.com/theopenlane/core/pkg/objects/storage/providers/disk"
+	gcsprovider "github.com/theopenlane/core/pkg/objects/storage/providers/gcs"
 	r2provider "github.com/theopenlane/core/pkg/objects/storage/providers/r2"
 	s3provider "github.com/theopenlane/core/pkg/objects/storage/providers/s3"
 	"github.com/theopenlane/eddy"
@@ -58,6 +62,9 @@ func NewServiceFromConfig(config storage.ProviderConfig, opts ...Option) *object
 		Resolver:       resolver,
 		ClientService:  clientService,
 		ValidationFunc: validators.MimeTypeValidator,
+		Scanner:        scanner.NewFromConfig(config.Scanning),
+		RejectInfected: config.Scanning.RejectInfected,
+		Retention:      retention.NewFromConfig(config.Retention),
 	})
 
 	return service
@@ -79,6 +86,8 @@ func buildWithRuntime(config storage.ProviderConfig, runtime serviceOptions) (*p
 	r2Builder := r2provider.NewR2Builder()
 	diskBuilder := disk.NewDiskBuilder()
 	dbBuilder := dbprovider.NewBuilder()
+	azureBlobBuilder := azblobprovider.NewAzureBlobBuilder()
+	gcsBuilder := gcsprovider.NewGCSBuilder()
 	if runtime.tokenManagerFunc != nil {
 		if tm := runtime.tokenManagerFunc(); tm != nil {
 			dbBuilder = dbBuilder.WithOptions(
@@ -91,10 +100,12 @@ func buildWithRuntime(config storage.ProviderConfig, runtime serviceOptions) (*p
 	// Create resolver and configure rules with builders
 	resolver := eddy.NewResolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]()
 	builderSet := providerBuilders{
-		s3:   s3Builder,
-		r2:   r2Builder,
-		disk: diskBuilder,
-		db:   dbBuilder,
+		s3:        s3Builder,
+		r2:        r2Builder,
+		disk:      diskBuilder,
+		db:        dbBuilder,
+		azureBlob: azureBlobBuilder,
+		gcs:       gcsBuilder,
 	}
 	configureProviderRules(
 		resolver,
@@ -18,6 +18,10 @@ func (rc *ruleCoordinator) providerEnabled(provider storage.ProviderType) bool {
 		return rc.config.Providers.Disk.Enabled
 	case storage.DatabaseProvider:
 		return rc.config.Providers.Database.Enabled
+	case storage.AzureBlobProvider:
+		return rc.config.Providers.AzureBlob.Enabled
+	case storage.GCSProvider:
+		return rc.config.Providers.GCS.Enabled
 	default:
 		return false
 	}
@@ -58,6 +62,10 @@ func providerOptionsFromConfig(provider storage.ProviderType, config storage.Pro
 		providerCfg = config.Providers.Disk
 	case storage.DatabaseProvider:
 		providerCfg = config.Providers.Database
+	case storage.AzureBlobProvider:
+		providerCfg = config.Providers.AzureBlob
+	case storage.GCSProvider:
+		providerCfg = config.Providers.GCS
 	default:
 		return nil, storage.ProviderCredentials{}, fmt.Errorf("%w: %s", errUnsupportedProvider, provider)
 	}
@@ -125,6 +133,16 @@ func providerOptionsFromConfig(provider storage.ProviderType, config storage.Pro
 		if providerCfg.Endpoint != "" {
 			options.Apply(storage.WithEndpoint(providerCfg.Endpoint))
 		}
+	case storage.AzureBlobProvider, storage.GCSProvider:
+		if providerCfg.Bucket != "" {
+			options.Apply(storage.WithBucket(providerCfg.Bucket))
+		}
+		if providerCfg.Region != "" {
+			options.Apply(storage.WithRegion(providerCfg.Region))
+		}
+		if providerCfg.Endpoint != "" {
+			options.Apply(storage.WithEndpoint(providerCfg.Endpoint))
+		}
 	}
 
 	return options, providerCfg.Credentials, nil
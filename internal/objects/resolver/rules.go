@@ -17,10 +17,12 @@ type providerBuilder = eddy.Builder[storage.Provider, storage.ProviderCredential
 
 // providerBuilders groups the provider builders required to assemble resolver rules
 type providerBuilders struct {
-	s3   providerBuilder
-	r2   providerBuilder
-	disk providerBuilder
-	db   providerBuilder
+	s3        providerBuilder
+	r2        providerBuilder
+	disk      providerBuilder
+	db        providerBuilder
+	azureBlob providerBuilder
+	gcs       providerBuilder
 }
 
 // RuleOption configures aspects of ruleCoordinator
@@ -100,6 +102,10 @@ func (rc *ruleCoordinator) getBuilder(provider storage.ProviderType) providerBui
 		return rc.builders.disk
 	case storage.DatabaseProvider:
 		return rc.builders.db
+	case storage.AzureBlobProvider:
+		return rc.builders.azureBlob
+	case storage.GCSProvider:
+		return rc.builders.gcs
 	default:
 		return nil
 	}
@@ -222,6 +228,8 @@ func (rc *ruleCoordinator) defaultProvider() (storage.ProviderType, bool) {
 	for _, provider := range []storage.ProviderType{
 		storage.S3Provider,
 		storage.R2Provider,
+		storage.AzureBlobProvider,
+		storage.GCSProvider,
 		storage.DiskProvider,
 		storage.DatabaseProvider,
 	} {
@@ -215,6 +215,78 @@ func TestDefaultRuleSelectsFirstEnabledProvider(t *testing.T) {
 	assert.Equal(t, "r2-bucket", result.Config.Bucket)
 }
 
+func TestKnownProviderRuleCloudProviders(t *testing.T) {
+	azureBuilder := &stubBuilder{providerType: "azblob"}
+	gcsBuilder := &stubBuilder{providerType: "gcs"}
+
+	config := storage.ProviderConfig{
+		Providers: storage.Providers{
+			AzureBlob: storage.ProviderConfigs{
+				Enabled:     true,
+				Bucket:      "evidence",
+				Endpoint:    "http://127.0.0.1:10000/devstoreaccount1",
+				Credentials: storage.ProviderCredentials{AccountName: "devstoreaccount1", AccountKey: "a2V5"},
+			},
+			GCS: storage.ProviderConfigs{
+				Enabled:     true,
+				Bucket:      "gcs-evidence",
+				Region:      "us-central1",
+				Credentials: storage.ProviderCredentials{ProjectID: "openlane"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		provider storage.ProviderType
+		builder  *stubBuilder
+		bucket   string
+	}{
+		{name: "azure blob", provider: storage.AzureBlobProvider, builder: azureBuilder, bucket: "evidence"},
+		{name: "gcs", provider: storage.GCSProvider, builder: gcsBuilder, bucket: "gcs-evidence"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			resolver := eddy.NewResolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]()
+
+			configureProviderRules(
+				resolver,
+				WithProviderConfig(config),
+				WithProviderBuilders(providerBuilders{
+					s3:        &stubBuilder{providerType: "s3"},
+					r2:        &stubBuilder{providerType: "r2"},
+					disk:      &stubBuilder{providerType: "disk"},
+					db:        &stubBuilder{providerType: "db"},
+					azureBlob: azureBuilder,
+					gcs:       gcsBuilder,
+				}),
+				WithRuntimeOptions(serviceOptions{}),
+			)
+
+			option := resolver.Resolve(objects.WithKnownProviderHint(context.Background(), tc.provider))
+			assert.True(t, option.IsPresent(), "expected known provider rule to resolve")
+
+			result := option.MustGet()
+			assert.Equal(t, tc.builder, result.Builder)
+			assert.Equal(t, tc.bucket, result.Config.Bucket)
+		})
+	}
+
+	// with S3 and R2 disabled, Azure takes precedence over GCS as the default provider
+	resolver := eddy.NewResolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]()
+	configureProviderRules(
+		resolver,
+		WithProviderConfig(config),
+		WithProviderBuilders(providerBuilders{azureBlob: azureBuilder, gcs: gcsBuilder}),
+		WithRuntimeOptions(serviceOptions{}),
+	)
+
+	option := resolver.Resolve(context.Background())
+	assert.True(t, option.IsPresent(), "expected default rule to resolve")
+	assert.Equal(t, azureBuilder, option.MustGet().Builder)
+}
+
 func TestDefaultRuleUsesS3WhenEnabled(t *testing.T) {
 	resolver := eddy.NewResolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]()
 
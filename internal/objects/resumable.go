@@ -0,0 +1,250 @@
+package objects
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"path"
+	"strconv"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+	"github.com/theopenlane/core/pkg/objects/tus"
+)
+
+const (
+	// resumableUploadsTable keeps the state of resumable uploads; the table is created by the db migrations
+	resumableUploadsTable = "resumable_uploads"
+	// resumableStagingFolder is the folder the chunks of resumable uploads are staged under in object storage
+	resumableStagingFolder = "resumable"
+	// resumableStagingContentType is the content type chunks are staged with; the content type of the assembled
+	// file is detected once the upload completes
+	resumableStagingContentType = "application/octet-stream"
+)
+
+// NewResumableStore returns the tus store for resumable uploads, keeping upload state in the application database
+// through drv and staging chunks through the storage provider the service resolves for the organization of the upload
+func NewResumableStore(drv dialect.ExecQuerier, service *Service) *tus.ObjectStore {
+	return tus.NewObjectStore(&resumableState{drv: drv}, &resumableObjects{service: service})
+}
+
+// resumableState is a tus.StateStore backed by the resumable_uploads table
+type resumableState struct {
+	drv dialect.ExecQuerier
+}
+
+// Create satisfies the tus.StateStore interface
+func (s *resumableState) Create(ctx context.Context, info tus.Info) error {
+	raw, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	return s.drv.Exec(ctx, `INSERT INTO `+resumableUploadsTable+` (id, organization_id, upload_offset, info, expires_at) VALUES ($1, $2, $3, $4, $5)`,
+		[]any{info.ID, info.OrganizationID, info.Offset, string(raw), info.ExpiresAt}, nil)
+}
+
+// Get satisfies the tus.StateStore interface
+func (s *resumableState) Get(ctx context.Context, id string) (tus.Info, error) {
+	infos, err := s.query(ctx, `SELECT info FROM `+resumableUploadsTable+` WHERE id = $1`, id)
+	if err != nil {
+		return tus.Info{}, err
+	}
+
+	if len(infos) == 0 {
+		return tus.Info{}, tus.ErrUploadNotFound
+	}
+
+	return infos[0], nil
+}
+
+// Save satisfies the tus.StateStore interface; the offset condition lets only one of the requests staging the
+// same offset on different replicas record its part
+func (s *resumableState) Save(ctx context.Context, info tus.Info, offset int64) error {
+	raw, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+
+	var res sql.Result
+	if err := s.drv.Exec(ctx, `UPDATE `+resumableUploadsTable+` SET upload_offset = $1, info = $2, expires_at = $3, updated_at = now() WHERE id = $4 AND upload_offset = $5`,
+		[]any{info.Offset, string(raw), info.ExpiresAt, info.ID, offset}, &res); err != nil {
+		return err
+	}
+
+	if updated, err := res.RowsAffected(); err != nil || updated > 0 {
+		return err
+	}
+
+	if _, err := s.Get(ctx, info.ID); err != nil {
+		return err
+	}
+
+	return tus.ErrInvalidOffset
+}
+
+// Delete satisfies the tus.StateStore interface
+func (s *resumableState) Delete(ctx context.Context, id string) error {
+	var res sql.Result
+	if err := s.drv.Exec(ctx, `DELETE FROM `+resumableUploadsTable+` WHERE id = $1`, []any{id}, &res); err != nil {
+		return err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return tus.ErrUploadNotFound
+	}
+
+	return nil
+}
+
+// List satisfies the tus.StateStore interface
+func (s *resumableState) List(ctx context.Context) ([]tus.Info, error) {
+	return s.query(ctx, `SELECT info FROM `+resumableUploadsTable+` ORDER BY expires_at`)
+}
+
+// query decodes the info column of the rows returned by the query
+func (s *resumableState) query(ctx context.Context, query string, args ...any) ([]tus.Info, error) {
+	var rows entsql.Rows
+	if err := s.drv.Query(ctx, query, args, &rows); err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var infos []tus.Info
+
+	for rows.Next() {
+		var raw []byte
+		if err := rows.Scan(&raw); err != nil {
+			return nil, err
+		}
+
+		var info tus.Info
+		if err := json.Unmarshal(raw, &info); err != nil {
+			return nil, err
+		}
+
+		infos = append(infos, info)
+	}
+
+	return infos, rows.Err()
+}
+
+// resumableObjects is a tus.ObjectBackend writing chunks straight to the resolved storage provider; chunks are
+// not files of their own, so they skip the validation, scanning and retention applied to uploaded files
+type resumableObjects struct {
+	service *Service
+}
+
+// Put satisfies the tus.ObjectBackend interface
+func (o *resumableObjects) Put(ctx context.Context, info tus.Info, offset int64, r io.Reader) (tus.Part, error) {
+	opts := &storage.UploadOptions{
+		FileName:          strconv.FormatInt(offset, 10),
+		ContentType:       resumableStagingContentType,
+		FolderDestination: path.Join(resumableStagingFolder, info.ID),
+		FileMetadata: storage.FileMetadata{
+			ProviderHints: resumableHints(info),
+		},
+	}
+
+	provider, err := o.service.resolveUploadProvider(ctx, opts)
+	if err != nil {
+		return tus.Part{}, err
+	}
+
+	counter := &countingReader{r: r}
+
+	uploaded, err := provider.Upload(ctx, counter, opts)
+	if err != nil {
+		return tus.Part{}, err
+	}
+
+	key := uploaded.Key
+	if key == "" {
+		key = path.Join(opts.FolderDestination, opts.FileName)
+	}
+
+	return tus.Part{Offset: offset, Size: counter.n, Key: key}, nil
+}
+
+// Get satisfies the tus.ObjectBackend interface
+func (o *resumableObjects) Get(ctx context.Context, info tus.Info, part tus.Part) (io.ReadCloser, error) {
+	file := resumableFile(info, part)
+
+	provider, err := o.provider(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	downloaded, err := provider.Download(ctx, file, &storagetypes.DownloadFileOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(downloaded.File)), nil
+}
+
+// Delete satisfies the tus.ObjectBackend interface
+func (o *resumableObjects) Delete(ctx context.Context, info tus.Info, part tus.Part) error {
+	file := resumableFile(info, part)
+
+	provider, err := o.provider(ctx, file)
+	if err != nil {
+		return err
+	}
+
+	return provider.Delete(ctx, file, &storagetypes.DeleteFileOptions{})
+}
+
+// provider resolves the storage provider holding a staged chunk
+func (o *resumableObjects) provider(ctx context.Context, file *storagetypes.File) (storage.Provider, error) {
+	provider, err := o.service.resolveDownloadProvider(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	if provider == nil {
+		return nil, ErrProviderResolutionFailed
+	}
+
+	return provider, nil
+}
+
+// resumableHints resolves staged chunks to the provider of the organization the upload was created in
+func resumableHints(info tus.Info) *storage.ProviderHints {
+	return &storage.ProviderHints{OrganizationID: info.OrganizationID}
+}
+
+// resumableFile addresses a staged chunk
+func resumableFile(info tus.Info, part tus.Part) *storagetypes.File {
+	return &storagetypes.File{
+		FileMetadata: storagetypes.FileMetadata{
+			Key:           part.Key,
+			Size:          part.Size,
+			ProviderHints: resumableHints(info),
+		},
+	}
+}
+
+// countingReader counts the bytes read through it
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(b []byte) (int, error) {
+	n, err := c.r.Read(b)
+	c.n += int64(n)
+
+	return n, err
+}
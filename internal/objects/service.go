@@ -1,6 +1,7 @@
 package objects
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -10,6 +11,8 @@ import (
 	"github.com/theopenlane/core/internal/consts"
 	"github.com/theopenlane/core/pkg/logx"
 	pkgobjects "github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
 	"github.com/theopenlane/eddy"
 	"github.com/theopenlane/iam/auth"
@@ -28,9 +31,12 @@ func (k ProviderCacheKey) String() string {
 
 // Service orchestrates storage operations using eddy provider resolution
 type Service struct {
-	resolver      *eddy.Resolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]
-	clientService *eddy.ClientService[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]
-	objectService *storage.ObjectService
+	resolver       *eddy.Resolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]
+	clientService  *eddy.ClientService[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]
+	objectService  *storage.ObjectService
+	scanner        scanner.Scanner
+	rejectInfected bool
+	retention      *retention.Policy
 }
 
 // Config holds configuration for creating a new Service
@@ -38,6 +44,12 @@ type Config struct {
 	Resolver       *eddy.Resolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]
 	ClientService  *eddy.ClientService[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions]
 	ValidationFunc storage.ValidationFunc
+	// Scanner is the optional content scanner applied to uploads; files are quarantined until they pass
+	Scanner scanner.Scanner
+	// RejectInfected fails the upload when the scanner detects a threat
+	RejectInfected bool
+	// Retention is the optional retention policy applied to uploads; retained files cannot be deleted or overwritten
+	Retention *retention.Policy
 }
 
 // NewService creates a new storage orchestration service
@@ -50,9 +62,12 @@ func NewService(cfg Config) *Service {
 	}
 
 	return &Service{
-		resolver:      cfg.Resolver,
-		clientService: cfg.ClientService,
-		objectService: objectService,
+		resolver:       cfg.Resolver,
+		clientService:  cfg.ClientService,
+		objectService:  objectService,
+		scanner:        cfg.Scanner,
+		rejectInfected: cfg.RejectInfected,
+		retention:      cfg.Retention,
 	}
 }
 
@@ -72,8 +87,14 @@ func (s *Service) Upload(ctx context.Context, reader io.Reader, opts *storage.Up
 	return file, nil
 }
 
-// Download downloads a file using provider resolution
+// Download downloads a file using provider resolution, falling back to the pre-migration location when one is recorded
 func (s *Service) Download(ctx context.Context, provider storage.Provider, file *storagetypes.File, opts *storage.DownloadOptions) (*storage.DownloadedMetadata, error) {
+	if file != nil {
+		if err := scanner.CheckAccess(file.ScanStatus); err != nil {
+			return nil, err
+		}
+	}
+
 	if provider == nil {
 		resolvedprovider, err := s.resolveDownloadProvider(ctx, file)
 		if err != nil {
@@ -82,7 +103,13 @@ func (s *Service) Download(ctx context.Context, provider storage.Provider, file
 		provider = resolvedprovider
 	}
 
-	return s.objectService.Download(ctx, provider, file, opts)
+	downloaded, err := s.objectService.Download(ctx, provider, file, opts)
+	if err != nil {
+		// files being migrated between providers are still readable from their previous location
+		return s.downloadMigrated(ctx, file, opts, err)
+	}
+
+	return downloaded, nil
 }
 
 // GetPresignedURL gets a presigned URL for a file using provider resolution
@@ -91,6 +118,10 @@ func (s *Service) GetPresignedURL(ctx context.Context, file *storagetypes.File,
 		return "", ErrMissingFileID
 	}
 
+	if err := scanner.CheckAccess(file.ScanStatus); err != nil {
+		return "", err
+	}
+
 	provider, err := s.resolveDownloadProvider(ctx, file)
 	if err != nil {
 		return "", err
@@ -100,8 +131,14 @@ func (s *Service) GetPresignedURL(ctx context.Context, file *storagetypes.File,
 	return s.objectService.GetPresignedURL(ctx, provider, file, opts)
 }
 
-// Delete deletes a file using provider resolution
+// Delete deletes a file using provider resolution; files under retention or legal hold are rejected
 func (s *Service) Delete(ctx context.Context, file *storagetypes.File, opts *storagetypes.DeleteFileOptions) error {
+	if file != nil {
+		if err := retention.CheckMutation(file.Metadata, time.Now()); err != nil {
+			return err
+		}
+	}
+
 	provider, err := s.resolveDownloadProvider(ctx, file)
 	if err != nil {
 		return err
@@ -120,6 +157,70 @@ func (s *Service) Exists(ctx context.Context, file *storagetypes.File) (bool, er
 	return provider.Exists(ctx, file)
 }
 
+// SetLegalHold places or releases an object lock legal hold on providers that support it; other providers
+// rely on the legal hold recorded in the file metadata
+func (s *Service) SetLegalHold(ctx context.Context, file *storagetypes.File, enabled bool) error {
+	provider, err := s.resolveDownloadProvider(ctx, file)
+	if err != nil {
+		return err
+	}
+
+	holder, ok := provider.(storagetypes.LegalHoldProvider)
+	if !ok {
+		return nil
+	}
+
+	return holder.SetLegalHold(ctx, file, enabled)
+}
+
+// Retention returns the configured retention policy, or nil when retention is disabled
+func (s *Service) Retention() *retention.Policy {
+	return s.retention
+}
+
+// ScanningEnabled reports whether uploaded files are scanned before they can be accessed
+func (s *Service) ScanningEnabled() bool {
+	return s.scanner != nil
+}
+
+// RejectInfected reports whether uploads with detected threats should fail the request
+func (s *Service) RejectInfected() bool {
+	return s.rejectInfected
+}
+
+// Scan runs the configured content scanner against the reader; when scanning is disabled the file is reported clean
+func (s *Service) Scan(ctx context.Context, reader io.Reader, target scanner.Target) (scanner.Result, error) {
+	if s.scanner == nil {
+		return scanner.Result{Status: scanner.StatusClean, ScannedAt: time.Now()}, nil
+	}
+
+	return s.scanner.Scan(ctx, reader, target)
+}
+
+// Rescan downloads a stored file and runs the content scanner against it again; the quarantine check is
+// skipped because the contents are only handed to the scanner
+func (s *Service) Rescan(ctx context.Context, file *storagetypes.File) (scanner.Result, error) {
+	if file == nil {
+		return scanner.Result{}, ErrMissingFileID
+	}
+
+	provider, err := s.resolveDownloadProvider(ctx, file)
+	if err != nil {
+		return scanner.Result{Status: scanner.StatusError, ScannedAt: time.Now()}, err
+	}
+
+	downloaded, err := s.objectService.Download(ctx, provider, file, &storage.DownloadOptions{})
+	if err != nil {
+		return scanner.Result{Status: scanner.StatusError, ScannedAt: time.Now()}, err
+	}
+
+	return s.Scan(ctx, bytes.NewReader(downloaded.File), scanner.Target{
+		Name:        file.OriginalName,
+		ContentType: file.ContentType,
+		Size:        int64(len(downloaded.File)),
+	})
+}
+
 // Skipper returns the configured skipper function
 func (s *Service) Skipper() storage.SkipperFunc {
 	return s.objectService.Skipper()
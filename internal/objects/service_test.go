@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,6 +13,8 @@ import (
 
 	"github.com/theopenlane/core/common/storagetypes"
 	pkgobjects "github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
 	"github.com/theopenlane/eddy"
 	"github.com/theopenlane/iam/auth"
@@ -60,7 +63,7 @@ func (f *fakeProvider) GetScheme() *string {
 	return nil
 }
 
-func (f *fakeProvider) ListBuckets() ([]string, error) {
+func (f *fakeProvider) ListBuckets(context.Context) ([]string, error) {
 	return nil, nil
 }
 
@@ -329,3 +332,80 @@ func TestServiceResolveDownloadProviderNoResult(t *testing.T) {
 		t.Fatalf("expected ErrProviderResolutionFailed, got %v", err)
 	}
 }
+
+func TestServiceQuarantinedFileAccess(t *testing.T) {
+	service := NewService(Config{
+		Resolver:      eddy.NewResolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions](),
+		ClientService: eddy.NewClientService[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions](eddy.NewClientPool[storage.Provider](time.Minute)),
+		Scanner:       scanner.NewRules(),
+	})
+
+	if !service.ScanningEnabled() {
+		t.Fatalf("expected scanning to be enabled")
+	}
+
+	file := &storagetypes.File{
+		ID:         "file",
+		ScanStatus: scanner.StatusPending.String(),
+	}
+
+	if _, err := service.GetPresignedURL(context.Background(), file, time.Minute); !errors.Is(err, scanner.ErrFileQuarantined) {
+		t.Fatalf("expected ErrFileQuarantined from presign, got %v", err)
+	}
+
+	if _, err := service.Download(context.Background(), nil, file, &storage.DownloadOptions{}); !errors.Is(err, scanner.ErrFileQuarantined) {
+		t.Fatalf("expected ErrFileQuarantined from download, got %v", err)
+	}
+}
+
+func TestServiceScanDisabled(t *testing.T) {
+	service := NewService(Config{})
+
+	if service.ScanningEnabled() {
+		t.Fatalf("expected scanning to be disabled")
+	}
+
+	res, err := service.Scan(context.Background(), strings.NewReader("data"), scanner.Target{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if res.Status != scanner.StatusClean {
+		t.Fatalf("expected clean status when scanning is disabled, got %s", res.Status)
+	}
+}
+
+func TestServiceDeleteRetainedFile(t *testing.T) {
+	service := NewService(Config{
+		Resolver:      eddy.NewResolver[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions](),
+		ClientService: eddy.NewClientService[storage.Provider, storage.ProviderCredentials, *storage.ProviderOptions](eddy.NewClientPool[storage.Provider](time.Minute)),
+	})
+
+	retained := &storagetypes.File{
+		ID:       "file",
+		Metadata: retention.State{RetainUntil: time.Now().Add(time.Hour)}.Metadata(),
+	}
+
+	if err := service.Delete(context.Background(), retained, nil); !errors.Is(err, retention.ErrRetentionActive) {
+		t.Fatalf("expected ErrRetentionActive, got %v", err)
+	}
+
+	held, err := retention.PlaceLegalHold(nil, "litigation", "user", time.Now())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if err := service.Delete(context.Background(), &storagetypes.File{ID: "file", Metadata: held}, nil); !errors.Is(err, retention.ErrLegalHoldActive) {
+		t.Fatalf("expected ErrLegalHoldActive, got %v", err)
+	}
+
+	// expired retention falls through to provider resolution
+	expired := &storagetypes.File{
+		ID:       "file",
+		Metadata: retention.State{RetainUntil: time.Now().Add(-time.Hour)}.Metadata(),
+	}
+
+	if err := service.Delete(context.Background(), expired, nil); !errors.Is(err, ErrProviderResolutionFailed) {
+		t.Fatalf("expected ErrProviderResolutionFailed, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"entgo.io/ent/dialect/sql"
 
@@ -15,9 +16,11 @@ import (
 	"github.com/theopenlane/core/internal/consts"
 	ent "github.com/theopenlane/core/internal/ent/generated"
 	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/ent/generated/retentionrule"
 	"github.com/theopenlane/core/pkg/logx"
 	"github.com/theopenlane/core/pkg/middleware/transaction"
 	pkgobjects "github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/retention"
 	"github.com/theopenlane/core/pkg/objects/storage"
 )
 
@@ -28,7 +31,8 @@ func CreateFileRecord(ctx context.Context, f pkgobjects.File) (*ent.File, error)
 
 // UpdateFileWithStorageMetadata updates a file entity with metadata returned from the storage provider.
 func UpdateFileWithStorageMetadata(ctx context.Context, entFile *ent.File, fileData pkgobjects.File) error {
-	allowCtx := privacy.DecisionContext(ctx, privacy.Allow)
+	// the retention state assigned on upload is recorded alongside the storage metadata
+	allowCtx := retention.WithManagedUpdate(privacy.DecisionContext(ctx, privacy.Allow))
 
 	update := txFileClientFromContext(ctx).
 		UpdateOne(entFile).
@@ -48,6 +52,10 @@ func UpdateFileWithStorageMetadata(ctx context.Context, entFile *ent.File, fileD
 		update = update.SetMetadata(metadata)
 	}
 
+	if fileData.ScanStatus != "" {
+		update = update.SetScanStatus(fileData.ScanStatus)
+	}
+
 	if _, err := update.Save(allowCtx); err != nil {
 		logx.FromContext(ctx).Error().Err(err).Msg("failed to update file with storage metadata")
 
@@ -57,6 +65,30 @@ func UpdateFileWithStorageMetadata(ctx context.Context, entFile *ent.File, fileD
 	return nil
 }
 
+// OrganizationRetentionRules returns the retention rules defined by the organization, queried with the caller
+// context so the rules are only visible to members of the organization
+func OrganizationRetentionRules(ctx context.Context, orgID string) ([]retention.Rule, error) {
+	records, err := txClientFromContext(ctx).RetentionRule.Query().
+		Where(retentionrule.OwnerID(orgID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]retention.Rule, 0, len(records))
+	for _, r := range records {
+		rules = append(rules, retention.Rule{
+			Name:       r.Name,
+			Category:   r.Category,
+			ObjectType: r.ObjectType,
+			Period:     time.Duration(r.RetentionDays) * 24 * time.Hour, //nolint:mnd
+			Purge:      r.Purge,
+		})
+	}
+
+	return rules, nil
+}
+
 func createFile(ctx context.Context, f pkgobjects.File) (*ent.File, error) {
 	contentType := f.ContentType
 	if contentType == "" {
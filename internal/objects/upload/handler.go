@@ -5,6 +5,7 @@ import (
 	"crypto/md5" // #nosec G501 -- not used for security
 	"encoding/hex"
 	"io"
+	"maps"
 	"path"
 	"strings"
 	"time"
@@ -18,6 +19,8 @@ import (
 	"github.com/theopenlane/core/pkg/logx"
 	"github.com/theopenlane/core/pkg/metrics"
 	pkgobjects "github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
 )
 
@@ -73,6 +76,18 @@ func HandleUploads(ctx context.Context, svc *objects.Service, files []pkgobjects
 			return ctx, nil, err
 		}
 
+		retentionState, retained, err := assignRetention(ctx, svc, orgID, entFile.CategoryName, file)
+		if err != nil {
+			logx.FromContext(ctx).Error().Err(err).Str("file", file.OriginalName).Msg("failed to load retention rules")
+			finish("error")
+
+			return ctx, nil, err
+		}
+
+		if retained {
+			uploadOpts.ObjectLock = retentionState.ObjectLock()
+		}
+
 		folderPath := path.Join(orgID, entFile.ID)
 		uploadOpts.FolderDestination = folderPath
 		file.Folder = folderPath
@@ -95,18 +110,39 @@ func HandleUploads(ctx context.Context, svc *objects.Service, files []pkgobjects
 			return ctx, nil, err
 		}
 
+		mergeUploadedFileMetadata(uploadedFile, entFile.ID, file)
+
+		scanResult := scanUpload(ctx, svc, file)
+		if svc.ScanningEnabled() {
+			uploadedFile.ScanStatus = scanResult.Status.String()
+			uploadedFile.Metadata = mergeScanMetadata(uploadedFile.Metadata, scanResult)
+		}
+
+		if retained {
+			uploadedFile.Metadata = retention.Merge(uploadedFile.Metadata, retentionState)
+		}
+
 		if closer, ok := file.RawFile.(io.Closer); ok {
 			_ = closer.Close()
 		}
 
-		mergeUploadedFileMetadata(uploadedFile, entFile.ID, file)
 		if err := store.UpdateFileWithStorageMetadata(ctx, entFile, *uploadedFile); err != nil {
 			logx.FromContext(ctx).Error().Err(err).Msg("failed to update file metadata")
+			// the update is rejected when the persisted size passes the storage quota, so the object is not kept
+			HandleRollback(ctx, svc, []pkgobjects.File{*uploadedFile})
 			finish("error")
 
 			return ctx, nil, err
 		}
 
+		if scanResult.Status == scanner.StatusInfected && svc.RejectInfected() {
+			logx.FromContext(ctx).Warn().Str("file_id", entFile.ID).Strs("threats", scanResult.Threats).Msg("uploaded file failed content scanning, rejecting upload")
+			HandleRollback(ctx, svc, []pkgobjects.File{*uploadedFile})
+			finish("infected")
+
+			return ctx, nil, scanner.ErrFileInfected
+		}
+
 		uploadedFiles = append(uploadedFiles, *uploadedFile)
 		finish("success")
 	}
@@ -239,6 +275,88 @@ func ComputeMD5Hash(file io.ReadSeeker) ([]byte, error) {
 	return encoded, nil
 }
 
+// scanUpload runs the content scanner against the buffered upload; scanner failures leave the file
+// quarantined rather than failing the request so the file can be rescanned later
+func scanUpload(ctx context.Context, svc *objects.Service, file pkgobjects.File) scanner.Result {
+	if !svc.ScanningEnabled() {
+		return scanner.Result{Status: scanner.StatusClean}
+	}
+
+	if file.RawFile == nil {
+		return scanner.Result{Status: scanner.StatusPending}
+	}
+
+	if _, err := file.RawFile.Seek(0, io.SeekStart); err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("file", file.OriginalName).Msg("failed to rewind file for content scanning")
+
+		return scanner.Result{Status: scanner.StatusError, ScannedAt: time.Now()}
+	}
+
+	startTime := time.Now()
+
+	result, err := svc.Scan(ctx, file.RawFile, scanner.Target{
+		Name:        file.OriginalName,
+		ContentType: file.ContentType,
+		Size:        file.Size,
+	})
+
+	metrics.RecordFileScan(result.Status.String(), time.Since(startTime).Seconds())
+
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("file", file.OriginalName).Msg("failed to scan uploaded file, file will remain quarantined")
+
+		result.Status = scanner.StatusError
+	}
+
+	return result
+}
+
+// mergeScanMetadata records the details of the scan result on the file metadata; the status is persisted
+// separately on the file scan status field
+func mergeScanMetadata(md map[string]any, result scanner.Result) map[string]any {
+	if md == nil {
+		md = map[string]any{}
+	}
+
+	delete(md, scanner.MetadataKeyThreats)
+
+	maps.Copy(md, result.Metadata())
+
+	return md
+}
+
+// assignRetention evaluates the retention rules of the organization that owns the upload against the file,
+// reporting false when retention is disabled or no rule of the organization matches
+func assignRetention(ctx context.Context, svc *objects.Service, orgID, category string, file pkgobjects.File) (retention.State, bool, error) {
+	policy := svc.Retention()
+	if policy == nil || orgID == "" {
+		return retention.State{}, false, nil
+	}
+
+	rules, err := store.OrganizationRetentionRules(ctx, orgID)
+	if err != nil {
+		return retention.State{}, false, err
+	}
+
+	state, ok := policy.Assign(rules, retentionTarget(category, file), time.Now())
+
+	return state, ok, nil
+}
+
+// retentionTarget describes the uploaded file for retention rule matching, preferring the parent object
+// type over the correlated object type
+func retentionTarget(category string, file pkgobjects.File) retention.Target {
+	objectType := file.Parent.Type
+	if objectType == "" {
+		objectType = file.CorrelatedObjectType
+	}
+
+	return retention.Target{
+		Category:   category,
+		ObjectType: objectType,
+	}
+}
+
 func mergeUploadedFileMetadata(dest *pkgobjects.File, entFileID string, src pkgobjects.File) {
 	dest.ID = entFileID
 	dest.FieldName = src.FieldName
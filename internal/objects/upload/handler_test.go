@@ -9,7 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/theopenlane/core/common/models"
+	"github.com/theopenlane/core/internal/objects"
 	pkgobjects "github.com/theopenlane/core/pkg/objects"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 	"github.com/theopenlane/core/pkg/objects/storage"
 	"github.com/theopenlane/iam/auth"
 )
@@ -121,3 +124,55 @@ func TestMergeUploadedFileMetadata(t *testing.T) {
 	assert.Equal(t, src.CorrelatedObjectType, dest.CorrelatedObjectType)
 	assert.NotEmpty(t, dest.Metadata)
 }
+
+func TestScanUpload(t *testing.T) {
+	disabled := objects.NewService(objects.Config{})
+	res := scanUpload(context.Background(), disabled, pkgobjects.File{RawFile: bytes.NewReader([]byte("data"))})
+	assert.Equal(t, scanner.StatusClean, res.Status)
+
+	enabled := objects.NewService(objects.Config{Scanner: scanner.NewRules()})
+
+	res = scanUpload(context.Background(), enabled, pkgobjects.File{
+		OriginalName: "report.pdf",
+		RawFile:      bytes.NewReader([]byte("%PDF-1.7\n<< /OpenAction << /S /JavaScript >> >>")),
+	})
+	assert.Equal(t, scanner.StatusInfected, res.Status)
+	assert.Equal(t, []string{"Openlane.PDF.ActiveContent"}, res.Threats)
+
+	res = scanUpload(context.Background(), enabled, pkgobjects.File{
+		OriginalName: "report.pdf",
+		RawFile:      bytes.NewReader([]byte("%PDF-1.7\n<< /Type /Catalog >>")),
+	})
+	assert.Equal(t, scanner.StatusClean, res.Status)
+
+	res = scanUpload(context.Background(), enabled, pkgobjects.File{OriginalName: "missing.pdf"})
+	assert.Equal(t, scanner.StatusPending, res.Status)
+}
+
+func TestMergeScanMetadata(t *testing.T) {
+	md := mergeScanMetadata(nil, scanner.Result{
+		Status:  scanner.StatusInfected,
+		Threats: []string{"Openlane.OOXML.Macro"},
+		Scanner: "rules",
+	})
+
+	assert.Equal(t, "Openlane.OOXML.Macro", md[scanner.MetadataKeyThreats])
+	assert.Equal(t, "rules", md[scanner.MetadataKeyScanner])
+
+	md = mergeScanMetadata(md, scanner.Result{Status: scanner.StatusClean, Scanner: "rules"})
+
+	assert.Equal(t, "rules", md[scanner.MetadataKeyScanner])
+	assert.NotContains(t, md, scanner.MetadataKeyThreats)
+}
+
+func TestRetentionTarget(t *testing.T) {
+	target := retentionTarget("evidence", pkgobjects.File{
+		CorrelatedObjectType: "organization",
+		Parent:               pkgobjects.ParentObject{ID: "ev", Type: "evidence"},
+	})
+
+	assert.Equal(t, retention.Target{Category: "evidence", ObjectType: "evidence"}, target)
+
+	target = retentionTarget("", pkgobjects.File{CorrelatedObjectType: "organization"})
+	assert.Equal(t, "organization", target.ObjectType)
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/theopenlane/core/internal/objects"
 	"github.com/theopenlane/core/pkg/logx"
 	"github.com/theopenlane/core/pkg/objects/storage"
+	azblobprovider "github.com/theopenlane/core/pkg/objects/storage/providers/azblob"
 	disk "github.com/theopenlane/core/pkg/objects/storage/providers/disk"
+	gcsprovider "github.com/theopenlane/core/pkg/objects/storage/providers/gcs"
 	r2provider "github.com/theopenlane/core/pkg/objects/storage/providers/r2"
 	s3provider "github.com/theopenlane/core/pkg/objects/storage/providers/s3"
 )
@@ -83,6 +85,18 @@ func ValidateAvailabilityByProvider(ctx context.Context, cfg storage.ProviderCon
 		}
 	}
 
+	if cfg.Providers.AzureBlob.Enabled && cfg.Providers.AzureBlob.EnsureAvailable {
+		if err := validateAzureBlobProvider(ctx, cfg.Providers.AzureBlob, logSuccess); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if cfg.Providers.GCS.Enabled && cfg.Providers.GCS.EnsureAvailable {
+		if err := validateGCSProvider(ctx, cfg.Providers.GCS, logSuccess); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	if cfg.Providers.Database.Enabled && cfg.Providers.Database.EnsureAvailable {
 		if err := validateDatabaseProvider(ctx, cfg.Providers.Database); err != nil {
 			errs = append(errs, err)
@@ -188,6 +202,64 @@ func validateR2Provider(ctx context.Context, cfg storage.ProviderConfigs, logSuc
 	return validateBuckets(ctx, "r2", provider, cfg.Bucket, logSuccess)
 }
 
+// validateAzureBlobProvider checks connectivity to Azure Blob Storage and the existence of the specified container
+func validateAzureBlobProvider(ctx context.Context, cfg storage.ProviderConfigs, logSuccess bool) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	options := storage.NewProviderOptions()
+	if cfg.Bucket != "" {
+		options.Apply(storage.WithBucket(cfg.Bucket))
+	}
+
+	if cfg.Endpoint != "" {
+		options.Apply(storage.WithEndpoint(cfg.Endpoint))
+	}
+
+	provider, err := azblobprovider.NewAzureBlobBuilder().Build(ctx, cfg.Credentials, options)
+	if err != nil {
+		return fmt.Errorf("azure blob provider initialization: %w", err)
+	}
+
+	defer provider.Close()
+
+	if err := validateProviderType(storage.AzureBlobProvider, provider); err != nil {
+		return err
+	}
+
+	return validateBuckets(ctx, "azureblob", provider, cfg.Bucket, logSuccess)
+}
+
+// validateGCSProvider checks connectivity to Google Cloud Storage and the existence of the specified bucket
+func validateGCSProvider(ctx context.Context, cfg storage.ProviderConfigs, logSuccess bool) error {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	options := storage.NewProviderOptions()
+	if cfg.Bucket != "" {
+		options.Apply(storage.WithBucket(cfg.Bucket))
+	}
+
+	if cfg.Endpoint != "" {
+		options.Apply(storage.WithEndpoint(cfg.Endpoint))
+	}
+
+	provider, err := gcsprovider.NewGCSBuilder().Build(ctx, cfg.Credentials, options)
+	if err != nil {
+		return fmt.Errorf("gcs provider initialization: %w", err)
+	}
+
+	defer provider.Close()
+
+	if err := validateProviderType(storage.GCSProvider, provider); err != nil {
+		return err
+	}
+
+	return validateBuckets(ctx, "gcs", provider, cfg.Bucket, logSuccess)
+}
+
 // validateDatabaseProvider checks that the database provider can access the File table
 func validateDatabaseProvider(ctx context.Context, cfg storage.ProviderConfigs) error {
 	if !cfg.Enabled {
@@ -219,7 +291,7 @@ func validateProviderType(expected storagetypes.ProviderType, provider storagety
 
 // validateBuckets checks that the expected bucket exists in the provider's list of buckets
 func validateBuckets(ctx context.Context, providerName string, provider storagetypes.Provider, expectedBucket string, logSuccess bool) error {
-	buckets, err := provider.ListBuckets()
+	buckets, err := provider.ListBuckets(ctx)
 	if err != nil {
 		return fmt.Errorf("%s list buckets: %w", providerName, err)
 	}
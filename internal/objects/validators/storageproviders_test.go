@@ -158,7 +158,7 @@ func (s *stubProvider) GetScheme() *string {
 	return nil
 }
 
-func (s *stubProvider) ListBuckets() ([]string, error) {
+func (s *stubProvider) ListBuckets(context.Context) ([]string, error) {
 	if s.listErr != nil {
 		return nil, s.listErr
 	}
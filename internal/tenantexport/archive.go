@@ -0,0 +1,328 @@
+package tenantexport
+
+import (
+	"archive/zip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+)
+
+// FormatVersion is the version of the archive written by the export; archives of other versions are rejected
+// by the import
+const FormatVersion = 1
+
+const (
+	// manifestPath is the archive entry holding the manifest
+	manifestPath = "manifest.json"
+	// usersPath is the archive entry holding the users referenced by the exported rows
+	usersPath = "users.jsonl"
+	// tuplesPath is the archive entry holding the tuples of the exported objects
+	tuplesPath = "tuples.jsonl"
+)
+
+// Manifest describes the contents of an archive
+type Manifest struct {
+	// Version is the format version of the archive
+	Version int `json:"version"`
+	// OrganizationID is the id of the exported organization
+	OrganizationID string `json:"organizationID"`
+	// OrganizationName is the name of the exported organization
+	OrganizationName string `json:"organizationName"`
+	// ExportedAt is when the export was taken
+	ExportedAt time.Time `json:"exportedAt"`
+	// Tables are the exported tables, main tables first and in the order they were written
+	Tables []TableManifest `json:"tables"`
+	// Users is the number of users referenced by the exported rows
+	Users int `json:"users"`
+	// Tuples is the number of exported tuples
+	Tuples int `json:"tuples"`
+	// Files are the ids of the files whose contents are in the archive
+	Files []string `json:"files"`
+	// MissingFiles are the ids of the files whose contents could not be read during the export
+	MissingFiles []string `json:"missingFiles,omitempty"`
+	// Digests holds the sha256 of every other entry of the archive by path
+	Digests map[string]string `json:"digests"`
+}
+
+// TableManifest describes an exported table
+type TableManifest struct {
+	// Name is the name of the table
+	Name string `json:"name"`
+	// History is true for the history tables
+	History bool `json:"history,omitempty"`
+	// Columns are the exported columns, in the order of the values of every row
+	Columns []string `json:"columns"`
+	// Rows is the number of exported rows
+	Rows int `json:"rows"`
+}
+
+// User is a user referenced by the exported rows; users are not exported themselves but mapped to the users of
+// the target by email
+type User struct {
+	// ID is the id of the user
+	ID string `json:"id"`
+	// Email is the email of the user
+	Email string `json:"email"`
+	// FirstName is the first name of the user
+	FirstName string `json:"firstName,omitempty"`
+	// LastName is the last name of the user
+	LastName string `json:"lastName,omitempty"`
+}
+
+// Row holds the values of a row as text, in the order of the columns of its table; nil values are null
+type Row []*string
+
+// tablePath returns the archive entry holding the rows of the table
+func tablePath(name string, history bool) string {
+	if history {
+		return "history/" + name + ".jsonl"
+	}
+
+	return "tables/" + name + ".jsonl"
+}
+
+// filePath returns the archive entry holding the contents of the file
+func filePath(id string) string {
+	return "files/" + id
+}
+
+// archiveWriter writes the entries of an archive and records their digests for the manifest
+type archiveWriter struct {
+	zw      *zip.Writer
+	digests map[string]string
+}
+
+// newArchiveWriter returns an archive writer writing to w
+func newArchiveWriter(w io.Writer) *archiveWriter {
+	return &archiveWriter{zw: zip.NewWriter(w), digests: map[string]string{}}
+}
+
+// entry creates the entry and writes it through fn, recording its digest
+func (a *archiveWriter) entry(name string, fn func(w io.Writer) error) error {
+	w, err := a.zw.Create(name)
+	if err != nil {
+		return err
+	}
+
+	h := sha256.New()
+
+	if err := fn(io.MultiWriter(w, h)); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+
+	a.digests[name] = hex.EncodeToString(h.Sum(nil))
+
+	return nil
+}
+
+// lines writes the values as an entry of json lines
+func lines[T any](a *archiveWriter, name string, values []T) error {
+	return a.entry(name, func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+
+		for _, v := range values {
+			if err := enc.Encode(v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// close writes the manifest along with the digests of the entries and closes the archive
+func (a *archiveWriter) close(m *Manifest) error {
+	m.Digests = a.digests
+
+	w, err := a.zw.Create(manifestPath)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(m); err != nil {
+		return err
+	}
+
+	return a.zw.Close()
+}
+
+// Archive is an archive opened for import
+type Archive struct {
+	// Manifest describes the contents of the archive
+	Manifest *Manifest
+
+	entries map[string]*zip.File
+}
+
+// OpenArchive opens the archive and verifies its version and the digest of every entry
+func OpenArchive(r io.ReaderAt, size int64) (*Archive, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrCorruptArchive, err)
+	}
+
+	a := &Archive{entries: make(map[string]*zip.File, len(zr.File))}
+
+	for _, f := range zr.File {
+		a.entries[f.Name] = f
+	}
+
+	if err := a.readJSON(manifestPath, &a.Manifest); err != nil {
+		return nil, err
+	}
+
+	if a.Manifest.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, a.Manifest.Version)
+	}
+
+	if err := a.verify(); err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+// verify checks every entry of the archive is listed in the manifest with a matching digest
+func (a *Archive) verify() error {
+	for name := range a.Manifest.Digests {
+		if _, ok := a.entries[name]; !ok {
+			return fmt.Errorf("%w: %s is missing", ErrCorruptArchive, name)
+		}
+	}
+
+	for name, f := range a.entries {
+		if name == manifestPath {
+			continue
+		}
+
+		want, ok := a.Manifest.Digests[name]
+		if !ok {
+			return fmt.Errorf("%w: %s is not in the manifest", ErrCorruptArchive, name)
+		}
+
+		rc, err := f.Open()
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrCorruptArchive, name, err)
+		}
+
+		h := sha256.New()
+		_, err = io.Copy(h, rc)
+		rc.Close()
+
+		if err != nil {
+			return fmt.Errorf("%w: %s: %w", ErrCorruptArchive, name, err)
+		}
+
+		if hex.EncodeToString(h.Sum(nil)) != want {
+			return fmt.Errorf("%w: %s does not match its digest", ErrCorruptArchive, name)
+		}
+	}
+
+	return nil
+}
+
+// open opens the entry of the archive
+func (a *Archive) open(name string) (io.ReadCloser, error) {
+	f, ok := a.entries[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is missing", ErrCorruptArchive, name)
+	}
+
+	return f.Open()
+}
+
+// readJSON decodes the entry into v
+func (a *Archive) readJSON(name string, v any) error {
+	rc, err := a.open(name)
+	if err != nil {
+		return err
+	}
+
+	defer rc.Close()
+
+	if err := json.NewDecoder(rc).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %w", ErrCorruptArchive, name, err)
+	}
+
+	return nil
+}
+
+// readLines decodes the json lines of the entry; a missing optional entry has no lines
+func readLines[T any](a *Archive, name string) ([]T, error) {
+	if _, ok := a.entries[name]; !ok {
+		return nil, nil
+	}
+
+	rc, err := a.open(name)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rc.Close()
+
+	var out []T
+
+	dec := json.NewDecoder(rc)
+
+	for {
+		var v T
+
+		err := dec.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			return out, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrCorruptArchive, name, err)
+		}
+
+		out = append(out, v)
+	}
+}
+
+// Rows returns the rows of the exported table
+func (a *Archive) Rows(t TableManifest) ([]Row, error) {
+	rows, err := readLines[Row](a, tablePath(t.Name, t.History))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		if len(r) != len(t.Columns) {
+			return nil, fmt.Errorf("%w: %s has a row with %d values for %d columns", ErrCorruptArchive, t.Name, len(r), len(t.Columns))
+		}
+	}
+
+	return rows, nil
+}
+
+// Users returns the users referenced by the exported rows
+func (a *Archive) Users() ([]User, error) {
+	return readLines[User](a, usersPath)
+}
+
+// Tuples returns the exported tuples
+func (a *Archive) Tuples() ([]tuplecheck.Tuple, error) {
+	return readLines[tuplecheck.Tuple](a, tuplesPath)
+}
+
+// File returns the contents of the exported file
+func (a *Archive) File(id string) ([]byte, error) {
+	rc, err := a.open(filePath(id))
+	if err != nil {
+		return nil, err
+	}
+
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
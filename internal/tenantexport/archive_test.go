@@ -0,0 +1,120 @@
+package tenantexport
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+)
+
+// testArchive writes an archive with one table, a user, a tuple and a file, letting tamper change the digests
+// or the manifest before it is written
+func testArchive(t *testing.T, tamper func(a *archiveWriter, m *Manifest)) *bytes.Reader {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	aw := newArchiveWriter(&buf)
+	m := &Manifest{
+		Version:          FormatVersion,
+		OrganizationID:   "org",
+		OrganizationName: "Acme",
+		ExportedAt:       time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Tables:           []TableManifest{{Name: "controls", Columns: []string{"id", "owner_id", "description"}, Rows: 2}},
+		Users:            1,
+		Tuples:           1,
+		Files:            []string{"file"},
+	}
+
+	require.NoError(t, lines(aw, tablePath("controls", false), []Row{
+		{ptr("c1"), ptr("org"), ptr("first")},
+		{ptr("c2"), ptr("org"), nil},
+	}))
+	require.NoError(t, lines(aw, usersPath, []User{{ID: "u1", Email: "u1@example.com"}}))
+	require.NoError(t, lines(aw, tuplesPath, []tuplecheck.Tuple{{Subject: "organization:org", Relation: "parent_context", Object: "control:c1"}}))
+	require.NoError(t, aw.entry(filePath("file"), func(w io.Writer) error {
+		_, err := w.Write([]byte("contents"))
+
+		return err
+	}))
+
+	if tamper != nil {
+		tamper(aw, m)
+	}
+
+	require.NoError(t, aw.close(m))
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	r := testArchive(t, nil)
+
+	a, err := OpenArchive(r, r.Size())
+	require.NoError(t, err)
+
+	assert.Equal(t, "Acme", a.Manifest.OrganizationName)
+	assert.Len(t, a.Manifest.Digests, 4)
+
+	rows, err := a.Rows(a.Manifest.Tables[0])
+	require.NoError(t, err)
+	assert.Equal(t, []Row{{ptr("c1"), ptr("org"), ptr("first")}, {ptr("c2"), ptr("org"), nil}}, rows)
+
+	users, err := a.Users()
+	require.NoError(t, err)
+	assert.Equal(t, []User{{ID: "u1", Email: "u1@example.com"}}, users)
+
+	tuples, err := a.Tuples()
+	require.NoError(t, err)
+	assert.Len(t, tuples, 1)
+
+	data, err := a.File("file")
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	_, err = a.Rows(TableManifest{Name: "controls", Columns: []string{"id"}})
+	assert.ErrorIs(t, err, ErrCorruptArchive)
+}
+
+func TestOpenArchive(t *testing.T) {
+	testCases := []struct {
+		name      string
+		tamper    func(a *archiveWriter, m *Manifest)
+		expectErr error
+	}{
+		{
+			name:      "unsupported version",
+			tamper:    func(_ *archiveWriter, m *Manifest) { m.Version = FormatVersion + 1 },
+			expectErr: ErrUnsupportedVersion,
+		},
+		{
+			name:      "digest mismatch",
+			tamper:    func(a *archiveWriter, _ *Manifest) { a.digests[usersPath] = "0000" },
+			expectErr: ErrCorruptArchive,
+		},
+		{
+			name:      "entry not in the manifest",
+			tamper:    func(a *archiveWriter, _ *Manifest) { delete(a.digests, filePath("file")) },
+			expectErr: ErrCorruptArchive,
+		},
+		{
+			name:      "missing entry",
+			tamper:    func(a *archiveWriter, _ *Manifest) { a.digests[filePath("other")] = "0000" },
+			expectErr: ErrCorruptArchive,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := testArchive(t, tc.tamper)
+
+			_, err := OpenArchive(r, r.Size())
+			assert.ErrorIs(t, err, tc.expectErr)
+		})
+	}
+}
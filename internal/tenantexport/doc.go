@@ -0,0 +1,12 @@
+// Package tenantexport backs up an organization into a versioned archive and restores it, in the same or another
+// environment. The export writes every row scoped to the organization, read straight from the tables of the ent
+// schemas so edges held in join tables come along, together with the history of those rows, the users referenced
+// by them, the authorization tuples of the exported objects and the contents of the files of the organization.
+// Credential tables such as API tokens, secrets and invites are left out.
+//
+// The import gives every exported row a new id, maps users to the existing users of the target by email and
+// rewrites every reference to the old ids, including references nested in json columns and tuples. References
+// to rows outside the archive are checked against the target before anything is written; rows that cannot be
+// restored are reported, and the import is aborted unless invalid rows are explicitly skipped. Rows are inserted
+// in a single transaction, after which the tuples are written and the files uploaded to the storage of the target
+package tenantexport
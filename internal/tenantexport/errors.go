@@ -0,0 +1,27 @@
+package tenantexport
+
+import "errors"
+
+var (
+	// ErrOrganizationRequired is returned when an export is started without an organization id
+	ErrOrganizationRequired = errors.New("organization id is required to export an organization")
+	// ErrOrganizationNotFound is returned when the organization to export does not exist
+	ErrOrganizationNotFound = errors.New("organization not found")
+	// ErrDriverRequired is returned when the service is created without a database driver
+	ErrDriverRequired = errors.New("database driver is required to export or import an organization")
+	// ErrStoreRequired is returned when the service is created without a tuple store
+	ErrStoreRequired = errors.New("tuple store is required to export or import an organization")
+	// ErrObjectsRequired is returned when the service is created without an object store
+	ErrObjectsRequired = errors.New("object store is required to export or import an organization")
+	// ErrUnsupportedVersion is returned when the archive was written with an unknown format version
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+	// ErrCorruptArchive is returned when an entry of the archive is missing or does not match its digest
+	ErrCorruptArchive = errors.New("archive is corrupt")
+	// ErrSchemaMismatch is returned when a table or column of the archive does not exist in the target
+	ErrSchemaMismatch = errors.New("archive does not match the schema of the target")
+	// ErrOrganizationExists is returned when an organization with the name of the imported one already exists
+	ErrOrganizationExists = errors.New("an organization with this name already exists, set a new name for the import")
+	// ErrIntegrityViolations is returned when rows of the archive reference rows missing from the archive and the
+	// target and invalid rows are not skipped
+	ErrIntegrityViolations = errors.New("archive has references to rows that do not exist")
+)
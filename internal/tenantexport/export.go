@@ -0,0 +1,239 @@
+package tenantexport
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+)
+
+// collector gathers what is exported alongside the rows: the objects to read tuples for, the users referenced by
+// the rows and the files to read contents for
+type collector struct {
+	orgID   string
+	objects []string
+	users   map[string]bool
+	files   []File
+}
+
+// table returns the function collecting from the rows of the table
+func (c *collector) table(sc scope, fgaType string, columns []string) func(Row) {
+	idIdx := slices.Index(columns, idColumn)
+
+	var userIdx []int
+
+	for _, fk := range foreignKeys(sc.table) {
+		if i := slices.Index(columns, fk.column.Name); fk.refTable == usersTable && i >= 0 {
+			userIdx = append(userIdx, i)
+		}
+	}
+
+	return func(row Row) {
+		if fgaType != "" && idIdx >= 0 && row[idIdx] != nil {
+			c.objects = append(c.objects, fgaType+":"+*row[idIdx])
+		}
+
+		for _, i := range userIdx {
+			if row[i] != nil {
+				c.users[*row[i]] = true
+			}
+		}
+
+		if sc.table.Name == filesTable {
+			if f := fileOf(c.orgID, columns, row); f.Location.Provider != "" && f.Location.Key != "" {
+				c.files = append(c.files, f)
+			}
+		}
+	}
+}
+
+// Export writes every row scoped to the organization along with its history, the users it references, the
+// tuples of its objects and the contents of its files to w as an archive. Files whose contents cannot be read
+// are listed in the manifest rather than failing the export
+func (s *Service) Export(ctx context.Context, orgID string, w io.Writer) (*Manifest, error) {
+	if orgID == "" {
+		return nil, ErrOrganizationRequired
+	}
+
+	names, err := queryStrings(ctx, s.drv, fmt.Sprintf("SELECT %s FROM %s WHERE %s = $1",
+		quote("name"), quote(organizationsTable), quote(idColumn)), []any{orgID}, 1)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return nil, ErrOrganizationNotFound
+	}
+
+	m := &Manifest{
+		Version:          FormatVersion,
+		OrganizationID:   orgID,
+		OrganizationName: names[0][0],
+		ExportedAt:       time.Now().UTC(),
+	}
+
+	aw := newArchiveWriter(w)
+	c := &collector{orgID: orgID, users: map[string]bool{}}
+
+	for _, sc := range plan(s.tables, s.historyTables) {
+		var collect func(Row)
+		if !sc.history {
+			collect = c.table(sc, s.fgaTypes[sc.table.Name], sc.columns())
+		}
+
+		tm, err := s.exportTable(ctx, aw, sc, orgID, collect)
+		if err != nil {
+			return nil, err
+		}
+
+		m.Tables = append(m.Tables, tm)
+	}
+
+	users, err := s.users(ctx, c.users)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := lines(aw, usersPath, users); err != nil {
+		return nil, err
+	}
+
+	m.Users = len(users)
+
+	var tuples []tuplecheck.Tuple
+
+	for _, object := range c.objects {
+		t, err := s.store.ReadObject(ctx, object)
+		if err != nil {
+			return nil, fmt.Errorf("reading tuples of %s: %w", object, err)
+		}
+
+		tuples = append(tuples, t...)
+	}
+
+	if err := lines(aw, tuplesPath, tuples); err != nil {
+		return nil, err
+	}
+
+	m.Tuples = len(tuples)
+
+	for _, f := range c.files {
+		data, err := s.objects.Download(ctx, f)
+		if err != nil {
+			m.MissingFiles = append(m.MissingFiles, f.ID)
+
+			continue
+		}
+
+		if err := aw.entry(filePath(f.ID), func(w io.Writer) error {
+			_, err := w.Write(data)
+
+			return err
+		}); err != nil {
+			return nil, err
+		}
+
+		m.Files = append(m.Files, f.ID)
+	}
+
+	if err := aw.close(m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// exportTable writes the rows of the organization in the table as json lines of text values, passing each row
+// to collect when set
+func (s *Service) exportTable(ctx context.Context, aw *archiveWriter, sc scope, orgID string, collect func(Row)) (TableManifest, error) {
+	columns := sc.columns()
+	tm := TableManifest{Name: sc.table.Name, History: sc.history, Columns: columns}
+
+	selects := make([]string, len(columns))
+	for i, c := range columns {
+		selects[i] = quote(c) + "::text"
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s", strings.Join(selects, ", "), quote(sc.table.Name), sc.where)
+	if hasColumn(sc.table, idColumn) {
+		query += " ORDER BY " + quote(idColumn)
+	}
+
+	var rows entsql.Rows
+	if err := s.drv.Query(ctx, query, []any{orgID}, &rows); err != nil {
+		return tm, fmt.Errorf("querying %s: %w", sc.table.Name, err)
+	}
+
+	defer rows.Close()
+
+	err := aw.entry(tablePath(sc.table.Name, sc.history), func(w io.Writer) error {
+		enc := json.NewEncoder(w)
+
+		for rows.Next() {
+			values := make([]sql.NullString, len(columns))
+			dest := make([]any, len(columns))
+
+			for i := range values {
+				dest[i] = &values[i]
+			}
+
+			if err := rows.Scan(dest...); err != nil {
+				return err
+			}
+
+			row := make(Row, len(columns))
+
+			for i, v := range values {
+				if v.Valid {
+					row[i] = &values[i].String
+				}
+			}
+
+			if collect != nil {
+				collect(row)
+			}
+
+			if err := enc.Encode(row); err != nil {
+				return err
+			}
+
+			tm.Rows++
+		}
+
+		return rows.Err()
+	})
+
+	return tm, err
+}
+
+// users returns the users with the ids, in id order
+func (s *Service) users(ctx context.Context, ids map[string]bool) ([]User, error) {
+	keys := make([]string, 0, len(ids))
+	for id := range ids {
+		keys = append(keys, id)
+	}
+
+	slices.Sort(keys)
+
+	rows, err := lookup(ctx, s.drv, fmt.Sprintf("SELECT %s, %s, %s, %s FROM %s WHERE %s IN (%%s) ORDER BY %s",
+		quote(idColumn), quote("email"), quote("first_name"), quote("last_name"), quote(usersTable), quote(idColumn), quote(idColumn)),
+		keys, 4) //nolint:mnd
+	if err != nil {
+		return nil, fmt.Errorf("querying users: %w", err)
+	}
+
+	users := make([]User, 0, len(rows))
+	for _, r := range rows {
+		users = append(users, User{ID: r[0], Email: r[1], FirstName: r[2], LastName: r[3]})
+	}
+
+	return users, nil
+}
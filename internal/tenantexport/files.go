@@ -0,0 +1,145 @@
+package tenantexport
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/theopenlane/iam/auth"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	ent "github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/privacy"
+	"github.com/theopenlane/core/internal/objects"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+// fileColumns are the columns of the files table read to download and upload file contents
+var fileColumns = struct {
+	provider, volume, path, region, uri, name, contentType string
+}{
+	provider:    "storage_provider",
+	volume:      "storage_volume",
+	path:        "storage_path",
+	region:      "storage_region",
+	uri:         "uri",
+	name:        "provided_file_name",
+	contentType: "detected_content_type",
+}
+
+// File is a file of the organization along with the location of its contents
+type File struct {
+	// ID is the id of the file
+	ID string
+	// OrganizationID is the organization the file belongs to
+	OrganizationID string
+	// Name is the name the file was uploaded with
+	Name string
+	// ContentType is the detected content type of the file
+	ContentType string
+	// Location is where the contents of the file are stored
+	Location objects.ObjectLocation
+}
+
+// Objects reads and writes the contents of files
+type Objects interface {
+	// Download returns the contents of the file
+	Download(ctx context.Context, f File) ([]byte, error)
+	// Upload stores the contents of the file with the storage provider of the organization and returns their location
+	Upload(ctx context.Context, f File, data []byte) (objects.ObjectLocation, error)
+}
+
+// objectStore reads and writes file contents through the object storage service
+type objectStore struct {
+	db      *ent.Client
+	service *objects.Service
+}
+
+// NewObjects returns Objects backed by the object storage service; the client is used by providers that store
+// file contents in the database
+func NewObjects(db *ent.Client, service *objects.Service) Objects {
+	return &objectStore{db: db, service: service}
+}
+
+// Download satisfies the Objects interface
+func (s *objectStore) Download(ctx context.Context, f File) ([]byte, error) {
+	file := f.Location.File(&storagetypes.File{
+		ID:           f.ID,
+		OriginalName: f.Name,
+		FileMetadata: storagetypes.FileMetadata{ContentType: f.ContentType},
+	})
+	file.ProviderHints.OrganizationID = f.OrganizationID
+
+	downloaded, err := s.service.Download(s.context(ctx, f.OrganizationID), nil, file, &storage.DownloadOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return downloaded.File, nil
+}
+
+// Upload satisfies the Objects interface
+func (s *objectStore) Upload(ctx context.Context, f File, data []byte) (objects.ObjectLocation, error) {
+	// the file id lets providers that key objects by file record, such as the database provider, locate the record
+	opts := &storage.UploadOptions{
+		FileName:    f.Name,
+		ContentType: f.ContentType,
+		FileMetadata: storagetypes.FileMetadata{
+			Key: f.Name,
+			ProviderHints: &storagetypes.ProviderHints{
+				OrganizationID: f.OrganizationID,
+				Metadata:       map[string]string{"file_id": f.ID},
+			},
+		},
+	}
+
+	uploaded, err := s.service.Upload(s.context(ctx, f.OrganizationID), bytes.NewReader(data), opts)
+	if err != nil {
+		return objects.ObjectLocation{}, err
+	}
+
+	return objects.ObjectLocation{
+		Provider: uploaded.ProviderType,
+		Bucket:   uploaded.Bucket,
+		Key:      uploaded.Key,
+		Region:   uploaded.Region,
+		URI:      uploaded.FullURI,
+	}, nil
+}
+
+// context returns a context allowed to read and write every file of the organization
+func (s *objectStore) context(ctx context.Context, orgID string) context.Context {
+	ctx = ent.NewContext(privacy.DecisionContext(ctx, privacy.Allow), s.db)
+
+	return auth.WithCaller(ctx, &auth.Caller{
+		OrganizationID:  orgID,
+		OrganizationIDs: []string{orgID},
+		Capabilities:    auth.CapBypassOrgFilter | auth.CapBypassFGA | auth.CapInternalOperation,
+	})
+}
+
+// fileOf returns the file described by a row of the files table
+func fileOf(orgID string, columns []string, row Row) File {
+	get := func(name string) string {
+		for i, c := range columns {
+			if c == name && row[i] != nil {
+				return *row[i]
+			}
+		}
+
+		return ""
+	}
+
+	return File{
+		ID:             get(idColumn),
+		OrganizationID: orgID,
+		Name:           get(fileColumns.name),
+		ContentType:    get(fileColumns.contentType),
+		Location: objects.ObjectLocation{
+			Provider: storagetypes.ProviderType(get(fileColumns.provider)),
+			Bucket:   get(fileColumns.volume),
+			Key:      get(fileColumns.path),
+			Region:   get(fileColumns.region),
+			URI:      get(fileColumns.uri),
+		},
+	}
+}
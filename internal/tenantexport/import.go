@@ -0,0 +1,715 @@
+package tenantexport
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entschema "entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+	"github.com/theopenlane/utils/ulids"
+
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+)
+
+// maxParameters is the maximum number of parameters postgres accepts in a statement, with room to spare
+const maxParameters = 65000
+
+// Options configures an import
+type Options struct {
+	// Name replaces the name of the organization, which must be unique in the target
+	Name string
+	// DryRun validates the archive and rolls back the inserted rows; tuples and files are not written
+	DryRun bool
+	// SkipInvalid restores the archive without the rows whose references cannot be resolved instead of aborting
+	SkipInvalid bool
+}
+
+// Violation is a reference of a row of the archive to a row that exists neither in the archive nor in the target
+type Violation struct {
+	// Table is the table of the row
+	Table string `json:"table"`
+	// ID is the id of the row in the archive, empty for join tables
+	ID string `json:"id,omitempty"`
+	// Column is the column holding the reference
+	Column string `json:"column"`
+	// Value is the referenced id, as found in the archive
+	Value string `json:"value"`
+	// Skipped is true when the row is not restored because the column is required, otherwise the column is cleared
+	Skipped bool `json:"skipped"`
+}
+
+// String returns the violation in a readable form
+func (v Violation) String() string {
+	action := "cleared"
+	if v.Skipped {
+		action = "row skipped"
+	}
+
+	return fmt.Sprintf("%s %s: %s references missing %s (%s)", v.Table, v.ID, v.Column, v.Value, action)
+}
+
+// Result summarizes an import
+type Result struct {
+	// SourceOrganizationID is the id of the organization in the archive
+	SourceOrganizationID string `json:"sourceOrganizationID"`
+	// OrganizationID is the id of the restored organization
+	OrganizationID string `json:"organizationID"`
+	// OrganizationName is the name of the restored organization
+	OrganizationName string `json:"organizationName"`
+	// DryRun is true when nothing was written
+	DryRun bool `json:"dryRun"`
+	// Rows is the number of rows restored
+	Rows int `json:"rows"`
+	// HistoryRows is the number of history rows restored
+	HistoryRows int `json:"historyRows"`
+	// Users is the number of users of the archive mapped to users of the target
+	Users int `json:"users"`
+	// UnmappedUsers are the emails of the users of the archive missing from the target
+	UnmappedUsers []string `json:"unmappedUsers,omitempty"`
+	// Violations are the references that could not be resolved
+	Violations []Violation `json:"violations,omitempty"`
+	// Tuples is the number of tuples written
+	Tuples int `json:"tuples"`
+	// TupleErrors are the errors of the tuple writes that failed
+	TupleErrors []string `json:"tupleErrors,omitempty"`
+	// Files is the number of files uploaded
+	Files int `json:"files"`
+	// FileErrors are the errors of the file uploads that failed
+	FileErrors []string `json:"fileErrors,omitempty"`
+}
+
+// importTable is a table of the archive resolved against the target
+type importTable struct {
+	manifest TableManifest
+	table    *entschema.Table
+	// types are the sql types of the columns of the manifest in the target
+	types []string
+	rows  []Row
+	// skip marks the rows not restored
+	skip []bool
+}
+
+// index returns the position of the column in the rows, or -1
+func (t *importTable) index(name string) int {
+	return slices.Index(t.manifest.Columns, name)
+}
+
+// id returns the id of the row, empty for join tables
+func (t *importTable) id(r int) string {
+	if i := t.index(idColumn); i >= 0 && t.rows[r][i] != nil {
+		return *t.rows[r][i]
+	}
+
+	return ""
+}
+
+// importState tracks the ids of an import
+type importState struct {
+	ids idMap
+	// rowIDs are the new ids of the main rows of the archive
+	rowIDs map[string]bool
+	// skipped are the new ids of the rows not restored
+	skipped map[string]bool
+	// original maps the new ids back to the ids of the archive for reporting
+	original map[string]string
+}
+
+// Import restores the organization of the archive with new ids. References to rows missing from both the archive
+// and the target are reported; unless opts.SkipInvalid is set the import is aborted with ErrIntegrityViolations
+// and the result listing them. Rows are inserted in one transaction, after which the tuples are written and the
+// files uploaded; failures of those are listed in the result
+func (s *Service) Import(ctx context.Context, a *Archive, opts Options) (*Result, error) {
+	res := &Result{SourceOrganizationID: a.Manifest.OrganizationID, DryRun: opts.DryRun}
+
+	mainTables, historyTables, err := s.loadTables(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &importState{ids: idMap{}, rowIDs: map[string]bool{}, skipped: map[string]bool{}, original: map[string]string{}}
+
+	assign := func(old string) string {
+		id, ok := st.ids[old]
+		if !ok {
+			id = ulids.New().String()
+			st.ids[old] = id
+			st.original[id] = old
+		}
+
+		return id
+	}
+
+	for _, t := range mainTables {
+		for r := range t.rows {
+			if old := t.id(r); old != "" {
+				st.rowIDs[assign(old)] = true
+			}
+		}
+	}
+
+	// history of deleted rows keeps referencing the same new id across its rows
+	for _, t := range historyTables {
+		ref := t.index(refColumn)
+
+		for r, row := range t.rows {
+			if old := t.id(r); old != "" {
+				assign(old)
+			}
+
+			if ref >= 0 && row[ref] != nil {
+				assign(*row[ref])
+			}
+		}
+	}
+
+	if err := s.mapUsers(ctx, a, st.ids, res); err != nil {
+		return nil, err
+	}
+
+	res.OrganizationID = st.ids[a.Manifest.OrganizationID]
+	res.OrganizationName = a.Manifest.OrganizationName
+
+	if opts.Name != "" {
+		res.OrganizationName = opts.Name
+	}
+
+	for _, t := range slices.Concat(mainTables, historyTables) {
+		for _, row := range t.rows {
+			for i, c := range t.manifest.Columns {
+				row[i] = st.ids.value(row[i], column(t.table, c).Type == field.TypeJSON)
+			}
+		}
+	}
+
+	if err := s.rename(ctx, mainTables, res.OrganizationName); err != nil {
+		return nil, err
+	}
+
+	mainTables = orderTables(mainTables)
+
+	if err := s.validate(ctx, mainTables, st, res); err != nil {
+		return nil, err
+	}
+
+	if len(res.Violations) > 0 && !opts.SkipInvalid {
+		return res, ErrIntegrityViolations
+	}
+
+	if err := s.insertAll(ctx, mainTables, historyTables, st, res, opts.DryRun); err != nil {
+		return res, err
+	}
+
+	if err := s.restoreTuples(ctx, a, st, res, opts.DryRun); err != nil {
+		return res, err
+	}
+
+	if err := s.restoreFiles(ctx, a, mainTables, st, res, opts.DryRun); err != nil {
+		return res, err
+	}
+
+	return res, nil
+}
+
+// loadTables reads the tables of the archive and checks every table and column exists in the target
+func (s *Service) loadTables(ctx context.Context, a *Archive) (mainTables, historyTables []*importTable, err error) {
+	for _, tm := range a.Manifest.Tables {
+		table, ok := s.table(tm.Name, tm.History)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: table %s", ErrSchemaMismatch, tm.Name)
+		}
+
+		types, err := s.columnTypes(ctx, tm.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		t := &importTable{manifest: tm, table: table, types: make([]string, len(tm.Columns))}
+
+		for i, c := range tm.Columns {
+			if !hasColumn(table, c) || types[c] == "" {
+				return nil, nil, fmt.Errorf("%w: column %s.%s", ErrSchemaMismatch, tm.Name, c)
+			}
+
+			t.types[i] = types[c]
+		}
+
+		if t.rows, err = a.Rows(tm); err != nil {
+			return nil, nil, err
+		}
+
+		t.skip = make([]bool, len(t.rows))
+
+		if tm.History {
+			historyTables = append(historyTables, t)
+		} else {
+			mainTables = append(mainTables, t)
+		}
+	}
+
+	return mainTables, historyTables, nil
+}
+
+// columnTypes returns the sql types of the columns of the table in the target, by column name
+func (s *Service) columnTypes(ctx context.Context, table string) (map[string]string, error) {
+	rows, err := queryStrings(ctx, s.drv,
+		"SELECT a.attname, format_type(a.atttypid, a.atttypmod) FROM pg_attribute a "+
+			"WHERE a.attrelid = to_regclass($1) AND a.attnum > 0 AND NOT a.attisdropped",
+		[]any{quote(table)}, 2) //nolint:mnd
+	if err != nil {
+		return nil, fmt.Errorf("reading columns of %s: %w", table, err)
+	}
+
+	types := make(map[string]string, len(rows))
+	for _, r := range rows {
+		types[r[0]] = r[1]
+	}
+
+	return types, nil
+}
+
+// mapUsers maps the users of the archive to the users of the target with the same email
+func (s *Service) mapUsers(ctx context.Context, a *Archive, ids idMap, res *Result) error {
+	users, err := a.Users()
+	if err != nil {
+		return err
+	}
+
+	emails := make([]string, 0, len(users))
+	for _, u := range users {
+		emails = append(emails, strings.ToLower(u.Email))
+	}
+
+	rows, err := lookup(ctx, s.drv, fmt.Sprintf("SELECT %s, lower(%s) FROM %s WHERE lower(%s) IN (%%s)",
+		quote(idColumn), quote("email"), quote(usersTable), quote("email")), emails, 2) //nolint:mnd
+	if err != nil {
+		return fmt.Errorf("querying users: %w", err)
+	}
+
+	byEmail := make(map[string]string, len(rows))
+	for _, r := range rows {
+		byEmail[r[1]] = r[0]
+	}
+
+	for _, u := range users {
+		id, ok := byEmail[strings.ToLower(u.Email)]
+		if !ok {
+			res.UnmappedUsers = append(res.UnmappedUsers, u.Email)
+
+			continue
+		}
+
+		ids[u.ID] = id
+		res.Users++
+	}
+
+	return nil
+}
+
+// rename sets the name of the restored organization after checking it is not taken in the target
+func (s *Service) rename(ctx context.Context, tables []*importTable, name string) error {
+	taken, err := queryStrings(ctx, s.drv, fmt.Sprintf("SELECT %s FROM %s WHERE %s = $1 AND %s IS NULL",
+		quote(idColumn), quote(organizationsTable), quote("name"), quote("deleted_at")), []any{name}, 1)
+	if err != nil {
+		return err
+	}
+
+	if len(taken) > 0 {
+		return fmt.Errorf("%w: %s", ErrOrganizationExists, name)
+	}
+
+	for _, t := range tables {
+		if t.manifest.Name != organizationsTable {
+			continue
+		}
+
+		if i := t.index("name"); i >= 0 {
+			for _, row := range t.rows {
+				row[i] = &name
+			}
+		}
+	}
+
+	return nil
+}
+
+// orderTables orders the main tables for insertion
+func orderTables(tables []*importTable) []*importTable {
+	byName := make(map[string]*importTable, len(tables))
+	schemaTables := make([]*entschema.Table, 0, len(tables))
+
+	for _, t := range tables {
+		byName[t.table.Name] = t
+		schemaTables = append(schemaTables, t.table)
+	}
+
+	ordered := make([]*importTable, 0, len(tables))
+	for _, t := range insertOrder(schemaTables) {
+		ordered = append(ordered, byName[t.Name])
+	}
+
+	return ordered
+}
+
+// validate resolves the references of the rows, looking up references to rows outside the archive in the target.
+// Rows with unresolved required references are skipped, which cascades to the rows requiring them, and unresolved
+// nullable references are cleared once every skipped row is known
+func (s *Service) validate(ctx context.Context, tables []*importTable, st *importState, res *Result) error {
+	external := map[foreignKey][]string{}
+
+	for _, t := range tables {
+		for _, fk := range foreignKeys(t.table) {
+			i := t.index(fk.column.Name)
+			if i < 0 {
+				continue
+			}
+
+			for _, row := range t.rows {
+				if v := row[i]; v != nil && !st.rowIDs[*v] {
+					key := foreignKey{refTable: fk.refTable, refColumn: fk.refColumn}
+					external[key] = append(external[key], *v)
+				}
+			}
+		}
+	}
+
+	exists := map[string]bool{}
+
+	for key, values := range external {
+		slices.Sort(values)
+
+		rows, err := lookup(ctx, s.drv, fmt.Sprintf("SELECT %s FROM %s WHERE %s IN (%%s)",
+			quote(key.refColumn), quote(key.refTable), quote(key.refColumn)), slices.Compact(values), 1)
+		if err != nil {
+			return fmt.Errorf("looking up references to %s: %w", key.refTable, err)
+		}
+
+		for _, r := range rows {
+			exists[key.refTable+":"+r[0]] = true
+		}
+	}
+
+	resolved := func(fk foreignKey, v string) bool {
+		if st.rowIDs[v] {
+			return !st.skipped[v]
+		}
+
+		return exists[fk.refTable+":"+v]
+	}
+
+	for _, required := range []bool{true, false} {
+		for _, t := range tables {
+			for _, fk := range foreignKeys(t.table) {
+				i := t.index(fk.column.Name)
+				if i < 0 || fk.column.Nullable == required {
+					continue
+				}
+
+				for r, row := range t.rows {
+					v := row[i]
+					if t.skip[r] || v == nil || resolved(fk, *v) {
+						continue
+					}
+
+					id := t.id(r)
+
+					res.Violations = append(res.Violations, Violation{
+						Table:   t.table.Name,
+						ID:      st.original[id],
+						Column:  fk.column.Name,
+						Value:   cmp.Or(st.original[*v], *v),
+						Skipped: required,
+					})
+
+					if !required {
+						row[i] = nil
+
+						continue
+					}
+
+					t.skip[r] = true
+
+					if id != "" {
+						st.skipped[id] = true
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// deferredUpdate sets the nullable references of a row to rows of the archive once every row is inserted
+type deferredUpdate struct {
+	table  *importTable
+	id     string
+	values map[int]string
+}
+
+// insertAll inserts the rows in a transaction, rolled back on a dry run
+func (s *Service) insertAll(ctx context.Context, mainTables, historyTables []*importTable, st *importState, res *Result, dryRun bool) (err error) {
+	tx, err := s.drv.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if err != nil || dryRun {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var deferred []deferredUpdate
+
+	for _, t := range mainTables {
+		n, updates, err := insertTable(ctx, tx, t, st)
+		if err != nil {
+			return err
+		}
+
+		res.Rows += n
+		deferred = append(deferred, updates...)
+	}
+
+	for _, u := range deferred {
+		if err := updateRow(ctx, tx, u); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range historyTables {
+		n, _, err := insertTable(ctx, tx, t, nil)
+		if err != nil {
+			return err
+		}
+
+		res.HistoryRows += n
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	return tx.Commit()
+}
+
+// insertTable inserts the rows of the table that are not skipped in batches; nullable references to rows of the
+// archive are inserted as null and returned as updates when st is set, so insertion order only follows required
+// references
+func insertTable(ctx context.Context, tx dialect.Tx, t *importTable, st *importState) (int, []deferredUpdate, error) {
+	var nullable []int
+
+	if st != nil && !isJoinTable(t.table) {
+		for _, fk := range foreignKeys(t.table) {
+			if i := t.index(fk.column.Name); i >= 0 && fk.column.Nullable {
+				nullable = append(nullable, i)
+			}
+		}
+	}
+
+	columns := make([]string, len(t.manifest.Columns))
+	for i, c := range t.manifest.Columns {
+		columns[i] = quote(c)
+	}
+
+	batchSize := max(1, min(lookupBatchSize, maxParameters/max(1, len(columns))))
+
+	var (
+		inserted int
+		deferred []deferredUpdate
+		values   []string
+		args     []any
+	)
+
+	flush := func() error {
+		if len(values) == 0 {
+			return nil
+		}
+
+		query := fmt.Sprintf("INSERT INTO %s (%s) VALUES %s",
+			quote(t.table.Name), strings.Join(columns, ", "), strings.Join(values, ", "))
+
+		if err := tx.Exec(ctx, query, args, nil); err != nil {
+			return fmt.Errorf("inserting into %s: %w", t.table.Name, err)
+		}
+
+		values, args = values[:0], args[:0]
+
+		return nil
+	}
+
+	for r, row := range t.rows {
+		if t.skip[r] {
+			continue
+		}
+
+		update := deferredUpdate{table: t, id: t.id(r), values: map[int]string{}}
+		params := make([]string, len(row))
+
+		for i, v := range row {
+			if v != nil && slices.Contains(nullable, i) && st.rowIDs[*v] {
+				update.values[i] = *v
+				v = nil
+			}
+
+			args = append(args, textValue(v))
+			params[i] = fmt.Sprintf("$%d::text::%s", len(args), t.types[i])
+		}
+
+		values = append(values, "("+strings.Join(params, ", ")+")")
+		inserted++
+
+		if len(update.values) > 0 {
+			deferred = append(deferred, update)
+		}
+
+		if len(values) >= batchSize {
+			if err := flush(); err != nil {
+				return 0, nil, err
+			}
+		}
+	}
+
+	if err := flush(); err != nil {
+		return 0, nil, err
+	}
+
+	return inserted, deferred, nil
+}
+
+// updateRow sets the deferred references of the row
+func updateRow(ctx context.Context, tx dialect.Tx, u deferredUpdate) error {
+	sets := make([]string, 0, len(u.values))
+	args := make([]any, 0, len(u.values)+1)
+
+	for i, c := range u.table.manifest.Columns {
+		v, ok := u.values[i]
+		if !ok {
+			continue
+		}
+
+		args = append(args, v)
+		sets = append(sets, fmt.Sprintf("%s = $%d::text::%s", quote(c), len(args), u.table.types[i]))
+	}
+
+	args = append(args, u.id)
+
+	query := fmt.Sprintf("UPDATE %s SET %s WHERE %s = $%d",
+		quote(u.table.table.Name), strings.Join(sets, ", "), quote(idColumn), len(args))
+
+	if err := tx.Exec(ctx, query, args, nil); err != nil {
+		return fmt.Errorf("updating references of %s %s: %w", u.table.table.Name, u.id, err)
+	}
+
+	return nil
+}
+
+// textValue returns the value as a query argument
+func textValue(v *string) any {
+	if v == nil {
+		return nil
+	}
+
+	return *v
+}
+
+// restoreTuples writes the tuples of the restored objects in batches
+func (s *Service) restoreTuples(ctx context.Context, a *Archive, st *importState, res *Result, dryRun bool) error {
+	archived, err := a.Tuples()
+	if err != nil {
+		return err
+	}
+
+	tuples := make([]tuplecheck.Tuple, 0, len(archived))
+
+	for _, t := range archived {
+		mapped, ok := st.ids.tuple(t)
+		if !ok || st.skipped[entityID(mapped.Object)] || st.skipped[entityID(mapped.Subject)] {
+			continue
+		}
+
+		tuples = append(tuples, mapped)
+	}
+
+	if dryRun {
+		res.Tuples = len(tuples)
+
+		return nil
+	}
+
+	for start := 0; start < len(tuples); start += tuplecheck.DefaultBatchSize {
+		batch := tuples[start:min(start+tuplecheck.DefaultBatchSize, len(tuples))]
+
+		if err := s.store.Write(ctx, batch, nil); err != nil {
+			res.TupleErrors = append(res.TupleErrors, err.Error())
+
+			continue
+		}
+
+		res.Tuples += len(batch)
+	}
+
+	return nil
+}
+
+// restoreFiles uploads the contents of the restored files to the storage of the target and points their rows at
+// the uploaded objects
+func (s *Service) restoreFiles(ctx context.Context, a *Archive, tables []*importTable, st *importState, res *Result, dryRun bool) error {
+	idx := slices.IndexFunc(tables, func(t *importTable) bool { return t.table.Name == filesTable })
+	if idx < 0 {
+		return nil
+	}
+
+	files := tables[idx]
+	rows := map[string]Row{}
+
+	for r, row := range files.rows {
+		if !files.skip[r] {
+			rows[files.id(r)] = row
+		}
+	}
+
+	for _, old := range a.Manifest.Files {
+		row, ok := rows[st.ids[old]]
+		if !ok {
+			continue
+		}
+
+		if dryRun {
+			res.Files++
+
+			continue
+		}
+
+		data, err := a.File(old)
+		if err != nil {
+			return err
+		}
+
+		f := fileOf(res.OrganizationID, files.manifest.Columns, row)
+
+		loc, err := s.objects.Upload(ctx, f, data)
+		if err != nil {
+			res.FileErrors = append(res.FileErrors, fmt.Sprintf("%s: %v", f.ID, err))
+
+			continue
+		}
+
+		if err := s.drv.Exec(ctx, fmt.Sprintf("UPDATE %s SET %s = $1, %s = $2, %s = $3, %s = $4, %s = $5 WHERE %s = $6",
+			quote(filesTable), quote(fileColumns.provider), quote(fileColumns.volume), quote(fileColumns.path),
+			quote(fileColumns.region), quote(fileColumns.uri), quote(idColumn)),
+			[]any{string(loc.Provider), loc.Bucket, loc.Key, loc.Region, loc.URI, f.ID}, nil); err != nil {
+			res.FileErrors = append(res.FileErrors, fmt.Sprintf("%s: %v", f.ID, err))
+
+			continue
+		}
+
+		res.Files++
+	}
+
+	return nil
+}
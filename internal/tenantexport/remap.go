@@ -0,0 +1,167 @@
+package tenantexport
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+)
+
+const (
+	// userType is the fga type of users, which must be mapped for their tuples to be restored
+	userType = "user"
+	// wildcard is the fga id granting a relation to every subject of a type
+	wildcard = "*"
+)
+
+// idMap maps the ids of the archive to the ids of the target
+type idMap map[string]string
+
+// value returns the value with a mapped id replaced; ids nested in json values are replaced as well
+func (m idMap) value(v *string, isJSON bool) *string {
+	if v == nil {
+		return nil
+	}
+
+	if id, ok := m[*v]; ok {
+		return &id
+	}
+
+	if !isJSON {
+		return v
+	}
+
+	out, ok := m.json(*v)
+	if !ok {
+		return v
+	}
+
+	return &out
+}
+
+// json replaces the mapped ids found in the string values and keys of the json document, returning false when
+// nothing was replaced or the document cannot be parsed
+func (m idMap) json(doc string) (string, bool) {
+	dec := json.NewDecoder(strings.NewReader(doc))
+	dec.UseNumber()
+
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return "", false
+	}
+
+	v, changed := m.walk(v)
+	if !changed {
+		return "", false
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return "", false
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), true
+}
+
+// walk replaces the mapped ids in the decoded json value
+func (m idMap) walk(v any) (any, bool) {
+	switch t := v.(type) {
+	case string:
+		if id, ok := m[t]; ok {
+			return id, true
+		}
+
+		return t, false
+	case []any:
+		changed := false
+
+		for i, e := range t {
+			var c bool
+
+			t[i], c = m.walk(e)
+			changed = changed || c
+		}
+
+		return t, changed
+	case map[string]any:
+		changed := false
+		out := make(map[string]any, len(t))
+
+		for k, e := range t {
+			if id, ok := m[k]; ok {
+				k = id
+				changed = true
+			}
+
+			var c bool
+
+			out[k], c = m.walk(e)
+			changed = changed || c
+		}
+
+		return out, changed
+	}
+
+	return v, false
+}
+
+// entity replaces a mapped id in an fga entity, e.g. control:01HABC or group:01HABC#member; unmapped users
+// are not found and wildcards are kept
+func (m idMap) entity(e string) (string, bool) {
+	typ, rest, ok := strings.Cut(e, ":")
+	if !ok {
+		return "", false
+	}
+
+	id, relation, hasRelation := strings.Cut(rest, "#")
+
+	if id == wildcard {
+		return e, true
+	}
+
+	mapped, ok := m[id]
+	if !ok {
+		return e, typ != userType
+	}
+
+	out := typ + ":" + mapped
+	if hasRelation {
+		out += "#" + relation
+	}
+
+	return out, true
+}
+
+// tuple maps the subject and object of the tuple; tuples on objects outside the archive or for users missing
+// from the target are not restored
+func (m idMap) tuple(t tuplecheck.Tuple) (tuplecheck.Tuple, bool) {
+	_, objectID, _ := strings.Cut(t.Object, ":")
+	if _, ok := m[objectID]; !ok {
+		return tuplecheck.Tuple{}, false
+	}
+
+	object, ok := m.entity(t.Object)
+	if !ok {
+		return tuplecheck.Tuple{}, false
+	}
+
+	subject, ok := m.entity(t.Subject)
+	if !ok {
+		return tuplecheck.Tuple{}, false
+	}
+
+	return tuplecheck.Tuple{Subject: subject, Relation: t.Relation, Object: object}, true
+}
+
+// entityID returns the id of an fga entity without its type and relation
+func entityID(e string) string {
+	_, rest, _ := strings.Cut(e, ":")
+	id, _, _ := strings.Cut(rest, "#")
+
+	return id
+}
@@ -0,0 +1,103 @@
+package tenantexport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+)
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestRemapValue(t *testing.T) {
+	ids := idMap{"old-control": "new-control", "old-org": "new-org"}
+
+	testCases := []struct {
+		name   string
+		value  *string
+		isJSON bool
+		want   *string
+	}{
+		{name: "null", value: nil, want: nil},
+		{name: "mapped id", value: ptr("old-control"), want: ptr("new-control")},
+		{name: "unmapped value", value: ptr("Access control policy"), want: ptr("Access control policy")},
+		{name: "id inside text is kept", value: ptr("see old-control"), want: ptr("see old-control")},
+		{name: "json without ids", value: ptr(`{"a": 1.50}`), isJSON: true, want: ptr(`{"a": 1.50}`)},
+		{
+			name:   "json with nested ids and keys",
+			value:  ptr(`{"controls":["old-control","other"],"old-org":{"count":10,"owner":"old-org"}}`),
+			isJSON: true,
+			want:   ptr(`{"controls":["new-control","other"],"new-org":{"count":10,"owner":"new-org"}}`),
+		},
+		{name: "json string", value: ptr(`"old-org"`), isJSON: true, want: ptr(`"new-org"`)},
+		{name: "invalid json", value: ptr(`{"old-org"`), isJSON: true, want: ptr(`{"old-org"`)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, ids.value(tc.value, tc.isJSON))
+		})
+	}
+}
+
+func TestRemapTuple(t *testing.T) {
+	ids := idMap{"c1": "c2", "g1": "g2", "u1": "u2", "o1": "o2"}
+
+	testCases := []struct {
+		name  string
+		tuple tuplecheck.Tuple
+		want  tuplecheck.Tuple
+		ok    bool
+	}{
+		{
+			name:  "parent context",
+			tuple: tuplecheck.Tuple{Subject: "organization:o1", Relation: "parent_context", Object: "control:c1"},
+			want:  tuplecheck.Tuple{Subject: "organization:o2", Relation: "parent_context", Object: "control:c2"},
+			ok:    true,
+		},
+		{
+			name:  "group members",
+			tuple: tuplecheck.Tuple{Subject: "group:g1#member", Relation: "editor", Object: "control:c1"},
+			want:  tuplecheck.Tuple{Subject: "group:g2#member", Relation: "editor", Object: "control:c2"},
+			ok:    true,
+		},
+		{
+			name:  "mapped user",
+			tuple: tuplecheck.Tuple{Subject: "user:u1", Relation: "member", Object: "organization:o1"},
+			want:  tuplecheck.Tuple{Subject: "user:u2", Relation: "member", Object: "organization:o2"},
+			ok:    true,
+		},
+		{
+			name:  "wildcard",
+			tuple: tuplecheck.Tuple{Subject: "user:*", Relation: "viewer", Object: "control:c1"},
+			want:  tuplecheck.Tuple{Subject: "user:*", Relation: "viewer", Object: "control:c2"},
+			ok:    true,
+		},
+		{
+			name:  "unmapped service is kept",
+			tuple: tuplecheck.Tuple{Subject: "service:s1", Relation: "viewer", Object: "control:c1"},
+			want:  tuplecheck.Tuple{Subject: "service:s1", Relation: "viewer", Object: "control:c2"},
+			ok:    true,
+		},
+		{
+			name:  "user missing from the target",
+			tuple: tuplecheck.Tuple{Subject: "user:u9", Relation: "member", Object: "organization:o1"},
+		},
+		{
+			name:  "object outside the archive",
+			tuple: tuplecheck.Tuple{Subject: "organization:o1", Relation: "parent_context", Object: "control:c9"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := ids.tuple(tc.tuple)
+
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
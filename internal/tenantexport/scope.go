@@ -0,0 +1,313 @@
+package tenantexport
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	entschema "entgo.io/ent/dialect/sql/schema"
+)
+
+const (
+	// organizationsTable holds the organizations
+	organizationsTable = "organizations"
+	// organizationHistoryTable holds the history of the organizations, scoped by ref
+	organizationHistoryTable = "organization_history"
+	// usersTable holds the users, which are mapped by email rather than exported
+	usersTable = "users"
+	// filesTable holds the files whose contents are exported
+	filesTable = "files"
+	// idColumn is the primary key of the entity tables
+	idColumn = "id"
+	// refColumn holds the id of the entity a history row was recorded for
+	refColumn = "ref"
+)
+
+// ownerColumns are the columns scoping the rows of a table to an organization
+var ownerColumns = []string{"owner_id", "organization_id"}
+
+// excludedTables hold credentials and secrets, which are not moved between environments
+var excludedTables = []string{
+	"api_tokens",
+	"api_token_history",
+	"hushes",
+	"hush_history",
+	"invites",
+	"invite_history",
+	"job_runner_tokens",
+	"job_runner_token_history",
+	"job_runner_registration_tokens",
+	"job_runner_registration_token_history",
+	"personal_access_tokens",
+	"personal_access_token_history",
+}
+
+// excludedColumns are columns not exported besides the sensitive columns; file contents stored in the database
+// travel as file entries and the secrets of the organization settings are not moved between environments
+var excludedColumns = map[string][]string{
+	filesTable:                     {"file_contents"},
+	"organization_settings":        {"identity_provider_client_secret", "compliance_webhook_token"},
+	"organization_setting_history": {"identity_provider_client_secret", "compliance_webhook_token"},
+}
+
+// scope is a table exported for the organization
+type scope struct {
+	// table is the table in the ent migration schema
+	table *entschema.Table
+	// history is true for history tables
+	history bool
+	// where selects the rows of the organization, with the organization id as $1
+	where string
+}
+
+// columns returns the exported columns of the table
+func (s scope) columns() []string {
+	cols := make([]string, 0, len(s.table.Columns))
+
+	for _, c := range s.table.Columns {
+		if !excludedColumn(s.table.Name, c.Name) {
+			cols = append(cols, c.Name)
+		}
+	}
+
+	return cols
+}
+
+// excludedColumn reports whether the column of the table is left out of the export, either because its field is
+// sensitive or holds credentials, or because it is listed in excludedColumns
+func excludedColumn(table, name string) bool {
+	return slices.Contains(sensitiveColumns[table], name) || slices.Contains(excludedColumns[table], name)
+}
+
+// hasColumn reports whether the table has the column
+func hasColumn(t *entschema.Table, name string) bool {
+	return column(t, name) != nil
+}
+
+// column returns the column of the table with the name
+func column(t *entschema.Table, name string) *entschema.Column {
+	for _, c := range t.Columns {
+		if c.Name == name {
+			return c
+		}
+	}
+
+	return nil
+}
+
+// foreignKey is a single column foreign key of a table
+type foreignKey struct {
+	column    *entschema.Column
+	refTable  string
+	refColumn string
+}
+
+// foreignKeys returns the single column foreign keys of the table
+func foreignKeys(t *entschema.Table) []foreignKey {
+	fks := make([]foreignKey, 0, len(t.ForeignKeys))
+
+	for _, fk := range t.ForeignKeys {
+		if fk.RefTable == nil || len(fk.Columns) != 1 || len(fk.RefColumns) != 1 {
+			continue
+		}
+
+		fks = append(fks, foreignKey{column: fk.Columns[0], refTable: fk.RefTable.Name, refColumn: fk.RefColumns[0].Name})
+	}
+
+	return fks
+}
+
+// isJoinTable reports whether the table holds the edges of a many to many relation rather than entities
+func isJoinTable(t *entschema.Table) bool {
+	return !hasColumn(t, idColumn)
+}
+
+// inScope returns the condition selecting the rows whose column references a row of the scoped table
+func inScope(col, refTable, refCol, refWhere string) string {
+	return fmt.Sprintf("%s IN (SELECT %s FROM %s WHERE %s)", quote(col), quote(refCol), quote(refTable), refWhere)
+}
+
+// plan returns the tables scoped to an organization in export order. Organizations are selected by id, tables
+// owned by an organization by their owner column, entities only linked to the organization through a join table
+// by that link, and the children of exported entities through their required foreign key; join tables are
+// exported for the edges of exported entities. History tables are scoped by their owner column
+func plan(tables, historyTables []*entschema.Table) []scope {
+	where := map[string]string{}
+	byName := make(map[string]*entschema.Table, len(tables))
+
+	for _, t := range tables {
+		byName[t.Name] = t
+	}
+
+	excluded := func(t *entschema.Table) bool {
+		if slices.Contains(excludedTables, t.Name) || t.Name == usersTable {
+			return true
+		}
+
+		// edges of excluded entities cannot be restored without them
+		return isJoinTable(t) && slices.ContainsFunc(foreignKeys(t), func(fk foreignKey) bool {
+			return slices.Contains(excludedTables, fk.refTable)
+		})
+	}
+
+	if org, ok := byName[organizationsTable]; ok {
+		where[org.Name] = quote(idColumn) + " = $1"
+	}
+
+	for _, t := range tables {
+		if excluded(t) || isJoinTable(t) || where[t.Name] != "" {
+			continue
+		}
+
+		for _, fk := range foreignKeys(t) {
+			if fk.refTable == organizationsTable && slices.Contains(ownerColumns, fk.column.Name) {
+				where[t.Name] = quote(fk.column.Name) + " = $1"
+
+				break
+			}
+		}
+	}
+
+	// entities without an owner, such as files and events, are linked to the organization through a join table
+	for _, join := range tables {
+		if excluded(join) || !isJoinTable(join) {
+			continue
+		}
+
+		fks := foreignKeys(join)
+
+		orgFK := slices.IndexFunc(fks, func(fk foreignKey) bool { return fk.refTable == organizationsTable })
+		if orgFK < 0 {
+			continue
+		}
+
+		for i, fk := range fks {
+			ref, ok := byName[fk.refTable]
+			if i == orgFK || !ok || excluded(ref) || where[ref.Name] != "" {
+				continue
+			}
+
+			where[ref.Name] = inScope(fk.refColumn, join.Name, fk.column.Name, quote(fks[orgFK].column.Name)+" = $1")
+		}
+	}
+
+	// children of exported entities are exported with them, until no more tables are added
+	for changed := true; changed; {
+		changed = false
+
+		for _, t := range tables {
+			if excluded(t) || isJoinTable(t) || where[t.Name] != "" {
+				continue
+			}
+
+			for _, fk := range foreignKeys(t) {
+				refWhere := where[fk.refTable]
+				if fk.column.Nullable || refWhere == "" || fk.refTable == organizationsTable {
+					continue
+				}
+
+				where[t.Name] = inScope(fk.column.Name, fk.refTable, fk.refColumn, refWhere)
+				changed = true
+
+				break
+			}
+		}
+	}
+
+	for _, t := range tables {
+		if excluded(t) || !isJoinTable(t) {
+			continue
+		}
+
+		var conds []string
+
+		for _, fk := range foreignKeys(t) {
+			if refWhere := where[fk.refTable]; refWhere != "" {
+				conds = append(conds, inScope(fk.column.Name, fk.refTable, fk.refColumn, refWhere))
+			}
+		}
+
+		if len(conds) > 0 {
+			where[t.Name] = "(" + strings.Join(conds, " OR ") + ")"
+		}
+	}
+
+	var scopes []scope
+
+	for _, t := range tables {
+		if w := where[t.Name]; w != "" {
+			scopes = append(scopes, scope{table: t, where: w})
+		}
+	}
+
+	for _, t := range historyTables {
+		if slices.Contains(excludedTables, t.Name) {
+			continue
+		}
+
+		if t.Name == organizationHistoryTable {
+			scopes = append(scopes, scope{table: t, history: true, where: quote(refColumn) + " = $1"})
+
+			continue
+		}
+
+		for _, c := range ownerColumns {
+			if hasColumn(t, c) {
+				scopes = append(scopes, scope{table: t, history: true, where: quote(c) + " = $1"})
+
+				break
+			}
+		}
+	}
+
+	return scopes
+}
+
+// insertOrder returns the tables in an order where every table comes after the tables its required foreign keys
+// reference; references through nullable columns are set after every row is inserted, so they are not ordered.
+// Tables left in a cycle are appended in their original order
+func insertOrder(tables []*entschema.Table) []*entschema.Table {
+	present := make(map[string]bool, len(tables))
+	for _, t := range tables {
+		present[t.Name] = true
+	}
+
+	done := make(map[string]bool, len(tables))
+	ordered := make([]*entschema.Table, 0, len(tables))
+
+	for len(ordered) < len(tables) {
+		added := false
+
+		for _, t := range tables {
+			if done[t.Name] {
+				continue
+			}
+
+			ready := !slices.ContainsFunc(foreignKeys(t), func(fk foreignKey) bool {
+				return !fk.column.Nullable && fk.refTable != t.Name && present[fk.refTable] && !done[fk.refTable]
+			})
+
+			if ready {
+				ordered = append(ordered, t)
+				done[t.Name] = true
+				added = true
+			}
+		}
+
+		if !added {
+			for _, t := range tables {
+				if !done[t.Name] {
+					ordered = append(ordered, t)
+					done[t.Name] = true
+				}
+			}
+		}
+	}
+
+	return ordered
+}
+
+// quote quotes an identifier for use in a query
+func quote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
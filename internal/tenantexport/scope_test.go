@@ -0,0 +1,177 @@
+package tenantexport
+
+import (
+	"testing"
+
+	entschema "entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/internal/ent/generated/migrate"
+	historymigrate "github.com/theopenlane/core/internal/ent/historygenerated/migrate"
+)
+
+// testTable returns a table with the columns, nullable when suffixed with ?
+func testTable(name string, columns ...string) *entschema.Table {
+	t := &entschema.Table{Name: name}
+
+	for _, c := range columns {
+		nullable := false
+		if c[len(c)-1] == '?' {
+			c, nullable = c[:len(c)-1], true
+		}
+
+		t.Columns = append(t.Columns, &entschema.Column{Name: c, Type: field.TypeString, Nullable: nullable})
+	}
+
+	return t
+}
+
+// withFK adds a foreign key from the column to the id of the referenced table
+func withFK(t *entschema.Table, col string, ref *entschema.Table) {
+	t.ForeignKeys = append(t.ForeignKeys, &entschema.ForeignKey{
+		Columns:    []*entschema.Column{column(t, col)},
+		RefTable:   ref,
+		RefColumns: []*entschema.Column{column(ref, idColumn)},
+	})
+}
+
+func testSchema() (tables, history []*entschema.Table) {
+	orgs := testTable("organizations", "id", "name", "parent_organization_id?")
+	withFK(orgs, "parent_organization_id", orgs)
+
+	users := testTable("users", "id", "email")
+
+	programs := testTable("programs", "id", "owner_id?")
+	withFK(programs, "owner_id", orgs)
+
+	controls := testTable("controls", "id", "owner_id?", "program_id?", "assignee_id?")
+	withFK(controls, "owner_id", orgs)
+	withFK(controls, "program_id", programs)
+	withFK(controls, "assignee_id", users)
+
+	comments := testTable("control_comments", "id", "control_id")
+	withFK(comments, "control_id", controls)
+
+	files := testTable("files", "id", "storage_path", "file_contents?")
+
+	orgFiles := testTable("organization_files", "organization_id", "file_id")
+	withFK(orgFiles, "organization_id", orgs)
+	withFK(orgFiles, "file_id", files)
+
+	programControls := testTable("program_controls", "program_id", "control_id")
+	withFK(programControls, "program_id", programs)
+	withFK(programControls, "control_id", controls)
+
+	tokens := testTable("api_tokens", "id", "owner_id?")
+	withFK(tokens, "owner_id", orgs)
+
+	tokenControls := testTable("api_token_controls", "api_token_id", "control_id")
+	withFK(tokenControls, "api_token_id", tokens)
+	withFK(tokenControls, "control_id", controls)
+
+	settings := testTable("user_settings", "id", "user_setting_default_org?")
+	withFK(settings, "user_setting_default_org", orgs)
+
+	tables = []*entschema.Table{
+		orgs, users, comments, programs, controls, files, orgFiles, programControls, tokens, tokenControls, settings,
+	}
+
+	history = []*entschema.Table{
+		testTable("control_history", "id", "ref?", "owner_id?"),
+		testTable("organization_history", "id", "ref?", "name"),
+		testTable("file_history", "id", "ref?"),
+		testTable("api_token_history", "id", "ref?", "owner_id?"),
+	}
+
+	return tables, history
+}
+
+func TestPlan(t *testing.T) {
+	tables, history := testSchema()
+
+	got := map[string]string{}
+
+	for _, s := range plan(tables, history) {
+		name := s.table.Name
+		if s.history {
+			name = "history:" + name
+		}
+
+		got[name] = s.where
+	}
+
+	assert.Equal(t, map[string]string{
+		"organizations":                `"id" = $1`,
+		"programs":                     `"owner_id" = $1`,
+		"controls":                     `"owner_id" = $1`,
+		"files":                        `"id" IN (SELECT "file_id" FROM "organization_files" WHERE "organization_id" = $1)`,
+		"control_comments":             `"control_id" IN (SELECT "id" FROM "controls" WHERE "owner_id" = $1)`,
+		"organization_files":           `("organization_id" IN (SELECT "id" FROM "organizations" WHERE "id" = $1) OR "file_id" IN (SELECT "id" FROM "files" WHERE "id" IN (SELECT "file_id" FROM "organization_files" WHERE "organization_id" = $1)))`,
+		"program_controls":             `("program_id" IN (SELECT "id" FROM "programs" WHERE "owner_id" = $1) OR "control_id" IN (SELECT "id" FROM "controls" WHERE "owner_id" = $1))`,
+		"history:control_history":      `"owner_id" = $1`,
+		"history:organization_history": `"ref" = $1`,
+	}, got)
+}
+
+func TestScopeColumns(t *testing.T) {
+	tables, _ := testSchema()
+
+	for _, s := range plan(tables, nil) {
+		if s.table.Name == filesTable {
+			assert.Equal(t, []string{"id", "storage_path"}, s.columns())
+		}
+	}
+}
+
+func TestSensitiveColumnsExcluded(t *testing.T) {
+	graph, err := entc.LoadGraph("../ent/schema", &gen.Config{BuildFlags: []string{"-tags=codegen"}})
+	require.NoError(t, err)
+
+	exported := map[string][]string{}
+
+	for _, s := range plan(migrate.Tables, historymigrate.Tables) {
+		exported[s.table.Name] = s.columns()
+	}
+
+	// every sensitive field and credential field is left out of its table and the history recorded for it
+	for _, node := range graph.Nodes {
+		tables := []string{node.Table(), gen.Funcs["snake"].(func(string) string)(node.Name) + "_history"}
+
+		for _, f := range node.Fields {
+			if !f.Sensitive() && (f.Type == nil || f.Type.Ident != "models.CredentialSet") {
+				continue
+			}
+
+			for _, table := range tables {
+				assert.NotContains(t, exported[table], f.StorageKey(), "%s.%s is exported", table, f.StorageKey())
+			}
+		}
+	}
+}
+
+func TestInsertOrder(t *testing.T) {
+	tables, _ := testSchema()
+
+	a := testTable("a", "id", "b_id")
+	b := testTable("b", "id", "a_id")
+	withFK(a, "b_id", b)
+	withFK(b, "a_id", a)
+
+	names := func(ts []*entschema.Table) []string {
+		out := make([]string, len(ts))
+		for i, t := range ts {
+			out[i] = t.Name
+		}
+
+		return out
+	}
+
+	assert.Equal(t, []string{
+		"organizations", "users", "programs", "controls", "files", "organization_files", "program_controls",
+		"api_tokens", "api_token_controls", "user_settings", "control_comments", "a", "b",
+	}, names(insertOrder(append(tables, a, b))))
+}
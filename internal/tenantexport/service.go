@@ -0,0 +1,152 @@
+package tenantexport
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	entschema "entgo.io/ent/dialect/sql/schema"
+
+	"github.com/theopenlane/core/internal/ent/generated/migrate"
+	historymigrate "github.com/theopenlane/core/internal/ent/historygenerated/migrate"
+	"github.com/theopenlane/core/internal/ent/schema"
+	"github.com/theopenlane/core/internal/ent/tuplecheck"
+)
+
+const (
+	// organizationType is the fga type of organizations, which has no tuple spec of its own
+	organizationType = "organization"
+	// lookupBatchSize is the number of ids looked up per query
+	lookupBatchSize = 500
+)
+
+// Service exports organizations to archives and imports them back; the history tables are expected in the same
+// database as the main tables
+type Service struct {
+	drv           dialect.Driver
+	store         tuplecheck.Store
+	objects       Objects
+	tables        []*entschema.Table
+	historyTables []*entschema.Table
+	// fgaTypes maps the tables of the schemas with tuples to their fga type
+	fgaTypes map[string]string
+}
+
+// New returns a service reading and writing rows through the database driver, e.g. the Driver() of the ent
+// client, tuples through the store and file contents through the objects
+func New(drv dialect.Driver, store tuplecheck.Store, files Objects) (*Service, error) {
+	if drv == nil {
+		return nil, ErrDriverRequired
+	}
+
+	if store == nil {
+		return nil, ErrStoreRequired
+	}
+
+	if files == nil {
+		return nil, ErrObjectsRequired
+	}
+
+	s := &Service{
+		drv:           drv,
+		store:         store,
+		objects:       files,
+		tables:        migrate.Tables,
+		historyTables: historymigrate.Tables,
+		fgaTypes:      map[string]string{organizationsTable: organizationType},
+	}
+
+	for _, spec := range schema.TupleSpecs() {
+		if spec.Table != "" {
+			s.fgaTypes[spec.Table] = spec.Schema
+		}
+	}
+
+	return s, nil
+}
+
+// table returns the main or history table with the name
+func (s *Service) table(name string, history bool) (*entschema.Table, bool) {
+	tables := s.tables
+	if history {
+		tables = s.historyTables
+	}
+
+	for _, t := range tables {
+		if t.Name == name {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+// queryStrings runs the query and returns the rows as strings, with null values as empty strings
+func queryStrings(ctx context.Context, q dialect.ExecQuerier, query string, args []any, columns int) ([][]string, error) {
+	var rows entsql.Rows
+	if err := q.Query(ctx, query, args, &rows); err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var out [][]string
+
+	for rows.Next() {
+		values := make([]sql.NullString, columns)
+		dest := make([]any, columns)
+
+		for i := range values {
+			dest[i] = &values[i]
+		}
+
+		if err := rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+
+		row := make([]string, columns)
+		for i, v := range values {
+			row[i] = v.String
+		}
+
+		out = append(out, row)
+	}
+
+	return out, rows.Err()
+}
+
+// lookup runs the query for the values in batches; the query is formatted with the placeholders of a batch
+func lookup(ctx context.Context, q dialect.ExecQuerier, format string, values []string, columns int) ([][]string, error) {
+	var out [][]string
+
+	for start := 0; start < len(values); start += lookupBatchSize {
+		batch := values[start:min(start+lookupBatchSize, len(values))]
+
+		args := make([]any, len(batch))
+		for i, v := range batch {
+			args[i] = v
+		}
+
+		rows, err := queryStrings(ctx, q, fmt.Sprintf(format, placeholders(len(batch), 1)), args, columns)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, rows...)
+	}
+
+	return out, nil
+}
+
+// placeholders returns n comma separated positional parameters starting at $start
+func placeholders(n, start int) string {
+	p := make([]string, n)
+	for i := range p {
+		p[i] = fmt.Sprintf("$%d", start+i)
+	}
+
+	return strings.Join(p, ", ")
+}
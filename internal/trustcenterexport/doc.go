@@ -0,0 +1,5 @@
+// Package trustcenterexport renders a trust center into a self-contained static site and packages it as a
+// signed bundle, so it can be hosted on a customer's own infrastructure or handed to a prospect who cannot
+// reach the hosted trust center. Documents that are not public are left out unless they have been
+// watermarked, and the bundle manifest is signed so recipients can verify the site was not modified
+package trustcenterexport
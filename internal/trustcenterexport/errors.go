@@ -0,0 +1,10 @@
+package trustcenterexport
+
+import "errors"
+
+var (
+	// ErrExportTooLarge is returned when the files of the trust center exceed the maximum export size
+	ErrExportTooLarge = errors.New("trust center export exceeds the maximum size")
+	// ErrSignerRequired is returned when no signer is configured to sign the export manifest
+	ErrSignerRequired = errors.New("trust center export signing key is not configured")
+)
@@ -0,0 +1,79 @@
+package trustcenterexport
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/pkg/signedbundle"
+)
+
+// ContentType is the content type of an export bundle
+const ContentType = "application/zip"
+
+// Bundle is a signed export of a trust center
+type Bundle struct {
+	// Filename is the suggested name of the archive
+	Filename string
+	// Data is the zip archive
+	Data []byte
+	// Manifest is the signed manifest included in the archive
+	Manifest *signedbundle.Manifest
+	// Site is the content that was exported
+	Site *Site
+}
+
+// Export loads the trust center, renders it as a static site and packages it into a zip archive with a
+// manifest signed by signer
+func Export(ctx context.Context, client *generated.Client, trustCenterID string, opts Options, signer *signedbundle.Signer) (*Bundle, error) {
+	if signer == nil {
+		return nil, ErrSignerRequired
+	}
+
+	now := time.Now()
+
+	site, err := Load(ctx, client, trustCenterID, opts, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return Package(site, signer, now)
+}
+
+// Package renders the site and writes it to a signed archive
+func Package(site *Site, signer *signedbundle.Signer, now time.Time) (*Bundle, error) {
+	if signer == nil {
+		return nil, ErrSignerRequired
+	}
+
+	files, err := site.Render(signer.KeyID())
+	if err != nil {
+		return nil, err
+	}
+
+	subject := map[string]string{
+		"type":            "trust_center",
+		"trust_center_id": site.TrustCenterID,
+		"slug":            site.Slug,
+	}
+
+	if site.URL != "" {
+		subject["url"] = site.URL
+	}
+
+	var buf bytes.Buffer
+
+	manifest, err := signedbundle.Write(&buf, files, subject, signer, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bundle{
+		Filename: fmt.Sprintf("%s-trust-center-%s.zip", unsafePathChars.ReplaceAllString(site.Slug, "-"), now.UTC().Format("20060102")),
+		Data:     buf.Bytes(),
+		Manifest: manifest,
+		Site:     site,
+	}, nil
+}
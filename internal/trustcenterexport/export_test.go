@@ -0,0 +1,252 @@
+package trustcenterexport
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/pkg/signedbundle"
+)
+
+func testSite() *Site {
+	return &Site{
+		TrustCenterID:      "tc1",
+		Slug:               "acme",
+		URL:                "https://trust.theopenlane.io/acme",
+		Title:              "Acme Trust Center",
+		CompanyName:        "Acme",
+		CompanyDescription: "<p>We make <b>anvils</b></p><script>alert(1)</script>",
+		Overview:           "Security is our priority",
+		SecurityContact:    "security@acme.example",
+		Theme: Theme{
+			Primary:    "#ff0000",
+			Background: "red;} body{display:none",
+			Font:       "Inter",
+		},
+		Logo: &Asset{Path: assetPath("assets", "logo.png", "file", 1), Data: []byte("png")},
+		Compliance: []Badge{
+			{Name: "SOC 2", ShortName: "SOC2", Version: "2017"},
+		},
+		Documents: []Document{
+			{Title: "SOC 2 Type II report", Watermarked: true, File: Asset{Path: assetPath("documents", "SOC 2 Type II report.pdf", "file", 2), Data: []byte("%PDF")}},
+		},
+		Excluded: []ExcludedDocument{
+			{Title: "Pen test", Reason: reasonNotWatermarked},
+		},
+		Subprocessors: []Subprocessor{
+			{Name: "Cloud <Provider>", Kind: "Hosting", Countries: []string{"US", "DE"}},
+		},
+		FAQs: []FAQ{
+			{Question: "Do you encrypt data at rest?", Answer: "Yes"},
+		},
+		GeneratedAt: time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestPackage(t *testing.T) {
+	signer, err := signedbundle.GenerateSigner()
+	require.NoError(t, err)
+
+	bundle, err := Package(testSite(), signer, time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.Equal(t, "acme-trust-center-20260301.zip", bundle.Filename)
+
+	manifest, err := signedbundle.VerifyBytes(bundle.Data, signer.PublicKey())
+	require.NoError(t, err)
+
+	assert.Equal(t, "tc1", manifest.Subject["trust_center_id"])
+	assert.Equal(t, "https://trust.theopenlane.io/acme", manifest.Subject["url"])
+
+	var paths []string
+	for _, f := range manifest.Files {
+		paths = append(paths, f.Path)
+	}
+
+	assert.Equal(t, []string{"index.html", "assets/site.css", "README.txt", "assets/01-logo.png", "documents/02-soc-2-type-ii-report.pdf"}, paths)
+
+	page := string(readFile(t, bundle.Data, "index.html"))
+	assert.Contains(t, page, "<b>anvils</b>")
+	assert.NotContains(t, page, "<script>")
+	assert.Contains(t, page, "Cloud &lt;Provider&gt;")
+	assert.Contains(t, page, `href="documents/02-soc-2-type-ii-report.pdf"`)
+	assert.Contains(t, page, "Do you encrypt data at rest?")
+	assert.Contains(t, page, "Powered by Openlane")
+
+	style := string(readFile(t, bundle.Data, "assets/site.css"))
+	assert.Contains(t, style, "#ff0000")
+	assert.Contains(t, style, defaultTheme.Background)
+	assert.NotContains(t, style, "display:none")
+
+	readme := string(readFile(t, bundle.Data, "README.txt"))
+	assert.Contains(t, readme, signer.KeyID())
+
+	t.Run("without branding", func(t *testing.T) {
+		site := testSite()
+		site.RemoveBranding = true
+
+		files, err := site.Render(signer.KeyID())
+		require.NoError(t, err)
+		assert.NotContains(t, string(files[0].Data), "Powered by Openlane")
+	})
+
+	t.Run("signer required", func(t *testing.T) {
+		_, err := Package(testSite(), nil, time.Now())
+		assert.ErrorIs(t, err, ErrSignerRequired)
+	})
+}
+
+func readFile(t *testing.T, data []byte, name string) []byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	rc, err := zr.Open(name)
+	require.NoError(t, err)
+
+	defer rc.Close()
+
+	content, err := io.ReadAll(rc)
+	require.NoError(t, err)
+
+	return content
+}
+
+func TestThemeWithDefaults(t *testing.T) {
+	theme := Theme{
+		Primary:    "#abc",
+		Foreground: "#12345678",
+		Accent:     "blue",
+		Font:       "Comic Sans'; }",
+	}.withDefaults()
+
+	assert.Equal(t, "#abc", theme.Primary)
+	assert.Equal(t, "#12345678", theme.Foreground)
+	assert.Equal(t, defaultTheme.Background, theme.Background)
+	assert.Equal(t, defaultTheme.Accent, theme.Accent)
+	assert.Equal(t, defaultTheme.Font, theme.Font)
+}
+
+func TestAssetPath(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "SOC 2 Report.PDF", want: "documents/03-soc-2-report.pdf"},
+		{name: "../../etc/passwd", want: "documents/03-etc-passwd"},
+		{name: "日本語.pdf", want: "documents/03-file.pdf"},
+		{name: "report.p?d/f", want: "documents/03-report.p-d-f"},
+		{name: "", want: "documents/03-file"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, assetPath("documents", tc.name, "file", 3))
+		})
+	}
+}
+
+func TestDocumentFile(t *testing.T) {
+	watermarked := &generated.File{ID: "watermarked"}
+	original := &generated.File{ID: "original"}
+
+	doc := func(visibility enums.TrustCenterDocumentVisibility, watermarking bool, status enums.WatermarkStatus, f, orig *generated.File) *generated.TrustCenterDoc {
+		d := &generated.TrustCenterDoc{
+			Visibility:          visibility,
+			WatermarkingEnabled: watermarking,
+			WatermarkStatus:     status,
+		}
+		d.Edges.File = f
+		d.Edges.OriginalFile = orig
+
+		return d
+	}
+
+	tests := []struct {
+		name             string
+		doc              *generated.TrustCenterDoc
+		watermarkEnabled bool
+		includeProtected bool
+		wantFile         *generated.File
+		wantWatermarked  bool
+		wantReason       string
+	}{
+		{
+			name:     "public",
+			doc:      doc(enums.TrustCenterDocumentVisibilityPubliclyVisible, false, enums.WatermarkStatusDisabled, original, nil),
+			wantFile: original,
+		},
+		{
+			name:            "public watermarked",
+			doc:             doc(enums.TrustCenterDocumentVisibilityPubliclyVisible, true, enums.WatermarkStatusSuccess, watermarked, original),
+			wantFile:        watermarked,
+			wantWatermarked: true,
+		},
+		{
+			name:     "public with only original file",
+			doc:      doc(enums.TrustCenterDocumentVisibilityPubliclyVisible, true, enums.WatermarkStatusPending, nil, original),
+			wantFile: original,
+		},
+		{
+			name:       "public without file",
+			doc:        doc(enums.TrustCenterDocumentVisibilityPubliclyVisible, false, enums.WatermarkStatusDisabled, nil, nil),
+			wantReason: reasonNoFile,
+		},
+		{
+			name:       "not visible",
+			doc:        doc(enums.TrustCenterDocumentVisibilityNotVisible, false, enums.WatermarkStatusDisabled, original, nil),
+			wantReason: reasonNotVisible,
+		},
+		{
+			name:             "protected not requested",
+			doc:              doc(enums.TrustCenterDocumentVisibilityProtected, true, enums.WatermarkStatusSuccess, watermarked, original),
+			watermarkEnabled: true,
+			wantReason:       reasonProtected,
+		},
+		{
+			name:             "protected watermarking disabled for trust center",
+			doc:              doc(enums.TrustCenterDocumentVisibilityProtected, true, enums.WatermarkStatusSuccess, watermarked, original),
+			includeProtected: true,
+			wantReason:       reasonNotWatermarked,
+		},
+		{
+			name:             "protected watermarking disabled for document",
+			doc:              doc(enums.TrustCenterDocumentVisibilityProtected, false, enums.WatermarkStatusDisabled, original, nil),
+			watermarkEnabled: true,
+			includeProtected: true,
+			wantReason:       reasonNotWatermarked,
+		},
+		{
+			name:             "protected watermark pending",
+			doc:              doc(enums.TrustCenterDocumentVisibilityProtected, true, enums.WatermarkStatusPending, nil, original),
+			watermarkEnabled: true,
+			includeProtected: true,
+			wantReason:       reasonWatermarkFailed,
+		},
+		{
+			name:             "protected watermarked",
+			doc:              doc(enums.TrustCenterDocumentVisibilityProtected, true, enums.WatermarkStatusSuccess, watermarked, original),
+			watermarkEnabled: true,
+			includeProtected: true,
+			wantFile:         watermarked,
+			wantWatermarked:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			f, isWatermarked, reason := documentFile(tc.doc, tc.watermarkEnabled, tc.includeProtected)
+			assert.Equal(t, tc.wantFile, f)
+			assert.Equal(t, tc.wantWatermarked, isWatermarked)
+			assert.Equal(t, tc.wantReason, reason)
+		})
+	}
+}
@@ -0,0 +1,362 @@
+package trustcenterexport
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/theopenlane/core/common/enums"
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/internal/ent/generated"
+	"github.com/theopenlane/core/internal/ent/generated/trustcenter"
+	"github.com/theopenlane/core/internal/ent/generated/trustcenterfaq"
+	"github.com/theopenlane/core/internal/ent/interceptors"
+	"github.com/theopenlane/core/internal/trustcenterurl"
+	"github.com/theopenlane/core/pkg/objects"
+)
+
+// DefaultMaxSize is the maximum combined size of the files in an export when no limit is configured
+const DefaultMaxSize int64 = 256 << 20
+
+// reasons documents are left out of an export
+const (
+	reasonNotVisible      = "document is not visible on the trust center"
+	reasonProtected       = "document is protected and protected documents were not requested"
+	reasonNotWatermarked  = "document is protected and has no watermarked copy"
+	reasonWatermarkFailed = "document is protected and watermarking has not completed"
+	reasonNoFile          = "document has no file"
+)
+
+// Options configure an export
+type Options struct {
+	// IncludeProtected includes protected documents when a watermarked copy exists
+	IncludeProtected bool
+	// MaxSize is the maximum combined size of the exported files in bytes, DefaultMaxSize when zero
+	MaxSize int64
+}
+
+// loader downloads the files of a site and keeps them under the size limit
+type loader struct {
+	client  *generated.Client
+	maxSize int64
+	size    int64
+	count   int
+}
+
+// Load reads the trust center and the files it links to and returns the site to render. Only documents that
+// are publicly visible are included; protected documents are included as their watermarked copy when
+// opts.IncludeProtected is set and watermarking is enabled for the trust center
+func Load(ctx context.Context, client *generated.Client, trustCenterID string, opts Options, now time.Time) (*Site, error) {
+	tc, err := client.TrustCenter.Query().
+		Where(trustcenter.ID(trustCenterID)).
+		WithCustomDomain().
+		WithWatermarkConfig().
+		WithSetting(func(q *generated.TrustCenterSettingQuery) {
+			q.WithLogoFile().WithFaviconFile()
+		}).
+		WithTrustCenterCompliances(func(q *generated.TrustCenterComplianceQuery) {
+			q.WithStandard(func(q *generated.StandardQuery) {
+				q.WithLogoFile()
+			})
+		}).
+		WithTrustCenterDocs(func(q *generated.TrustCenterDocQuery) {
+			q.WithStandard().WithFile().WithOriginalFile()
+		}).
+		WithTrustCenterSubprocessors(func(q *generated.TrustCenterSubprocessorQuery) {
+			q.WithSubprocessor(func(q *generated.SubprocessorQuery) {
+				q.WithLogoFile()
+			})
+		}).
+		WithTrustCenterFaqs(func(q *generated.TrustCenterFAQQuery) {
+			q.WithNote().Order(generated.Asc(trustcenterfaq.FieldDisplayOrder))
+		}).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &loader{
+		client:  client,
+		maxSize: cmp.Or(opts.MaxSize, DefaultMaxSize),
+	}
+
+	customDomain := ""
+	if tc.Edges.CustomDomain != nil {
+		customDomain = tc.Edges.CustomDomain.CnameRecord
+	}
+
+	site := &Site{
+		TrustCenterID: tc.ID,
+		Slug:          tc.Slug,
+		URL:           trustcenterurl.BuildURL(customDomain, tc.Slug),
+		Title:         tc.Slug,
+		GeneratedAt:   now.UTC(),
+	}
+
+	if err := l.loadSetting(ctx, site, tc.Edges.Setting); err != nil {
+		return nil, err
+	}
+
+	if err := l.loadCompliance(ctx, site, tc.Edges.TrustCenterCompliances); err != nil {
+		return nil, err
+	}
+
+	watermarkEnabled := tc.Edges.WatermarkConfig != nil && tc.Edges.WatermarkConfig.IsEnabled
+
+	if err := l.loadDocuments(ctx, site, tc.Edges.TrustCenterDocs, watermarkEnabled, opts.IncludeProtected); err != nil {
+		return nil, err
+	}
+
+	if err := l.loadSubprocessors(ctx, site, tc.Edges.TrustCenterSubprocessors); err != nil {
+		return nil, err
+	}
+
+	for _, f := range tc.Edges.TrustCenterFaqs {
+		if f.Edges.Note == nil {
+			continue
+		}
+
+		site.FAQs = append(site.FAQs, FAQ{
+			Question:      deref(f.Edges.Note.Title),
+			Answer:        f.Edges.Note.Text,
+			ReferenceLink: f.ReferenceLink,
+		})
+	}
+
+	return site, nil
+}
+
+// loadSetting copies the branding of the trust center to the site
+func (l *loader) loadSetting(ctx context.Context, site *Site, s *generated.TrustCenterSetting) error {
+	if s == nil {
+		return nil
+	}
+
+	site.Title = cmp.Or(s.Title, s.CompanyName, site.Title)
+	site.CompanyName = s.CompanyName
+	site.CompanyDescription = s.CompanyDescription
+	site.Overview = s.Overview
+	site.SecurityContact = deref(s.SecurityContact)
+	site.StatusPageURL = deref(s.StatusPageURL)
+	site.RemoveBranding = s.RemoveBranding
+	site.Theme = Theme{
+		Primary:             s.PrimaryColor,
+		Foreground:          s.ForegroundColor,
+		Background:          s.BackgroundColor,
+		Accent:              s.AccentColor,
+		SecondaryBackground: s.SecondaryBackgroundColor,
+		SecondaryForeground: s.SecondaryForegroundColor,
+		Font:                s.Font,
+	}
+
+	var err error
+
+	if site.Logo, err = l.asset(ctx, "assets", "logo", s.Edges.LogoFile); err != nil {
+		return err
+	}
+
+	site.Favicon, err = l.asset(ctx, "assets", "favicon", s.Edges.FaviconFile)
+
+	return err
+}
+
+// loadCompliance adds a badge for each standard the trust center lists
+func (l *loader) loadCompliance(ctx context.Context, site *Site, compliances []*generated.TrustCenterCompliance) error {
+	for _, c := range compliances {
+		std := c.Edges.Standard
+		if std == nil {
+			continue
+		}
+
+		logo, err := l.asset(ctx, "assets/standards", std.ShortName, std.Edges.LogoFile)
+		if err != nil {
+			return err
+		}
+
+		site.Compliance = append(site.Compliance, Badge{
+			Name:          std.Name,
+			ShortName:     std.ShortName,
+			Version:       std.Version,
+			GoverningBody: std.GoverningBody,
+			Link:          std.Link,
+			Logo:          logo,
+		})
+	}
+
+	slices.SortStableFunc(site.Compliance, func(a, b Badge) int {
+		return cmp.Compare(cmp.Or(a.ShortName, a.Name), cmp.Or(b.ShortName, b.Name))
+	})
+
+	return nil
+}
+
+// loadDocuments adds the documents that may be shared and records why the others were excluded
+func (l *loader) loadDocuments(ctx context.Context, site *Site, docs []*generated.TrustCenterDoc, watermarkEnabled, includeProtected bool) error {
+	for _, doc := range docs {
+		f, watermarked, reason := documentFile(doc, watermarkEnabled, includeProtected)
+		if reason != "" {
+			site.Excluded = append(site.Excluded, ExcludedDocument{Title: doc.Title, Reason: reason})
+
+			continue
+		}
+
+		asset, err := l.asset(ctx, "documents", doc.Title, f)
+		if err != nil {
+			return err
+		}
+
+		if asset == nil {
+			site.Excluded = append(site.Excluded, ExcludedDocument{Title: doc.Title, Reason: reasonNoFile})
+
+			continue
+		}
+
+		standard := ""
+		if doc.Edges.Standard != nil {
+			standard = cmp.Or(doc.Edges.Standard.ShortName, doc.Edges.Standard.Name)
+		}
+
+		site.Documents = append(site.Documents, Document{
+			Title:       doc.Title,
+			Kind:        doc.TrustCenterDocKindName,
+			Standard:    standard,
+			Watermarked: watermarked,
+			File:        *asset,
+		})
+	}
+
+	return nil
+}
+
+// loadSubprocessors adds the subprocessors listed on the trust center
+func (l *loader) loadSubprocessors(ctx context.Context, site *Site, subprocessors []*generated.TrustCenterSubprocessor) error {
+	for _, tcs := range subprocessors {
+		sp := tcs.Edges.Subprocessor
+		if sp == nil {
+			continue
+		}
+
+		logo, err := l.asset(ctx, "assets/subprocessors", sp.Name, sp.Edges.LogoFile)
+		if err != nil {
+			return err
+		}
+
+		site.Subprocessors = append(site.Subprocessors, Subprocessor{
+			Name:        sp.Name,
+			Description: sp.Description,
+			Kind:        tcs.TrustCenterSubprocessorKindName,
+			Countries:   tcs.Countries,
+			Logo:        logo,
+		})
+	}
+
+	slices.SortStableFunc(site.Subprocessors, func(a, b Subprocessor) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return nil
+}
+
+// documentFile returns the file to export for a trust center document and whether it is the watermarked copy,
+// or the reason the document is excluded
+func documentFile(doc *generated.TrustCenterDoc, watermarkEnabled, includeProtected bool) (*generated.File, bool, string) {
+	switch doc.Visibility {
+	case enums.TrustCenterDocumentVisibilityPubliclyVisible:
+		if doc.Edges.File != nil {
+			return doc.Edges.File, doc.WatermarkingEnabled && doc.WatermarkStatus == enums.WatermarkStatusSuccess, ""
+		}
+
+		if doc.Edges.OriginalFile != nil {
+			return doc.Edges.OriginalFile, false, ""
+		}
+
+		return nil, false, reasonNoFile
+	case enums.TrustCenterDocumentVisibilityProtected:
+		switch {
+		case !includeProtected:
+			return nil, false, reasonProtected
+		case !watermarkEnabled || !doc.WatermarkingEnabled:
+			return nil, false, reasonNotWatermarked
+		case doc.WatermarkStatus != enums.WatermarkStatusSuccess:
+			return nil, false, reasonWatermarkFailed
+		case doc.Edges.File == nil:
+			return nil, false, reasonNoFile
+		}
+
+		// once watermarking succeeds the file edge holds the watermarked copy and the original is kept aside
+		return doc.Edges.File, true, ""
+	default:
+		return nil, false, reasonNotVisible
+	}
+}
+
+// asset downloads the file and returns it as an asset in dir, or nil when there is no file
+func (l *loader) asset(ctx context.Context, dir, name string, f *generated.File) (*Asset, error) {
+	if f == nil {
+		return nil, nil
+	}
+
+	data, err := l.fileContents(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	l.size += int64(len(data))
+	if l.size > l.maxSize {
+		return nil, fmt.Errorf("%w: limit is %d bytes", ErrExportTooLarge, l.maxSize)
+	}
+
+	l.count++
+
+	return &Asset{
+		Path: assetPath(dir, fileName(name, f.ProvidedFileExtension), "file", l.count),
+		Data: data,
+	}, nil
+}
+
+// fileContents returns the contents of a file stored in the database or downloaded from object storage
+func (l *loader) fileContents(ctx context.Context, f *generated.File) ([]byte, error) {
+	if storagetypes.ProviderType(f.StorageProvider) == storagetypes.DatabaseProvider && len(f.FileContents) > 0 {
+		return f.FileContents, nil
+	}
+
+	if l.client.ObjectManager == nil {
+		return nil, nil
+	}
+
+	downloaded, err := l.client.ObjectManager.Download(ctx, nil, interceptors.StorageFileFromEnt(f), &objects.DownloadOptions{
+		FileName:    f.ProvidedFileName,
+		ContentType: f.DetectedContentType,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return downloaded.File, nil
+}
+
+// fileName returns the name with the extension of the uploaded file
+func fileName(name, ext string) string {
+	ext = strings.TrimPrefix(ext, ".")
+	if ext == "" {
+		return name
+	}
+
+	return name + "." + ext
+}
+
+// deref returns the value of an optional string field
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
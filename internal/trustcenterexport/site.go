@@ -0,0 +1,249 @@
+package trustcenterexport
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"html/template"
+	"path"
+	"regexp"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/microcosm-cc/bluemonday"
+
+	"github.com/theopenlane/core/pkg/signedbundle"
+)
+
+//go:embed templates
+var templates embed.FS
+
+var (
+	pageTemplate   = template.Must(template.New("index.html.tmpl").Funcs(template.FuncMap{"richText": richText}).ParseFS(templates, "templates/index.html.tmpl"))
+	styleTemplate  = texttemplate.Must(texttemplate.ParseFS(templates, "templates/site.css.tmpl"))
+	readmeTemplate = texttemplate.Must(texttemplate.ParseFS(templates, "templates/README.txt.tmpl"))
+
+	hexColorPattern = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$`)
+	fontPattern     = regexp.MustCompile(`^[A-Za-z0-9 -]{1,64}$`)
+	unsafePathChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+	richTextPolicy = bluemonday.UGCPolicy()
+)
+
+// defaultTheme is used for colors and fonts that are not set or not valid
+var defaultTheme = Theme{
+	Primary:             "#1f6feb",
+	Foreground:          "#1f2328",
+	Background:          "#ffffff",
+	Accent:              "#0969da",
+	SecondaryBackground: "#f6f8fa",
+	SecondaryForeground: "#59636e",
+	Font:                "Helvetica",
+}
+
+// Site is the content of an exported trust center
+type Site struct {
+	// TrustCenterID is the ID of the exported trust center
+	TrustCenterID string
+	// Slug is the slug of the trust center
+	Slug string
+	// URL is the address of the hosted trust center, if any
+	URL string
+	// Title is the page title
+	Title string
+	// CompanyName is the name of the company the trust center belongs to
+	CompanyName string
+	// CompanyDescription is the description of the company
+	CompanyDescription string
+	// Overview is the overview shown at the top of the trust center
+	Overview string
+	// SecurityContact is the address to report security issues to
+	SecurityContact string
+	// StatusPageURL is the address of the company status page
+	StatusPageURL string
+	// Theme holds the branding colors and font
+	Theme Theme
+	// Logo is the company logo
+	Logo *Asset
+	// Favicon is the site icon
+	Favicon *Asset
+	// Compliance are the standards the company complies with
+	Compliance []Badge
+	// Documents are the documents included in the export
+	Documents []Document
+	// Excluded are the documents left out of the export
+	Excluded []ExcludedDocument
+	// Subprocessors are the subprocessors the company uses
+	Subprocessors []Subprocessor
+	// FAQs are the frequently asked questions
+	FAQs []FAQ
+	// RemoveBranding hides the attribution in the footer
+	RemoveBranding bool
+	// GeneratedAt is when the export was generated
+	GeneratedAt time.Time
+}
+
+// Theme holds the branding colors and font of the site
+type Theme struct {
+	Primary             string
+	Foreground          string
+	Background          string
+	Accent              string
+	SecondaryBackground string
+	SecondaryForeground string
+	Font                string
+}
+
+// Asset is a file included in the site
+type Asset struct {
+	// Path is the path of the file in the bundle
+	Path string
+	// Data is the content of the file
+	Data []byte
+}
+
+// Badge is a compliance standard shown on the site
+type Badge struct {
+	Name          string
+	ShortName     string
+	Version       string
+	GoverningBody string
+	Link          string
+	Logo          *Asset
+}
+
+// Document is a trust center document included in the export
+type Document struct {
+	Title       string
+	Kind        string
+	Standard    string
+	Watermarked bool
+	File        Asset
+}
+
+// ExcludedDocument is a trust center document left out of the export and the reason why
+type ExcludedDocument struct {
+	Title  string
+	Reason string
+}
+
+// Subprocessor is a subprocessor listed on the site
+type Subprocessor struct {
+	Name        string
+	Description string
+	Kind        string
+	Countries   []string
+	Logo        *Asset
+}
+
+// FAQ is a frequently asked question shown on the site
+type FAQ struct {
+	Question      string
+	Answer        string
+	ReferenceLink string
+}
+
+// Render renders the site into the files of the bundle: the page, its stylesheet, a readme explaining how to
+// verify the bundle and the logos and documents it links to
+func (s *Site) Render(keyID string) ([]signedbundle.File, error) {
+	s.Theme = s.Theme.withDefaults()
+
+	var page, style, readme bytes.Buffer
+
+	if err := pageTemplate.Execute(&page, s); err != nil {
+		return nil, err
+	}
+
+	if err := styleTemplate.Execute(&style, s.Theme); err != nil {
+		return nil, err
+	}
+
+	if err := readmeTemplate.Execute(&readme, map[string]any{"Site": s, "KeyID": keyID}); err != nil {
+		return nil, err
+	}
+
+	files := []signedbundle.File{
+		{Path: "index.html", Data: page.Bytes()},
+		{Path: "assets/site.css", Data: style.Bytes()},
+		{Path: "README.txt", Data: readme.Bytes()},
+	}
+
+	for _, a := range s.assets() {
+		files = append(files, signedbundle.File{Path: a.Path, Data: a.Data})
+	}
+
+	return files, nil
+}
+
+// assets returns the files the page links to
+func (s *Site) assets() []*Asset {
+	var out []*Asset
+
+	add := func(a *Asset) {
+		if a != nil && a.Path != "" {
+			out = append(out, a)
+		}
+	}
+
+	add(s.Logo)
+	add(s.Favicon)
+
+	for i := range s.Compliance {
+		add(s.Compliance[i].Logo)
+	}
+
+	for i := range s.Documents {
+		add(&s.Documents[i].File)
+	}
+
+	for i := range s.Subprocessors {
+		add(s.Subprocessors[i].Logo)
+	}
+
+	return out
+}
+
+// withDefaults replaces colors and fonts that are unset or could break out of the stylesheet with the defaults
+func (t Theme) withDefaults() Theme {
+	color := func(v, fallback string) string {
+		if hexColorPattern.MatchString(v) {
+			return v
+		}
+
+		return fallback
+	}
+
+	font := t.Font
+	if !fontPattern.MatchString(font) {
+		font = defaultTheme.Font
+	}
+
+	return Theme{
+		Primary:             color(t.Primary, defaultTheme.Primary),
+		Foreground:          color(t.Foreground, defaultTheme.Foreground),
+		Background:          color(t.Background, defaultTheme.Background),
+		Accent:              color(t.Accent, defaultTheme.Accent),
+		SecondaryBackground: color(t.SecondaryBackground, defaultTheme.SecondaryBackground),
+		SecondaryForeground: color(t.SecondaryForeground, defaultTheme.SecondaryForeground),
+		Font:                font,
+	}
+}
+
+// assetPath returns a unique path in dir for a file, keeping its extension; n disambiguates files with the
+// same name
+func assetPath(dir, name, fallback string, n int) string {
+	ext := strings.ToLower(path.Ext(name))
+	base := strings.Trim(unsafePathChars.ReplaceAllString(strings.TrimSuffix(name, path.Ext(name)), "-"), "-.")
+
+	if base == "" {
+		base = fallback
+	}
+
+	return path.Join(dir, fmt.Sprintf("%02d-%s%s", n, strings.ToLower(base), unsafePathChars.ReplaceAllString(ext, "")))
+}
+
+// richText sanitizes user provided formatted text for the page
+func richText(s string) template.HTML {
+	return template.HTML(richTextPolicy.Sanitize(s)) //nolint:gosec // sanitized by the policy
+}
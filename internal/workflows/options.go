@@ -61,6 +61,11 @@ type GalaConfig struct {
 	FailOnEnqueueError bool `json:"failonenqueueerror" koanf:"failonenqueueerror" default:"false"`
 	// QueueName optionally overrides queue selection for durable gala dispatch jobs.
 	QueueName string `json:"queuename" koanf:"queuename" default:"events"`
+	// DeadLetters keeps the envelopes of gala dispatch jobs that failed and will not run again for replay
+	DeadLetters bool `json:"deadletters" koanf:"deadletters" default:"true"`
+	// Outbox writes mutation events emitted inside a database transaction to an outbox table in the same
+	// transaction and relays them to the job queue at least once after it commits
+	Outbox bool `json:"outbox" koanf:"outbox" default:"false"`
 }
 
 // NewDefaultConfig creates a new workflows config with default values applied.
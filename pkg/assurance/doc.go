@@ -0,0 +1,5 @@
+// Package assurance reads third party assurance reports, such as SOC 1 and SOC 2 examination reports and
+// ISO/IEC 27001 certificates, from their extracted text. The audit period, auditor, opinion, exceptions noted
+// in testing and complementary user entity controls are recognized with text heuristics so that reports from
+// different audit firms can be read without a per-firm template
+package assurance
@@ -0,0 +1,8 @@
+package assurance
+
+import "errors"
+
+var (
+	// ErrNotAssuranceReport is returned when the text is not recognized as an assurance report
+	ErrNotAssuranceReport = errors.New("document is not a recognized assurance report")
+)
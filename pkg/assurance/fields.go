@@ -0,0 +1,139 @@
+package assurance
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	monthExpr = `(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|June?|July?|Aug(?:ust)?|Sep(?:t(?:ember)?)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\.?`
+	dateExpr  = `(?:` + monthExpr + `\s+\d{1,2}(?:st|nd|rd|th)?,?\s+\d{4}` +
+		`|\d{1,2}(?:st|nd|rd|th)?\s+` + monthExpr + `,?\s+\d{4}` +
+		`|\d{4}-\d{2}-\d{2}` +
+		`|\d{1,2}/\d{1,2}/\d{4})`
+
+	// signatureLookahead is the number of lines after the report heading searched for the audit firm signature
+	signatureLookahead = 120
+)
+
+var (
+	periodPattern   = regexp.MustCompile(`(?i)\b(?:period|from)\s+(?:of\s+)?(?:from\s+)?(` + dateExpr + `)\s*(?:to|through|thru|until|-)\s*(` + dateExpr + `)`)
+	asOfPattern     = regexp.MustCompile(`(?i)\bas of\s+(` + dateExpr + `)`)
+	isoExpiry       = regexp.MustCompile(`(?i)\b(?:valid until|valid through|expiry date|expiration date|expires on|expires|date of expiry)\s*:?\s*(` + dateExpr + `)`)
+	ordinalPattern  = regexp.MustCompile(`(?i)(\d)(?:st|nd|rd|th)\b`)
+	auditorLabel    = regexp.MustCompile(`(?im)^(?:independent\s+)?(?:service\s+)?(?:auditor|audit firm|certification body|certifying body|registrar)(?:'s name)?\s*:\s*(.+)$`)
+	firmPattern     = regexp.MustCompile(`^[A-Z][A-Za-z0-9&.,' -]{1,80}?,?\s(?:LLP|L\.L\.P\.|LLC|PLLC|P\.C\.|PC|CPAs?|Ltd\.?|Limited|GmbH|AG)$`)
+	dotLeader       = regexp.MustCompile(`\.{4,}|\s\d{1,3}$`)
+	qualifiedPhrase = regexp.MustCompile(`\bqualified opinion\b`)
+	adversePhrase   = regexp.MustCompile(`\badverse opinion\b`)
+
+	// isoIssue matches the issue date of a certificate, preferring the date the current certificate was issued
+	// over the date the organization was first certified
+	isoIssue = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)\b(?:issue date|date of issue|issued on|effective date)\s*:?\s*(` + dateExpr + `)`),
+		regexp.MustCompile(`(?i)\b(?:certification date|date of certification)\s*:?\s*(` + dateExpr + `)`),
+	}
+
+	dateLayouts = []string{
+		"January 2 2006",
+		"Jan 2 2006",
+		"2 January 2006",
+		"2 Jan 2006",
+		"2006-01-02",
+		"01/02/2006",
+	}
+)
+
+// parseDate parses a date matched by dateExpr
+func parseDate(s string) *time.Time {
+	s = ordinalPattern.ReplaceAllString(s, "$1")
+	s = strings.Join(strings.Fields(strings.NewReplacer(",", " ", ".", " ").Replace(s)), " ")
+	s = strings.Replace(s, "Sept ", "Sep ", 1)
+
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return &t
+		}
+	}
+
+	return nil
+}
+
+// parsePeriod returns the audit period; Type I reports cover a single date and ISO certificates are valid from
+// their issue date until their expiry
+func parsePeriod(text string, framework Framework, reportType ReportType) (start, end *time.Time) {
+	if framework == FrameworkISO27001 {
+		if m := isoExpiry.FindStringSubmatch(text); m != nil {
+			end = parseDate(m[1])
+
+			for _, pattern := range isoIssue {
+				if m := pattern.FindStringSubmatch(text); m != nil {
+					start = parseDate(m[1])
+
+					break
+				}
+			}
+
+			return start, end
+		}
+	}
+
+	if reportType != TypeI {
+		if m := periodPattern.FindStringSubmatch(text); m != nil {
+			return parseDate(m[1]), parseDate(m[2])
+		}
+	}
+
+	if m := asOfPattern.FindStringSubmatch(text); m != nil {
+		date := parseDate(m[1])
+
+		return date, date
+	}
+
+	return nil, nil
+}
+
+// parseAuditor returns the audit firm, either from a labeled field or from the signature that follows the
+// auditor's report
+func parseAuditor(text string) string {
+	if m := auditorLabel.FindStringSubmatch(text); m != nil {
+		return strings.TrimSpace(m[1])
+	}
+
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		lower := strings.ToLower(line)
+		if (!strings.Contains(lower, "auditor's report") && !strings.Contains(lower, "independent auditor")) || dotLeader.MatchString(line) {
+			continue
+		}
+
+		for _, candidate := range lines[i+1 : min(len(lines), i+signatureLookahead)] {
+			if firmPattern.MatchString(candidate) {
+				return strings.TrimSpace(candidate)
+			}
+		}
+	}
+
+	return ""
+}
+
+// parseOpinion returns the auditor's opinion; the modified opinions are checked first as their reports also
+// contain the wording of a clean opinion when describing the criteria that were met
+func parseOpinion(text string, framework Framework) Opinion {
+	lower := strings.ToLower(text)
+
+	switch {
+	case strings.Contains(lower, "do not express an opinion") || strings.Contains(lower, "disclaimer of opinion"):
+		return OpinionDisclaimer
+	case adversePhrase.MatchString(lower):
+		return OpinionAdverse
+	case qualifiedPhrase.MatchString(lower) || strings.Contains(lower, "except for the matter") || strings.Contains(lower, "except for the effects of"):
+		return OpinionQualified
+	case strings.Contains(lower, "in our opinion") || strings.Contains(lower, "unqualified opinion") || framework == FrameworkISO27001:
+		return OpinionUnqualified
+	default:
+		return ""
+	}
+}
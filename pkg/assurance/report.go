@@ -0,0 +1,193 @@
+package assurance
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Framework is the attestation or certification standard a report was issued under
+type Framework string
+
+const (
+	// FrameworkSOC1 is a SOC 1 report on controls relevant to financial reporting
+	FrameworkSOC1 Framework = "SOC 1"
+	// FrameworkSOC2 is a SOC 2 report on the trust services criteria
+	FrameworkSOC2 Framework = "SOC 2"
+	// FrameworkISO27001 is an ISO/IEC 27001 certificate or audit report
+	FrameworkISO27001 Framework = "ISO 27001"
+)
+
+// ReportType distinguishes reports on the design of controls at a point in time from reports on their
+// operating effectiveness over a period
+type ReportType string
+
+const (
+	// TypeI reports on the design of controls as of a date
+	TypeI ReportType = "Type I"
+	// TypeII reports on the design and operating effectiveness of controls over a period
+	TypeII ReportType = "Type II"
+)
+
+// Opinion is the auditor's opinion on the report
+type Opinion string
+
+const (
+	// OpinionUnqualified is a clean opinion
+	OpinionUnqualified Opinion = "UNQUALIFIED"
+	// OpinionQualified is an opinion with exceptions to one or more criteria
+	OpinionQualified Opinion = "QUALIFIED"
+	// OpinionAdverse is an opinion that the controls are not suitably designed or operating effectively
+	OpinionAdverse Opinion = "ADVERSE"
+	// OpinionDisclaimer is issued when the auditor could not obtain enough evidence to express an opinion
+	OpinionDisclaimer Opinion = "DISCLAIMER"
+)
+
+// Favorable reports whether the opinion supports reliance on the report
+func (o Opinion) Favorable() bool {
+	return o == OpinionUnqualified || o == OpinionQualified
+}
+
+// Report is the information read from an assurance report
+type Report struct {
+	// Framework is the standard the report was issued under
+	Framework Framework `json:"framework"`
+	// Type is the report type; empty for ISO certificates
+	Type ReportType `json:"type,omitempty"`
+	// PeriodStart is the first day of the audit period, or the report date for Type I reports
+	PeriodStart *time.Time `json:"periodStart,omitempty"`
+	// PeriodEnd is the last day of the audit period, or the certificate expiry for ISO certificates
+	PeriodEnd *time.Time `json:"periodEnd,omitempty"`
+	// Auditor is the audit firm or certification body that issued the report
+	Auditor string `json:"auditor,omitempty"`
+	// Opinion is the auditor's opinion; empty when no opinion was found
+	Opinion Opinion `json:"opinion,omitempty"`
+	// Exceptions are the deviations noted in testing
+	Exceptions []Exception `json:"exceptions,omitempty"`
+	// CUECs are the controls the service organization expects its customers to operate
+	CUECs []CUEC `json:"cuecs,omitempty"`
+}
+
+// Exception is a deviation noted by the auditor while testing a control
+type Exception struct {
+	// Control is the criteria or control reference the exception was noted against, when known
+	Control string `json:"control,omitempty"`
+	// Description is the auditor's description of the exception
+	Description string `json:"description"`
+	// ManagementResponse is the service organization's response to the exception
+	ManagementResponse string `json:"managementResponse,omitempty"`
+}
+
+// CUEC is a complementary user entity control
+type CUEC struct {
+	// Ref is the position of the control in the report, e.g. CUEC 3
+	Ref string `json:"ref"`
+	// Description is the control the user entity is responsible for
+	Description string `json:"description"`
+	// Criteria are the criteria the control supports, e.g. CC6.1
+	Criteria []string `json:"criteria,omitempty"`
+}
+
+var (
+	soc1Pattern       = regexp.MustCompile(`(?i)\bSOC\s*1\b|\bSOC\s+for\s+service\s+organizations:\s*ICFR\b|\bSSAE\s*(?:16|18)\b.*\bfinancial reporting\b`)
+	soc2Pattern       = regexp.MustCompile(`(?i)\bSOC\s*2\b|\bSOC\s+for\s+service\s+organizations:\s*trust services criteria\b|\btrust services criteria\b`)
+	isoPattern        = regexp.MustCompile(`(?i)\bISO\s*/?\s*(?:IEC\s*)?27001\b`)
+	typeIIPattern     = regexp.MustCompile(`(?i)\btype\s+(?:II|2)\b`)
+	typeIPattern      = regexp.MustCompile(`(?i)\btype\s+(?:I|1)\b`)
+	whitespacePattern = regexp.MustCompile(`[ \t\x{00a0}]+`)
+)
+
+// Detect reports whether text looks like an assurance report
+func Detect(text string) bool {
+	_, ok := detectFramework(normalize(text))
+
+	return ok
+}
+
+// Parse reads an assurance report from its extracted text; ErrNotAssuranceReport is returned when the text is
+// not recognized as a report
+func Parse(text string) (*Report, error) {
+	text = normalize(text)
+
+	framework, ok := detectFramework(text)
+	if !ok {
+		return nil, ErrNotAssuranceReport
+	}
+
+	r := &Report{Framework: framework}
+
+	if framework != FrameworkISO27001 {
+		r.Type = detectType(text)
+	}
+
+	r.PeriodStart, r.PeriodEnd = parsePeriod(text, framework, r.Type)
+	r.Auditor = parseAuditor(text)
+	r.Opinion = parseOpinion(text, framework)
+	r.Exceptions = parseExceptions(text)
+	r.CUECs = parseCUECs(text)
+
+	return r, nil
+}
+
+// detectFramework returns the standard a report was issued under; a document must refer to an independent
+// examination or certification as well as the standard to be treated as a report rather than, for example, a
+// policy that mentions SOC 2
+func detectFramework(text string) (Framework, bool) {
+	lower := strings.ToLower(text)
+
+	attested := strings.Contains(lower, "independent service auditor") ||
+		strings.Contains(lower, "service auditor's report") ||
+		strings.Contains(lower, "independent auditor") ||
+		strings.Contains(lower, "report on controls")
+
+	certified := strings.Contains(lower, "certificate") ||
+		strings.Contains(lower, "certification body") ||
+		strings.Contains(lower, "certified")
+
+	if attested {
+		soc1 := len(soc1Pattern.FindAllStringIndex(text, -1))
+		soc2 := len(soc2Pattern.FindAllStringIndex(text, -1))
+
+		switch {
+		case soc2 > 0 && soc2 >= soc1:
+			return FrameworkSOC2, true
+		case soc1 > 0:
+			return FrameworkSOC1, true
+		}
+	}
+
+	if (certified || attested) && isoPattern.MatchString(text) {
+		return FrameworkISO27001, true
+	}
+
+	return "", false
+}
+
+// detectType returns the report type; the type named most often wins, as a Type II report commonly refers to
+// Type I reports when describing its scope
+func detectType(text string) ReportType {
+	typeII := len(typeIIPattern.FindAllStringIndex(text, -1))
+	typeI := len(typeIPattern.FindAllStringIndex(text, -1))
+
+	switch {
+	case typeII == 0 && typeI == 0:
+		return ""
+	case typeII >= typeI:
+		return TypeII
+	default:
+		return TypeI
+	}
+}
+
+// normalize collapses runs of spaces and normalizes line endings and quotes so the heuristics do not have to
+// account for layout differences introduced by text extraction
+func normalize(text string) string {
+	text = strings.NewReplacer("\r\n", "\n", "\r", "\n", "’", "'", "‘", "'", "“", `"`, "”", `"`, "–", "-", "—", "-").Replace(text)
+
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(whitespacePattern.ReplaceAllString(line, " "))
+	}
+
+	return strings.Join(lines, "\n")
+}
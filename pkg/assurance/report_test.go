@@ -0,0 +1,172 @@
+package assurance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const soc2Report = `Acme Cloud, Inc.
+SOC 2 Type 2 Report
+Report on Controls at a Service Organization Relevant to Security and Availability
+For the period January 1, 2025 to December 31st, 2025
+
+Table of Contents
+Section 1: Independent Service Auditor’s Report ........ 1
+Complementary User Entity Controls ........ 42
+
+Section 1
+Independent Service Auditor’s Report
+
+To the management of Acme Cloud, Inc.:
+
+Scope
+We have examined Acme Cloud's description of its system for the period January 1, 2025 to December 31, 2025
+based on the trust services criteria relevant to security and availability.
+
+Basis for Qualified Opinion
+Acme Cloud states that user access is revoked within one business day. As noted in Section 4, exceptions noted
+in our testing demonstrate the control was not operating effectively.
+
+Qualified Opinion
+In our opinion, except for the matter described in the Basis for Qualified Opinion paragraph, the controls
+stated in the description were suitably designed and operated effectively.
+
+Examplar & Associates, LLP
+Denver, Colorado
+February 14, 2026
+
+Section 4
+CC6.1 Logical access to production systems requires multi-factor authentication.
+Inspected the MFA configuration. No exceptions noted.
+
+CC6.2 Access for terminated employees is revoked within one business day.
+Inspected termination tickets for a sample of 25 terminated employees.
+Exceptions noted. For 3 of 25 sampled terminated employees, access was not revoked
+within one business day of termination.
+
+Management's Response: The offboarding checklist has been automated so access is
+revoked when the HR record is closed.
+
+CC7.2 Security events are reviewed daily.
+Exception noted.
+
+The security event review was not evidenced for 2 of 40 sampled days.
+
+Complementary User Entity Controls
+Acme Cloud's controls were designed with the assumption that certain controls would be implemented by user
+entities. User entities are responsible for the following:
+#   Complementary User Entity Controls   Related Criteria
+1. User entities are responsible for provisioning and removing access of their own users to the
+application. CC6.1, CC6.2
+2. User entities are responsible for reporting suspected security incidents to Acme Cloud.
+CC7.3
+• User entities should review audit logs made available in the application. CC7.2
+
+Complementary Subservice Organization Controls
+1. The subservice organization is responsible for physical security. CC6.4
+`
+
+const isoCertificate = `CERTIFICATE OF REGISTRATION
+This is to certify that Acme Cloud GmbH
+operates an Information Security Management System which complies with the requirements of ISO/IEC 27001:2022
+Certification body: Example Registrar Ltd
+Original certification date: 3 March 2022
+Issue date: 3 March 2025
+Expiry date: 2 March 2028
+`
+
+func date(year int, month time.Month, day int) *time.Time {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+
+	return &t
+}
+
+func TestParseSOC2Report(t *testing.T) {
+	r, err := Parse(soc2Report)
+	require.NoError(t, err)
+
+	assert.Equal(t, FrameworkSOC2, r.Framework)
+	assert.Equal(t, TypeII, r.Type)
+	assert.Equal(t, date(2025, time.January, 1), r.PeriodStart)
+	assert.Equal(t, date(2025, time.December, 31), r.PeriodEnd)
+	assert.Equal(t, "Examplar & Associates, LLP", r.Auditor)
+	assert.Equal(t, OpinionQualified, r.Opinion)
+	assert.True(t, r.Opinion.Favorable())
+
+	require.Len(t, r.Exceptions, 2)
+	assert.Equal(t, Exception{
+		Control:            "CC6.2",
+		Description:        "For 3 of 25 sampled terminated employees, access was not revoked within one business day of termination.",
+		ManagementResponse: "The offboarding checklist has been automated so access is revoked when the HR record is closed.",
+	}, r.Exceptions[0])
+	assert.Equal(t, Exception{
+		Control:     "CC7.2",
+		Description: "The security event review was not evidenced for 2 of 40 sampled days.",
+	}, r.Exceptions[1])
+
+	require.Len(t, r.CUECs, 3)
+	assert.Equal(t, CUEC{
+		Ref:         "CUEC 1",
+		Description: "User entities are responsible for provisioning and removing access of their own users to the application.",
+		Criteria:    []string{"CC6.1", "CC6.2"},
+	}, r.CUECs[0])
+	assert.Equal(t, []string{"CC7.3"}, r.CUECs[1].Criteria)
+	assert.Equal(t, "User entities should review audit logs made available in the application.", r.CUECs[2].Description)
+}
+
+func TestParseISOCertificate(t *testing.T) {
+	r, err := Parse(isoCertificate)
+	require.NoError(t, err)
+
+	assert.Equal(t, FrameworkISO27001, r.Framework)
+	assert.Empty(t, r.Type)
+	assert.Equal(t, date(2025, time.March, 3), r.PeriodStart)
+	assert.Equal(t, date(2028, time.March, 2), r.PeriodEnd)
+	assert.Equal(t, "Example Registrar Ltd", r.Auditor)
+	assert.Equal(t, OpinionUnqualified, r.Opinion)
+	assert.Empty(t, r.Exceptions)
+	assert.Empty(t, r.CUECs)
+}
+
+func TestParseTypeIAndOpinions(t *testing.T) {
+	text := `Independent Service Auditor's Report
+SOC 1 Type 1 report on the description of the system as of Sept. 30, 2025
+We do not express an opinion on the suitability of the design of the controls.`
+
+	r, err := Parse(text)
+	require.NoError(t, err)
+
+	assert.Equal(t, FrameworkSOC1, r.Framework)
+	assert.Equal(t, TypeI, r.Type)
+	assert.Equal(t, date(2025, time.September, 30), r.PeriodStart)
+	assert.Equal(t, r.PeriodStart, r.PeriodEnd)
+	assert.Equal(t, OpinionDisclaimer, r.Opinion)
+	assert.False(t, r.Opinion.Favorable())
+
+	assert.Equal(t, OpinionAdverse, parseOpinion("basis for adverse opinion", FrameworkSOC2))
+	assert.Equal(t, OpinionUnqualified, parseOpinion("in our opinion, an unqualified opinion", FrameworkSOC2))
+	assert.Equal(t, Opinion(""), parseOpinion("no opinion here", FrameworkSOC2))
+}
+
+func TestParseNotReport(t *testing.T) {
+	policy := "Access Control Policy\nThis policy supports our SOC 2 program and the trust services criteria."
+
+	assert.False(t, Detect(policy))
+
+	_, err := Parse(policy)
+	assert.ErrorIs(t, err, ErrNotAssuranceReport)
+
+	assert.True(t, Detect(soc2Report))
+	assert.True(t, Detect(isoCertificate))
+}
+
+func TestParseDate(t *testing.T) {
+	for _, s := range []string{"July 4, 2025", "Jul. 4 2025", "4th July 2025", "2025-07-04", "07/04/2025"} {
+		assert.Equal(t, date(2025, time.July, 4), parseDate(s), s)
+	}
+
+	assert.Nil(t, parseDate("the fourth of July"))
+}
@@ -0,0 +1,232 @@
+package assurance
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/samber/lo"
+)
+
+const (
+	// maxSectionLines bounds the number of lines read from the complementary user entity controls section
+	maxSectionLines = 400
+	// maxResponseGap is the number of blank lines allowed between an exception and management's response
+	maxResponseGap = 2
+
+	// controlRefExpr matches trust services criteria such as CC6.1 and ISO/IEC 27001 Annex A controls
+	controlRefExpr = `(?:CC\d{1,2}\.\d{1,2}|PI\d\.\d{1,2}|[ACP]\d\.\d{1,2}|A\.\d{1,2}\.\d{1,2}(?:\.\d{1,2})?)`
+	// descriptionTrimSet is trimmed from the ends of text taken from around an exception phrase
+	descriptionTrimSet = " .:;-"
+)
+
+var (
+	controlRefPattern = regexp.MustCompile(`\b` + controlRefExpr + `\b`)
+	leadingControlRef = regexp.MustCompile(`^(?:Control\s+)?(` + controlRefExpr + `)\b`)
+	refsOnlyPattern   = regexp.MustCompile(`^(?:` + controlRefExpr + `[\s,;/&]*(?:and\s+)?)+$`)
+	trailingRefs      = regexp.MustCompile(`(?:[\s,;/(]+` + controlRefExpr + `\)?)+\s*$`)
+	exceptionPhrase   = regexp.MustCompile(`(?i)\b(?:exceptions?|deviations?)\s+(?:were\s+)?noted\b`)
+	noExceptionPhrase = regexp.MustCompile(`(?i)\b(?:no|any|if|whether|none|without)\s+(?:relevant\s+|other\s+)?(?:exceptions?|deviations?)\b`)
+	responsePattern   = regexp.MustCompile(`(?i)^management'?s?\s+response(?:\s+to\s+(?:the\s+)?(?:exceptions?|deviations?))?\s*[:.\-]?\s*(.*)$`)
+	cuecHeading       = regexp.MustCompile(`(?i)^(?:section\s+[\divx]+[:.\-]?\s*)?complementary user[- ]entity controls?(?:\s*\(cuecs?\))?:?$`)
+	cuecSectionEnd    = regexp.MustCompile(`(?i)^(?:section\s+[\divx]+\b|complementary subservice|subservice organi[sz]ations?$|trust services criteria|control environment$|other information|report of independent|independent service auditor)`)
+	cuecColumnHeading = regexp.MustCompile(`(?i)^(?:#|no\.?|ref\.?|criteria|related criteria|applicable criteria|control objectives?|related control objectives?|complementary user entity controls?)$`)
+	listItemPattern   = regexp.MustCompile(`^(?:\d{1,3}[.)]|[•*▪●◦-])\s+(.+)$`)
+	userEntityItem    = regexp.MustCompile(`(?i)^(?:user entit(?:y|ies)|customers?|clients?)\s+(?:are|is|should|must|have|has|will)\b`)
+)
+
+// parseExceptions returns the exceptions noted in the tests of controls; each is attributed to the control
+// reference on its line or, failing that, the most recent control reference above it. Mentions of exceptions
+// before the first control reference, such as in the basis for a qualified opinion, are not tests of controls
+func parseExceptions(text string) []Exception {
+	lines := strings.Split(text, "\n")
+
+	var (
+		out     []Exception
+		current string
+	)
+
+	seen := map[string]bool{}
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+
+		if m := leadingControlRef.FindStringSubmatch(line); m != nil {
+			current = m[1]
+		}
+
+		loc := exceptionPhrase.FindStringIndex(line)
+		if loc == nil || noExceptionPhrase.MatchString(line) {
+			continue
+		}
+
+		control := current
+		if ref := controlRefPattern.FindString(line); ref != "" {
+			control = ref
+		}
+
+		if control == "" {
+			continue
+		}
+
+		desc := strings.Trim(line[loc[1]:], descriptionTrimSet)
+		if desc == "" {
+			before := strings.Trim(controlRefPattern.ReplaceAllString(line[:loc[0]], ""), descriptionTrimSet+",")
+			if len(strings.Fields(before)) > 3 {
+				desc = before
+			}
+		}
+
+		more, next := exceptionContinuation(lines, i+1, desc == "")
+		desc = joinText(desc, more)
+		i = next - 1
+
+		if desc == "" {
+			continue
+		}
+
+		exc := Exception{Control: control, Description: desc}
+
+		if response, end := managementResponse(lines, next); response != "" {
+			exc.ManagementResponse = response
+			i = end - 1
+		}
+
+		key := exc.Control + "\x00" + exc.Description
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+
+		out = append(out, exc)
+	}
+
+	return out
+}
+
+// exceptionContinuation returns the lines that continue an exception description starting at start and the
+// index of the first line after them; a single blank line is skipped when the description has not started
+func exceptionContinuation(lines []string, start int, allowGap bool) (string, int) {
+	var parts []string
+
+	i := start
+	if allowGap && i < len(lines) && lines[i] == "" {
+		i++
+	}
+
+	for ; i < len(lines); i++ {
+		line := lines[i]
+		if line == "" || exceptionPhrase.MatchString(line) || responsePattern.MatchString(line) || leadingControlRef.MatchString(line) {
+			break
+		}
+
+		parts = append(parts, line)
+	}
+
+	return strings.Join(parts, " "), i
+}
+
+// managementResponse returns management's response to an exception when it follows the exception at start,
+// and the index of the first line after it
+func managementResponse(lines []string, start int) (string, int) {
+	i := start
+	for gap := 0; i < len(lines) && lines[i] == "" && gap < maxResponseGap; gap++ {
+		i++
+	}
+
+	if i >= len(lines) {
+		return "", start
+	}
+
+	m := responsePattern.FindStringSubmatch(lines[i])
+	if m == nil {
+		return "", start
+	}
+
+	parts := []string{m[1]}
+
+	for i++; i < len(lines); i++ {
+		line := lines[i]
+		if line == "" || exceptionPhrase.MatchString(line) || leadingControlRef.MatchString(line) {
+			break
+		}
+
+		parts = append(parts, line)
+	}
+
+	return strings.Trim(joinText(parts...), " "), i
+}
+
+// parseCUECs returns the complementary user entity controls listed in the section of the same name
+func parseCUECs(text string) []CUEC {
+	lines := strings.Split(text, "\n")
+
+	for i, line := range lines {
+		if !cuecHeading.MatchString(line) {
+			continue
+		}
+
+		if out := cuecItems(lines[i+1 : min(len(lines), i+1+maxSectionLines)]); len(out) > 0 {
+			return out
+		}
+	}
+
+	return nil
+}
+
+// cuecItems reads the list items of a complementary user entity controls section; items are numbered or
+// bulleted, or are sentences starting with the party responsible, and continue until a blank line
+func cuecItems(lines []string) []CUEC {
+	var (
+		items []string
+		open  bool
+	)
+
+	for _, line := range lines {
+		switch {
+		case cuecSectionEnd.MatchString(line):
+			return buildCUECs(items)
+		case line == "":
+			open = false
+		case cuecColumnHeading.MatchString(line):
+		case refsOnlyPattern.MatchString(line) && len(items) > 0:
+			items[len(items)-1] = joinText(items[len(items)-1], line)
+		case listItemPattern.MatchString(line):
+			items = append(items, listItemPattern.FindStringSubmatch(line)[1])
+			open = true
+		case userEntityItem.MatchString(line) && !strings.HasSuffix(line, ":"):
+			items = append(items, line)
+			open = true
+		case open:
+			items[len(items)-1] = joinText(items[len(items)-1], line)
+		}
+	}
+
+	return buildCUECs(items)
+}
+
+// buildCUECs numbers the items and separates the criteria references from the control description
+func buildCUECs(items []string) []CUEC {
+	out := make([]CUEC, 0, len(items))
+
+	for _, item := range items {
+		desc := strings.TrimSpace(trailingRefs.ReplaceAllString(item, ""))
+		if desc == "" {
+			continue
+		}
+
+		out = append(out, CUEC{
+			Ref:         fmt.Sprintf("CUEC %d", len(out)+1),
+			Description: desc,
+			Criteria:    lo.Uniq(controlRefPattern.FindAllString(item, -1)),
+		})
+	}
+
+	return out
+}
+
+// joinText joins the non-empty parts with a space
+func joinText(parts ...string) string {
+	return strings.Join(lo.Compact(parts), " ")
+}
@@ -215,12 +215,24 @@ func billingLit(b models.Billing) *jen.Statement {
 		jen.Id("Prices"): jen.Index().Qual(modelsPath, "ItemPrice").Values(items...)})
 }
 
-// usageLit generates a block for models.Usage, currently only
-// containing EvidenceStorageGB (records limitations / metering not yet implemented), but can be extended in the future
+// usageLit generates a block for models.Usage, only emitting the limits that are declared
 func usageLit(u models.Usage) *jen.Statement {
-	return jen.Qual(modelsPath, "Usage").Values(jen.Dict{
-		jen.Id("EvidenceStorageGB"): jen.Lit(u.EvidenceStorageGB),
-	})
+	dict := jen.Dict{}
+
+	for name, v := range map[string]int64{
+		"EvidenceStorageGB":       u.EvidenceStorageGB,
+		"RecordCount":             u.RecordCount,
+		"Seats":                   u.Seats,
+		"IntegrationRunsPerMonth": u.IntegrationRunsPerMonth,
+		"ActivePrograms":          u.ActivePrograms,
+		"OverageAllowancePercent": u.OverageAllowancePercent,
+	} {
+		if v != 0 {
+			dict[jen.Id(name)] = jen.Lit(v)
+		}
+	}
+
+	return jen.Qual(modelsPath, "Usage").Values(dict)
 }
 
 // priceLit generates a list of elemtents for price
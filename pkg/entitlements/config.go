@@ -1,5 +1,7 @@
 package entitlements
 
+import "time"
+
 type Config struct {
 	// Enabled determines if the entitlements service is enabled
 	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
@@ -21,6 +23,20 @@ type Config struct {
 	StripeWebhookAPIVersion string `json:"stripewebhookapiversion" koanf:"stripewebhookapiversion" default:"2026_02_25_CLOVER"`
 	// StripeWebhookDiscardAPIVersion is the Stripe API version to discard during migration
 	StripeWebhookDiscardAPIVersion string `json:"stripewebhookdiscardapiversion" koanf:"stripewebhookdiscardapiversion" default:"2025_12_15_CLOVER"`
+	// License configures the offline license file used instead of Stripe by self-hosted deployments
+	License LicenseConfig `json:"license" koanf:"license"`
+}
+
+// LicenseConfig configures the offline license file for self-hosted deployments
+type LicenseConfig struct {
+	// Enabled determines if modules are granted from the license file
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// File is the path to the signed license file
+	File string `json:"file" koanf:"file" default:""`
+	// PublicKey is the base64 encoded Ed25519 public key the license file is verified with
+	PublicKey string `json:"publickey" koanf:"publickey" default:""`
+	// GracePeriod is how long an expired license keeps granting its modules
+	GracePeriod time.Duration `json:"graceperiod" koanf:"graceperiod" default:"336h"`
 }
 
 type ConfigOpts func(*Config)
@@ -25,6 +25,8 @@ var (
 	ErrMissingAPIKey = errors.New("missing API key")
 	// ErrNoSubscriptionItems is returned when no subscription items are found
 	ErrNoSubscriptionItems = errors.New("no subscription items found to create subscription")
+	// ErrMeterEventNameRequired is returned when a meter event is reported without an event name
+	ErrMeterEventNameRequired = errors.New("meter event name is required")
 	// ErrInternalServerError is returned when an error occurs that should not be exposed to the user and is not the user's fault
 	ErrInternalServerError = errors.New("internal server error")
 )
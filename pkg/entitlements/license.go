@@ -0,0 +1,161 @@
+package entitlements
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// LicenseStatus describes whether a license currently grants its modules
+type LicenseStatus string
+
+const (
+	// LicenseStatusValid is a license within its validity period
+	LicenseStatusValid LicenseStatus = "VALID"
+	// LicenseStatusGracePeriod is an expired license that still grants its modules until the grace period ends
+	LicenseStatusGracePeriod LicenseStatus = "GRACE_PERIOD"
+	// LicenseStatusExpired is a license past its grace period, its modules are no longer granted
+	LicenseStatusExpired LicenseStatus = "EXPIRED"
+	// LicenseStatusNotYetValid is a license issued for a period that has not started
+	LicenseStatusNotYetValid LicenseStatus = "NOT_YET_VALID"
+)
+
+// License is the set of entitlements granted to a self-hosted deployment, used in place of a Stripe
+// subscription when the deployment cannot reach Stripe
+type License struct {
+	// ID is the identifier of the license assigned when it was issued
+	ID string `json:"id"`
+	// Licensee is the name of the customer the license was issued to
+	Licensee string `json:"licensee"`
+	// OrganizationID is the organization the license is bound to
+	OrganizationID string `json:"organization_id"`
+	// Modules are the catalog modules and addons the license enables
+	Modules []string `json:"modules"`
+	// Seats is the maximum number of organization members, zero is unlimited
+	Seats int64 `json:"seats,omitempty"`
+	// IssuedAt is when the license was issued, it is not valid before then
+	IssuedAt time.Time `json:"issued_at"`
+	// ExpiresAt is when the license expires
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// SignedLicense is the contents of a license file, the license payload and its Ed25519 signature
+type SignedLicense struct {
+	// Payload is the base64 encoded JSON license
+	Payload string `json:"payload"`
+	// Signature is the base64 encoded Ed25519 signature of the decoded payload
+	Signature string `json:"signature"`
+}
+
+// ParseLicensePublicKey decodes a base64 encoded Ed25519 public key
+func ParseLicensePublicKey(encoded string) (ed25519.PublicKey, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil || len(key) != ed25519.PublicKeySize {
+		return nil, ErrLicensePublicKeyInvalid
+	}
+
+	return ed25519.PublicKey(key), nil
+}
+
+// SignLicense encodes and signs a license, returning the contents of a license file
+func SignLicense(l License, key ed25519.PrivateKey) ([]byte, error) {
+	payload, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(SignedLicense{
+		Payload:   base64.StdEncoding.EncodeToString(payload),
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(key, payload)),
+	}, "", "  ")
+}
+
+// ParseLicense verifies the signature of a license file and returns the license it contains
+func ParseLicense(data []byte, key ed25519.PublicKey) (*License, error) {
+	var signed SignedLicense
+	if err := json.Unmarshal(data, &signed); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLicenseMalformed, err)
+	}
+
+	payload, err := base64.StdEncoding.DecodeString(signed.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: payload is not base64 encoded", ErrLicenseMalformed)
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(signed.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature is not base64 encoded", ErrLicenseMalformed)
+	}
+
+	if !ed25519.Verify(key, payload, signature) {
+		return nil, ErrLicenseSignatureInvalid
+	}
+
+	var l License
+	if err := json.Unmarshal(payload, &l); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLicenseMalformed, err)
+	}
+
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &l, nil
+}
+
+// LoadLicenseFile reads and verifies the license file at path
+func LoadLicenseFile(path string, key ed25519.PublicKey) (*License, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseLicense(data, key)
+}
+
+// Validate checks the license has the fields required to apply it
+func (l *License) Validate() error {
+	switch {
+	case l.ID == "":
+		return fmt.Errorf("%w: id", ErrLicenseFieldRequired)
+	case l.OrganizationID == "":
+		return fmt.Errorf("%w: organization_id", ErrLicenseFieldRequired)
+	case len(l.Modules) == 0:
+		return fmt.Errorf("%w: modules", ErrLicenseFieldRequired)
+	case l.ExpiresAt.IsZero():
+		return fmt.Errorf("%w: expires_at", ErrLicenseFieldRequired)
+	case l.Seats < 0:
+		return ErrLicenseSeatsInvalid
+	}
+
+	return nil
+}
+
+// Status returns whether the license grants its modules at now, an expired license keeps granting them
+// until the grace period ends
+func (l *License) Status(now time.Time, grace time.Duration) LicenseStatus {
+	switch {
+	case !l.IssuedAt.IsZero() && now.Before(l.IssuedAt):
+		return LicenseStatusNotYetValid
+	case now.Before(l.ExpiresAt):
+		return LicenseStatusValid
+	case now.Before(l.ExpiresAt.Add(grace)):
+		return LicenseStatusGracePeriod
+	default:
+		return LicenseStatusExpired
+	}
+}
+
+// BoundTo reports whether the license is bound to the organization
+func (l *License) BoundTo(orgID string) bool {
+	return orgID != "" && l.OrganizationID == orgID
+}
+
+// Grants reports whether the license status still grants its modules
+func (s LicenseStatus) Grants() bool {
+	return s == LicenseStatusValid || s == LicenseStatusGracePeriod
+}
@@ -0,0 +1,18 @@
+package entitlements
+
+import "errors"
+
+var (
+	// ErrLicenseNotConfigured is returned when the license file or public key is not configured
+	ErrLicenseNotConfigured = errors.New("license file and public key are required")
+	// ErrLicensePublicKeyInvalid is returned when the license public key is not a base64 encoded Ed25519 public key
+	ErrLicensePublicKeyInvalid = errors.New("license public key must be a base64 encoded Ed25519 public key")
+	// ErrLicenseMalformed is returned when the license file cannot be decoded
+	ErrLicenseMalformed = errors.New("license file is malformed")
+	// ErrLicenseSignatureInvalid is returned when the license was not signed by the configured key or was modified
+	ErrLicenseSignatureInvalid = errors.New("license signature is invalid")
+	// ErrLicenseFieldRequired is returned when the license is missing a required field
+	ErrLicenseFieldRequired = errors.New("license is missing a required field")
+	// ErrLicenseSeatsInvalid is returned when the license has a negative seat limit
+	ErrLicenseSeatsInvalid = errors.New("license seats cannot be negative")
+)
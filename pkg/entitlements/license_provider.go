@@ -0,0 +1,182 @@
+package entitlements
+
+import (
+	"context"
+	"crypto/ed25519"
+	"sync"
+	"time"
+
+	"github.com/theopenlane/core/pkg/logx"
+	"github.com/theopenlane/core/pkg/mapx"
+)
+
+const (
+	// DefaultLicenseGracePeriod is how long an expired license keeps granting its modules
+	DefaultLicenseGracePeriod = 14 * 24 * time.Hour
+)
+
+// LicenseProvider grants the modules of an offline license file to the organization it is bound to; it is the
+// alternative to StripeClient for self-hosted deployments and writes the same feature tuples through a
+// TupleChecker instead of reacting to Stripe webhooks
+type LicenseProvider struct {
+	config  LicenseConfig
+	key     ed25519.PublicKey
+	checker *TupleChecker
+
+	mu      sync.RWMutex
+	license *License
+}
+
+// NewLicenseProvider verifies the configured license file and returns a provider for it
+func NewLicenseProvider(cfg LicenseConfig, checker *TupleChecker) (*LicenseProvider, error) {
+	if cfg.File == "" || cfg.PublicKey == "" {
+		return nil, ErrLicenseNotConfigured
+	}
+
+	key, err := ParseLicensePublicKey(cfg.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := LoadLicenseFile(cfg.File, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.GracePeriod <= 0 {
+		cfg.GracePeriod = DefaultLicenseGracePeriod
+	}
+
+	return &LicenseProvider{
+		config:  cfg,
+		key:     key,
+		checker: checker,
+		license: l,
+	}, nil
+}
+
+// License returns a copy of the loaded license
+func (p *LicenseProvider) License() License {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return *p.license
+}
+
+// Status returns whether the loaded license grants its modules at now
+func (p *LicenseProvider) Status(now time.Time) LicenseStatus {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.license.Status(now, p.config.GracePeriod)
+}
+
+// SeatLimit returns the seat limit of the license for the organization, the limit is only returned while
+// the license grants its modules and declares seats
+func (p *LicenseProvider) SeatLimit(orgID string, now time.Time) (int64, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if !p.license.BoundTo(orgID) || p.license.Seats == 0 || !p.license.Status(now, p.config.GracePeriod).Grants() {
+		return 0, false
+	}
+
+	return p.license.Seats, true
+}
+
+// Apply writes the feature tuples of the license modules while the license grants them and removes them
+// once it has expired; the license is the only source of modules for the organization, so any other feature
+// enabled for it, such as a module dropped from a reloaded license, is removed as well
+func (p *LicenseProvider) Apply(ctx context.Context, now time.Time) (LicenseStatus, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	status := p.license.Status(now, p.config.GracePeriod)
+	logger := logx.FromContext(ctx).With().
+		Str("license_id", p.license.ID).
+		Str("organization_id", p.license.OrganizationID).
+		Str("status", string(status)).
+		Logger()
+
+	grant := []string{}
+	if status.Grants() {
+		grant = p.license.Modules
+	}
+
+	// the modules to revoke are read from the tuples in FGA rather than tracked in memory, so tuples written
+	// before a restart or by a previous license are removed as well
+	enabled, err := p.checker.ListFeatures(ctx, p.license.OrganizationID)
+	if err != nil {
+		return status, err
+	}
+
+	revoke := mapx.MapSetFromSlice(enabled)
+
+	for _, module := range grant {
+		delete(revoke, module)
+	}
+
+	for module := range revoke {
+		if err := p.checker.DeleteFeatureTuple(ctx, p.featureTuple(module)); err != nil {
+			return status, err
+		}
+	}
+
+	for _, module := range grant {
+		if err := p.checker.CreateFeatureTuple(ctx, p.featureTuple(module)); err != nil {
+			return status, err
+		}
+	}
+
+	switch status {
+	case LicenseStatusGracePeriod:
+		logger.Warn().Time("expires_at", p.license.ExpiresAt).Dur("grace_period", p.config.GracePeriod).
+			Msg("license has expired and modules will be disabled when the grace period ends, install a renewed license file")
+	case LicenseStatusExpired, LicenseStatusNotYetValid:
+		logger.Error().Msg("license does not grant its modules, they have been disabled")
+	default:
+		logger.Debug().Strs("modules", grant).Msg("license modules granted")
+	}
+
+	return status, nil
+}
+
+// Reload verifies the license file again so a renewed license can be installed without a restart, the
+// previously loaded license is kept when the file cannot be verified
+func (p *LicenseProvider) Reload(ctx context.Context) error {
+	l, err := LoadLicenseFile(p.config.File, p.key)
+	if err != nil {
+		logx.FromContext(ctx).Error().Err(err).Str("file", p.config.File).Msg("failed to reload license file, keeping the loaded license")
+
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if l.OrganizationID != p.license.OrganizationID {
+		// a license bound to another organization revokes the modules enabled for the previous one
+		enabled, err := p.checker.ListFeatures(ctx, p.license.OrganizationID)
+		if err != nil {
+			return err
+		}
+
+		for _, module := range enabled {
+			if err := p.checker.DeleteFeatureTuple(ctx, p.featureTuple(module)); err != nil {
+				return err
+			}
+		}
+	}
+
+	p.license = l
+
+	return nil
+}
+
+// featureTuple returns the feature tuple enabling the module for the licensed organization
+func (p *LicenseProvider) featureTuple(module string) FeatureTuple {
+	return FeatureTuple{
+		UserID:  p.license.OrganizationID,
+		Feature: module,
+	}
+}
@@ -0,0 +1,238 @@
+package entitlements
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// recordingFGA records the feature tuples written and removed
+type recordingFGA struct {
+	enabled map[string]bool
+}
+
+func (r *recordingFGA) CheckTuple(_ context.Context, tuple FeatureTuple) (bool, error) {
+	return r.enabled[tuple.UserID+"#"+tuple.Feature], nil
+}
+
+func (r *recordingFGA) CreateTuple(_ context.Context, tuple FeatureTuple) error {
+	r.enabled[tuple.UserID+"#"+tuple.Feature] = true
+
+	return nil
+}
+
+func (r *recordingFGA) DeleteTuple(_ context.Context, tuple FeatureTuple) error {
+	delete(r.enabled, tuple.UserID+"#"+tuple.Feature)
+
+	return nil
+}
+
+func (r *recordingFGA) ListFeatures(_ context.Context, userID string) ([]string, error) {
+	features := []string{}
+
+	for key := range r.enabled {
+		if user, feature, ok := strings.Cut(key, "#"); ok && user == userID {
+			features = append(features, feature)
+		}
+	}
+
+	return features, nil
+}
+
+func testLicense(now time.Time) License {
+	return License{
+		ID:             "lic_123",
+		Licensee:       "Meow Corp",
+		OrganizationID: "org_123",
+		Modules:        []string{"base_module", "compliance_module"},
+		Seats:          25,
+		IssuedAt:       now.Add(-24 * time.Hour),
+		ExpiresAt:      now.Add(24 * time.Hour),
+	}
+}
+
+func writeLicenseFile(t *testing.T, path string, l License, key ed25519.PrivateKey) {
+	t.Helper()
+
+	data, err := SignLicense(l, key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+func TestParseLicense(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	otherPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Second)
+
+	data, err := SignLicense(testLicense(now), priv)
+	require.NoError(t, err)
+
+	t.Run("valid", func(t *testing.T) {
+		l, err := ParseLicense(data, pub)
+		require.NoError(t, err)
+		assert.Equal(t, testLicense(now), *l)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := ParseLicense(data, otherPub)
+		assert.ErrorIs(t, err, ErrLicenseSignatureInvalid)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		var signed SignedLicense
+		require.NoError(t, json.Unmarshal(data, &signed))
+
+		tampered := testLicense(now)
+		tampered.Seats = 1000
+
+		payload, err := json.Marshal(tampered)
+		require.NoError(t, err)
+
+		signed.Payload = base64.StdEncoding.EncodeToString(payload)
+
+		modified, err := json.Marshal(signed)
+		require.NoError(t, err)
+
+		_, err = ParseLicense(modified, pub)
+		assert.ErrorIs(t, err, ErrLicenseSignatureInvalid)
+	})
+
+	t.Run("malformed", func(t *testing.T) {
+		_, err := ParseLicense([]byte("not a license"), pub)
+		assert.ErrorIs(t, err, ErrLicenseMalformed)
+	})
+
+	t.Run("missing organization", func(t *testing.T) {
+		l := testLicense(now)
+		l.OrganizationID = ""
+
+		unbound, err := SignLicense(l, priv)
+		require.NoError(t, err)
+
+		_, err = ParseLicense(unbound, pub)
+		assert.ErrorIs(t, err, ErrLicenseFieldRequired)
+	})
+}
+
+func TestParseLicensePublicKey(t *testing.T) {
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	key, err := ParseLicensePublicKey(base64.StdEncoding.EncodeToString(pub))
+	require.NoError(t, err)
+	assert.Equal(t, pub, key)
+
+	_, err = ParseLicensePublicKey("c2hvcnQ=")
+	assert.ErrorIs(t, err, ErrLicensePublicKeyInvalid)
+}
+
+func TestLicenseStatus(t *testing.T) {
+	now := time.Now()
+	l := testLicense(now)
+	grace := 48 * time.Hour
+
+	assert.Equal(t, LicenseStatusNotYetValid, l.Status(now.Add(-48*time.Hour), grace))
+	assert.Equal(t, LicenseStatusValid, l.Status(now, grace))
+	assert.Equal(t, LicenseStatusGracePeriod, l.Status(now.Add(48*time.Hour), grace))
+	assert.Equal(t, LicenseStatusExpired, l.Status(now.Add(96*time.Hour), grace))
+
+	assert.True(t, LicenseStatusGracePeriod.Grants())
+	assert.False(t, LicenseStatusExpired.Grants())
+}
+
+func TestLicenseProvider(t *testing.T) {
+	ctx := context.Background()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Now()
+	path := filepath.Join(t.TempDir(), "license.json")
+	writeLicenseFile(t, path, testLicense(now), priv)
+
+	// a module enabled before the provider was created, such as by a previous license, is revoked on apply
+	fga := &recordingFGA{enabled: map[string]bool{"org_123#legacy_module": true}}
+	checker := NewTupleChecker(WithRedisClient(newTestRedis(t)), WithFGAClient(fga))
+
+	p, err := NewLicenseProvider(LicenseConfig{
+		File:        path,
+		PublicKey:   base64.StdEncoding.EncodeToString(pub),
+		GracePeriod: 48 * time.Hour,
+	}, checker)
+	require.NoError(t, err)
+
+	status, err := p.Apply(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, LicenseStatusValid, status)
+	assert.Equal(t, map[string]bool{"org_123#base_module": true, "org_123#compliance_module": true}, fga.enabled)
+
+	seats, ok := p.SeatLimit("org_123", now)
+	assert.True(t, ok)
+	assert.Equal(t, int64(25), seats)
+
+	_, ok = p.SeatLimit("org_456", now)
+	assert.False(t, ok)
+
+	// a renewed license dropping a module revokes it
+	renewed := testLicense(now)
+	renewed.Modules = []string{"base_module"}
+	renewed.ExpiresAt = now.Add(365 * 24 * time.Hour)
+	writeLicenseFile(t, path, renewed, priv)
+
+	require.NoError(t, p.Reload(ctx))
+
+	_, err = p.Apply(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]bool{"org_123#base_module": true}, fga.enabled)
+
+	// within the grace period the modules are kept
+	status, err = p.Apply(ctx, renewed.ExpiresAt.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, LicenseStatusGracePeriod, status)
+	assert.Len(t, fga.enabled, 1)
+
+	// past the grace period they are revoked
+	status, err = p.Apply(ctx, renewed.ExpiresAt.Add(72*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, LicenseStatusExpired, status)
+	assert.Empty(t, fga.enabled)
+
+	_, ok = p.SeatLimit("org_123", renewed.ExpiresAt.Add(72*time.Hour))
+	assert.False(t, ok)
+}
+
+func TestLicenseProviderInvalidFileKeepsLicense(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	now := time.Now()
+	path := filepath.Join(t.TempDir(), "license.json")
+	writeLicenseFile(t, path, testLicense(now), priv)
+
+	checker := NewTupleChecker(WithRedisClient(newTestRedis(t)), WithFGAClient(&recordingFGA{enabled: map[string]bool{}}))
+
+	p, err := NewLicenseProvider(LicenseConfig{File: path, PublicKey: base64.StdEncoding.EncodeToString(pub)}, checker)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(path, []byte("{}"), 0o600))
+
+	assert.Error(t, p.Reload(context.Background()))
+	assert.Equal(t, "lic_123", p.License().ID)
+
+	_, err = NewLicenseProvider(LicenseConfig{}, checker)
+	assert.ErrorIs(t, err, ErrLicenseNotConfigured)
+}
@@ -25,6 +25,7 @@ type FGAClient interface {
 	CheckTuple(ctx context.Context, tuple FeatureTuple) (bool, error)
 	CreateTuple(ctx context.Context, tuple FeatureTuple) error
 	DeleteTuple(ctx context.Context, tuple FeatureTuple) error
+	ListFeatures(ctx context.Context, userID string) ([]string, error)
 }
 
 // FeatureTuple represents a generic tuple for feature checks
@@ -136,6 +137,16 @@ func (tc *TupleChecker) DeleteFeatureTuple(ctx context.Context, tuple FeatureTup
 	return nil
 }
 
+// ListFeatures returns the features enabled for the user in FGA; the cache only holds individual checks so the
+// list is always read from FGA
+func (tc *TupleChecker) ListFeatures(ctx context.Context, userID string) ([]string, error) {
+	if tc.fgaChecker == nil {
+		return nil, ErrTupleCheckerNotConfigured
+	}
+
+	return tc.fgaChecker.ListFeatures(ctx, userID)
+}
+
 func (tc *TupleChecker) cacheKey(tuple FeatureTuple) string {
 	ctxBytes, _ := json.Marshal(tuple.Context)
 
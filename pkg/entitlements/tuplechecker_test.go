@@ -27,6 +27,9 @@ func (m *mockFGA) CreateTuple(ctx context.Context, tuple FeatureTuple) error {
 func (m *mockFGA) DeleteTuple(ctx context.Context, tuple FeatureTuple) error {
 	return m.deleteErr
 }
+func (m *mockFGA) ListFeatures(ctx context.Context, userID string) ([]string, error) {
+	return nil, nil
+}
 
 // newTestRedis returns a redis.Client backed by a fresh miniredis instance for each test.
 func newTestRedis(t *testing.T) *redis.Client {
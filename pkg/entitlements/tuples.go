@@ -52,6 +52,64 @@ func CreateFeatureTuples(ctx context.Context, authz *fgax.Client, orgID string,
 	return nil
 }
 
+// FGAFeatureClient is the FGAClient writing organization feature tuples to the authorization service
+type FGAFeatureClient struct {
+	authz *fgax.Client
+}
+
+// NewFGAFeatureClient returns an FGAClient for the feature tuples of organizations, the user of a
+// FeatureTuple is the organization ID
+func NewFGAFeatureClient(authz *fgax.Client) *FGAFeatureClient {
+	return &FGAFeatureClient{authz: authz}
+}
+
+// CheckTuple reports whether the organization has the feature enabled
+func (c *FGAFeatureClient) CheckTuple(ctx context.Context, tuple FeatureTuple) (bool, error) {
+	return c.authz.CheckAccess(ctx, fgax.AccessCheck{
+		SubjectID:   tuple.UserID,
+		SubjectType: subjectType,
+		ObjectID:    tuple.Feature,
+		ObjectType:  TupleObjectType,
+		Relation:    TupleRelation,
+	})
+}
+
+// CreateTuple enables the feature for the organization
+func (c *FGAFeatureClient) CreateTuple(ctx context.Context, tuple FeatureTuple) error {
+	return CreateFeatureTuples(ctx, c.authz, tuple.UserID, []models.OrgModule{models.OrgModule(tuple.Feature)})
+}
+
+// DeleteTuple removes the feature from the organization
+func (c *FGAFeatureClient) DeleteTuple(ctx context.Context, tuple FeatureTuple) error {
+	return DeleteModuleTuple(ctx, c.authz, tuple.UserID, tuple.Feature)
+}
+
+// ListFeatures returns the features enabled for the organization
+func (c *FGAFeatureClient) ListFeatures(ctx context.Context, orgID string) ([]string, error) {
+	resp, err := c.authz.ListObjectsRequest(ctx, fgax.ListRequest{
+		SubjectID:   orgID,
+		SubjectType: subjectType,
+		ObjectType:  TupleObjectType,
+		Relation:    TupleRelation,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	features := make([]string, 0, len(resp.Objects))
+
+	for _, obj := range resp.Objects {
+		entity, err := fgax.ParseEntity(obj)
+		if err != nil {
+			continue
+		}
+
+		features = append(features, entity.Identifier)
+	}
+
+	return features, nil
+}
+
 func getFeatureTupleKey(orgID, module string) fgax.TupleKey {
 	tuple := baseTupleRequest
 
@@ -0,0 +1,52 @@
+package entitlements
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/stripe/stripe-go/v86"
+)
+
+// MeterEvent is a usage measurement reported to a Stripe billing meter
+type MeterEvent struct {
+	// EventName is the event name of the billing meter
+	EventName string
+	// CustomerID is the Stripe customer the usage belongs to
+	CustomerID string
+	// Value is the measured usage
+	Value int64
+	// Identifier deduplicates the event, Stripe ignores events with an identifier it has already seen
+	Identifier string
+	// Timestamp is when the usage was measured
+	Timestamp time.Time
+}
+
+// ReportUsage sends a meter event to Stripe
+func (sc *StripeClient) ReportUsage(ctx context.Context, event MeterEvent) (*stripe.BillingMeterEvent, error) {
+	if event.CustomerID == "" {
+		return nil, ErrCustomerIDRequired
+	}
+
+	if event.EventName == "" {
+		return nil, ErrMeterEventNameRequired
+	}
+
+	params := &stripe.BillingMeterEventCreateParams{
+		EventName: stripe.String(event.EventName),
+		Payload: map[string]string{
+			"stripe_customer_id": event.CustomerID,
+			"value":              strconv.FormatInt(event.Value, 10),
+		},
+	}
+
+	if event.Identifier != "" {
+		params.Identifier = stripe.String(event.Identifier)
+	}
+
+	if !event.Timestamp.IsZero() {
+		params.Timestamp = stripe.Int64(event.Timestamp.Unix())
+	}
+
+	return sc.Client.V1BillingMeterEvents.Create(ctx, params)
+}
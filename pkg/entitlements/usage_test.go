@@ -0,0 +1,61 @@
+package entitlements_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/stripe/stripe-go/v86"
+
+	"github.com/theopenlane/core/pkg/entitlements"
+	"github.com/theopenlane/core/pkg/entitlements/mocks"
+)
+
+func TestReportUsage(t *testing.T) {
+	backend := new(mocks.MockStripeBackend)
+	backends := &stripe.Backends{API: backend, Connect: backend, Uploads: backend}
+
+	var sent *stripe.BillingMeterEventCreateParams
+
+	backend.On("Call", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			sent, _ = args.Get(3).(*stripe.BillingMeterEventCreateParams)
+
+			if v, ok := args.Get(4).(*stripe.BillingMeterEvent); ok {
+				v.EventName = "storage_bytes"
+			}
+		}).Return(nil)
+
+	sc := entitlements.StripeClient{Client: stripe.NewClient("sk_test", stripe.WithBackends(backends))}
+
+	now := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	event, err := sc.ReportUsage(context.Background(), entitlements.MeterEvent{
+		EventName:  "storage_bytes",
+		CustomerID: "cus_123",
+		Value:      2048,
+		Identifier: "org1-storage_bytes-2026-03-01",
+		Timestamp:  now,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "storage_bytes", event.EventName)
+
+	require.NotNil(t, sent)
+	assert.Equal(t, "cus_123", sent.Payload["stripe_customer_id"])
+	assert.Equal(t, "2048", sent.Payload["value"])
+	assert.Equal(t, "org1-storage_bytes-2026-03-01", *sent.Identifier)
+	assert.Equal(t, now.Unix(), *sent.Timestamp)
+}
+
+func TestReportUsageValidation(t *testing.T) {
+	sc := entitlements.StripeClient{}
+
+	_, err := sc.ReportUsage(context.Background(), entitlements.MeterEvent{EventName: "seats"})
+	assert.ErrorIs(t, err, entitlements.ErrCustomerIDRequired)
+
+	_, err = sc.ReportUsage(context.Background(), entitlements.MeterEvent{CustomerID: "cus_123"})
+	assert.ErrorIs(t, err, entitlements.ErrMeterEventNameRequired)
+}
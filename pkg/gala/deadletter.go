@@ -0,0 +1,264 @@
+package gala
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/riverqueue/river/rivertype"
+	"github.com/samber/lo"
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// DeadLetter is an envelope whose durable dispatch job failed and will not run again, because it exhausted its
+// retries or was cancelled
+type DeadLetter struct {
+	// ID is the dead letter identifier
+	ID string `json:"id"`
+	// EventID is the identifier of the dead-lettered event
+	EventID EventID `json:"event_id"`
+	// Topic is the topic the event was emitted on
+	Topic TopicName `json:"topic"`
+	// Listener is the definition name of the listener whose failure exhausted the retries; empty when the
+	// envelope failed before reaching a listener
+	Listener string `json:"listener,omitempty"`
+	// Kind is the job kind the envelope dispatched under
+	Kind string `json:"kind,omitempty"`
+	// Queue is the River queue the job ran on
+	Queue string `json:"queue"`
+	// JobID is the River job that was discarded
+	JobID int64 `json:"job_id"`
+	// Attempts is the number of attempts made before the envelope was dead-lettered
+	Attempts int `json:"attempts"`
+	// Envelope is the envelope as emitted, including the payload and the context snapshot
+	Envelope Envelope `json:"envelope"`
+	// Errors is the error of every attempt, oldest first
+	Errors []DeadLetterError `json:"errors"`
+	// FailedAt is when the final attempt failed
+	FailedAt time.Time `json:"failed_at"`
+}
+
+// DeadLetterError is the error of one attempt of a dead-lettered envelope
+type DeadLetterError struct {
+	// Attempt is the attempt number, starting at one
+	Attempt int `json:"attempt"`
+	// At is when the attempt failed
+	At time.Time `json:"at"`
+	// Error is the error message of the attempt
+	Error string `json:"error"`
+}
+
+// DeadLetterFilter selects dead letters; the set fields are combined
+type DeadLetterFilter struct {
+	// Topic restricts the selection to one topic
+	Topic TopicName
+	// Listener restricts the selection to the dead letters of one listener definition
+	Listener string
+	// IDs restricts the selection to the given dead letters
+	IDs []string
+	// All selects every dead letter when no other field is set; discarding requires it to be explicit
+	All bool
+	// Limit caps the number of dead letters listed, zero means no limit
+	Limit int
+}
+
+// empty reports whether the filter selects nothing more specific than every dead letter
+func (f DeadLetterFilter) empty() bool {
+	return f.Topic == "" && f.Listener == "" && len(f.IDs) == 0
+}
+
+// DeadLetterStore persists dead-lettered envelopes
+type DeadLetterStore interface {
+	// Save stores a dead letter; saving another dead letter for the same job is a no-op
+	Save(context.Context, DeadLetter) error
+	// List returns the dead letters matching the filter, most recent first
+	List(context.Context, DeadLetterFilter) ([]DeadLetter, error)
+	// Get returns one dead letter or ErrDeadLetterNotFound
+	Get(context.Context, string) (DeadLetter, error)
+	// Delete removes the dead letters matching the filter and returns how many were removed
+	Delete(context.Context, DeadLetterFilter) (int, error)
+	// Depth returns the number of dead letters per topic
+	Depth(context.Context) (map[TopicName]int, error)
+}
+
+// newDeadLetter builds the dead letter of a job whose last attempt failed with err
+func newDeadLetter(job *rivertype.JobRow, envelope Envelope, err error, now time.Time) DeadLetter {
+	errs := make([]DeadLetterError, 0, len(job.Errors)+1)
+	for _, attemptErr := range job.Errors {
+		errs = append(errs, DeadLetterError{Attempt: attemptErr.Attempt, At: attemptErr.At, Error: attemptErr.Error})
+	}
+
+	// River records the error of the running attempt only after Work returns
+	errs = append(errs, DeadLetterError{Attempt: job.Attempt, At: now, Error: err.Error()})
+
+	letter := DeadLetter{
+		ID:       string(NewEventID()),
+		EventID:  envelope.ID,
+		Topic:    envelope.Topic,
+		Kind:     envelope.Headers.Kind,
+		Queue:    job.Queue,
+		JobID:    job.ID,
+		Attempts: job.Attempt,
+		Envelope: envelope,
+		Errors:   errs,
+		FailedAt: now,
+	}
+
+	var listenerErr ListenerError
+	if errors.As(err, &listenerErr) {
+		letter.Listener, _, _ = strings.Cut(listenerErr.ListenerName, "#")
+	}
+
+	return letter
+}
+
+// deadLetter stores the envelope of a job that will not run again. Storage failures are logged so the job still
+// fails with the listener error
+func (g *Gala) deadLetter(ctx context.Context, job *rivertype.JobRow, envelope Envelope, err error) {
+	if g.deadLetters == nil {
+		return
+	}
+
+	// the job context may have timed out or been cancelled, which is exactly when the envelope must not be lost
+	ctx = context.WithoutCancel(ctx)
+
+	letter := newDeadLetter(job, envelope, err, time.Now().UTC())
+
+	if saveErr := g.deadLetters.Save(ctx, letter); saveErr != nil {
+		logx.FromContext(ctx).Error().Err(saveErr).Int64("job_id", job.ID).Str("event_id", string(envelope.ID)).Str("topic", string(envelope.Topic)).Msg("gala: failed to store dead letter")
+
+		return
+	}
+
+	deadLettersTotal.WithLabelValues(string(letter.Topic), letter.Listener).Inc()
+
+	logx.FromContext(ctx).Warn().Str("dead_letter_id", letter.ID).Int64("job_id", job.ID).Str("event_id", string(envelope.ID)).Str("topic", string(envelope.Topic)).Str("listener", letter.Listener).Msg("gala: envelope dead-lettered")
+
+	g.refreshDeadLetterDepth(ctx)
+}
+
+// deadLetterStore returns the configured dead letter store or ErrDeadLettersDisabled
+func (g *Gala) deadLetterStore() (DeadLetterStore, error) {
+	if g.deadLetters == nil {
+		return nil, ErrDeadLettersDisabled
+	}
+
+	return g.deadLetters, nil
+}
+
+// ListDeadLetters returns the dead letters matching the filter, most recent first
+func (g *Gala) ListDeadLetters(ctx context.Context, filter DeadLetterFilter) ([]DeadLetter, error) {
+	store, err := g.deadLetterStore()
+	if err != nil {
+		return nil, err
+	}
+
+	return store.List(ctx, filter)
+}
+
+// ReplayDeadLetter dispatches the envelope of a dead letter again and removes the dead letter. When listener is
+// set only that listener definition receives the envelope, so the listeners that already handled it are not
+// invoked twice; otherwise every listener on the topic does
+func (g *Gala) ReplayDeadLetter(ctx context.Context, id, listener string) (DeadLetter, error) {
+	store, err := g.deadLetterStore()
+	if err != nil {
+		return DeadLetter{}, err
+	}
+
+	letter, err := store.Get(ctx, id)
+	if err != nil {
+		return DeadLetter{}, err
+	}
+
+	envelope := letter.Envelope
+
+	if listener != "" && !g.listenerKnown(envelope, listener) {
+		return DeadLetter{}, fmt.Errorf("%w: %q on topic %q", ErrDeadLetterListenerUnknown, listener, envelope.Topic)
+	}
+
+	// the original job holds the unique key in a terminal state and the schedule has long passed
+	envelope.Headers.TargetListener = listener
+	envelope.Headers.UniqueKey = ""
+	envelope.Headers.UniqueOnce = false
+	envelope.Headers.ScheduledAt = nil
+
+	if err := g.dispatch(ctx, envelope); err != nil {
+		return DeadLetter{}, err
+	}
+
+	if _, err := store.Delete(ctx, DeadLetterFilter{IDs: []string{letter.ID}}); err != nil {
+		return DeadLetter{}, err
+	}
+
+	g.refreshDeadLetterDepth(ctx)
+
+	return letter, nil
+}
+
+// DiscardDeadLetters removes the dead letters matching the filter without dispatching them and returns how many
+// were removed; the filter must select something or set All
+func (g *Gala) DiscardDeadLetters(ctx context.Context, filter DeadLetterFilter) (int, error) {
+	store, err := g.deadLetterStore()
+	if err != nil {
+		return 0, err
+	}
+
+	if filter.empty() && !filter.All {
+		return 0, ErrDeadLetterFilterRequired
+	}
+
+	removed, err := store.Delete(ctx, filter)
+	if err != nil {
+		return removed, err
+	}
+
+	g.refreshDeadLetterDepth(ctx)
+
+	return removed, nil
+}
+
+// listenerKnown reports whether a listener definition received the envelope when it was emitted or is registered
+// on its topic; the recorded names let runtimes without registrations, like the admin commands, replay too
+func (g *Gala) listenerKnown(envelope Envelope, listener string) bool {
+	if slices.ContainsFunc(envelope.Headers.Listeners, func(name string) bool {
+		definitionName, _, _ := strings.Cut(name, "#")
+		return definitionName == listener
+	}) {
+		return true
+	}
+
+	topic := envelope.Topic
+	if _, err := g.registry.topicRegistration(topic); err != nil {
+		if renamed, ok := g.topicRenames[topic]; ok {
+			topic = renamed
+		}
+	}
+
+	return lo.ContainsBy(g.registry.registeredListeners(topic), func(registered registeredListener) bool {
+		return registered.definitionName == listener
+	})
+}
+
+// refreshDeadLetterDepth sets the depth gauge from the store; every replica reports the same shared depth
+func (g *Gala) refreshDeadLetterDepth(ctx context.Context) {
+	if g.deadLetters == nil {
+		return
+	}
+
+	depth, err := g.deadLetters.Depth(ctx)
+	if err != nil {
+		logx.FromContext(ctx).Warn().Err(err).Msg("gala: failed to read dead letter depth")
+
+		return
+	}
+
+	// reset so topics whose dead letters were all replayed or discarded stop reporting
+	deadLetterDepth.Reset()
+
+	for topic, count := range depth {
+		deadLetterDepth.WithLabelValues(string(topic)).Set(float64(count))
+	}
+}
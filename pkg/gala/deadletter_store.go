@@ -0,0 +1,218 @@
+package gala
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // add pgx driver
+)
+
+// deadLetterTable is the table the postgres store keeps dead letters in; it is created by the application
+// migrations
+const deadLetterTable = "gala_dead_letters"
+
+// deadLetterColumns are the columns read back into a DeadLetter, in scan order
+const deadLetterColumns = "id, event_id, topic, listener, kind, queue, job_id, attempts, envelope, errors, failed_at"
+
+// PostgresDeadLetterStore is a DeadLetterStore backed by a postgres table in the application database
+type PostgresDeadLetterStore struct {
+	db *sql.DB
+}
+
+// NewPostgresDeadLetterStore connects to the application database holding the dead letter table
+func NewPostgresDeadLetterStore(ctx context.Context, connectionURI string) (*PostgresDeadLetterStore, error) {
+	if connectionURI == "" {
+		return nil, fmt.Errorf("%w: connection URI is required", ErrDeadLetterStoreInitFailed)
+	}
+
+	db, err := sql.Open("pgx", connectionURI)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrDeadLetterStoreInitFailed, err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("%w: %w", ErrDeadLetterStoreInitFailed, err)
+	}
+
+	return &PostgresDeadLetterStore{db: db}, nil
+}
+
+// Save stores a dead letter; a second dead letter for the same job is ignored
+func (s *PostgresDeadLetterStore) Save(ctx context.Context, letter DeadLetter) error {
+	envelope, err := json.Marshal(letter.Envelope)
+	if err != nil {
+		return err
+	}
+
+	errs, err := json.Marshal(letter.Errors)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.db.ExecContext(ctx, `INSERT INTO `+deadLetterTable+` (`+deadLetterColumns+`)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (job_id) DO NOTHING`,
+		letter.ID, string(letter.EventID), string(letter.Topic), letter.Listener, letter.Kind, letter.Queue,
+		letter.JobID, letter.Attempts, string(envelope), string(errs), letter.FailedAt)
+
+	return err
+}
+
+// List returns the dead letters matching the filter, most recent first
+func (s *PostgresDeadLetterStore) List(ctx context.Context, filter DeadLetterFilter) ([]DeadLetter, error) {
+	where, args := deadLetterWhere(filter)
+
+	query := `SELECT ` + deadLetterColumns + ` FROM ` + deadLetterTable + where + ` ORDER BY failed_at DESC, id`
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var letters []DeadLetter
+
+	for rows.Next() {
+		letter, err := scanDeadLetter(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		letters = append(letters, letter)
+	}
+
+	return letters, rows.Err()
+}
+
+// Get returns one dead letter or ErrDeadLetterNotFound
+func (s *PostgresDeadLetterStore) Get(ctx context.Context, id string) (DeadLetter, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+deadLetterColumns+` FROM `+deadLetterTable+` WHERE id = $1`, id)
+
+	letter, err := scanDeadLetter(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return DeadLetter{}, fmt.Errorf("%w: %s", ErrDeadLetterNotFound, id)
+	}
+
+	return letter, err
+}
+
+// Delete removes the dead letters matching the filter and returns how many were removed
+func (s *PostgresDeadLetterStore) Delete(ctx context.Context, filter DeadLetterFilter) (int, error) {
+	where, args := deadLetterWhere(filter)
+
+	res, err := s.db.ExecContext(ctx, `DELETE FROM `+deadLetterTable+where, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	removed, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(removed), nil
+}
+
+// Depth returns the number of dead letters per topic
+func (s *PostgresDeadLetterStore) Depth(ctx context.Context) (map[TopicName]int, error) {
+	rows, err := s.db.QueryContext(ctx, `SELECT topic, count(*) FROM `+deadLetterTable+` GROUP BY topic`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	depth := map[TopicName]int{}
+
+	for rows.Next() {
+		var (
+			topic string
+			count int
+		)
+
+		if err := rows.Scan(&topic, &count); err != nil {
+			return nil, err
+		}
+
+		depth[TopicName(topic)] = count
+	}
+
+	return depth, rows.Err()
+}
+
+// Close closes the database connection of the store
+func (s *PostgresDeadLetterStore) Close() error {
+	return s.db.Close()
+}
+
+// deadLetterWhere builds the where clause and arguments selecting the dead letters matching the filter
+func deadLetterWhere(filter DeadLetterFilter) (string, []any) {
+	var (
+		conds []string
+		args  []any
+	)
+
+	add := func(cond string, arg any) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+
+	if filter.Topic != "" {
+		add("topic = $%d", string(filter.Topic))
+	}
+
+	if filter.Listener != "" {
+		add("listener = $%d", filter.Listener)
+	}
+
+	if len(filter.IDs) > 0 {
+		add("id = ANY($%d)", filter.IDs)
+	}
+
+	if len(conds) == 0 {
+		return "", nil
+	}
+
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// rowScanner is the scan method shared by sql.Row and sql.Rows
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanDeadLetter reads one dead letter selected with deadLetterColumns
+func scanDeadLetter(row rowScanner) (DeadLetter, error) {
+	var (
+		letter         DeadLetter
+		eventID, topic string
+		envelope, errs []byte
+	)
+
+	if err := row.Scan(&letter.ID, &eventID, &topic, &letter.Listener, &letter.Kind, &letter.Queue, &letter.JobID,
+		&letter.Attempts, &envelope, &errs, &letter.FailedAt); err != nil {
+		return DeadLetter{}, err
+	}
+
+	letter.EventID = EventID(eventID)
+	letter.Topic = TopicName(topic)
+
+	if err := json.Unmarshal(envelope, &letter.Envelope); err != nil {
+		return DeadLetter{}, fmt.Errorf("%w: %w", ErrRiverEnvelopeDecodeFailed, err)
+	}
+
+	if err := json.Unmarshal(errs, &letter.Errors); err != nil {
+		return DeadLetter{}, err
+	}
+
+	return letter, nil
+}
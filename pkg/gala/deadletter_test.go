@@ -0,0 +1,450 @@
+package gala
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+)
+
+// memoryDeadLetterStore is an in-memory DeadLetterStore for unit tests
+type memoryDeadLetterStore struct {
+	mu      sync.Mutex
+	letters []DeadLetter
+}
+
+func (s *memoryDeadLetterStore) Save(_ context.Context, letter DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if slices.ContainsFunc(s.letters, func(stored DeadLetter) bool { return stored.JobID == letter.JobID }) {
+		return nil
+	}
+
+	s.letters = append(s.letters, letter)
+
+	return nil
+}
+
+func (s *memoryDeadLetterStore) List(_ context.Context, filter DeadLetterFilter) ([]DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var out []DeadLetter
+
+	for _, letter := range s.letters {
+		if memoryDeadLetterMatches(letter, filter) {
+			out = append(out, letter)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *memoryDeadLetterStore) Get(_ context.Context, id string) (DeadLetter, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, letter := range s.letters {
+		if letter.ID == id {
+			return letter, nil
+		}
+	}
+
+	return DeadLetter{}, ErrDeadLetterNotFound
+}
+
+func (s *memoryDeadLetterStore) Delete(_ context.Context, filter DeadLetterFilter) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := len(s.letters)
+	s.letters = slices.DeleteFunc(s.letters, func(letter DeadLetter) bool {
+		return memoryDeadLetterMatches(letter, filter)
+	})
+
+	return before - len(s.letters), nil
+}
+
+func (s *memoryDeadLetterStore) Depth(context.Context) (map[TopicName]int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	depth := map[TopicName]int{}
+	for _, letter := range s.letters {
+		depth[letter.Topic]++
+	}
+
+	return depth, nil
+}
+
+func memoryDeadLetterMatches(letter DeadLetter, filter DeadLetterFilter) bool {
+	return (filter.Topic == "" || letter.Topic == filter.Topic) &&
+		(filter.Listener == "" || letter.Listener == filter.Listener) &&
+		(len(filter.IDs) == 0 || slices.Contains(filter.IDs, letter.ID))
+}
+
+// deadLetterTestRuntime registers a topic with a succeeding and a failing listener on a runtime with an
+// in-memory dead letter store, returning the invocation counts of both listeners
+func deadLetterTestRuntime(t *testing.T, client riverInsertClient, failWith error) (*Gala, *memoryDeadLetterStore, Topic[runtimeTestPayload], *int, *int) {
+	t.Helper()
+
+	runtime := newTestGala(t, client)
+	store := &memoryDeadLetterStore{}
+	runtime.deadLetters = store
+
+	topic := Topic[runtimeTestPayload]{Name: TopicName("runtime.test.deadletter")}
+	if err := registerTopic(runtime.registry, topic); err != nil {
+		t.Fatalf("failed to register topic: %v", err)
+	}
+
+	var healthyCalls, failingCalls int
+
+	if _, err := attachListener(runtime, Definition[runtimeTestPayload]{
+		Topic: topic,
+		Name:  "healthy.listener",
+		Handle: func(HandlerContext, runtimeTestPayload) error {
+			healthyCalls++
+			return nil
+		},
+	}); err != nil {
+		t.Fatalf("failed to register listener: %v", err)
+	}
+
+	if _, err := attachListener(runtime, Definition[runtimeTestPayload]{
+		Topic: topic,
+		Name:  "failing.listener",
+		Handle: func(HandlerContext, runtimeTestPayload) error {
+			failingCalls++
+			return failWith
+		},
+	}); err != nil {
+		t.Fatalf("failed to register listener: %v", err)
+	}
+
+	return runtime, store, topic, &healthyCalls, &failingCalls
+}
+
+// deadLetterHandlerRuntime builds a runtime with a dead letter store and one listener running handle
+func deadLetterHandlerRuntime(t *testing.T, handle func(HandlerContext, runtimeTestPayload) error) (*Gala, *memoryDeadLetterStore, Topic[runtimeTestPayload]) {
+	t.Helper()
+
+	runtime := newTestGala(t, nil)
+	store := &memoryDeadLetterStore{}
+	runtime.deadLetters = store
+
+	topic := Topic[runtimeTestPayload]{Name: TopicName("runtime.test.deadletter.handler")}
+	if err := registerTopic(runtime.registry, topic); err != nil {
+		t.Fatalf("failed to register topic: %v", err)
+	}
+
+	if _, err := attachListener(runtime, Definition[runtimeTestPayload]{
+		Topic:  topic,
+		Name:   "handler.listener",
+		Handle: handle,
+	}); err != nil {
+		t.Fatalf("failed to register listener: %v", err)
+	}
+
+	return runtime, store, topic
+}
+
+// deadLetterTestJob builds a dispatch job for the envelope at the given attempt of three
+func deadLetterTestJob(t *testing.T, envelope Envelope, attempt int) *river.Job[EnvelopeArgs] {
+	t.Helper()
+
+	args, err := newRiverDispatchArgs(envelope)
+	if err != nil {
+		t.Fatalf("failed to build dispatch args: %v", err)
+	}
+
+	var errs []rivertype.AttemptError
+	for i := 1; i < attempt; i++ {
+		errs = append(errs, rivertype.AttemptError{Attempt: i, At: time.Now(), Error: "earlier failure"})
+	}
+
+	return &river.Job[EnvelopeArgs]{
+		JobRow: &rivertype.JobRow{ID: 42, Attempt: attempt, MaxAttempts: 3, Queue: DefaultQueueName, Errors: errs},
+		Args:   args,
+	}
+}
+
+// deadLetterTestEnvelope builds an envelope for the dead letter test topic
+func deadLetterTestEnvelope(t *testing.T, runtime *Gala, topic TopicName) Envelope {
+	t.Helper()
+
+	payload, err := json.Marshal(runtimeTestPayload{Message: "dead"})
+	if err != nil {
+		t.Fatalf("failed to encode payload: %v", err)
+	}
+
+	return Envelope{
+		ID:              NewEventID(),
+		Topic:           topic,
+		Headers:         Headers{UniqueKey: "dead", UniqueOnce: true},
+		Payload:         payload,
+		ContextSnapshot: testCallerSnapshot(t, runtime),
+	}
+}
+
+func TestWorkDeadLettersFinalAttempt(t *testing.T) {
+	listenerErr := errors.New("listener failed")
+	runtime, store, topic, _, _ := deadLetterTestRuntime(t, nil, listenerErr)
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	worker := newRiverDispatchWorker(func() *Gala { return runtime })
+
+	if err := worker.Work(context.Background(), deadLetterTestJob(t, envelope, 2)); !errors.Is(err, listenerErr) {
+		t.Fatalf("expected listener error, got %v", err)
+	}
+
+	if len(store.letters) != 0 {
+		t.Fatalf("expected no dead letter before the final attempt, got %d", len(store.letters))
+	}
+
+	if err := worker.Work(context.Background(), deadLetterTestJob(t, envelope, 3)); !errors.Is(err, listenerErr) {
+		t.Fatalf("expected listener error, got %v", err)
+	}
+
+	if len(store.letters) != 1 {
+		t.Fatalf("expected one dead letter, got %d", len(store.letters))
+	}
+
+	letter := store.letters[0]
+	if letter.Topic != topic.Name || letter.EventID != envelope.ID || letter.JobID != 42 || letter.Attempts != 3 {
+		t.Fatalf("unexpected dead letter identity: %+v", letter)
+	}
+
+	if letter.Listener != "failing.listener" {
+		t.Fatalf("expected failing listener definition name, got %q", letter.Listener)
+	}
+
+	if len(letter.Errors) != 3 || letter.Errors[2].Attempt != 3 || letter.Errors[2].Error == "earlier failure" {
+		t.Fatalf("expected the error of every attempt, got %+v", letter.Errors)
+	}
+
+	if string(letter.Envelope.Payload) != string(envelope.Payload) || len(letter.Envelope.ContextSnapshot.Values) == 0 {
+		t.Fatalf("expected the payload and context snapshot to be kept, got %+v", letter.Envelope)
+	}
+}
+
+func TestWorkDeadLettersCancelledJobs(t *testing.T) {
+	runtime, store, topic, _, _ := deadLetterTestRuntime(t, nil, river.JobCancel(errors.New("stop")))
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	worker := newRiverDispatchWorker(func() *Gala { return runtime })
+
+	// a cancelled job is not retried, so it is dead-lettered on any attempt
+	if err := worker.Work(context.Background(), deadLetterTestJob(t, envelope, 1)); err == nil {
+		t.Fatal("expected cancel error")
+	}
+
+	if len(store.letters) != 1 || store.letters[0].Listener != "failing.listener" {
+		t.Fatalf("expected the cancelled job to be dead-lettered, got %+v", store.letters)
+	}
+}
+
+func TestWorkDeadLettersRemotelyCancelledJobs(t *testing.T) {
+	runtime, store, topic := deadLetterHandlerRuntime(t, func(ctx HandlerContext, _ runtimeTestPayload) error {
+		<-ctx.Context.Done()
+
+		return ctx.Context.Err()
+	})
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	worker := newRiverDispatchWorker(func() *Gala { return runtime })
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	cancel(river.ErrJobCancelledRemotely)
+
+	if err := worker.Work(ctx, deadLetterTestJob(t, envelope, 1)); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context cancelled error, got %v", err)
+	}
+
+	if len(store.letters) != 1 {
+		t.Fatalf("expected the remotely cancelled job to be dead-lettered, got %d", len(store.letters))
+	}
+}
+
+func TestWorkDeadLettersTimedOutJobs(t *testing.T) {
+	runtime, store, topic := deadLetterHandlerRuntime(t, func(ctx HandlerContext, _ runtimeTestPayload) error {
+		<-ctx.Context.Done()
+
+		return ctx.Context.Err()
+	})
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	worker := newRiverDispatchWorker(func() *Gala { return runtime })
+
+	work := func(attempt int) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+
+		if err := worker.Work(ctx, deadLetterTestJob(t, envelope, attempt)); !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("expected deadline exceeded, got %v", err)
+		}
+	}
+
+	// a timed out attempt is retried like any other failure
+	work(1)
+
+	if len(store.letters) != 0 {
+		t.Fatalf("expected no dead letter before the final attempt, got %d", len(store.letters))
+	}
+
+	work(3)
+
+	if len(store.letters) != 1 || store.letters[0].Listener != "handler.listener" {
+		t.Fatalf("expected the timed out job to be dead-lettered, got %+v", store.letters)
+	}
+}
+
+func TestWorkDeadLettersListenerPanics(t *testing.T) {
+	runtime, store, topic := deadLetterHandlerRuntime(t, func(HandlerContext, runtimeTestPayload) error {
+		panic("listener exploded")
+	})
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	worker := newRiverDispatchWorker(func() *Gala { return runtime })
+
+	if err := worker.Work(context.Background(), deadLetterTestJob(t, envelope, 3)); !errors.Is(err, ErrListenerPanicked) {
+		t.Fatalf("expected listener panic error, got %v", err)
+	}
+
+	if len(store.letters) != 1 || store.letters[0].Listener != "handler.listener" {
+		t.Fatalf("expected the panicked job to be dead-lettered, got %+v", store.letters)
+	}
+}
+
+// deadLetterPanicPayload panics while it is decoded, before any listener runs
+type deadLetterPanicPayload struct{}
+
+func (*deadLetterPanicPayload) UnmarshalJSON([]byte) error {
+	panic("payload exploded")
+}
+
+func TestWorkDeadLettersDispatchPanics(t *testing.T) {
+	runtime := newTestGala(t, nil)
+	store := &memoryDeadLetterStore{}
+	runtime.deadLetters = store
+
+	topic := Topic[deadLetterPanicPayload]{Name: TopicName("runtime.test.deadletter.panic")}
+	if err := registerTopic(runtime.registry, topic); err != nil {
+		t.Fatalf("failed to register topic: %v", err)
+	}
+
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	worker := newRiverDispatchWorker(func() *Gala { return runtime })
+
+	if err := worker.Work(context.Background(), deadLetterTestJob(t, envelope, 3)); !errors.Is(err, ErrDispatchPanicked) {
+		t.Fatalf("expected dispatch panic error, got %v", err)
+	}
+
+	if len(store.letters) != 1 || store.letters[0].Listener != "" {
+		t.Fatalf("expected the panicked job to be dead-lettered without a listener, got %+v", store.letters)
+	}
+}
+
+func TestReplayDeadLetterTargetsListener(t *testing.T) {
+	dispatcher := &runtimeTestDispatcher{}
+	runtime, store, topic, healthyCalls, failingCalls := deadLetterTestRuntime(t, dispatcher, nil)
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	letter := newDeadLetter(&rivertype.JobRow{ID: 7, Attempt: 3}, envelope, ListenerError{ListenerName: "failing.listener#1"}, time.Now())
+	if err := store.Save(context.Background(), letter); err != nil {
+		t.Fatalf("failed to save dead letter: %v", err)
+	}
+
+	if _, err := runtime.ReplayDeadLetter(context.Background(), letter.ID, "unknown.listener"); !errors.Is(err, ErrDeadLetterListenerUnknown) {
+		t.Fatalf("expected unknown listener error, got %v", err)
+	}
+
+	if len(store.letters) != 1 {
+		t.Fatal("expected dead letter to be kept after a rejected replay")
+	}
+
+	if _, err := runtime.ReplayDeadLetter(context.Background(), letter.ID, "failing.listener"); err != nil {
+		t.Fatalf("failed to replay dead letter: %v", err)
+	}
+
+	if len(store.letters) != 0 {
+		t.Fatal("expected replayed dead letter to be removed")
+	}
+
+	if len(dispatcher.envelopes) != 1 {
+		t.Fatalf("expected one dispatched envelope, got %d", len(dispatcher.envelopes))
+	}
+
+	replayed := dispatcher.envelopes[0]
+	if replayed.Headers.TargetListener != "failing.listener" || replayed.Headers.UniqueKey != "" || replayed.Headers.UniqueOnce {
+		t.Fatalf("unexpected replay headers: %+v", replayed.Headers)
+	}
+
+	if err := runtime.dispatchEnvelope(context.Background(), replayed); err != nil {
+		t.Fatalf("failed to dispatch replayed envelope: %v", err)
+	}
+
+	if *healthyCalls != 0 || *failingCalls != 1 {
+		t.Fatalf("expected only the target listener to run, got healthy=%d failing=%d", *healthyCalls, *failingCalls)
+	}
+}
+
+func TestDiscardDeadLetters(t *testing.T) {
+	runtime, store, topic, _, _ := deadLetterTestRuntime(t, nil, nil)
+	envelope := deadLetterTestEnvelope(t, runtime, topic.Name)
+
+	for jobID := range int64(3) {
+		if err := store.Save(context.Background(), newDeadLetter(&rivertype.JobRow{ID: jobID, Attempt: 3}, envelope, errors.New("failed"), time.Now())); err != nil {
+			t.Fatalf("failed to save dead letter: %v", err)
+		}
+	}
+
+	if _, err := runtime.DiscardDeadLetters(context.Background(), DeadLetterFilter{}); !errors.Is(err, ErrDeadLetterFilterRequired) {
+		t.Fatalf("expected filter required error, got %v", err)
+	}
+
+	removed, err := runtime.DiscardDeadLetters(context.Background(), DeadLetterFilter{IDs: []string{store.letters[0].ID}})
+	if err != nil || removed != 1 {
+		t.Fatalf("expected one dead letter discarded, got %d (%v)", removed, err)
+	}
+
+	removed, err = runtime.DiscardDeadLetters(context.Background(), DeadLetterFilter{Topic: topic.Name})
+	if err != nil || removed != 2 {
+		t.Fatalf("expected the remaining dead letters discarded, got %d (%v)", removed, err)
+	}
+}
+
+func TestDeadLettersDisabled(t *testing.T) {
+	runtime := newTestGala(t, nil)
+
+	if _, err := runtime.ListDeadLetters(context.Background(), DeadLetterFilter{}); !errors.Is(err, ErrDeadLettersDisabled) {
+		t.Fatalf("expected dead letters disabled error, got %v", err)
+	}
+
+	if _, err := runtime.ReplayDeadLetter(context.Background(), "id", ""); !errors.Is(err, ErrDeadLettersDisabled) {
+		t.Fatalf("expected dead letters disabled error, got %v", err)
+	}
+}
+
+func TestDeadLetterWhere(t *testing.T) {
+	where, args := deadLetterWhere(DeadLetterFilter{})
+	if where != "" || len(args) != 0 {
+		t.Fatalf("expected no where clause, got %q %v", where, args)
+	}
+
+	where, args = deadLetterWhere(DeadLetterFilter{Topic: "topic", Listener: "listener", IDs: []string{"a", "b"}})
+	if where != " WHERE topic = $1 AND listener = $2 AND id = ANY($3)" {
+		t.Fatalf("unexpected where clause %q", where)
+	}
+
+	if len(args) != 3 || args[0] != "topic" || args[1] != "listener" {
+		t.Fatalf("unexpected arguments %v", args)
+	}
+}
@@ -3,6 +3,8 @@ package gala
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"slices"
 	"strings"
 	"time"
@@ -226,12 +228,40 @@ func decodeDispatchEnvelope(payload []byte) (Envelope, error) {
 	return envelope, nil
 }
 
-// Work processes one River dispatch job and invokes Gala dispatch
-func (w *riverDispatchWorker) Work(ctx context.Context, job *river.Job[EnvelopeArgs]) error {
+// Work processes one River dispatch job and invokes Gala dispatch, dead-lettering the envelope when the job
+// will not run again: the final attempt failed, timed out or panicked, or the job was cancelled
+func (w *riverDispatchWorker) Work(ctx context.Context, job *river.Job[EnvelopeArgs]) (err error) {
 	envelope, err := decodeDispatchEnvelope(job.Args.EnvelopePayload())
 	if err != nil {
 		return err
 	}
 
-	return w.galaProvider().dispatchEnvelope(ctx, envelope)
+	g := w.galaProvider()
+
+	defer func() {
+		// listener panics are already listener errors, this covers decoding and restoring the context
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v", ErrDispatchPanicked, recovered)
+		}
+
+		if err != nil && jobFinished(ctx, job.JobRow, err) {
+			g.deadLetter(ctx, job.JobRow, envelope, err)
+		}
+	}()
+
+	return g.dispatchEnvelope(ctx, envelope)
+}
+
+// jobFinished reports whether River does not retry a job whose attempt failed with err: the attempt was the last
+// one, a listener cancelled the job, or the job was cancelled remotely while it ran
+func jobFinished(ctx context.Context, job *rivertype.JobRow, err error) bool {
+	if job.Attempt >= job.MaxAttempts {
+		return true
+	}
+
+	if _, cancelled := errors.AsType[*river.JobCancelError](err); cancelled {
+		return true
+	}
+
+	return errors.Is(context.Cause(ctx), river.ErrJobCancelledRemotely)
 }
@@ -70,6 +70,26 @@ var (
 	ErrDispatchModeInvalid = errors.New("gala: dispatch mode is invalid")
 	// ErrListenerPanicked is returned when a listener panics during execution
 	ErrListenerPanicked = errors.New("gala: listener panicked")
+	// ErrDispatchPanicked is returned when a durable dispatch panics outside of a listener
+	ErrDispatchPanicked = errors.New("gala: dispatch panicked")
+	// ErrDeadLettersDisabled is returned when dead letters are used on a runtime without a dead letter store
+	ErrDeadLettersDisabled = errors.New("gala: dead letters are disabled")
+	// ErrDeadLetterStoreInitFailed is returned when the dead letter store cannot be set up
+	ErrDeadLetterStoreInitFailed = errors.New("gala: dead letter store initialization failed")
+	// ErrDeadLetterNotFound is returned when a dead letter does not exist
+	ErrDeadLetterNotFound = errors.New("gala: dead letter not found")
+	// ErrDeadLetterListenerUnknown is returned when a dead letter is replayed to a listener not registered on its topic
+	ErrDeadLetterListenerUnknown = errors.New("gala: dead letter replay listener is not registered on the topic")
+	// ErrDeadLetterFilterRequired is returned when discarding dead letters without selecting any or setting All
+	ErrDeadLetterFilterRequired = errors.New("gala: dead letter filter is required")
+	// ErrOutboxRequiresDurable is returned when an outbox is configured on an in-memory runtime
+	ErrOutboxRequiresDurable = errors.New("gala: outbox requires durable dispatch")
+	// ErrOutboxTxRequired is returned when an outbox is configured without a way to find the transaction of a context
+	ErrOutboxTxRequired = errors.New("gala: outbox requires a transaction resolver")
+	// ErrOutboxStoreInitFailed is returned when the outbox store cannot be set up
+	ErrOutboxStoreInitFailed = errors.New("gala: outbox store initialization failed")
+	// ErrOutboxWriteFailed is returned when an envelope cannot be written to the outbox
+	ErrOutboxWriteFailed = errors.New("gala: outbox write failed")
 )
 
 // ErrListenerGated signals a gated skip so executeListener suppresses delivery metrics
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"sync"
 	"time"
@@ -63,6 +64,19 @@ type Config struct {
 	// SoftStopTimeout is how long in-flight jobs may keep running after a stop begins
 	// before their contexts are cancelled
 	SoftStopTimeout time.Duration
+	// DeadLetterStore keeps the envelopes of jobs that exhausted their retries; nil leaves them as discarded
+	// River jobs. The runtime closes the store on Close when it implements io.Closer
+	DeadLetterStore DeadLetterStore
+	// Outbox holds envelopes emitted inside a database transaction until it commits, when the relay hands them to
+	// River at least once; nil dispatches every envelope at emit. The runtime closes the store on Close when it
+	// implements io.Closer
+	Outbox OutboxStore
+	// OutboxTx returns the database transaction of an emitting context; required with Outbox
+	OutboxTx OutboxTxFunc
+	// OutboxRelayInterval is the time between outbox relay passes (default 1s)
+	OutboxRelayInterval time.Duration
+	// OutboxRelayBatchSize is the number of envelopes one relay pass hands to River (default 100)
+	OutboxRelayBatchSize int
 }
 
 // Gala provides cohesive event dispatch + worker lifecycle management
@@ -94,6 +108,22 @@ type Gala struct {
 	operationRenames map[string]string
 	// inMemoryPool backs in-process dispatch when DispatchModeInMemory is enabled.
 	inMemoryPool *Pool
+	// deadLetters keeps the envelopes of durable jobs that exhausted their retries
+	deadLetters DeadLetterStore
+	// outbox holds envelopes emitted inside a database transaction until the relay hands them to River
+	outbox OutboxStore
+	// outboxTx returns the database transaction of an emitting context
+	outboxTx OutboxTxFunc
+	// outboxRelayInterval is the time between outbox relay passes
+	outboxRelayInterval time.Duration
+	// outboxRelayBatchSize is the number of envelopes one relay pass hands to River
+	outboxRelayBatchSize int
+	// outboxRelayMu guards starting and stopping the outbox relay
+	outboxRelayMu sync.Mutex
+	// outboxRelayCancel stops the running outbox relay, nil when the relay is not running
+	outboxRelayCancel context.CancelFunc
+	// outboxRelayDone is closed when the running outbox relay returns
+	outboxRelayDone chan struct{}
 }
 
 // NewGala initializes your gala, initializes dependencies, and starts workers
@@ -186,6 +216,13 @@ func NewGala(ctx context.Context, config Config) (app *Gala, err error) {
 	app.durableQueues = append([]string{config.QueueName}, lo.Values(kindQueues)...)
 	app.topicRenames = config.TopicRenames
 	app.operationRenames = config.OperationRenames
+	app.deadLetters = config.DeadLetterStore
+	app.outbox = config.Outbox
+	app.outboxTx = config.OutboxTx
+	app.outboxRelayInterval = config.OutboxRelayInterval
+	app.outboxRelayBatchSize = config.OutboxRelayBatchSize
+
+	app.refreshDeadLetterDepth(ctx)
 
 	return app, nil
 }
@@ -245,6 +282,24 @@ func (c *Config) validate() error {
 		c.SoftStopTimeout = DefaultSoftStopTimeout
 	}
 
+	if c.OutboxRelayInterval <= 0 {
+		c.OutboxRelayInterval = DefaultOutboxRelayInterval
+	}
+
+	if c.OutboxRelayBatchSize <= 0 {
+		c.OutboxRelayBatchSize = DefaultOutboxRelayBatchSize
+	}
+
+	if c.Outbox != nil {
+		if c.DispatchMode != DispatchModeDurable {
+			return ErrOutboxRequiresDurable
+		}
+
+		if c.OutboxTx == nil {
+			return ErrOutboxTxRequired
+		}
+	}
+
 	if c.DispatchMode == DispatchModeDurable && c.ConnectionURI == "" {
 		return ErrRiverConnectionURIRequired
 	}
@@ -401,6 +456,19 @@ func (g *Gala) EmitWithHeaders(ctx context.Context, topic TopicName, payload any
 
 	envelope.Headers.Listeners = g.registry.listenerNamesForTopic(topic)
 
+	written, err := g.writeOutbox(ctx, envelope)
+	if err != nil {
+		logx.FromContext(ctx).Debug().Err(err).Str("event_id", string(envelope.ID)).Str("topic", string(topic)).Msg("gala event outbox write failed")
+
+		return envelope.ID, errors.Join(ErrDispatchFailed, err)
+	}
+
+	if written {
+		logx.FromContext(ctx).Debug().Str("event_id", string(envelope.ID)).Str("topic", string(topic)).Msg("gala event written to outbox")
+
+		return envelope.ID, nil
+	}
+
 	if err := g.dispatch(ctx, envelope); err != nil {
 		logx.FromContext(ctx).Debug().Err(err).Str("event_id", string(envelope.ID)).Str("topic", string(topic)).Msg("gala event dispatch failed")
 
@@ -471,6 +539,10 @@ func (g *Gala) dispatchEnvelope(ctx context.Context, envelope Envelope) error {
 			continue
 		}
 
+		if target := envelope.Headers.TargetListener; target != "" && listener.definitionName != target {
+			continue
+		}
+
 		if err := g.executeListener(handlerContext, listener, decodedPayload, operation); err != nil {
 			// header properties are the only identity left when the emitting context carried no durable log fields
 			logx.FromContext(restoredContext).Warn().Err(err).Str("event_id", string(envelope.ID)).Str("topic", string(envelope.Topic)).Str("operation", operation).Str("listener", listener.name).Interface("envelope_properties", envelope.Headers.Properties).Msg("gala listener failed")
@@ -562,7 +634,7 @@ func (g *Gala) executeListener(handlerContext HandlerContext, listener registere
 	return nil
 }
 
-// StartWorkers starts Gala workers
+// StartWorkers starts Gala workers and the outbox relay
 func (g *Gala) StartWorkers(ctx context.Context) error {
 	if g.dispatchMode == DispatchModeInMemory {
 		return nil
@@ -572,15 +644,19 @@ func (g *Gala) StartWorkers(ctx context.Context) error {
 		return ErrRiverWorkerStartFailed
 	}
 
+	g.startOutboxRelay(ctx)
+
 	return nil
 }
 
-// StopWorkers stops Gala workers
+// StopWorkers stops the outbox relay and Gala workers
 func (g *Gala) StopWorkers(ctx context.Context) error {
 	if g.dispatchMode == DispatchModeInMemory {
 		return nil
 	}
 
+	g.stopOutboxRelay()
+
 	if err := g.jobClient.GetRiverClient().Stop(ctx); err != nil &&
 		!errors.Is(err, context.Canceled) &&
 		!errors.Is(err, context.DeadlineExceeded) {
@@ -830,6 +906,20 @@ func (g *Gala) Close() error {
 		g.inMemoryPool.Release()
 	}
 
+	if closer, ok := g.deadLetters.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			logx.FromContext(context.Background()).Warn().Err(err).Msg("gala: failed to close dead letter store")
+		}
+	}
+
+	g.stopOutboxRelay()
+
+	if closer, ok := g.outbox.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			logx.FromContext(context.Background()).Warn().Err(err).Msg("gala: failed to close outbox store")
+		}
+	}
+
 	if g.jobClient == nil {
 		return nil
 	}
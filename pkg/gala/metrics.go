@@ -41,3 +41,44 @@ var (
 		listenerMetricLabels,
 	)
 )
+
+// dead letter collectors, registered automatically by promauto
+var (
+	deadLetterDepth = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "gala_dead_letters",
+			Help: "Number of gala envelopes waiting in the dead letter store",
+		},
+		[]string{metricLabelTopic},
+	)
+	deadLettersTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gala_dead_letters_total",
+			Help: "Number of gala envelopes dead-lettered after exhausting their retries",
+		},
+		[]string{metricLabelTopic, metricLabelListener},
+	)
+)
+
+// outbox collectors, registered automatically by promauto
+var (
+	outboxPending = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Name: "gala_outbox_pending",
+			Help: "Number of committed gala envelopes waiting in the outbox for the relay",
+		},
+	)
+	outboxFailed = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "gala_outbox_failed_total",
+			Help: "Number of gala outbox rows marked failed because their envelope cannot be decoded",
+		},
+	)
+	outboxRelayed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "gala_outbox_relayed_total",
+			Help: "Number of gala envelopes relayed from the outbox to the job queue",
+		},
+		[]string{metricLabelTopic},
+	)
+)
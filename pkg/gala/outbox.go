@@ -0,0 +1,174 @@
+package gala
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/theopenlane/core/pkg/logx"
+)
+
+// DefaultOutboxRelayInterval is the default time between outbox relay passes
+const DefaultOutboxRelayInterval = time.Second
+
+// DefaultOutboxRelayBatchSize is the default number of envelopes one relay pass hands to the job queue
+const DefaultOutboxRelayBatchSize = 100
+
+// outboxUniqueKeyPrefix prefixes the unique key relayed envelopes without their own key are inserted under
+const outboxUniqueKeyPrefix = "gala_outbox:"
+
+// OutboxExecer executes a statement inside a database transaction; the drivers of ent transactions satisfy it
+type OutboxExecer interface {
+	// Exec executes the query with the arguments, scanning the result into v when it is not nil
+	Exec(ctx context.Context, query string, args, v any) error
+}
+
+// OutboxTxFunc returns the database transaction of the context, nil when the context has none
+type OutboxTxFunc func(context.Context) OutboxExecer
+
+// OutboxStore holds envelopes emitted inside a database transaction until the transaction commits
+type OutboxStore interface {
+	// Write stores the envelope through the transaction, so it only becomes visible when the transaction commits
+	Write(context.Context, OutboxExecer, Envelope) error
+	// Relay hands up to limit committed envelopes to relay, oldest first, removes the ones relay accepted and
+	// returns how many were removed; envelopes relay rejects stay for the next pass and envelopes that cannot be
+	// decoded are set aside as failed
+	Relay(ctx context.Context, limit int, relay func(context.Context, Envelope) error) (int, error)
+	// Pending returns the number of committed envelopes waiting for the relay
+	Pending(context.Context) (int, error)
+}
+
+// OutboxEnabled reports whether the runtime writes envelopes emitted inside a database transaction to its outbox
+func (g *Gala) OutboxEnabled() bool {
+	return g != nil && g.outbox != nil
+}
+
+// writeOutbox writes the envelope to the outbox when the runtime has one and the context carries a database
+// transaction, reporting whether it did; the relay dispatches the envelope once the transaction commits and the
+// envelope disappears with the transaction on rollback
+func (g *Gala) writeOutbox(ctx context.Context, envelope Envelope) (bool, error) {
+	if g.outbox == nil {
+		return false, nil
+	}
+
+	tx := g.outboxTx(ctx)
+	if tx == nil {
+		return false, nil
+	}
+
+	if err := g.outbox.Write(ctx, tx, envelope); err != nil {
+		// contexts outliving their transaction, like commit hooks, dispatch directly as they did without an outbox
+		if errors.Is(err, sql.ErrTxDone) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("%w: %w", ErrOutboxWriteFailed, err)
+	}
+
+	return true, nil
+}
+
+// startOutboxRelay starts relaying committed outbox envelopes to the job queue until the context ends or the
+// relay is stopped; starting a running relay is a no-op
+func (g *Gala) startOutboxRelay(ctx context.Context) {
+	if g.outbox == nil {
+		return
+	}
+
+	g.outboxRelayMu.Lock()
+	defer g.outboxRelayMu.Unlock()
+
+	if g.outboxRelayCancel != nil {
+		return
+	}
+
+	relayCtx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+
+	g.outboxRelayCancel = cancel
+	g.outboxRelayDone = done
+
+	go func() {
+		defer close(done)
+
+		g.runOutboxRelay(relayCtx)
+	}()
+}
+
+// stopOutboxRelay stops the relay and waits for the running pass to finish
+func (g *Gala) stopOutboxRelay() {
+	g.outboxRelayMu.Lock()
+	defer g.outboxRelayMu.Unlock()
+
+	if g.outboxRelayCancel == nil {
+		return
+	}
+
+	g.outboxRelayCancel()
+	<-g.outboxRelayDone
+
+	g.outboxRelayCancel = nil
+	g.outboxRelayDone = nil
+}
+
+// runOutboxRelay relays the outbox every interval, draining full batches back to back
+func (g *Gala) runOutboxRelay(ctx context.Context) {
+	ticker := time.NewTicker(g.outboxRelayInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil {
+				relayed, err := g.relayOutbox(ctx)
+				if err != nil && ctx.Err() == nil {
+					logx.FromContext(ctx).Warn().Err(err).Int("relayed", relayed).Msg("gala: outbox relay pass failed")
+				}
+
+				if err != nil || relayed < g.outboxRelayBatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// relayOutbox hands one batch of committed outbox envelopes to the job queue and returns how many were relayed
+func (g *Gala) relayOutbox(ctx context.Context) (int, error) {
+	relayed, err := g.outbox.Relay(ctx, g.outboxRelayBatchSize, g.relayEnvelope)
+
+	if pending, pendingErr := g.outbox.Pending(ctx); pendingErr == nil {
+		outboxPending.Set(float64(pending))
+	}
+
+	return relayed, err
+}
+
+// relayEnvelope inserts the dispatch job of a committed outbox envelope. The hand-off is at least once: a relay
+// that stops between inserting the job and removing the outbox row relays the envelope again. Envelopes without a
+// unique key of their own are keyed on the event, so the second insert is skipped as a duplicate; envelopes with
+// their own key are only deduplicated while the first job is live, so their listeners must tolerate a repeat
+func (g *Gala) relayEnvelope(ctx context.Context, envelope Envelope) error {
+	if envelope.Headers.UniqueKey == "" {
+		envelope.Headers.UniqueKey = outboxUniqueKeyPrefix + string(envelope.ID)
+		envelope.Headers.UniqueOnce = true
+	}
+
+	result, err := g.insertEnvelope(ctx, envelope)
+	if err != nil {
+		return err
+	}
+
+	// a skipped duplicate was handed over by an earlier pass, whatever became of its job since
+	if result.inserted && !dispatchHolderReady(result) {
+		return ErrRiverDispatchInsertFailed
+	}
+
+	outboxRelayed.WithLabelValues(string(envelope.Topic)).Inc()
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package gala
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	_ "github.com/jackc/pgx/v5/stdlib" // add pgx driver
+)
+
+// outboxTable is the table the postgres outbox keeps envelopes in; it lives in the application database so
+// envelopes are written in the transactions of the mutations emitting them, and is created by the application
+// migrations
+const outboxTable = "gala_outbox"
+
+// PostgresOutboxStore is an OutboxStore backed by a postgres table in the application database
+type PostgresOutboxStore struct {
+	db *sql.DB
+}
+
+// NewPostgresOutboxStore connects to the application database holding the outbox table
+func NewPostgresOutboxStore(ctx context.Context, connectionURI string) (*PostgresOutboxStore, error) {
+	if connectionURI == "" {
+		return nil, fmt.Errorf("%w: connection URI is required", ErrOutboxStoreInitFailed)
+	}
+
+	db, err := sql.Open("pgx", connectionURI)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrOutboxStoreInitFailed, err)
+	}
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+
+		return nil, fmt.Errorf("%w: %w", ErrOutboxStoreInitFailed, err)
+	}
+
+	return &PostgresOutboxStore{db: db}, nil
+}
+
+// Write stores the envelope through the transaction
+func (s *PostgresOutboxStore) Write(ctx context.Context, tx OutboxExecer, envelope Envelope) error {
+	raw, err := json.Marshal(envelope)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrRiverEnvelopeEncodeFailed, err)
+	}
+
+	return tx.Exec(ctx, `INSERT INTO `+outboxTable+` (event_id, topic, envelope) VALUES ($1, $2, $3)`,
+		[]any{string(envelope.ID), string(envelope.Topic), string(raw)}, nil)
+}
+
+// Relay hands up to limit committed envelopes to relay, removes the accepted ones and marks the undecodable ones
+// failed in one transaction; the selected rows stay locked until it commits and relays on other replicas skip them
+// for the next rows
+func (s *PostgresOutboxStore) Relay(ctx context.Context, limit int, relay func(context.Context, Envelope) error) (int, error) {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	defer func() { _ = tx.Rollback() }()
+
+	rows, err := tx.QueryContext(ctx, `SELECT id, envelope FROM `+outboxTable+` WHERE failed_at IS NULL ORDER BY id LIMIT $1 FOR UPDATE SKIP LOCKED`, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	var pending []outboxRow
+
+	for rows.Next() {
+		var row outboxRow
+		if err := rows.Scan(&row.id, &row.envelope); err != nil {
+			rows.Close()
+
+			return 0, err
+		}
+
+		pending = append(pending, row)
+	}
+
+	rows.Close()
+
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	result := relayOutboxRows(ctx, pending, relay)
+
+	if len(result.relayed) > 0 {
+		if _, err := tx.ExecContext(ctx, `DELETE FROM `+outboxTable+` WHERE id = ANY($1)`, result.relayed); err != nil {
+			return 0, err
+		}
+	}
+
+	for id, decodeErr := range result.failed {
+		if _, err := tx.ExecContext(ctx, `UPDATE `+outboxTable+` SET failed_at = now(), error = $2 WHERE id = $1`, id, decodeErr.Error()); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+
+	return len(result.relayed), errors.Join(result.errs...)
+}
+
+// outboxRow is an outbox row selected for relaying
+type outboxRow struct {
+	id       int64
+	envelope []byte
+}
+
+// outboxRelayResult is the outcome of relaying a batch of outbox rows
+type outboxRelayResult struct {
+	// relayed are the rows the relay accepted, to be removed
+	relayed []int64
+	// failed are the rows whose envelope cannot be decoded, with the decode error; they can never be relayed so
+	// they are marked failed rather than selected again by every pass
+	failed map[int64]error
+	// errs are the errors of the rows that failed, the rejected rows stay for the next pass
+	errs []error
+}
+
+// relayOutboxRows decodes the envelope of each row and hands it to relay
+func relayOutboxRows(ctx context.Context, rows []outboxRow, relay func(context.Context, Envelope) error) outboxRelayResult {
+	result := outboxRelayResult{failed: map[int64]error{}}
+
+	for _, row := range rows {
+		var envelope Envelope
+		if err := json.Unmarshal(row.envelope, &envelope); err != nil {
+			decodeErr := fmt.Errorf("%w: outbox row %d: %w", ErrRiverEnvelopeDecodeFailed, row.id, err)
+
+			result.failed[row.id] = decodeErr
+			result.errs = append(result.errs, decodeErr)
+
+			outboxFailed.Inc()
+
+			continue
+		}
+
+		if err := relay(ctx, envelope); err != nil {
+			result.errs = append(result.errs, fmt.Errorf("outbox event %s: %w", envelope.ID, err))
+
+			continue
+		}
+
+		result.relayed = append(result.relayed, row.id)
+	}
+
+	return result
+}
+
+// Pending returns the number of committed envelopes waiting for the relay
+func (s *PostgresOutboxStore) Pending(ctx context.Context) (int, error) {
+	var count int
+	if err := s.db.QueryRowContext(ctx, `SELECT count(*) FROM `+outboxTable+` WHERE failed_at IS NULL`).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Close closes the database connection of the store
+func (s *PostgresOutboxStore) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,262 @@
+package gala
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// testOutboxTx stands in for a database transaction, staging outbox envelopes until it commits
+type testOutboxTx struct {
+	staged []Envelope
+	done   bool
+}
+
+func (tx *testOutboxTx) Exec(context.Context, string, any, any) error {
+	if tx.done {
+		return sql.ErrTxDone
+	}
+
+	return nil
+}
+
+// commit makes the staged envelopes visible to the relay
+func (tx *testOutboxTx) commit(store *memoryOutboxStore) {
+	store.mu.Lock()
+	defer store.mu.Unlock()
+
+	store.envelopes = append(store.envelopes, tx.staged...)
+	tx.staged = nil
+	tx.done = true
+}
+
+// memoryOutboxStore is an in-memory OutboxStore for unit tests
+type memoryOutboxStore struct {
+	mu        sync.Mutex
+	envelopes []Envelope
+}
+
+func (s *memoryOutboxStore) Write(ctx context.Context, tx OutboxExecer, envelope Envelope) error {
+	if err := tx.Exec(ctx, "", nil, nil); err != nil {
+		return err
+	}
+
+	testTx := tx.(*testOutboxTx)
+	testTx.staged = append(testTx.staged, envelope)
+
+	return nil
+}
+
+func (s *memoryOutboxStore) Relay(ctx context.Context, limit int, relay func(context.Context, Envelope) error) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var (
+		relayed []EventID
+		errs    []error
+	)
+
+	for _, envelope := range s.envelopes[:min(limit, len(s.envelopes))] {
+		if err := relay(ctx, envelope); err != nil {
+			errs = append(errs, err)
+
+			continue
+		}
+
+		relayed = append(relayed, envelope.ID)
+	}
+
+	s.envelopes = slices.DeleteFunc(s.envelopes, func(envelope Envelope) bool {
+		return slices.Contains(relayed, envelope.ID)
+	})
+
+	return len(relayed), errors.Join(errs...)
+}
+
+func (s *memoryOutboxStore) Pending(context.Context) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.envelopes), nil
+}
+
+// testOutboxTxKey is the context key of the test transaction
+type testOutboxTxKey struct{}
+
+// outboxTestRuntime registers a topic on a runtime with an in-memory outbox that finds the test transaction on
+// the emitting context
+func outboxTestRuntime(t *testing.T, client riverInsertClient) (*Gala, *memoryOutboxStore, Topic[runtimeTestPayload]) {
+	t.Helper()
+
+	runtime := newTestGala(t, client)
+	store := &memoryOutboxStore{}
+	runtime.outbox = store
+	runtime.outboxRelayBatchSize = DefaultOutboxRelayBatchSize
+	runtime.outboxTx = func(ctx context.Context) OutboxExecer {
+		tx, ok := ctx.Value(testOutboxTxKey{}).(*testOutboxTx)
+		if !ok {
+			return nil
+		}
+
+		return tx
+	}
+
+	topic := Topic[runtimeTestPayload]{Name: TopicName("runtime.test.outbox")}
+	if err := registerTopic(runtime.registry, topic); err != nil {
+		t.Fatalf("failed to register topic: %v", err)
+	}
+
+	return runtime, store, topic
+}
+
+// TestEmitInsideTransactionWritesOutbox verifies envelopes emitted inside a transaction wait in the outbox until
+// it commits and are then relayed under a key of their event
+func TestEmitInsideTransactionWritesOutbox(t *testing.T) {
+	dispatcher := &runtimeTestDispatcher{}
+	runtime, store, topic := outboxTestRuntime(t, dispatcher)
+
+	tx := &testOutboxTx{}
+	ctx := context.WithValue(context.Background(), testOutboxTxKey{}, tx)
+
+	id, err := runtime.EmitWithHeaders(ctx, topic.Name, runtimeTestPayload{Message: "outbox"}, Headers{Kind: System.Kind()})
+	if err != nil {
+		t.Fatalf("unexpected emit error: %v", err)
+	}
+
+	if dispatcher.calls != 0 {
+		t.Fatalf("expected no dispatch before commit, got %d", dispatcher.calls)
+	}
+
+	if len(tx.staged) != 1 || tx.staged[0].ID != id {
+		t.Fatalf("expected the envelope to be staged in the transaction, got %+v", tx.staged)
+	}
+
+	relayed, err := runtime.relayOutbox(context.Background())
+	if err != nil || relayed != 0 {
+		t.Fatalf("expected nothing to relay before commit, got %d, %v", relayed, err)
+	}
+
+	tx.commit(store)
+
+	relayed, err = runtime.relayOutbox(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected relay error: %v", err)
+	}
+
+	if relayed != 1 || dispatcher.calls != 1 {
+		t.Fatalf("expected 1 relayed envelope and dispatch, got %d and %d", relayed, dispatcher.calls)
+	}
+
+	headers := dispatcher.envelopes[0].Headers
+	if headers.UniqueKey != outboxUniqueKeyPrefix+string(id) || !headers.UniqueOnce {
+		t.Fatalf("expected the relayed envelope to be keyed on its event, got %q (once %t)", headers.UniqueKey, headers.UniqueOnce)
+	}
+
+	if pending, _ := store.Pending(context.Background()); pending != 0 {
+		t.Fatalf("expected the outbox to be empty, got %d", pending)
+	}
+}
+
+// TestEmitOutsideTransactionDispatches verifies emits without a live transaction bypass the outbox
+func TestEmitOutsideTransactionDispatches(t *testing.T) {
+	dispatcher := &runtimeTestDispatcher{}
+	runtime, store, topic := outboxTestRuntime(t, dispatcher)
+
+	if _, err := runtime.EmitWithHeaders(context.Background(), topic.Name, runtimeTestPayload{Message: "direct"}, Headers{Kind: System.Kind()}); err != nil {
+		t.Fatalf("unexpected emit error: %v", err)
+	}
+
+	committed := &testOutboxTx{done: true}
+	ctx := context.WithValue(context.Background(), testOutboxTxKey{}, committed)
+
+	if _, err := runtime.EmitWithHeaders(ctx, topic.Name, runtimeTestPayload{Message: "after commit"}, Headers{Kind: System.Kind()}); err != nil {
+		t.Fatalf("unexpected emit error: %v", err)
+	}
+
+	if dispatcher.calls != 2 {
+		t.Fatalf("expected 2 direct dispatches, got %d", dispatcher.calls)
+	}
+
+	if pending, _ := store.Pending(context.Background()); pending != 0 || len(committed.staged) != 0 {
+		t.Fatalf("expected nothing in the outbox, got %d pending and %d staged", pending, len(committed.staged))
+	}
+
+	if headers := dispatcher.envelopes[0].Headers; headers.UniqueKey != "" {
+		t.Fatalf("expected direct dispatch to keep the envelope unkeyed, got %q", headers.UniqueKey)
+	}
+}
+
+// TestRelayKeepsRejectedEnvelopes verifies envelopes the job queue rejects stay in the outbox for the next pass
+func TestRelayKeepsRejectedEnvelopes(t *testing.T) {
+	dispatcher := &runtimeTestDispatcher{err: errors.New("insert failed")}
+	runtime, store, topic := outboxTestRuntime(t, dispatcher)
+
+	tx := &testOutboxTx{}
+	ctx := context.WithValue(context.Background(), testOutboxTxKey{}, tx)
+
+	if _, err := runtime.EmitWithHeaders(ctx, topic.Name, runtimeTestPayload{Message: "retry"}, Headers{Kind: System.Kind()}); err != nil {
+		t.Fatalf("unexpected emit error: %v", err)
+	}
+
+	tx.commit(store)
+
+	relayed, err := runtime.relayOutbox(context.Background())
+	if !errors.Is(err, ErrRiverDispatchInsertFailed) || relayed != 0 {
+		t.Fatalf("expected the relay to fail without relaying, got %d, %v", relayed, err)
+	}
+
+	dispatcher.err = nil
+
+	if relayed, err := runtime.relayOutbox(context.Background()); err != nil || relayed != 1 {
+		t.Fatalf("expected the next pass to relay the envelope, got %d, %v", relayed, err)
+	}
+}
+
+// TestRelayOutboxRowsFailsUndecodableRows verifies rows whose envelope cannot be decoded are marked failed rather
+// than kept for the next pass, while the other rows are relayed
+func TestRelayOutboxRowsFailsUndecodableRows(t *testing.T) {
+	envelope, err := json.Marshal(Envelope{ID: NewEventID(), Topic: TopicName("runtime.test.outbox")})
+	if err != nil {
+		t.Fatalf("failed to encode envelope: %v", err)
+	}
+
+	var relayed []EventID
+
+	result := relayOutboxRows(context.Background(), []outboxRow{
+		{id: 1, envelope: []byte("{not json")},
+		{id: 2, envelope: envelope},
+	}, func(_ context.Context, envelope Envelope) error {
+		relayed = append(relayed, envelope.ID)
+
+		return nil
+	})
+
+	if !slices.Equal(result.relayed, []int64{2}) || len(relayed) != 1 {
+		t.Fatalf("expected the decodable row to be relayed, got %v", result.relayed)
+	}
+
+	if _, failed := result.failed[1]; !failed || len(result.failed) != 1 {
+		t.Fatalf("expected the undecodable row to be marked failed, got %v", result.failed)
+	}
+
+	if err := errors.Join(result.errs...); !errors.Is(err, ErrRiverEnvelopeDecodeFailed) {
+		t.Fatalf("expected a decode error, got %v", err)
+	}
+}
+
+// TestConfigValidateOutbox verifies an outbox requires durable dispatch and a transaction resolver
+func TestConfigValidateOutbox(t *testing.T) {
+	inMemory := Config{DispatchMode: DispatchModeInMemory, Outbox: &memoryOutboxStore{}}
+	if err := inMemory.validate(); !errors.Is(err, ErrOutboxRequiresDurable) {
+		t.Fatalf("expected ErrOutboxRequiresDurable, got %v", err)
+	}
+
+	missingTx := Config{ConnectionURI: "postgres://localhost", Outbox: &memoryOutboxStore{}}
+	if err := missingTx.validate(); !errors.Is(err, ErrOutboxTxRequired) {
+		t.Fatalf("expected ErrOutboxTxRequired, got %v", err)
+	}
+}
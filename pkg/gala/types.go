@@ -25,6 +25,9 @@ type Headers struct {
 	UniqueOnce bool `json:"unique_once,omitempty"`
 	// Metadata carries structured operation context as opaque JSON
 	Metadata json.RawMessage `json:"metadata,omitempty"`
+	// TargetListener restricts dispatch to the listener with this definition name, set when a dead letter is
+	// replayed to one listener
+	TargetListener string `json:"target_listener,omitempty"`
 }
 
 // Envelope is the durable event envelope
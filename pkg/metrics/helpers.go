@@ -234,6 +234,12 @@ func FinishFileUpload(status string, duration float64) {
 	FileUploadDuration.WithLabelValues(status).Observe(duration)
 }
 
+// RecordFileScan records the completion of a content scan for an uploaded file
+func RecordFileScan(status string, duration float64) {
+	FileScansCompleted.WithLabelValues(status).Inc()
+	FileScanDuration.WithLabelValues(status).Observe(duration)
+}
+
 // RecordFileBufferingStrategy records which buffering strategy was used
 func RecordFileBufferingStrategy(strategy string) {
 	FileBufferingStrategy.WithLabelValues(strategy).Inc()
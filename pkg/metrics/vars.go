@@ -149,6 +149,19 @@ var (
 		Help: "Total number of files buffered by strategy (memory vs disk)",
 	}, []string{"strategy"})
 
+	// FileScansCompleted records the number of content scans of uploaded files by result status
+	FileScansCompleted = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "openlane_file_scans_completed_total",
+		Help: "Total number of uploaded file content scans by result status",
+	}, []string{"status"})
+
+	// FileScanDuration records the duration of content scans of uploaded files
+	FileScanDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "openlane_file_scan_duration_seconds",
+		Help:    "Duration of uploaded file content scans in seconds",
+		Buckets: []float64{0.01, 0.05, 0.1, 0.5, 1.0, 2.5, 5.0, 10.0, 30.0},
+	}, []string{"status"})
+
 	// StorageProviderUploads tracks the number of upload operations per storage provider
 	StorageProviderUploads = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Name: "openlane_storage_provider_uploads_total",
@@ -262,6 +275,8 @@ var (
 		FileUploadsCompleted,
 		FileUploadDuration,
 		FileBufferingStrategy,
+		FileScansCompleted,
+		FileScanDuration,
 		StorageProviderUploads,
 		StorageProviderBytesUploaded,
 		StorageProviderDownloads,
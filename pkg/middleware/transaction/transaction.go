@@ -40,6 +40,16 @@ func NewContext(parent context.Context, c *ent.Tx) context.Context {
 	return entClientContextKey.Set(parent, c)
 }
 
+// Current returns the transaction of the context: the transaction of the graph request when there is one,
+// otherwise the transaction of the REST middleware, or nil
+func Current(ctx context.Context) *ent.Tx {
+	if tx := ent.TxFromContext(ctx); tx != nil {
+		return tx
+	}
+
+	return FromContext(ctx)
+}
+
 // Middleware returns a middleware function for transactions on REST endpoints
 func (d *Client) Middleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -0,0 +1,4 @@
+// Package extract pulls the plain text out of uploaded documents so their contents can be indexed for
+// full-text search. PDF, DOCX, XLSX, Markdown and plain text files are supported; extraction is best effort
+// and only recovers the text a reader would see, not the layout
+package extract
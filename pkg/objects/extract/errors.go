@@ -0,0 +1,10 @@
+package extract
+
+import "errors"
+
+var (
+	// ErrUnsupportedFormat is returned when text cannot be extracted from the file type
+	ErrUnsupportedFormat = errors.New("text extraction is not supported for the file type")
+	// ErrInvalidDocument is returned when the file does not match the structure of its format
+	ErrInvalidDocument = errors.New("document could not be read for text extraction")
+)
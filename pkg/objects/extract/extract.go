@@ -0,0 +1,146 @@
+package extract
+
+import (
+	"bytes"
+	"mime"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+// Format is a document format text can be extracted from
+type Format string
+
+const (
+	// FormatPDF is a PDF document
+	FormatPDF Format = "pdf"
+	// FormatDOCX is a Word document
+	FormatDOCX Format = "docx"
+	// FormatXLSX is an Excel workbook
+	FormatXLSX Format = "xlsx"
+	// FormatMarkdown is a Markdown document; front matter is left out of the extracted text
+	FormatMarkdown Format = "markdown"
+	// FormatText is a plain text document
+	FormatText Format = "text"
+)
+
+const (
+	// MaxTextBytes caps the extracted text of a single document so large files do not exceed the search vector limits
+	MaxTextBytes = 512 << 10
+	// maxExtractedBytes caps the total uncompressed content read from a single document across its pages or
+	// archive entries, so a small compressed file cannot expand without bound
+	maxExtractedBytes = 64 << 20
+
+	mimePDF  = "application/pdf"
+	mimeDOCX = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+	mimeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// FormatOf returns the format of a file from its detected content type, falling back to the file extension
+// when the content type is generic; an empty format means the file is not supported
+func FormatOf(contentType, extension string) Format {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = strings.ToLower(strings.TrimSpace(contentType))
+	}
+
+	switch mediaType {
+	case mimePDF:
+		return FormatPDF
+	case mimeDOCX:
+		return FormatDOCX
+	case mimeXLSX:
+		return FormatXLSX
+	case "text/markdown", "text/x-markdown":
+		return FormatMarkdown
+	}
+
+	switch strings.ToLower(strings.TrimPrefix(extension, ".")) {
+	case "pdf":
+		return FormatPDF
+	case "docx":
+		return FormatDOCX
+	case "xlsx":
+		return FormatXLSX
+	case "md", "markdown":
+		return FormatMarkdown
+	case "txt", "text":
+		return FormatText
+	}
+
+	if mediaType == "text/plain" {
+		return FormatText
+	}
+
+	return ""
+}
+
+// Supported reports whether text can be extracted from a file with the content type and extension
+func Supported(contentType, extension string) bool {
+	return FormatOf(contentType, extension) != ""
+}
+
+// Text returns the plain text of the document, truncated to MaxTextBytes
+func Text(data []byte, contentType, extension string) (string, error) {
+	var (
+		text string
+		err  error
+	)
+
+	switch FormatOf(contentType, extension) {
+	case FormatPDF:
+		text, err = pdfText(data)
+	case FormatXLSX:
+		text, err = xlsxText(data)
+	case FormatDOCX:
+		text, err = parsedText(data, mimeDOCX)
+	case FormatMarkdown:
+		text, err = parsedText(data, "text/markdown")
+	case FormatText:
+		text, err = parsedText(data, "text/plain")
+	default:
+		return "", ErrUnsupportedFormat
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return clean(text), nil
+}
+
+// parsedText returns the text of a document handled by the storage document parser
+func parsedText(data []byte, mimeType string) (string, error) {
+	parsed, err := storage.ParseDocument(bytes.NewReader(data), mimeType)
+	if err != nil {
+		return "", err
+	}
+
+	switch v := parsed.Data.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	default:
+		return "", ErrInvalidDocument
+	}
+}
+
+// clean makes the text safe to store in a postgres text column and truncates it on a rune boundary
+func clean(text string) string {
+	text = strings.ToValidUTF8(text, "")
+	text = strings.ReplaceAll(text, "\x00", "")
+	text = strings.TrimSpace(text)
+
+	if len(text) <= MaxTextBytes {
+		return text
+	}
+
+	cut := MaxTextBytes
+	for cut > 0 && !utf8.RuneStart(text[cut]) {
+		cut--
+	}
+
+	return text[:cut]
+}
@@ -0,0 +1,242 @@
+package extract
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func buildZip(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+// buildPDF returns a single page pdf whose page content is the flate encoded content; an image stream that
+// is not part of the page content is included to check only page content is read
+func buildPDF(t *testing.T, content string) []byte {
+	t.Helper()
+
+	var compressed bytes.Buffer
+
+	zw := zlib.NewWriter(&compressed)
+	_, err := zw.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 612 792] /Contents 4 0 R /Resources << /XObject << /Im1 5 0 R >> >> >>",
+		fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.Bytes()),
+		"<< /Type /XObject /Subtype /Image /Width 1 /Height 1 /ColorSpace /DeviceGray /BitsPerComponent 8 /Length 17 >>\nstream\nBT (hidden) Tj ET\nendstream",
+	}
+
+	var buf bytes.Buffer
+
+	buf.WriteString("%PDF-1.4\n")
+
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+
+	for _, off := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", off)
+	}
+
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// buildXLSX returns a workbook with the cell values of each sheet, keyed by cell name
+func buildXLSX(t *testing.T, sheets []string, values map[string]map[string]any) []byte {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	for i, sheet := range sheets {
+		if i == 0 {
+			require.NoError(t, f.SetSheetName("Sheet1", sheet))
+		} else {
+			_, err := f.NewSheet(sheet)
+			require.NoError(t, err)
+		}
+
+		for cell, value := range values[sheet] {
+			require.NoError(t, f.SetCellValue(sheet, cell, value))
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	require.NoError(t, err)
+
+	return buf.Bytes()
+}
+
+func TestFormatOf(t *testing.T) {
+	testCases := []struct {
+		contentType string
+		extension   string
+		expected    Format
+	}{
+		{contentType: "application/pdf", expected: FormatPDF},
+		{contentType: mimeDOCX, extension: "docx", expected: FormatDOCX},
+		{contentType: mimeXLSX, expected: FormatXLSX},
+		{contentType: "application/zip", extension: ".xlsx", expected: FormatXLSX},
+		{contentType: "text/plain; charset=utf-8", extension: "md", expected: FormatMarkdown},
+		{contentType: "text/markdown", expected: FormatMarkdown},
+		{contentType: "text/plain; charset=utf-8", extension: "txt", expected: FormatText},
+		{contentType: "text/plain; charset=utf-8", expected: FormatText},
+		{contentType: "image/png", extension: "png", expected: ""},
+		{contentType: "application/octet-stream", expected: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.contentType+" "+tc.extension, func(t *testing.T) {
+			assert.Equal(t, tc.expected, FormatOf(tc.contentType, tc.extension))
+			assert.Equal(t, tc.expected != "", Supported(tc.contentType, tc.extension))
+		})
+	}
+}
+
+func TestTextPDF(t *testing.T) {
+	data, err := os.ReadFile("testdata/hello.pdf")
+	require.NoError(t, err)
+
+	text, err := Text(data, "application/pdf", "pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "Hello, world", text)
+
+	generated := buildPDF(t, "BT /F1 12 Tf 72 720 Td (Access Control) Tj 0 -14 Td [(Poli) 20 (cy) -300 (review)] TJ T* (\\(annual\\)) Tj ET")
+
+	text, err = Text(generated, "application/pdf", "pdf")
+	require.NoError(t, err)
+	assert.Equal(t, "Access Control\nPolicy review\n(annual)", text)
+
+	_, err = Text([]byte("not a pdf"), "application/pdf", "pdf")
+	assert.ErrorIs(t, err, ErrInvalidDocument)
+}
+
+func TestContentText(t *testing.T) {
+	testCases := []struct {
+		name     string
+		content  string
+		expected string
+	}{
+		{
+			name:     "kerning does not split words",
+			content:  "BT [ (Hello) 15 (,) 1 ( w) 22 (o) 1 (rld) ] TJ ET",
+			expected: "Hello, world",
+		},
+		{
+			name:     "large offsets are word gaps",
+			content:  "BT [(risk) -450 (register)] TJ ET",
+			expected: "risk register",
+		},
+		{
+			name:     "escapes and nested parentheses",
+			content:  `BT (a \(b\) (c) \101\102) Tj ET`,
+			expected: "a (b) (c) AB",
+		},
+		{
+			name:     "utf-16 hex strings",
+			content:  "BT <FEFF00E9007400E9> Tj ET",
+			expected: "été",
+		},
+		{
+			name:     "glyph id hex strings are dropped",
+			content:  "BT <0012003400560078> Tj ET",
+			expected: "",
+		},
+		{
+			name:     "line operators",
+			content:  "BT (first) Tj T* (second) Tj (third) ' ET",
+			expected: "first\nsecond\nthird",
+		},
+		{
+			name:     "inline images are skipped",
+			content:  "BI /W 1 /H 1 ID \x00(\xff) EI BT (after) Tj ET",
+			expected: "after",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var sb strings.Builder
+
+			writeContentText(&sb, []byte(tc.content))
+			assert.Equal(t, tc.expected, normalizeLines(sb.String()))
+		})
+	}
+}
+
+func TestTextXLSX(t *testing.T) {
+	data := buildXLSX(t, []string{"Controls", "Notes"}, map[string]map[string]any{
+		"Controls": {
+			"A1": "Control",
+			"B1": 42,
+			"A2": "Encryption at rest",
+			"C4": "  ",
+		},
+		"Notes": {
+			"B2": "Second sheet",
+		},
+	})
+
+	text, err := Text(data, mimeXLSX, "xlsx")
+	require.NoError(t, err)
+	assert.Equal(t, "Control\t42\nEncryption at rest\nSecond sheet", text)
+
+	_, err = Text(buildZip(t, map[string]string{"readme.txt": "x"}), mimeXLSX, "xlsx")
+	assert.ErrorIs(t, err, ErrInvalidDocument)
+}
+
+func TestTextMarkdownAndPlain(t *testing.T) {
+	text, err := Text([]byte("---\ntitle: Backup Policy\n---\n# Backups\n\nDaily snapshots.\n"), "text/plain; charset=utf-8", "md")
+	require.NoError(t, err)
+	assert.Equal(t, "# Backups\n\nDaily snapshots.", text)
+
+	text, err = Text([]byte("vendor\x00 list\xff"), "text/plain", "txt")
+	require.NoError(t, err)
+	assert.Equal(t, "vendor list", text)
+
+	_, err = Text([]byte{0x89, 'P', 'N', 'G'}, "image/png", "png")
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestClean(t *testing.T) {
+	text := strings.Repeat("a", MaxTextBytes-1) + "é"
+
+	cleaned := clean(text)
+	assert.Len(t, cleaned, MaxTextBytes-1)
+	assert.Equal(t, strings.Repeat("a", MaxTextBytes-1), cleaned)
+}
@@ -0,0 +1,483 @@
+package extract
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu/model"
+)
+
+const (
+	// tjSpaceThreshold is the TJ offset, in thousandths of a text unit, treated as a gap between words;
+	// smaller offsets are kerning inside a word
+	tjSpaceThreshold = -200
+)
+
+// pdfText returns the text shown by the content streams of each page of the pdf. The document is read with
+// pdfcpu, which resolves the page tree, cross reference streams and stream filters; the text operators of the
+// decoded page content are then interpreted here, so text drawn through fonts without a unicode compatible
+// encoding is skipped
+func pdfText(data []byte) (string, error) {
+	conf := model.NewDefaultConfiguration()
+	conf.ValidationMode = model.ValidationRelaxed
+
+	ctx, err := api.ReadContext(bytes.NewReader(data), conf)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	if err := ctx.EnsurePageCount(); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	var (
+		sb   strings.Builder
+		read int64
+	)
+
+	for page := 1; page <= ctx.PageCount; page++ {
+		content, err := pdfcpu.ExtractPageContent(ctx, page)
+		if err != nil {
+			// a damaged page does not hide the text of the rest of the document
+			continue
+		}
+
+		raw, err := io.ReadAll(io.LimitReader(content, maxExtractedBytes-read))
+		if err != nil {
+			continue
+		}
+
+		read += int64(len(raw))
+
+		writeContentText(&sb, raw)
+
+		if sb.Len() > MaxTextBytes || read >= maxExtractedBytes {
+			break
+		}
+	}
+
+	return normalizeLines(sb.String()), nil
+}
+
+// writeContentText runs the text operators of a content stream and writes the shown text
+func writeContentText(sb *strings.Builder, content []byte) {
+	lex := &contentLexer{data: content}
+
+	var (
+		operands []any
+		arrays   [][]any
+		lastY    float64
+	)
+
+	push := func(v any) {
+		if len(arrays) > 0 {
+			arrays[len(arrays)-1] = append(arrays[len(arrays)-1], v)
+			return
+		}
+
+		operands = append(operands, v)
+	}
+
+	for {
+		tok, ok := lex.next()
+		if !ok {
+			return
+		}
+
+		switch tok.kind {
+		case tokenString, tokenNumber, tokenName:
+			push(tok.value)
+
+			continue
+		case tokenArrayStart:
+			arrays = append(arrays, nil)
+
+			continue
+		case tokenArrayEnd:
+			if len(arrays) > 0 {
+				arr := arrays[len(arrays)-1]
+				arrays = arrays[:len(arrays)-1]
+				push(arr)
+			}
+
+			continue
+		case tokenOther:
+			continue
+		}
+
+		switch tok.op {
+		case "Tj":
+			writeOperandString(sb, operands, 1)
+		case "'", `"`:
+			sb.WriteByte('\n')
+			writeOperandString(sb, operands, 1)
+		case "TJ":
+			if len(operands) > 0 {
+				writeTJ(sb, operands[len(operands)-1])
+			}
+		case "T*", "ET":
+			sb.WriteByte('\n')
+		case "Td", "TD":
+			if y, ok := operandNumber(operands, 1); ok && y != 0 {
+				sb.WriteByte('\n')
+			} else {
+				sb.WriteByte(' ')
+			}
+		case "Tm":
+			if y, ok := operandNumber(operands, 1); ok && y != lastY {
+				lastY = y
+				sb.WriteByte('\n')
+			} else {
+				sb.WriteByte(' ')
+			}
+		case "ID":
+			lex.skipInlineImage()
+		}
+
+		operands = operands[:0]
+		arrays = arrays[:0]
+	}
+}
+
+// writeOperandString writes the string operand at position n counted from the end of the operands
+func writeOperandString(sb *strings.Builder, operands []any, n int) {
+	if len(operands) < n {
+		return
+	}
+
+	if s, ok := operands[len(operands)-n].(string); ok {
+		sb.WriteString(s)
+	}
+}
+
+// writeTJ writes the strings of a TJ array, adding a space where the offset between strings is a word gap
+func writeTJ(sb *strings.Builder, arg any) {
+	parts, ok := arg.([]any)
+	if !ok {
+		return
+	}
+
+	for _, p := range parts {
+		switch v := p.(type) {
+		case string:
+			sb.WriteString(v)
+		case float64:
+			if v < tjSpaceThreshold {
+				sb.WriteByte(' ')
+			}
+		}
+	}
+}
+
+// operandNumber returns the number operand at position n counted from the end of the operands
+func operandNumber(operands []any, n int) (float64, bool) {
+	if len(operands) < n {
+		return 0, false
+	}
+
+	f, ok := operands[len(operands)-n].(float64)
+
+	return f, ok
+}
+
+// normalizeLines collapses the whitespace of each line and drops empty lines
+func normalizeLines(text string) string {
+	lines := strings.Split(text, "\n")
+	out := lines[:0]
+
+	for _, line := range lines {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			out = append(out, line)
+		}
+	}
+
+	return strings.Join(out, "\n")
+}
+
+// pdfName is a name operand; it is kept apart from strings so names are never written as text
+type pdfName string
+
+type tokenKind int
+
+const (
+	tokenOperator tokenKind = iota
+	tokenString
+	tokenNumber
+	tokenName
+	tokenArrayStart
+	tokenArrayEnd
+	tokenOther
+)
+
+// contentToken is a single token of a content stream
+type contentToken struct {
+	kind  tokenKind
+	op    string
+	value any
+}
+
+// contentLexer splits a pdf content stream into tokens
+type contentLexer struct {
+	data []byte
+	pos  int
+}
+
+// next returns the next token of the stream
+func (l *contentLexer) next() (contentToken, bool) {
+	l.skipSpace()
+
+	if l.pos >= len(l.data) {
+		return contentToken{}, false
+	}
+
+	c := l.data[l.pos]
+
+	switch {
+	case c == '(':
+		return contentToken{kind: tokenString, value: decodeText(l.literalString())}, true
+	case c == '<' && l.peek(1) == '<', c == '>' && l.peek(1) == '>':
+		l.pos += 2
+
+		return contentToken{kind: tokenOther}, true
+	case c == '<':
+		return contentToken{kind: tokenString, value: l.hexString()}, true
+	case c == '[':
+		l.pos++
+
+		return contentToken{kind: tokenArrayStart}, true
+	case c == ']':
+		l.pos++
+
+		return contentToken{kind: tokenArrayEnd}, true
+	case c == '/':
+		l.pos++
+
+		return contentToken{kind: tokenName, value: pdfName(l.regular())}, true
+	case c == '{' || c == '}' || c == '>' || c == ')':
+		l.pos++
+
+		return contentToken{kind: tokenOther}, true
+	}
+
+	word := l.regular()
+	if word == "" {
+		l.pos++
+
+		return contentToken{kind: tokenOther}, true
+	}
+
+	if f, err := strconv.ParseFloat(word, 64); err == nil {
+		return contentToken{kind: tokenNumber, value: f}, true
+	}
+
+	return contentToken{kind: tokenOperator, op: word}, true
+}
+
+// peek returns the byte n positions ahead, or zero at the end of the stream
+func (l *contentLexer) peek(n int) byte {
+	if l.pos+n < len(l.data) {
+		return l.data[l.pos+n]
+	}
+
+	return 0
+}
+
+// skipSpace skips whitespace and comments
+func (l *contentLexer) skipSpace() {
+	for l.pos < len(l.data) {
+		switch c := l.data[l.pos]; {
+		case isPDFSpace(c):
+			l.pos++
+		case c == '%':
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// regular reads a run of regular characters
+func (l *contentLexer) regular() string {
+	start := l.pos
+	for l.pos < len(l.data) && !isPDFSpace(l.data[l.pos]) && !isPDFDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+
+	return string(l.data[start:l.pos])
+}
+
+// literalString reads a parenthesized string, resolving escapes and balanced parentheses
+func (l *contentLexer) literalString() []byte {
+	l.pos++
+
+	var (
+		out   []byte
+		depth = 1
+	)
+
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return out
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				return out
+			}
+
+			e := l.data[l.pos]
+			l.pos++
+
+			switch e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+
+				continue
+			case '\n':
+				continue
+			default:
+				if e < '0' || e > '7' {
+					c = e
+					break
+				}
+
+				n := int(e - '0')
+				for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+					n = n*8 + int(l.data[l.pos]-'0')
+					l.pos++
+				}
+
+				c = byte(n)
+			}
+		}
+
+		out = append(out, c)
+	}
+
+	return out
+}
+
+// hexString reads a hex string; strings that do not decode to printable text are usually glyph ids of
+// embedded fonts and are dropped
+func (l *contentLexer) hexString() string {
+	l.pos++
+
+	var digits []byte
+
+	for l.pos < len(l.data) && l.data[l.pos] != '>' {
+		if c := l.data[l.pos]; isHexDigit(c) {
+			digits = append(digits, c)
+		}
+
+		l.pos++
+	}
+
+	l.pos++
+
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+
+	raw := make([]byte, len(digits)/2)
+	for i := range raw {
+		v, _ := strconv.ParseUint(string(digits[i*2:i*2+2]), 16, 8)
+		raw[i] = byte(v)
+	}
+
+	if bytes.HasPrefix(raw, []byte{0xfe, 0xff}) {
+		return decodeText(raw)
+	}
+
+	for _, b := range raw {
+		if b < 0x20 || b > 0x7e {
+			return ""
+		}
+	}
+
+	return string(raw)
+}
+
+// skipInlineImage skips the binary data of an inline image up to its EI operator
+func (l *contentLexer) skipInlineImage() {
+	for l.pos+2 < len(l.data) {
+		if l.data[l.pos] == 'E' && l.data[l.pos+1] == 'I' && isPDFSpace(l.data[l.pos-1]) &&
+			(l.pos+2 == len(l.data) || isPDFSpace(l.data[l.pos+2])) {
+			l.pos += 2
+
+			return
+		}
+
+		l.pos++
+	}
+
+	l.pos = len(l.data)
+}
+
+// decodeText converts a pdf string to text; strings with a UTF-16 byte order mark are decoded as UTF-16
+// and all others are treated as single byte text with control characters removed
+func decodeText(raw []byte) string {
+	if len(raw) >= 2 && raw[0] == 0xfe && raw[1] == 0xff {
+		units := make([]uint16, 0, len(raw)/2)
+		for i := 2; i+1 < len(raw); i += 2 {
+			units = append(units, uint16(raw[i])<<8|uint16(raw[i+1]))
+		}
+
+		return string(utf16.Decode(units))
+	}
+
+	var sb strings.Builder
+
+	for _, b := range raw {
+		r := rune(b)
+		if unicode.IsControl(r) && r != '\t' && r != '\n' {
+			continue
+		}
+
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// isPDFSpace reports whether the byte is pdf whitespace
+func isPDFSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == '\f' || c == 0
+}
+
+// isPDFDelimiter reports whether the byte is a pdf delimiter
+func isPDFDelimiter(c byte) bool {
+	return strings.IndexByte("()<>[]{}/%", c) >= 0
+}
+
+// isHexDigit reports whether the byte is a hex digit
+func isHexDigit(c byte) bool {
+	return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'f') || (c >= 'A' && c <= 'F')
+}
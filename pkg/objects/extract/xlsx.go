@@ -0,0 +1,79 @@
+package extract
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// xlsxText returns the displayed cell values of every worksheet in the workbook, one row per line with the
+// cells separated by tabs; the workbook is unzipped within maxExtractedBytes and its rows are streamed
+func xlsxText(data []byte) (string, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data), excelize.Options{UnzipSizeLimit: maxExtractedBytes})
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	defer f.Close()
+
+	sheets := f.GetSheetList()
+	if len(sheets) == 0 {
+		return "", ErrInvalidDocument
+	}
+
+	var sb strings.Builder
+
+	for _, sheet := range sheets {
+		if err := writeSheet(&sb, f, sheet); err != nil {
+			return "", err
+		}
+
+		if sb.Len() > MaxTextBytes {
+			break
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// writeSheet writes the rows of a worksheet that hold at least one value
+func writeSheet(sb *strings.Builder, f *excelize.File, sheet string) error {
+	rows, err := f.Rows(sheet)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	defer rows.Close()
+
+	for rows.Next() {
+		cells, err := rows.Columns()
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+		}
+
+		row := make([]string, 0, len(cells))
+
+		for _, cell := range cells {
+			if text := strings.TrimSpace(cell); text != "" {
+				row = append(row, text)
+			}
+		}
+
+		if len(row) > 0 {
+			sb.WriteString(strings.Join(row, "\t"))
+			sb.WriteByte('\n')
+		}
+
+		if sb.Len() > MaxTextBytes {
+			break
+		}
+	}
+
+	if err := rows.Error(); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidDocument, err)
+	}
+
+	return nil
+}
@@ -405,8 +405,8 @@ func (_c *MockProvider_GetScheme_Call) RunAndReturn(run func() *string) *MockPro
 }
 
 // ListBuckets provides a mock function for the type MockProvider
-func (_mock *MockProvider) ListBuckets() ([]string, error) {
-	ret := _mock.Called()
+func (_mock *MockProvider) ListBuckets(ctx context.Context) ([]string, error) {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListBuckets")
@@ -414,18 +414,18 @@ func (_mock *MockProvider) ListBuckets() ([]string, error) {
 
 	var r0 []string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func() ([]string, error)); ok {
-		return returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]string, error)); ok {
+		return returnFunc(ctx)
 	}
-	if returnFunc, ok := ret.Get(0).(func() []string); ok {
-		r0 = returnFunc()
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func() error); ok {
-		r1 = returnFunc()
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -438,13 +438,20 @@ type MockProvider_ListBuckets_Call struct {
 }
 
 // ListBuckets is a helper method to define mock.On call
-func (_e *MockProvider_Expecter) ListBuckets() *MockProvider_ListBuckets_Call {
-	return &MockProvider_ListBuckets_Call{Call: _e.mock.On("ListBuckets")}
+//   - ctx context.Context
+func (_e *MockProvider_Expecter) ListBuckets(ctx interface{}) *MockProvider_ListBuckets_Call {
+	return &MockProvider_ListBuckets_Call{Call: _e.mock.On("ListBuckets", ctx)}
 }
 
-func (_c *MockProvider_ListBuckets_Call) Run(run func()) *MockProvider_ListBuckets_Call {
+func (_c *MockProvider_ListBuckets_Call) Run(run func(ctx context.Context)) *MockProvider_ListBuckets_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -454,7 +461,7 @@ func (_c *MockProvider_ListBuckets_Call) Return(strings []string, err error) *Mo
 	return _c
 }
 
-func (_c *MockProvider_ListBuckets_Call) RunAndReturn(run func() ([]string, error)) *MockProvider_ListBuckets_Call {
+func (_c *MockProvider_ListBuckets_Call) RunAndReturn(run func(ctx context.Context) ([]string, error)) *MockProvider_ListBuckets_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,60 @@
+package retention
+
+import (
+	"strings"
+)
+
+const (
+	// ObjectLockModeGovernance allows privileged principals to shorten or remove object lock retention
+	ObjectLockModeGovernance = "GOVERNANCE"
+	// ObjectLockModeCompliance prevents any principal from shortening or removing object lock retention
+	ObjectLockModeCompliance = "COMPLIANCE"
+)
+
+// Config contains configuration for evidence retention of uploaded files
+type Config struct {
+	// Enabled indicates if retention rules are applied to uploaded files
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// ObjectLock contains configuration for provider enforced write-once storage
+	ObjectLock ObjectLockConfig `json:"objectlock" koanf:"objectlock"`
+}
+
+// ObjectLockConfig contains configuration for provider enforced write-once storage
+type ObjectLockConfig struct {
+	// Enabled requests object lock retention on providers that support it; the bucket must have object lock enabled
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// Mode is the object lock mode, either GOVERNANCE or COMPLIANCE
+	Mode string `json:"mode" koanf:"mode" default:"GOVERNANCE"`
+}
+
+// Validate ensures the retention configuration can be applied
+func (c Config) Validate() error {
+	if !c.Enabled || !c.ObjectLock.Enabled {
+		return nil
+	}
+
+	switch strings.ToUpper(c.ObjectLock.Mode) {
+	case "", ObjectLockModeGovernance, ObjectLockModeCompliance:
+		return nil
+	default:
+		return ErrInvalidObjectLockMode
+	}
+}
+
+// NewFromConfig builds the retention policy described by the configuration; it returns nil when retention is disabled
+func NewFromConfig(cfg Config) *Policy {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	policy := &Policy{}
+
+	if cfg.ObjectLock.Enabled {
+		policy.objectLockMode = strings.ToUpper(cfg.ObjectLock.Mode)
+		if policy.objectLockMode == "" {
+			policy.objectLockMode = ObjectLockModeGovernance
+		}
+	}
+
+	return policy
+}
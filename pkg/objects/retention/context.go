@@ -0,0 +1,35 @@
+package retention
+
+import "context"
+
+// managedUpdateKey marks a context as changing retention state on behalf of the retention workflows
+type managedUpdateKey struct{}
+
+// WithManagedUpdate returns a context permitted to change the retention state recorded in file metadata;
+// it is used when retention is first assigned on upload and when legal holds are placed or released
+func WithManagedUpdate(ctx context.Context) context.Context {
+	return context.WithValue(ctx, managedUpdateKey{}, true)
+}
+
+// IsManagedUpdate reports whether the context is permitted to change recorded retention state
+func IsManagedUpdate(ctx context.Context) bool {
+	managed, _ := ctx.Value(managedUpdateKey{}).(bool)
+
+	return managed
+}
+
+// relocationKey marks a context as moving a file between storage locations without changing its contents
+type relocationKey struct{}
+
+// WithRelocation returns a context permitted to change the storage location of a retained file; it is only
+// used once the copy at the new location has been verified against the original checksum
+func WithRelocation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, relocationKey{}, true)
+}
+
+// IsRelocation reports whether the context is moving a file between storage locations
+func IsRelocation(ctx context.Context) bool {
+	relocation, _ := ctx.Value(relocationKey{}).(bool)
+
+	return relocation
+}
@@ -0,0 +1,3 @@
+// Package retention provides evidence retention rules, legal holds and WORM enforcement for stored files,
+// recording the retention state of each file in its metadata so it travels with the file record
+package retention
@@ -0,0 +1,16 @@
+package retention
+
+import "errors"
+
+var (
+	// ErrRetentionActive is returned when a file is deleted or overwritten before its retention period ends
+	ErrRetentionActive = errors.New("file is under retention and cannot be modified or deleted")
+	// ErrLegalHoldActive is returned when a file under legal hold is deleted or overwritten
+	ErrLegalHoldActive = errors.New("file is under legal hold and cannot be modified or deleted")
+	// ErrRetentionMetadataImmutable is returned when a mutation attempts to change the recorded retention state of a file
+	ErrRetentionMetadataImmutable = errors.New("file retention state can only be changed through the legal hold endpoints")
+	// ErrLegalHoldReasonRequired is returned when a legal hold is placed without a reason
+	ErrLegalHoldReasonRequired = errors.New("a reason is required to place a legal hold")
+	// ErrInvalidObjectLockMode is returned when the configured object lock mode is not supported
+	ErrInvalidObjectLockMode = errors.New("object lock mode must be GOVERNANCE or COMPLIANCE")
+)
@@ -0,0 +1,318 @@
+package retention
+
+import (
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/theopenlane/core/common/storagetypes"
+)
+
+const (
+	// MetadataKeyRule is the file metadata key holding the name of the retention rule applied to the file
+	MetadataKeyRule = "retention_rule"
+	// MetadataKeyRetainUntil is the file metadata key holding the time the retention period ends
+	MetadataKeyRetainUntil = "retention_until"
+	// MetadataKeyPurge is the file metadata key indicating the file is purged once retention ends
+	MetadataKeyPurge = "retention_purge"
+	// MetadataKeyObjectLock is the file metadata key holding the object lock mode applied by the storage provider
+	MetadataKeyObjectLock = "retention_object_lock"
+	// MetadataKeyLegalHold is the file metadata key indicating the file is under legal hold
+	MetadataKeyLegalHold = "legal_hold"
+	// MetadataKeyLegalHoldReason is the file metadata key holding the reason the legal hold was placed
+	MetadataKeyLegalHoldReason = "legal_hold_reason"
+	// MetadataKeyLegalHoldBy is the file metadata key holding the subject that placed the legal hold
+	MetadataKeyLegalHoldBy = "legal_hold_by"
+	// MetadataKeyLegalHoldAt is the file metadata key holding the time the legal hold was placed
+	MetadataKeyLegalHoldAt = "legal_hold_at"
+)
+
+// metadataKeys are all file metadata keys owned by the retention package
+var metadataKeys = []string{
+	MetadataKeyRule,
+	MetadataKeyRetainUntil,
+	MetadataKeyPurge,
+	MetadataKeyObjectLock,
+	MetadataKeyLegalHold,
+	MetadataKeyLegalHoldReason,
+	MetadataKeyLegalHoldBy,
+	MetadataKeyLegalHoldAt,
+}
+
+// Rule describes how long matching files are retained and what happens once the period ends; rules are
+// defined by each organization and loaded for the organization that owns the file
+type Rule struct {
+	// Name identifies the rule in file metadata and purge audit records
+	Name string
+	// Category matches the file category, e.g. evidence; empty matches any category
+	Category string
+	// ObjectType matches the type of object the file is attached to, e.g. evidence or procedure; empty matches any type
+	ObjectType string
+	// Period is how long matching files are retained after upload
+	Period time.Duration
+	// Purge deletes matching files once the retention period ends
+	Purge bool
+}
+
+// Target describes the file a retention rule is evaluated against
+type Target struct {
+	// Category is the category of the file
+	Category string
+	// ObjectType is the type of object the file is attached to
+	ObjectType string
+}
+
+// Policy applies the retention rules of an organization to uploaded files
+type Policy struct {
+	objectLockMode string
+}
+
+// Match returns the most specific rule matching the target; rules matching both category and object type
+// take precedence over rules matching one, and rules without a retention period are ignored
+func Match(rules []Rule, target Target) (Rule, bool) {
+	var (
+		best      Rule
+		bestScore = -1
+	)
+
+	for _, rule := range rules {
+		if rule.Period <= 0 {
+			continue
+		}
+
+		score, ok := rule.match(target)
+		if ok && score > bestScore {
+			best, bestScore = rule, score
+		}
+	}
+
+	return best, bestScore >= 0
+}
+
+// Assign returns the retention state for a file uploaded at the given time from the rules of the organization
+// that owns the file, reporting false when retention is disabled or no rule matches
+func (p *Policy) Assign(rules []Rule, target Target, now time.Time) (State, bool) {
+	if p == nil {
+		return State{}, false
+	}
+
+	rule, ok := Match(rules, target)
+	if !ok {
+		return State{}, false
+	}
+
+	return State{
+		Rule:           rule.Name,
+		RetainUntil:    now.Add(rule.Period).UTC().Truncate(time.Second),
+		Purge:          rule.Purge,
+		ObjectLockMode: p.objectLockMode,
+	}, true
+}
+
+// match reports whether the rule applies to the target along with how specific the match is
+func (r Rule) match(target Target) (int, bool) {
+	score := 0
+
+	if r.Category != "" {
+		if !strings.EqualFold(r.Category, target.Category) {
+			return 0, false
+		}
+
+		score += 2 //nolint:mnd
+	}
+
+	if r.ObjectType != "" {
+		if !strings.EqualFold(r.ObjectType, target.ObjectType) {
+			return 0, false
+		}
+
+		score++
+	}
+
+	return score, true
+}
+
+// State is the retention state recorded against a file
+type State struct {
+	// Rule is the name of the retention rule applied to the file
+	Rule string
+	// RetainUntil is the time the retention period ends
+	RetainUntil time.Time
+	// Purge indicates the file is deleted once the retention period ends
+	Purge bool
+	// ObjectLockMode is the object lock mode requested from the storage provider, if any
+	ObjectLockMode string
+	// LegalHold indicates the file is under legal hold
+	LegalHold bool
+	// LegalHoldReason is the reason the legal hold was placed
+	LegalHoldReason string
+	// LegalHoldBy is the subject that placed the legal hold
+	LegalHoldBy string
+	// LegalHoldAt is the time the legal hold was placed
+	LegalHoldAt time.Time
+}
+
+// Retained reports whether the retention period is still running at the given time
+func (s State) Retained(now time.Time) bool {
+	return !s.RetainUntil.IsZero() && now.Before(s.RetainUntil)
+}
+
+// CheckMutation returns an error when the file cannot be deleted or overwritten at the given time
+func (s State) CheckMutation(now time.Time) error {
+	if s.LegalHold {
+		return ErrLegalHoldActive
+	}
+
+	if s.Retained(now) {
+		return ErrRetentionActive
+	}
+
+	return nil
+}
+
+// PurgeDue reports whether the file should be purged at the given time
+func (s State) PurgeDue(now time.Time) bool {
+	return s.Purge && !s.LegalHold && !s.RetainUntil.IsZero() && !s.Retained(now)
+}
+
+// ObjectLock returns the object lock options to request from the storage provider, or nil when none apply
+func (s State) ObjectLock() *storagetypes.ObjectLockOptions {
+	if s.ObjectLockMode == "" || s.RetainUntil.IsZero() {
+		return nil
+	}
+
+	return &storagetypes.ObjectLockOptions{
+		Mode:        s.ObjectLockMode,
+		RetainUntil: s.RetainUntil,
+		LegalHold:   s.LegalHold,
+	}
+}
+
+// Metadata returns the state formatted as file metadata entries
+func (s State) Metadata() map[string]any {
+	md := map[string]any{}
+
+	if s.Rule != "" {
+		md[MetadataKeyRule] = s.Rule
+	}
+
+	if !s.RetainUntil.IsZero() {
+		md[MetadataKeyRetainUntil] = s.RetainUntil.UTC().Format(time.RFC3339)
+		md[MetadataKeyPurge] = s.Purge
+	}
+
+	if s.ObjectLockMode != "" {
+		md[MetadataKeyObjectLock] = s.ObjectLockMode
+	}
+
+	if s.LegalHold {
+		md[MetadataKeyLegalHold] = true
+		md[MetadataKeyLegalHoldReason] = s.LegalHoldReason
+		md[MetadataKeyLegalHoldBy] = s.LegalHoldBy
+		md[MetadataKeyLegalHoldAt] = s.LegalHoldAt.UTC().Format(time.RFC3339)
+	}
+
+	return md
+}
+
+// StateFromMetadata returns the retention state recorded in file metadata; files without recorded
+// retention were stored before retention was enabled and carry no restrictions
+func StateFromMetadata(md map[string]any) State {
+	if md == nil {
+		return State{}
+	}
+
+	return State{
+		Rule:            stringValue(md, MetadataKeyRule),
+		RetainUntil:     timeValue(md, MetadataKeyRetainUntil),
+		Purge:           boolValue(md, MetadataKeyPurge),
+		ObjectLockMode:  stringValue(md, MetadataKeyObjectLock),
+		LegalHold:       boolValue(md, MetadataKeyLegalHold),
+		LegalHoldReason: stringValue(md, MetadataKeyLegalHoldReason),
+		LegalHoldBy:     stringValue(md, MetadataKeyLegalHoldBy),
+		LegalHoldAt:     timeValue(md, MetadataKeyLegalHoldAt),
+	}
+}
+
+// CheckMutation returns an error when the metadata indicates the file cannot be deleted or overwritten
+func CheckMutation(md map[string]any, now time.Time) error {
+	return StateFromMetadata(md).CheckMutation(now)
+}
+
+// Merge replaces the retention entries in the metadata with the provided state
+func Merge(md map[string]any, state State) map[string]any {
+	if md == nil {
+		md = map[string]any{}
+	}
+
+	for _, key := range metadataKeys {
+		delete(md, key)
+	}
+
+	maps.Copy(md, state.Metadata())
+
+	return md
+}
+
+// Changed reports whether the retention entries differ between two metadata maps
+func Changed(previous, next map[string]any) bool {
+	return StateFromMetadata(previous) != StateFromMetadata(next)
+}
+
+// PlaceLegalHold returns the metadata with a legal hold placed by the subject
+func PlaceLegalHold(md map[string]any, reason, subjectID string, now time.Time) (map[string]any, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, ErrLegalHoldReasonRequired
+	}
+
+	state := StateFromMetadata(md)
+	state.LegalHold = true
+	state.LegalHoldReason = reason
+	state.LegalHoldBy = subjectID
+	state.LegalHoldAt = now.UTC().Truncate(time.Second)
+
+	return Merge(md, state), nil
+}
+
+// ReleaseLegalHold returns the metadata with any legal hold removed
+func ReleaseLegalHold(md map[string]any) map[string]any {
+	state := StateFromMetadata(md)
+	state.LegalHold = false
+	state.LegalHoldReason = ""
+	state.LegalHoldBy = ""
+	state.LegalHoldAt = time.Time{}
+
+	return Merge(md, state)
+}
+
+func stringValue(md map[string]any, key string) string {
+	s, _ := md[key].(string)
+
+	return s
+}
+
+func boolValue(md map[string]any, key string) bool {
+	switch v := md[key].(type) {
+	case bool:
+		return v
+	case string:
+		return strings.EqualFold(v, "true")
+	default:
+		return false
+	}
+}
+
+func timeValue(md map[string]any, key string) time.Time {
+	s := stringValue(md, key)
+	if s == "" {
+		return time.Time{}
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+
+	return t
+}
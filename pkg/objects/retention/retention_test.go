@@ -0,0 +1,177 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testRules() []Rule {
+	return []Rule{
+		{Name: "default", Period: 24 * time.Hour},
+		{Name: "evidence", Category: "evidence", Period: 7 * 24 * time.Hour, Purge: true},
+		{Name: "evidence-control", Category: "evidence", ObjectType: "control", Period: 30 * 24 * time.Hour},
+		{Name: "disabled", ObjectType: "procedure"},
+	}
+}
+
+func TestMatch(t *testing.T) {
+	rules := testRules()
+
+	tests := []struct {
+		name   string
+		target Target
+		rule   string
+	}{
+		{name: "fallback", target: Target{Category: "policy"}, rule: "default"},
+		{name: "category", target: Target{Category: "Evidence"}, rule: "evidence"},
+		{name: "category and object type", target: Target{Category: "evidence", ObjectType: "control"}, rule: "evidence-control"},
+		{name: "zero period rules are ignored", target: Target{ObjectType: "procedure"}, rule: "default"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rule, ok := Match(rules, tc.target)
+			require.True(t, ok)
+			assert.Equal(t, tc.rule, rule.Name)
+		})
+	}
+
+	_, ok := Match(nil, Target{Category: "evidence"})
+	assert.False(t, ok)
+}
+
+func TestPolicyDisabled(t *testing.T) {
+	policy := NewFromConfig(Config{})
+	assert.Nil(t, policy)
+
+	_, ok := policy.Assign(testRules(), Target{}, time.Now())
+	assert.False(t, ok)
+}
+
+func TestPolicyAssign(t *testing.T) {
+	policy := NewFromConfig(Config{
+		Enabled:    true,
+		ObjectLock: ObjectLockConfig{Enabled: true, Mode: "compliance"},
+	})
+
+	rules := []Rule{{Name: "evidence", Category: "evidence", Period: time.Hour, Purge: true}}
+	now := time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC)
+
+	_, ok := policy.Assign(rules, Target{Category: "policy"}, now)
+	assert.False(t, ok)
+
+	_, ok = policy.Assign(nil, Target{Category: "evidence"}, now)
+	assert.False(t, ok)
+
+	state, ok := policy.Assign(rules, Target{Category: "evidence"}, now)
+	require.True(t, ok)
+
+	assert.Equal(t, "evidence", state.Rule)
+	assert.Equal(t, time.Date(2026, 1, 2, 4, 4, 5, 0, time.UTC), state.RetainUntil)
+	assert.True(t, state.Purge)
+	assert.Equal(t, ObjectLockModeCompliance, state.ObjectLockMode)
+
+	lock := state.ObjectLock()
+	require.NotNil(t, lock)
+	assert.Equal(t, ObjectLockModeCompliance, lock.Mode)
+	assert.Equal(t, state.RetainUntil, lock.RetainUntil)
+}
+
+func TestStateMetadataRoundTrip(t *testing.T) {
+	state := State{
+		Rule:            "evidence",
+		RetainUntil:     time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+		Purge:           true,
+		ObjectLockMode:  ObjectLockModeGovernance,
+		LegalHold:       true,
+		LegalHoldReason: "litigation",
+		LegalHoldBy:     "user-1",
+		LegalHoldAt:     time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	md := Merge(map[string]any{"scan_status": "CLEAN"}, state)
+
+	assert.Equal(t, "CLEAN", md["scan_status"])
+	assert.Equal(t, state, StateFromMetadata(md))
+	assert.Equal(t, State{}, StateFromMetadata(nil))
+}
+
+func TestCheckMutation(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, CheckMutation(nil, now))
+	assert.NoError(t, CheckMutation(map[string]any{"scan_status": "CLEAN"}, now))
+
+	retained := State{RetainUntil: now.Add(time.Hour), Purge: true}.Metadata()
+	assert.ErrorIs(t, CheckMutation(retained, now), ErrRetentionActive)
+	assert.NoError(t, CheckMutation(retained, now.Add(time.Hour)))
+
+	held, err := PlaceLegalHold(State{RetainUntil: now.Add(-time.Hour)}.Metadata(), "subpoena", "user-1", now)
+	require.NoError(t, err)
+	assert.ErrorIs(t, CheckMutation(held, now), ErrLegalHoldActive)
+
+	released := ReleaseLegalHold(held)
+	assert.NoError(t, CheckMutation(released, now))
+	assert.NotContains(t, released, MetadataKeyLegalHoldReason)
+}
+
+func TestPurgeDue(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		state State
+		due   bool
+	}{
+		{name: "no retention", state: State{}, due: false},
+		{name: "retention running", state: State{RetainUntil: now.Add(time.Hour), Purge: true}, due: false},
+		{name: "retention ended", state: State{RetainUntil: now.Add(-time.Hour), Purge: true}, due: true},
+		{name: "retention ended without purge", state: State{RetainUntil: now.Add(-time.Hour)}, due: false},
+		{name: "legal hold", state: State{RetainUntil: now.Add(-time.Hour), Purge: true, LegalHold: true}, due: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.due, tc.state.PurgeDue(now))
+		})
+	}
+}
+
+func TestPlaceLegalHoldRequiresReason(t *testing.T) {
+	_, err := PlaceLegalHold(nil, "  ", "user-1", time.Now())
+	assert.ErrorIs(t, err, ErrLegalHoldReasonRequired)
+}
+
+func TestChanged(t *testing.T) {
+	md := State{Rule: "evidence", RetainUntil: time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)}.Metadata()
+
+	next := map[string]any{"name": "renamed"}
+	for k, v := range md {
+		next[k] = v
+	}
+
+	assert.False(t, Changed(md, next))
+
+	next[MetadataKeyRetainUntil] = "2026-01-01T00:00:00Z"
+	assert.True(t, Changed(md, next))
+}
+
+func TestConfigValidate(t *testing.T) {
+	assert.NoError(t, Config{}.Validate())
+	assert.NoError(t, Config{Enabled: true, ObjectLock: ObjectLockConfig{Enabled: true, Mode: "governance"}}.Validate())
+	assert.ErrorIs(t, Config{Enabled: true, ObjectLock: ObjectLockConfig{Enabled: true, Mode: "legal"}}.Validate(), ErrInvalidObjectLockMode)
+}
+
+func TestManagedUpdateContext(t *testing.T) {
+	ctx := context.Background()
+	assert.False(t, IsManagedUpdate(ctx))
+	assert.True(t, IsManagedUpdate(WithManagedUpdate(ctx)))
+
+	assert.False(t, IsRelocation(ctx))
+	assert.True(t, IsRelocation(WithRelocation(ctx)))
+	assert.False(t, IsManagedUpdate(WithRelocation(ctx)))
+}
@@ -0,0 +1,216 @@
+package scanner
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	// clamavScannerName is the name reported for ClamAV scan results
+	clamavScannerName = "clamav"
+	// defaultClamAVChunkSize is the size of each INSTREAM chunk sent to clamd
+	defaultClamAVChunkSize = 64 * 1024
+	// defaultClamAVTimeout bounds a single clamd request when the context has no deadline
+	defaultClamAVTimeout = 60 * time.Second
+)
+
+// ClamAV is a client for the clamd daemon using the INSTREAM command
+type ClamAV struct {
+	network   string
+	address   string
+	timeout   time.Duration
+	chunkSize int
+	dialer    net.Dialer
+}
+
+// ClamAVOption configures the ClamAV client
+type ClamAVOption func(*ClamAV)
+
+// WithClamAVTimeout sets the per request timeout used when the context has no deadline
+func WithClamAVTimeout(timeout time.Duration) ClamAVOption {
+	return func(c *ClamAV) {
+		if timeout > 0 {
+			c.timeout = timeout
+		}
+	}
+}
+
+// WithClamAVChunkSize sets the size of the chunks streamed to clamd
+func WithClamAVChunkSize(size int) ClamAVOption {
+	return func(c *ClamAV) {
+		if size > 0 {
+			c.chunkSize = size
+		}
+	}
+}
+
+// NewClamAV returns a clamd client; address may be host:port or a unix socket path prefixed with unix://
+func NewClamAV(address string, opts ...ClamAVOption) *ClamAV {
+	network := "tcp"
+	if after, ok := strings.CutPrefix(address, "unix://"); ok {
+		network = "unix"
+		address = after
+	} else if after, ok := strings.CutPrefix(address, "tcp://"); ok {
+		address = after
+	}
+
+	c := &ClamAV{
+		network:   network,
+		address:   address,
+		timeout:   defaultClamAVTimeout,
+		chunkSize: defaultClamAVChunkSize,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c
+}
+
+// Name returns the name of the scanner
+func (c *ClamAV) Name() string {
+	return clamavScannerName
+}
+
+// Ping checks that clamd is reachable and responding
+func (c *ClamAV) Ping(ctx context.Context) error {
+	resp, err := c.command(ctx, func(conn net.Conn) error {
+		_, err := conn.Write([]byte("zPING\x00"))
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp != "PONG" {
+		return fmt.Errorf("%w: %q", ErrUnexpectedResponse, resp)
+	}
+
+	return nil
+}
+
+// Scan streams the file to clamd and parses the verdict
+func (c *ClamAV) Scan(ctx context.Context, r io.Reader, _ Target) (Result, error) {
+	resp, err := c.command(ctx, func(conn net.Conn) error {
+		return c.writeStream(conn, r)
+	})
+	if err != nil {
+		return Result{Status: StatusError, Scanner: c.Name(), ScannedAt: time.Now()}, err
+	}
+
+	return parseClamAVResponse(resp)
+}
+
+// command dials clamd, runs the supplied write function and reads the null terminated response
+func (c *ClamAV) command(ctx context.Context, write func(net.Conn) error) (string, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	conn, err := c.dialer.DialContext(ctx, c.network, c.address)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrScannerUnavailable, err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return "", err
+		}
+	}
+
+	if err := write(conn); err != nil {
+		return "", err
+	}
+
+	resp, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+
+	return strings.TrimSpace(strings.TrimRight(resp, "\x00")), nil
+}
+
+// writeStream sends the INSTREAM command followed by length prefixed chunks and a zero length terminator
+func (c *ClamAV) writeStream(w io.Writer, r io.Reader) error {
+	if _, err := w.Write([]byte("zINSTREAM\x00")); err != nil {
+		return err
+	}
+
+	buf := make([]byte, c.chunkSize)
+	size := make([]byte, 4) //nolint:mnd
+
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			binary.BigEndian.PutUint32(size, uint32(n)) //nolint:gosec
+
+			if _, werr := w.Write(size); werr != nil {
+				return werr
+			}
+
+			if _, werr := w.Write(buf[:n]); werr != nil {
+				return werr
+			}
+		}
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write([]byte{0, 0, 0, 0})
+
+	return err
+}
+
+// parseClamAVResponse converts a clamd INSTREAM response into a scan result
+func parseClamAVResponse(resp string) (Result, error) {
+	result := Result{
+		Scanner:   clamavScannerName,
+		ScannedAt: time.Now(),
+	}
+
+	_, verdict, ok := strings.Cut(resp, ":")
+	if !ok {
+		result.Status = StatusError
+
+		if strings.Contains(resp, "size limit exceeded") {
+			return result, ErrStreamSizeExceeded
+		}
+
+		return result, fmt.Errorf("%w: %q", ErrUnexpectedResponse, resp)
+	}
+
+	verdict = strings.TrimSpace(verdict)
+
+	switch {
+	case verdict == "OK":
+		result.Status = StatusClean
+	case strings.HasSuffix(verdict, " FOUND"):
+		result.Status = StatusInfected
+		result.Threats = []string{strings.TrimSpace(strings.TrimSuffix(verdict, " FOUND"))}
+	case strings.Contains(verdict, "size limit exceeded"):
+		result.Status = StatusError
+		return result, ErrStreamSizeExceeded
+	default:
+		result.Status = StatusError
+		return result, fmt.Errorf("%w: %q", ErrUnexpectedResponse, resp)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,175 @@
+package scanner
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const eicar = `X5O!P%@AP[4\PZX54(P^)7CC)7}$EICAR-STANDARD-ANTIVIRUS-TEST-FILE!$H+H*`
+
+// fakeClamd is a minimal clamd implementation that flags payloads containing the EICAR test string
+func fakeClamd(t *testing.T) string {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { _ = ln.Close() })
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+
+			go handleFakeClamdConn(conn)
+		}
+	}()
+
+	return ln.Addr().String()
+}
+
+func handleFakeClamdConn(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+
+	cmd, err := r.ReadString(0)
+	if err != nil {
+		return
+	}
+
+	switch strings.TrimRight(cmd, "\x00") {
+	case "zPING":
+		_, _ = conn.Write([]byte("PONG\x00"))
+	case "zINSTREAM":
+		var payload bytes.Buffer
+
+		size := make([]byte, 4)
+
+		for {
+			if _, err := io.ReadFull(r, size); err != nil {
+				return
+			}
+
+			n := binary.BigEndian.Uint32(size)
+			if n == 0 {
+				break
+			}
+
+			if _, err := io.CopyN(&payload, r, int64(n)); err != nil {
+				return
+			}
+		}
+
+		if bytes.Contains(payload.Bytes(), []byte(eicar)) {
+			_, _ = conn.Write([]byte("stream: Win.Test.EICAR_HDB-1 FOUND\x00"))
+			return
+		}
+
+		_, _ = conn.Write([]byte("stream: OK\x00"))
+	default:
+		_, _ = conn.Write([]byte("UNKNOWN COMMAND\x00"))
+	}
+}
+
+func TestClamAVScan(t *testing.T) {
+	addr := fakeClamd(t)
+	client := NewClamAV(addr, WithClamAVChunkSize(8))
+
+	require.NoError(t, client.Ping(context.Background()))
+
+	testCases := []struct {
+		name            string
+		content         string
+		expectedStatus  Status
+		expectedThreats []string
+	}{
+		{
+			name:           "clean file",
+			content:        "quarterly access review evidence",
+			expectedStatus: StatusClean,
+		},
+		{
+			name:            "eicar test file",
+			content:         "prefix " + eicar + " suffix",
+			expectedStatus:  StatusInfected,
+			expectedThreats: []string{"Win.Test.EICAR_HDB-1"},
+		},
+		{
+			name:           "empty file",
+			content:        "",
+			expectedStatus: StatusClean,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := client.Scan(context.Background(), strings.NewReader(tc.content), Target{Name: "file.txt"})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.Status)
+			assert.Equal(t, tc.expectedThreats, res.Threats)
+			assert.Equal(t, "clamav", res.Scanner)
+		})
+	}
+}
+
+func TestClamAVUnavailable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	res, err := NewClamAV(addr).Scan(context.Background(), strings.NewReader("data"), Target{})
+	require.ErrorIs(t, err, ErrScannerUnavailable)
+	assert.Equal(t, StatusError, res.Status)
+}
+
+func TestParseClamAVResponse(t *testing.T) {
+	testCases := []struct {
+		name           string
+		resp           string
+		expectedStatus Status
+		expectedErr    error
+	}{
+		{name: "ok", resp: "stream: OK", expectedStatus: StatusClean},
+		{name: "found", resp: "stream: Eicar-Signature FOUND", expectedStatus: StatusInfected},
+		{name: "size limit", resp: "INSTREAM size limit exceeded. ERROR", expectedStatus: StatusError, expectedErr: ErrStreamSizeExceeded},
+		{name: "garbage", resp: "stream: something odd", expectedStatus: StatusError, expectedErr: ErrUnexpectedResponse},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseClamAVResponse(tc.resp)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tc.expectedStatus, res.Status)
+		})
+	}
+}
+
+func TestNewClamAVAddress(t *testing.T) {
+	unix := NewClamAV("unix:///var/run/clamd.sock")
+	assert.Equal(t, "unix", unix.network)
+	assert.Equal(t, "/var/run/clamd.sock", unix.address)
+
+	tcp := NewClamAV("tcp://clamd:3310")
+	assert.Equal(t, "tcp", tcp.network)
+	assert.Equal(t, "clamd:3310", tcp.address)
+}
@@ -0,0 +1,57 @@
+package scanner
+
+import "time"
+
+// Config contains configuration for content scanning of uploaded files
+type Config struct {
+	// Enabled indicates if uploaded files are scanned and quarantined until they pass
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// RejectInfected rejects the upload request when a threat is detected instead of only quarantining the file
+	RejectInfected bool `json:"rejectinfected" koanf:"rejectinfected" default:"true"`
+	// ClamAV contains configuration for the clamd scanner
+	ClamAV ClamAVConfig `json:"clamav" koanf:"clamav"`
+	// Rules contains configuration for the rule based macro and script scanner
+	Rules RulesConfig `json:"rules" koanf:"rules"`
+}
+
+// ClamAVConfig contains configuration for the clamd scanner
+type ClamAVConfig struct {
+	// Enabled indicates if files are streamed to clamd for scanning
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// Address is the clamd address as host:port or unix:///path/to/clamd.sock
+	Address string `json:"address" koanf:"address" default:"localhost:3310"`
+	// Timeout is the maximum time to wait for a clamd verdict
+	Timeout time.Duration `json:"timeout" koanf:"timeout" default:"60s"`
+}
+
+// RulesConfig contains configuration for the rule based scanner
+type RulesConfig struct {
+	// Enabled indicates if the built-in macro and embedded script rules are applied
+	Enabled bool `json:"enabled" koanf:"enabled" default:"true"`
+	// MaxSizeMB is the size in megabytes of the largest file inspected by the rules; larger files fail the scan and stay quarantined
+	MaxSizeMB int64 `json:"maxsizemb" koanf:"maxsizemb" default:"100"`
+}
+
+// NewFromConfig builds the scanner chain described by the configuration; it returns nil when scanning is disabled
+func NewFromConfig(cfg Config) Scanner {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	var scanners []Scanner
+
+	if cfg.Rules.Enabled {
+		scanners = append(scanners, NewRules(WithRulesMaxBytes(cfg.Rules.MaxSizeMB*1024*1024))) //nolint:mnd
+	}
+
+	if cfg.ClamAV.Enabled && cfg.ClamAV.Address != "" {
+		scanners = append(scanners, NewClamAV(cfg.ClamAV.Address, WithClamAVTimeout(cfg.ClamAV.Timeout)))
+	}
+
+	chain := NewChain(scanners...)
+	if chain.Len() == 0 {
+		return nil
+	}
+
+	return chain
+}
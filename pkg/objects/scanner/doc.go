@@ -0,0 +1,3 @@
+// Package scanner provides content scanning for uploaded files using a pluggable scanner interface,
+// with a ClamAV clamd protocol client and a rule based scanner for macros and embedded scripts
+package scanner
@@ -0,0 +1,18 @@
+package scanner
+
+import "errors"
+
+var (
+	// ErrScannerUnavailable is returned when the scanner backend cannot be reached
+	ErrScannerUnavailable = errors.New("file scanner unavailable")
+	// ErrUnexpectedResponse is returned when clamd returns a response that cannot be parsed
+	ErrUnexpectedResponse = errors.New("unexpected response from clamd")
+	// ErrStreamSizeExceeded is returned when clamd rejects a stream for exceeding its size limit
+	ErrStreamSizeExceeded = errors.New("file exceeds clamd stream size limit")
+	// ErrRulesSizeExceeded is returned when a file is larger than the rule scanner is configured to inspect
+	ErrRulesSizeExceeded = errors.New("file exceeds the rule scanner size limit")
+	// ErrFileQuarantined is returned when a file has not passed scanning and cannot be accessed
+	ErrFileQuarantined = errors.New("file is quarantined pending a successful content scan")
+	// ErrFileInfected is returned when a scan detects a threat in an uploaded file
+	ErrFileInfected = errors.New("file failed content scanning")
+)
@@ -0,0 +1,341 @@
+package scanner
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"time"
+	"unicode/utf16"
+)
+
+const (
+	// rulesScannerName is the name reported for rule based scan results
+	rulesScannerName = "rules"
+	// defaultRulesMaxBytes is the largest file the rule scanner will inspect
+	defaultRulesMaxBytes = 100 * 1024 * 1024
+	// rulesChunkSize is the number of bytes read at a time when searching file contents
+	rulesChunkSize = 1024 * 1024
+)
+
+var (
+	zipMagic = []byte("PK\x03\x04")
+	oleMagic = []byte{0xD0, 0xCF, 0x11, 0xE0, 0xA1, 0xB1, 0x1A, 0xE1}
+	pdfMagic = []byte("%PDF-")
+)
+
+// Rule is a single content check; Detect returns true when the file should be treated as infected
+type Rule struct {
+	// Name is the threat name reported when the rule matches
+	Name string
+	// Detect inspects the file contents and reports a match
+	Detect func(content Content, target Target) bool
+}
+
+// Content gives rules random access to the scanned file so large files are never held in memory
+type Content struct {
+	*io.SectionReader
+}
+
+// Head returns up to the first n bytes of the content
+func (c Content) Head(n int) []byte {
+	buf := make([]byte, min(int64(n), c.Size()))
+
+	read, _ := c.ReadAt(buf, 0)
+
+	return buf[:read]
+}
+
+// HasPrefix reports whether the content starts with prefix
+func (c Content) HasPrefix(prefix []byte) bool {
+	return bytes.HasPrefix(c.Head(len(prefix)), prefix)
+}
+
+// Contains reports whether any of the markers appears in the content
+func (c Content) Contains(markers ...[]byte) bool {
+	return c.search(false, markers)
+}
+
+// ContainsFold reports whether any of the lower case markers appears in the content, ignoring case
+func (c Content) ContainsFold(markers ...[]byte) bool {
+	return c.search(true, markers)
+}
+
+// search reads the content in chunks that overlap by the longest marker so matches spanning
+// two chunks are still found
+func (c Content) search(fold bool, markers [][]byte) bool {
+	overlap := 0
+	for _, m := range markers {
+		overlap = max(overlap, len(m)-1)
+	}
+
+	buf := make([]byte, rulesChunkSize+overlap)
+	carry := 0
+
+	for off := int64(0); off < c.Size(); {
+		n, err := c.ReadAt(buf[carry:], off)
+		if n == 0 {
+			return false
+		}
+
+		window := buf[:carry+n]
+
+		haystack := window
+		if fold {
+			haystack = bytes.ToLower(window)
+		}
+
+		for _, m := range markers {
+			if bytes.Contains(haystack, m) {
+				return true
+			}
+		}
+
+		if err != nil {
+			return false
+		}
+
+		off += int64(n)
+		carry = min(overlap, len(window))
+		copy(buf, window[len(window)-carry:])
+	}
+
+	return false
+}
+
+// Rules is a scanner that applies static content rules to detect macros and embedded scripts
+type Rules struct {
+	rules    []Rule
+	maxBytes int64
+}
+
+// RulesOption configures the rule based scanner
+type RulesOption func(*Rules)
+
+// WithRules replaces the default rule set
+func WithRules(rules ...Rule) RulesOption {
+	return func(r *Rules) {
+		r.rules = rules
+	}
+}
+
+// WithAdditionalRules appends rules to the default rule set
+func WithAdditionalRules(rules ...Rule) RulesOption {
+	return func(r *Rules) {
+		r.rules = append(r.rules, rules...)
+	}
+}
+
+// WithRulesMaxBytes sets the size of the largest file the rules inspect; larger files fail the scan
+func WithRulesMaxBytes(maxBytes int64) RulesOption {
+	return func(r *Rules) {
+		if maxBytes > 0 {
+			r.maxBytes = maxBytes
+		}
+	}
+}
+
+// NewRules returns a rule based scanner configured with the default rules
+func NewRules(opts ...RulesOption) *Rules {
+	r := &Rules{
+		rules:    DefaultRules(),
+		maxBytes: defaultRulesMaxBytes,
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Name returns the name of the scanner
+func (r *Rules) Name() string {
+	return rulesScannerName
+}
+
+// Scan applies every rule to the file, collecting the names of matching rules as threats; files larger than
+// the configured maximum fail the scan rather than being passed on a partial inspection
+func (r *Rules) Scan(ctx context.Context, reader io.Reader, target Target) (Result, error) {
+	content, cleanup, err := r.content(reader)
+	if err != nil {
+		return Result{Status: StatusError, Scanner: r.Name(), ScannedAt: time.Now()}, err
+	}
+
+	defer cleanup()
+
+	result := Result{
+		Status:  StatusClean,
+		Scanner: r.Name(),
+	}
+
+	for _, rule := range r.rules {
+		if err := ctx.Err(); err != nil {
+			return Result{Status: StatusError, Scanner: r.Name(), ScannedAt: time.Now()}, err
+		}
+
+		if rule.Detect(content, target) {
+			result.Status = StatusInfected
+			result.Threats = append(result.Threats, rule.Name)
+		}
+	}
+
+	result.ScannedAt = time.Now()
+
+	return result, nil
+}
+
+// content returns random access to the file; seekable readers are read in place and anything else is
+// spooled to a temporary file, so only one chunk of the file is held in memory at a time
+func (r *Rules) content(reader io.Reader) (Content, func(), error) {
+	if ra, ok := reader.(interface {
+		io.ReaderAt
+		io.Seeker
+	}); ok {
+		start, err := ra.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return Content{}, nil, err
+		}
+
+		end, err := ra.Seek(0, io.SeekEnd)
+		if err != nil {
+			return Content{}, nil, err
+		}
+
+		if _, err := ra.Seek(start, io.SeekStart); err != nil {
+			return Content{}, nil, err
+		}
+
+		if end-start > r.maxBytes {
+			return Content{}, nil, ErrRulesSizeExceeded
+		}
+
+		return Content{io.NewSectionReader(ra, start, end-start)}, func() {}, nil
+	}
+
+	spool, err := os.CreateTemp("", "openlane-scan-*")
+	if err != nil {
+		return Content{}, nil, err
+	}
+
+	cleanup := func() {
+		_ = spool.Close()
+		_ = os.Remove(spool.Name())
+	}
+
+	size, err := io.Copy(spool, io.LimitReader(reader, r.maxBytes+1))
+	if err != nil {
+		cleanup()
+
+		return Content{}, nil, err
+	}
+
+	if size > r.maxBytes {
+		cleanup()
+
+		return Content{}, nil, ErrRulesSizeExceeded
+	}
+
+	return Content{io.NewSectionReader(spool, 0, size)}, cleanup, nil
+}
+
+// DefaultRules returns the built-in rules for office macros, active content in PDFs and scripts in markup
+func DefaultRules() []Rule {
+	return []Rule{
+		{Name: "Openlane.OOXML.Macro", Detect: detectOOXMLMacro},
+		{Name: "Openlane.OLE.Macro", Detect: detectOLEMacro},
+		{Name: "Openlane.PDF.ActiveContent", Detect: detectPDFActiveContent},
+		{Name: "Openlane.Markup.Script", Detect: detectMarkupScript},
+	}
+}
+
+// detectOOXMLMacro looks for VBA projects and ActiveX parts inside office open xml archives
+func detectOOXMLMacro(content Content, _ Target) bool {
+	if !content.HasPrefix(zipMagic) {
+		return false
+	}
+
+	zr, err := zip.NewReader(content, content.Size())
+	if err != nil {
+		return false
+	}
+
+	for _, f := range zr.File {
+		name := strings.ToLower(f.Name)
+		base := path.Base(name)
+
+		switch {
+		case base == "vbaproject.bin", base == "vbadata.xml":
+			return true
+		case strings.Contains(name, "/activex/"):
+			return true
+		}
+	}
+
+	return false
+}
+
+// detectOLEMacro looks for VBA storage names in legacy compound office documents
+func detectOLEMacro(content Content, _ Target) bool {
+	if !content.HasPrefix(oleMagic) {
+		return false
+	}
+
+	return content.Contains(utf16LE("_VBA_PROJECT"), utf16LE("VBA"), utf16LE("Macros")) && content.Contains(utf16LE("PROJECT"))
+}
+
+// detectPDFActiveContent looks for javascript and launch actions in PDF documents; embedded files are
+// allowed because PDF/A-3 archives carry their source documents as attachments
+func detectPDFActiveContent(content Content, _ Target) bool {
+	if !bytes.HasPrefix(bytes.TrimLeft(content.Head(1024), "\x00\r\n\t "), pdfMagic) { //nolint:mnd
+		return false
+	}
+
+	return content.Contains(
+		[]byte("/JavaScript"),
+		[]byte("/JS"),
+		[]byte("/Launch"),
+	)
+}
+
+// detectMarkupScript looks for script elements and javascript URIs in html, svg and xml files
+func detectMarkupScript(content Content, target Target) bool {
+	if !isMarkup(target) {
+		return false
+	}
+
+	return content.ContainsFold(
+		[]byte("<script"),
+		[]byte("javascript:"),
+	)
+}
+
+// isMarkup reports whether the target is a markup document that a browser may execute
+func isMarkup(target Target) bool {
+	ct := strings.ToLower(target.ContentType)
+	if strings.Contains(ct, "html") || strings.Contains(ct, "svg") || strings.Contains(ct, "xml") {
+		return true
+	}
+
+	switch strings.ToLower(path.Ext(target.Name)) {
+	case ".html", ".htm", ".xhtml", ".svg", ".xml":
+		return true
+	default:
+		return false
+	}
+}
+
+// utf16LE encodes s as little endian UTF-16, which is how OLE directory entry names are stored
+func utf16LE(s string) []byte {
+	encoded := utf16.Encode([]rune(s))
+	out := make([]byte, 0, len(encoded)*2) //nolint:mnd
+
+	for _, u := range encoded {
+		out = append(out, byte(u), byte(u>>8)) //nolint:mnd
+	}
+
+	return out
+}
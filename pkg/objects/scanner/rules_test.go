@@ -0,0 +1,160 @@
+package scanner
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildZip(t *testing.T, names ...string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+	for _, name := range names {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+
+		_, err = w.Write([]byte("content"))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func TestRulesScan(t *testing.T) {
+	oleWithMacro := append(append([]byte{}, oleMagic...), utf16LE("_VBA_PROJECT")...)
+	oleWithMacro = append(oleWithMacro, utf16LE("PROJECT")...)
+
+	testCases := []struct {
+		name            string
+		data            []byte
+		target          Target
+		expectedStatus  Status
+		expectedThreats []string
+	}{
+		{
+			name:           "plain docx",
+			data:           buildZip(t, "[Content_Types].xml", "word/document.xml"),
+			target:         Target{Name: "policy.docx"},
+			expectedStatus: StatusClean,
+		},
+		{
+			name:            "macro enabled docm",
+			data:            buildZip(t, "[Content_Types].xml", "word/document.xml", "word/vbaProject.bin"),
+			target:          Target{Name: "policy.docm"},
+			expectedStatus:  StatusInfected,
+			expectedThreats: []string{"Openlane.OOXML.Macro"},
+		},
+		{
+			name:            "activex control",
+			data:            buildZip(t, "[Content_Types].xml", "xl/activeX/activeX1.xml"),
+			target:          Target{Name: "sheet.xlsx"},
+			expectedStatus:  StatusInfected,
+			expectedThreats: []string{"Openlane.OOXML.Macro"},
+		},
+		{
+			name:            "legacy doc with vba",
+			data:            oleWithMacro,
+			target:          Target{Name: "old.doc"},
+			expectedStatus:  StatusInfected,
+			expectedThreats: []string{"Openlane.OLE.Macro"},
+		},
+		{
+			name:           "plain pdf",
+			data:           []byte("%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj"),
+			target:         Target{Name: "report.pdf"},
+			expectedStatus: StatusClean,
+		},
+		{
+			name:            "pdf with javascript",
+			data:            []byte("%PDF-1.7\n1 0 obj << /OpenAction << /S /JavaScript /JS (app.alert(1)) >> >> endobj"),
+			target:          Target{Name: "report.pdf"},
+			expectedStatus:  StatusInfected,
+			expectedThreats: []string{"Openlane.PDF.ActiveContent"},
+		},
+		{
+			name:           "pdf/a-3 with embedded source document",
+			data:           []byte("%PDF-1.7\n1 0 obj << /Type /Filespec /EF << /F 2 0 R >> >> endobj 2 0 obj << /Type /EmbeddedFile >> endobj"),
+			target:         Target{Name: "archive.pdf"},
+			expectedStatus: StatusClean,
+		},
+		{
+			name:            "svg with script",
+			data:            []byte(`<svg xmlns="http://www.w3.org/2000/svg"><SCRIPT>alert(1)</SCRIPT></svg>`),
+			target:          Target{Name: "logo.svg", ContentType: "image/svg+xml"},
+			expectedStatus:  StatusInfected,
+			expectedThreats: []string{"Openlane.Markup.Script"},
+		},
+		{
+			name:           "markdown mentioning script is not markup",
+			data:           []byte("use a `<script>` tag to load the widget"),
+			target:         Target{Name: "readme.md", ContentType: "text/markdown"},
+			expectedStatus: StatusClean,
+		},
+	}
+
+	scanner := NewRules()
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := scanner.Scan(context.Background(), bytes.NewReader(tc.data), tc.target)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectedStatus, res.Status)
+			assert.Equal(t, tc.expectedThreats, res.Threats)
+		})
+	}
+}
+
+func TestRulesCustomRule(t *testing.T) {
+	scanner := NewRules(WithRules(Rule{
+		Name: "Custom.Marker",
+		Detect: func(content Content, _ Target) bool {
+			return content.Contains([]byte("forbidden"))
+		},
+	}))
+
+	res, err := scanner.Scan(context.Background(), bytes.NewReader([]byte("this is forbidden")), Target{})
+	require.NoError(t, err)
+	assert.Equal(t, StatusInfected, res.Status)
+	assert.Equal(t, []string{"Custom.Marker"}, res.Threats)
+}
+
+func TestRulesMarkerAcrossChunks(t *testing.T) {
+	data := append(bytes.Repeat([]byte(" "), rulesChunkSize-4), []byte("<script>alert(1)</script>")...)
+
+	for name, reader := range map[string]io.Reader{
+		"seekable":     bytes.NewReader(data),
+		"not seekable": io.NopCloser(bytes.NewReader(data)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := NewRules().Scan(context.Background(), reader, Target{Name: "page.html"})
+			require.NoError(t, err)
+			assert.Equal(t, StatusInfected, res.Status)
+		})
+	}
+}
+
+func TestRulesSizeExceeded(t *testing.T) {
+	scanner := NewRules(WithRulesMaxBytes(8))
+
+	for name, reader := range map[string]io.Reader{
+		"seekable":     bytes.NewReader([]byte("more than eight bytes")),
+		"not seekable": io.NopCloser(bytes.NewReader([]byte("more than eight bytes"))),
+	} {
+		t.Run(name, func(t *testing.T) {
+			res, err := scanner.Scan(context.Background(), reader, Target{})
+			require.ErrorIs(t, err, ErrRulesSizeExceeded)
+			assert.Equal(t, StatusError, res.Status)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+)
+
+// Status is the scan status recorded against a file
+type Status string
+
+const (
+	// StatusPending indicates the file has not yet been scanned and is quarantined
+	StatusPending Status = "PENDING"
+	// StatusClean indicates the file passed every configured scanner
+	StatusClean Status = "CLEAN"
+	// StatusInfected indicates at least one scanner detected a threat
+	StatusInfected Status = "INFECTED"
+	// StatusError indicates the scan could not be completed; the file remains quarantined
+	StatusError Status = "ERROR"
+)
+
+// String returns the string representation of the status
+func (s Status) String() string {
+	return string(s)
+}
+
+// Quarantined reports whether a file with this status must be hidden from download and presign
+func (s Status) Quarantined() bool {
+	return s != StatusClean
+}
+
+const (
+	// MetadataKeyThreats is the file metadata key holding the detected threat names
+	MetadataKeyThreats = "scan_threats"
+	// MetadataKeyScanner is the file metadata key holding the scanner(s) that produced the result
+	MetadataKeyScanner = "scan_engine"
+	// MetadataKeyScannedAt is the file metadata key holding the time the scan completed
+	MetadataKeyScannedAt = "scanned_at"
+)
+
+// Target describes the file being scanned so scanners can tailor their checks
+type Target struct {
+	// Name is the original file name provided by the client
+	Name string
+	// ContentType is the detected MIME type of the file
+	ContentType string
+	// Size is the size of the file in bytes, when known
+	Size int64
+}
+
+// Result is the outcome of scanning a single file
+type Result struct {
+	// Status is the overall scan status
+	Status Status
+	// Threats contains the names of any detected threats
+	Threats []string
+	// Scanner is the name of the scanner(s) that produced the result
+	Scanner string
+	// ScannedAt is the time the scan completed
+	ScannedAt time.Time
+}
+
+// Metadata returns the details of the result formatted as file metadata entries; the status itself is
+// recorded on the file scan status field, which users cannot modify
+func (r Result) Metadata() map[string]any {
+	md := map[string]any{
+		MetadataKeyScanner:   r.Scanner,
+		MetadataKeyScannedAt: r.ScannedAt.UTC().Format(time.RFC3339),
+	}
+
+	if len(r.Threats) > 0 {
+		md[MetadataKeyThreats] = strings.Join(r.Threats, ",")
+	}
+
+	return md
+}
+
+// Scanner inspects file contents and reports whether they are safe to serve
+type Scanner interface {
+	// Name returns the name of the scanner used in results and logs
+	Name() string
+	// Scan reads the file contents and returns the scan result
+	Scan(ctx context.Context, r io.Reader, target Target) (Result, error)
+}
+
+// Chain runs a set of scanners in order; a file is clean only when every scanner reports it clean
+type Chain struct {
+	scanners []Scanner
+}
+
+// NewChain returns a scanner that runs each of the provided scanners, skipping nil entries
+func NewChain(scanners ...Scanner) *Chain {
+	c := &Chain{}
+
+	for _, s := range scanners {
+		if s != nil {
+			c.scanners = append(c.scanners, s)
+		}
+	}
+
+	return c
+}
+
+// Name returns the name of the scanner chain
+func (c *Chain) Name() string {
+	names := make([]string, 0, len(c.scanners))
+	for _, s := range c.scanners {
+		names = append(names, s.Name())
+	}
+
+	return strings.Join(names, "+")
+}
+
+// Len returns the number of scanners in the chain
+func (c *Chain) Len() int {
+	return len(c.scanners)
+}
+
+// Scan runs every scanner against the file contents; the reader must be seekable when more than one scanner is configured
+func (c *Chain) Scan(ctx context.Context, r io.Reader, target Target) (Result, error) {
+	result := Result{
+		Status:  StatusClean,
+		Scanner: c.Name(),
+	}
+
+	for i, s := range c.scanners {
+		if i > 0 {
+			seeker, ok := r.(io.Seeker)
+			if !ok {
+				return Result{Status: StatusError, Scanner: c.Name(), ScannedAt: time.Now()}, errors.ErrUnsupported
+			}
+
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return Result{Status: StatusError, Scanner: c.Name(), ScannedAt: time.Now()}, err
+			}
+		}
+
+		res, err := s.Scan(ctx, r, target)
+		if err != nil {
+			return Result{Status: StatusError, Scanner: c.Name(), ScannedAt: time.Now()}, err
+		}
+
+		if res.Status == StatusInfected {
+			result.Status = StatusInfected
+			result.Threats = append(result.Threats, res.Threats...)
+		}
+	}
+
+	result.ScannedAt = time.Now()
+
+	return result, nil
+}
+
+// ParseStatus returns the scan status recorded on a file; files without a recorded status were stored
+// while scanning was disabled and are treated as clean
+func ParseStatus(status string) Status {
+	if status == "" {
+		return StatusClean
+	}
+
+	return Status(strings.ToUpper(status))
+}
+
+// CheckAccess returns ErrFileQuarantined when the recorded scan status indicates the file has not passed scanning
+func CheckAccess(status string) error {
+	if ParseStatus(status).Quarantined() {
+		return ErrFileQuarantined
+	}
+
+	return nil
+}
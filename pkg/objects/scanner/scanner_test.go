@@ -0,0 +1,81 @@
+package scanner
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainScan(t *testing.T) {
+	addr := fakeClamd(t)
+	chain := NewChain(NewRules(), nil, NewClamAV(addr))
+
+	assert.Equal(t, 2, chain.Len())
+	assert.Equal(t, "rules+clamav", chain.Name())
+
+	res, err := chain.Scan(context.Background(), bytes.NewReader([]byte("clean evidence")), Target{Name: "a.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusClean, res.Status)
+
+	res, err = chain.Scan(context.Background(), bytes.NewReader([]byte(eicar)), Target{Name: "a.txt"})
+	require.NoError(t, err)
+	assert.Equal(t, StatusInfected, res.Status)
+	assert.Equal(t, []string{"Win.Test.EICAR_HDB-1"}, res.Threats)
+}
+
+func TestChainRequiresSeeker(t *testing.T) {
+	chain := NewChain(NewRules(), NewRules())
+
+	res, err := chain.Scan(context.Background(), io.NopCloser(strings.NewReader("data")), Target{})
+	require.Error(t, err)
+	assert.Equal(t, StatusError, res.Status)
+}
+
+func TestParseStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   string
+		expected Status
+		quarant  bool
+	}{
+		{name: "not scanned", status: "", expected: StatusClean},
+		{name: "pending", status: "PENDING", expected: StatusPending, quarant: true},
+		{name: "lowercase clean", status: "clean", expected: StatusClean},
+		{name: "infected", status: "INFECTED", expected: StatusInfected, quarant: true},
+		{name: "error", status: "ERROR", expected: StatusError, quarant: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, ParseStatus(tc.status))
+
+			if tc.quarant {
+				assert.ErrorIs(t, CheckAccess(tc.status), ErrFileQuarantined)
+			} else {
+				assert.NoError(t, CheckAccess(tc.status))
+			}
+		})
+	}
+}
+
+func TestNewFromConfig(t *testing.T) {
+	assert.Nil(t, NewFromConfig(Config{}))
+	assert.Nil(t, NewFromConfig(Config{Enabled: true}))
+
+	s := NewFromConfig(Config{Enabled: true, Rules: RulesConfig{Enabled: true, MaxSizeMB: 1}})
+	require.NotNil(t, s)
+	assert.Equal(t, "rules", s.Name())
+
+	s = NewFromConfig(Config{
+		Enabled: true,
+		Rules:   RulesConfig{Enabled: true},
+		ClamAV:  ClamAVConfig{Enabled: true, Address: "localhost:3310"},
+	})
+	require.NotNil(t, s)
+	assert.Equal(t, "rules+clamav", s.Name())
+}
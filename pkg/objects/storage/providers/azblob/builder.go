@@ -0,0 +1,62 @@
+package azblob
+
+import (
+	"context"
+
+	"github.com/samber/mo"
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+// Builder creates Azure Blob providers for the client pool
+type Builder struct {
+	options []Option
+}
+
+// NewAzureBlobBuilder creates a new Builder
+func NewAzureBlobBuilder() *Builder {
+	return &Builder{}
+}
+
+// WithOptions sets provider options for the builder
+func (b *Builder) WithOptions(opts ...Option) *Builder {
+	b.options = append(b.options, opts...)
+	return b
+}
+
+// Build implements eddy.Builder
+func (b *Builder) Build(_ context.Context, credentials storage.ProviderCredentials, config *storage.ProviderOptions) (storagetypes.Provider, error) {
+	if config == nil {
+		config = storage.NewProviderOptions()
+	}
+
+	cfg := config.Clone()
+	cfg.Credentials = credentials
+
+	if cfg.Bucket == "" || cfg.Credentials.AccountName == "" {
+		return nil, ErrAzureCredentialsRequired
+	}
+
+	return NewAzureBlobProvider(cfg, b.options...)
+}
+
+// ProviderType implements eddy.Builder
+func (b *Builder) ProviderType() string {
+	return string(storagetypes.AzureBlobProvider)
+}
+
+// NewAzureBlobProviderFromCredentials creates an Azure Blob provider using the supplied credentials and options
+func NewAzureBlobProviderFromCredentials(credentials storage.ProviderCredentials, options *storage.ProviderOptions) mo.Result[storagetypes.Provider] {
+	cfg := storage.NewProviderOptions()
+	if options != nil {
+		cfg = options.Clone()
+	}
+	cfg.Credentials = credentials
+
+	provider, err := NewAzureBlobProvider(cfg)
+	if err != nil {
+		return mo.Err[storagetypes.Provider](err)
+	}
+
+	return mo.Ok[storagetypes.Provider](provider)
+}
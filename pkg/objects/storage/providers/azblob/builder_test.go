@@ -0,0 +1,27 @@
+package azblob
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+func TestAzureBlobBuilder(t *testing.T) {
+	builder := NewAzureBlobBuilder()
+	assert.Equal(t, string(storagetypes.AzureBlobProvider), builder.ProviderType())
+
+	_, err := builder.Build(context.Background(), storage.ProviderCredentials{AccountKey: azuriteKey}, storage.NewProviderOptions(storage.WithBucket(testContainer)))
+	assert.ErrorIs(t, err, ErrAzureCredentialsRequired)
+
+	provider, err := builder.Build(context.Background(), storage.ProviderCredentials{AccountName: azuriteAccount, AccountKey: azuriteKey}, storage.NewProviderOptions(storage.WithBucket(testContainer)))
+	require.NoError(t, err)
+	assert.Equal(t, storagetypes.AzureBlobProvider, provider.ProviderType())
+
+	result := NewAzureBlobProviderFromCredentials(storage.ProviderCredentials{}, nil)
+	assert.True(t, result.IsError())
+}
@@ -0,0 +1,2 @@
+// Package azblob is the Azure Blob Storage provider for objects service
+package azblob
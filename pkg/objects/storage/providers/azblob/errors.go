@@ -0,0 +1,16 @@
+package azblob
+
+import "errors"
+
+var (
+	// ErrAzureCredentialsRequired is returned when required Azure credentials are missing
+	ErrAzureCredentialsRequired = errors.New("missing required Azure Blob credentials: bucket, account_name")
+	// ErrAzureContainerRequired is returned when the Azure container is not specified
+	ErrAzureContainerRequired = errors.New("azure blob container (bucket) is required")
+	// ErrAzureAccountNameRequired is returned when the Azure storage account name is not specified
+	ErrAzureAccountNameRequired = errors.New("azure storage account name is required")
+	// ErrAzureAccountKeyInvalid is returned when the Azure storage account key is not base64 encoded
+	ErrAzureAccountKeyInvalid = errors.New("azure storage account key is invalid")
+	// ErrAzureRequestFailed is returned when the Azure Blob service responds with an unexpected status
+	ErrAzureRequestFailed = errors.New("azure blob request failed")
+)
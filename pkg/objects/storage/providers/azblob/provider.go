@@ -0,0 +1,396 @@
+package azblob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	azureblob "github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/service"
+	"github.com/rs/zerolog/log"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/metrics"
+	"github.com/theopenlane/core/pkg/objects/storage"
+	"github.com/theopenlane/core/pkg/objects/storage/proxy"
+)
+
+const (
+	// DefaultPresignedURLExpiry defines the default expiry time for SAS download URLs
+	DefaultPresignedURLExpiry = 15 * time.Minute
+	// DefaultRequestTimeout bounds a single request to the Blob service
+	DefaultRequestTimeout = 5 * time.Minute
+	// sasClockSkew backdates the start of a user delegation key to tolerate clock drift
+	sasClockSkew = 5 * time.Minute
+)
+
+// Provider implements the storagetypes.Provider interface for Azure Blob Storage
+type Provider struct {
+	client              *azureblob.Client
+	sharedKey           *azureblob.SharedKeyCredential
+	endpoint            *url.URL
+	options             *storage.ProviderOptions
+	proxyPresignEnabled bool
+	proxyConfig         *storage.ProxyPresignConfig
+}
+
+// providerConfig holds configuration for the Azure Blob provider
+type providerConfig struct {
+	options         *storage.ProviderOptions
+	httpClient      *http.Client
+	tokenCredential azcore.TokenCredential
+}
+
+// Option configures the Azure Blob provider during construction
+type Option func(*providerConfig)
+
+// WithHTTPClient configures the HTTP client used to reach the Blob service
+func WithHTTPClient(client *http.Client) Option {
+	return func(cfg *providerConfig) {
+		cfg.httpClient = client
+	}
+}
+
+// WithTokenCredential configures the Microsoft Entra credential used when no account key is supplied
+func WithTokenCredential(credential azcore.TokenCredential) Option {
+	return func(cfg *providerConfig) {
+		cfg.tokenCredential = credential
+	}
+}
+
+// NewAzureBlobProvider creates a new Azure Blob provider instance; the bucket option is used as the container name.
+// Requests are authorized with the storage account key when one is configured, otherwise with a Microsoft Entra
+// credential resolved through azidentity. When no endpoint is configured the public endpoint for the storage
+// account is used, while an Azurite style endpoint such as http://127.0.0.1:10000/devstoreaccount1 can be
+// supplied for local development
+func NewAzureBlobProvider(options *storage.ProviderOptions, opts ...Option) (*Provider, error) {
+	config := providerConfig{
+		options: options,
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&config)
+		}
+	}
+
+	if config.options == nil || config.options.Bucket == "" {
+		return nil, ErrAzureContainerRequired
+	}
+
+	accountName := config.options.Credentials.AccountName
+	if accountName == "" {
+		return nil, ErrAzureAccountNameRequired
+	}
+
+	rawEndpoint := config.options.Endpoint
+	if rawEndpoint == "" {
+		rawEndpoint = fmt.Sprintf("https://%s.blob.core.windows.net", accountName)
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(rawEndpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	httpClient := config.httpClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: DefaultRequestTimeout}
+	}
+
+	clientOptions := &azureblob.ClientOptions{
+		ClientOptions: policy.ClientOptions{Transport: httpClient},
+	}
+
+	provider := &Provider{
+		endpoint:            endpoint,
+		options:             config.options.Clone(),
+		proxyPresignEnabled: config.options.ProxyPresignEnabled,
+		proxyConfig:         config.options.ProxyPresignConfig,
+	}
+
+	if accountKey := config.options.Credentials.AccountKey; accountKey != "" {
+		provider.sharedKey, err = azureblob.NewSharedKeyCredential(accountName, accountKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAzureAccountKeyInvalid, err)
+		}
+
+		provider.client, err = azureblob.NewClientWithSharedKeyCredential(endpoint.String(), provider.sharedKey, clientOptions)
+		if err != nil {
+			return nil, err
+		}
+
+		return provider, nil
+	}
+
+	credential := config.tokenCredential
+	if credential == nil {
+		credential, err = azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+			ClientOptions: policy.ClientOptions{Transport: httpClient},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrAzureCredentialsRequired, err)
+		}
+	}
+
+	provider.client, err = azureblob.NewClient(endpoint.String(), credential, clientOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider, nil
+}
+
+// Upload implements storagetypes.Provider, streaming the reader to a block blob
+func (p *Provider) Upload(ctx context.Context, reader io.Reader, opts *storagetypes.UploadFileOptions) (*storagetypes.UploadedFileMetadata, error) {
+	objectKey := opts.FileName
+	if opts.FolderDestination != "" {
+		objectKey = path.Join(opts.FolderDestination, opts.FileName)
+	}
+
+	uploadOpts := &azureblob.UploadStreamOptions{}
+	if opts.ContentType != "" {
+		uploadOpts.HTTPHeaders = &blob.HTTPHeaders{BlobContentType: &opts.ContentType}
+	}
+
+	counter := &countingReader{reader: reader}
+
+	if _, err := p.client.UploadStream(ctx, p.options.Bucket, objectKey, counter, uploadOpts); err != nil {
+		return nil, requestError(err)
+	}
+
+	metrics.RecordStorageUpload(string(storagetypes.AzureBlobProvider), counter.size)
+
+	return &storagetypes.UploadedFileMetadata{
+		FileMetadata: storagetypes.FileMetadata{
+			Key:          objectKey,
+			Size:         counter.size,
+			Folder:       opts.FolderDestination,
+			Bucket:       p.options.Bucket,
+			Region:       p.options.Region,
+			ContentType:  opts.ContentType,
+			ProviderType: storagetypes.AzureBlobProvider,
+			FullURI:      fmt.Sprintf("azblob://%s/%s", p.options.Bucket, objectKey),
+		},
+	}, nil
+}
+
+// Download implements storagetypes.Provider
+func (p *Provider) Download(ctx context.Context, file *storagetypes.File, _ *storagetypes.DownloadFileOptions) (*storagetypes.DownloadedFileMetadata, error) {
+	resp, err := p.client.DownloadStream(ctx, p.container(file), file.Key, nil)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadedSize := int64(len(data))
+	metrics.RecordStorageDownload(string(storagetypes.AzureBlobProvider), downloadedSize)
+
+	return &storagetypes.DownloadedFileMetadata{
+		File: data,
+		Size: downloadedSize,
+	}, nil
+}
+
+// Open implements storagetypes.StreamingProvider, returning the blob body without buffering it
+func (p *Provider) Open(ctx context.Context, file *storagetypes.File) (io.ReadCloser, error) {
+	resp, err := p.client.DownloadStream(ctx, p.container(file), file.Key, nil)
+	if err != nil {
+		return nil, requestError(err)
+	}
+
+	if resp.ContentLength != nil {
+		metrics.RecordStorageDownload(string(storagetypes.AzureBlobProvider), *resp.ContentLength)
+	}
+
+	return resp.Body, nil
+}
+
+// Delete implements storagetypes.Provider
+func (p *Provider) Delete(ctx context.Context, file *storagetypes.File, _ *storagetypes.DeleteFileOptions) error {
+	if _, err := p.client.DeleteBlob(ctx, p.container(file), file.Key, nil); err != nil && !isNotFound(err) {
+		return requestError(err)
+	}
+
+	metrics.RecordStorageDelete(string(storagetypes.AzureBlobProvider))
+
+	return nil
+}
+
+// GetPresignedURL implements storagetypes.Provider, returning a read-only SAS URL for the blob. The SAS is
+// signed with the account key when one is configured, otherwise with a user delegation key
+func (p *Provider) GetPresignedURL(ctx context.Context, file *storagetypes.File, opts *storagetypes.PresignedURLOptions) (string, error) {
+	if opts == nil {
+		opts = &storagetypes.PresignedURLOptions{}
+	}
+
+	if p.proxyPresignEnabled && p.proxyConfig != nil && p.proxyConfig.TokenManager != nil {
+		url, err := proxy.GenerateDownloadURL(ctx, file, opts.Duration, p.proxyConfig)
+		if err == nil {
+			return url, nil
+		}
+		if !errors.Is(err, proxy.ErrTokenManagerRequired) && !errors.Is(err, proxy.ErrEntClientRequired) {
+			return "", err
+		}
+	}
+
+	expires := opts.Duration
+	if expires == 0 {
+		expires = DefaultPresignedURLExpiry
+	}
+
+	now := time.Now().UTC()
+	container := p.container(file)
+
+	values := sas.BlobSignatureValues{
+		ExpiryTime:         now.Add(expires),
+		Permissions:        (&sas.BlobPermissions{Read: true}).String(),
+		ContainerName:      container,
+		BlobName:           file.Key,
+		ContentType:        file.ContentType,
+		ContentDisposition: storage.DispositionFor(file.ContentType),
+	}
+
+	var (
+		query sas.QueryParameters
+		err   error
+	)
+
+	if p.sharedKey != nil {
+		query, err = values.SignWithSharedKey(p.sharedKey)
+	} else {
+		query, err = p.signWithUserDelegation(ctx, values, now)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	presignURL := p.client.ServiceClient().NewContainerClient(container).NewBlobClient(file.Key).URL() + "?" + query.Encode()
+
+	log.Debug().Str("presigned_url", presignURL).Msg("Azure Blob SAS URL created")
+
+	return presignURL, nil
+}
+
+// signWithUserDelegation requests a user delegation key covering the SAS lifetime and signs the values with it
+func (p *Provider) signWithUserDelegation(ctx context.Context, values sas.BlobSignatureValues, now time.Time) (sas.QueryParameters, error) {
+	start := now.Add(-sasClockSkew).Format(sas.TimeFormat)
+	expiry := values.ExpiryTime.Format(sas.TimeFormat)
+
+	credential, err := p.client.ServiceClient().GetUserDelegationCredential(ctx, service.KeyInfo{Start: &start, Expiry: &expiry}, nil)
+	if err != nil {
+		return sas.QueryParameters{}, requestError(err)
+	}
+
+	return values.SignWithUserDelegation(credential)
+}
+
+// Exists checks if a blob exists in the container
+func (p *Provider) Exists(ctx context.Context, file *storagetypes.File) (bool, error) {
+	_, err := p.client.ServiceClient().NewContainerClient(p.container(file)).NewBlobClient(file.Key).GetProperties(ctx, nil)
+	if err == nil {
+		return true, nil
+	}
+
+	if isNotFound(err) {
+		return false, nil
+	}
+
+	return false, requestError(err)
+}
+
+// GetScheme returns the URI scheme for Azure Blob Storage
+func (p *Provider) GetScheme() *string {
+	scheme := "azblob://"
+
+	return &scheme
+}
+
+// ProviderType returns the type of the storage provider
+func (p *Provider) ProviderType() storagetypes.ProviderType {
+	return storagetypes.AzureBlobProvider
+}
+
+// Close cleans up resources
+func (p *Provider) Close() error {
+	return nil
+}
+
+// ListBuckets lists the containers in the storage account
+func (p *Provider) ListBuckets(ctx context.Context) ([]string, error) {
+	var containers []string
+
+	pager := p.client.NewListContainersPager(nil)
+
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, requestError(err)
+		}
+
+		for _, container := range page.ContainerItems {
+			if container != nil && container.Name != nil {
+				containers = append(containers, *container.Name)
+			}
+		}
+	}
+
+	return containers, nil
+}
+
+// container returns the container recorded on the file, falling back to the configured container
+func (p *Provider) container(file *storagetypes.File) string {
+	if file != nil && file.Bucket != "" {
+		return file.Bucket
+	}
+
+	return p.options.Bucket
+}
+
+// isNotFound reports whether the Blob service rejected the request because the blob or container does not exist
+func isNotFound(err error) bool {
+	var respErr *azcore.ResponseError
+
+	return errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound
+}
+
+// requestError wraps errors returned by the Blob service so callers can match them with ErrAzureRequestFailed
+func requestError(err error) error {
+	var respErr *azcore.ResponseError
+	if errors.As(err, &respErr) {
+		return fmt.Errorf("%w: %w", ErrAzureRequestFailed, err)
+	}
+
+	return err
+}
+
+// countingReader records the number of bytes read from the wrapped reader
+type countingReader struct {
+	reader io.Reader
+	size   int64
+}
+
+// Read implements io.Reader
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += int64(n)
+
+	return n, err
+}
@@ -0,0 +1,363 @@
+package azblob
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	azureblob "github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/sas"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+const (
+	// azuriteAccount and azuriteKey are the well-known Azurite development credentials
+	azuriteAccount = "devstoreaccount1"
+	azuriteKey     = "Eby8vdM02xNOcqFlqUwJPLlmEtlCDXJ1OUzFT50uSRZ6IFsuFq2UVErCz4I6tq/K1SZFPTOtr/KBHBeksoGMGw=="
+	testContainer  = "evidence"
+)
+
+// fakeAzurite is an in-memory stand-in for the Azurite blob service; requests must carry a Shared Key
+// authorization for the Azurite account
+type fakeAzurite struct {
+	mu         sync.Mutex
+	blocks     map[string][]byte
+	containers map[string]map[string][]byte
+}
+
+func newFakeAzurite(t *testing.T) (*fakeAzurite, *httptest.Server) {
+	t.Helper()
+
+	fake := &fakeAzurite{
+		blocks:     map[string][]byte{},
+		containers: map[string]map[string][]byte{testContainer: {}, "archive": {}},
+	}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return fake, server
+}
+
+func (f *fakeAzurite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "SharedKey "+azuriteAccount+":") || r.Header.Get("x-ms-version") == "" {
+		w.Header().Set("x-ms-error-code", "AuthenticationFailed")
+		w.WriteHeader(http.StatusForbidden)
+
+		return
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	parts := strings.SplitN(strings.TrimPrefix(r.URL.Path, "/"+azuriteAccount+"/"), "/", 2)
+	query := r.URL.Query()
+
+	if parts[0] == "" && query.Get("comp") == "list" {
+		type container struct {
+			Name string `xml:"Name"`
+		}
+
+		result := struct {
+			XMLName    xml.Name    `xml:"EnumerationResults"`
+			Containers []container `xml:"Containers>Container"`
+			NextMarker string      `xml:"NextMarker"`
+		}{}
+
+		// page one container at a time to exercise marker handling
+		names := []string{"archive", testContainer}
+		start := 0
+
+		if query.Get("marker") != "" {
+			start = 1
+		}
+
+		result.Containers = append(result.Containers, container{Name: names[start]})
+		if start == 0 {
+			result.NextMarker = names[1]
+		}
+
+		w.Header().Set("Content-Type", "application/xml")
+		_ = xml.NewEncoder(w).Encode(result)
+
+		return
+	}
+
+	blobs, ok := f.containers[parts[0]]
+	if !ok || len(parts) != 2 {
+		w.Header().Set("x-ms-error-code", "ContainerNotFound")
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	name := parts[1]
+
+	switch {
+	case r.Method == http.MethodPut && r.Header.Get("x-ms-blob-type") == "BlockBlob":
+		data, _ := io.ReadAll(r.Body)
+		blobs[name] = data
+
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "block":
+		data, _ := io.ReadAll(r.Body)
+		f.blocks[query.Get("blockid")] = data
+
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodPut && query.Get("comp") == "blocklist":
+		var list struct {
+			Latest []string `xml:"Latest"`
+		}
+
+		if err := xml.NewDecoder(r.Body).Decode(&list); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var data []byte
+		for _, id := range list.Latest {
+			data = append(data, f.blocks[id]...)
+		}
+
+		blobs[name] = data
+
+		w.WriteHeader(http.StatusCreated)
+	case r.Method == http.MethodGet, r.Method == http.MethodHead:
+		data, ok := blobs[name]
+		if !ok {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+		w.WriteHeader(http.StatusOK)
+
+		if r.Method == http.MethodGet {
+			_, _ = w.Write(data)
+		}
+	case r.Method == http.MethodDelete:
+		if _, ok := blobs[name]; !ok {
+			w.Header().Set("x-ms-error-code", "BlobNotFound")
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		delete(blobs, name)
+		w.WriteHeader(http.StatusAccepted)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func azuriteOptions(endpoint string) *storage.ProviderOptions {
+	return storage.NewProviderOptions(
+		storage.WithBucket(testContainer),
+		storage.WithEndpoint(endpoint+"/"+azuriteAccount),
+		storage.WithCredentials(storage.ProviderCredentials{
+			AccountName: azuriteAccount,
+			AccountKey:  azuriteKey,
+		}),
+	)
+}
+
+func TestNewAzureBlobProviderValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		options *storage.ProviderOptions
+		err     error
+	}{
+		{
+			name:    "missing container",
+			options: storage.NewProviderOptions(storage.WithCredentials(storage.ProviderCredentials{AccountName: azuriteAccount, AccountKey: azuriteKey})),
+			err:     ErrAzureContainerRequired,
+		},
+		{
+			name:    "missing account",
+			options: storage.NewProviderOptions(storage.WithBucket(testContainer), storage.WithCredentials(storage.ProviderCredentials{AccountKey: azuriteKey})),
+			err:     ErrAzureAccountNameRequired,
+		},
+		{
+			name:    "invalid key",
+			options: storage.NewProviderOptions(storage.WithBucket(testContainer), storage.WithCredentials(storage.ProviderCredentials{AccountName: azuriteAccount, AccountKey: "not base64!"})),
+			err:     ErrAzureAccountKeyInvalid,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewAzureBlobProvider(tc.options)
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestNewAzureBlobProviderDefaultEndpoint(t *testing.T) {
+	opts := azuriteOptions("")
+	opts.Endpoint = ""
+
+	provider, err := NewAzureBlobProvider(opts)
+	require.NoError(t, err)
+
+	assert.Equal(t, "https://devstoreaccount1.blob.core.windows.net", provider.endpoint.String())
+	assert.Equal(t, "https://devstoreaccount1.blob.core.windows.net", provider.client.URL())
+	assert.Equal(t, storagetypes.AzureBlobProvider, provider.ProviderType())
+	assert.Equal(t, "azblob://", *provider.GetScheme())
+}
+
+func TestNewAzureBlobProviderTokenCredential(t *testing.T) {
+	opts := azuriteOptions("")
+	opts.Endpoint = ""
+	opts.Credentials.AccountKey = ""
+
+	provider, err := NewAzureBlobProvider(opts, WithTokenCredential(staticToken{}))
+	require.NoError(t, err)
+
+	assert.Nil(t, provider.sharedKey)
+	assert.Equal(t, "https://devstoreaccount1.blob.core.windows.net", provider.client.URL())
+}
+
+// staticToken is an azcore.TokenCredential returning a fixed token
+type staticToken struct{}
+
+func (staticToken) GetToken(context.Context, policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: "token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestAzureBlobProviderRoundTrip(t *testing.T) {
+	fake, server := newFakeAzurite(t)
+
+	provider, err := NewAzureBlobProvider(azuriteOptions(server.URL))
+	require.NoError(t, err)
+
+	defer provider.Close()
+
+	ctx := context.Background()
+	content := []byte("quarterly access review export")
+
+	uploaded, err := provider.Upload(ctx, bytes.NewReader(content), &storagetypes.UploadFileOptions{
+		FileName:          "access review.csv",
+		FolderDestination: "org-1",
+		ContentType:       "text/csv",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "org-1/access review.csv", uploaded.Key)
+	assert.Equal(t, int64(len(content)), uploaded.Size)
+	assert.Equal(t, storagetypes.AzureBlobProvider, uploaded.ProviderType)
+	assert.Equal(t, "azblob://evidence/org-1/access review.csv", uploaded.FullURI)
+	assert.Equal(t, content, fake.containers[testContainer]["org-1/access review.csv"])
+
+	file := &storagetypes.File{FileMetadata: storagetypes.FileMetadata{Key: uploaded.Key}}
+
+	exists, err := provider.Exists(ctx, file)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	downloaded, err := provider.Download(ctx, file, nil)
+	require.NoError(t, err)
+	assert.Equal(t, content, downloaded.File)
+	assert.Equal(t, int64(len(content)), downloaded.Size)
+
+	buckets, err := provider.ListBuckets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"archive", testContainer}, buckets)
+
+	require.NoError(t, provider.Delete(ctx, file, nil))
+
+	exists, err = provider.Exists(ctx, file)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing blob is not an error
+	require.NoError(t, provider.Delete(ctx, file, nil))
+
+	_, err = provider.Download(ctx, file, nil)
+	assert.ErrorIs(t, err, ErrAzureRequestFailed)
+}
+
+func TestAzureBlobProviderUploadStreamsBlocks(t *testing.T) {
+	fake, server := newFakeAzurite(t)
+
+	provider, err := NewAzureBlobProvider(azuriteOptions(server.URL))
+	require.NoError(t, err)
+
+	// larger than one block so the upload is staged and committed as a block list
+	content := bytes.Repeat([]byte("evidence"), 400*1024)
+
+	uploaded, err := provider.Upload(context.Background(), io.MultiReader(bytes.NewReader(content)), &storagetypes.UploadFileOptions{FileName: "large.bin"})
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(len(content)), uploaded.Size)
+	assert.Equal(t, content, fake.containers[testContainer]["large.bin"])
+}
+
+func TestAzureBlobProviderRejectedRequest(t *testing.T) {
+	_, server := newFakeAzurite(t)
+
+	opts := azuriteOptions(server.URL)
+	opts.Credentials.AccountName = "otheraccount"
+
+	provider, err := NewAzureBlobProvider(opts)
+	require.NoError(t, err)
+
+	_, err = provider.Exists(context.Background(), &storagetypes.File{FileMetadata: storagetypes.FileMetadata{Key: "missing"}})
+	assert.ErrorIs(t, err, ErrAzureRequestFailed)
+}
+
+func TestAzureBlobPresignedURL(t *testing.T) {
+	provider, err := NewAzureBlobProvider(azuriteOptions("https://example.blob.core.windows.net"))
+	require.NoError(t, err)
+
+	file := &storagetypes.File{FileMetadata: storagetypes.FileMetadata{Key: "org-1/report.pdf", ContentType: "application/pdf"}}
+
+	presigned, err := provider.GetPresignedURL(context.Background(), file, &storagetypes.PresignedURLOptions{Duration: time.Hour})
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(presigned)
+	require.NoError(t, err)
+
+	assert.Equal(t, "/devstoreaccount1/evidence/org-1/report.pdf", parsed.Path)
+
+	query := parsed.Query()
+	assert.Equal(t, sas.Version, query.Get("sv"))
+	assert.Equal(t, "r", query.Get("sp"))
+	assert.Equal(t, "b", query.Get("sr"))
+	assert.Equal(t, "application/pdf", query.Get("rsct"))
+	assert.Equal(t, storage.DispositionFor("application/pdf"), query.Get("rscd"))
+
+	expiry, err := time.Parse(sas.TimeFormat, query.Get("se"))
+	require.NoError(t, err)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiry, time.Minute)
+
+	credential, err := azureblob.NewSharedKeyCredential(azuriteAccount, azuriteKey)
+	require.NoError(t, err)
+
+	expected, err := sas.BlobSignatureValues{
+		ExpiryTime:         expiry,
+		Permissions:        "r",
+		ContainerName:      testContainer,
+		BlobName:           file.Key,
+		ContentType:        file.ContentType,
+		ContentDisposition: storage.DispositionFor(file.ContentType),
+	}.SignWithSharedKey(credential)
+	require.NoError(t, err)
+	assert.Equal(t, expected.Signature(), query.Get("sig"))
+}
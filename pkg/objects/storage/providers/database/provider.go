@@ -172,7 +172,7 @@ func (p *Provider) GetPresignedURL(ctx context.Context, fileRef *storagetypes.Fi
 }
 
 // ListBuckets returns the configured logical bucket for the provider.
-func (p *Provider) ListBuckets() ([]string, error) {
+func (p *Provider) ListBuckets(_ context.Context) ([]string, error) {
 	return []string{p.bucket()}, nil
 }
 
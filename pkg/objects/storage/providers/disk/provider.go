@@ -49,7 +49,7 @@ func NewDiskProvider(options *storage.ProviderOptions) (*Provider, error) {
 	disk.proxyPresignEnabled = options.ProxyPresignEnabled
 	disk.proxyConfig = options.ProxyPresignConfig
 
-	if _, err := disk.ListBuckets(); os.IsNotExist(err) {
+	if _, err := disk.ListBuckets(context.Background()); os.IsNotExist(err) {
 		log.Info().Str("folder", options.Bucket).Msg("directory does not exist, creating directory")
 
 		if err := os.MkdirAll(options.Bucket, os.ModePerm); err != nil {
@@ -128,6 +128,20 @@ func (p *Provider) Download(_ context.Context, file *storagetypes.File, _ *stora
 	}, nil
 }
 
+// Open implements storagetypes.StreamingProvider, returning the file on disk without reading it into memory
+func (p *Provider) Open(_ context.Context, file *storagetypes.File) (io.ReadCloser, error) {
+	f, err := os.Open(filepath.Join(p.options.Bucket, file.Key))
+	if err != nil {
+		return nil, err
+	}
+
+	if info, err := f.Stat(); err == nil {
+		metrics.RecordStorageDownload(string(storagetypes.DiskProvider), info.Size())
+	}
+
+	return f, nil
+}
+
 // Delete implements storagetypes.Provider
 func (p *Provider) Delete(_ context.Context, file *storagetypes.File, _ *storagetypes.DeleteFileOptions) error {
 	bucket := file.Bucket
@@ -202,7 +216,7 @@ func (p *Provider) Close() error {
 }
 
 // ListBuckets lists the local bucket if it exists
-func (p *Provider) ListBuckets() ([]string, error) {
+func (p *Provider) ListBuckets(_ context.Context) ([]string, error) {
 	if _, err := os.Stat(p.options.Bucket); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,57 @@
+package gcs
+
+import (
+	"context"
+
+	"github.com/samber/mo"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+// Builder creates GCS providers for the client pool
+type Builder struct {
+	options []Option
+}
+
+// NewGCSBuilder creates a new Builder
+func NewGCSBuilder() *Builder {
+	return &Builder{}
+}
+
+// WithOptions sets provider options for the builder
+func (b *Builder) WithOptions(opts ...Option) *Builder {
+	b.options = append(b.options, opts...)
+	return b
+}
+
+// Build implements eddy.Builder
+func (b *Builder) Build(_ context.Context, credentials storage.ProviderCredentials, config *storage.ProviderOptions) (storagetypes.Provider, error) {
+	if config == nil {
+		config = storage.NewProviderOptions()
+	}
+
+	cfg := config.Clone()
+	cfg.Credentials = credentials
+
+	if cfg.Bucket == "" {
+		return nil, ErrGCSBucketRequired
+	}
+
+	return NewGCSProvider(cfg, b.options...)
+}
+
+// ProviderType implements eddy.Builder
+func (b *Builder) ProviderType() string {
+	return string(storagetypes.GCSProvider)
+}
+
+// NewGCSProviderFromCredentials creates a GCS provider using the supplied credentials and options
+func NewGCSProviderFromCredentials(credentials storage.ProviderCredentials, options *storage.ProviderOptions) mo.Result[storagetypes.Provider] {
+	provider, err := NewGCSBuilder().Build(context.Background(), credentials, options)
+	if err != nil {
+		return mo.Err[storagetypes.Provider](err)
+	}
+
+	return mo.Ok[storagetypes.Provider](provider)
+}
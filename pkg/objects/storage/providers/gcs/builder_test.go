@@ -0,0 +1,40 @@
+package gcs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+func TestGCSBuilder(t *testing.T) {
+	builder := NewGCSBuilder()
+	assert.Equal(t, string(storagetypes.GCSProvider), builder.ProviderType())
+
+	_, err := builder.Build(context.Background(), storage.ProviderCredentials{}, storage.NewProviderOptions())
+	assert.ErrorIs(t, err, ErrGCSBucketRequired)
+
+	_, err = builder.Build(context.Background(), storage.ProviderCredentials{CredentialsJSON: "{}"}, storage.NewProviderOptions(storage.WithBucket(testBucket)))
+	assert.ErrorIs(t, err, ErrGCSCredentialsInvalid)
+
+	// a custom endpoint without credentials targets an unauthenticated emulator
+	provider, err := builder.Build(context.Background(), storage.ProviderCredentials{ProjectID: testProject}, storage.NewProviderOptions(
+		storage.WithBucket(testBucket),
+		storage.WithEndpoint("http://localhost:4443"),
+	))
+	require.NoError(t, err)
+	assert.Equal(t, storagetypes.GCSProvider, provider.ProviderType())
+
+	credentialsJSON, _ := serviceAccountJSON(t)
+
+	provider, err = builder.Build(context.Background(), storage.ProviderCredentials{CredentialsJSON: credentialsJSON}, storage.NewProviderOptions(storage.WithBucket(testBucket)))
+	require.NoError(t, err)
+	assert.NotNil(t, provider)
+
+	result := NewGCSProviderFromCredentials(storage.ProviderCredentials{}, nil)
+	assert.True(t, result.IsError())
+}
@@ -0,0 +1,2 @@
+// Package gcs is the Google Cloud Storage provider for objects service
+package gcs
@@ -0,0 +1,18 @@
+package gcs
+
+import "errors"
+
+var (
+	// ErrGCSBucketRequired is returned when the GCS bucket is not specified
+	ErrGCSBucketRequired = errors.New("GCS bucket is required")
+	// ErrGCSProjectIDRequired is returned when listing buckets without a project id
+	ErrGCSProjectIDRequired = errors.New("GCS project ID is required to list buckets")
+	// ErrGCSCredentialsInvalid is returned when the service account key JSON is rejected by the storage client
+	ErrGCSCredentialsInvalid = errors.New("GCS service account credentials are invalid")
+	// ErrGCSSigningKeyRequired is returned when a signed URL is requested without credentials able to sign it
+	ErrGCSSigningKeyRequired = errors.New("GCS signed URLs require service account credentials or an identity permitted to sign blobs")
+	// ErrGCSSignedURLExpiryTooLong is returned when a signed URL expiry exceeds the V4 maximum of seven days
+	ErrGCSSignedURLExpiryTooLong = errors.New("GCS signed URL expiry cannot exceed seven days")
+	// ErrGCSRequestFailed is returned when a request to GCS fails
+	ErrGCSRequestFailed = errors.New("GCS request failed")
+)
@@ -0,0 +1,346 @@
+package gcs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	gcstorage "cloud.google.com/go/storage"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/metrics"
+	"github.com/theopenlane/core/pkg/objects/storage"
+	"github.com/theopenlane/core/pkg/objects/storage/proxy"
+)
+
+const (
+	// DefaultPresignedURLExpiry defines the default expiry time for signed URLs
+	DefaultPresignedURLExpiry = 15 * time.Minute
+	// DefaultEndpoint is the public GCS endpoint
+	DefaultEndpoint = "https://storage.googleapis.com"
+	// signedURLMaxExpiry is the longest expiry allowed for a V4 signed URL
+	signedURLMaxExpiry = 7 * 24 * time.Hour
+)
+
+// Provider implements the storagetypes.Provider interface for Google Cloud Storage
+type Provider struct {
+	client              *gcstorage.Client
+	endpoint            *url.URL
+	anonymous           bool
+	options             *storage.ProviderOptions
+	proxyPresignEnabled bool
+	proxyConfig         *storage.ProxyPresignConfig
+}
+
+// providerConfig holds configuration for the GCS provider
+type providerConfig struct {
+	options       *storage.ProviderOptions
+	clientOptions []option.ClientOption
+}
+
+// Option configures the GCS provider during construction
+type Option func(*providerConfig)
+
+// WithHTTPClient configures the HTTP client used to reach GCS; the client is expected to carry its own credentials
+func WithHTTPClient(client *http.Client) Option {
+	return func(cfg *providerConfig) {
+		cfg.clientOptions = append(cfg.clientOptions, option.WithHTTPClient(client))
+	}
+}
+
+// WithClientOptions appends Google API client options used when creating the storage client
+func WithClientOptions(opts ...option.ClientOption) Option {
+	return func(cfg *providerConfig) {
+		cfg.clientOptions = append(cfg.clientOptions, opts...)
+	}
+}
+
+// NewGCSProvider creates a new GCS provider instance. A service account key is used when configured, otherwise
+// application default credentials are used, except against a custom endpoint without credentials, which is
+// assumed to be a fake-gcs-server style emulator that does not require authentication
+func NewGCSProvider(options *storage.ProviderOptions, opts ...Option) (*Provider, error) {
+	config := providerConfig{
+		options: options,
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&config)
+		}
+	}
+
+	if config.options == nil || config.options.Bucket == "" {
+		return nil, ErrGCSBucketRequired
+	}
+
+	rawEndpoint := config.options.Endpoint
+	if rawEndpoint == "" {
+		rawEndpoint = DefaultEndpoint
+	}
+
+	endpoint, err := url.Parse(strings.TrimSuffix(rawEndpoint, "/"))
+	if err != nil {
+		return nil, err
+	}
+
+	credentialsJSON := config.options.Credentials.CredentialsJSON
+	anonymous := credentialsJSON == "" && config.options.Endpoint != ""
+
+	// the client outlives the construction call, so it must not be bound to a request scoped context
+	ctx := context.Background()
+
+	clientOptions := []option.ClientOption{gcstorage.WithJSONReads()}
+
+	switch {
+	case credentialsJSON != "":
+		clientOptions = append(clientOptions, option.WithAuthCredentialsJSON(option.ServiceAccount, []byte(credentialsJSON)))
+	case anonymous:
+		clientOptions = append(clientOptions, option.WithoutAuthentication())
+	}
+
+	if config.options.Endpoint != "" {
+		clientOptions = append(clientOptions, option.WithEndpoint(endpoint.String()+"/storage/v1/"))
+	}
+
+	client, err := gcstorage.NewClient(ctx, append(clientOptions, config.clientOptions...)...)
+	if err != nil {
+		if credentialsJSON != "" {
+			return nil, fmt.Errorf("%w: %w", ErrGCSCredentialsInvalid, err)
+		}
+
+		return nil, err
+	}
+
+	return &Provider{
+		client:              client,
+		endpoint:            endpoint,
+		anonymous:           anonymous,
+		options:             config.options.Clone(),
+		proxyPresignEnabled: config.options.ProxyPresignEnabled,
+		proxyConfig:         config.options.ProxyPresignConfig,
+	}, nil
+}
+
+// Upload implements storagetypes.Provider, streaming the reader to the object
+func (p *Provider) Upload(ctx context.Context, reader io.Reader, opts *storagetypes.UploadFileOptions) (*storagetypes.UploadedFileMetadata, error) {
+	objectKey := opts.FileName
+	if opts.FolderDestination != "" {
+		objectKey = path.Join(opts.FolderDestination, opts.FileName)
+	}
+
+	writer := p.client.Bucket(p.options.Bucket).Object(objectKey).NewWriter(ctx)
+
+	writer.ContentType = opts.ContentType
+	if writer.ContentType == "" {
+		writer.ContentType = "application/octet-stream"
+	}
+
+	size, err := io.Copy(writer, reader)
+	if err != nil {
+		_ = writer.Close()
+
+		return nil, requestError(err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, requestError(err)
+	}
+
+	if attrs := writer.Attrs(); attrs != nil {
+		size = attrs.Size
+	}
+
+	metrics.RecordStorageUpload(string(storagetypes.GCSProvider), size)
+
+	return &storagetypes.UploadedFileMetadata{
+		FileMetadata: storagetypes.FileMetadata{
+			Key:          objectKey,
+			Size:         size,
+			Folder:       opts.FolderDestination,
+			Bucket:       p.options.Bucket,
+			Region:       p.options.Region,
+			ContentType:  opts.ContentType,
+			ProviderType: storagetypes.GCSProvider,
+			FullURI:      fmt.Sprintf("gs://%s/%s", p.options.Bucket, objectKey),
+		},
+	}, nil
+}
+
+// Download implements storagetypes.Provider
+func (p *Provider) Download(ctx context.Context, file *storagetypes.File, _ *storagetypes.DownloadFileOptions) (*storagetypes.DownloadedFileMetadata, error) {
+	reader, err := p.client.Bucket(p.bucket(file)).Object(file.Key).NewReader(ctx)
+	if err != nil {
+		return nil, requestError(err)
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	downloadedSize := int64(len(data))
+	metrics.RecordStorageDownload(string(storagetypes.GCSProvider), downloadedSize)
+
+	return &storagetypes.DownloadedFileMetadata{
+		File: data,
+		Size: downloadedSize,
+	}, nil
+}
+
+// Open implements storagetypes.StreamingProvider, returning the object reader without buffering it
+func (p *Provider) Open(ctx context.Context, file *storagetypes.File) (io.ReadCloser, error) {
+	reader, err := p.client.Bucket(p.bucket(file)).Object(file.Key).NewReader(ctx)
+	if err != nil {
+		return nil, requestError(err)
+	}
+
+	metrics.RecordStorageDownload(string(storagetypes.GCSProvider), reader.Attrs.Size)
+
+	return reader, nil
+}
+
+// Delete implements storagetypes.Provider
+func (p *Provider) Delete(ctx context.Context, file *storagetypes.File, _ *storagetypes.DeleteFileOptions) error {
+	err := p.client.Bucket(p.bucket(file)).Object(file.Key).Delete(ctx)
+	if err != nil && !errors.Is(err, gcstorage.ErrObjectNotExist) {
+		return requestError(err)
+	}
+
+	metrics.RecordStorageDelete(string(storagetypes.GCSProvider))
+
+	return nil
+}
+
+// GetPresignedURL implements storagetypes.Provider, returning a V4 signed URL for the object. The URL is signed
+// with the service account key when one is configured, otherwise through the IAM signBlob API for the identity
+// resolved from application default credentials
+func (p *Provider) GetPresignedURL(ctx context.Context, file *storagetypes.File, opts *storagetypes.PresignedURLOptions) (string, error) {
+	if opts == nil {
+		opts = &storagetypes.PresignedURLOptions{}
+	}
+
+	if p.proxyPresignEnabled && p.proxyConfig != nil && p.proxyConfig.TokenManager != nil {
+		url, err := proxy.GenerateDownloadURL(ctx, file, opts.Duration, p.proxyConfig)
+		if err == nil {
+			return url, nil
+		}
+		if !errors.Is(err, proxy.ErrTokenManagerRequired) && !errors.Is(err, proxy.ErrEntClientRequired) {
+			return "", err
+		}
+	}
+
+	if p.anonymous {
+		return "", ErrGCSSigningKeyRequired
+	}
+
+	expires := opts.Duration
+	if expires == 0 {
+		expires = DefaultPresignedURLExpiry
+	}
+
+	if expires > signedURLMaxExpiry {
+		return "", ErrGCSSignedURLExpiryTooLong
+	}
+
+	query := url.Values{}
+	if file.ContentType != "" {
+		query.Set("response-content-type", file.ContentType)
+	}
+
+	query.Set("response-content-disposition", storage.DispositionFor(file.ContentType))
+
+	presignURL, err := p.client.Bucket(p.bucket(file)).SignedURL(file.Key, &gcstorage.SignedURLOptions{
+		Method:          http.MethodGet,
+		Expires:         time.Now().Add(expires),
+		Scheme:          gcstorage.SigningSchemeV4,
+		QueryParameters: query,
+		Hostname:        p.endpoint.Host,
+		Insecure:        p.endpoint.Scheme == "http",
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrGCSSigningKeyRequired, err)
+	}
+
+	log.Debug().Str("presigned_url", presignURL).Msg("GCS signed URL created")
+
+	return presignURL, nil
+}
+
+// Exists checks if an object exists in the bucket
+func (p *Provider) Exists(ctx context.Context, file *storagetypes.File) (bool, error) {
+	_, err := p.client.Bucket(p.bucket(file)).Object(file.Key).Attrs(ctx)
+	if err == nil {
+		return true, nil
+	}
+
+	if errors.Is(err, gcstorage.ErrObjectNotExist) {
+		return false, nil
+	}
+
+	return false, requestError(err)
+}
+
+// GetScheme returns the URI scheme for GCS
+func (p *Provider) GetScheme() *string {
+	scheme := "gs://"
+
+	return &scheme
+}
+
+// ProviderType returns the type of the storage provider
+func (p *Provider) ProviderType() storagetypes.ProviderType {
+	return storagetypes.GCSProvider
+}
+
+// Close cleans up resources
+func (p *Provider) Close() error {
+	return p.client.Close()
+}
+
+// ListBuckets lists the buckets in the configured project
+func (p *Provider) ListBuckets(ctx context.Context) ([]string, error) {
+	if p.options.Credentials.ProjectID == "" {
+		return nil, ErrGCSProjectIDRequired
+	}
+
+	var buckets []string
+
+	it := p.client.Buckets(ctx, p.options.Credentials.ProjectID)
+
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			return buckets, nil
+		}
+
+		if err != nil {
+			return nil, requestError(err)
+		}
+
+		buckets = append(buckets, attrs.Name)
+	}
+}
+
+// bucket returns the bucket recorded on the file, falling back to the configured bucket
+func (p *Provider) bucket(file *storagetypes.File) string {
+	if file != nil && file.Bucket != "" {
+		return file.Bucket
+	}
+
+	return p.options.Bucket
+}
+
+// requestError wraps errors returned by the storage client so callers can match them with ErrGCSRequestFailed
+func requestError(err error) error {
+	return fmt.Errorf("%w: %w", ErrGCSRequestFailed, err)
+}
@@ -0,0 +1,314 @@
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/storage"
+)
+
+const (
+	testBucket  = "evidence"
+	testProject = "openlane-test"
+)
+
+// objectResource is the subset of the GCS object resource returned by the fake
+type objectResource struct {
+	Name        string `json:"name"`
+	Bucket      string `json:"bucket"`
+	Size        string `json:"size"`
+	ContentType string `json:"contentType"`
+}
+
+// fakeGCS is an in-memory stand-in for fake-gcs-server covering the JSON API calls used by the provider
+type fakeGCS struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func newFakeGCS(t *testing.T) (*fakeGCS, *httptest.Server) {
+	t.Helper()
+
+	fake := &fakeGCS{objects: map[string][]byte{}}
+
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	return fake, server
+}
+
+func (f *fakeGCS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/upload/storage/v1/b/"+testBucket+"/o":
+		object, data, err := readMultipartUpload(r)
+		if err != nil || r.URL.Query().Get("uploadType") != "multipart" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		f.objects[object.Name] = data
+
+		object.Bucket = testBucket
+		object.Size = strconv.Itoa(len(data))
+
+		_ = json.NewEncoder(w).Encode(object)
+	case r.URL.Path == "/storage/v1/b":
+		if r.URL.Query().Get("project") != testProject {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		type bucket struct {
+			Name string `json:"name"`
+		}
+
+		result := struct {
+			Items         []bucket `json:"items"`
+			NextPageToken string   `json:"nextPageToken,omitempty"`
+		}{}
+
+		// page one bucket at a time to exercise page token handling
+		if r.URL.Query().Get("pageToken") == "" {
+			result.Items = append(result.Items, bucket{Name: "archive"})
+			result.NextPageToken = "next"
+		} else {
+			result.Items = append(result.Items, bucket{Name: testBucket})
+		}
+
+		_ = json.NewEncoder(w).Encode(result)
+	case strings.HasPrefix(r.URL.Path, "/storage/v1/b/"+testBucket+"/o/"):
+		name := strings.TrimPrefix(r.URL.Path, "/storage/v1/b/"+testBucket+"/o/")
+
+		data, ok := f.objects[name]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"error":{"code":404,"message":"No such object"}}`)
+
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Query().Get("alt") == "media" {
+				_, _ = w.Write(data)
+				return
+			}
+
+			_ = json.NewEncoder(w).Encode(objectResource{Name: name, Bucket: testBucket, Size: strconv.Itoa(len(data))})
+		case http.MethodDelete:
+			delete(f.objects, name)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+		}
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// readMultipartUpload decodes the object metadata and media parts of a multipart upload request
+func readMultipartUpload(r *http.Request) (objectResource, []byte, error) {
+	var object objectResource
+
+	_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return object, nil, err
+	}
+
+	reader := multipart.NewReader(r.Body, params["boundary"])
+
+	part, err := reader.NextPart()
+	if err != nil {
+		return object, nil, err
+	}
+
+	if err := json.NewDecoder(part).Decode(&object); err != nil {
+		return object, nil, err
+	}
+
+	part, err = reader.NextPart()
+	if err != nil {
+		return object, nil, err
+	}
+
+	data, err := io.ReadAll(part)
+
+	return object, data, err
+}
+
+func emulatorOptions(endpoint string) *storage.ProviderOptions {
+	return storage.NewProviderOptions(
+		storage.WithBucket(testBucket),
+		storage.WithEndpoint(endpoint),
+		storage.WithCredentials(storage.ProviderCredentials{ProjectID: testProject}),
+	)
+}
+
+// serviceAccountJSON returns a service account key file for a freshly generated RSA key
+func serviceAccountJSON(t *testing.T) (string, *rsa.PrivateKey) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"client_email": "uploader@openlane-test.iam.gserviceaccount.com",
+		"private_key":  string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+	})
+	require.NoError(t, err)
+
+	return string(data), key
+}
+
+func TestNewGCSProviderValidation(t *testing.T) {
+	_, err := NewGCSProvider(storage.NewProviderOptions())
+	assert.ErrorIs(t, err, ErrGCSBucketRequired)
+
+	_, err = NewGCSProvider(storage.NewProviderOptions(
+		storage.WithBucket(testBucket),
+		storage.WithCredentials(storage.ProviderCredentials{CredentialsJSON: "{not json"}),
+	))
+	assert.ErrorIs(t, err, ErrGCSCredentialsInvalid)
+
+	credentialsJSON, _ := serviceAccountJSON(t)
+
+	provider, err := NewGCSProvider(storage.NewProviderOptions(
+		storage.WithBucket(testBucket),
+		storage.WithCredentials(storage.ProviderCredentials{CredentialsJSON: credentialsJSON}),
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, DefaultEndpoint, provider.endpoint.String())
+	assert.Equal(t, storagetypes.GCSProvider, provider.ProviderType())
+	assert.Equal(t, "gs://", *provider.GetScheme())
+
+	_, err = provider.ListBuckets(context.Background())
+	assert.ErrorIs(t, err, ErrGCSProjectIDRequired)
+}
+
+func TestGCSProviderRoundTrip(t *testing.T) {
+	fake, server := newFakeGCS(t)
+
+	provider, err := NewGCSProvider(emulatorOptions(server.URL))
+	require.NoError(t, err)
+
+	defer provider.Close()
+
+	ctx := context.Background()
+	content := []byte("penetration test summary")
+
+	uploaded, err := provider.Upload(ctx, bytes.NewReader(content), &storagetypes.UploadFileOptions{
+		FileName:          "pen test.pdf",
+		FolderDestination: "org-1",
+		ContentType:       "application/pdf",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "org-1/pen test.pdf", uploaded.Key)
+	assert.Equal(t, int64(len(content)), uploaded.Size)
+	assert.Equal(t, storagetypes.GCSProvider, uploaded.ProviderType)
+	assert.Equal(t, "gs://evidence/org-1/pen test.pdf", uploaded.FullURI)
+	assert.Equal(t, content, fake.objects["org-1/pen test.pdf"])
+
+	file := &storagetypes.File{FileMetadata: storagetypes.FileMetadata{Key: uploaded.Key}}
+
+	exists, err := provider.Exists(ctx, file)
+	require.NoError(t, err)
+	assert.True(t, exists)
+
+	downloaded, err := provider.Download(ctx, file, nil)
+	require.NoError(t, err)
+	assert.Equal(t, content, downloaded.File)
+
+	buckets, err := provider.ListBuckets(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"archive", testBucket}, buckets)
+
+	require.NoError(t, provider.Delete(ctx, file, nil))
+
+	exists, err = provider.Exists(ctx, file)
+	require.NoError(t, err)
+	assert.False(t, exists)
+
+	// deleting a missing object is not an error
+	require.NoError(t, provider.Delete(ctx, file, nil))
+
+	_, err = provider.Download(ctx, file, nil)
+	assert.ErrorIs(t, err, ErrGCSRequestFailed)
+}
+
+func TestGCSPresignedURL(t *testing.T) {
+	credentialsJSON, key := serviceAccountJSON(t)
+
+	provider, err := NewGCSProvider(storage.NewProviderOptions(
+		storage.WithBucket(testBucket),
+		storage.WithCredentials(storage.ProviderCredentials{CredentialsJSON: credentialsJSON}),
+	))
+	require.NoError(t, err)
+
+	file := &storagetypes.File{FileMetadata: storagetypes.FileMetadata{Key: "org-1/soc 2.pdf", ContentType: "application/pdf"}}
+
+	presigned, err := provider.GetPresignedURL(context.Background(), file, &storagetypes.PresignedURLOptions{Duration: time.Hour})
+	require.NoError(t, err)
+
+	parsed, err := url.Parse(presigned)
+	require.NoError(t, err)
+
+	assert.Equal(t, "storage.googleapis.com", parsed.Host)
+	assert.Equal(t, "/evidence/org-1/soc%202.pdf", parsed.EscapedPath())
+
+	query := parsed.Query()
+	assert.Equal(t, "GOOG4-RSA-SHA256", query.Get("X-Goog-Algorithm"))
+	expires, err := strconv.Atoi(query.Get("X-Goog-Expires"))
+	require.NoError(t, err)
+	assert.InDelta(t, time.Hour.Seconds(), expires, 5)
+	assert.Equal(t, "host", query.Get("X-Goog-SignedHeaders"))
+	assert.True(t, strings.HasPrefix(query.Get("X-Goog-Credential"), "uploader@openlane-test.iam.gserviceaccount.com/"))
+	assert.Equal(t, "application/pdf", query.Get("response-content-type"))
+	assert.Equal(t, storage.DispositionFor("application/pdf"), query.Get("response-content-disposition"))
+
+	// the signature is the hex encoded RSA signature of the string to sign, one key length long
+	signature, err := hex.DecodeString(query.Get("X-Goog-Signature"))
+	require.NoError(t, err)
+	assert.Len(t, signature, key.Size())
+
+	_, err = provider.GetPresignedURL(context.Background(), file, &storagetypes.PresignedURLOptions{Duration: 8 * 24 * time.Hour})
+	assert.ErrorIs(t, err, ErrGCSSignedURLExpiryTooLong)
+}
+
+func TestGCSPresignedURLRequiresKey(t *testing.T) {
+	// an emulator endpoint without credentials has no identity to sign with
+	provider, err := NewGCSProvider(emulatorOptions("http://localhost:4443"))
+	require.NoError(t, err)
+
+	_, err = provider.GetPresignedURL(context.Background(), &storagetypes.File{FileMetadata: storagetypes.FileMetadata{Key: "a.pdf"}}, nil)
+	assert.ErrorIs(t, err, ErrGCSSigningKeyRequired)
+}
@@ -211,6 +211,26 @@ func (p *Provider) Download(ctx context.Context, file *storagetypes.File, _ *sto
 	}, nil
 }
 
+// Open implements storagetypes.StreamingProvider, returning the object body without buffering it
+func (p *Provider) Open(ctx context.Context, file *storagetypes.File) (io.ReadCloser, error) {
+	bucket := file.Bucket
+	if bucket == "" {
+		bucket = p.options.Bucket
+	}
+
+	output, err := p.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(file.Key),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	metrics.RecordStorageDownload("r2", aws.ToInt64(output.ContentLength))
+
+	return output.Body, nil
+}
+
 // Delete implements storagetypes.Provider
 func (p *Provider) Delete(ctx context.Context, file *storagetypes.File, _ *storagetypes.DeleteFileOptions) error {
 	bucket := file.Bucket
@@ -308,10 +328,10 @@ func (p *Provider) Close() error {
 }
 
 // ListBuckets lists the buckets in the current account.
-func (p *Provider) ListBuckets() ([]string, error) {
+func (p *Provider) ListBuckets(ctx context.Context) ([]string, error) {
 	var buckets []string
 
-	result, err := p.client.ListBuckets(context.TODO(), &s3.ListBucketsInput{})
+	result, err := p.client.ListBuckets(ctx, &s3.ListBucketsInput{})
 	if err != nil {
 		return nil, err
 	}
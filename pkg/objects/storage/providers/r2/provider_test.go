@@ -209,7 +209,7 @@ func TestR2Provider_ListBuckets(t *testing.T) {
 			t.Skip("Skipping test due to missing R2 credentials or environment")
 		}
 
-		_, err = provider.ListBuckets()
+		_, err = provider.ListBuckets(context.Background())
 		assert.Error(t, err)
 	})
 }
@@ -0,0 +1,36 @@
+package s3
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/theopenlane/core/common/storagetypes"
+)
+
+func TestApplyObjectLock(t *testing.T) {
+	retainUntil := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	input := &s3.PutObjectInput{}
+	applyObjectLock(input, nil)
+	assert.Empty(t, input.ObjectLockMode)
+	assert.Nil(t, input.ObjectLockRetainUntilDate)
+
+	applyObjectLock(input, &storagetypes.ObjectLockOptions{Mode: "COMPLIANCE", RetainUntil: retainUntil})
+	assert.Equal(t, types.ObjectLockModeCompliance, input.ObjectLockMode)
+	assert.Equal(t, retainUntil, *input.ObjectLockRetainUntilDate)
+	assert.Empty(t, input.ObjectLockLegalHoldStatus)
+
+	held := &s3.PutObjectInput{}
+	applyObjectLock(held, &storagetypes.ObjectLockOptions{Mode: "GOVERNANCE", RetainUntil: retainUntil, LegalHold: true})
+	assert.Equal(t, types.ObjectLockModeGovernance, held.ObjectLockMode)
+	assert.Equal(t, types.ObjectLockLegalHoldStatusOn, held.ObjectLockLegalHoldStatus)
+}
+
+func TestLegalHoldStatus(t *testing.T) {
+	assert.Equal(t, types.ObjectLockLegalHoldStatusOn, legalHoldStatus(true))
+	assert.Equal(t, types.ObjectLockLegalHoldStatusOff, legalHoldStatus(false))
+}
@@ -260,7 +260,7 @@ func TestS3Provider_ListBuckets(t *testing.T) {
 			t.Skip("Skipping test due to missing AWS credentials or environment")
 		}
 
-		_, err = provider.ListBuckets()
+		_, err = provider.ListBuckets(context.Background())
 		assert.Error(t, err)
 	})
 }
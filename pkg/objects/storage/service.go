@@ -72,6 +72,7 @@ func (s *ObjectService) Upload(ctx context.Context, provider Provider, reader io
 		ContentType:       contentType,
 		Bucket:            opts.Bucket,
 		FolderDestination: opts.FolderDestination,
+		ObjectLock:        opts.ObjectLock,
 		FileMetadata: FileMetadata{
 			Key:           opts.Key,
 			Bucket:        opts.Bucket,
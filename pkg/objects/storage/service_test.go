@@ -107,7 +107,7 @@ func (m *mockProvider) GetScheme() *string {
 	return &scheme
 }
 
-func (m *mockProvider) ListBuckets() ([]string, error) {
+func (m *mockProvider) ListBuckets(context.Context) ([]string, error) {
 	return []string{"bucket1", "bucket2"}, nil
 }
 
@@ -4,8 +4,11 @@ import (
 	"context"
 	"maps"
 	"net/http"
+	"time"
 
 	"github.com/theopenlane/core/common/storagetypes"
+	"github.com/theopenlane/core/pkg/objects/retention"
+	"github.com/theopenlane/core/pkg/objects/scanner"
 
 	"github.com/theopenlane/iam/tokens"
 )
@@ -28,10 +31,12 @@ type (
 
 // Provider type constants so we can range, switch, etc
 const (
-	S3Provider       = storagetypes.S3Provider
-	R2Provider       = storagetypes.R2Provider
-	DiskProvider     = storagetypes.DiskProvider
-	DatabaseProvider = storagetypes.DatabaseProvider
+	S3Provider        = storagetypes.S3Provider
+	R2Provider        = storagetypes.R2Provider
+	DiskProvider      = storagetypes.DiskProvider
+	DatabaseProvider  = storagetypes.DatabaseProvider
+	AzureBlobProvider = storagetypes.AzureBlobProvider
+	GCSProvider       = storagetypes.GCSProvider
 	// Presign mode constants
 	PresignModeProvider = storagetypes.PresignModeProvider
 	PresignModeProxy    = storagetypes.PresignModeProxy
@@ -97,6 +102,22 @@ type ProviderConfig struct {
 	DevMode bool `json:"devmode" koanf:"devmode" default:"false"`
 	// Providers contains configuration for each storage provider
 	Providers Providers `json:"providers" koanf:"providers"`
+	// Scanning contains configuration for content scanning and quarantine of uploaded files
+	Scanning scanner.Config `json:"scanning" koanf:"scanning"`
+	// Resumable contains configuration for resumable (tus) uploads of large files
+	Resumable ResumableConfig `json:"resumable" koanf:"resumable"`
+	// Retention contains configuration for evidence retention, legal hold and write-once storage of uploaded files
+	Retention retention.Config `json:"retention" koanf:"retention"`
+}
+
+// ResumableConfig contains configuration for resumable uploads
+type ResumableConfig struct {
+	// Enabled toggles the resumable upload endpoints
+	Enabled bool `json:"enabled" koanf:"enabled" default:"false"`
+	// MaxSizeMB is the maximum size of a single resumable upload in MB
+	MaxSizeMB int64 `json:"maxsizemb" koanf:"maxsizemb" default:"5120"`
+	// Expiration is how long an incomplete upload is retained before the resumable upload cleanup sweep removes it
+	Expiration time.Duration `json:"expiration" koanf:"expiration" default:"24h"`
 }
 
 type Providers struct {
@@ -108,6 +129,10 @@ type Providers struct {
 	Disk ProviderConfigs `json:"disk" koanf:"disk"`
 	// Database provider configuration
 	Database ProviderConfigs `json:"database" koanf:"database"`
+	// AzureBlob provider configuration
+	AzureBlob ProviderConfigs `json:"azureblob" koanf:"azureblob"`
+	// GCS provider configuration
+	GCS ProviderConfigs `json:"gcs" koanf:"gcs"`
 }
 
 // ProviderConfigs contains configuration for all storage providers
@@ -143,6 +168,12 @@ type ProviderCredentials struct {
 	AccountID string `json:"accountid" koanf:"accountid" sensitive:"true"`
 	// APIToken for Cloudflare R2
 	APIToken string `json:"apitoken" koanf:"apitoken" sensitive:"true"`
+	// AccountName is the storage account name for Azure Blob Storage
+	AccountName string `json:"accountname" koanf:"accountname" sensitive:"true"`
+	// AccountKey is the base64 encoded storage account key for Azure Blob Storage
+	AccountKey string `json:"accountkey" koanf:"accountkey" sensitive:"true"`
+	// CredentialsJSON is the service account key JSON for GCS; application default credentials are used when empty
+	CredentialsJSON string `json:"credentialsjson" koanf:"credentialsjson" sensitive:"true"`
 }
 
 // ProviderOption configures runtime provider options
@@ -0,0 +1,5 @@
+// Package tus implements the server side of the tus 1.0 resumable upload protocol with the creation,
+// creation-defer-length, termination and expiration extensions. Partial uploads are staged in a Store,
+// such as the ObjectStore keeping each chunk in object storage, and handed to a completion callback once
+// every byte has been received so the assembled file can go through the normal object storage persistence path
+package tus
@@ -0,0 +1,57 @@
+package tus
+
+import (
+	"errors"
+	"net/http"
+)
+
+var (
+	// ErrUploadNotFound is returned when the requested upload does not exist or has expired
+	ErrUploadNotFound = errors.New("upload not found")
+	// ErrUploadExpired is returned when an upload is accessed after its expiration time
+	ErrUploadExpired = errors.New("upload has expired")
+	// ErrUnsupportedVersion is returned when the client does not send a supported Tus-Resumable header
+	ErrUnsupportedVersion = errors.New("unsupported tus protocol version")
+	// ErrInvalidUploadLength is returned when the Upload-Length header is missing or invalid
+	ErrInvalidUploadLength = errors.New("invalid upload length")
+	// ErrUploadLengthAlreadySet is returned when a deferred length is set more than once
+	ErrUploadLengthAlreadySet = errors.New("upload length has already been set")
+	// ErrInvalidOffset is returned when the Upload-Offset header does not match the stored offset
+	ErrInvalidOffset = errors.New("upload offset does not match")
+	// ErrInvalidContentType is returned when a PATCH request does not use application/offset+octet-stream
+	ErrInvalidContentType = errors.New("invalid content type, expected application/offset+octet-stream")
+	// ErrMaxSizeExceeded is returned when the upload exceeds the maximum allowed size
+	ErrMaxSizeExceeded = errors.New("upload exceeds maximum size")
+	// ErrInvalidMetadata is returned when the Upload-Metadata header cannot be parsed
+	ErrInvalidMetadata = errors.New("invalid upload metadata")
+	// ErrUploadForbidden is returned when the caller does not own the upload
+	ErrUploadForbidden = errors.New("upload belongs to another caller")
+	// ErrUploadLocked is returned when another request is currently writing to the upload
+	ErrUploadLocked = errors.New("upload is locked by another request")
+	// ErrInvalidUploadID is returned when an upload ID contains unexpected characters
+	ErrInvalidUploadID = errors.New("invalid upload id")
+)
+
+// statusForError maps protocol errors to the status codes defined by the tus specification
+func statusForError(err error) int {
+	switch {
+	case errors.Is(err, ErrUploadNotFound), errors.Is(err, ErrInvalidUploadID):
+		return http.StatusNotFound
+	case errors.Is(err, ErrUploadExpired):
+		return http.StatusGone
+	case errors.Is(err, ErrUnsupportedVersion):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, ErrInvalidOffset), errors.Is(err, ErrUploadLocked):
+		return http.StatusConflict
+	case errors.Is(err, ErrInvalidContentType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, ErrMaxSizeExceeded):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUploadForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, ErrInvalidUploadLength), errors.Is(err, ErrUploadLengthAlreadySet), errors.Is(err, ErrInvalidMetadata):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,433 @@
+package tus
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultExpiration is how long an incomplete upload is kept after its last activity
+	DefaultExpiration = 24 * time.Hour
+	// uploadIDBytes is the number of random bytes used for upload IDs
+	uploadIDBytes = 16
+)
+
+// Config configures the tus Handler
+type Config struct {
+	// Store stages partial uploads
+	Store Store
+	// MaxSize is the maximum upload size in bytes; zero means unlimited
+	MaxSize int64
+	// Expiration is how long an incomplete upload is kept after its last activity
+	Expiration time.Duration
+	// Caller resolves the subject performing the request
+	Caller CallerFunc
+	// Validate optionally validates a new upload before it is created
+	Validate ValidateFunc
+	// Complete persists a fully received upload
+	Complete CompleteFunc
+}
+
+// Handler serves the tus protocol endpoints
+type Handler struct {
+	store      Store
+	maxSize    int64
+	expiration time.Duration
+	caller     CallerFunc
+	validate   ValidateFunc
+	complete   CompleteFunc
+
+	locks sync.Map
+	now   func() time.Time
+}
+
+// NewHandler returns a tus Handler for the provided configuration
+func NewHandler(cfg Config) *Handler {
+	expiration := cfg.Expiration
+	if expiration <= 0 {
+		expiration = DefaultExpiration
+	}
+
+	return &Handler{
+		store:      cfg.Store,
+		maxSize:    cfg.MaxSize,
+		expiration: expiration,
+		caller:     cfg.Caller,
+		validate:   cfg.Validate,
+		complete:   cfg.Complete,
+		now:        time.Now,
+	}
+}
+
+// Options advertises the protocol version, extensions and maximum size
+func (h *Handler) Options(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set(HeaderResumable, Version)
+	w.Header().Set(HeaderVersion, Version)
+	w.Header().Set(HeaderExtension, Extensions)
+
+	if h.maxSize > 0 {
+		w.Header().Set(HeaderMaxSize, strconv.FormatInt(h.maxSize, 10))
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Create registers a new upload and returns its location
+func (h *Handler) Create(w http.ResponseWriter, r *http.Request) {
+	if err := checkVersion(r); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	caller, err := h.resolveCaller(r.Context())
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	info := Info{
+		Owner:          caller.SubjectID,
+		OrganizationID: caller.OrganizationID,
+		CreatedAt:      h.now(),
+		ExpiresAt:      h.now().Add(h.expiration),
+	}
+
+	if r.Header.Get(HeaderUploadDeferLength) == "1" {
+		info.SizeDeferred = true
+	} else {
+		size, err := parseLength(r.Header.Get(HeaderUploadLength))
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+
+		info.Size = size
+	}
+
+	if h.maxSize > 0 && info.Size > h.maxSize {
+		h.writeError(w, ErrMaxSizeExceeded)
+		return
+	}
+
+	if info.Metadata, err = ParseMetadata(r.Header.Get(HeaderUploadMetadata)); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	if h.validate != nil {
+		if err := h.validate(r.Context(), info); err != nil {
+			h.writeError(w, err)
+			return
+		}
+	}
+
+	if info.ID, err = newUploadID(); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	if err := h.store.Create(r.Context(), info); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set(HeaderResumable, Version)
+	w.Header().Set("Location", path.Join(r.URL.Path, info.ID))
+	w.Header().Set(HeaderUploadExpires, info.ExpiresAt.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// Head returns the current offset of an upload
+func (h *Handler) Head(w http.ResponseWriter, r *http.Request, id string) {
+	if err := checkVersion(r); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	info, err := h.load(r.Context(), id)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set(HeaderResumable, Version)
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set(HeaderUploadOffset, strconv.FormatInt(info.Offset, 10))
+	w.Header().Set(HeaderUploadExpires, info.ExpiresAt.UTC().Format(http.TimeFormat))
+
+	if info.SizeDeferred {
+		w.Header().Set(HeaderUploadDeferLength, "1")
+	} else {
+		w.Header().Set(HeaderUploadLength, strconv.FormatInt(info.Size, 10))
+	}
+
+	if len(info.Metadata) > 0 {
+		w.Header().Set(HeaderUploadMetadata, info.Metadata.String())
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// Patch appends a chunk to an upload; once every byte is received the upload is handed to the completion callback
+func (h *Handler) Patch(w http.ResponseWriter, r *http.Request, id string) {
+	if err := checkVersion(r); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	if !strings.EqualFold(r.Header.Get("Content-Type"), OffsetContentType) {
+		h.writeError(w, ErrInvalidContentType)
+		return
+	}
+
+	unlock, ok := h.lock(id)
+	if !ok {
+		h.writeError(w, ErrUploadLocked)
+		return
+	}
+	defer unlock()
+
+	ctx := r.Context()
+
+	info, err := h.load(ctx, id)
+	if err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	offset, err := strconv.ParseInt(r.Header.Get(HeaderUploadOffset), 10, 64)
+	if err != nil || offset != info.Offset {
+		h.writeError(w, ErrInvalidOffset)
+		return
+	}
+
+	if info.SizeDeferred && r.Header.Get(HeaderUploadLength) != "" {
+		size, err := parseLength(r.Header.Get(HeaderUploadLength))
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+
+		if size < info.Offset || (h.maxSize > 0 && size > h.maxSize) {
+			h.writeError(w, ErrInvalidUploadLength)
+			return
+		}
+
+		info.Size = size
+		info.SizeDeferred = false
+	}
+
+	info.ExpiresAt = h.now().Add(h.expiration)
+	if err := h.store.Update(ctx, info); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	limit := h.remaining(info)
+
+	written, err := h.store.WriteChunk(ctx, id, offset, io.LimitReader(r.Body, limit))
+	info.Offset += written
+
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		h.writeError(w, err)
+		return
+	}
+
+	// reject bodies that carry more bytes than the declared length
+	if written == limit && limit > 0 && !info.SizeDeferred {
+		if n, _ := r.Body.Read(make([]byte, 1)); n > 0 {
+			h.writeError(w, ErrMaxSizeExceeded)
+			return
+		}
+	}
+
+	w.Header().Set(HeaderResumable, Version)
+	w.Header().Set(HeaderUploadOffset, strconv.FormatInt(info.Offset, 10))
+	w.Header().Set(HeaderUploadExpires, info.ExpiresAt.UTC().Format(http.TimeFormat))
+
+	if info.Complete() {
+		fileID, err := h.finish(ctx, info)
+		if err != nil {
+			h.writeError(w, err)
+			return
+		}
+
+		w.Header().Set(HeaderFileID, fileID)
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Delete terminates an upload and removes its staged bytes
+func (h *Handler) Delete(w http.ResponseWriter, r *http.Request, id string) {
+	if err := checkVersion(r); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	unlock, ok := h.lock(id)
+	if !ok {
+		h.writeError(w, ErrUploadLocked)
+		return
+	}
+	defer unlock()
+
+	if _, err := h.load(r.Context(), id); err != nil && !errors.Is(err, ErrUploadExpired) {
+		h.writeError(w, err)
+		return
+	}
+
+	if err := h.store.Terminate(r.Context(), id); err != nil {
+		h.writeError(w, err)
+		return
+	}
+
+	w.Header().Set(HeaderResumable, Version)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CleanupExpired removes up to limit uploads in the store that expired before now and returns the number removed;
+// a limit of zero or less removes every expired upload
+func CleanupExpired(ctx context.Context, store Store, now time.Time, limit int) (int, error) {
+	infos, err := store.List(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+
+	for _, info := range infos {
+		if limit > 0 && removed >= limit {
+			break
+		}
+
+		if !info.Expired(now) {
+			continue
+		}
+
+		if err := store.Terminate(ctx, info.ID); err != nil && !errors.Is(err, ErrUploadNotFound) {
+			return removed, err
+		}
+
+		removed++
+	}
+
+	return removed, nil
+}
+
+// finish hands the assembled upload to the completion callback and removes the staged bytes on success;
+// on failure the staged bytes are kept so a zero length PATCH can retry the completion
+func (h *Handler) finish(ctx context.Context, info Info) (string, error) {
+	file, err := h.store.Open(ctx, info.ID)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	fileID, err := h.complete(ctx, info, file)
+	if err != nil {
+		return "", err
+	}
+
+	if err := h.store.Terminate(ctx, info.ID); err != nil && !errors.Is(err, ErrUploadNotFound) {
+		return fileID, err
+	}
+
+	return fileID, nil
+}
+
+// load returns the upload when it exists, has not expired and belongs to the caller
+func (h *Handler) load(ctx context.Context, id string) (Info, error) {
+	info, err := h.store.Get(ctx, id)
+	if err != nil {
+		return Info{}, err
+	}
+
+	caller, err := h.resolveCaller(ctx)
+	if err != nil {
+		return Info{}, err
+	}
+
+	if info.Owner != caller.SubjectID || info.OrganizationID != caller.OrganizationID {
+		return Info{}, ErrUploadForbidden
+	}
+
+	if info.Expired(h.now()) {
+		return info, ErrUploadExpired
+	}
+
+	return info, nil
+}
+
+func (h *Handler) resolveCaller(ctx context.Context) (Caller, error) {
+	if h.caller == nil {
+		return Caller{}, nil
+	}
+
+	return h.caller(ctx)
+}
+
+// remaining returns how many more bytes the upload may accept
+func (h *Handler) remaining(info Info) int64 {
+	if info.SizeDeferred {
+		if h.maxSize > 0 {
+			return h.maxSize - info.Offset
+		}
+
+		return int64(^uint64(0) >> 1)
+	}
+
+	return info.Size - info.Offset
+}
+
+// lock acquires the per upload lock, failing fast when another request holds it
+func (h *Handler) lock(id string) (func(), bool) {
+	if _, loaded := h.locks.LoadOrStore(id, struct{}{}); loaded {
+		return nil, false
+	}
+
+	return func() { h.locks.Delete(id) }, true
+}
+
+func (h *Handler) writeError(w http.ResponseWriter, err error) {
+	w.Header().Set(HeaderResumable, Version)
+	http.Error(w, err.Error(), statusForError(err))
+}
+
+// checkVersion verifies the client speaks the supported protocol version
+func checkVersion(r *http.Request) error {
+	if r.Header.Get(HeaderResumable) != Version {
+		return ErrUnsupportedVersion
+	}
+
+	return nil
+}
+
+// parseLength parses a non-negative Upload-Length header value
+func parseLength(value string) (int64, error) {
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return 0, ErrInvalidUploadLength
+	}
+
+	return size, nil
+}
+
+// newUploadID returns a random lowercase hex identifier
+func newUploadID() (string, error) {
+	b := make([]byte, uploadIDBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,283 @@
+package tus
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type completed struct {
+	info Info
+	data []byte
+}
+
+func newTestHandler(t *testing.T, caller *Caller, done *[]completed, completeErr *error) *Handler {
+	t.Helper()
+
+	return NewHandler(Config{
+		Store:   newMemoryObjectStore(),
+		MaxSize: 1024,
+		Caller: func(context.Context) (Caller, error) {
+			return *caller, nil
+		},
+		Complete: func(_ context.Context, info Info, file io.ReadSeeker) (string, error) {
+			if completeErr != nil && *completeErr != nil {
+				return "", *completeErr
+			}
+
+			data, err := io.ReadAll(file)
+			if err != nil {
+				return "", err
+			}
+
+			*done = append(*done, completed{info: info, data: data})
+
+			return "file-" + info.ID, nil
+		},
+	})
+}
+
+func tusRequest(method, target string, body io.Reader, headers map[string]string) *http.Request {
+	req := httptest.NewRequest(method, target, body)
+	req.Header.Set(HeaderResumable, Version)
+
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	return req
+}
+
+func createUpload(t *testing.T, h *Handler, headers map[string]string) string {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	h.Create(rec, tusRequest(http.MethodPost, "/v1/uploads", nil, headers))
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	location := rec.Header().Get("Location")
+	require.True(t, strings.HasPrefix(location, "/v1/uploads/"))
+
+	return path.Base(location)
+}
+
+func patchUpload(h *Handler, id string, offset int64, data string, extra map[string]string) *httptest.ResponseRecorder {
+	headers := map[string]string{
+		"Content-Type":     OffsetContentType,
+		HeaderUploadOffset: strconv.FormatInt(offset, 10),
+	}
+
+	for k, v := range extra {
+		headers[k] = v
+	}
+
+	rec := httptest.NewRecorder()
+	h.Patch(rec, tusRequest(http.MethodPatch, "/v1/uploads/"+id, strings.NewReader(data), headers), id)
+
+	return rec
+}
+
+func TestOptions(t *testing.T) {
+	h := newTestHandler(t, &Caller{}, &[]completed{}, nil)
+
+	rec := httptest.NewRecorder()
+	h.Options(rec, httptest.NewRequest(http.MethodOptions, "/v1/uploads", nil))
+
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Equal(t, Version, rec.Header().Get(HeaderVersion))
+	assert.Equal(t, Extensions, rec.Header().Get(HeaderExtension))
+	assert.Equal(t, "1024", rec.Header().Get(HeaderMaxSize))
+}
+
+func TestResumableUpload(t *testing.T) {
+	var done []completed
+
+	caller := &Caller{SubjectID: "user-1", OrganizationID: "org-1"}
+	h := newTestHandler(t, caller, &done, nil)
+
+	md := Metadata{"filename": "recording.mp4", "parent_type": "evidence"}
+	id := createUpload(t, h, map[string]string{
+		HeaderUploadLength:   "11",
+		HeaderUploadMetadata: md.String(),
+	})
+
+	rec := patchUpload(h, id, 0, "hello ", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Equal(t, "6", rec.Header().Get(HeaderUploadOffset))
+	assert.Empty(t, rec.Header().Get(HeaderFileID))
+
+	// resuming with a stale offset is rejected
+	rec = patchUpload(h, id, 0, "hello ", nil)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	head := httptest.NewRecorder()
+	h.Head(head, tusRequest(http.MethodHead, "/v1/uploads/"+id, nil, nil), id)
+	assert.Equal(t, http.StatusOK, head.Code)
+	assert.Equal(t, "6", head.Header().Get(HeaderUploadOffset))
+	assert.Equal(t, "11", head.Header().Get(HeaderUploadLength))
+	assert.Equal(t, md.String(), head.Header().Get(HeaderUploadMetadata))
+
+	rec = patchUpload(h, id, 6, "world", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	assert.Equal(t, "11", rec.Header().Get(HeaderUploadOffset))
+	assert.Equal(t, "file-"+id, rec.Header().Get(HeaderFileID))
+
+	require.Len(t, done, 1)
+	assert.Equal(t, "hello world", string(done[0].data))
+	assert.Equal(t, "recording.mp4", done[0].info.Metadata["filename"])
+
+	// the staged upload is removed after completion
+	head = httptest.NewRecorder()
+	h.Head(head, tusRequest(http.MethodHead, "/v1/uploads/"+id, nil, nil), id)
+	assert.Equal(t, http.StatusNotFound, head.Code)
+}
+
+func TestDeferredLength(t *testing.T) {
+	var done []completed
+
+	h := newTestHandler(t, &Caller{SubjectID: "user-1"}, &done, nil)
+
+	id := createUpload(t, h, map[string]string{HeaderUploadDeferLength: "1"})
+
+	rec := patchUpload(h, id, 0, "abc", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = patchUpload(h, id, 3, "def", map[string]string{HeaderUploadLength: "6"})
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+
+	require.Len(t, done, 1)
+	assert.Equal(t, "abcdef", string(done[0].data))
+}
+
+func TestCompletionRetry(t *testing.T) {
+	var done []completed
+
+	completeErr := errors.New("storage unavailable")
+	h := newTestHandler(t, &Caller{SubjectID: "user-1"}, &done, &completeErr)
+
+	id := createUpload(t, h, map[string]string{HeaderUploadLength: "3"})
+
+	rec := patchUpload(h, id, 0, "abc", nil)
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+
+	completeErr = nil
+
+	rec = patchUpload(h, id, 3, "", nil)
+	require.Equal(t, http.StatusNoContent, rec.Code, rec.Body.String())
+	require.Len(t, done, 1)
+	assert.Equal(t, "abc", string(done[0].data))
+}
+
+func TestUploadValidation(t *testing.T) {
+	caller := &Caller{SubjectID: "user-1", OrganizationID: "org-1"}
+	h := newTestHandler(t, caller, &[]completed{}, nil)
+
+	testCases := []struct {
+		name     string
+		method   string
+		headers  map[string]string
+		expected int
+	}{
+		{name: "missing version", method: http.MethodPost, headers: map[string]string{HeaderResumable: ""}, expected: http.StatusPreconditionFailed},
+		{name: "missing length", method: http.MethodPost, headers: map[string]string{}, expected: http.StatusBadRequest},
+		{name: "too large", method: http.MethodPost, headers: map[string]string{HeaderUploadLength: "2048"}, expected: http.StatusRequestEntityTooLarge},
+		{name: "bad metadata", method: http.MethodPost, headers: map[string]string{HeaderUploadLength: "1", HeaderUploadMetadata: "filename !!!"}, expected: http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			h.Create(rec, tusRequest(tc.method, "/v1/uploads", nil, tc.headers))
+			assert.Equal(t, tc.expected, rec.Code)
+		})
+	}
+
+	id := createUpload(t, h, map[string]string{HeaderUploadLength: "3"})
+
+	rec := httptest.NewRecorder()
+	h.Patch(rec, tusRequest(http.MethodPatch, "/v1/uploads/"+id, strings.NewReader("abc"), map[string]string{
+		"Content-Type":     "application/json",
+		HeaderUploadOffset: "0",
+	}), id)
+	assert.Equal(t, http.StatusUnsupportedMediaType, rec.Code)
+
+	rec = patchUpload(h, id, 0, "abcdef", nil)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+
+	rec = patchUpload(h, "../../etc/passwd", 0, "abc", nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestUploadOwnership(t *testing.T) {
+	caller := &Caller{SubjectID: "user-1", OrganizationID: "org-1"}
+	h := newTestHandler(t, caller, &[]completed{}, nil)
+
+	id := createUpload(t, h, map[string]string{HeaderUploadLength: "3"})
+
+	*caller = Caller{SubjectID: "user-2", OrganizationID: "org-1"}
+
+	rec := patchUpload(h, id, 0, "abc", nil)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	del := httptest.NewRecorder()
+	h.Delete(del, tusRequest(http.MethodDelete, "/v1/uploads/"+id, nil, nil), id)
+	assert.Equal(t, http.StatusForbidden, del.Code)
+
+	*caller = Caller{SubjectID: "user-1", OrganizationID: "org-1"}
+
+	del = httptest.NewRecorder()
+	h.Delete(del, tusRequest(http.MethodDelete, "/v1/uploads/"+id, nil, nil), id)
+	assert.Equal(t, http.StatusNoContent, del.Code)
+
+	head := httptest.NewRecorder()
+	h.Head(head, tusRequest(http.MethodHead, "/v1/uploads/"+id, nil, nil), id)
+	assert.Equal(t, http.StatusNotFound, head.Code)
+}
+
+func TestCleanupExpired(t *testing.T) {
+	h := newTestHandler(t, &Caller{SubjectID: "user-1"}, &[]completed{}, nil)
+
+	stale := createUpload(t, h, map[string]string{HeaderUploadLength: "3"})
+
+	h.now = func() time.Time { return time.Now().Add(2 * DefaultExpiration) }
+
+	fresh := createUpload(t, h, map[string]string{HeaderUploadLength: "3"})
+
+	rec := patchUpload(h, stale, 0, "abc", nil)
+	assert.Equal(t, http.StatusGone, rec.Code)
+
+	removed, err := CleanupExpired(context.Background(), h.store, h.now(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+
+	infos, err := h.store.List(context.Background())
+	require.NoError(t, err)
+	require.Len(t, infos, 1)
+	assert.Equal(t, fresh, infos[0].ID)
+}
+
+func TestParseMetadata(t *testing.T) {
+	md, err := ParseMetadata("filename cmVwb3J0LnBkZg==,is_confidential")
+	require.NoError(t, err)
+	assert.Equal(t, Metadata{"filename": "report.pdf", "is_confidential": ""}, md)
+	assert.Equal(t, "filename cmVwb3J0LnBkZg==,is_confidential", md.String())
+
+	md, err = ParseMetadata("")
+	require.NoError(t, err)
+	assert.Empty(t, md)
+
+	_, err = ParseMetadata("filename ???")
+	assert.ErrorIs(t, err, ErrInvalidMetadata)
+
+	assert.Equal(t, "", Metadata{}.String())
+}
@@ -0,0 +1,309 @@
+package tus
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"sort"
+)
+
+// Part is one chunk of an upload staged as its own object
+type Part struct {
+	// Offset is the upload offset the chunk starts at
+	Offset int64 `json:"offset"`
+	// Size is the number of bytes in the chunk
+	Size int64 `json:"size"`
+	// Key is the key of the object holding the chunk
+	Key string `json:"key"`
+}
+
+// StateStore persists the state of the uploads staged by an ObjectStore
+type StateStore interface {
+	// Create registers the state of a new upload
+	Create(ctx context.Context, info Info) error
+	// Get returns the state of an upload
+	Get(ctx context.Context, id string) (Info, error)
+	// Save replaces the state of an upload, failing with ErrInvalidOffset when the stored offset no longer equals offset
+	Save(ctx context.Context, info Info, offset int64) error
+	// Delete removes the state of an upload
+	Delete(ctx context.Context, id string) error
+	// List returns the state of every upload
+	List(ctx context.Context) ([]Info, error)
+}
+
+// ObjectBackend stores the chunks of the uploads staged by an ObjectStore
+type ObjectBackend interface {
+	// Put stores the chunk read from r, which starts at offset of the upload, and returns the stored part
+	Put(ctx context.Context, info Info, offset int64, r io.Reader) (Part, error)
+	// Get returns a reader over a stored part
+	Get(ctx context.Context, info Info, part Part) (io.ReadCloser, error)
+	// Delete removes a stored part
+	Delete(ctx context.Context, info Info, part Part) error
+}
+
+// ObjectStore stages every chunk of an upload as an object in object storage and keeps the upload state in a
+// StateStore, so any replica can resume any upload without a shared filesystem
+type ObjectStore struct {
+	state   StateStore
+	objects ObjectBackend
+}
+
+// NewObjectStore returns a store keeping upload state in state and staged chunks in objects
+func NewObjectStore(state StateStore, objects ObjectBackend) *ObjectStore {
+	return &ObjectStore{state: state, objects: objects}
+}
+
+// Create registers a new upload without any staged parts
+func (s *ObjectStore) Create(ctx context.Context, info Info) error {
+	if err := validateID(info.ID); err != nil {
+		return err
+	}
+
+	info.Offset = 0
+	info.Parts = nil
+
+	return s.state.Create(ctx, info)
+}
+
+// Get returns the stored state of an upload
+func (s *ObjectStore) Get(ctx context.Context, id string) (Info, error) {
+	if err := validateID(id); err != nil {
+		return Info{}, err
+	}
+
+	return s.state.Get(ctx, id)
+}
+
+// Update persists changes to the upload state, keeping the offset and parts already staged
+func (s *ObjectStore) Update(ctx context.Context, info Info) error {
+	if err := validateID(info.ID); err != nil {
+		return err
+	}
+
+	current, err := s.state.Get(ctx, info.ID)
+	if err != nil {
+		return err
+	}
+
+	info.Offset = current.Offset
+	info.Parts = current.Parts
+
+	return s.state.Save(ctx, info, current.Offset)
+}
+
+// WriteChunk stores the data as a new part starting at offset, which must equal the number of bytes already staged.
+// The bytes received before a request is interrupted are still staged, so the upload resumes from the new offset
+func (s *ObjectStore) WriteChunk(ctx context.Context, id string, offset int64, r io.Reader) (int64, error) {
+	if err := validateID(id); err != nil {
+		return 0, err
+	}
+
+	info, err := s.state.Get(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	if info.Offset != offset {
+		return 0, ErrInvalidOffset
+	}
+
+	body := &partialReader{r: r}
+	chunk := bufio.NewReader(body)
+
+	// empty requests, like the retry of a failed completion, stage nothing
+	if _, err := chunk.Peek(1); err != nil {
+		return 0, body.err
+	}
+
+	part, err := s.objects.Put(ctx, info, offset, chunk)
+	if err != nil {
+		return 0, err
+	}
+
+	info.Parts = append(info.Parts, part)
+	info.Offset += part.Size
+
+	if err := s.state.Save(ctx, info, offset); err != nil {
+		// another request staged the same offset first, so this part is never referenced
+		_ = s.objects.Delete(ctx, info, part)
+
+		return 0, err
+	}
+
+	return part.Size, body.err
+}
+
+// Open returns a reader over the staged bytes of the upload; parts are fetched from object storage as the reader
+// reaches them and only the current part is held in memory
+func (s *ObjectStore) Open(ctx context.Context, id string) (ReadSeekCloser, error) {
+	if err := validateID(id); err != nil {
+		return nil, err
+	}
+
+	info, err := s.state.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &partsReader{ctx: ctx, info: info, objects: s.objects, current: -1}, nil
+}
+
+// Terminate removes the staged parts and then the state of the upload; a part that cannot be removed keeps the
+// state, so a later cleanup retries the upload
+func (s *ObjectStore) Terminate(ctx context.Context, id string) error {
+	if err := validateID(id); err != nil {
+		return err
+	}
+
+	info, err := s.state.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+
+	for _, part := range info.Parts {
+		if err := s.objects.Delete(ctx, info, part); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	return s.state.Delete(ctx, id)
+}
+
+// List returns every staged upload
+func (s *ObjectStore) List(ctx context.Context) ([]Info, error) {
+	return s.state.List(ctx)
+}
+
+// partialReader ends the stream at the first read error and records it, so the bytes received before the error
+// are stored as a complete part
+type partialReader struct {
+	r   io.Reader
+	err error
+}
+
+func (p *partialReader) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if err != nil && !errors.Is(err, io.EOF) {
+		p.err = err
+
+		return n, io.EOF
+	}
+
+	return n, err
+}
+
+// partsReader reads the staged parts of an upload as one stream
+type partsReader struct {
+	ctx     context.Context
+	info    Info
+	objects ObjectBackend
+
+	pos     int64
+	current int
+	data    []byte
+}
+
+func (r *partsReader) Read(b []byte) (int, error) {
+	if r.pos >= r.info.Offset {
+		return 0, io.EOF
+	}
+
+	idx := sort.Search(len(r.info.Parts), func(i int) bool {
+		part := r.info.Parts[i]
+
+		return part.Offset+part.Size > r.pos
+	})
+
+	if idx == len(r.info.Parts) {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	if idx != r.current {
+		if err := r.load(idx); err != nil {
+			return 0, err
+		}
+	}
+
+	part := r.info.Parts[idx]
+	start := r.pos - part.Offset
+
+	if start >= int64(len(r.data)) {
+		return 0, io.ErrUnexpectedEOF
+	}
+
+	n := copy(b, r.data[start:])
+	r.pos += int64(n)
+
+	return n, nil
+}
+
+func (r *partsReader) Seek(offset int64, whence int) (int64, error) {
+	var pos int64
+
+	switch whence {
+	case io.SeekStart:
+		pos = offset
+	case io.SeekCurrent:
+		pos = r.pos + offset
+	case io.SeekEnd:
+		pos = r.info.Offset + offset
+	default:
+		return 0, ErrInvalidOffset
+	}
+
+	if pos < 0 {
+		return 0, ErrInvalidOffset
+	}
+
+	r.pos = pos
+
+	return pos, nil
+}
+
+func (r *partsReader) Close() error {
+	r.data = nil
+	r.current = -1
+
+	return nil
+}
+
+// load fetches the part at idx, replacing the part held in memory
+func (r *partsReader) load(idx int) error {
+	rc, err := r.objects.Get(r.ctx, r.info, r.info.Parts[idx])
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		return err
+	}
+
+	r.data = data
+	r.current = idx
+
+	return nil
+}
+
+// validateID only allows the characters produced by newUploadID, keeping upload IDs safe to use in object keys
+func validateID(id string) error {
+	if id == "" {
+		return ErrInvalidUploadID
+	}
+
+	for _, r := range id {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'z') {
+			return ErrInvalidUploadID
+		}
+	}
+
+	return nil
+}
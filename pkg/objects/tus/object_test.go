@@ -0,0 +1,239 @@
+package tus
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryState is an in-memory StateStore for unit tests
+type memoryState struct {
+	mu      sync.Mutex
+	uploads map[string]Info
+}
+
+func (m *memoryState) Create(_ context.Context, info Info) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.uploads[info.ID] = info
+
+	return nil
+}
+
+func (m *memoryState) Get(_ context.Context, id string) (Info, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	info, ok := m.uploads[id]
+	if !ok {
+		return Info{}, ErrUploadNotFound
+	}
+
+	return info, nil
+}
+
+func (m *memoryState) Save(_ context.Context, info Info, offset int64) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	current, ok := m.uploads[info.ID]
+	if !ok {
+		return ErrUploadNotFound
+	}
+
+	if current.Offset != offset {
+		return ErrInvalidOffset
+	}
+
+	m.uploads[info.ID] = info
+
+	return nil
+}
+
+func (m *memoryState) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.uploads[id]; !ok {
+		return ErrUploadNotFound
+	}
+
+	delete(m.uploads, id)
+
+	return nil
+}
+
+func (m *memoryState) List(context.Context) ([]Info, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	infos := make([]Info, 0, len(m.uploads))
+	for _, info := range m.uploads {
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// memoryObjects is an in-memory ObjectBackend for unit tests
+type memoryObjects struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+	gets    int
+}
+
+func (m *memoryObjects) Put(_ context.Context, info Info, offset int64, r io.Reader) (Part, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return Part{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := info.ID + "/" + strconv.FormatInt(offset, 10)
+	m.objects[key] = data
+
+	return Part{Offset: offset, Size: int64(len(data)), Key: key}, nil
+}
+
+func (m *memoryObjects) Get(_ context.Context, _ Info, part Part) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	data, ok := m.objects[part.Key]
+	if !ok {
+		return nil, ErrUploadNotFound
+	}
+
+	m.gets++
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (m *memoryObjects) Delete(_ context.Context, _ Info, part Part) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.objects, part.Key)
+
+	return nil
+}
+
+func newMemoryObjectStore() *ObjectStore {
+	return NewObjectStore(&memoryState{uploads: map[string]Info{}}, &memoryObjects{objects: map[string][]byte{}})
+}
+
+// interruptedReader returns its data and then fails like a dropped connection
+type interruptedReader struct {
+	data []byte
+	err  error
+}
+
+func (r *interruptedReader) Read(b []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+
+	n := copy(b, r.data)
+	r.data = r.data[n:]
+
+	return n, nil
+}
+
+func TestObjectStoreParts(t *testing.T) {
+	ctx := context.Background()
+	objects := &memoryObjects{objects: map[string][]byte{}}
+	store := NewObjectStore(&memoryState{uploads: map[string]Info{}}, objects)
+
+	require.NoError(t, store.Create(ctx, Info{ID: "abc123", Size: 11}))
+
+	written, err := store.WriteChunk(ctx, "abc123", 0, bytes.NewReader([]byte("hello ")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(6), written)
+
+	// an empty chunk stages nothing
+	written, err = store.WriteChunk(ctx, "abc123", 6, bytes.NewReader(nil))
+	require.NoError(t, err)
+	assert.Zero(t, written)
+
+	_, err = store.WriteChunk(ctx, "abc123", 0, bytes.NewReader([]byte("again")))
+	assert.ErrorIs(t, err, ErrInvalidOffset)
+
+	written, err = store.WriteChunk(ctx, "abc123", 6, bytes.NewReader([]byte("world")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), written)
+
+	info, err := store.Get(ctx, "abc123")
+	require.NoError(t, err)
+	require.Len(t, info.Parts, 2)
+	assert.True(t, info.Complete())
+
+	file, err := store.Open(ctx, "abc123")
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	// seeking within the part held in memory does not fetch it again
+	_, err = file.Seek(8, io.SeekStart)
+	require.NoError(t, err)
+
+	gets := objects.gets
+
+	data, err = io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "rld", string(data))
+	assert.Equal(t, gets, objects.gets)
+	require.NoError(t, file.Close())
+
+	require.NoError(t, store.Terminate(ctx, "abc123"))
+	assert.Empty(t, objects.objects)
+
+	_, err = store.Get(ctx, "abc123")
+	assert.ErrorIs(t, err, ErrUploadNotFound)
+}
+
+func TestObjectStoreInterruptedChunk(t *testing.T) {
+	ctx := context.Background()
+	store := newMemoryObjectStore()
+
+	require.NoError(t, store.Create(ctx, Info{ID: "abc123", Size: 6}))
+
+	dropped := errors.New("connection reset")
+
+	written, err := store.WriteChunk(ctx, "abc123", 0, &interruptedReader{data: []byte("abc"), err: dropped})
+	assert.ErrorIs(t, err, dropped)
+	assert.Equal(t, int64(3), written)
+
+	// the upload resumes from the bytes received before the connection dropped
+	written, err = store.WriteChunk(ctx, "abc123", 3, bytes.NewReader([]byte("def")))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), written)
+
+	file, err := store.Open(ctx, "abc123")
+	require.NoError(t, err)
+
+	data, err := io.ReadAll(file)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", string(data))
+}
+
+func TestObjectStoreInvalidID(t *testing.T) {
+	store := newMemoryObjectStore()
+
+	_, err := store.Get(context.Background(), "../../etc/passwd")
+	assert.ErrorIs(t, err, ErrInvalidUploadID)
+
+	err = store.Create(context.Background(), Info{ID: "UPPER"})
+	assert.ErrorIs(t, err, ErrInvalidUploadID)
+}
@@ -0,0 +1,170 @@
+package tus
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// Version is the tus protocol version implemented by this package
+	Version = "1.0.0"
+	// Extensions lists the tus protocol extensions implemented by this package
+	Extensions = "creation,creation-defer-length,termination,expiration"
+	// OffsetContentType is the content type required for PATCH requests
+	OffsetContentType = "application/offset+octet-stream"
+
+	// HeaderResumable is the header carrying the protocol version
+	HeaderResumable = "Tus-Resumable"
+	// HeaderVersion advertises the supported protocol versions
+	HeaderVersion = "Tus-Version"
+	// HeaderExtension advertises the supported protocol extensions
+	HeaderExtension = "Tus-Extension"
+	// HeaderMaxSize advertises the maximum upload size
+	HeaderMaxSize = "Tus-Max-Size"
+	// HeaderUploadOffset carries the current upload offset
+	HeaderUploadOffset = "Upload-Offset"
+	// HeaderUploadLength carries the total upload size
+	HeaderUploadLength = "Upload-Length"
+	// HeaderUploadDeferLength indicates the upload length will be sent later
+	HeaderUploadDeferLength = "Upload-Defer-Length"
+	// HeaderUploadMetadata carries the base64 encoded upload metadata
+	HeaderUploadMetadata = "Upload-Metadata"
+	// HeaderUploadExpires carries the upload expiration time
+	HeaderUploadExpires = "Upload-Expires"
+	// HeaderFileID carries the ID of the persisted file once an upload completes
+	HeaderFileID = "Upload-File-Id"
+)
+
+// Info describes the state of a single resumable upload
+type Info struct {
+	// ID is the unique identifier of the upload
+	ID string `json:"id"`
+	// Size is the total size of the upload in bytes
+	Size int64 `json:"size"`
+	// SizeDeferred indicates the size has not been declared yet
+	SizeDeferred bool `json:"size_deferred"`
+	// Offset is the number of bytes received so far
+	Offset int64 `json:"offset"`
+	// Metadata is the decoded Upload-Metadata provided at creation
+	Metadata Metadata `json:"metadata,omitempty"`
+	// Owner is the subject that created the upload; only the owner may resume or terminate it
+	Owner string `json:"owner,omitempty"`
+	// OrganizationID is the organization the upload was created in
+	OrganizationID string `json:"organization_id,omitempty"`
+	// CreatedAt is the time the upload was created
+	CreatedAt time.Time `json:"created_at"`
+	// ExpiresAt is the time after which an incomplete upload is removed
+	ExpiresAt time.Time `json:"expires_at"`
+	// Parts lists the chunks staged for the upload, in offset order
+	Parts []Part `json:"parts,omitempty"`
+}
+
+// Complete reports whether every byte of the upload has been received
+func (i Info) Complete() bool {
+	return !i.SizeDeferred && i.Offset == i.Size
+}
+
+// Expired reports whether the upload expired before the provided time
+func (i Info) Expired(now time.Time) bool {
+	return !i.ExpiresAt.IsZero() && now.After(i.ExpiresAt)
+}
+
+// Metadata is the decoded key/value metadata sent in the Upload-Metadata header
+type Metadata map[string]string
+
+// ParseMetadata decodes an Upload-Metadata header value of comma separated "key base64value" pairs
+func ParseMetadata(header string) (Metadata, error) {
+	md := Metadata{}
+
+	if strings.TrimSpace(header) == "" {
+		return md, nil
+	}
+
+	for pair := range strings.SplitSeq(header, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		key, encoded, _ := strings.Cut(pair, " ")
+		if key == "" {
+			return nil, ErrInvalidMetadata
+		}
+
+		value, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+		if err != nil {
+			return nil, ErrInvalidMetadata
+		}
+
+		md[key] = string(value)
+	}
+
+	return md, nil
+}
+
+// String encodes the metadata back into the Upload-Metadata header format with keys in sorted order
+func (m Metadata) String() string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if m[k] == "" {
+			pairs = append(pairs, k)
+			continue
+		}
+
+		pairs = append(pairs, k+" "+base64.StdEncoding.EncodeToString([]byte(m[k])))
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+// Store stages partial uploads until every byte has been received
+type Store interface {
+	// Create registers a new upload
+	Create(ctx context.Context, info Info) error
+	// Get returns the stored state of an upload
+	Get(ctx context.Context, id string) (Info, error)
+	// Update persists changes to the upload state other than the offset (e.g. a deferred length)
+	Update(ctx context.Context, info Info) error
+	// WriteChunk appends data at the given offset and returns the number of bytes written
+	WriteChunk(ctx context.Context, id string, offset int64, r io.Reader) (int64, error)
+	// Open returns a reader over the staged bytes of the upload
+	Open(ctx context.Context, id string) (ReadSeekCloser, error)
+	// Terminate removes the upload and its staged bytes
+	Terminate(ctx context.Context, id string) error
+	// List returns every staged upload
+	List(ctx context.Context) ([]Info, error)
+}
+
+// ReadSeekCloser is the reader returned for a completed upload
+type ReadSeekCloser interface {
+	io.ReadSeeker
+	io.Closer
+}
+
+// Caller identifies the subject performing an upload request
+type Caller struct {
+	// SubjectID is the ID of the authenticated subject
+	SubjectID string
+	// OrganizationID is the active organization of the subject
+	OrganizationID string
+}
+
+// CallerFunc resolves the caller for a request context
+type CallerFunc func(ctx context.Context) (Caller, error)
+
+// CompleteFunc persists a fully received upload and returns the ID of the stored file
+type CompleteFunc func(ctx context.Context, info Info, file io.ReadSeeker) (string, error)
+
+// ValidateFunc validates an upload before it is created, e.g. checking access to the parent object
+type ValidateFunc func(ctx context.Context, info Info) error
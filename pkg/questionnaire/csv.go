@@ -0,0 +1,124 @@
+package questionnaire
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+// utf8BOM is written by spreadsheet tools at the start of CSV exports
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// csvDelimiters are the separators tried when reading a CSV file
+var csvDelimiters = []rune{',', ';', '\t'}
+
+// parseCSV reads a CSV questionnaire, detecting the delimiter from the first line
+func parseCSV(data []byte) (*Document, error) {
+	doc := &Document{
+		Format:  FormatCSV,
+		raw:     data,
+		csvBOM:  bytes.HasPrefix(data, utf8BOM),
+		csvCRLF: bytes.Contains(data, []byte("\r\n")),
+	}
+
+	body := bytes.TrimPrefix(data, utf8BOM)
+	doc.csvComma = detectDelimiter(body)
+
+	records, err := readCSV(body, doc.csvComma)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet := &Sheet{Name: "Sheet1"}
+
+	for i, record := range records {
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		sheet.Rows = append(sheet.Rows, Row{Number: i + 1, Cells: record})
+	}
+
+	doc.Sheets = []*Sheet{sheet}
+
+	return doc, nil
+}
+
+// fillCSV writes the answers into the records and encodes the file with the original delimiter and line endings
+func (d *Document) fillCSV(answers map[int]map[int]string) ([]byte, error) {
+	records, err := readCSV(bytes.TrimPrefix(d.raw, utf8BOM), d.csvComma)
+	if err != nil {
+		return nil, err
+	}
+
+	for rowNumber, cells := range answers {
+		i := rowNumber - 1
+		if i < 0 || i >= len(records) {
+			continue
+		}
+
+		for col, text := range cells {
+			for len(records[i]) <= col {
+				records[i] = append(records[i], "")
+			}
+
+			records[i][col] = text
+		}
+	}
+
+	var buf bytes.Buffer
+
+	if d.csvBOM {
+		buf.Write(utf8BOM)
+	}
+
+	w := csv.NewWriter(&buf)
+	w.Comma = d.csvComma
+	w.UseCRLF = d.csvCRLF
+
+	if err := w.WriteAll(records); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// readCSV reads every record, allowing rows of different lengths
+func readCSV(data []byte, comma rune) ([][]string, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = comma
+	r.LazyQuotes = true
+	r.FieldsPerRecord = -1
+
+	var records [][]string
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+
+		if err != nil {
+			return nil, ErrInvalidDocument
+		}
+
+		records = append(records, record)
+	}
+}
+
+// detectDelimiter returns the separator that splits the first line into the most fields
+func detectDelimiter(data []byte) rune {
+	line, _, _ := bytes.Cut(data, []byte("\n"))
+
+	best, bestCount := csvDelimiters[0], 0
+
+	for _, d := range csvDelimiters {
+		if n := bytes.Count(line, []byte(string(d))); n > bestCount {
+			best, bestCount = d, n
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,4 @@
+// Package questionnaire answers inbound security questionnaires from a library of approved answers. Questions
+// are read from CSV or XLSX files, matched to the library by lexical similarity and the chosen answers are
+// written back into the file without changing its layout
+package questionnaire
@@ -0,0 +1,299 @@
+package questionnaire
+
+import (
+	"path"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Format is a questionnaire file format
+type Format string
+
+const (
+	// FormatCSV is a comma, semicolon or tab separated file
+	FormatCSV Format = "csv"
+	// FormatXLSX is an Excel workbook
+	FormatXLSX Format = "xlsx"
+)
+
+const (
+	// headerScanRows is how many rows at the top of a sheet are checked for the column headers
+	headerScanRows = 20
+	// minQuestionWords is the fewest words a cell without a question mark needs to count as a question
+	minQuestionWords = 3
+
+	contentTypeCSV  = "text/csv"
+	contentTypeXLSX = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// Sheet is a single sheet of the questionnaire; CSV files have one sheet
+type Sheet struct {
+	// Name of the sheet
+	Name string
+	// Rows of the sheet that contain at least one value
+	Rows []Row
+}
+
+// Row is a row of a sheet
+type Row struct {
+	// Number is the 1-based row number shown in a spreadsheet
+	Number int
+	// Cells are the cell values by 0-based column
+	Cells []string
+}
+
+// Cell returns the trimmed value of the column, empty when the row has no value in it
+func (r Row) Cell(column int) string {
+	if column < 0 || column >= len(r.Cells) {
+		return ""
+	}
+
+	return strings.TrimSpace(r.Cells[column])
+}
+
+// Document is a parsed questionnaire that keeps the original file so answers can be written back into it
+type Document struct {
+	// Format of the file
+	Format Format
+	// Sheets of the file, in order
+	Sheets []*Sheet
+
+	raw      []byte
+	csvComma rune
+	csvCRLF  bool
+	csvBOM   bool
+}
+
+// Question is a question read from the questionnaire
+type Question struct {
+	// Sheet the question is on
+	Sheet string
+	// Row is the 1-based row number of the question
+	Row int
+	// Text of the question
+	Text string
+	// Answer is the current value of the answer cell, if any
+	Answer string
+}
+
+// Answer is an answer to write into the questionnaire
+type Answer struct {
+	// Sheet the question is on; empty matches the first sheet
+	Sheet string
+	// Row is the 1-based row number of the question
+	Row int
+	// Text of the answer
+	Text string
+}
+
+// layout is where the questions and answers are on a sheet
+type layout struct {
+	headerRow      int
+	questionColumn int
+	answerColumn   int
+}
+
+// FormatOf returns the format of the questionnaire from its file name; an empty format is not supported
+func FormatOf(filename string) Format {
+	switch strings.ToLower(strings.TrimPrefix(path.Ext(filename), ".")) {
+	case "csv", "tsv", "txt":
+		return FormatCSV
+	case "xlsx":
+		return FormatXLSX
+	default:
+		return ""
+	}
+}
+
+// Parse reads the questionnaire file
+func Parse(filename string, data []byte) (*Document, error) {
+	switch FormatOf(filename) {
+	case FormatCSV:
+		return parseCSV(data)
+	case FormatXLSX:
+		return parseXLSX(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the media type of the file
+func (d *Document) ContentType() string {
+	if d.Format == FormatXLSX {
+		return contentTypeXLSX
+	}
+
+	return contentTypeCSV
+}
+
+// Questions returns the questions of every sheet in file order
+func (d *Document) Questions() ([]Question, error) {
+	var out []Question
+
+	for _, sheet := range d.Sheets {
+		l, ok := detectLayout(sheet)
+		if !ok {
+			continue
+		}
+
+		for _, row := range sheet.Rows {
+			if row.Number <= l.headerRow {
+				continue
+			}
+
+			text := row.Cell(l.questionColumn)
+			if !isQuestion(text) {
+				continue
+			}
+
+			out = append(out, Question{
+				Sheet:  sheet.Name,
+				Row:    row.Number,
+				Text:   text,
+				Answer: row.Cell(l.answerColumn),
+			})
+		}
+	}
+
+	if len(out) == 0 {
+		return nil, ErrNoQuestions
+	}
+
+	return out, nil
+}
+
+// Fill returns a copy of the file with the answers written into the answer column of their rows; everything
+// else in the file is left as it was
+func (d *Document) Fill(answers []Answer) ([]byte, error) {
+	bySheet := map[*Sheet]map[int]map[int]string{}
+
+	for _, a := range answers {
+		sheet := d.sheet(a.Sheet)
+		if sheet == nil {
+			return nil, ErrUnknownSheet
+		}
+
+		l, ok := detectLayout(sheet)
+		if !ok {
+			return nil, ErrNoQuestions
+		}
+
+		if bySheet[sheet] == nil {
+			bySheet[sheet] = map[int]map[int]string{}
+		}
+
+		if bySheet[sheet][a.Row] == nil {
+			bySheet[sheet][a.Row] = map[int]string{}
+		}
+
+		bySheet[sheet][a.Row][l.answerColumn] = a.Text
+	}
+
+	if d.Format == FormatXLSX {
+		return d.fillXLSX(bySheet)
+	}
+
+	return d.fillCSV(bySheet[d.Sheets[0]])
+}
+
+// sheet returns the sheet with the name, or the first sheet when the name is empty
+func (d *Document) sheet(name string) *Sheet {
+	if len(d.Sheets) == 0 {
+		return nil
+	}
+
+	if name == "" {
+		return d.Sheets[0]
+	}
+
+	for _, s := range d.Sheets {
+		if s.Name == name {
+			return s
+		}
+	}
+
+	return nil
+}
+
+// detectLayout finds the question and answer columns from the header row; without headers the column with
+// the most cells ending in a question mark is used and answers go in the column after it
+func detectLayout(sheet *Sheet) (layout, bool) {
+	for i, row := range sheet.Rows {
+		if i >= headerScanRows {
+			break
+		}
+
+		l := layout{headerRow: row.Number, questionColumn: -1, answerColumn: -1}
+
+		for col := range row.Cells {
+			header := strings.ToLower(row.Cell(col))
+
+			switch {
+			case l.questionColumn < 0 && isQuestionHeader(header):
+				l.questionColumn = col
+			case l.answerColumn < 0 && isAnswerHeader(header):
+				l.answerColumn = col
+			}
+		}
+
+		if l.questionColumn >= 0 {
+			if l.answerColumn < 0 {
+				l.answerColumn = l.questionColumn + 1
+			}
+
+			return l, true
+		}
+	}
+
+	counts := map[int]int{}
+	best := -1
+
+	for _, row := range sheet.Rows {
+		for col := range row.Cells {
+			if strings.HasSuffix(row.Cell(col), "?") {
+				counts[col]++
+
+				if best < 0 || counts[col] > counts[best] || (counts[col] == counts[best] && col < best) {
+					best = col
+				}
+			}
+		}
+	}
+
+	if best < 0 {
+		return layout{}, false
+	}
+
+	return layout{questionColumn: best, answerColumn: best + 1}, true
+}
+
+// questionHeaderSkipWords mark header columns that hold question numbers or groupings instead of the text
+var questionHeaderSkipWords = []string{"id", "#", "number", "no.", "ref", "type", "category"}
+
+// isQuestionHeader reports whether the header names the question text column rather than a question number
+func isQuestionHeader(header string) bool {
+	if !strings.Contains(header, "question") && header != "control" && header != "requirement" {
+		return false
+	}
+
+	words := strings.FieldsFunc(header, func(r rune) bool { return unicode.IsSpace(r) || r == '_' })
+
+	return !slices.ContainsFunc(words, func(w string) bool {
+		return slices.Contains(questionHeaderSkipWords, w)
+	})
+}
+
+// isAnswerHeader reports whether the header names the column answers are written to
+func isAnswerHeader(header string) bool {
+	return strings.Contains(header, "answer") || strings.Contains(header, "response")
+}
+
+// isQuestion reports whether the cell holds a question rather than a section title or note
+func isQuestion(text string) bool {
+	if text == "" {
+		return false
+	}
+
+	return strings.HasSuffix(text, "?") || len(strings.Fields(text)) >= minQuestionWords
+}
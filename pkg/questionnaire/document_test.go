@@ -0,0 +1,153 @@
+package questionnaire
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+// buildXLSX returns a workbook with a cover sheet and a security questionnaire sheet; the style id of the
+// draft answer cell is returned so tests can check it is kept
+func buildXLSX(t *testing.T) ([]byte, int) {
+	t.Helper()
+
+	f := excelize.NewFile()
+	defer f.Close()
+
+	require.NoError(t, f.SetSheetName("Sheet1", "Cover"))
+	require.NoError(t, f.SetCellStr("Cover", "A1", "Vendor questionnaire"))
+
+	_, err := f.NewSheet("Security")
+	require.NoError(t, err)
+
+	for cell, value := range map[string]string{
+		"A1": "Question",
+		"B1": "Response",
+		"C1": "Notes",
+		"A2": "Do you encrypt data at rest?",
+		"C2": "keep me",
+		"A3": "Describe your incident response process",
+		"B3": "draft",
+		"A5": "Access Control",
+	} {
+		require.NoError(t, f.SetCellStr("Security", cell, value))
+	}
+
+	style, err := f.NewStyle(&excelize.Style{Font: &excelize.Font{Bold: true}})
+	require.NoError(t, err)
+	require.NoError(t, f.SetCellStyle("Security", "B3", "B3", style))
+
+	buf, err := f.WriteToBuffer()
+	require.NoError(t, err)
+
+	return buf.Bytes(), style
+}
+
+func TestFormatOf(t *testing.T) {
+	assert.Equal(t, FormatCSV, FormatOf("sig-lite.csv"))
+	assert.Equal(t, FormatXLSX, FormatOf("CAIQ.XLSX"))
+	assert.Equal(t, Format(""), FormatOf("questions.pdf"))
+
+	_, err := Parse("questions.pdf", []byte("x"))
+	assert.ErrorIs(t, err, ErrUnsupportedFormat)
+}
+
+func TestCSVQuestionsAndFill(t *testing.T) {
+	data := []byte("\xEF\xBB\xBFID;Question;Answer\r\n1;Do you encrypt data at rest?;\r\n2;Section 2;\r\n3;\"Do you run annual penetration tests; and who runs them?\";Yes\r\n")
+
+	doc, err := Parse("vendor.csv", data)
+	require.NoError(t, err)
+
+	questions, err := doc.Questions()
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+
+	assert.Equal(t, Question{Sheet: "Sheet1", Row: 2, Text: "Do you encrypt data at rest?"}, questions[0])
+	assert.Equal(t, 4, questions[1].Row)
+	assert.Equal(t, "Yes", questions[1].Answer)
+
+	out, err := doc.Fill([]Answer{{Row: 2, Text: "Yes, AES-256"}, {Row: 4, Text: "Yes; annually"}})
+	require.NoError(t, err)
+
+	expected := "\xEF\xBB\xBFID;Question;Answer\r\n1;Do you encrypt data at rest?;Yes, AES-256\r\n2;Section 2;\r\n3;\"Do you run annual penetration tests; and who runs them?\";\"Yes; annually\"\r\n"
+	assert.Equal(t, expected, string(out))
+
+	_, err = doc.Fill([]Answer{{Sheet: "Other", Row: 2, Text: "x"}})
+	assert.ErrorIs(t, err, ErrUnknownSheet)
+}
+
+func TestCSVWithoutHeaders(t *testing.T) {
+	doc, err := Parse("vendor.csv", []byte("1.1,Is MFA enforced for all users?\n1.2,Are backups tested?\n"))
+	require.NoError(t, err)
+
+	questions, err := doc.Questions()
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+	assert.Equal(t, "Is MFA enforced for all users?", questions[0].Text)
+
+	out, err := doc.Fill([]Answer{{Row: 1, Text: "Yes"}})
+	require.NoError(t, err)
+	assert.Equal(t, "1.1,Is MFA enforced for all users?,Yes\n1.2,Are backups tested?\n", string(out))
+
+	doc, err = Parse("notes.csv", []byte("just,some,values\n"))
+	require.NoError(t, err)
+
+	_, err = doc.Questions()
+	assert.ErrorIs(t, err, ErrNoQuestions)
+}
+
+func TestXLSXQuestionsAndFill(t *testing.T) {
+	data, style := buildXLSX(t)
+
+	doc, err := Parse("caiq.xlsx", data)
+	require.NoError(t, err)
+	require.Len(t, doc.Sheets, 2)
+	assert.Equal(t, "Security", doc.Sheets[1].Name)
+
+	questions, err := doc.Questions()
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+
+	assert.Equal(t, Question{Sheet: "Security", Row: 2, Text: "Do you encrypt data at rest?"}, questions[0])
+	assert.Equal(t, Question{Sheet: "Security", Row: 3, Text: "Describe your incident response process", Answer: "draft"}, questions[1])
+
+	out, err := doc.Fill([]Answer{
+		{Sheet: "Security", Row: 2, Text: "Yes, <AES-256> & KMS"},
+		{Sheet: "Security", Row: 3, Text: "Documented in the IR plan"},
+	})
+	require.NoError(t, err)
+
+	filled, err := Parse("caiq.xlsx", out)
+	require.NoError(t, err)
+
+	questions, err = filled.Questions()
+	require.NoError(t, err)
+	require.Len(t, questions, 2)
+
+	assert.Equal(t, "Yes, <AES-256> & KMS", questions[0].Answer)
+	assert.Equal(t, "Documented in the IR plan", questions[1].Answer)
+
+	// the other cells of the answered rows and the other sheets are untouched; empty rows keep their numbers
+	assert.Equal(t, "keep me", filled.Sheets[1].Rows[1].Cell(2))
+	assert.Equal(t, 5, filled.Sheets[1].Rows[3].Number)
+	assert.Equal(t, "Access Control", filled.Sheets[1].Rows[3].Cell(0))
+	assert.Equal(t, "Vendor questionnaire", filled.Sheets[0].Rows[0].Cell(0))
+
+	// the style of an existing answer cell is kept
+	f, err := excelize.OpenReader(bytes.NewReader(out))
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	cellStyle, err := f.GetCellStyle("Security", "B3")
+	require.NoError(t, err)
+	assert.Equal(t, style, cellStyle)
+}
+
+func TestXLSXInvalid(t *testing.T) {
+	_, err := Parse("caiq.xlsx", []byte("not a workbook"))
+	assert.ErrorIs(t, err, ErrInvalidDocument)
+}
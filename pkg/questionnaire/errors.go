@@ -0,0 +1,14 @@
+package questionnaire
+
+import "errors"
+
+var (
+	// ErrUnsupportedFormat is returned when the questionnaire is not a CSV or XLSX file
+	ErrUnsupportedFormat = errors.New("questionnaire must be a csv or xlsx file")
+	// ErrInvalidDocument is returned when the file does not match the structure of its format
+	ErrInvalidDocument = errors.New("questionnaire could not be read")
+	// ErrNoQuestions is returned when no question column could be found in the questionnaire
+	ErrNoQuestions = errors.New("no questions found in the questionnaire")
+	// ErrUnknownSheet is returned when an answer refers to a sheet that is not in the questionnaire
+	ErrUnknownSheet = errors.New("sheet not found in the questionnaire")
+)
@@ -0,0 +1,131 @@
+package questionnaire
+
+import (
+	"strings"
+
+	"github.com/theopenlane/core/pkg/summarizer"
+)
+
+const (
+	// DefaultMinConfidence is the lowest similarity an answer needs to be suggested
+	DefaultMinConfidence = 0.2
+	// DefaultSuggestionLimit is how many suggestions are returned for a question by default
+	DefaultSuggestionLimit = 3
+)
+
+// Source is the object an answer in the library came from
+type Source struct {
+	// ObjectType is the type of the object, e.g. TrustCenterFAQ
+	ObjectType string
+	// ID of the object
+	ID string
+	// Title of the object shown next to the suggestion
+	Title string
+}
+
+// Entry is an approved answer in the library
+type Entry struct {
+	// Question the answer was given for; for documents such as policies this is the document text
+	Question string
+	// Answer to suggest
+	Answer string
+	// Source of the answer
+	Source Source
+}
+
+// Suggestion is a library answer suggested for a question
+type Suggestion struct {
+	// Answer text
+	Answer string
+	// Confidence is the similarity of the question to the library entry, between 0 and 1
+	Confidence float64
+	// Sources are the objects the answer was found in
+	Sources []Source
+}
+
+// Library matches questions to approved answers by lexical similarity
+type Library struct {
+	entries       []Entry
+	index         *summarizer.Index
+	minConfidence float64
+}
+
+// LibraryOption configures a Library
+type LibraryOption func(*Library)
+
+// WithMinConfidence sets the lowest similarity an answer needs to be suggested
+func WithMinConfidence(c float64) LibraryOption {
+	return func(l *Library) {
+		l.minConfidence = c
+	}
+}
+
+// NewLibrary builds a library from the entries; entries without an answer are left out
+func NewLibrary(entries []Entry, opts ...LibraryOption) *Library {
+	l := &Library{minConfidence: DefaultMinConfidence}
+
+	for _, opt := range opts {
+		opt(l)
+	}
+
+	docs := make([]string, 0, len(entries))
+
+	for _, e := range entries {
+		if strings.TrimSpace(e.Answer) == "" {
+			continue
+		}
+
+		l.entries = append(l.entries, e)
+		docs = append(docs, e.Question+"\n"+e.Answer)
+	}
+
+	l.index = summarizer.NewIndex(docs)
+
+	return l
+}
+
+// Len returns the number of answers in the library
+func (l *Library) Len() int {
+	return len(l.entries)
+}
+
+// Suggest returns up to limit answers for the question, most confident first; entries with the same answer
+// are merged into one suggestion citing every source
+func (l *Library) Suggest(question string, limit int) []Suggestion {
+	if limit <= 0 {
+		limit = DefaultSuggestionLimit
+	}
+
+	var (
+		out   []Suggestion
+		byKey = map[string]int{}
+	)
+
+	for _, m := range l.index.Rank(question, 0) {
+		if m.Score < l.minConfidence {
+			break
+		}
+
+		entry := l.entries[m.Document]
+		key := strings.ToLower(strings.Join(strings.Fields(entry.Answer), " "))
+
+		if i, ok := byKey[key]; ok {
+			out[i].Sources = append(out[i].Sources, entry.Source)
+
+			continue
+		}
+
+		if len(out) == limit {
+			continue
+		}
+
+		byKey[key] = len(out)
+		out = append(out, Suggestion{
+			Answer:     entry.Answer,
+			Confidence: m.Score,
+			Sources:    []Source{entry.Source},
+		})
+	}
+
+	return out
+}
@@ -0,0 +1,52 @@
+package questionnaire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLibrarySuggest(t *testing.T) {
+	lib := NewLibrary([]Entry{
+		{
+			Question: "Is customer data encrypted at rest?",
+			Answer:   "Yes, all customer data is encrypted at rest with AES-256.",
+			Source:   Source{ObjectType: "TrustCenterFAQ", ID: "faq1", Title: "Encryption"},
+		},
+		{
+			Question: "Do you encrypt stored customer data?",
+			Answer:   "Yes, all customer data is encrypted at rest with  AES-256.",
+			Source:   Source{ObjectType: "AssessmentResponse", ID: "resp1", Title: "Vendor review 2025"},
+		},
+		{
+			Question: "How often are penetration tests performed?",
+			Answer:   "An independent firm performs a penetration test every year.",
+			Source:   Source{ObjectType: "TrustCenterFAQ", ID: "faq2", Title: "Pen testing"},
+		},
+		{
+			Question: "Is there a business continuity plan?",
+			Answer:   "",
+			Source:   Source{ObjectType: "AssessmentResponse", ID: "resp2"},
+		},
+	})
+
+	assert.Equal(t, 3, lib.Len())
+
+	suggestions := lib.Suggest("Is customer data encrypted when stored at rest?", 0)
+	require.Len(t, suggestions, 1)
+
+	// the two entries differ only in spacing so they are merged into one suggestion citing both
+	assert.Contains(t, suggestions[0].Answer, "encrypted at rest")
+	assert.Greater(t, suggestions[0].Confidence, DefaultMinConfidence)
+	assert.ElementsMatch(t, []string{"faq1", "resp1"}, []string{suggestions[0].Sources[0].ID, suggestions[0].Sources[1].ID})
+
+	suggestions = lib.Suggest("Are penetration tests performed by a third party?", 1)
+	require.Len(t, suggestions, 1)
+	assert.Equal(t, "faq2", suggestions[0].Sources[0].ID)
+
+	assert.Empty(t, lib.Suggest("What is your favourite colour?", 0))
+
+	strict := NewLibrary([]Entry{{Question: "penetration tests", Answer: "yearly"}}, WithMinConfidence(1.1))
+	assert.Empty(t, strict.Suggest("penetration tests", 0))
+}
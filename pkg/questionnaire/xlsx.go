@@ -0,0 +1,89 @@
+package questionnaire
+
+import (
+	"bytes"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// parseXLSX reads the displayed values of every worksheet of the workbook
+func parseXLSX(data []byte) (*Document, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, ErrInvalidDocument
+	}
+
+	defer f.Close()
+
+	names := f.GetSheetList()
+	if len(names) == 0 {
+		return nil, ErrInvalidDocument
+	}
+
+	sheets := make([]*Sheet, 0, len(names))
+
+	for _, name := range names {
+		rows, err := f.GetRows(name)
+		if err != nil {
+			return nil, ErrInvalidDocument
+		}
+
+		sheet := &Sheet{Name: name}
+
+		for i, cells := range rows {
+			if !hasValue(cells) {
+				continue
+			}
+
+			sheet.Rows = append(sheet.Rows, Row{Number: i + 1, Cells: cells})
+		}
+
+		sheets = append(sheets, sheet)
+	}
+
+	return &Document{Format: FormatXLSX, Sheets: sheets, raw: data}, nil
+}
+
+// fillXLSX writes the answers into their cells, keeping the style of cells that already exist; every other part
+// of the workbook is written back as it was read
+func (d *Document) fillXLSX(answers map[*Sheet]map[int]map[int]string) ([]byte, error) {
+	f, err := excelize.OpenReader(bytes.NewReader(d.raw))
+	if err != nil {
+		return nil, ErrInvalidDocument
+	}
+
+	defer f.Close()
+
+	for sheet, rows := range answers {
+		for row, cells := range rows {
+			for column, text := range cells {
+				cell, err := excelize.CoordinatesToCellName(column+1, row)
+				if err != nil {
+					return nil, err
+				}
+
+				if err := f.SetCellStr(sheet.Name, cell, text); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// hasValue reports whether any cell of the row holds a value
+func hasValue(cells []string) bool {
+	for _, c := range cells {
+		if c != "" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,227 @@
+package signedbundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// ManifestPath is the path of the manifest in the archive
+	ManifestPath = "manifest.json"
+	// SignaturePath is the path of the base64 encoded manifest signature in the archive
+	SignaturePath = "manifest.sig"
+
+	// manifestVersion is the version of the manifest format
+	manifestVersion = 1
+)
+
+// File is a file to add to a bundle
+type File struct {
+	// Path is the slash separated path of the file in the archive
+	Path string
+	// Data is the content of the file
+	Data []byte
+}
+
+// FileDigest is the manifest entry for a file in the bundle
+type FileDigest struct {
+	// Path is the path of the file in the archive
+	Path string `json:"path"`
+	// SHA256 is the hex encoded SHA-256 digest of the file
+	SHA256 string `json:"sha256"`
+	// Size is the size of the file in bytes
+	Size int64 `json:"size"`
+}
+
+// Manifest lists the files in a bundle and identifies the key that signed it
+type Manifest struct {
+	// Version is the version of the manifest format
+	Version int `json:"version"`
+	// Algorithm is the signature algorithm
+	Algorithm string `json:"algorithm"`
+	// KeyID is the RFC 7638 thumbprint of the signing key
+	KeyID string `json:"keyId"`
+	// CreatedAt is when the bundle was created
+	CreatedAt time.Time `json:"createdAt"`
+	// Subject describes what the bundle contains, e.g. the ID of the exported object
+	Subject map[string]string `json:"subject,omitempty"`
+	// Files are the files in the bundle, in archive order
+	Files []FileDigest `json:"files"`
+}
+
+// Write writes the files and a manifest signed by signer to w as a zip archive and returns the manifest
+func Write(w io.Writer, files []File, subject map[string]string, signer *Signer, now time.Time) (*Manifest, error) {
+	manifest := &Manifest{
+		Version:   manifestVersion,
+		Algorithm: Algorithm,
+		KeyID:     signer.KeyID(),
+		CreatedAt: now.UTC().Truncate(time.Second),
+		Subject:   subject,
+		Files:     make([]FileDigest, 0, len(files)),
+	}
+
+	seen := map[string]bool{}
+
+	for _, f := range files {
+		if err := validatePath(f.Path); err != nil {
+			return nil, err
+		}
+
+		if seen[f.Path] {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatePath, f.Path)
+		}
+
+		seen[f.Path] = true
+
+		manifest.Files = append(manifest.Files, digest(f.Path, f.Data))
+	}
+
+	manifestData, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	signature := base64.StdEncoding.EncodeToString(signer.sign(manifestData)) + "\n"
+
+	zw := zip.NewWriter(w)
+
+	entries := append(slices.Clone(files), File{Path: ManifestPath, Data: manifestData}, File{Path: SignaturePath, Data: []byte(signature)})
+
+	for _, f := range entries {
+		fw, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     f.Path,
+			Method:   zip.Deflate,
+			Modified: manifest.CreatedAt,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := fw.Write(f.Data); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// Verify checks the manifest signature of the archive with pub and that the archive contains exactly the files
+// listed in the manifest with the recorded digests; the verified manifest is returned
+func Verify(r io.ReaderAt, size int64, pub ed25519.PublicKey) (*Manifest, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrContentMismatch, err)
+	}
+
+	contents := map[string][]byte{}
+
+	for _, zf := range zr.File {
+		if zf.FileInfo().IsDir() {
+			continue
+		}
+
+		if _, dup := contents[zf.Name]; dup {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicatePath, zf.Name)
+		}
+
+		data, err := readZipFile(zf)
+		if err != nil {
+			return nil, err
+		}
+
+		contents[zf.Name] = data
+	}
+
+	manifestData, okManifest := contents[ManifestPath]
+	signatureData, okSignature := contents[SignaturePath]
+
+	if !okManifest || !okSignature {
+		return nil, ErrMissingManifest
+	}
+
+	manifest := &Manifest{}
+	if err := json.Unmarshal(manifestData, manifest); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrMissingManifest, err)
+	}
+
+	if manifest.KeyID != KeyID(pub) {
+		return nil, ErrUnknownKey
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(signatureData)))
+	if err != nil || !ed25519.Verify(pub, manifestData, signature) {
+		return nil, ErrInvalidSignature
+	}
+
+	delete(contents, ManifestPath)
+	delete(contents, SignaturePath)
+
+	for _, want := range manifest.Files {
+		data, ok := contents[want.Path]
+		if !ok || digest(want.Path, data) != want {
+			return nil, fmt.Errorf("%w: %s", ErrContentMismatch, want.Path)
+		}
+
+		delete(contents, want.Path)
+	}
+
+	if len(contents) > 0 {
+		return nil, fmt.Errorf("%w: unexpected file %s", ErrContentMismatch, slices.Sorted(maps.Keys(contents))[0])
+	}
+
+	return manifest, nil
+}
+
+// VerifyBytes verifies an archive held in memory
+func VerifyBytes(data []byte, pub ed25519.PublicKey) (*Manifest, error) {
+	return Verify(bytes.NewReader(data), int64(len(data)), pub)
+}
+
+// validatePath rejects paths that are not clean relative paths inside the archive and the reserved manifest paths
+func validatePath(p string) error {
+	if p == "" || p != path.Clean(p) || path.IsAbs(p) || p == ".." || strings.HasPrefix(p, "../") || strings.Contains(p, "\\") ||
+		p == ManifestPath || p == SignaturePath {
+		return fmt.Errorf("%w: %q", ErrInvalidPath, p)
+	}
+
+	return nil
+}
+
+// digest returns the manifest entry for the file
+func digest(p string, data []byte) FileDigest {
+	sum := sha256.Sum256(data)
+
+	return FileDigest{
+		Path:   p,
+		SHA256: hex.EncodeToString(sum[:]),
+		Size:   int64(len(data)),
+	}
+}
+
+// readZipFile reads the contents of a file in the archive
+func readZipFile(zf *zip.File) ([]byte, error) {
+	rc, err := zf.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrContentMismatch, err)
+	}
+
+	defer rc.Close()
+
+	return io.ReadAll(rc)
+}
@@ -0,0 +1,178 @@
+package signedbundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeBundle(t *testing.T, signer *Signer, files []File) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	_, err := Write(&buf, files, map[string]string{"id": "tc1"}, signer, time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	return buf.Bytes()
+}
+
+// rewrite copies the archive, replacing or adding the given files
+func rewrite(t *testing.T, data []byte, replace map[string][]byte) []byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+
+	for _, zf := range zr.File {
+		content, err := readZipFile(zf)
+		require.NoError(t, err)
+
+		if r, ok := replace[zf.Name]; ok {
+			content = r
+			delete(replace, zf.Name)
+		}
+
+		w, err := zw.Create(zf.Name)
+		require.NoError(t, err)
+
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+
+	for name, content := range replace {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+
+		_, err = w.Write(content)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func TestWriteAndVerify(t *testing.T) {
+	signer, err := GenerateSigner()
+	require.NoError(t, err)
+
+	files := []File{
+		{Path: "index.html", Data: []byte("<html></html>")},
+		{Path: "documents/soc2.pdf", Data: []byte("%PDF-1.7")},
+	}
+
+	data := writeBundle(t, signer, files)
+
+	manifest, err := VerifyBytes(data, signer.PublicKey())
+	require.NoError(t, err)
+
+	assert.Equal(t, signer.KeyID(), manifest.KeyID)
+	assert.Equal(t, Algorithm, manifest.Algorithm)
+	assert.Equal(t, map[string]string{"id": "tc1"}, manifest.Subject)
+	require.Len(t, manifest.Files, 2)
+	assert.Equal(t, "documents/soc2.pdf", manifest.Files[1].Path)
+	assert.Equal(t, int64(8), manifest.Files[1].Size)
+
+	t.Run("modified file", func(t *testing.T) {
+		_, err := VerifyBytes(rewrite(t, data, map[string][]byte{"index.html": []byte("<html>changed</html>")}), signer.PublicKey())
+		assert.ErrorIs(t, err, ErrContentMismatch)
+	})
+
+	t.Run("added file", func(t *testing.T) {
+		_, err := VerifyBytes(rewrite(t, data, map[string][]byte{"extra.js": []byte("alert(1)")}), signer.PublicKey())
+		assert.ErrorIs(t, err, ErrContentMismatch)
+	})
+
+	t.Run("modified manifest", func(t *testing.T) {
+		_, err := VerifyBytes(rewrite(t, data, map[string][]byte{ManifestPath: bytes.Replace(mustRead(t, data, ManifestPath), []byte("tc1"), []byte("tc2"), 1)}), signer.PublicKey())
+		assert.ErrorIs(t, err, ErrInvalidSignature)
+	})
+
+	t.Run("other key", func(t *testing.T) {
+		other, err := GenerateSigner()
+		require.NoError(t, err)
+
+		_, err = VerifyBytes(data, other.PublicKey())
+		assert.ErrorIs(t, err, ErrUnknownKey)
+	})
+
+	t.Run("not a bundle", func(t *testing.T) {
+		_, err := VerifyBytes([]byte("not a zip"), signer.PublicKey())
+		assert.ErrorIs(t, err, ErrContentMismatch)
+	})
+}
+
+func mustRead(t *testing.T, data []byte, name string) []byte {
+	t.Helper()
+
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+
+	for _, zf := range zr.File {
+		if zf.Name == name {
+			content, err := readZipFile(zf)
+			require.NoError(t, err)
+
+			return content
+		}
+	}
+
+	t.Fatalf("%s not found", name)
+
+	return nil
+}
+
+func TestWriteRejectsInvalidPaths(t *testing.T) {
+	signer, err := GenerateSigner()
+	require.NoError(t, err)
+
+	for _, p := range []string{"", "/etc/passwd", "../escape", "a/../../b", "a//b", ManifestPath, SignaturePath, `a\b`} {
+		_, err := Write(&bytes.Buffer{}, []File{{Path: p}}, nil, signer, time.Now())
+		assert.ErrorIs(t, err, ErrInvalidPath, p)
+	}
+
+	_, err = Write(&bytes.Buffer{}, []File{{Path: "a"}, {Path: "a"}}, nil, signer, time.Now())
+	assert.ErrorIs(t, err, ErrDuplicatePath)
+}
+
+func TestParsePrivateKey(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	parsed, err := ParsePrivateKey(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	require.NoError(t, err)
+	assert.Equal(t, key, parsed)
+
+	signer := NewSigner(parsed)
+	assert.Equal(t, KeyID(key.Public().(ed25519.PublicKey)), signer.KeyID())
+	assert.Equal(t, signer.KeyID(), signer.JWK()["kid"])
+
+	_, err = ParsePrivateKey([]byte("not pem"))
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+func TestKeyID(t *testing.T) {
+	// RFC 8037 appendix A.3 thumbprint of the example Ed25519 key
+	pub := ed25519.PublicKey([]byte{
+		0xd7, 0x5a, 0x98, 0x01, 0x82, 0xb1, 0x0a, 0xb7, 0xd5, 0x4b, 0xfe, 0xd3, 0xc9, 0x64, 0x07, 0x3a,
+		0x0e, 0xe1, 0x72, 0xf3, 0xda, 0xa6, 0x23, 0x25, 0xaf, 0x02, 0x1a, 0x68, 0xf7, 0x07, 0x51, 0x1a,
+	})
+
+	assert.Equal(t, "kPrK_qmxVWaYVA9wwBF6Iuo3vVzz7TxHCTwXBygrS4k", KeyID(pub))
+}
@@ -0,0 +1,4 @@
+// Package signedbundle writes and verifies zip archives whose contents are listed in a manifest signed with
+// Ed25519. The manifest records the SHA-256 digest of every file so a recipient holding the public key can
+// confirm the archive was produced by the signer and has not been modified, without contacting the signer
+package signedbundle
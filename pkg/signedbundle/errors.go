@@ -0,0 +1,21 @@
+package signedbundle
+
+import "errors"
+
+var (
+	// ErrInvalidKey is returned when a signing key is not a PEM encoded Ed25519 private key
+	ErrInvalidKey = errors.New("signing key must be a PEM encoded Ed25519 private key")
+	// ErrInvalidPath is returned when a file path is empty, absolute, escapes the archive or is reserved
+	ErrInvalidPath = errors.New("invalid bundle file path")
+	// ErrDuplicatePath is returned when two files share a path
+	ErrDuplicatePath = errors.New("duplicate bundle file path")
+	// ErrMissingManifest is returned when the archive has no manifest or manifest signature
+	ErrMissingManifest = errors.New("bundle manifest or signature not found")
+	// ErrUnknownKey is returned when the manifest was signed by a different key than the one provided
+	ErrUnknownKey = errors.New("bundle was signed with a different key")
+	// ErrInvalidSignature is returned when the manifest signature does not verify
+	ErrInvalidSignature = errors.New("bundle manifest signature is invalid")
+	// ErrContentMismatch is returned when a file does not match the digest in the manifest, or the archive
+	// contains files the manifest does not list
+	ErrContentMismatch = errors.New("bundle contents do not match the manifest")
+)
@@ -0,0 +1,95 @@
+package signedbundle
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+)
+
+// Algorithm is the signature algorithm recorded in manifests and published keys
+const Algorithm = "EdDSA"
+
+// Signer signs bundle manifests with an Ed25519 key
+type Signer struct {
+	keyID string
+	key   ed25519.PrivateKey
+}
+
+// NewSigner returns a signer for the key; the key ID is the RFC 7638 thumbprint of the public key
+func NewSigner(key ed25519.PrivateKey) *Signer {
+	return &Signer{
+		keyID: KeyID(key.Public().(ed25519.PublicKey)),
+		key:   key,
+	}
+}
+
+// GenerateSigner returns a signer for a new random key
+func GenerateSigner() (*Signer, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSigner(key), nil
+}
+
+// ParsePrivateKey parses a PKCS #8 PEM encoded Ed25519 private key, as written by
+// `openssl genpkey -algorithm ed25519`
+func ParsePrivateKey(data []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+
+	return key, nil
+}
+
+// KeyID returns the RFC 7638 JWK thumbprint of an Ed25519 public key
+func KeyID(pub ed25519.PublicKey) string {
+	// the thumbprint is the digest of the required members of the JWK in lexicographic order
+	canonical := fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, base64.RawURLEncoding.EncodeToString(pub))
+	sum := sha256.Sum256([]byte(canonical))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// KeyID returns the ID of the signing key
+func (s *Signer) KeyID() string {
+	return s.keyID
+}
+
+// PublicKey returns the public half of the signing key
+func (s *Signer) PublicKey() ed25519.PublicKey {
+	return s.key.Public().(ed25519.PublicKey)
+}
+
+// JWK returns the public key as a JSON web key so it can be published alongside other verification keys
+func (s *Signer) JWK() map[string]string {
+	return map[string]string{
+		"kty": "OKP",
+		"crv": "Ed25519",
+		"use": "sig",
+		"alg": Algorithm,
+		"kid": s.keyID,
+		"x":   base64.RawURLEncoding.EncodeToString(s.PublicKey()),
+	}
+}
+
+// sign signs the message
+func (s *Signer) sign(message []byte) []byte {
+	return ed25519.Sign(s.key, message)
+}
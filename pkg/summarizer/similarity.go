@@ -0,0 +1,200 @@
+package summarizer
+
+import (
+	"cmp"
+	"html"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// minTokenLength is the shortest word kept when tokenizing text for similarity
+const minTokenLength = 2
+
+// stopWords are common words that carry no meaning when comparing texts
+var stopWords = map[string]struct{}{
+	"a": {}, "an": {}, "and": {}, "any": {}, "are": {}, "as": {}, "at": {}, "be": {}, "by": {}, "can": {},
+	"do": {}, "does": {}, "for": {}, "from": {}, "has": {}, "have": {}, "how": {}, "if": {}, "in": {},
+	"is": {}, "it": {}, "its": {}, "of": {}, "on": {}, "or": {}, "please": {}, "that": {}, "the": {},
+	"this": {}, "to": {}, "was": {}, "we": {}, "what": {}, "when": {}, "where": {}, "which": {}, "who": {},
+	"will": {}, "with": {}, "you": {}, "your": {}, "our": {}, "us": {}, "there": {}, "their": {}, "these": {},
+	"those": {}, "describe": {}, "provide": {}, "explain": {},
+}
+
+// Match is a document of an Index that is similar to a query
+type Match struct {
+	// Document is the position of the document in the slice the index was built from
+	Document int
+	// Score is the cosine similarity of the document and the query, between 0 and 1
+	Score float64
+}
+
+// Index ranks documents by the TF-IDF cosine similarity of their words to a query, the same word weighting
+// the lexrank summarizer uses to compare sentences
+type Index struct {
+	sanitizer *bluemonday.Policy
+	idf       map[string]float64
+	vectors   []map[string]float64
+}
+
+// NewIndex builds a similarity index over the documents; markdown and html are reduced to plain text first
+func NewIndex(documents []string) *Index {
+	idx := &Index{
+		sanitizer: bluemonday.StrictPolicy(),
+		idf:       map[string]float64{},
+		vectors:   make([]map[string]float64, len(documents)),
+	}
+
+	counts := make([]map[string]int, len(documents))
+	docFreq := map[string]int{}
+
+	for i, doc := range documents {
+		counts[i] = termCounts(Tokenize(plainText(idx.sanitizer, doc)))
+
+		for term := range counts[i] {
+			docFreq[term]++
+		}
+	}
+
+	n := float64(len(documents))
+	for term, df := range docFreq {
+		// smoothed so terms in every document still carry a little weight
+		idx.idf[term] = math.Log((1+n)/(1+float64(df))) + 1
+	}
+
+	for i := range counts {
+		idx.vectors[i] = idx.weigh(counts[i])
+	}
+
+	return idx
+}
+
+// Len returns the number of documents in the index
+func (idx *Index) Len() int {
+	return len(idx.vectors)
+}
+
+// Rank returns the documents similar to the query, most similar first; documents sharing no words with the
+// query are left out and at most limit matches are returned when limit is positive
+func (idx *Index) Rank(query string, limit int) []Match {
+	q := idx.weigh(termCounts(Tokenize(plainText(idx.sanitizer, query))))
+	if len(q) == 0 {
+		return nil
+	}
+
+	var matches []Match
+
+	for i, vec := range idx.vectors {
+		if score := cosine(q, vec); score > 0 {
+			matches = append(matches, Match{Document: i, Score: score})
+		}
+	}
+
+	slices.SortStableFunc(matches, func(a, b Match) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
+
+// weigh returns the unit length TF-IDF vector of the term counts; terms unknown to the index are dropped
+func (idx *Index) weigh(counts map[string]int) map[string]float64 {
+	vec := make(map[string]float64, len(counts))
+
+	var norm float64
+
+	for term, count := range counts {
+		idf, ok := idx.idf[term]
+		if !ok {
+			continue
+		}
+
+		w := (1 + math.Log(float64(count))) * idf
+		vec[term] = w
+		norm += w * w
+	}
+
+	if norm == 0 {
+		return nil
+	}
+
+	norm = math.Sqrt(norm)
+	for term := range vec {
+		vec[term] /= norm
+	}
+
+	return vec
+}
+
+// cosine returns the cosine similarity of two unit length vectors
+func cosine(a, b map[string]float64) float64 {
+	if len(b) < len(a) {
+		a, b = b, a
+	}
+
+	var dot float64
+
+	for term, w := range a {
+		dot += w * b[term]
+	}
+
+	return min(dot, 1)
+}
+
+// Tokenize splits text into lower case words, dropping stop words and reducing plurals so "controls" and
+// "control" compare equal
+func Tokenize(s string) []string {
+	words := strings.FieldsFunc(strings.ToLower(html.UnescapeString(s)), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	out := words[:0]
+
+	for _, w := range words {
+		if len(w) < minTokenLength {
+			continue
+		}
+
+		if _, ok := stopWords[w]; ok {
+			continue
+		}
+
+		out = append(out, stem(w))
+	}
+
+	return out
+}
+
+// stem removes common plural and verb endings
+func stem(w string) string {
+	switch {
+	case len(w) > 4 && strings.HasSuffix(w, "ies"):
+		return w[:len(w)-3] + "y"
+	case len(w) > 5 && strings.HasSuffix(w, "ing"):
+		return w[:len(w)-3]
+	case len(w) > 4 && strings.HasSuffix(w, "ed") && !strings.HasSuffix(w, "eed"):
+		return w[:len(w)-2]
+	case len(w) > 3 && strings.HasSuffix(w, "s") && !strings.HasSuffix(w, "ss") && !strings.HasSuffix(w, "us"):
+		return w[:len(w)-1]
+	default:
+		return w
+	}
+}
+
+// termCounts counts the occurrences of each term
+func termCounts(terms []string) map[string]int {
+	counts := make(map[string]int, len(terms))
+
+	for _, t := range terms {
+		counts[t]++
+	}
+
+	return counts
+}
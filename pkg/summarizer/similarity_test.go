@@ -0,0 +1,41 @@
+package summarizer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	assert.Equal(t, []string{"encrypt", "data", "rest"}, Tokenize("Do you encrypt data at rest?"))
+	assert.Equal(t, []string{"policy", "control", "access"}, Tokenize("**Policies** & controls: access"))
+	assert.Empty(t, Tokenize("is it a"))
+}
+
+func TestIndexRank(t *testing.T) {
+	idx := NewIndex([]string{
+		"All customer data is encrypted at rest using AES-256.",
+		"Employees complete security awareness training every year.",
+		"We run annual penetration tests with an independent firm.",
+		"<p>Backups are <strong>encrypted</strong> and tested quarterly.</p>",
+	})
+
+	require.Equal(t, 4, idx.Len())
+
+	matches := idx.Rank("Is customer data encrypted at rest?", 0)
+	require.NotEmpty(t, matches)
+	assert.Equal(t, 0, matches[0].Document)
+	assert.LessOrEqual(t, matches[0].Score, 1.0)
+
+	for i := 1; i < len(matches); i++ {
+		assert.GreaterOrEqual(t, matches[i-1].Score, matches[i].Score)
+	}
+
+	matches = idx.Rank("How often do you run penetration testing?", 1)
+	require.Len(t, matches, 1)
+	assert.Equal(t, 2, matches[0].Document)
+
+	assert.Empty(t, idx.Rank("completely unrelated wording", 0))
+	assert.Empty(t, idx.Rank("", 0))
+}
@@ -52,10 +52,7 @@ func NewSummarizer(cfg Config) (*Client, error) {
 
 // Summarize returns a shortened version of the provided string using the lexrank algorithm
 func (s *Client) Summarize(ctx context.Context, sentence string) (string, error) {
-	// also convert markdown to HTML before sanitizing
-	sanitizedSentence := string(mdToHTML([]byte(sentence)))
-
-	sanitizedSentence = s.sanitizer.Sanitize(sanitizedSentence)
+	sanitizedSentence := plainText(s.sanitizer, sentence)
 
 	if strings.TrimSpace(sanitizedSentence) == "" {
 		return "", nil
@@ -64,6 +61,11 @@ func (s *Client) Summarize(ctx context.Context, sentence string) (string, error)
 	return s.impl.Summarize(ctx, sanitizedSentence)
 }
 
+// plainText converts markdown to HTML and strips the tags the policy does not allow
+func plainText(sanitizer *bluemonday.Policy, s string) string {
+	return sanitizer.Sanitize(string(mdToHTML([]byte(s))))
+}
+
 func mdToHTML(md []byte) []byte {
 	gm := goldmark.New(
 		goldmark.WithParserOptions(